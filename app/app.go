@@ -116,6 +116,8 @@ import (
 	"github.com/spf13/cast"
 
 	appparams "github.com/babylonchain/babylon/app/params"
+	"github.com/babylonchain/babylon/app/upgrades"
+	v1 "github.com/babylonchain/babylon/app/upgrades/v1"
 	"github.com/babylonchain/babylon/client/docs"
 	bbn "github.com/babylonchain/babylon/types"
 	owasm "github.com/babylonchain/babylon/wasmbinding"
@@ -184,10 +186,15 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		ibcfeetypes.ModuleName:         nil,
-		incentivetypes.ModuleName:      nil, // this line is needed to create an account for incentive module
+		incentivetypes.ModuleName:      nil,                 // this line is needed to create an account for incentive module
+		btclightclienttypes.ModuleName: nil,                 // holds the bonds of BTC header reporters
+		epochingtypes.ModuleName:       {authtypes.Staking}, // escrows the funds of queued delegations
 	}
 )
 
+// Upgrades holds the software upgrades the app knows how to apply
+var Upgrades = []upgrades.Upgrade{v1.Upgrade}
+
 // Wasm related variables
 var (
 	// EmptyWasmOpts defines a type alias for a list of wasm options.
@@ -509,6 +516,8 @@ func NewBabylonApp(
 		app.StakingKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// checkpointing keeper slashes validators signing conflicting block hashes
+	checkpointingKeeper.SetSlashingKeeper(app.SlashingKeeper)
 
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
 		appCodec,
@@ -597,6 +606,8 @@ func NewBabylonApp(
 	btclightclientKeeper := btclightclientkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[btclightclienttypes.StoreKey]),
+		app.BankKeeper,
+		app.IncentiveKeeper,
 		btcConfig,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -662,10 +673,13 @@ func NewBabylonApp(
 		appCodec,
 		runtime.NewKVStoreService(keys[monitortypes.StoreKey]),
 		&btclightclientKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// add msgServiceRouter so that the epoching module can forward unwrapped messages to the staking module
 	epochingKeeper.SetMsgServiceRouter(app.BaseApp.MsgServiceRouter())
+	// add authzKeeper so that grantees can queue staking messages on behalf of granters
+	epochingKeeper.SetAuthzKeeper(app.AuthzKeeper)
 	// make ZoneConcierge and Monitor to subscribe to the epoching's hooks
 	app.EpochingKeeper = *epochingKeeper.SetHooks(
 		epochingtypes.NewMultiEpochingHooks(app.ZoneConciergeKeeper.Hooks(), app.MonitorKeeper.Hooks()),
@@ -681,7 +695,7 @@ func NewBabylonApp(
 	)
 
 	// set up BTC staking keeper
-	app.BTCStakingKeeper = btcstakingkeeper.NewKeeper(
+	btcStakingKeeper := btcstakingkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[btcstakingtypes.StoreKey]),
 		&btclightclientKeeper,
@@ -690,6 +704,11 @@ func NewBabylonApp(
 		btcNetParams,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// make Incentive to subscribe to the BTC staking's hooks for accounting
+	// rewards of BTC delegations
+	app.BTCStakingKeeper = *btcStakingKeeper.SetHooks(
+		btcstakingtypes.NewMultiBTCStakingHooks(app.IncentiveKeeper.Hooks()),
+	)
 	// set up finality keeper
 	app.FinalityKeeper = finalitykeeper.NewKeeper(
 		appCodec,
@@ -716,7 +735,16 @@ func NewBabylonApp(
 		panic(fmt.Sprintf("error while reading wasm config: %s", err))
 	}
 
-	wasmOpts = append(owasm.RegisterCustomPlugins(&app.EpochingKeeper, &app.ZoneConciergeKeeper, &app.BTCLightClientKeeper), wasmOpts...)
+	wasmOpts = append(owasm.RegisterCustomPlugins(
+		app.MsgServiceRouter(),
+		interfaceRegistry,
+		&app.EpochingKeeper,
+		&app.ZoneConciergeKeeper,
+		&app.BTCLightClientKeeper,
+		&app.BTCStakingKeeper,
+		&app.FinalityKeeper,
+		&app.CheckpointingKeeper,
+	), wasmOpts...)
 
 	app.WasmKeeper = wasmkeeper.NewKeeper(
 		appCodec,
@@ -814,7 +842,7 @@ func NewBabylonApp(
 		btcstaking.NewAppModule(appCodec, app.BTCStakingKeeper),
 		finality.NewAppModule(appCodec, app.FinalityKeeper),
 		// Babylon modules - tokenomics
-		incentive.NewAppModule(appCodec, app.IncentiveKeeper, app.AccountKeeper, app.BankKeeper),
+		incentive.NewAppModule(appCodec, app.IncentiveKeeper, app.AccountKeeper, app.BankKeeper, app.BTCStakingKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager which is in charge of setting up basic,
@@ -976,6 +1004,7 @@ func NewBabylonApp(
 	// - authAnteHandler
 	// - custom wasm ante handler NewLimitSimulationGasDecorator and NewCountTXDecorator
 	// - Extra decorators introduced in Babylon, such as DropValidatorMsgDecorator that delays validator-related messages
+	//   and QueuedMsgFeeDecorator that records the fees prioritising queued messages
 	//
 	// We are using constructor from wasmapp as it introduces custom wasm ante handle decorators
 	// early in chain of ante handlers.
@@ -1003,6 +1032,7 @@ func NewBabylonApp(
 	anteHandler := sdk.ChainAnteDecorators(
 		NewWrappedAnteHandler(authAnteHandler),
 		epochingkeeper.NewDropValidatorMsgDecorator(app.EpochingKeeper),
+		epochingkeeper.NewQueuedMsgFeeDecorator(app.EpochingKeeper),
 		NewBtcValidationDecorator(btcConfig, &app.BtcCheckpointKeeper),
 	)
 
@@ -1048,6 +1078,9 @@ func NewBabylonApp(
 		_, _ = fmt.Fprintln(os.Stderr, err.Error())
 	}
 
+	app.setupUpgradeHandlers()
+	app.setupUpgradeStoreLoaders()
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			cmtos.Exit(err.Error())
@@ -1261,6 +1294,34 @@ func (app *BabylonApp) AutoCliOpts() autocli.AppOptions {
 	}
 }
 
+// setupUpgradeHandlers registers the handlers of all software upgrades
+func (app *BabylonApp) setupUpgradeHandlers() {
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			upgrade.UpgradeName,
+			upgrade.CreateUpgradeHandler(app.ModuleManager, app.configurator),
+		)
+	}
+}
+
+// setupUpgradeStoreLoaders sets the store loader of the upgrade that is
+// scheduled at the current height, if any, so that its store upgrades apply
+func (app *BabylonApp) setupUpgradeStoreLoaders() {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk %s", err))
+	}
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+	for _, upgrade := range Upgrades {
+		if upgradeInfo.Name == upgrade.UpgradeName {
+			storeUpgrades := upgrade.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		}
+	}
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 func GetMaccPerms() map[string][]string {
 	dupMaccPerms := make(map[string][]string)
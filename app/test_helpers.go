@@ -26,6 +26,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/docker/docker/pkg/ioutils"
 	"github.com/stretchr/testify/require"
@@ -150,6 +151,7 @@ func genesisStateWithValSet(t *testing.T,
 
 	validators := make([]stakingtypes.Validator, 0, len(valSet))
 	delegations := make([]stakingtypes.Delegation, 0, len(valSet))
+	signingInfos := make([]slashingtypes.SigningInfo, 0, len(valSet))
 
 	bondAmt := sdk.DefaultPowerReduction.MulRaw(1000)
 
@@ -172,6 +174,13 @@ func genesisStateWithValSet(t *testing.T,
 
 		validators = append(validators, validator)
 		delegations = append(delegations, stakingtypes.NewDelegation(genAccs[0].GetAddress().String(), valGenKey.ValidatorAddress, math.LegacyOneDec()))
+		// genesis validators bonded via gentxs get their signing info from the
+		// staking hooks, which are not triggered by pre-bonded validators
+		consAddr := sdk.ConsAddress(valGenKey.ValPubkey.Address())
+		signingInfos = append(signingInfos, slashingtypes.SigningInfo{
+			Address:              consAddr.String(),
+			ValidatorSigningInfo: slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0).UTC(), false, 0),
+		})
 		// blsKeys = append(blsKeys, checkpointingtypes.NewGenesisKey(sdk.ValAddress(val.Address), genesisBLSPubkey))
 	}
 	// total bond amount = bond amount * number of validators
@@ -183,6 +192,10 @@ func genesisStateWithValSet(t *testing.T,
 	stakingGenesis.Params.BondDenom = appparams.DefaultBondDenom
 	genesisState[stakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(stakingGenesis)
 
+	slashingGenesis := slashingtypes.DefaultGenesisState()
+	slashingGenesis.SigningInfos = signingInfos
+	genesisState[slashingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(slashingGenesis)
+
 	checkpointingGenesis := &checkpointingtypes.GenesisState{
 		GenesisKeys: valSet,
 	}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+
+	store "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/babylonchain/babylon/app/upgrades"
+)
+
+// UpgradeName defines the on-chain upgrade name for the v1 upgrade.
+const UpgradeName = "v1"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades:        store.StoreUpgrades{},
+}
+
+// CreateUpgradeHandler runs the in-place store migrations registered by the
+// modules whose consensus version was bumped, e.g., to fill in parameters
+// that did not exist in the previous version with their defaults.
+func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
@@ -36,6 +36,11 @@ const (
 
 	CurrentVersion FormatVersion = 0
 
+	// SingleTxVersion is the version in which the whole checkpoint is carried
+	// by a single transaction, e.g., in the witness of a Taproot script path
+	// spend, rather than split into two OP_RETURN outputs
+	SingleTxVersion FormatVersion = 1
+
 	firstPartIndex uint8 = 0
 
 	secondPartIndex uint8 = 1
@@ -69,6 +74,11 @@ const (
 	secondPartLength = headerLength + BlsSigLength + firstPartHashLength
 
 	RawBTCCheckpointLength = EpochLength + BlockHashLength + BitMapLength + BlsSigLength + AddressLength
+
+	// A single tx checkpoint is the header followed by the whole raw checkpoint
+	singleTxDataLength = headerLength + RawBTCCheckpointLength
+
+	singleTxPartIndex uint8 = 0
 )
 
 func getVerHalf(version FormatVersion, halfNumber uint8) uint8 {
@@ -143,34 +153,42 @@ func encodeSecondOpReturn(
 	return serializedBytes
 }
 
-func EncodeCheckpointData(
-	tag BabylonTag,
-	version FormatVersion,
-	rawBTCCheckpoint *RawBtcCheckpoint,
-) ([]byte, []byte, error) {
-
+func validateEncodingInput(tag BabylonTag, rawBTCCheckpoint *RawBtcCheckpoint) error {
 	if len(tag) != TagLength {
-		return nil, nil, errors.New("tag should have 4 bytes")
-	}
-
-	if version > CurrentVersion {
-		return nil, nil, errors.New("invalid format version")
+		return errors.New("tag should have 4 bytes")
 	}
 
 	if len(rawBTCCheckpoint.BlockHash) != BlockHashLength {
-		return nil, nil, errors.New("appHash should have 32 bytes")
+		return errors.New("appHash should have 32 bytes")
 	}
 
 	if len(rawBTCCheckpoint.BitMap) != BitMapLength {
-		return nil, nil, errors.New("bitmap should have 13 bytes")
+		return errors.New("bitmap should have 13 bytes")
 	}
 
 	if len(rawBTCCheckpoint.BlsSig) != BlsSigLength {
-		return nil, nil, errors.New("BlsSig should have 48 bytes")
+		return errors.New("BlsSig should have 48 bytes")
 	}
 
 	if len(rawBTCCheckpoint.SubmitterAddress) != AddressLength {
-		return nil, nil, errors.New("address should have 20 bytes")
+		return errors.New("address should have 20 bytes")
+	}
+
+	return nil
+}
+
+func EncodeCheckpointData(
+	tag BabylonTag,
+	version FormatVersion,
+	rawBTCCheckpoint *RawBtcCheckpoint,
+) ([]byte, []byte, error) {
+
+	if version > CurrentVersion {
+		return nil, nil, errors.New("invalid format version")
+	}
+
+	if err := validateEncodingInput(tag, rawBTCCheckpoint); err != nil {
+		return nil, nil, err
 	}
 
 	var firstHalf = encodeFirstOpRetrun(
@@ -205,6 +223,46 @@ func MustEncodeCheckpointData(
 	return f, s
 }
 
+// EncodeSingleTxCheckpointData encodes the whole checkpoint into a single
+// piece of data in the SingleTxVersion format, which is the header followed by
+// the raw checkpoint in the same layout as the one produced by ConnectParts
+func EncodeSingleTxCheckpointData(
+	tag BabylonTag,
+	rawBTCCheckpoint *RawBtcCheckpoint,
+) ([]byte, error) {
+	if err := validateEncodingInput(tag, rawBTCCheckpoint); err != nil {
+		return nil, err
+	}
+
+	var serializedBytes = []byte{}
+
+	serializedBytes = append(serializedBytes, encodeHeader(tag, SingleTxVersion, singleTxPartIndex)...)
+
+	serializedBytes = append(serializedBytes, U64ToBEBytes(rawBTCCheckpoint.Epoch)...)
+
+	serializedBytes = append(serializedBytes, rawBTCCheckpoint.BlockHash...)
+
+	serializedBytes = append(serializedBytes, rawBTCCheckpoint.BitMap...)
+
+	serializedBytes = append(serializedBytes, rawBTCCheckpoint.SubmitterAddress...)
+
+	serializedBytes = append(serializedBytes, rawBTCCheckpoint.BlsSig...)
+
+	return serializedBytes, nil
+}
+
+func MustEncodeSingleTxCheckpointData(
+	tag BabylonTag,
+	rawBTCCheckpoint *RawBtcCheckpoint,
+) []byte {
+	data, err := EncodeSingleTxCheckpointData(tag, rawBTCCheckpoint)
+	if err != nil {
+		panic(err)
+	}
+
+	return data
+}
+
 func parseHeader(
 	data []byte,
 ) *formatHeader {
@@ -223,14 +281,16 @@ func parseHeader(
 
 func (header *formatHeader) validateHeader(
 	expectedTag BabylonTag,
-	_ FormatVersion,
+	expectedVersion FormatVersion,
 	expectedPart uint8,
 ) error {
 	if !bytes.Equal(header.tag, expectedTag) {
 		return fmt.Errorf("data does not have expected tag, expected tag: %v, got tag: %v", expectedTag, header.tag)
 	}
 
-	if header.version > CurrentVersion {
+	// two-part and single tx checkpoints have different layouts, so data of one
+	// format can never be accepted as data of the other
+	if header.version != expectedVersion {
 		return errors.New("header have invalid version")
 	}
 
@@ -283,6 +343,32 @@ func GetCheckpointData(
 	return dataNoHeader, nil
 }
 
+// GetSingleTxCheckpointData validates the data carried by a single tx
+// checkpoint and returns the raw checkpoint bytes without the header, which
+// can be decoded by DecodeRawCheckpoint
+func GetSingleTxCheckpointData(
+	tag BabylonTag,
+	data []byte,
+) ([]byte, error) {
+	if len(data) != singleTxDataLength {
+		return nil, fmt.Errorf("invalid length. Single tx checkpoint data should have %d bytes", singleTxDataLength)
+	}
+
+	header := parseHeader(data)
+
+	err := header.validateHeader(tag, SingleTxVersion, singleTxPartIndex)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dataNoHeader := make([]byte, RawBTCCheckpointLength)
+
+	copy(dataNoHeader, data[headerLength:])
+
+	return dataNoHeader, nil
+}
+
 // IsBabylonCheckpointData Checks if given bytearray is potential babylon data,
 // if it is then returns index of data along side with data itself
 func IsBabylonCheckpointData(
@@ -310,7 +396,8 @@ func IsBabylonCheckpointData(
 // DecodeRawCheckpoint extracts epoch, appHash, bitmap, and blsSig from a
 // flat byte array and compose them into a RawCheckpoint struct
 func DecodeRawCheckpoint(version FormatVersion, btcCkptBytes []byte) (*RawBtcCheckpoint, error) {
-	if version > CurrentVersion {
+	// both versions share the same layout of raw checkpoint bytes
+	if version > SingleTxVersion {
 		return nil, errors.New("not supported version")
 	}
 
@@ -92,6 +92,86 @@ func FuzzEncodingDecoding(f *testing.F) {
 	})
 }
 
+func FuzzSingleTxEncodingDecoding(f *testing.F) {
+	f.Add(uint64(5), randNBytes(TagLength), randNBytes(BlockHashLength), randNBytes(BitMapLength), randNBytes(BlsSigLength), randNBytes(AddressLength))
+	f.Add(uint64(20), randNBytes(TagLength), randNBytes(BlockHashLength), randNBytes(BitMapLength), randNBytes(BlsSigLength), randNBytes(AddressLength))
+	f.Add(uint64(2000), randNBytes(TagLength), randNBytes(BlockHashLength), randNBytes(BitMapLength), randNBytes(BlsSigLength), randNBytes(AddressLength))
+
+	f.Fuzz(func(t *testing.T, epoch uint64, tag []byte, appHash []byte, bitMap []byte, blsSig []byte, address []byte) {
+
+		if len(tag) < TagLength {
+			t.Skip("Tag should have 4 bytes")
+		}
+
+		babylonTag := BabylonTag(tag[:TagLength])
+
+		rawBTCCkpt := &RawBtcCheckpoint{
+			Epoch:            epoch,
+			BlockHash:        appHash,
+			BitMap:           bitMap,
+			SubmitterAddress: address,
+			BlsSig:           blsSig,
+		}
+		data, err := EncodeSingleTxCheckpointData(babylonTag, rawBTCCkpt)
+
+		if err != nil {
+			// if encoding failed we cannod check anything else
+			t.Skip("Encoding should be correct")
+		}
+
+		if len(data) != singleTxDataLength {
+			t.Errorf("Encoded data should have %d bytes, have %d", singleTxDataLength, len(data))
+		}
+
+		// single tx data should never be taken as a part of two-part checkpoint
+		if _, err := IsBabylonCheckpointData(babylonTag, CurrentVersion, data); err == nil {
+			t.Errorf("Single tx data should not be decoded as a part of checkpoint")
+		}
+
+		ckptData, err := GetSingleTxCheckpointData(babylonTag, data)
+		if err != nil {
+			t.Fatalf("Valid data should be properly decoded. Error: %v", err)
+		}
+
+		ckpt, err := DecodeRawCheckpoint(SingleTxVersion, ckptData)
+		if err != nil {
+			t.Fatalf("Failed to unmarshal. Error: %v", err)
+		}
+
+		if ckpt.Epoch != epoch {
+			t.Errorf("Epoch should match. Expected: %v. Got: %v", epoch, ckpt.Epoch)
+		}
+
+		if !bytes.Equal(appHash, ckpt.BlockHash) {
+			t.Errorf("BlockHash should match. Expected: %v. Got: %v", appHash, ckpt.BlockHash)
+		}
+
+		if !bytes.Equal(bitMap, ckpt.BitMap) {
+			t.Errorf("Bitmap should match. Expected: %v. Got: %v", bitMap, ckpt.BitMap)
+		}
+
+		if !bytes.Equal(address, ckpt.SubmitterAddress) {
+			t.Errorf("Submitter address should match. Expected: %v. Got: %v", address, ckpt.SubmitterAddress)
+		}
+
+		if !bytes.Equal(blsSig, ckpt.BlsSig) {
+			t.Errorf("BLS signature should match. Expected: %v. Got: %v", blsSig, ckpt.BlsSig)
+		}
+
+		// the single tx format yields the same raw checkpoint bytes as the
+		// two-part format
+		firstHalf, secondHalf := MustEncodeCheckpointData(babylonTag, CurrentVersion, rawBTCCkpt)
+		connected, err := ConnectParts(CurrentVersion, firstHalf[headerLength:], secondHalf[headerLength:])
+		if err != nil {
+			t.Fatalf("Parts should match. Error: %v", err)
+		}
+
+		if !bytes.Equal(connected, ckptData) {
+			t.Errorf("Raw checkpoint bytes should match. Expected: %v. Got: %v", connected, ckptData)
+		}
+	})
+}
+
 // This fuzzer checks if decoder won't panic with whatever bytes we point it at
 func FuzzDecodingWontPanic(f *testing.F) {
 	f.Add(randNBytes(firstPartLength), uint8(rand.Intn(99)))
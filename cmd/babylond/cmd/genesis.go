@@ -318,14 +318,14 @@ func TestnetGenesisParams(maxActiveValidators uint32, btcConfirmationDepth uint6
 	work := btclightclienttypes.CalcWork(&baseBtcHeader)
 	baseBtcHeaderInfo := btclightclienttypes.NewBTCHeaderInfo(&baseBtcHeader, baseBtcHeader.Hash(), baseBtcHeaderHeight, &work)
 
-	params, err := btclightclienttypes.NewParamsValidate(allowedReporters)
+	genParams.BtclightclientParams = btclightclienttypes.DefaultParams()
+	genParams.BtclightclientParams.InsertHeadersAllowList = allowedReporters
 
-	if err != nil {
+	if err := genParams.BtclightclientParams.Validate(); err != nil {
 		panic(err)
 	}
 
 	genParams.BtclightclientBaseBtcHeader = *baseBtcHeaderInfo
-	genParams.BtclightclientParams = params
 
 	genParams.BtcstakingParams = btcstakingtypes.DefaultParams()
 	covenantPKsBIP340 := make([]bbn.BIP340PubKey, 0, len(covenantPKs))
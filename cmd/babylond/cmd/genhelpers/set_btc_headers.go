@@ -25,6 +25,8 @@ func CmdSetBtcHeaders() *cobra.Command {
 		Long: `Reads BTC Headers structures from the given json file and update the genesis.json file
 in place to include the btc headers in the btcstaking module's genesis state.
 Duplicated BTC headers are not allowed and it will prompt an error.
+If the file contains a retarget context, it replaces the one in the genesis. It is
+required if the base BTC header is not at a difficulty adjustment boundary.
 `,
 		Example: `babylond gen-helpers set-btc-headers path/to/btc_headers.json
 Possible content of 'btc_headers.json' is
@@ -42,7 +44,12 @@ Possible content of 'btc_headers.json' is
 			"height": "1",
 			"work": "4"
 		}
-	]
+	],
+	"retarget_context": {
+		"period_start_header": "0100000000000000000000000000000000000000000000000000000000000000000000003ba3edfd7a7b12b27ac72c3e67768f617fc81bc3888a51323a9fb8aa4b1e5e4a45068653ffff7f2002000000",
+		"period_start_height": "0",
+		"previous_timestamps": []
+	}
 }
 `,
 		Args: cobra.ExactArgs(1),
@@ -86,6 +93,9 @@ Possible content of 'btc_headers.json' is
 				newBtcHeaders = append(newBtcHeaders, btcHeader)
 			}
 			btclightGenState.BtcHeaders = append(btclightGenState.BtcHeaders, newBtcHeaders...)
+			if inputBtcHeaders.RetargetContext != nil {
+				btclightGenState.RetargetContext = inputBtcHeaders.RetargetContext
+			}
 
 			if err := btclightGenState.Validate(); err != nil {
 				return err
@@ -190,6 +190,7 @@ func initRootCmd(rootCmd *cobra.Command, txConfig client.TxEncodingConfig, basic
 		genhelpers.CmdGenHelpers(gentxModule.GenTxValidator),
 		CreateBlsKeyCmd(),
 		ModuleSizeCmd(),
+		VerifyBTCTimestampCmd(),
 		debug.Cmd(),
 		confixcmd.ConfigCommand(),
 	)
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	zctypes "github.com/babylonchain/babylon/x/zoneconcierge/types"
+	"github.com/babylonchain/babylon/x/zoneconcierge/verifier"
+)
+
+const (
+	flagTimestampFormat = "format"
+	flagBTCHeaders      = "btc-headers"
+	flagBTCNetwork      = "btc-network"
+
+	timestampFormatQuery  = "query"
+	timestampFormatPacket = "packet"
+)
+
+func VerifyBTCTimestampCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-btc-timestamp [timestamp-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Verify a BTC timestamp of a consumer chain offline against trusted BTC headers",
+		Long: strings.TrimSpace(
+			`verify-btc-timestamp verifies a BTC timestamp of a consumer chain without
+connecting to a Babylon node, and prints the verdict of each verification step.
+
+The BTC timestamp is read from the given file, which contains either
+- the JSON response of the finalized-chain-info-until-height query (--format query), or
+- the hex-encoded data of a zoneconcierge IBC packet (--format packet).
+
+The trusted BTC headers are read from the file given by --btc-headers, which
+contains one hex-encoded BTC header per line, ordered by height. The BTC
+headers carried by the BTC timestamp have to extend the trusted ones.
+
+Example:
+$ babylond verify-btc-timestamp timestamp.json --btc-headers headers.txt --btc-network signet
+`,
+		),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, _ := cmd.Flags().GetString(flagTimestampFormat)
+			headersFile, _ := cmd.Flags().GetString(flagBTCHeaders)
+			network, _ := cmd.Flags().GetString(flagBTCNetwork)
+			tagHex, _ := cmd.Flags().GetString(flagCheckpointTag)
+			w, _ := cmd.Flags().GetUint64(flagBtcFinalizationTimeout)
+
+			netParams, err := bbn.GetBtcNetworkParams(network)
+			if err != nil {
+				return err
+			}
+			tag, err := hex.DecodeString(tagHex)
+			if err != nil || len(tag) != txformat.TagLength {
+				return fmt.Errorf("checkpoint tag should be %d bytes in hex", txformat.TagLength)
+			}
+
+			ts, err := readBTCTimestamp(args[0], format)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(headersFile)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			trustedHeaders, err := verifier.ReadBTCHeaders(f)
+			if err != nil {
+				return err
+			}
+
+			report := verifier.Verify(ts, trustedHeaders, verifier.Config{
+				PowLimit:      netParams.PowLimit,
+				CheckpointTag: tag,
+				W:             w,
+				CZHeaderKey:   zctypes.GetCZHeaderKeyPath,
+			})
+			cmd.Println(report.String())
+			if !report.Valid() {
+				return fmt.Errorf("the BTC timestamp is invalid")
+			}
+			cmd.Printf("the BTC timestamp of epoch %d is valid\n", ts.EpochInfo.EpochNumber)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagTimestampFormat, timestampFormatQuery, "The format of the BTC timestamp file, either query or packet")
+	cmd.Flags().String(flagBTCHeaders, "", "The file of trusted BTC headers")
+	cmd.Flags().String(flagBTCNetwork, string(bbn.BtcMainnet), "The BTC network, one of [mainnet, testnet, simnet, regtest, signet]")
+	cmd.Flags().String(flagCheckpointTag, btcctypes.DefaultCheckpointTag, "The tag of Babylon checkpoints on BTC in hex")
+	cmd.Flags().Uint64(flagBtcFinalizationTimeout, btcctypes.DefaultCheckpointFinalizationTimeout, "The BTC confirmation depth for a checkpoint to be finalised")
+	_ = cmd.MarkFlagRequired(flagBTCHeaders)
+
+	return cmd
+}
+
+func readBTCTimestamp(path string, format string) (*verifier.BTCTimestamp, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case timestampFormatQuery:
+		return verifier.BTCTimestampFromQueryJSON(bz)
+	case timestampFormatPacket:
+		return verifier.BTCTimestampFromPacketHex(string(bz))
+	default:
+		return nil, fmt.Errorf("format should be one of [%s, %s]", timestampFormatQuery, timestampFormatPacket)
+	}
+}
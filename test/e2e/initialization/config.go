@@ -26,6 +26,7 @@ import (
 	btccheckpointtypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	blctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	zctypes "github.com/babylonchain/babylon/x/zoneconcierge/types"
 
 	"github.com/babylonchain/babylon/test/e2e/util"
 )
@@ -61,6 +62,9 @@ const (
 	ChainBID        = "bbn-test-b"
 	BabylonBalanceB = 500000000000
 	StakeAmountB    = 400000000000
+	// IBC light client and ZoneConcierge channel of the counterparty chain
+	ConsumerClientID  = "07-tendermint-0"
+	ConsumerChannelID = "channel-0"
 
 	EpochDuration         = time.Second * 60
 	TWAPPruningKeepPeriod = EpochDuration / 4
@@ -251,6 +255,11 @@ func initGenesis(chain *internalChain, votingPeriod, expeditedVotingPeriod time.
 		return err
 	}
 
+	err = updateModuleGenesis(appGenState, zctypes.ModuleName, zctypes.DefaultGenesis(), updateZoneConciergeGenesis)
+	if err != nil {
+		return err
+	}
+
 	bz, err := json.MarshalIndent(appGenState, "", "  ")
 	if err != nil {
 		return err
@@ -324,6 +333,16 @@ func updateBtccheckpointGenesis(btccheckpointGenState *btccheckpointtypes.Genesi
 	btccheckpointGenState.Params.CheckpointTag = BabylonOpReturnTag
 }
 
+func updateZoneConciergeGenesis(zcGenState *zctypes.GenesisState) {
+	// the test chains checkpoint to each other as consumer chains, through the
+	// first IBC light client and channel, which the relayer creates for the
+	// ZoneConcierge channel between them
+	zcGenState.Consumers = []*zctypes.ConsumerMetadata{
+		zctypes.NewConsumerMetadata(ChainAID, ChainAID, "", ConsumerClientID, ConsumerChannelID),
+		zctypes.NewConsumerMetadata(ChainBID, ChainBID, "", ConsumerClientID, ConsumerChannelID),
+	}
+}
+
 func updateGenUtilGenesis(c *internalChain) func(*genutiltypes.GenesisState) {
 	return func(genUtilGenState *genutiltypes.GenesisState) {
 		// generate genesis txs
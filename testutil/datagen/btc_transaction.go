@@ -45,6 +45,8 @@ type TestRawCheckpointData struct {
 	FirstPart        []byte
 	SecondPart       []byte
 	ExpectedOpReturn []byte
+	// SingleTxData is the checkpoint encoded in the single tx format
+	SingleTxData []byte
 }
 
 // standardCoinbaseScript returns a standard script suitable for use as the
@@ -231,10 +233,61 @@ func CreatOpReturnTransaction(r *rand.Rand, babylonData []byte) *wire.MsgTx {
 	return tx
 }
 
+// CreateEnvelopeTransactions creates a commit transaction with a Taproot
+// output committing to an envelope of the given data, and a reveal transaction
+// spending that output through the script path, which reveals the data
+func CreateEnvelopeTransactions(r *rand.Rand, babylonData []byte) (*wire.MsgTx, *wire.MsgTx) {
+	_, internalKey, err := GenRandomBTCKeyPair(r)
+	if err != nil {
+		panic(err)
+	}
+
+	envelopeScript, err := btcctypes.NewEnvelopeScript(internalKey, babylonData)
+	if err != nil {
+		panic(err)
+	}
+
+	tree := txscript.AssembleTaprootScriptTree(txscript.NewBaseTapLeaf(envelopeScript))
+	rootHash := tree.RootNode.TapHash()
+	outputKey := txscript.ComputeTaprootOutputKey(internalKey, rootHash[:])
+	pkScript, err := txscript.PayToTaprootScript(outputKey)
+	if err != nil {
+		panic(err)
+	}
+	controlBlock := tree.LeafMerkleProofs[0].ToControlBlock(internalKey)
+	controlBlockBytes, err := controlBlock.ToBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	out := makeSpendableOutWithRandOutPoint(r, 1000)
+	commitTx := wire.NewMsgTx(int32(tranasctionVersion))
+	commitTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: out.prevOut,
+		Sequence:         wire.MaxTxInSequenceNum,
+		SignatureScript:  nil,
+	})
+	commitTx.AddTxOut(wire.NewTxOut(int64(out.amount-lowFee), pkScript))
+
+	revealTx := wire.NewMsgTx(int32(tranasctionVersion))
+	revealTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: commitTx.TxHash(), Index: 0},
+		Sequence:         wire.MaxTxInSequenceNum,
+		// the signature is not verified by Babylon, so a random one suffices
+		Witness: wire.TxWitness{GenRandomByteArray(r, 64), envelopeScript, controlBlockBytes},
+	})
+	revealTx.AddTxOut(wire.NewTxOut(int64(out.amount-2*lowFee), opTrueScript))
+
+	return commitTx, revealTx
+}
+
 type BlockCreationResult struct {
 	HeaderBytes  bbn.BTCHeaderBytes
 	Transactions []string
 	BbnTxIndex   uint32
+	// SpentTransaction is the hex of the transaction spent by the babylon tx,
+	// which is only set for checkpoints carried by a single transaction
+	SpentTransaction string
 }
 
 func CreateBlock(
@@ -244,6 +297,37 @@ func CreateBlock(
 	babylonOpReturnIdx uint32,
 	babylonData []byte,
 ) *BlockCreationResult {
+	out := makeSpendableOutWithRandOutPoint(r, 1000)
+	tx := createSpendOpReturnTx(&out, lowFee, babylonData)
+	return createBlockWithBabylonTx(r, height, numTx, babylonOpReturnIdx, tx)
+}
+
+// CreateSingleTxCheckpointBlock creates a block including a transaction which
+// reveals the given checkpoint data of the single tx format in its witness
+func CreateSingleTxCheckpointBlock(
+	r *rand.Rand,
+	height uint32,
+	numTx uint32,
+	babylonTxIdx uint32,
+	babylonData []byte,
+) *BlockCreationResult {
+	commitTx, revealTx := CreateEnvelopeTransactions(r, babylonData)
+	res := createBlockWithBabylonTx(r, height, numTx, babylonTxIdx, revealTx)
+
+	var buf bytes.Buffer
+	_ = commitTx.Serialize(&buf)
+	res.SpentTransaction = hex.EncodeToString(buf.Bytes())
+
+	return res
+}
+
+func createBlockWithBabylonTx(
+	r *rand.Rand,
+	height uint32,
+	numTx uint32,
+	babylonOpReturnIdx uint32,
+	babylonTx *wire.MsgTx,
+) *BlockCreationResult {
 
 	if babylonOpReturnIdx > numTx {
 		panic("babylon tx index should be less than number of transasactions and greater than 0")
@@ -256,9 +340,7 @@ func CreateBlock(
 			tx := createCoinbaseTx(int32(height), &chaincfg.SimNetParams)
 			transactions = append(transactions, tx)
 		} else if i == babylonOpReturnIdx {
-			out := makeSpendableOutWithRandOutPoint(r, 1000)
-			tx := createSpendOpReturnTx(&out, lowFee, babylonData)
-			transactions = append(transactions, tx)
+			transactions = append(transactions, babylonTx)
 		} else {
 			out := makeSpendableOutWithRandOutPoint(r, 1000)
 			tx := createSpendTx(r, &out, lowFee)
@@ -435,6 +517,14 @@ func BlockCreationResultToProofs(inputs []*BlockCreationResult) []*btcctypes.BTC
 			panic("Inputs should contain valid spv hex encoded data")
 		}
 
+		if input.SpentTransaction != "" {
+			spv.SpentBtcTransaction, err = hex.DecodeString(input.SpentTransaction)
+
+			if err != nil {
+				panic("Inputs should contain valid hex encoded spent transaction")
+			}
+		}
+
 		spvs = append(spvs, spv)
 	}
 
@@ -521,12 +611,14 @@ func EncodeRawCkptToTestData(rawBTCCkpt *txformat.RawBtcCheckpoint) *TestRawChec
 		rawBTCCkpt,
 	)
 	opReturn := getExpectedOpReturn(babylonTag, data1, data2)
+	singleTxData := txformat.MustEncodeSingleTxCheckpointData(babylonTag, rawBTCCkpt)
 
 	return &TestRawCheckpointData{
 		Epoch:            rawBTCCkpt.Epoch,
 		FirstPart:        data1,
 		SecondPart:       data2,
 		ExpectedOpReturn: opReturn,
+		SingleTxData:     singleTxData,
 	}
 }
 
@@ -246,6 +246,80 @@ func GenBTCStakingSlashingInfoWithOutPoint(
 	slashingRate sdkmath.LegacyDec,
 	slashingChangeLockTime uint16,
 ) *TestStakingSlashingInfo {
+	return genBTCStakingSlashingInfo(
+		r,
+		t,
+		btcNet,
+		outPoint,
+		stakerSK,
+		fpPKs,
+		covenantPKs,
+		covenantQuorum,
+		stakingTimeBlocks,
+		stakingValue,
+		slashingAddress,
+		slashingRate,
+		slashingChangeLockTime,
+	)
+}
+
+// GenBTCStakingSlashingInfoWithOpReturn generates a staking tx that carries
+// the given V0 OP_RETURN data right after the staking output, together with
+// its slashing tx
+func GenBTCStakingSlashingInfoWithOpReturn(
+	r *rand.Rand,
+	t testing.TB,
+	btcNet *chaincfg.Params,
+	stakerSK *btcec.PrivateKey,
+	fpPKs []*btcec.PublicKey,
+	covenantPKs []*btcec.PublicKey,
+	covenantQuorum uint32,
+	stakingTimeBlocks uint16,
+	stakingValue int64,
+	slashingAddress string,
+	slashingRate sdkmath.LegacyDec,
+	slashingChangeLockTime uint16,
+	opReturnData *btcstaking.V0OpReturnData,
+) *TestStakingSlashingInfo {
+	opReturnOutput, err := opReturnData.ToTxOutput()
+	require.NoError(t, err)
+
+	// an arbitrary input
+	spend := makeSpendableOutWithRandOutPoint(r, btcutil.Amount(stakingValue+UnbondingTxFee))
+	return genBTCStakingSlashingInfo(
+		r,
+		t,
+		btcNet,
+		&spend.prevOut,
+		stakerSK,
+		fpPKs,
+		covenantPKs,
+		covenantQuorum,
+		stakingTimeBlocks,
+		stakingValue,
+		slashingAddress,
+		slashingRate,
+		slashingChangeLockTime,
+		opReturnOutput,
+	)
+}
+
+func genBTCStakingSlashingInfo(
+	r *rand.Rand,
+	t testing.TB,
+	btcNet *chaincfg.Params,
+	outPoint *wire.OutPoint,
+	stakerSK *btcec.PrivateKey,
+	fpPKs []*btcec.PublicKey,
+	covenantPKs []*btcec.PublicKey,
+	covenantQuorum uint32,
+	stakingTimeBlocks uint16,
+	stakingValue int64,
+	slashingAddress string,
+	slashingRate sdkmath.LegacyDec,
+	slashingChangeLockTime uint16,
+	extraOutputs ...*wire.TxOut,
+) *TestStakingSlashingInfo {
 
 	stakingInfo, err := btcstaking.BuildStakingInfo(
 		stakerSK.PubKey(),
@@ -263,6 +337,9 @@ func GenBTCStakingSlashingInfoWithOutPoint(
 	txIn := wire.NewTxIn(outPoint, nil, nil)
 	tx.AddTxIn(txIn)
 	tx.AddTxOut(stakingInfo.StakingOutput)
+	for _, out := range extraOutputs {
+		tx.AddTxOut(out)
+	}
 
 	// 2 outputs for changes and staking output
 	changeAddrScript, err := GenRandomPubKeyHashScript(r, btcNet)
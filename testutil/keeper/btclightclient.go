@@ -57,6 +57,8 @@ func BTCLightClientKeeperWithCustomParams(t testing.TB, p btclightclientt.Params
 	k := btclightclientk.NewKeeper(
 		cdc,
 		stServ,
+		nil,
+		nil,
 		testCfg,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
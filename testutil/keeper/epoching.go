@@ -31,6 +31,7 @@ func EpochingKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
 
 	k := keeper.NewKeeper(
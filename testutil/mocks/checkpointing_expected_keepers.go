@@ -7,7 +7,9 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	math "cosmossdk.io/math"
 	types "github.com/babylonchain/babylon/x/checkpointing/types"
 	types0 "github.com/babylonchain/babylon/x/epoching/types"
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
@@ -54,9 +56,11 @@ func (mr *MockEpochingKeeperMockRecorder) CheckMsgCreateValidator(ctx, msg inter
 }
 
 // EnqueueMsg mocks base method.
-func (m *MockEpochingKeeper) EnqueueMsg(ctx context.Context, msg types0.QueuedMessage) {
+func (m *MockEpochingKeeper) EnqueueMsg(ctx context.Context, msg types0.QueuedMessage) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "EnqueueMsg", ctx, msg)
+	ret := m.ctrl.Call(m, "EnqueueMsg", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // EnqueueMsg indicates an expected call of EnqueueMsg.
@@ -65,19 +69,18 @@ func (mr *MockEpochingKeeperMockRecorder) EnqueueMsg(ctx, msg interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueMsg", reflect.TypeOf((*MockEpochingKeeper)(nil).EnqueueMsg), ctx, msg)
 }
 
-// GetAppHash mocks base method.
-func (m *MockEpochingKeeper) GetAppHash(ctx context.Context, height uint64) ([]byte, error) {
+// EscrowQueuedMsg mocks base method.
+func (m *MockEpochingKeeper) EscrowQueuedMsg(ctx context.Context, msg *types0.QueuedMessage) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppHash", ctx, height)
-	ret0, _ := ret[0].([]byte)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "EscrowQueuedMsg", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAppHash indicates an expected call of GetAppHash.
-func (mr *MockEpochingKeeperMockRecorder) GetAppHash(ctx, height interface{}) *gomock.Call {
+// EscrowQueuedMsg indicates an expected call of EscrowQueuedMsg.
+func (mr *MockEpochingKeeperMockRecorder) EscrowQueuedMsg(ctx, msg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppHash", reflect.TypeOf((*MockEpochingKeeper)(nil).GetAppHash), ctx, height)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EscrowQueuedMsg", reflect.TypeOf((*MockEpochingKeeper)(nil).EscrowQueuedMsg), ctx, msg)
 }
 
 // GetEpoch mocks base method.
@@ -94,6 +97,21 @@ func (mr *MockEpochingKeeperMockRecorder) GetEpoch(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpoch", reflect.TypeOf((*MockEpochingKeeper)(nil).GetEpoch), ctx)
 }
 
+// GetHistoricalEpoch mocks base method.
+func (m *MockEpochingKeeper) GetHistoricalEpoch(ctx context.Context, epochNumber uint64) (*types0.Epoch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoricalEpoch", ctx, epochNumber)
+	ret0, _ := ret[0].(*types0.Epoch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoricalEpoch indicates an expected call of GetHistoricalEpoch.
+func (mr *MockEpochingKeeperMockRecorder) GetHistoricalEpoch(ctx, epochNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoricalEpoch", reflect.TypeOf((*MockEpochingKeeper)(nil).GetHistoricalEpoch), ctx, epochNumber)
+}
+
 // GetPubKeyByConsAddr mocks base method.
 func (m *MockEpochingKeeper) GetPubKeyByConsAddr(ctx context.Context, consAddr types1.ConsAddress) (crypto.PublicKey, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +141,21 @@ func (mr *MockEpochingKeeperMockRecorder) GetTotalVotingPower(ctx, epochNumber i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalVotingPower", reflect.TypeOf((*MockEpochingKeeper)(nil).GetTotalVotingPower), ctx, epochNumber)
 }
 
+// GetValidator mocks base method.
+func (m *MockEpochingKeeper) GetValidator(ctx context.Context, valAddr types1.ValAddress) (types2.Validator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidator", ctx, valAddr)
+	ret0, _ := ret[0].(types2.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidator indicates an expected call of GetValidator.
+func (mr *MockEpochingKeeperMockRecorder) GetValidator(ctx, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidator", reflect.TypeOf((*MockEpochingKeeper)(nil).GetValidator), ctx, valAddr)
+}
+
 // GetValidatorSet mocks base method.
 func (m *MockEpochingKeeper) GetValidatorSet(ctx context.Context, epochNumer uint64) types0.ValidatorSet {
 	m.ctrl.T.Helper()
@@ -137,6 +170,114 @@ func (mr *MockEpochingKeeperMockRecorder) GetValidatorSet(ctx, epochNumer interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorSet", reflect.TypeOf((*MockEpochingKeeper)(nil).GetValidatorSet), ctx, epochNumer)
 }
 
+// MockSlashingKeeper is a mock of SlashingKeeper interface.
+type MockSlashingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlashingKeeperMockRecorder
+}
+
+// MockSlashingKeeperMockRecorder is the mock recorder for MockSlashingKeeper.
+type MockSlashingKeeperMockRecorder struct {
+	mock *MockSlashingKeeper
+}
+
+// NewMockSlashingKeeper creates a new mock instance.
+func NewMockSlashingKeeper(ctrl *gomock.Controller) *MockSlashingKeeper {
+	mock := &MockSlashingKeeper{ctrl: ctrl}
+	mock.recorder = &MockSlashingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlashingKeeper) EXPECT() *MockSlashingKeeperMockRecorder {
+	return m.recorder
+}
+
+// IsTombstoned mocks base method.
+func (m *MockSlashingKeeper) IsTombstoned(ctx context.Context, consAddr types1.ConsAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTombstoned", ctx, consAddr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsTombstoned indicates an expected call of IsTombstoned.
+func (mr *MockSlashingKeeperMockRecorder) IsTombstoned(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTombstoned", reflect.TypeOf((*MockSlashingKeeper)(nil).IsTombstoned), ctx, consAddr)
+}
+
+// Jail mocks base method.
+func (m *MockSlashingKeeper) Jail(ctx context.Context, consAddr types1.ConsAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Jail", ctx, consAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Jail indicates an expected call of Jail.
+func (mr *MockSlashingKeeperMockRecorder) Jail(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockSlashingKeeper)(nil).Jail), ctx, consAddr)
+}
+
+// JailUntil mocks base method.
+func (m *MockSlashingKeeper) JailUntil(ctx context.Context, consAddr types1.ConsAddress, jailTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JailUntil", ctx, consAddr, jailTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JailUntil indicates an expected call of JailUntil.
+func (mr *MockSlashingKeeperMockRecorder) JailUntil(ctx, consAddr, jailTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailUntil", reflect.TypeOf((*MockSlashingKeeper)(nil).JailUntil), ctx, consAddr, jailTime)
+}
+
+// Slash mocks base method.
+func (m *MockSlashingKeeper) Slash(ctx context.Context, consAddr types1.ConsAddress, fraction math.LegacyDec, power, distributionHeight int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Slash", ctx, consAddr, fraction, power, distributionHeight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Slash indicates an expected call of Slash.
+func (mr *MockSlashingKeeperMockRecorder) Slash(ctx, consAddr, fraction, power, distributionHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockSlashingKeeper)(nil).Slash), ctx, consAddr, fraction, power, distributionHeight)
+}
+
+// SlashFractionDoubleSign mocks base method.
+func (m *MockSlashingKeeper) SlashFractionDoubleSign(ctx context.Context) (math.LegacyDec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashFractionDoubleSign", ctx)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashFractionDoubleSign indicates an expected call of SlashFractionDoubleSign.
+func (mr *MockSlashingKeeperMockRecorder) SlashFractionDoubleSign(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFractionDoubleSign", reflect.TypeOf((*MockSlashingKeeper)(nil).SlashFractionDoubleSign), ctx)
+}
+
+// Tombstone mocks base method.
+func (m *MockSlashingKeeper) Tombstone(ctx context.Context, consAddr types1.ConsAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tombstone", ctx, consAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Tombstone indicates an expected call of Tombstone.
+func (mr *MockSlashingKeeperMockRecorder) Tombstone(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tombstone", reflect.TypeOf((*MockSlashingKeeper)(nil).Tombstone), ctx, consAddr)
+}
+
 // MockCheckpointingHooks is a mock of CheckpointingHooks interface.
 type MockCheckpointingHooks struct {
 	ctrl     *gomock.Controller
@@ -229,3 +370,17 @@ func (mr *MockCheckpointingHooksMockRecorder) AfterRawCheckpointForgotten(ctx, c
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterRawCheckpointForgotten", reflect.TypeOf((*MockCheckpointingHooks)(nil).AfterRawCheckpointForgotten), ctx, ckpt)
 }
+
+// AfterRawCheckpointSealed mocks base method.
+func (m *MockCheckpointingHooks) AfterRawCheckpointSealed(ctx context.Context, epoch uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterRawCheckpointSealed", ctx, epoch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterRawCheckpointSealed indicates an expected call of AfterRawCheckpointSealed.
+func (mr *MockCheckpointingHooksMockRecorder) AfterRawCheckpointSealed(ctx, epoch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterRawCheckpointSealed", reflect.TypeOf((*MockCheckpointingHooks)(nil).AfterRawCheckpointSealed), ctx, epoch)
+}
@@ -0,0 +1,18 @@
+package bindings
+
+// BabylonMsg is the set of custom messages that CosmWasm contracts can submit
+// to Babylon. The contract address is used as the signer of each message.
+type BabylonMsg struct {
+	InsertHeaders  *InsertHeaders  `json:"insert_headers,omitempty"`
+	WithdrawReward *WithdrawReward `json:"withdraw_reward,omitempty"`
+}
+
+type InsertHeaders struct {
+	// Headers are hex encoded BTC headers
+	Headers []string `json:"headers"`
+}
+
+type WithdrawReward struct {
+	// Type is one of {submitter, reporter, finality_provider, btc_delegation}
+	Type string `json:"type"`
+}
@@ -1,12 +1,17 @@
 package bindings
 
 type BabylonQuery struct {
-	Epoch                    *struct{}          `json:"epoch,omitempty"`
-	LatestFinalizedEpochInfo *struct{}          `json:"latest_finalized_epoch_info,omitempty"`
-	BtcTip                   *struct{}          `json:"btc_tip,omitempty"`
-	BtcBaseHeader            *struct{}          `json:"btc_base_header,omitempty"`
-	BtcHeaderByHash          *BtcHeaderByHash   `json:"btc_header_by_hash,omitempty"`
-	BtcHeaderByHeight        *BtcHeaderByHeight `json:"btc_header_by_height,omitempty"`
+	Epoch                         *struct{}                      `json:"epoch,omitempty"`
+	LatestFinalizedEpochInfo      *struct{}                      `json:"latest_finalized_epoch_info,omitempty"`
+	BtcTip                        *struct{}                      `json:"btc_tip,omitempty"`
+	BtcBaseHeader                 *struct{}                      `json:"btc_base_header,omitempty"`
+	BtcHeaderByHash               *BtcHeaderByHash               `json:"btc_header_by_hash,omitempty"`
+	BtcHeaderByHeight             *BtcHeaderByHeight             `json:"btc_header_by_height,omitempty"`
+	FinalityProvider              *FinalityProvider              `json:"finality_provider,omitempty"`
+	BtcDelegation                 *BtcDelegation                 `json:"btc_delegation,omitempty"`
+	FinalityProviderPowerAtHeight *FinalityProviderPowerAtHeight `json:"finality_provider_power_at_height,omitempty"`
+	Block                         *Block                         `json:"block,omitempty"`
+	CheckpointStatus              *CheckpointStatus              `json:"checkpoint_status,omitempty"`
 }
 
 type BtcHeaderByHash struct {
@@ -17,6 +22,27 @@ type BtcHeaderByHeight struct {
 	Height uint64 `json:"height"`
 }
 
+type FinalityProvider struct {
+	BtcPkHex string `json:"btc_pk_hex"`
+}
+
+type BtcDelegation struct {
+	StakingTxHashHex string `json:"staking_tx_hash_hex"`
+}
+
+type FinalityProviderPowerAtHeight struct {
+	BtcPkHex string `json:"btc_pk_hex"`
+	Height   uint64 `json:"height"`
+}
+
+type Block struct {
+	Height uint64 `json:"height"`
+}
+
+type CheckpointStatus struct {
+	EpochNum uint64 `json:"epoch_num"`
+}
+
 type CurrentEpochResponse struct {
 	Epoch uint64 `json:"epoch"`
 }
@@ -55,3 +81,50 @@ type BtcBaseHeaderResponse struct {
 type BtcHeaderQueryResponse struct {
 	HeaderInfo *BtcBlockHeaderInfo `json:"header_info,omitempty"`
 }
+
+type FinalityProviderInfo struct {
+	Addr                 string `json:"addr"`
+	BtcPkHex             string `json:"btc_pk_hex"`
+	Commission           string `json:"commission,omitempty"`
+	SlashedBabylonHeight uint64 `json:"slashed_babylon_height"`
+	SlashedBtcHeight     uint64 `json:"slashed_btc_height"`
+}
+
+type FinalityProviderResponse struct {
+	FinalityProvider *FinalityProviderInfo `json:"finality_provider,omitempty"`
+}
+
+type BtcDelegationInfo struct {
+	StakerAddr    string   `json:"staker_addr"`
+	BtcPkHex      string   `json:"btc_pk_hex"`
+	FpBtcPkList   []string `json:"fp_btc_pk_list"`
+	StartHeight   uint64   `json:"start_height"`
+	EndHeight     uint64   `json:"end_height"`
+	TotalSat      uint64   `json:"total_sat"`
+	StakingTxHex  string   `json:"staking_tx_hex"`
+	UnbondingTime uint32   `json:"unbonding_time"`
+	Status        string   `json:"status"`
+}
+
+type BtcDelegationResponse struct {
+	BtcDelegation *BtcDelegationInfo `json:"btc_delegation,omitempty"`
+}
+
+type FinalityProviderPowerAtHeightResponse struct {
+	VotingPower uint64 `json:"voting_power"`
+}
+
+type IndexedBlockInfo struct {
+	Height    uint64 `json:"height"`
+	AppHash   string `json:"app_hash"`
+	Finalized bool   `json:"finalized"`
+}
+
+type BlockResponse struct {
+	Block *IndexedBlockInfo `json:"block,omitempty"`
+}
+
+type CheckpointStatusResponse struct {
+	// Status is empty if the checkpoint of the given epoch does not exist
+	Status string `json:"status,omitempty"`
+}
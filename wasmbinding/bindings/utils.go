@@ -1,7 +1,11 @@
 package bindings
 
 import (
+	"encoding/hex"
+
 	lcTypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	bsTypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	ftypes "github.com/babylonchain/babylon/x/finality/types"
 )
 
 // AsBtcBlockHeaderInfo translates BTCHeaderInfo to BtcBlockHeaderInfo
@@ -25,3 +29,57 @@ func AsBtcBlockHeaderInfo(info *lcTypes.BTCHeaderInfo) *BtcBlockHeaderInfo {
 		Height: info.Height,
 	}
 }
+
+// AsFinalityProviderInfo translates FinalityProvider to FinalityProviderInfo
+func AsFinalityProviderInfo(fp *bsTypes.FinalityProvider) *FinalityProviderInfo {
+	if fp == nil {
+		return nil
+	}
+
+	info := &FinalityProviderInfo{
+		Addr:                 fp.Addr,
+		BtcPkHex:             fp.BtcPk.MarshalHex(),
+		SlashedBabylonHeight: fp.SlashedBabylonHeight,
+		SlashedBtcHeight:     fp.SlashedBtcHeight,
+	}
+	if fp.Commission != nil {
+		info.Commission = fp.Commission.String()
+	}
+	return info
+}
+
+// AsBtcDelegationInfo translates BTCDelegationResponse to BtcDelegationInfo
+func AsBtcDelegationInfo(del *bsTypes.BTCDelegationResponse) *BtcDelegationInfo {
+	if del == nil {
+		return nil
+	}
+
+	fpBtcPkList := make([]string, 0, len(del.FpBtcPkList))
+	for i := range del.FpBtcPkList {
+		fpBtcPkList = append(fpBtcPkList, del.FpBtcPkList[i].MarshalHex())
+	}
+	return &BtcDelegationInfo{
+		StakerAddr:    del.StakerAddr,
+		BtcPkHex:      del.BtcPk.MarshalHex(),
+		FpBtcPkList:   fpBtcPkList,
+		StartHeight:   del.StartHeight,
+		EndHeight:     del.EndHeight,
+		TotalSat:      del.TotalSat,
+		StakingTxHex:  del.StakingTxHex,
+		UnbondingTime: del.UnbondingTime,
+		Status:        del.StatusDesc,
+	}
+}
+
+// AsIndexedBlockInfo translates IndexedBlock to IndexedBlockInfo
+func AsIndexedBlockInfo(block *ftypes.IndexedBlock) *IndexedBlockInfo {
+	if block == nil {
+		return nil
+	}
+
+	return &IndexedBlockInfo{
+		Height:    block.Height,
+		AppHash:   hex.EncodeToString(block.AppHash),
+		Finalized: block.Finalized,
+	}
+}
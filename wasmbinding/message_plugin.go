@@ -0,0 +1,200 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/wasmbinding/bindings"
+	lcTypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	epochingkeeper "github.com/babylonchain/babylon/x/epoching/keeper"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+	itypes "github.com/babylonchain/babylon/x/incentive/types"
+	zckeeper "github.com/babylonchain/babylon/x/zoneconcierge/keeper"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CustomMessageDecorator returns a decorator that wraps the default messenger
+// of the wasm keeper with a CustomMessenger
+func CustomMessageDecorator(
+	router wasmkeeper.MessageRouter,
+	unpacker codectypes.AnyUnpacker,
+	zcKeeper *zckeeper.Keeper,
+) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:   old,
+			router:    router,
+			anyEncode: wasmkeeper.EncodeAnyMsg(unpacker),
+			zcKeeper:  zcKeeper,
+		}
+	}
+}
+
+// CustomMessenger dispatches Babylon custom messages submitted by CosmWasm
+// contracts, and delegates all other messages to the wrapped messenger.
+// Staking messages are wrapped into their epoching counterparts so that they
+// are queued until the end of the epoch, and `Any` messages that might change
+// the validator set are rejected.
+type CustomMessenger struct {
+	wrapped   wasmkeeper.Messenger
+	router    wasmkeeper.MessageRouter
+	anyEncode wasmkeeper.AnyEncoder
+	zcKeeper  *zckeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	switch {
+	case msg.Staking != nil:
+		return m.dispatchStakingMsg(ctx, contractAddr, msg.Staking)
+	case msg.Any != nil:
+		if err := m.checkAnyMsg(contractAddr, msg.Any); err != nil {
+			return nil, nil, nil, err
+		}
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	case msg.Custom == nil:
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	// custom messages are only allowed if enabled in ZoneConcierge parameters
+	if !m.zcKeeper.GetParams(ctx).WasmCustomMsgsEnabled {
+		return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "babylon custom messages are disabled")
+	}
+
+	var contractMsg bindings.BabylonMsg
+	if err := json.Unmarshal(msg.Custom, &contractMsg); err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "failed to unmarshal babylon message")
+	}
+
+	sdkMsg, err := toSDKMsg(contractAddr, &contractMsg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return m.handleSDKMsg(ctx, sdkMsg)
+}
+
+// dispatchStakingMsg wraps the staking message of the contract into the
+// corresponding epoching message, so that it is queued until the end of the
+// epoch rather than changing the validator set right away
+func (m *CustomMessenger) dispatchStakingMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	msg *wasmvmtypes.StakingMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	sdkMsgs, err := wasmkeeper.EncodeStakingMsg(contractAddr, msg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// wrap and validate all messages, together with their inner staking
+	// messages, before any of them is escrowed and queued
+	wrappedMsgs := make([]sdk.Msg, len(sdkMsgs))
+	for i, sdkMsg := range sdkMsgs {
+		wrappedMsg, err := epochingtypes.WrapStakingMsg(sdkMsg)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if err := wrappedMsg.(sdk.HasValidateBasic).ValidateBasic(); err != nil {
+			return nil, nil, nil, err
+		}
+		wrappedMsgs[i] = wrappedMsg
+	}
+
+	var (
+		events       []sdk.Event
+		data         [][]byte
+		msgResponses [][]*codectypes.Any
+	)
+	for _, wrappedMsg := range wrappedMsgs {
+		ev, d, r, err := m.handleSDKMsg(ctx, wrappedMsg)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		events = append(events, ev...)
+		data = append(data, d...)
+		msgResponses = append(msgResponses, r...)
+	}
+	return events, data, msgResponses, nil
+}
+
+// checkAnyMsg rejects `Any` messages of the contract that might change the
+// validator set, including those nested in authz's MsgExec
+func (m *CustomMessenger) checkAnyMsg(contractAddr sdk.AccAddress, msg *wasmvmtypes.AnyMsg) error {
+	sdkMsgs, err := m.anyEncode(contractAddr, msg)
+	if err != nil {
+		return err
+	}
+	decorator := epochingkeeper.DropValidatorMsgDecorator{}
+	for _, sdkMsg := range sdkMsgs {
+		if decorator.IsValidatorRelatedMsg(sdkMsg) {
+			return errorsmod.Wrapf(epochingtypes.ErrUnwrappedMsgType, "%s", sdk.MsgTypeURL(sdkMsg))
+		}
+	}
+	return nil
+}
+
+// handleSDKMsg validates the given SDK message, and routes it to its handler
+// and executes it. As with the SDK message handler of wasmd, the stateless
+// checks of the message are enforced, as messages of contracts do not go
+// through the ante handler
+func (m *CustomMessenger) handleSDKMsg(ctx sdk.Context, sdkMsg sdk.Msg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg, ok := sdkMsg.(sdk.HasValidateBasic); ok {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	handler := m.router.Handler(sdkMsg)
+	if handler == nil {
+		return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", sdkMsg)
+	}
+	res, err := handler(ctx, sdkMsg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	events := make([]sdk.Event, len(res.Events))
+	for i := range res.Events {
+		events[i] = sdk.Event(res.Events[i])
+	}
+	return events, [][]byte{res.Data}, [][]*codectypes.Any{res.MsgResponses}, nil
+}
+
+// toSDKMsg converts a Babylon custom message to the corresponding SDK message
+// signed by the contract
+func toSDKMsg(contractAddr sdk.AccAddress, contractMsg *bindings.BabylonMsg) (sdk.Msg, error) {
+	switch {
+	case contractMsg.InsertHeaders != nil:
+		headers := make([]bbn.BTCHeaderBytes, 0, len(contractMsg.InsertHeaders.Headers))
+		for _, headerHex := range contractMsg.InsertHeaders.Headers {
+			header, err := bbn.NewBTCHeaderBytesFromHex(headerHex)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed to parse BTC header")
+			}
+			headers = append(headers, header)
+		}
+		return &lcTypes.MsgInsertHeaders{
+			Signer:  contractAddr.String(),
+			Headers: headers,
+		}, nil
+	case contractMsg.WithdrawReward != nil:
+		return &itypes.MsgWithdrawReward{
+			Type:    contractMsg.WithdrawReward.Type,
+			Address: contractAddr.String(),
+		}, nil
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown babylon message variant"}
+	}
+}
@@ -0,0 +1,212 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/app"
+	appparams "github.com/babylonchain/babylon/app/params"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/wasmbinding"
+	"github.com/babylonchain/babylon/wasmbinding/bindings"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+	itypes "github.com/babylonchain/babylon/x/incentive/types"
+)
+
+func TestCustomMsgDisabled(t *testing.T) {
+	acc := randomAccountAddress()
+	babylonApp, ctx := setupAppWithContext(t)
+	fundAccount(t, ctx, babylonApp, acc)
+
+	contractAddr := deployTestContract(t, ctx, babylonApp, acc, pathToPassthroughContract)
+
+	msg := bindings.BabylonMsg{
+		InsertHeaders: &bindings.InsertHeaders{},
+	}
+	err := executeCustomMsg(t, ctx, babylonApp, acc, contractAddr, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestCustomMsgInsertHeaders(t *testing.T) {
+	acc := randomAccountAddress()
+	babylonApp, ctx := setupAppWithContext(t)
+	fundAccount(t, ctx, babylonApp, acc)
+
+	contractAddr := deployTestContract(t, ctx, babylonApp, acc, pathToPassthroughContract)
+	enableCustomMsgs(t, ctx, babylonApp)
+
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	tip := babylonApp.BTCLightClientKeeper.GetTipInfo(ctx)
+	chain := datagen.NewBTCHeaderChainFromParentInfo(r, tip, uint32(datagen.RandomInt(r, 10)+1))
+
+	headers := []string{}
+	for _, header := range chain.ChainToBytes() {
+		headers = append(headers, header.MarshalHex())
+	}
+	msg := bindings.BabylonMsg{
+		InsertHeaders: &bindings.InsertHeaders{
+			Headers: headers,
+		},
+	}
+	err := executeCustomMsg(t, ctx, babylonApp, acc, contractAddr, msg)
+	require.NoError(t, err)
+
+	// the tip is updated to the last inserted header
+	newTip := babylonApp.BTCLightClientKeeper.GetTipInfo(ctx)
+	require.True(t, newTip.Eq(chain.GetTipInfo()))
+}
+
+func TestCustomMsgWithdrawReward(t *testing.T) {
+	acc := randomAccountAddress()
+	babylonApp, ctx := setupAppWithContext(t)
+	fundAccount(t, ctx, babylonApp, acc)
+
+	contractAddr := deployTestContract(t, ctx, babylonApp, acc, pathToPassthroughContract)
+	enableCustomMsgs(t, ctx, babylonApp)
+
+	// the contract has no reward to withdraw
+	msg := bindings.BabylonMsg{
+		WithdrawReward: &bindings.WithdrawReward{
+			Type: itypes.SubmitterType.String(),
+		},
+	}
+	err := executeCustomMsg(t, ctx, babylonApp, acc, contractAddr, msg)
+	require.ErrorIs(t, err, itypes.ErrRewardGaugeNotFound)
+}
+
+func TestCustomMsgUnknownVariant(t *testing.T) {
+	acc := randomAccountAddress()
+	babylonApp, ctx := setupAppWithContext(t)
+	fundAccount(t, ctx, babylonApp, acc)
+
+	contractAddr := deployTestContract(t, ctx, babylonApp, acc, pathToPassthroughContract)
+	enableCustomMsgs(t, ctx, babylonApp)
+
+	err := executeCustomMsg(t, ctx, babylonApp, acc, contractAddr, bindings.BabylonMsg{})
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+}
+
+func TestNonCustomMsgIsDelegated(t *testing.T) {
+	babylonApp, ctx := setupAppWithContext(t)
+	contractAddr := randomAccountAddress()
+
+	delegated := false
+	wrapped := wasmkeeper.MessageHandlerFunc(func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+		delegated = true
+		return nil, nil, nil, nil
+	})
+	messenger := wasmbinding.CustomMessageDecorator(babylonApp.MsgServiceRouter(), babylonApp.InterfaceRegistry(), &babylonApp.ZoneConciergeKeeper)(wrapped)
+
+	_, _, _, err := messenger.DispatchMsg(ctx, contractAddr, "", wasmvmtypes.CosmosMsg{
+		Bank: &wasmvmtypes.BankMsg{},
+	})
+	require.NoError(t, err)
+	require.True(t, delegated)
+}
+
+func TestStakingMsgIsQueued(t *testing.T) {
+	babylonApp, ctx := setupAppWithContext(t)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+	// the contract needs funds as the delegated amount is escrowed
+	addrs, err := app.AddTestAddrs(babylonApp, ctx, 1, sdkmath.NewInt(100000))
+	require.NoError(t, err)
+	contractAddr := addrs[0]
+
+	vals, err := babylonApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, vals)
+	messenger := wasmbinding.CustomMessageDecorator(babylonApp.MsgServiceRouter(), babylonApp.InterfaceRegistry(), &babylonApp.ZoneConciergeKeeper)(nil)
+
+	// the staking message of the contract is queued until the end of the epoch
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", wasmvmtypes.CosmosMsg{
+		Staking: &wasmvmtypes.StakingMsg{
+			Delegate: &wasmvmtypes.DelegateMsg{
+				Validator: vals[0].OperatorAddress,
+				Amount:    wasmvmtypes.NewCoin(1000, appparams.DefaultBondDenom),
+			},
+		},
+	})
+	require.NoError(t, err)
+	epochMsgs := babylonApp.EpochingKeeper.GetCurrentEpochMsgs(ctx)
+	require.Len(t, epochMsgs, 1)
+	require.Equal(t, contractAddr.String(), epochMsgs[0].GetMsgDelegate().DelegatorAddress)
+	balance := babylonApp.BankKeeper.GetBalance(ctx, contractAddr, appparams.DefaultBondDenom)
+	require.True(t, balance.Amount.Equal(sdkmath.NewInt(99000)))
+
+	// invalid staking messages are rejected before being escrowed and queued
+	for _, delegateMsg := range []*wasmvmtypes.DelegateMsg{
+		{Validator: vals[0].OperatorAddress, Amount: wasmvmtypes.NewCoin(0, appparams.DefaultBondDenom)},
+		{Validator: "invalid", Amount: wasmvmtypes.NewCoin(1000, appparams.DefaultBondDenom)},
+	} {
+		_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", wasmvmtypes.CosmosMsg{
+			Staking: &wasmvmtypes.StakingMsg{Delegate: delegateMsg},
+		})
+		require.Error(t, err)
+	}
+	require.Len(t, babylonApp.EpochingKeeper.GetCurrentEpochMsgs(ctx), 1)
+	balance = babylonApp.BankKeeper.GetBalance(ctx, contractAddr, appparams.DefaultBondDenom)
+	require.True(t, balance.Amount.Equal(sdkmath.NewInt(99000)))
+
+	// staking messages encoded as `Any`, including those nested in authz's
+	// MsgExec, are rejected
+	delegateMsg := stakingtypes.NewMsgDelegate(contractAddr.String(), vals[0].OperatorAddress, sdk.NewInt64Coin(appparams.DefaultBondDenom, 1000))
+	execMsg := authz.NewMsgExec(contractAddr, []sdk.Msg{delegateMsg})
+	for _, msg := range []sdk.Msg{delegateMsg, &execMsg} {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", wasmvmtypes.CosmosMsg{
+			Any: &wasmvmtypes.AnyMsg{TypeURL: msgAny.TypeUrl, Value: msgAny.Value},
+		})
+		require.ErrorIs(t, err, epochingtypes.ErrUnwrappedMsgType)
+	}
+	require.Len(t, babylonApp.EpochingKeeper.GetCurrentEpochMsgs(ctx), 1)
+}
+
+func enableCustomMsgs(t *testing.T, ctx sdk.Context, bbn *app.BabylonApp) {
+	params := bbn.ZoneConciergeKeeper.GetParams(ctx)
+	params.WasmCustomMsgsEnabled = true
+	err := bbn.ZoneConciergeKeeper.SetParams(ctx, params)
+	require.NoError(t, err)
+}
+
+// executeCustomMsg makes the passthrough contract send the given message
+func executeCustomMsg(
+	t *testing.T,
+	ctx sdk.Context,
+	bbn *app.BabylonApp,
+	caller sdk.AccAddress,
+	contractAddr sdk.AccAddress,
+	msg bindings.BabylonMsg,
+) error {
+	msgBz, err := json.Marshal(msg)
+	require.NoError(t, err)
+
+	// the passthrough contract returns its execute message as its result
+	result := wasmvmtypes.ContractResult{
+		Ok: &wasmvmtypes.Response{
+			Messages: []wasmvmtypes.SubMsg{{
+				Msg:     wasmvmtypes.CosmosMsg{Custom: msgBz},
+				ReplyOn: wasmvmtypes.ReplyNever,
+			}},
+		},
+	}
+	executeMsgBz, err := json.Marshal(result)
+	require.NoError(t, err)
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(bbn.WasmKeeper)
+	_, err = contractKeeper.Execute(ctx, contractAddr, caller, executeMsgBz, nil)
+	return err
+}
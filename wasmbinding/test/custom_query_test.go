@@ -22,6 +22,7 @@ import (
 	"github.com/babylonchain/babylon/app"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/babylonchain/babylon/wasmbinding/bindings"
+	ftypes "github.com/babylonchain/babylon/x/finality/types"
 )
 
 // TODO consider doing it by enviromental variables as currently it may fail on some
@@ -38,6 +39,10 @@ func getArtifactPath() string {
 
 var pathToContract = getArtifactPath()
 
+// pathToPassthroughContract is the contract that forwards any query or
+// message to the chain, for the bindings not exposed by the example contract
+const pathToPassthroughContract = "../testdata/passthrough/passthrough.wasm"
+
 func TestQueryEpoch(t *testing.T) {
 	acc := randomAccountAddress()
 	babylonApp, ctx := setupAppWithContext(t)
@@ -197,6 +202,153 @@ func TestQueryNonExistingHeader(t *testing.T) {
 	require.Nil(t, resp1.HeaderInfo)
 }
 
+func TestQueryFinalityProvider(t *testing.T) {
+	acc := randomAccountAddress()
+	babylonApp, ctx := setupAppWithContext(t)
+	fundAccount(t, ctx, babylonApp, acc)
+
+	contractAddress := deployTestContract(t, ctx, babylonApp, acc, pathToPassthroughContract)
+
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	fp, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+
+	query := bindings.BabylonQuery{
+		FinalityProvider: &bindings.FinalityProvider{
+			BtcPkHex: fp.BtcPk.MarshalHex(),
+		},
+	}
+
+	// non-existing finality provider
+	resp := bindings.FinalityProviderResponse{}
+	queryPassthrough(t, ctx, babylonApp, contractAddress, query, &resp)
+	require.Nil(t, resp.FinalityProvider)
+
+	babylonApp.BTCStakingKeeper.SetFinalityProvider(ctx, fp)
+
+	resp = bindings.FinalityProviderResponse{}
+	queryPassthrough(t, ctx, babylonApp, contractAddress, query, &resp)
+	require.Equal(t, bindings.AsFinalityProviderInfo(fp), resp.FinalityProvider)
+}
+
+func TestQueryNonExistingBtcDelegation(t *testing.T) {
+	acc := randomAccountAddress()
+	babylonApp, ctx := setupAppWithContext(t)
+	fundAccount(t, ctx, babylonApp, acc)
+
+	contractAddress := deployTestContract(t, ctx, babylonApp, acc, pathToPassthroughContract)
+
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	query := bindings.BabylonQuery{
+		BtcDelegation: &bindings.BtcDelegation{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		},
+	}
+
+	resp := bindings.BtcDelegationResponse{}
+	queryPassthrough(t, ctx, babylonApp, contractAddress, query, &resp)
+	require.Nil(t, resp.BtcDelegation)
+}
+
+func TestQueryFinalityProviderPowerAtHeight(t *testing.T) {
+	acc := randomAccountAddress()
+	babylonApp, ctx := setupAppWithContext(t)
+	fundAccount(t, ctx, babylonApp, acc)
+
+	contractAddress := deployTestContract(t, ctx, babylonApp, acc, pathToPassthroughContract)
+
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	fp, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+	height := datagen.RandomInt(r, 100) + 1
+	power := datagen.RandomInt(r, 100000) + 1
+	babylonApp.BTCStakingKeeper.SetFinalityProvider(ctx, fp)
+	babylonApp.BTCStakingKeeper.SetVotingPower(ctx, fp.BtcPk.MustMarshal(), height, power)
+
+	query := bindings.BabylonQuery{
+		FinalityProviderPowerAtHeight: &bindings.FinalityProviderPowerAtHeight{
+			BtcPkHex: fp.BtcPk.MarshalHex(),
+			Height:   height,
+		},
+	}
+	resp := bindings.FinalityProviderPowerAtHeightResponse{}
+	queryPassthrough(t, ctx, babylonApp, contractAddress, query, &resp)
+	require.Equal(t, power, resp.VotingPower)
+
+	// no voting power at other heights
+	query.FinalityProviderPowerAtHeight.Height = height + 1
+	resp = bindings.FinalityProviderPowerAtHeightResponse{}
+	queryPassthrough(t, ctx, babylonApp, contractAddress, query, &resp)
+	require.Zero(t, resp.VotingPower)
+}
+
+func TestQueryBlock(t *testing.T) {
+	acc := randomAccountAddress()
+	babylonApp, ctx := setupAppWithContext(t)
+	fundAccount(t, ctx, babylonApp, acc)
+
+	contractAddress := deployTestContract(t, ctx, babylonApp, acc, pathToPassthroughContract)
+
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	block := &ftypes.IndexedBlock{
+		Height:    datagen.RandomInt(r, 100) + 1,
+		AppHash:   datagen.GenRandomByteArray(r, 32),
+		Finalized: false,
+	}
+
+	query := bindings.BabylonQuery{
+		Block: &bindings.Block{
+			Height: block.Height,
+		},
+	}
+
+	// non-existing block
+	resp := bindings.BlockResponse{}
+	queryPassthrough(t, ctx, babylonApp, contractAddress, query, &resp)
+	require.Nil(t, resp.Block)
+
+	babylonApp.FinalityKeeper.SetBlock(ctx, block)
+	resp = bindings.BlockResponse{}
+	queryPassthrough(t, ctx, babylonApp, contractAddress, query, &resp)
+	require.Equal(t, bindings.AsIndexedBlockInfo(block), resp.Block)
+	require.False(t, resp.Block.Finalized)
+
+	// finalise the block
+	block.Finalized = true
+	babylonApp.FinalityKeeper.SetBlock(ctx, block)
+	resp = bindings.BlockResponse{}
+	queryPassthrough(t, ctx, babylonApp, contractAddress, query, &resp)
+	require.True(t, resp.Block.Finalized)
+}
+
+func TestQueryCheckpointStatus(t *testing.T) {
+	acc := randomAccountAddress()
+	babylonApp, ctx := setupAppWithContext(t)
+	fundAccount(t, ctx, babylonApp, acc)
+
+	contractAddress := deployTestContract(t, ctx, babylonApp, acc, pathToPassthroughContract)
+
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ckptWithMeta := datagen.GenRandomRawCheckpointWithMeta(r)
+
+	query := bindings.BabylonQuery{
+		CheckpointStatus: &bindings.CheckpointStatus{
+			EpochNum: ckptWithMeta.Ckpt.EpochNum,
+		},
+	}
+
+	// non-existing checkpoint
+	resp := bindings.CheckpointStatusResponse{}
+	queryPassthrough(t, ctx, babylonApp, contractAddress, query, &resp)
+	require.Empty(t, resp.Status)
+
+	err := babylonApp.CheckpointingKeeper.AddRawCheckpoint(ctx, ckptWithMeta)
+	require.NoError(t, err)
+	resp = bindings.CheckpointStatusResponse{}
+	queryPassthrough(t, ctx, babylonApp, contractAddress, query, &resp)
+	require.Equal(t, ckptWithMeta.Status.String(), resp.Status)
+}
+
 func setupAppWithContext(t *testing.T) (*app.BabylonApp, sdk.Context) {
 	return setupAppWithContextAndCustomHeight(t, 1)
 }
@@ -329,6 +481,27 @@ func queryCustom(
 	require.NoError(t, err)
 }
 
+// queryPassthrough queries the chain through the passthrough contract
+func queryPassthrough(
+	t *testing.T,
+	ctx sdk.Context,
+	bbn *app.BabylonApp,
+	contract sdk.AccAddress,
+	request bindings.BabylonQuery,
+	response interface{},
+) {
+	msgBz, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	queryBz, err := json.Marshal(wasmvmtypes.QueryRequest{Custom: msgBz})
+	require.NoError(t, err)
+
+	resBz, err := bbn.WasmKeeper.QuerySmart(ctx, contract, queryBz)
+	require.NoError(t, err)
+	err = json.Unmarshal(resBz, response)
+	require.NoError(t, err)
+}
+
 //nolint:unused
 func queryCustomErr(
 	t *testing.T,
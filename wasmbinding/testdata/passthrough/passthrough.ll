@@ -0,0 +1,147 @@
+; Passthrough test contract
+;
+; The contract forwards whatever it is given to the chain, so that every
+; variant of the Babylon bindings can be tested through an instantiated
+; contract without a Rust toolchain that knows about the variant:
+;
+; - `query` sends the query message, which is a `QueryRequest` in JSON, to
+;   the chain and returns the response of the chain
+; - `execute` returns the execute message as its result, so the message is a
+;   `ContractResult<Response>` in JSON whose sub-messages are dispatched by the
+;   chain on behalf of the contract
+; - `instantiate` ignores its message and returns an empty response
+;
+; Memory is handed out by a bump allocator and never freed, which is fine for
+; the short-lived contract calls of tests.
+
+target datalayout = "e-m:e-p:32:32-i64:64-n32:64-S128"
+target triple = "wasm32-unknown-unknown"
+
+; Region is the view of a byte slice shared with the VM, as in cosmwasm-std
+%Region = type { i32, i32, i32 } ; offset, capacity, length
+
+@__heap_base = external global i8
+@heap_top = internal global i32 0
+
+@empty_response = internal constant [50 x i8] c"{\22ok\22:{\22messages\22:[],\22attributes\22:[],\22events\22:[]}}"
+@empty_response_region = internal global %Region { i32 ptrtoint ([50 x i8]* @empty_response to i32), i32 50, i32 50 }
+
+@system_error = internal constant [39 x i8] c"{\22error\22:\22system error querying chain\22}"
+@system_error_region = internal global %Region { i32 ptrtoint ([39 x i8]* @system_error to i32), i32 39, i32 39 }
+
+; `{"ok":`, the prefix of successful system results
+@ok_prefix = internal constant [6 x i8] c"{\22ok\22:"
+
+declare i32 @query_chain(i32) #0
+declare i32 @llvm.wasm.memory.size.i32(i32)
+declare i32 @llvm.wasm.memory.grow.i32(i32, i32)
+
+define void @interface_version_8() #1 {
+  ret void
+}
+
+; allocate returns a region with the given capacity, growing the memory if
+; needed
+define i32 @allocate(i32 %capacity) #2 {
+entry:
+  %top = load i32, i32* @heap_top
+  %uninit = icmp eq i32 %top, 0
+  %heap_base = ptrtoint i8* @__heap_base to i32
+  %start = select i1 %uninit, i32 %heap_base, i32 %top
+  ; align regions to 8 bytes
+  %start_plus = add i32 %start, 7
+  %region_ptr = and i32 %start_plus, -8
+  %data_ptr = add i32 %region_ptr, 12
+  %end = add i32 %data_ptr, %capacity
+  store i32 %end, i32* @heap_top
+
+  %pages = call i32 @llvm.wasm.memory.size.i32(i32 0)
+  %mem_size = shl i32 %pages, 16
+  %fits = icmp ule i32 %end, %mem_size
+  br i1 %fits, label %done, label %grow
+
+grow:
+  %missing = sub i32 %end, %mem_size
+  %missing_plus = add i32 %missing, 65535
+  %missing_pages = lshr i32 %missing_plus, 16
+  %grown = call i32 @llvm.wasm.memory.grow.i32(i32 0, i32 %missing_pages)
+  %failed = icmp eq i32 %grown, -1
+  br i1 %failed, label %oom, label %done
+
+oom:
+  unreachable
+
+done:
+  %region = inttoptr i32 %region_ptr to %Region*
+  %offset_field = getelementptr %Region, %Region* %region, i32 0, i32 0
+  store i32 %data_ptr, i32* %offset_field
+  %capacity_field = getelementptr %Region, %Region* %region, i32 0, i32 1
+  store i32 %capacity, i32* %capacity_field
+  %length_field = getelementptr %Region, %Region* %region, i32 0, i32 2
+  store i32 0, i32* %length_field
+  ret i32 %region_ptr
+}
+
+define void @deallocate(i32 %region_ptr) #3 {
+  ret void
+}
+
+define i32 @instantiate(i32 %env, i32 %info, i32 %msg) #4 {
+  ret i32 ptrtoint (%Region* @empty_response_region to i32)
+}
+
+define i32 @execute(i32 %env, i32 %info, i32 %msg) #5 {
+  ret i32 %msg
+}
+
+; query unwraps the `SystemResult<ContractResult<Binary>>` returned by the
+; chain into the `ContractResult<Binary>` expected from the contract, i.e., it
+; strips the leading `{"ok":` and the trailing `}`
+define i32 @query(i32 %env, i32 %msg) #6 {
+entry:
+  %res_ptr = call i32 @query_chain(i32 %msg)
+  %res = inttoptr i32 %res_ptr to %Region*
+  %offset_field = getelementptr %Region, %Region* %res, i32 0, i32 0
+  %offset = load i32, i32* %offset_field
+  %length_field = getelementptr %Region, %Region* %res, i32 0, i32 2
+  %length = load i32, i32* %length_field
+  %long_enough = icmp ugt i32 %length, 7
+  br i1 %long_enough, label %loop, label %error
+
+loop:
+  %i = phi i32 [ 0, %entry ], [ %next, %match ]
+  %prefix_char_ptr = getelementptr [6 x i8], [6 x i8]* @ok_prefix, i32 0, i32 %i
+  %prefix_char = load i8, i8* %prefix_char_ptr
+  %res_char_addr = add i32 %offset, %i
+  %res_char_ptr = inttoptr i32 %res_char_addr to i8*
+  %res_char = load i8, i8* %res_char_ptr
+  %eq = icmp eq i8 %prefix_char, %res_char
+  br i1 %eq, label %match, label %error
+
+match:
+  %next = add i32 %i, 1
+  %prefix_done = icmp eq i32 %next, 6
+  br i1 %prefix_done, label %ok, label %loop
+
+ok:
+  %inner_offset = add i32 %offset, 6
+  store i32 %inner_offset, i32* %offset_field
+  %inner_length = sub i32 %length, 7
+  store i32 %inner_length, i32* %length_field
+  %capacity_field = getelementptr %Region, %Region* %res, i32 0, i32 1
+  %capacity = load i32, i32* %capacity_field
+  %inner_capacity = sub i32 %capacity, 6
+  store i32 %inner_capacity, i32* %capacity_field
+  ret i32 %res_ptr
+
+error:
+  ret i32 ptrtoint (%Region* @system_error_region to i32)
+}
+
+attributes #0 = { "wasm-import-module"="env" "wasm-import-name"="query_chain" }
+attributes #1 = { "wasm-export-name"="interface_version_8" }
+attributes #2 = { "wasm-export-name"="allocate" }
+attributes #3 = { "wasm-export-name"="deallocate" }
+attributes #4 = { "wasm-export-name"="instantiate" }
+attributes #5 = { "wasm-export-name"="execute" }
+attributes #6 = { "wasm-export-name"="query" }
@@ -2,6 +2,7 @@ package wasmbinding
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -10,15 +11,25 @@ import (
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/wasmbinding/bindings"
 	lcKeeper "github.com/babylonchain/babylon/x/btclightclient/keeper"
+	bsKeeper "github.com/babylonchain/babylon/x/btcstaking/keeper"
+	bsTypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	ckptKeeper "github.com/babylonchain/babylon/x/checkpointing/keeper"
+	ckptTypes "github.com/babylonchain/babylon/x/checkpointing/types"
 	epochingkeeper "github.com/babylonchain/babylon/x/epoching/keeper"
+	fKeeper "github.com/babylonchain/babylon/x/finality/keeper"
+	fTypes "github.com/babylonchain/babylon/x/finality/types"
 	zckeeper "github.com/babylonchain/babylon/x/zoneconcierge/keeper"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type QueryPlugin struct {
-	epochingKeeper *epochingkeeper.Keeper
-	zcKeeper       *zckeeper.Keeper
-	lcKeeper       *lcKeeper.Keeper
+	epochingKeeper      *epochingkeeper.Keeper
+	zcKeeper            *zckeeper.Keeper
+	lcKeeper            *lcKeeper.Keeper
+	btcStakingKeeper    *bsKeeper.Keeper
+	finalityKeeper      *fKeeper.Keeper
+	checkpointingKeeper *ckptKeeper.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
@@ -26,11 +37,17 @@ func NewQueryPlugin(
 	ek *epochingkeeper.Keeper,
 	zcKeeper *zckeeper.Keeper,
 	lcKeeper *lcKeeper.Keeper,
+	btcStakingKeeper *bsKeeper.Keeper,
+	finalityKeeper *fKeeper.Keeper,
+	checkpointingKeeper *ckptKeeper.Keeper,
 ) *QueryPlugin {
 	return &QueryPlugin{
-		epochingKeeper: ek,
-		zcKeeper:       zcKeeper,
-		lcKeeper:       lcKeeper,
+		epochingKeeper:      ek,
+		zcKeeper:            zcKeeper,
+		lcKeeper:            lcKeeper,
+		btcStakingKeeper:    btcStakingKeeper,
+		finalityKeeper:      finalityKeeper,
+		checkpointingKeeper: checkpointingKeeper,
 	}
 }
 
@@ -144,6 +161,105 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 				return nil, errorsmod.Wrap(err, "failed marshaling")
 			}
 
+			return bz, nil
+		case contractQuery.FinalityProvider != nil:
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(contractQuery.FinalityProvider.BtcPkHex)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed to parse finality provider BTC PK")
+			}
+
+			fp, err := qp.btcStakingKeeper.GetFinalityProvider(ctx, fpBTCPK.MustMarshal())
+			if err != nil && !errors.Is(err, bsTypes.ErrFpNotFound) {
+				return nil, err
+			}
+
+			res := bindings.FinalityProviderResponse{
+				FinalityProvider: bindings.AsFinalityProviderInfo(fp),
+			}
+			bz, err := json.Marshal(res)
+
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed marshaling")
+			}
+
+			return bz, nil
+		case contractQuery.BtcDelegation != nil:
+			req := &bsTypes.QueryBTCDelegationRequest{
+				StakingTxHashHex: contractQuery.BtcDelegation.StakingTxHashHex,
+			}
+			delResp, err := qp.btcStakingKeeper.BTCDelegation(ctx, req)
+			if err != nil && !errors.Is(err, bsTypes.ErrBTCDelegationNotFound) {
+				return nil, err
+			}
+
+			res := bindings.BtcDelegationResponse{}
+			if delResp != nil {
+				res.BtcDelegation = bindings.AsBtcDelegationInfo(delResp.BtcDelegation)
+			}
+			bz, err := json.Marshal(res)
+
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed marshaling")
+			}
+
+			return bz, nil
+		case contractQuery.FinalityProviderPowerAtHeight != nil:
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(contractQuery.FinalityProviderPowerAtHeight.BtcPkHex)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed to parse finality provider BTC PK")
+			}
+
+			height := contractQuery.FinalityProviderPowerAtHeight.Height
+			if qp.btcStakingKeeper.IsVotingPowerPruned(ctx, height) {
+				return nil, bsTypes.ErrVotingPowerTablePruned.Wrapf("height: %d", height)
+			}
+
+			res := bindings.FinalityProviderPowerAtHeightResponse{
+				VotingPower: qp.btcStakingKeeper.GetVotingPower(ctx, fpBTCPK.MustMarshal(), height),
+			}
+			bz, err := json.Marshal(res)
+
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed marshaling")
+			}
+
+			return bz, nil
+		case contractQuery.Block != nil:
+			height := contractQuery.Block.Height
+			if qp.finalityKeeper.IsHeightPruned(ctx, height) {
+				return nil, fTypes.ErrHeightPruned.Wrapf("height: %d", height)
+			}
+
+			block, err := qp.finalityKeeper.GetBlock(ctx, height)
+			if err != nil && !errors.Is(err, fTypes.ErrBlockNotFound) {
+				return nil, err
+			}
+
+			res := bindings.BlockResponse{
+				Block: bindings.AsIndexedBlockInfo(block),
+			}
+			bz, err := json.Marshal(res)
+
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed marshaling")
+			}
+
+			return bz, nil
+		case contractQuery.CheckpointStatus != nil:
+			res := bindings.CheckpointStatusResponse{}
+
+			status, err := qp.checkpointingKeeper.GetStatus(ctx, contractQuery.CheckpointStatus.EpochNum)
+			if err == nil {
+				res.Status = status.String()
+			} else if !errors.Is(err, ckptTypes.ErrCkptDoesNotExist) {
+				return nil, err
+			}
+			bz, err := json.Marshal(res)
+
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed marshaling")
+			}
+
 			return bz, nil
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown babylon query variant"}
@@ -152,17 +268,27 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 }
 
 func RegisterCustomPlugins(
+	router wasmkeeper.MessageRouter,
+	unpacker codectypes.AnyUnpacker,
 	ek *epochingkeeper.Keeper,
 	zcKeeper *zckeeper.Keeper,
 	lcKeeper *lcKeeper.Keeper,
+	btcStakingKeeper *bsKeeper.Keeper,
+	finalityKeeper *fKeeper.Keeper,
+	checkpointingKeeper *ckptKeeper.Keeper,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(ek, zcKeeper, lcKeeper)
+	wasmQueryPlugin := NewQueryPlugin(ek, zcKeeper, lcKeeper, btcStakingKeeper, finalityKeeper, checkpointingKeeper)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(router, unpacker, zcKeeper),
+	)
+
 	return []wasmkeeper.Option{
 		queryPluginOpt,
+		messengerDecoratorOpt,
 	}
 }
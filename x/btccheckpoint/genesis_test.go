@@ -31,6 +31,8 @@ func TestInitGenesis(t *testing.T) {
 			BtcConfirmationDepth:          888,
 			CheckpointFinalizationTimeout: 999,
 			CheckpointTag:                 types.DefaultCheckpointTag,
+			FeeReimbursementRate:          types.DefaultParams().FeeReimbursementRate,
+			MaxFeeReimbursement:           types.DefaultParams().MaxFeeReimbursement,
 		},
 	}
 
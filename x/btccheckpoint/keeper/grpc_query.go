@@ -35,6 +35,8 @@ func (k Keeper) getCheckpointInfo(ctx context.Context, epochNum uint64, epochDat
 		BestSubmissionBtcBlockHash:         &bestSubmission.YoungestBlockHash,
 		BestSubmissionTransactions:         bestSubmissionData.TxsInfo,
 		BestSubmissionVigilanteAddressList: []*types.CheckpointAddresses{bestSubmissionData.VigilanteAddresses},
+		BestSubmissionBtcFee:               bestSubmissionData.GetBTCFee(),
+		BestSubmissionFeeReimbursement:     epochData.FeeReimbursement,
 	}, nil
 }
 
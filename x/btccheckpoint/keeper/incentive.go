@@ -2,16 +2,20 @@ package keeper
 
 import (
 	"context"
+
 	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // rewardBTCTimestamping finds the (submitter, reporter) pairs of all submissions at the
 // given finalised epoch according to the given epoch data, then distribute rewards to them
-// by invoking the incentive module
+// by invoking the incentive module. The submitter of the best submission is also reimbursed
+// for the BTC fees of its transactions, which is recorded in the epoch data
 func (k Keeper) rewardBTCTimestamping(ctx context.Context, epoch uint64, ed *types.EpochData, bestIdx int) {
 	var (
 		bestSubmissionAddrs  *types.CheckpointAddressPair
 		otherSubmissionAddrs []*types.CheckpointAddressPair
+		bestSubmissionFee    uint64
 	)
 
 	// iterate over all submission keys to find all submission addresses, including the best one
@@ -33,6 +37,7 @@ func (k Keeper) rewardBTCTimestamping(ctx context.Context, epoch uint64, ed *typ
 		// assign to best submission or append to other submission according to best submission index
 		if i == bestIdx {
 			bestSubmissionAddrs = submissionAddrs
+			bestSubmissionFee = submissionData.GetBTCFee()
 		} else {
 			otherSubmissionAddrs = append(otherSubmissionAddrs, submissionAddrs)
 		}
@@ -40,5 +45,6 @@ func (k Keeper) rewardBTCTimestamping(ctx context.Context, epoch uint64, ed *typ
 
 	// construct reward distribution information and invoke incentive module to distribute rewards
 	rewardDistInfo := types.NewRewardDistInfo(bestSubmissionAddrs, otherSubmissionAddrs...)
-	k.incentiveKeeper.RewardBTCTimestamping(ctx, epoch, rewardDistInfo)
+	rewardDistInfo.BestFeeReimbursement = sdk.NewCoins(k.GetParams(ctx).GetFeeReimbursement(bestSubmissionFee))
+	ed.FeeReimbursement = k.incentiveKeeper.RewardBTCTimestamping(ctx, epoch, rewardDistInfo)
 }
@@ -147,8 +147,8 @@ func (k Keeper) checkAncestors(
 	var haveDescendant = false
 
 	for _, sk := range previousEpochData.Keys {
-		if len(sk.Key) < 2 {
-			panic("Submission key composed of less than 2 transactions keys in database")
+		if len(sk.Key) == 0 {
+			panic("Submission key composed of no transaction keys in database")
 		}
 
 		parentEpochSubmissionInfo, err := k.GetSubmissionBtcInfo(ctx, *sk)
@@ -222,8 +222,10 @@ func (k Keeper) getEpochChanges(
 
 	for i, sk := range ed.Keys {
 		sk := sk
-		if len(sk.Key) < 2 {
-			panic("Submission key composed of less than 2 transactions keys in database")
+		// a submission key has a single transaction key for a checkpoint
+		// carried by a single transaction
+		if len(sk.Key) == 0 {
+			panic("Submission key composed of no transaction keys in database")
 		}
 
 		submissionInfo, err := k.GetSubmissionBtcInfo(ctx, *sk)
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/btccheckpoint params from consensus version 1 to 2.
+// Version 1 params were stored without the BTC fee reimbursement fields, which
+// decode as a nil rate and a coin without amount. Both are set to their defaults,
+// i.e., fee reimbursement stays disabled until governance enables it.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaults := types.DefaultParams()
+	if params.FeeReimbursementRate.IsNil() {
+		params.FeeReimbursementRate = defaults.FeeReimbursementRate
+	}
+	if params.MaxFeeReimbursement.Denom == "" || params.MaxFeeReimbursement.Amount.IsNil() {
+		params.MaxFeeReimbursement = defaults.MaxFeeReimbursement
+	}
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/btccheckpoint/keeper"
+	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := testkeeper.NewBTCCheckpointKeeper(t, nil, nil, nil, nil)
+
+	// params as stored by consensus version 1, i.e., without the BTC fee
+	// reimbursement fields
+	var bz []byte
+	bz = protowire.AppendTag(bz, 1, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 10)
+	bz = protowire.AppendTag(bz, 2, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 100)
+	bz = protowire.AppendTag(bz, 3, protowire.BytesType)
+	bz = protowire.AppendString(bz, "01020304")
+	storeKey := ctx.MultiStore().(*rootmulti.Store).StoreKeysByName()[types.StoreKey]
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	oldParams := k.GetParams(ctx)
+	require.True(t, oldParams.FeeReimbursementRate.IsNil())
+	require.Error(t, oldParams.Validate())
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+
+	params := k.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	require.Equal(t, uint64(10), params.BtcConfirmationDepth)
+	require.Equal(t, uint64(100), params.CheckpointFinalizationTimeout)
+	require.Equal(t, "01020304", params.CheckpointTag)
+	defaults := types.DefaultParams()
+	require.True(t, defaults.FeeReimbursementRate.Equal(params.FeeReimbursementRate))
+	require.True(t, defaults.MaxFeeReimbursement.IsEqual(params.MaxFeeReimbursement))
+	require.True(t, params.GetFeeReimbursement(1000).IsZero())
+}
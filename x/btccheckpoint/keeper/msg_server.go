@@ -49,7 +49,8 @@ func (ms msgServer) InsertBTCSpvProof(ctx context.Context, req *types.MsgInsertB
 
 	// At this point:
 	// - every proof of inclusion is valid i.e every transaction is proved to be
-	// part of provided block and contains some OP_RETURN data
+	// part of provided block and contains some OP_RETURN data, or reveals the
+	// checkpoint in its witness for a checkpoint carried by a single transaction
 	// - header is proved to be part of the chain we know about through BTCLightClient
 	// - this is new checkpoint submission
 	// Verify if this is expected checkpoint
@@ -69,6 +70,13 @@ func (ms msgServer) InsertBTCSpvProof(ctx context.Context, req *types.MsgInsertB
 		return nil, err
 	}
 
+	// compute the BTC fees paid by the submission for the fee reimbursement
+	fees, err := req.GetBTCFees()
+
+	if err != nil {
+		return nil, types.ErrInvalidInputTransactions.Wrap(err.Error())
+	}
+
 	// construct TransactionInfo pair and the submission data
 	txsInfo := make([]*types.TransactionInfo, len(submissionKey.Key))
 	for i := range submissionKey.Key {
@@ -77,6 +85,8 @@ func (ms msgServer) InsertBTCSpvProof(ctx context.Context, req *types.MsgInsertB
 		// see https://github.com/golang/go/discussions/56010
 		txKey := submissionKey.Key[i]
 		txsInfo[i] = types.NewTransactionInfo(txKey, req.Proofs[i].BtcTransaction, req.Proofs[i].MerkleNodes)
+		txsInfo[i].SpentTransaction = req.Proofs[i].SpentBtcTransaction
+		txsInfo[i].Fee = fees[i]
 	}
 	submissionData := rawSubmission.GetSubmissionData(epochNum, txsInfo)
 
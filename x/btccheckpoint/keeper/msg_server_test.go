@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	dg "github.com/babylonchain/babylon/testutil/datagen"
 	keepertest "github.com/babylonchain/babylon/testutil/keeper"
 	bbn "github.com/babylonchain/babylon/types"
@@ -203,6 +204,115 @@ func TestSubmitValidNewCheckpoint(t *testing.T) {
 	}
 }
 
+func TestSubmitValidSingleTxCheckpoint(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	tk := InitTestKeepers(t)
+
+	// epoch 1 is checkpointed by a single tx
+	epoch := uint64(1)
+	raw, rawBtcCheckpoint := dg.RandomRawCheckpointDataForEpoch(r, epoch)
+	blck := dg.CreateSingleTxCheckpointBlock(r, 1, 7, 5, raw.SingleTxData)
+	msg := dg.GenerateMessageWithRandomSubmitter([]*dg.BlockCreationResult{blck})
+	tk.BTCLightClient.SetDepth(blck.HeaderBytes.Hash(), uint64(5))
+
+	// submission without the spent tx is rejected
+	noSpentTxMsg := *msg
+	noSpentTxProof := *msg.Proofs[0]
+	noSpentTxProof.SpentBtcTransaction = nil
+	noSpentTxMsg.Proofs = []*btcctypes.BTCSpvProof{&noSpentTxProof}
+	_, err := tk.insertProofMsg(&noSpentTxMsg)
+	require.ErrorIs(t, err, btcctypes.ErrInvalidCheckpointProof)
+
+	_, err = tk.insertProofMsg(msg)
+	require.NoError(t, err)
+
+	ed := tk.GetEpochData(epoch)
+	require.Len(t, ed.Keys, 1)
+	require.Len(t, ed.Keys[0].Key, 1)
+	submissionData := tk.getSubmissionData(*ed.Keys[0])
+	require.NotNil(t, submissionData)
+	require.Equal(t, rawBtcCheckpoint.SubmitterAddress, submissionData.VigilanteAddresses.Submitter)
+	require.Len(t, submissionData.TxsInfo, 1)
+	require.Equal(t, msg.Proofs[0].BtcTransaction, submissionData.TxsInfo[0].Transaction)
+	require.Equal(t, msg.Proofs[0].SpentBtcTransaction, submissionData.TxsInfo[0].SpentTransaction)
+
+	// epoch 2 is checkpointed by two txs, which must both be fresher than the
+	// single tx of epoch 1
+	raw2, _ := dg.RandomRawCheckpointDataForEpoch(r, epoch+1)
+	epoch2Block1 := dg.CreateBlock(r, 1, 19, 2, raw2.FirstPart)
+	epoch2Block2 := dg.CreateBlock(r, 2, 14, 7, raw2.SecondPart)
+	msg2 := dg.GenerateMessageWithRandomSubmitter([]*dg.BlockCreationResult{epoch2Block1, epoch2Block2})
+	tk.BTCLightClient.SetDepth(epoch2Block1.HeaderBytes.Hash(), uint64(5))
+	tk.BTCLightClient.SetDepth(epoch2Block2.HeaderBytes.Hash(), uint64(3))
+	_, err = tk.insertProofMsg(msg2)
+	require.ErrorIs(t, err, btcctypes.ErrProvidedHeaderDoesNotHaveAncestor)
+
+	tk.BTCLightClient.SetDepth(epoch2Block1.HeaderBytes.Hash(), uint64(4))
+	_, err = tk.insertProofMsg(msg2)
+	require.NoError(t, err)
+}
+
+func TestReimburseBTCFeesOfBestSubmission(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	tk := InitTestKeepers(t)
+
+	params := btcctypes.DefaultParams()
+	params.FeeReimbursementRate = sdkmath.LegacyNewDec(r.Int63n(1000) + 1)
+	params.MaxFeeReimbursement = sdk.NewInt64Coin(params.MaxFeeReimbursement.Denom, r.Int63n(1000)+1)
+	require.NoError(t, tk.BTCCheckpoint.SetParams(tk.Ctx, params))
+	epoch := uint64(1)
+
+	// input txs that do not contain the outputs spent by the checkpoint txs
+	// are rejected
+	msg1 := dg.GenerateMessageWithRandomSubmitterForEpoch(r, epoch)
+	tk.BTCLightClient.SetDepth(b1Hash(msg1), uint64(1))
+	tk.BTCLightClient.SetDepth(b2Hash(msg1), uint64(1))
+	var wrongInputTx bytes.Buffer
+	require.NoError(t, dg.GenRandomTx(r).Serialize(&wrongInputTx))
+	msg1.Proofs[0].InputBtcTransactions = [][]byte{wrongInputTx.Bytes()}
+	_, err := tk.insertProofMsg(msg1)
+	require.ErrorIs(t, err, btcctypes.ErrInvalidInputTransactions)
+
+	// the fee of a single tx checkpoint is computed from its spent tx
+	raw, _ := dg.RandomRawCheckpointDataForEpoch(r, epoch)
+	blck := dg.CreateSingleTxCheckpointBlock(r, 1, 7, 5, raw.SingleTxData)
+	msg := dg.GenerateMessageWithRandomSubmitter([]*dg.BlockCreationResult{blck})
+	tk.BTCLightClient.SetDepth(blck.HeaderBytes.Hash(), uint64(1))
+	_, err = tk.insertProofMsg(msg)
+	require.NoError(t, err)
+
+	spentTx, err := btcctypes.ParseTransaction(msg.Proofs[0].SpentBtcTransaction)
+	require.NoError(t, err)
+	tx, err := btcctypes.ParseTransaction(msg.Proofs[0].BtcTransaction)
+	require.NoError(t, err)
+	expectedFee := spentTx.MsgTx().TxOut[0].Value
+	for _, txOut := range tx.MsgTx().TxOut {
+		expectedFee -= txOut.Value
+	}
+	require.Positive(t, expectedFee)
+
+	ed := tk.GetEpochData(epoch)
+	require.Len(t, ed.Keys, 1)
+	submissionData := tk.getSubmissionData(*ed.Keys[0])
+	require.Equal(t, uint64(expectedFee), submissionData.GetBTCFee())
+
+	// the submitter is reimbursed once the epoch is finalized
+	tk.BTCLightClient.SetDepth(blck.HeaderBytes.Hash(), params.CheckpointFinalizationTimeout)
+	tk.onTipChange()
+	ed = tk.GetEpochData(epoch)
+	require.Equal(t, btcctypes.Finalized, ed.Status)
+	expectedAmount := sdkmath.MinInt(params.FeeReimbursementRate.MulInt64(expectedFee).TruncateInt(), params.MaxFeeReimbursement.Amount)
+	expectedReimbursement := sdk.NewCoins(sdk.NewCoin(params.MaxFeeReimbursement.Denom, expectedAmount))
+	require.Equal(t, expectedReimbursement, ed.FeeReimbursement)
+
+	// the accounting is visible in the checkpoint info
+	res, err := tk.BTCCheckpoint.BtcCheckpointInfo(tk.Ctx, &btcctypes.QueryBtcCheckpointInfoRequest{EpochNum: epoch})
+	require.NoError(t, err)
+	require.Equal(t, uint64(expectedFee), res.Info.BestSubmissionBtcFee)
+	require.Equal(t, uint64(expectedFee), res.Info.BestSubmissionTransactions[0].Fee)
+	require.Equal(t, expectedReimbursement, res.Info.BestSubmissionFeeReimbursement)
+}
+
 func TestRejectSubmissionWithoutSubmissionsForPreviousEpoch(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().Unix()))
 	epoch := uint64(2)
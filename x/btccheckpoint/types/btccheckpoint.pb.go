@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -77,7 +79,8 @@ func (BtcStatus) EnumDescriptor() ([]byte, []int) {
 // By looking at 010 we would know that H4 is a right sibling,
 // H12 is left, H5555 is right again.
 type BTCSpvProof struct {
-	// Valid bitcoin transaction containing OP_RETURN opcode.
+	// Valid bitcoin transaction containing OP_RETURN opcode, or revealing the
+	// checkpoint in the witness of a Taproot script path spend.
 	BtcTransaction []byte `protobuf:"bytes,1,opt,name=btc_transaction,json=btcTransaction,proto3" json:"btc_transaction,omitempty"`
 	// Index of transaction within the block. Index is needed to determine if
 	// currently hashed node is left or right.
@@ -91,6 +94,17 @@ type BTCSpvProof struct {
 	// Valid btc header which confirms btc_transaction.
 	// Should have exactly 80 bytes
 	ConfirmingBtcHeader *github_com_babylonchain_babylon_types.BTCHeaderBytes `protobuf:"bytes,4,opt,name=confirming_btc_header,json=confirmingBtcHeader,proto3,customtype=github.com/babylonchain/babylon/types.BTCHeaderBytes" json:"confirming_btc_header,omitempty"`
+	// Transaction whose Taproot output is spent by btc_transaction through the
+	// script path revealing the checkpoint data. Only set for checkpoints
+	// carried by a single transaction. It is needed as the witness is not
+	// committed by the transaction hash proven by merkle_nodes, so the revealed
+	// script is authenticated against the spent output instead.
+	SpentBtcTransaction []byte `protobuf:"bytes,5,opt,name=spent_btc_transaction,json=spentBtcTransaction,proto3" json:"spent_btc_transaction,omitempty"`
+	// Transactions whose outputs are spent by btc_transaction, used to compute
+	// the BTC fee paid by btc_transaction. The transactions of the other proofs
+	// of the submission and spent_btc_transaction do not need to be repeated.
+	// Optional; the fee is unknown, and thus not reimbursed, without them.
+	InputBtcTransactions [][]byte `protobuf:"bytes,6,rep,name=input_btc_transactions,json=inputBtcTransactions,proto3" json:"input_btc_transactions,omitempty"`
 }
 
 func (m *BTCSpvProof) Reset()         { *m = BTCSpvProof{} }
@@ -147,6 +161,20 @@ func (m *BTCSpvProof) GetMerkleNodes() []byte {
 	return nil
 }
 
+func (m *BTCSpvProof) GetSpentBtcTransaction() []byte {
+	if m != nil {
+		return m.SpentBtcTransaction
+	}
+	return nil
+}
+
+func (m *BTCSpvProof) GetInputBtcTransactions() [][]byte {
+	if m != nil {
+		return m.InputBtcTransactions
+	}
+	return nil
+}
+
 // Each provided OP_RETURN transaction can be identified by hash of block in
 // which transaction was included and transaction index in the block
 type TransactionKey struct {
@@ -264,6 +292,13 @@ type TransactionInfo struct {
 	// TODO: maybe it could use here better format as we already processed and
 	// validated the proof?
 	Proof []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// spent_transaction is the transaction whose Taproot output is spent by
+	// `transaction` to reveal the checkpoint data. It is only set for
+	// checkpoints carried by a single transaction.
+	SpentTransaction []byte `protobuf:"bytes,4,opt,name=spent_transaction,json=spentTransaction,proto3" json:"spent_transaction,omitempty"`
+	// fee is the BTC fee in satoshis paid by `transaction`. It is zero if the
+	// transactions spent by `transaction` were not provided upon submission.
+	Fee uint64 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
 func (m *TransactionInfo) Reset()         { *m = TransactionInfo{} }
@@ -320,6 +355,20 @@ func (m *TransactionInfo) GetProof() []byte {
 	return nil
 }
 
+func (m *TransactionInfo) GetSpentTransaction() []byte {
+	if m != nil {
+		return m.SpentTransaction
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
 // TODO: Determine if we should keep any block number or depth info.
 // On one hand it may be useful to determine if block is stable or not, on
 // other depth/block number info, without context (i.e info about chain) is
@@ -399,6 +448,9 @@ type EpochData struct {
 	Keys []*SubmissionKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 	// status is the current btc status of the epoch
 	Status BtcStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.btccheckpoint.v1.BtcStatus" json:"status,omitempty"`
+	// fee_reimbursement is the reimbursement of BTC fees paid to the submitter
+	// of the best submission, set once the epoch is finalized
+	FeeReimbursement github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee_reimbursement,json=feeReimbursement,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_reimbursement"`
 }
 
 func (m *EpochData) Reset()         { *m = EpochData{} }
@@ -448,6 +500,13 @@ func (m *EpochData) GetStatus() BtcStatus {
 	return Submitted
 }
 
+func (m *EpochData) GetFeeReimbursement() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeReimbursement
+	}
+	return nil
+}
+
 // CheckpointAddresses contains the addresses of the submitter and reporter of a
 // given checkpoint
 type CheckpointAddresses struct {
@@ -521,6 +580,11 @@ type BTCCheckpointInfo struct {
 	BestSubmissionTransactions []*TransactionInfo `protobuf:"bytes,4,rep,name=best_submission_transactions,json=bestSubmissionTransactions,proto3" json:"best_submission_transactions,omitempty"`
 	// list of vigilantes' addresses of the best submission
 	BestSubmissionVigilanteAddressList []*CheckpointAddresses `protobuf:"bytes,5,rep,name=best_submission_vigilante_address_list,json=bestSubmissionVigilanteAddressList,proto3" json:"best_submission_vigilante_address_list,omitempty"`
+	// total BTC fee in satoshis paid by the transactions of the best submission
+	BestSubmissionBtcFee uint64 `protobuf:"varint,6,opt,name=best_submission_btc_fee,json=bestSubmissionBtcFee,proto3" json:"best_submission_btc_fee,omitempty"`
+	// reimbursement of BTC fees paid to the submitter of the best submission,
+	// set once the epoch is finalized
+	BestSubmissionFeeReimbursement github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=best_submission_fee_reimbursement,json=bestSubmissionFeeReimbursement,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"best_submission_fee_reimbursement"`
 }
 
 func (m *BTCCheckpointInfo) Reset()         { *m = BTCCheckpointInfo{} }
@@ -584,6 +648,20 @@ func (m *BTCCheckpointInfo) GetBestSubmissionVigilanteAddressList() []*Checkpoin
 	return nil
 }
 
+func (m *BTCCheckpointInfo) GetBestSubmissionBtcFee() uint64 {
+	if m != nil {
+		return m.BestSubmissionBtcFee
+	}
+	return 0
+}
+
+func (m *BTCCheckpointInfo) GetBestSubmissionFeeReimbursement() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BestSubmissionFeeReimbursement
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("babylon.btccheckpoint.v1.BtcStatus", BtcStatus_name, BtcStatus_value)
 	proto.RegisterType((*BTCSpvProof)(nil), "babylon.btccheckpoint.v1.BTCSpvProof")
@@ -601,58 +679,70 @@ func init() {
 }
 
 var fileDescriptor_e096cac78d49b0a6 = []byte{
-	// 814 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x8f, 0x22, 0x45,
-	0x14, 0xa7, 0xa0, 0x67, 0x5d, 0x0a, 0x86, 0x1d, 0x8b, 0x59, 0xd3, 0x21, 0x93, 0x5e, 0xb6, 0x4d,
-	0x5c, 0xd6, 0x28, 0x64, 0x47, 0x4d, 0x36, 0xae, 0x97, 0x69, 0x3e, 0x02, 0xd9, 0x5d, 0x98, 0x34,
-	0xbd, 0x1e, 0xf6, 0x60, 0xa7, 0xbb, 0x29, 0xe8, 0x0a, 0xd0, 0x45, 0xba, 0x0a, 0x02, 0x9e, 0xf4,
-	0x60, 0x62, 0x3c, 0x19, 0xef, 0x9e, 0xfc, 0x67, 0x3c, 0x78, 0xd8, 0xa3, 0xd9, 0xc3, 0xc4, 0xcc,
-	0xfc, 0x07, 0x5e, 0xbd, 0x98, 0xaa, 0xee, 0xe1, 0x6b, 0x06, 0x95, 0xc4, 0x1b, 0xef, 0xd5, 0xef,
-	0x7d, 0xfc, 0x7e, 0xef, 0x3d, 0x1a, 0x7e, 0xe4, 0x3a, 0xee, 0x62, 0x44, 0x83, 0x8a, 0xcb, 0x3d,
-	0xcf, 0xc7, 0xde, 0x70, 0x42, 0x49, 0xc0, 0x2b, 0xb3, 0x27, 0x9b, 0x8e, 0xf2, 0x24, 0xa4, 0x9c,
-	0x22, 0x35, 0x46, 0x97, 0x37, 0x1f, 0x67, 0x4f, 0x0a, 0xc7, 0x03, 0x3a, 0xa0, 0x12, 0x54, 0x11,
-	0xbf, 0x22, 0xbc, 0xfe, 0x17, 0x80, 0x19, 0xc3, 0xaa, 0x76, 0x27, 0xb3, 0xf3, 0x90, 0xd2, 0x3e,
-	0x7a, 0x04, 0xef, 0xb9, 0xdc, 0xb3, 0x79, 0xe8, 0x04, 0xcc, 0xf1, 0x38, 0xa1, 0x81, 0x0a, 0x8a,
-	0xa0, 0x94, 0x35, 0x73, 0x2e, 0xf7, 0xac, 0x95, 0x17, 0x9d, 0xc2, 0xfb, 0x5b, 0x40, 0x9b, 0x04,
-	0x3d, 0x3c, 0x57, 0x93, 0x45, 0x50, 0x3a, 0x34, 0xf3, 0x9b, 0xf0, 0x96, 0x78, 0x42, 0x0f, 0x61,
-	0x76, 0x8c, 0xc3, 0xe1, 0x08, 0xdb, 0x01, 0xed, 0x61, 0xa6, 0xa6, 0x64, 0xe6, 0x4c, 0xe4, 0x6b,
-	0x0b, 0x17, 0x1a, 0xc1, 0xfb, 0x1e, 0x0d, 0xfa, 0x24, 0x1c, 0x93, 0x60, 0x60, 0x8b, 0x0a, 0x3e,
-	0x76, 0x7a, 0x38, 0x54, 0x15, 0x81, 0x35, 0x9e, 0xbe, 0xbd, 0x78, 0xf0, 0xe9, 0x80, 0x70, 0x7f,
-	0xea, 0x96, 0x3d, 0x3a, 0xae, 0xc4, 0x6c, 0x3d, 0xdf, 0x21, 0xc1, 0xb5, 0x51, 0xe1, 0x8b, 0x09,
-	0x66, 0x65, 0xc3, 0xaa, 0x36, 0x65, 0xa8, 0xb1, 0xe0, 0x98, 0x99, 0xf9, 0x55, 0x5a, 0x83, 0x7b,
-	0xd1, 0x8b, 0x3e, 0x87, 0xb9, 0xb5, 0x26, 0x9f, 0xe3, 0x05, 0x3a, 0x86, 0x07, 0x11, 0x0d, 0x20,
-	0x69, 0x44, 0x06, 0x3a, 0x87, 0x8a, 0xef, 0x30, 0x5f, 0x72, 0xcb, 0x1a, 0x5f, 0xbc, 0xbd, 0x78,
-	0xf0, 0x74, 0xcf, 0x26, 0x9a, 0x0e, 0xf3, 0xa3, 0x46, 0x64, 0x26, 0xfd, 0x39, 0x3c, 0xec, 0x4e,
-	0xdd, 0x31, 0x61, 0x2c, 0x2e, 0xfc, 0x39, 0x4c, 0x0d, 0xf1, 0x42, 0x05, 0xc5, 0x54, 0x29, 0x73,
-	0x5a, 0x2a, 0xef, 0x1a, 0x63, 0x79, 0xb3, 0x5f, 0x53, 0x04, 0xe9, 0xdf, 0x01, 0x78, 0x6f, 0x43,
-	0xec, 0x3e, 0x5d, 0xe5, 0x03, 0x7b, 0xe7, 0x43, 0x45, 0x98, 0x59, 0x5f, 0x80, 0x64, 0x34, 0xa6,
-	0x35, 0x97, 0x90, 0x69, 0x22, 0xf6, 0x25, 0x1e, 0x61, 0x64, 0xe8, 0xbf, 0x01, 0x98, 0x5b, 0xb1,
-	0xaa, 0x39, 0xdc, 0x41, 0x5f, 0xc1, 0xfc, 0x8c, 0x0c, 0xc8, 0xc8, 0x09, 0x38, 0xb6, 0x9d, 0x5e,
-	0x2f, 0xc4, 0x8c, 0x61, 0x16, 0xb7, 0xf5, 0xf1, 0xee, 0xb6, 0xaa, 0x4b, 0xeb, 0xec, 0x3a, 0xc8,
-	0x44, 0xcb, 0x4c, 0x4b, 0x1f, 0xaa, 0xc1, 0xbb, 0x7c, 0xce, 0x6c, 0x12, 0xf4, 0xa9, 0x9a, 0x94,
-	0xda, 0x3d, 0xfe, 0x4f, 0x5c, 0x85, 0x46, 0xe6, 0x3b, 0x7c, 0xce, 0xa4, 0x58, 0xc7, 0xf0, 0x00,
-	0x4f, 0xa8, 0xe7, 0x4b, 0x3a, 0x8a, 0x19, 0x19, 0x42, 0xd6, 0x74, 0x5d, 0xfc, 0x92, 0x4c, 0x9e,
-	0x41, 0x65, 0x88, 0x17, 0x2c, 0x9e, 0xd0, 0xa3, 0xdd, 0x55, 0x36, 0xe6, 0x6a, 0xca, 0x20, 0xf4,
-	0x0c, 0xde, 0x61, 0xdc, 0xe1, 0x53, 0x26, 0xc5, 0xcc, 0x9d, 0xbe, 0xbf, 0x3b, 0xdc, 0xe0, 0x5e,
-	0x57, 0x42, 0xcd, 0x38, 0x44, 0xef, 0xc0, 0xfc, 0x2d, 0x72, 0xa0, 0x13, 0x98, 0x66, 0xa2, 0x14,
-	0xe7, 0x38, 0x8c, 0x8f, 0x74, 0xe5, 0x40, 0x05, 0x78, 0x37, 0xc4, 0x13, 0x1a, 0x8a, 0xc7, 0x68,
-	0x80, 0x4b, 0x5b, 0xff, 0x33, 0x05, 0xdf, 0x35, 0xac, 0xea, 0x2a, 0xa9, 0x14, 0xe1, 0x21, 0xcc,
-	0x4a, 0xde, 0x76, 0x30, 0x1d, 0xbb, 0x71, 0x4a, 0xc5, 0xcc, 0x48, 0x5f, 0x5b, 0xba, 0x50, 0x03,
-	0x16, 0x5d, 0xcc, 0xb8, 0xcd, 0x96, 0x14, 0xe5, 0x89, 0xba, 0x23, 0xea, 0x0d, 0x6d, 0x1f, 0x93,
-	0x81, 0xcf, 0x65, 0x31, 0xc5, 0x3c, 0x11, 0xb8, 0x95, 0x12, 0x06, 0xf7, 0x0c, 0x01, 0x6a, 0x4a,
-	0x0c, 0xfa, 0x06, 0x40, 0xed, 0x1f, 0x12, 0x89, 0x53, 0x4b, 0xfd, 0x0f, 0xa7, 0x56, 0xd8, 0xd1,
-	0x84, 0xc3, 0x7c, 0x34, 0x84, 0x27, 0xdb, 0x1d, 0xac, 0x2d, 0x38, 0x53, 0x95, 0x7d, 0x97, 0x69,
-	0xab, 0xd8, 0xda, 0x33, 0x43, 0xdf, 0x02, 0xf8, 0xc1, 0x76, 0xb5, 0x1b, 0x67, 0x61, 0x8f, 0x08,
-	0xe3, 0xea, 0x81, 0xac, 0xbb, 0xe7, 0x65, 0xe8, 0x9b, 0xb5, 0xbf, 0xdc, 0xba, 0x93, 0x17, 0x84,
-	0xf1, 0x0f, 0x7f, 0x02, 0x30, 0xbd, 0xdc, 0x2d, 0xf4, 0x18, 0xbe, 0x57, 0x3f, 0xef, 0x54, 0x9b,
-	0x76, 0xd7, 0x3a, 0xb3, 0x5e, 0x75, 0xed, 0xee, 0x2b, 0xe3, 0x65, 0xcb, 0xb2, 0xea, 0xb5, 0xa3,
-	0x44, 0xe1, 0xf0, 0x87, 0x9f, 0x8b, 0xe9, 0x6e, 0xbc, 0x49, 0xbd, 0x1b, 0xd0, 0x6a, 0xa7, 0xdd,
-	0x68, 0x99, 0x2f, 0xeb, 0xb5, 0x23, 0x10, 0x41, 0xab, 0xd1, 0x3f, 0xeb, 0x2d, 0xd0, 0x46, 0xab,
-	0x7d, 0xf6, 0xa2, 0xf5, 0xba, 0x5e, 0x3b, 0x4a, 0x46, 0xd0, 0x06, 0x09, 0x9c, 0x11, 0xf9, 0x1a,
-	0xf7, 0x0a, 0xca, 0xf7, 0xbf, 0x68, 0x09, 0xa3, 0xf3, 0xeb, 0xa5, 0x06, 0xde, 0x5c, 0x6a, 0xe0,
-	0x8f, 0x4b, 0x0d, 0xfc, 0x78, 0xa5, 0x25, 0xde, 0x5c, 0x69, 0x89, 0xdf, 0xaf, 0xb4, 0xc4, 0xeb,
-	0xcf, 0xfe, 0x6d, 0xea, 0xf3, 0xad, 0x0f, 0xa2, 0xdc, 0x02, 0xf7, 0x8e, 0xfc, 0xac, 0x7d, 0xf2,
-	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x55, 0x92, 0x5f, 0x36, 0x07, 0x00, 0x00,
+	// 1002 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xfa, 0x23, 0x6d, 0xc6, 0x4e, 0xea, 0x8c, 0xdd, 0xb2, 0x58, 0xd1, 0xc6, 0x31, 0x12,
+	0x75, 0x81, 0xae, 0x49, 0x68, 0xa5, 0x8a, 0x72, 0xc9, 0xfa, 0x43, 0xb1, 0xda, 0x26, 0xd1, 0xda,
+	0xe5, 0xd0, 0x03, 0xab, 0xdd, 0xf5, 0xd8, 0x3b, 0xb2, 0xbd, 0x63, 0xed, 0x8c, 0x2d, 0x9b, 0x13,
+	0xdc, 0x10, 0x27, 0xc4, 0x81, 0x1b, 0x27, 0x6e, 0xfc, 0x19, 0x88, 0x43, 0x0f, 0x1c, 0x7a, 0x44,
+	0x3d, 0x04, 0x94, 0x88, 0xbf, 0x03, 0x34, 0xb3, 0x5b, 0xdb, 0xbb, 0x8e, 0x81, 0x08, 0x4e, 0xd9,
+	0xf7, 0xde, 0xef, 0xbd, 0x37, 0xef, 0xf7, 0x3e, 0x62, 0xf0, 0x81, 0x65, 0x5a, 0xb3, 0x01, 0x71,
+	0x2b, 0x16, 0xb3, 0x6d, 0x07, 0xd9, 0xfd, 0x11, 0xc1, 0x2e, 0xab, 0x4c, 0x0e, 0xc2, 0x0a, 0x75,
+	0xe4, 0x11, 0x46, 0xa0, 0x1c, 0xa0, 0xd5, 0xb0, 0x71, 0x72, 0x50, 0xc8, 0xf7, 0x48, 0x8f, 0x08,
+	0x50, 0x85, 0x7f, 0xf9, 0xf8, 0x82, 0x62, 0x13, 0x3a, 0x24, 0xb4, 0x62, 0x99, 0x14, 0x55, 0x26,
+	0x07, 0x16, 0x62, 0xe6, 0x41, 0xc5, 0x26, 0xd8, 0xf5, 0xed, 0xa5, 0x3f, 0xe2, 0x20, 0xad, 0xb5,
+	0xab, 0xad, 0xd1, 0xe4, 0xcc, 0x23, 0xa4, 0x0b, 0xef, 0x82, 0x5b, 0x16, 0xb3, 0x0d, 0xe6, 0x99,
+	0x2e, 0x35, 0x6d, 0x86, 0x89, 0x2b, 0x4b, 0x45, 0xa9, 0x9c, 0xd1, 0xb7, 0x2d, 0x66, 0xb7, 0x17,
+	0x5a, 0x78, 0x08, 0x6e, 0x47, 0x80, 0x06, 0x76, 0x3b, 0x68, 0x2a, 0xc7, 0x8b, 0x52, 0x79, 0x4b,
+	0xcf, 0x85, 0xe1, 0x4d, 0x6e, 0x82, 0xfb, 0x20, 0x33, 0x44, 0x5e, 0x7f, 0x80, 0x0c, 0x97, 0x74,
+	0x10, 0x95, 0x13, 0x22, 0x72, 0xda, 0xd7, 0x9d, 0x70, 0x15, 0x1c, 0x80, 0xdb, 0x36, 0x71, 0xbb,
+	0xd8, 0x1b, 0x62, 0xb7, 0x67, 0xf0, 0x0c, 0x0e, 0x32, 0x3b, 0xc8, 0x93, 0x93, 0x1c, 0xab, 0x3d,
+	0x7a, 0x7d, 0xbe, 0xf7, 0xa0, 0x87, 0x99, 0x33, 0xb6, 0x54, 0x9b, 0x0c, 0x2b, 0x01, 0x1b, 0xb6,
+	0x63, 0x62, 0xf7, 0x8d, 0x50, 0x61, 0xb3, 0x11, 0xa2, 0xaa, 0xd6, 0xae, 0x1e, 0x0b, 0x57, 0x6d,
+	0xc6, 0x10, 0xd5, 0x73, 0x8b, 0xb0, 0x1a, 0xb3, 0x7d, 0x0b, 0x2f, 0x82, 0x8e, 0x90, 0xcb, 0x8c,
+	0x68, 0xcd, 0x29, 0xf1, 0xb2, 0x9c, 0x30, 0x6a, 0xe1, 0xc2, 0x1f, 0x80, 0x3b, 0xd8, 0x1d, 0x8d,
+	0x57, 0x7c, 0xa8, 0xbc, 0x51, 0x4c, 0x94, 0x33, 0x7a, 0x5e, 0x58, 0xc3, 0x4e, 0xb4, 0x34, 0x05,
+	0xdb, 0x4b, 0xf2, 0x13, 0x34, 0x83, 0x79, 0x90, 0xf2, 0x09, 0x93, 0x04, 0x61, 0xbe, 0x00, 0xcf,
+	0x40, 0xd2, 0x31, 0xa9, 0x23, 0x58, 0xcc, 0x68, 0x9f, 0xbc, 0x3e, 0xdf, 0x7b, 0x74, 0xcd, 0x72,
+	0x8f, 0x4d, 0xea, 0xf8, 0x25, 0x8b, 0x48, 0xa5, 0x27, 0x60, 0xab, 0x35, 0xb6, 0x86, 0x98, 0xd2,
+	0x20, 0xf1, 0xc7, 0x20, 0xd1, 0x47, 0x33, 0x59, 0x2a, 0x26, 0xca, 0xe9, 0xc3, 0xb2, 0xba, 0x6e,
+	0xa0, 0xd4, 0xf0, 0x7b, 0x75, 0xee, 0x54, 0xfa, 0x59, 0x02, 0xb7, 0x42, 0x6d, 0xed, 0x92, 0x45,
+	0x3c, 0xe9, 0xda, 0xf1, 0x60, 0x11, 0xa4, 0x97, 0x69, 0x8f, 0xfb, 0x03, 0xb1, 0xa4, 0xe2, 0x34,
+	0x8d, 0xf8, 0x64, 0x06, 0xc3, 0xe2, 0x0b, 0xf0, 0x7d, 0xb0, 0xe3, 0x37, 0x6e, 0xd9, 0x5b, 0x8c,
+	0x88, 0x9e, 0x15, 0x86, 0xe5, 0x8e, 0x65, 0x41, 0xa2, 0x8b, 0x90, 0xe8, 0x69, 0x52, 0xe7, 0x9f,
+	0xa5, 0x5f, 0x24, 0xb0, 0xbd, 0x20, 0xa5, 0x66, 0x32, 0x13, 0x7e, 0x06, 0x72, 0x13, 0xdc, 0xc3,
+	0x03, 0xd3, 0x65, 0xc8, 0x30, 0x3b, 0x1d, 0x0f, 0x51, 0x8a, 0x68, 0x50, 0xd5, 0xfd, 0xf5, 0x55,
+	0x55, 0xe7, 0xd2, 0xd1, 0x1b, 0x27, 0x1d, 0xce, 0x23, 0xcd, 0x75, 0xb0, 0x06, 0x6e, 0xb2, 0x29,
+	0x35, 0xb0, 0xdb, 0x25, 0x72, 0x5c, 0x50, 0x7f, 0xef, 0x5f, 0x51, 0xc5, 0x29, 0xd6, 0x6f, 0xb0,
+	0x29, 0x15, 0x5c, 0xe7, 0x41, 0x0a, 0x8d, 0x88, 0xed, 0x08, 0x36, 0x92, 0xba, 0x2f, 0x94, 0xfe,
+	0x94, 0xc0, 0x66, 0x9d, 0x7f, 0x89, 0x4a, 0x1e, 0x83, 0x64, 0x1f, 0xcd, 0x68, 0xd0, 0xe0, 0xbb,
+	0xeb, 0xb3, 0x84, 0xc6, 0x42, 0x17, 0x4e, 0xf0, 0x31, 0xd8, 0xa0, 0xcc, 0x64, 0x63, 0x2a, 0x7a,
+	0xb1, 0x7d, 0xf8, 0xce, 0x7a, 0x77, 0x8d, 0xd9, 0x2d, 0x01, 0xd5, 0x03, 0x17, 0x38, 0x05, 0x3b,
+	0x5d, 0x84, 0x0c, 0x0f, 0xe1, 0xa1, 0x35, 0xf6, 0x28, 0x1a, 0x22, 0x97, 0xc9, 0x09, 0xf1, 0x8c,
+	0xb7, 0x55, 0xff, 0x10, 0xa9, 0xfc, 0x10, 0xa9, 0xc1, 0x21, 0x52, 0xab, 0x04, 0xbb, 0xda, 0x87,
+	0x2f, 0xcf, 0xf7, 0x62, 0x3f, 0xfe, 0xb6, 0x57, 0x5e, 0x1a, 0xf4, 0xe0, 0x6a, 0xf9, 0x7f, 0xee,
+	0xd3, 0x4e, 0x3f, 0x98, 0x72, 0xee, 0x40, 0xf5, 0x6c, 0x17, 0x21, 0x7d, 0x39, 0x49, 0xe9, 0x14,
+	0xe4, 0xae, 0x68, 0x04, 0xdc, 0x05, 0x9b, 0x94, 0x17, 0xc9, 0x18, 0xf2, 0x82, 0x3b, 0xb6, 0x50,
+	0xc0, 0x02, 0xb8, 0xe9, 0xa1, 0x11, 0xf1, 0xb8, 0xd1, 0x9f, 0xbc, 0xb9, 0x5c, 0xfa, 0x29, 0x05,
+	0x76, 0xb4, 0x76, 0x75, 0x11, 0x54, 0xd0, 0xbf, 0x0f, 0x32, 0x82, 0x71, 0xc3, 0x1d, 0x0f, 0xad,
+	0x20, 0x64, 0x52, 0x4f, 0x0b, 0xdd, 0x89, 0x50, 0xc1, 0x06, 0x28, 0x5a, 0x88, 0x32, 0x83, 0xce,
+	0xc9, 0x15, 0x87, 0xc2, 0x1a, 0x10, 0xbb, 0x6f, 0x38, 0x08, 0xf7, 0x1c, 0x26, 0x92, 0x25, 0xf5,
+	0x5d, 0x8e, 0x5b, 0xf4, 0x40, 0x63, 0xb6, 0xc6, 0x41, 0xc7, 0x02, 0x03, 0xbf, 0x90, 0x80, 0xf2,
+	0x37, 0x81, 0x4c, 0xea, 0xcf, 0xc0, 0x7f, 0xbd, 0x11, 0x85, 0x35, 0x8f, 0x30, 0xa9, 0x03, 0xfb,
+	0x60, 0x37, 0xfa, 0x82, 0xd0, 0xbd, 0x4b, 0x5e, 0x77, 0x8c, 0x23, 0xc9, 0x96, 0xcc, 0x14, 0x7e,
+	0x29, 0x81, 0x77, 0xa3, 0xd9, 0x56, 0x16, 0xd2, 0x18, 0x60, 0xca, 0xe4, 0x54, 0x31, 0x71, 0xfd,
+	0x9d, 0x2c, 0x85, 0x73, 0x7f, 0x1a, 0xd9, 0xd0, 0xa7, 0x98, 0x32, 0xf8, 0x10, 0xbc, 0x75, 0x15,
+	0xe5, 0xfc, 0x78, 0x6c, 0x88, 0x96, 0xe5, 0x57, 0xd8, 0x6a, 0x20, 0x04, 0xbf, 0x93, 0xc0, 0x7e,
+	0xd4, 0x6f, 0x75, 0x0f, 0x6e, 0xfc, 0xff, 0x7b, 0xa0, 0x84, 0x9f, 0xd3, 0x88, 0x6c, 0xc5, 0x7b,
+	0xdf, 0x4a, 0x60, 0x73, 0xbe, 0xa5, 0xf0, 0x1e, 0xb8, 0x53, 0x3f, 0x3b, 0xad, 0x1e, 0x1b, 0xad,
+	0xf6, 0x51, 0xfb, 0x79, 0xcb, 0x68, 0x3d, 0xd7, 0x9e, 0x35, 0xdb, 0xed, 0x7a, 0x2d, 0x1b, 0x2b,
+	0x6c, 0x7d, 0xfd, 0x7d, 0x71, 0xb3, 0x15, 0x6c, 0x46, 0x67, 0x05, 0x5a, 0x3d, 0x3d, 0x69, 0x34,
+	0xf5, 0x67, 0xf5, 0x5a, 0x56, 0xf2, 0xa1, 0x55, 0xff, 0x9f, 0xe9, 0x15, 0xd0, 0x46, 0xf3, 0xe4,
+	0xe8, 0x69, 0xf3, 0x45, 0xbd, 0x96, 0x8d, 0xfb, 0xd0, 0x06, 0x76, 0xcd, 0x01, 0xfe, 0x1c, 0x75,
+	0x0a, 0xc9, 0xaf, 0x7e, 0x50, 0x62, 0xda, 0xe9, 0xcb, 0x0b, 0x45, 0x7a, 0x75, 0xa1, 0x48, 0xbf,
+	0x5f, 0x28, 0xd2, 0x37, 0x97, 0x4a, 0xec, 0xd5, 0xa5, 0x12, 0xfb, 0xf5, 0x52, 0x89, 0xbd, 0x78,
+	0xf8, 0x4f, 0x53, 0x3c, 0x8d, 0xfc, 0x46, 0x12, 0x5c, 0x58, 0x1b, 0xe2, 0x97, 0xcc, 0x47, 0x7f,
+	0x0d, 0x00, 0xfb, 0x58, 0x0f, 0xfa, 0x49, 0x09, 0x00, 0x00,
 }
 
 func (m *BTCSpvProof) Marshal() (dAtA []byte, err error) {
@@ -675,6 +765,22 @@ func (m *BTCSpvProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InputBtcTransactions) > 0 {
+		for iNdEx := len(m.InputBtcTransactions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InputBtcTransactions[iNdEx])
+			copy(dAtA[i:], m.InputBtcTransactions[iNdEx])
+			i = encodeVarintBtccheckpoint(dAtA, i, uint64(len(m.InputBtcTransactions[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SpentBtcTransaction) > 0 {
+		i -= len(m.SpentBtcTransaction)
+		copy(dAtA[i:], m.SpentBtcTransaction)
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(len(m.SpentBtcTransaction)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ConfirmingBtcHeader != nil {
 		{
 			size := m.ConfirmingBtcHeader.Size()
@@ -806,6 +912,18 @@ func (m *TransactionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Fee != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SpentTransaction) > 0 {
+		i -= len(m.SpentTransaction)
+		copy(dAtA[i:], m.SpentTransaction)
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(len(m.SpentTransaction)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Proof) > 0 {
 		i -= len(m.Proof)
 		copy(dAtA[i:], m.Proof)
@@ -909,6 +1027,20 @@ func (m *EpochData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeReimbursement) > 0 {
+		for iNdEx := len(m.FeeReimbursement) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeReimbursement[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtccheckpoint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Status != 0 {
 		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.Status))
 		i--
@@ -988,6 +1120,25 @@ func (m *BTCCheckpointInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BestSubmissionFeeReimbursement) > 0 {
+		for iNdEx := len(m.BestSubmissionFeeReimbursement) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BestSubmissionFeeReimbursement[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtccheckpoint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.BestSubmissionBtcFee != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.BestSubmissionBtcFee))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.BestSubmissionVigilanteAddressList) > 0 {
 		for iNdEx := len(m.BestSubmissionVigilanteAddressList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1073,6 +1224,16 @@ func (m *BTCSpvProof) Size() (n int) {
 		l = m.ConfirmingBtcHeader.Size()
 		n += 1 + l + sovBtccheckpoint(uint64(l))
 	}
+	l = len(m.SpentBtcTransaction)
+	if l > 0 {
+		n += 1 + l + sovBtccheckpoint(uint64(l))
+	}
+	if len(m.InputBtcTransactions) > 0 {
+		for _, b := range m.InputBtcTransactions {
+			l = len(b)
+			n += 1 + l + sovBtccheckpoint(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1125,6 +1286,13 @@ func (m *TransactionInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBtccheckpoint(uint64(l))
 	}
+	l = len(m.SpentTransaction)
+	if l > 0 {
+		n += 1 + l + sovBtccheckpoint(uint64(l))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.Fee))
+	}
 	return n
 }
 
@@ -1165,6 +1333,12 @@ func (m *EpochData) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovBtccheckpoint(uint64(m.Status))
 	}
+	if len(m.FeeReimbursement) > 0 {
+		for _, e := range m.FeeReimbursement {
+			l = e.Size()
+			n += 1 + l + sovBtccheckpoint(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1213,6 +1387,15 @@ func (m *BTCCheckpointInfo) Size() (n int) {
 			n += 1 + l + sovBtccheckpoint(uint64(l))
 		}
 	}
+	if m.BestSubmissionBtcFee != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.BestSubmissionBtcFee))
+	}
+	if len(m.BestSubmissionFeeReimbursement) > 0 {
+		for _, e := range m.BestSubmissionFeeReimbursement {
+			l = e.Size()
+			n += 1 + l + sovBtccheckpoint(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1373,6 +1556,72 @@ func (m *BTCSpvProof) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentBtcTransaction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentBtcTransaction = append(m.SpentBtcTransaction[:0], dAtA[iNdEx:postIndex]...)
+			if m.SpentBtcTransaction == nil {
+				m.SpentBtcTransaction = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputBtcTransactions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputBtcTransactions = append(m.InputBtcTransactions, make([]byte, postIndex-iNdEx))
+			copy(m.InputBtcTransactions[len(m.InputBtcTransactions)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
@@ -1715,6 +1964,59 @@ func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
 				m.Proof = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentTransaction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentTransaction = append(m.SpentTransaction[:0], dAtA[iNdEx:postIndex]...)
+			if m.SpentTransaction == nil {
+				m.SpentTransaction = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
@@ -1957,6 +2259,40 @@ func (m *EpochData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeReimbursement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeReimbursement = append(m.FeeReimbursement, types.Coin{})
+			if err := m.FeeReimbursement[len(m.FeeReimbursement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
@@ -2266,6 +2602,59 @@ func (m *BTCCheckpointInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestSubmissionBtcFee", wireType)
+			}
+			m.BestSubmissionBtcFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestSubmissionBtcFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestSubmissionFeeReimbursement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BestSubmissionFeeReimbursement = append(m.BestSubmissionFeeReimbursement, types.Coin{})
+			if err := m.BestSubmissionFeeReimbursement[len(m.BestSubmissionFeeReimbursement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
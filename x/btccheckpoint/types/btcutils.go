@@ -8,6 +8,8 @@ import (
 
 	"github.com/babylonchain/babylon/types"
 	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
@@ -30,7 +32,8 @@ const (
 // - Bitcoin Header hash
 // - Bitcoin Transaction
 // - Bitcoin Transaction index in block
-// - Non-empty OpReturnData
+// - Non-empty OpReturnData, or non-empty EnvelopeData for checkpoints carried
+// by a single transaction
 type ParsedProof struct {
 	// keeping header hash to avoid recomputing it everytime
 	BlockHash        types.BTCHeaderHashBytes
@@ -38,6 +41,10 @@ type ParsedProof struct {
 	TransactionBytes []byte
 	TransactionIdx   uint32
 	OpReturnData     []byte
+	// data revealed in the envelope of the Taproot script path spend, together
+	// with the spent transaction authenticating it
+	EnvelopeData          []byte
+	SpentTransactionBytes []byte
 }
 
 // Concatenates and double hashes two provided inputs
@@ -232,6 +239,164 @@ func ExtractOpReturnData(tx *btcutil.Tx) []byte {
 	return opReturnData
 }
 
+// NewEnvelopeScript returns the tapscript that reveals the given data in an
+// envelope, i.e.,
+// <x-only pk> OP_CHECKSIG OP_FALSE OP_IF <data chunk>... OP_ENDIF
+// The data is split into chunks of at most 520 bytes, i.e., the maximum size
+// of a script element. The envelope is never executed, so the output
+// committing to this script can only be spent by the owner of pk.
+func NewEnvelopeScript(pk *btcec.PublicKey, data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, errors.New("envelope data should not be empty")
+	}
+
+	builder := txscript.NewScriptBuilder()
+	builder.AddData(schnorr.SerializePubKey(pk))
+	builder.AddOp(txscript.OP_CHECKSIG)
+	builder.AddOp(txscript.OP_FALSE)
+	builder.AddOp(txscript.OP_IF)
+	for start := 0; start < len(data); start += txscript.MaxScriptElementSize {
+		end := min(uint(start+txscript.MaxScriptElementSize), uint(len(data)))
+		builder.AddFullData(data[start:end])
+	}
+	builder.AddOp(txscript.OP_ENDIF)
+
+	return builder.Script()
+}
+
+// extractEnvelope returns the data within the first envelope of the given
+// tapscript, i.e., the concatenation of the data pushes between OP_FALSE OP_IF
+// and OP_ENDIF
+func extractEnvelope(script []byte) ([]byte, error) {
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+
+	var prevOp byte = txscript.OP_CHECKSIG
+	for tokenizer.Next() {
+		op := tokenizer.Opcode()
+		if prevOp == txscript.OP_FALSE && op == txscript.OP_IF {
+			break
+		}
+		prevOp = op
+	}
+	if tokenizer.Done() {
+		return nil, errors.New("script does not contain an envelope")
+	}
+
+	envelopeData := []byte{}
+	for tokenizer.Next() {
+		op := tokenizer.Opcode()
+		if op == txscript.OP_ENDIF {
+			return envelopeData, nil
+		}
+		// only data pushes are allowed within the envelope
+		if op > txscript.OP_PUSHDATA4 {
+			return nil, fmt.Errorf("envelope contains a non-push opcode %d", op)
+		}
+		envelopeData = append(envelopeData, tokenizer.Data()...)
+	}
+	if err := tokenizer.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, errors.New("envelope is not terminated")
+}
+
+// ExtractEnvelopeData returns the data revealed in the envelope of the
+// tapscript through which tx spends a Taproot output of spentTx. As the
+// witness is not committed by the hash of tx, the tapscript is verified
+// against the Taproot output key of the spent output, whose transaction is in
+// turn committed by the outpoint of tx. This ensures the envelope data cannot
+// be forged by replacing the witness of tx.
+func ExtractEnvelopeData(tx *btcutil.Tx, spentTx *btcutil.Tx) ([]byte, error) {
+	spentTxHash := spentTx.Hash()
+	spentOutputs := spentTx.MsgTx().TxOut
+
+	for _, txIn := range tx.MsgTx().TxIn {
+		if !txIn.PreviousOutPoint.Hash.IsEqual(spentTxHash) {
+			continue
+		}
+		outIdx := txIn.PreviousOutPoint.Index
+		if int(outIdx) >= len(spentOutputs) {
+			return nil, fmt.Errorf("spent output index %d is out of range", outIdx)
+		}
+		pkScript := spentOutputs[outIdx].PkScript
+		if !txscript.IsPayToTaproot(pkScript) {
+			continue
+		}
+
+		// script path spend witness is [...args, script, control block, (annex)]
+		witness := txIn.Witness
+		if len(witness) > 0 {
+			lastElement := witness[len(witness)-1]
+			if len(witness) > 1 && len(lastElement) > 0 && lastElement[0] == txscript.TaprootAnnexTag {
+				witness = witness[:len(witness)-1]
+			}
+		}
+		if len(witness) < 2 {
+			// key path spend does not reveal any script
+			continue
+		}
+		script := witness[len(witness)-2]
+		controlBlock, err := txscript.ParseControlBlock(witness[len(witness)-1])
+		if err != nil {
+			continue
+		}
+		if controlBlock.LeafVersion != txscript.BaseLeafVersion {
+			continue
+		}
+		// the witness program of a Taproot output is the 32 bytes after
+		// OP_1 OP_DATA_32
+		if err := txscript.VerifyTaprootLeafCommitment(controlBlock, pkScript[2:], script); err != nil {
+			continue
+		}
+
+		envelopeData, err := extractEnvelope(script)
+		if err != nil || len(envelopeData) == 0 {
+			continue
+		}
+		return envelopeData, nil
+	}
+
+	return nil, errors.New("transaction does not reveal envelope data of the spent transaction")
+}
+
+// GetTransactionFee returns the fee in satoshis paid by the given transaction,
+// i.e., the total value of the outputs spent by its inputs minus the total
+// value of its outputs. The transactions whose outputs are spent by tx have to
+// be among inputTxs. As they are looked up by the hashes committed in the
+// inputs of tx, the values of the spent outputs cannot be forged
+func GetTransactionFee(tx *btcutil.Tx, inputTxs []*btcutil.Tx) (uint64, error) {
+	txsByHash := make(map[chainhash.Hash]*btcutil.Tx, len(inputTxs))
+	for _, inputTx := range inputTxs {
+		txsByHash[*inputTx.Hash()] = inputTx
+	}
+
+	var inputValue int64
+	for _, txIn := range tx.MsgTx().TxIn {
+		prevOut := txIn.PreviousOutPoint
+		inputTx, ok := txsByHash[prevOut.Hash]
+		if !ok {
+			return 0, fmt.Errorf("transaction spent by input %s is not provided", prevOut.String())
+		}
+		spentOutputs := inputTx.MsgTx().TxOut
+		if int(prevOut.Index) >= len(spentOutputs) {
+			return 0, fmt.Errorf("spent output index %d is out of range", prevOut.Index)
+		}
+		inputValue += spentOutputs[prevOut.Index].Value
+	}
+
+	var outputValue int64
+	for _, txOut := range tx.MsgTx().TxOut {
+		outputValue += txOut.Value
+	}
+
+	if inputValue < outputValue {
+		return 0, fmt.Errorf("total input value %d is smaller than total output value %d", inputValue, outputValue)
+	}
+
+	return uint64(inputValue - outputValue), nil
+}
+
 func ParseTransaction(bytes []byte) (*btcutil.Tx, error) {
 	tx, e := btcutil.NewTxFromBytes(bytes)
 
@@ -251,6 +416,65 @@ func ParseTransaction(bytes []byte) (*btcutil.Tx, error) {
 // TODO define domain errors with nice error messages
 // TODO add some tests for the proof validation
 func ParseProof(
+	btcTransaction []byte,
+	transactionIndex uint32,
+	merkleProof []byte,
+	btcHeader *types.BTCHeaderBytes,
+	powLimit *big.Int) (*ParsedProof, error) {
+	parsedProof, e := parseInclusionProof(btcTransaction, transactionIndex, merkleProof, btcHeader, powLimit)
+
+	if e != nil {
+		return nil, e
+	}
+
+	opReturnData := ExtractOpReturnData(parsedProof.Transaction)
+
+	if len(opReturnData) == 0 {
+		return nil, fmt.Errorf("provided transaction should provide op return data")
+	}
+
+	parsedProof.OpReturnData = opReturnData
+
+	return parsedProof, nil
+}
+
+// ParseEnvelopeProof parses and validates the proof of a transaction which
+// reveals data in the envelope of a Taproot script path spend of a output of
+// spentTransaction
+func ParseEnvelopeProof(
+	btcTransaction []byte,
+	transactionIndex uint32,
+	merkleProof []byte,
+	btcHeader *types.BTCHeaderBytes,
+	spentTransaction []byte,
+	powLimit *big.Int) (*ParsedProof, error) {
+	parsedProof, e := parseInclusionProof(btcTransaction, transactionIndex, merkleProof, btcHeader, powLimit)
+
+	if e != nil {
+		return nil, e
+	}
+
+	spentTx, e := ParseTransaction(spentTransaction)
+
+	if e != nil {
+		return nil, fmt.Errorf("invalid spent transaction: %w", e)
+	}
+
+	envelopeData, e := ExtractEnvelopeData(parsedProof.Transaction, spentTx)
+
+	if e != nil {
+		return nil, e
+	}
+
+	parsedProof.EnvelopeData = envelopeData
+	parsedProof.SpentTransactionBytes = spentTransaction
+
+	return parsedProof, nil
+}
+
+// parseInclusionProof parses the transaction and verifies that it is included
+// in the block of the given header
+func parseInclusionProof(
 	btcTransaction []byte,
 	transactionIndex uint32,
 	merkleProof []byte,
@@ -276,19 +500,12 @@ func ParseProof(
 		return nil, fmt.Errorf("header failed validation due to failed proof")
 	}
 
-	opReturnData := ExtractOpReturnData(tx)
-
-	if len(opReturnData) == 0 {
-		return nil, fmt.Errorf("provided transaction should provide op return data")
-	}
-
 	bh := header.BlockHash()
 	parsedProof := &ParsedProof{
 		BlockHash:        types.NewBTCHeaderHashBytesFromChainhash(&bh),
 		Transaction:      tx,
 		TransactionBytes: btcTransaction,
 		TransactionIdx:   transactionIndex,
-		OpReturnData:     opReturnData,
 	}
 
 	return parsedProof, nil
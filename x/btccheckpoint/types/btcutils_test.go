@@ -3,12 +3,16 @@ package types_test
 import (
 	"bytes"
 	"encoding/hex"
+	"math/rand"
 	"testing"
 
+	"github.com/babylonchain/babylon/testutil/datagen"
 	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	"github.com/btcsuite/btcd/btcutil"
 	btcchaincfg "github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
 )
 
 // Sanity test checking mostly btcd code, that we can realy parse bitcoin transaction
@@ -164,3 +168,33 @@ func TestParsingCorrectBtcProofs(t *testing.T) {
 		}
 	}
 }
+
+func FuzzParsingEnvelopeProof(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		babylonData := datagen.GenRandomByteArray(r, datagen.RandomInt(r, 2000)+1)
+		commitTx, revealTx := datagen.CreateEnvelopeTransactions(r, babylonData)
+
+		// the envelope data is revealed by the spend of the commit tx
+		envelopeData, err := btcctypes.ExtractEnvelopeData(btcutil.NewTx(revealTx), btcutil.NewTx(commitTx))
+		require.NoError(t, err)
+		require.Equal(t, babylonData, envelopeData)
+
+		// the envelope data cannot be extracted against another tx
+		_, err = btcctypes.ExtractEnvelopeData(btcutil.NewTx(revealTx), btcutil.NewTx(datagen.GenRandomTx(r)))
+		require.Error(t, err)
+
+		// the envelope data cannot be forged by replacing the witness, which
+		// is not committed by the tx hash
+		_, pk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		forgedScript, err := btcctypes.NewEnvelopeScript(pk, datagen.GenRandomByteArray(r, 146))
+		require.NoError(t, err)
+		forgedTx := revealTx.Copy()
+		forgedTx.TxIn[0].Witness[1] = forgedScript
+		require.Equal(t, revealTx.TxHash(), forgedTx.TxHash())
+		_, err = btcctypes.ExtractEnvelopeData(btcutil.NewTx(forgedTx), btcutil.NewTx(commitTx))
+		require.Error(t, err)
+	})
+}
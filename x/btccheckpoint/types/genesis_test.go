@@ -25,6 +25,8 @@ func TestGenesisState_Validate(t *testing.T) {
 					BtcConfirmationDepth:          124,
 					CheckpointFinalizationTimeout: 12222,
 					CheckpointTag:                 types.DefaultCheckpointTag,
+					FeeReimbursementRate:          types.DefaultParams().FeeReimbursementRate,
+					MaxFeeReimbursement:           types.DefaultParams().MaxFeeReimbursement,
 				},
 			},
 			valid: true,
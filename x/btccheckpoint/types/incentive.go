@@ -37,6 +37,9 @@ type RewardDistInfo struct {
 	Best *CheckpointAddressPair
 	// Others is a list of other address pairs
 	Others []*CheckpointAddressPair
+	// BestFeeReimbursement is the reimbursement of BTC fees requested for the
+	// submitter of the best checkpoint submission
+	BestFeeReimbursement sdk.Coins
 }
 
 func NewRewardDistInfo(best *CheckpointAddressPair, others ...*CheckpointAddressPair) *RewardDistInfo {
@@ -6,6 +6,7 @@ import (
 
 	txformat "github.com/babylonchain/babylon/btctxformatter"
 	bbn "github.com/babylonchain/babylon/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type MockBTCLightClientKeeper struct {
@@ -95,5 +96,6 @@ func (ck MockCheckpointingKeeper) SetCheckpointFinalized(ctx context.Context, ep
 func (ck MockCheckpointingKeeper) SetCheckpointForgotten(ctx context.Context, epoch uint64) {
 }
 
-func (ik *MockIncentiveKeeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rewardDistInfo *RewardDistInfo) {
+func (ik *MockIncentiveKeeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rewardDistInfo *RewardDistInfo) sdk.Coins {
+	return rewardDistInfo.BestFeeReimbursement
 }
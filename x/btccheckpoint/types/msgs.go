@@ -6,6 +6,7 @@ import (
 	"math/big"
 
 	txformat "github.com/babylonchain/babylon/btctxformatter"
+	"github.com/btcsuite/btcd/btcutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -84,6 +85,109 @@ func ParseTwoProofs(
 	return &sub, nil
 }
 
+// ParseSingleProof Parse and Validate transaction which should reveal the
+// whole checkpoint in the envelope of a Taproot script path spend, i.e., a
+// checkpoint of the txformat.SingleTxVersion format.
+func ParseSingleProof(
+	submitter sdk.AccAddress,
+	proof *BTCSpvProof,
+	powLimit *big.Int,
+	expectedTag txformat.BabylonTag) (*RawCheckpointSubmission, error) {
+	if proof == nil {
+		return nil, errors.New("proof can't be nil")
+	}
+
+	if len(proof.SpentBtcTransaction) == 0 {
+		return nil, errors.New("single transaction checkpoint should provide the spent transaction")
+	}
+
+	parsedProof, err := ParseEnvelopeProof(
+		proof.BtcTransaction,
+		proof.BtcTransactionIndex,
+		proof.MerkleNodes,
+		proof.ConfirmingBtcHeader,
+		proof.SpentBtcTransaction,
+		powLimit,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rawCkptData, err := txformat.GetSingleTxCheckpointData(expectedTag, parsedProof.EnvelopeData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rawCheckpoint, err := txformat.DecodeRawCheckpoint(txformat.SingleTxVersion, rawCkptData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sub := NewSingleTxCheckpointSubmission(submitter, *parsedProof, *rawCheckpoint)
+
+	return &sub, nil
+}
+
+// GetBTCFees returns the BTC fee in satoshis paid by the transaction of each
+// proof, in the same order as the proofs. The transactions spent by the
+// transaction of a proof are looked up in its input transactions, its spent
+// transaction and the transactions of the other proofs. The fee of a
+// transaction is zero if it cannot be computed and no input transactions are
+// provided for it
+func (m *MsgInsertBTCSpvProof) GetBTCFees() ([]uint64, error) {
+	proofTxs := make([]*btcutil.Tx, len(m.Proofs))
+	for i, proof := range m.Proofs {
+		tx, err := ParseTransaction(proof.BtcTransaction)
+		if err != nil {
+			return nil, err
+		}
+		proofTxs[i] = tx
+	}
+
+	fees := make([]uint64, len(m.Proofs))
+	for i, proof := range m.Proofs {
+		inputTxs := []*btcutil.Tx{}
+		for _, txBytes := range proof.InputBtcTransactions {
+			tx, err := ParseTransaction(txBytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid input transaction: %w", err)
+			}
+			inputTxs = append(inputTxs, tx)
+		}
+		if len(proof.SpentBtcTransaction) > 0 {
+			spentTx, err := ParseTransaction(proof.SpentBtcTransaction)
+			if err != nil {
+				return nil, fmt.Errorf("invalid spent transaction: %w", err)
+			}
+			inputTxs = append(inputTxs, spentTx)
+		}
+		for j, tx := range proofTxs {
+			if j != i {
+				inputTxs = append(inputTxs, tx)
+			}
+		}
+
+		fee, err := GetTransactionFee(proofTxs[i], inputTxs)
+		if err != nil {
+			// the fee is unknown rather than invalid if the submitter does
+			// not provide any input transactions
+			if len(proof.InputBtcTransactions) == 0 {
+				continue
+			}
+			return nil, err
+		}
+		fees[i] = fee
+	}
+
+	return fees, nil
+}
+
+// ParseSubmission parses the submission as a checkpoint carried by a single
+// transaction if it contains one proof, and as a checkpoint split into two
+// transactions otherwise
 func ParseSubmission(
 	m *MsgInsertBTCSpvProof,
 	powLimit *big.Int,
@@ -98,7 +202,13 @@ func ParseSubmission(
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid submitter address: %s", err)
 	}
 
-	sub, err := ParseTwoProofs(address, m.Proofs, powLimit, expectedTag)
+	var sub *RawCheckpointSubmission
+
+	if len(m.Proofs) == 1 {
+		sub, err = ParseSingleProof(address, m.Proofs[0], powLimit, expectedTag)
+	} else {
+		sub, err = ParseTwoProofs(address, m.Proofs, powLimit, expectedTag)
+	}
 
 	if err != nil {
 		return nil, err
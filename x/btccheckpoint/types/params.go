@@ -4,6 +4,10 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/babylonchain/babylon/app/params"
 	txformat "github.com/babylonchain/babylon/btctxformatter"
 )
 
@@ -14,11 +18,19 @@ const (
 )
 
 // NewParams creates a new Params instance
-func NewParams(btcConfirmationDepth uint64, checkpointFinalizationTimeout uint64, checkpointTag string) Params {
+func NewParams(
+	btcConfirmationDepth uint64,
+	checkpointFinalizationTimeout uint64,
+	checkpointTag string,
+	feeReimbursementRate sdkmath.LegacyDec,
+	maxFeeReimbursement sdk.Coin,
+) Params {
 	return Params{
 		BtcConfirmationDepth:          btcConfirmationDepth,
 		CheckpointFinalizationTimeout: checkpointFinalizationTimeout,
 		CheckpointTag:                 checkpointTag,
+		FeeReimbursementRate:          feeReimbursementRate,
+		MaxFeeReimbursement:           maxFeeReimbursement,
 	}
 }
 
@@ -28,6 +40,9 @@ func DefaultParams() Params {
 		DefaultBtcConfirmationDepth,
 		DefaultCheckpointFinalizationTimeout,
 		DefaultCheckpointTag,
+		// the reimbursement of BTC fees is disabled by default
+		sdkmath.LegacyZeroDec(),
+		sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.ZeroInt()),
 	)
 }
 
@@ -48,6 +63,14 @@ func (p Params) Validate() error {
 		return fmt.Errorf("BtcConfirmationDepth should be smaller than CheckpointFinalizationTimeout")
 	}
 
+	if err := validateFeeReimbursementRate(p.FeeReimbursementRate); err != nil {
+		return err
+	}
+
+	if err := p.MaxFeeReimbursement.Validate(); err != nil {
+		return fmt.Errorf("invalid MaxFeeReimbursement: %w", err)
+	}
+
 	return nil
 }
 
@@ -96,3 +119,23 @@ func validateCheckpointTag(i interface{}) error {
 
 	return nil
 }
+
+func validateFeeReimbursementRate(rate sdkmath.LegacyDec) error {
+	if rate.IsNil() {
+		return fmt.Errorf("FeeReimbursementRate should not be nil")
+	}
+
+	if rate.IsNegative() {
+		return fmt.Errorf("FeeReimbursementRate should not be negative: %s", rate)
+	}
+
+	return nil
+}
+
+// GetFeeReimbursement returns the reimbursement for the given BTC fee in
+// satoshis, i.e., the fee multiplied by the reimbursement rate, truncated and
+// capped by the maximum reimbursement
+func (p Params) GetFeeReimbursement(feeSat uint64) sdk.Coin {
+	amount := p.FeeReimbursementRate.MulInt(sdkmath.NewIntFromUint64(feeSat)).TruncateInt()
+	return sdk.NewCoin(p.MaxFeeReimbursement.Denom, sdkmath.MinInt(amount, p.MaxFeeReimbursement.Amount))
+}
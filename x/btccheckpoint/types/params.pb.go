@@ -4,7 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -39,6 +42,15 @@ type Params struct {
 	// 4byte tag in hex format, required to be present in the OP_RETURN transaction
 	// related to babylon
 	CheckpointTag string `protobuf:"bytes,3,opt,name=checkpoint_tag,json=checkpointTag,proto3" json:"checkpoint_tag,omitempty" yaml:"checkpoint_tag"`
+	// fee_reimbursement_rate is the amount of the denom of
+	// max_fee_reimbursement reimbursed per satoshi of BTC fee paid by the best
+	// submission of a finalized epoch. Zero disables the reimbursement
+	FeeReimbursementRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fee_reimbursement_rate,json=feeReimbursementRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_reimbursement_rate"`
+	// max_fee_reimbursement is the maximum reimbursement of BTC fees for the
+	// best submission of an epoch. The reimbursement is paid from the fee
+	// collector on top of the BTC timestamping rewards, and is further bounded
+	// by the fee collector's balance
+	MaxFeeReimbursement types.Coin `protobuf:"bytes,5,opt,name=max_fee_reimbursement,json=maxFeeReimbursement,proto3" json:"max_fee_reimbursement"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -95,6 +107,13 @@ func (m *Params) GetCheckpointTag() string {
 	return ""
 }
 
+func (m *Params) GetMaxFeeReimbursement() types.Coin {
+	if m != nil {
+		return m.MaxFeeReimbursement
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.btccheckpoint.v1.Params")
 }
@@ -104,26 +123,35 @@ func init() {
 }
 
 var fileDescriptor_5445a19005ae983c = []byte{
-	// 306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0x4e, 0xce, 0x48, 0x4d, 0xce, 0x2e, 0xc8, 0xcf, 0xcc,
-	0x2b, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x80, 0x2a, 0xd3, 0x43, 0x51, 0xa6, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0xcd, 0x65, 0xe2, 0x62, 0x0b, 0x00, 0x1b,
-	0x20, 0x14, 0xce, 0x25, 0x96, 0x54, 0x92, 0x1c, 0x9f, 0x9c, 0x9f, 0x97, 0x96, 0x59, 0x94, 0x9b,
-	0x58, 0x92, 0x99, 0x9f, 0x17, 0x9f, 0x92, 0x5a, 0x50, 0x92, 0x21, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0xe2, 0xa4, 0xf8, 0xe9, 0x9e, 0xbc, 0x6c, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x76, 0x75, 0x4a,
-	0x41, 0x22, 0x49, 0x25, 0xc9, 0xce, 0x48, 0xe2, 0x2e, 0x20, 0x61, 0xa1, 0x22, 0x2e, 0x79, 0x84,
-	0x53, 0xe2, 0xd3, 0x32, 0xf3, 0x12, 0x73, 0x32, 0xab, 0x20, 0xfa, 0x4a, 0x32, 0x73, 0x53, 0xf3,
-	0x4b, 0x4b, 0x24, 0x98, 0xc0, 0x36, 0x68, 0x7d, 0xba, 0x27, 0xaf, 0x06, 0xb1, 0x81, 0x80, 0x06,
-	0xa5, 0x20, 0x59, 0x84, 0x0a, 0x37, 0x24, 0x05, 0x21, 0x10, 0x79, 0x21, 0x07, 0x2e, 0x3e, 0x24,
-	0x23, 0x4a, 0x12, 0xd3, 0x25, 0x98, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x3f, 0xdd, 0x93, 0x17,
-	0xc5, 0xb0, 0xa2, 0x24, 0x31, 0x5d, 0x29, 0x88, 0x17, 0x21, 0x10, 0x92, 0x98, 0x6e, 0xc5, 0xf2,
-	0x62, 0x81, 0x3c, 0xa3, 0x93, 0xff, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0x99, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x03, 0x3d, 0x39,
-	0x23, 0x31, 0x33, 0x0f, 0xc6, 0xd1, 0xaf, 0x40, 0x8b, 0xaa, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24,
-	0x36, 0x70, 0xb8, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x78, 0xcb, 0x81, 0xd0, 0x01,
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x8a, 0xd3, 0x50,
+	0x14, 0x86, 0x1b, 0xa7, 0x0e, 0x18, 0xd1, 0x45, 0xec, 0x0c, 0xe9, 0xc8, 0x24, 0x35, 0xa0, 0x14,
+	0xc1, 0x5c, 0xa2, 0xb8, 0x99, 0x95, 0x74, 0xca, 0xac, 0x04, 0x25, 0x0e, 0x08, 0x6e, 0xc2, 0xb9,
+	0x77, 0x4e, 0x93, 0xcb, 0xf4, 0xe6, 0x86, 0xe4, 0xb4, 0xb4, 0x3e, 0x85, 0x8f, 0xe0, 0x43, 0xf8,
+	0x10, 0xb3, 0x1c, 0x5c, 0x89, 0x42, 0x90, 0x76, 0xe3, 0xba, 0x4f, 0x20, 0x4d, 0x22, 0xad, 0x53,
+	0xc1, 0x5d, 0xce, 0xf9, 0xbf, 0xf3, 0xff, 0x49, 0xce, 0x31, 0x1f, 0x73, 0xe0, 0xf3, 0xb1, 0x4e,
+	0x19, 0x27, 0x21, 0x12, 0x14, 0x97, 0x99, 0x96, 0x29, 0xb1, 0x69, 0xc0, 0x32, 0xc8, 0x41, 0x15,
+	0x7e, 0x96, 0x6b, 0xd2, 0x96, 0xdd, 0x60, 0xfe, 0x5f, 0x98, 0x3f, 0x0d, 0x8e, 0x3a, 0xb1, 0x8e,
+	0x75, 0x05, 0xb1, 0xf5, 0x53, 0xcd, 0x1f, 0x75, 0x85, 0x2e, 0x94, 0x2e, 0xa2, 0x5a, 0xa8, 0x8b,
+	0x46, 0x72, 0xea, 0x8a, 0x71, 0x28, 0x90, 0x4d, 0x03, 0x8e, 0x04, 0x01, 0x13, 0x5a, 0xa6, 0xb5,
+	0xee, 0xfd, 0xd8, 0x33, 0xf7, 0xdf, 0x56, 0xd9, 0xd6, 0x7b, 0xf3, 0x90, 0x93, 0x88, 0x84, 0x4e,
+	0x47, 0x32, 0x57, 0x40, 0x52, 0xa7, 0xd1, 0x05, 0x66, 0x94, 0xd8, 0x46, 0xcf, 0xe8, 0xb7, 0x07,
+	0x8f, 0x56, 0xa5, 0x7b, 0x3c, 0x07, 0x35, 0x3e, 0xf1, 0xfe, 0xcd, 0x79, 0x61, 0x87, 0x93, 0x38,
+	0xdd, 0xea, 0x0f, 0xd7, 0x6d, 0x2b, 0x37, 0xdd, 0xcd, 0x57, 0x44, 0x23, 0x99, 0xc2, 0x58, 0x7e,
+	0xac, 0xe7, 0x48, 0x2a, 0xd4, 0x13, 0xb2, 0x6f, 0x55, 0x09, 0x4f, 0x57, 0xa5, 0xfb, 0xa4, 0x4e,
+	0xf8, 0xcf, 0x80, 0x17, 0x1e, 0x6f, 0x88, 0xb3, 0x2d, 0xe0, 0xbc, 0xd6, 0xad, 0x57, 0xe6, 0xfd,
+	0x2d, 0x0b, 0x82, 0xd8, 0xde, 0xeb, 0x19, 0xfd, 0x3b, 0x83, 0xee, 0xaa, 0x74, 0x0f, 0x76, 0x22,
+	0x08, 0x62, 0x2f, 0xbc, 0xb7, 0x69, 0x9c, 0x43, 0x6c, 0xc5, 0xe6, 0xe1, 0x08, 0x31, 0xca, 0x51,
+	0x2a, 0x3e, 0xc9, 0x0b, 0x54, 0x98, 0x52, 0x94, 0x03, 0xa1, 0xdd, 0xae, 0x9c, 0x82, 0xab, 0xd2,
+	0x6d, 0x7d, 0x2f, 0xdd, 0x87, 0xf5, 0x1f, 0x2e, 0x2e, 0x2e, 0x7d, 0xa9, 0x99, 0x02, 0x4a, 0xfc,
+	0xd7, 0x18, 0x83, 0x98, 0x0f, 0x51, 0x7c, 0xfd, 0xf2, 0xcc, 0x6c, 0xd6, 0x31, 0x44, 0x11, 0x76,
+	0x46, 0x88, 0xe1, 0xb6, 0x5f, 0x08, 0x84, 0xd6, 0x3b, 0xf3, 0x40, 0xc1, 0x2c, 0xda, 0x09, 0xb3,
+	0x6f, 0xf7, 0x8c, 0xfe, 0xdd, 0xe7, 0x5d, 0xbf, 0x71, 0x58, 0xaf, 0xd0, 0x6f, 0x56, 0xe8, 0x9f,
+	0x6a, 0x99, 0x0e, 0xda, 0xeb, 0x57, 0x08, 0x1f, 0x28, 0x98, 0x9d, 0xdd, 0x30, 0x3e, 0x69, 0xff,
+	0xfa, 0xec, 0x1a, 0x83, 0x37, 0x57, 0x0b, 0xc7, 0xb8, 0x5e, 0x38, 0xc6, 0xcf, 0x85, 0x63, 0x7c,
+	0x5a, 0x3a, 0xad, 0xeb, 0xa5, 0xd3, 0xfa, 0xb6, 0x74, 0x5a, 0x1f, 0x5e, 0xc6, 0x92, 0x92, 0x09,
+	0xf7, 0x85, 0x56, 0xac, 0xb9, 0x36, 0x91, 0x80, 0x4c, 0xff, 0x14, 0x6c, 0x76, 0xe3, 0x46, 0x69,
+	0x9e, 0x61, 0xc1, 0xf7, 0xab, 0xab, 0x79, 0xf1, 0x7b, 0x00, 0xee, 0x26, 0xbc, 0xc6, 0xc9, 0x02,
 	0x00, 0x00,
 }
 
@@ -155,6 +183,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.CheckpointTag != that1.CheckpointTag {
 		return false
 	}
+	if !this.FeeReimbursementRate.Equal(that1.FeeReimbursementRate) {
+		return false
+	}
+	if !this.MaxFeeReimbursement.Equal(&that1.MaxFeeReimbursement) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -177,6 +211,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.MaxFeeReimbursement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FeeReimbursementRate.Size()
+		i -= size
+		if _, err := m.FeeReimbursementRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.CheckpointTag) > 0 {
 		i -= len(m.CheckpointTag)
 		copy(dAtA[i:], m.CheckpointTag)
@@ -224,6 +278,10 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = m.FeeReimbursementRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxFeeReimbursement.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -332,6 +390,73 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.CheckpointTag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeReimbursementRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeReimbursementRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeReimbursement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeeReimbursement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
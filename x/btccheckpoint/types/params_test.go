@@ -3,6 +3,9 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/babylonchain/babylon/x/btccheckpoint/types"
 	"github.com/stretchr/testify/require"
 )
@@ -19,3 +22,23 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestGetFeeReimbursement(t *testing.T) {
+	params := types.DefaultParams()
+
+	// the reimbursement is disabled by default
+	require.True(t, params.GetFeeReimbursement(1000).IsZero())
+
+	params.FeeReimbursementRate = sdkmath.LegacyNewDecWithPrec(15, 1) // 1.5
+	params.MaxFeeReimbursement = sdk.NewInt64Coin(params.MaxFeeReimbursement.Denom, 1000)
+	require.NoError(t, params.Validate())
+
+	// the reimbursement is truncated
+	require.Equal(t, int64(1), params.GetFeeReimbursement(1).Amount.Int64())
+	require.Equal(t, int64(150), params.GetFeeReimbursement(100).Amount.Int64())
+	// the reimbursement is capped
+	require.Equal(t, int64(1000), params.GetFeeReimbursement(1000).Amount.Int64())
+
+	params.FeeReimbursementRate = sdkmath.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+}
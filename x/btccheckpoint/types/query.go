@@ -15,6 +15,7 @@ func (ti *TransactionInfo) ToResponse() *TransactionInfoResponse {
 		Hash:        ti.Key.Hash.MarshalHex(),
 		Transaction: hex.EncodeToString(ti.Transaction),
 		Proof:       hex.EncodeToString(ti.Proof),
+		Fee:         ti.Fee,
 	}
 }
 
@@ -27,18 +28,25 @@ func (ca *CheckpointAddresses) ToResponse() *CheckpointAddressesResponse {
 }
 
 // NewSubmissionKeyResponse parses a SubmissionKey into a query response submission key struct.
+// The second tx fields are empty for a checkpoint carried by a single transaction.
 func NewSubmissionKeyResponse(sk SubmissionKey) (skr *SubmissionKeyResponse, err error) {
-	if len(sk.Key) != 2 {
-		return nil, status.Errorf(codes.Internal, "bad submission key %+v, does not have 2 keys", sk)
+	switch len(sk.Key) {
+	case 1:
+		return &SubmissionKeyResponse{
+			FirstTxBlockHash: sk.Key[0].Hash.MarshalHex(),
+			FirstTxIndex:     sk.Key[0].Index,
+		}, nil
+	case 2:
+		k1, k2 := sk.Key[0], sk.Key[1]
+		return &SubmissionKeyResponse{
+			FirstTxBlockHash:  k1.Hash.MarshalHex(),
+			FirstTxIndex:      k1.Index,
+			SecondTxBlockHash: k2.Hash.MarshalHex(),
+			SecondTxIndex:     k2.Index,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "bad submission key %+v, does not have 1 or 2 keys", sk)
 	}
-
-	k1, k2 := sk.Key[0], sk.Key[1]
-	return &SubmissionKeyResponse{
-		FirstTxBlockHash:  k1.Hash.MarshalHex(),
-		FirstTxIndex:      k1.Index,
-		SecondTxBlockHash: k2.Hash.MarshalHex(),
-		SecondTxIndex:     k2.Index,
-	}, nil
 }
 
 // ToResponse parses a BTCCheckpointInfo into a query response for btc checkpoint info struct.
@@ -58,5 +66,7 @@ func (b BTCCheckpointInfo) ToResponse() *BTCCheckpointInfoResponse {
 		BestSubmissionBtcBlockHash:         b.BestSubmissionBtcBlockHash.MarshalHex(),
 		BestSubmissionTransactions:         bestSubTxs,
 		BestSubmissionVigilanteAddressList: bestSubVigAddrs,
+		BestSubmissionBtcFee:               b.BestSubmissionBtcFee,
+		BestSubmissionFeeReimbursement:     b.BestSubmissionFeeReimbursement,
 	}
 }
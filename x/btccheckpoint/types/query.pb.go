@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -418,6 +420,11 @@ type BTCCheckpointInfoResponse struct {
 	BestSubmissionTransactions []*TransactionInfoResponse `protobuf:"bytes,4,rep,name=best_submission_transactions,json=bestSubmissionTransactions,proto3" json:"best_submission_transactions,omitempty"`
 	// list of vigilantes' addresses of the best submission
 	BestSubmissionVigilanteAddressList []*CheckpointAddressesResponse `protobuf:"bytes,5,rep,name=best_submission_vigilante_address_list,json=bestSubmissionVigilanteAddressList,proto3" json:"best_submission_vigilante_address_list,omitempty"`
+	// total BTC fee in satoshis paid by the transactions of the best submission
+	BestSubmissionBtcFee uint64 `protobuf:"varint,6,opt,name=best_submission_btc_fee,json=bestSubmissionBtcFee,proto3" json:"best_submission_btc_fee,omitempty"`
+	// reimbursement of BTC fees paid to the submitter of the best submission,
+	// set once the epoch is finalized
+	BestSubmissionFeeReimbursement github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=best_submission_fee_reimbursement,json=bestSubmissionFeeReimbursement,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"best_submission_fee_reimbursement"`
 }
 
 func (m *BTCCheckpointInfoResponse) Reset()         { *m = BTCCheckpointInfoResponse{} }
@@ -488,6 +495,20 @@ func (m *BTCCheckpointInfoResponse) GetBestSubmissionVigilanteAddressList() []*C
 	return nil
 }
 
+func (m *BTCCheckpointInfoResponse) GetBestSubmissionBtcFee() uint64 {
+	if m != nil {
+		return m.BestSubmissionBtcFee
+	}
+	return 0
+}
+
+func (m *BTCCheckpointInfoResponse) GetBestSubmissionFeeReimbursement() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BestSubmissionFeeReimbursement
+	}
+	return nil
+}
+
 // TransactionInfoResponse is the info of a tx on Bitcoin,
 // including
 // - the position of the tx on BTC blockchain
@@ -502,6 +523,8 @@ type TransactionInfoResponse struct {
 	Transaction string `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
 	// proof is the Merkle proof that this tx is included in the position in `key`
 	Proof string `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	// fee is the BTC fee in satoshis paid by the transaction, or zero if unknown
+	Fee uint64 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
 func (m *TransactionInfoResponse) Reset()         { *m = TransactionInfoResponse{} }
@@ -565,6 +588,13 @@ func (m *TransactionInfoResponse) GetProof() string {
 	return ""
 }
 
+func (m *TransactionInfoResponse) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
 // CheckpointAddressesResponse contains the addresses of the submitter and reporter of a
 // given checkpoint
 type CheckpointAddressesResponse struct {
@@ -720,67 +750,73 @@ func init() {
 }
 
 var fileDescriptor_6b9a2f46ada7d854 = []byte{
-	// 949 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x37, 0x4e, 0x94, 0x7d, 0x69, 0xa1, 0x9d, 0x2e, 0x62, 0xb3, 0x09, 0xdb, 0xad, 0xd5,
-	0xa6, 0x11, 0x22, 0xb6, 0xb6, 0xa1, 0x2d, 0x15, 0x08, 0x89, 0x8d, 0x68, 0xa9, 0x40, 0x10, 0xdc,
-	0xc0, 0x81, 0x8b, 0x35, 0xf6, 0x4e, 0xec, 0x51, 0x76, 0x3d, 0xae, 0x67, 0x36, 0xca, 0xaa, 0xe2,
-	0xc2, 0x0d, 0x71, 0x00, 0x89, 0xbf, 0xc1, 0x11, 0x6e, 0x08, 0x89, 0x03, 0x52, 0x25, 0x2e, 0x15,
-	0x5c, 0x38, 0x21, 0x94, 0xf0, 0x43, 0x2a, 0xcf, 0xcc, 0xae, 0xbd, 0x9b, 0xb8, 0x9b, 0xf4, 0xb6,
-	0xf6, 0x7c, 0xdf, 0xf7, 0xbe, 0xf9, 0xde, 0x8b, 0x5f, 0xe0, 0xba, 0x8f, 0xfd, 0x61, 0x8f, 0xc5,
-	0x8e, 0x2f, 0x82, 0x20, 0x22, 0xc1, 0x7e, 0xc2, 0x68, 0x2c, 0x9c, 0x83, 0xb6, 0xf3, 0x78, 0x40,
-	0xd2, 0xa1, 0x9d, 0xa4, 0x4c, 0x30, 0x54, 0xd7, 0x28, 0x7b, 0x02, 0x65, 0x1f, 0xb4, 0x1b, 0xb5,
-	0x90, 0x85, 0x4c, 0x82, 0x9c, 0xec, 0x97, 0xc2, 0x37, 0x56, 0x02, 0xc6, 0xfb, 0x8c, 0x7b, 0xea,
-	0x40, 0x3d, 0xe8, 0xa3, 0xb5, 0x90, 0xb1, 0xb0, 0x47, 0x1c, 0x9c, 0x50, 0x07, 0xc7, 0x31, 0x13,
-	0x58, 0x50, 0x16, 0x8f, 0x4e, 0xdf, 0x54, 0x58, 0xc7, 0xc7, 0x9c, 0x28, 0x07, 0xce, 0x41, 0xdb,
-	0x27, 0x02, 0xb7, 0x9d, 0x04, 0x87, 0x34, 0x96, 0x60, 0x8d, 0xbd, 0x51, 0x6a, 0x3d, 0xc1, 0x29,
-	0xee, 0x6b, 0x49, 0xab, 0x06, 0xe8, 0xf3, 0x4c, 0x68, 0x47, 0xbe, 0x74, 0xc9, 0xe3, 0x01, 0xe1,
-	0xc2, 0xfa, 0x02, 0xae, 0x4c, 0xbc, 0xe5, 0x09, 0x8b, 0x39, 0x41, 0xef, 0xc3, 0xa2, 0x22, 0xd7,
-	0x8d, 0x96, 0xb1, 0xb1, 0x7c, 0xab, 0x65, 0x97, 0xdd, 0xdc, 0x56, 0xcc, 0x8e, 0xf9, 0xf4, 0xdf,
-	0xab, 0x73, 0xae, 0x66, 0x59, 0xef, 0xc1, 0x1b, 0x52, 0xb6, 0x23, 0x82, 0xed, 0x31, 0xfa, 0x61,
-	0xbc, 0xc7, 0x74, 0x5d, 0xb4, 0x0a, 0x55, 0x92, 0xb0, 0x20, 0xf2, 0xe2, 0x41, 0x5f, 0xd6, 0x30,
-	0xdd, 0x25, 0xf9, 0xe2, 0xd3, 0x41, 0xdf, 0xa2, 0xd0, 0x2c, 0x63, 0x6b, 0x7f, 0x0f, 0xc0, 0xa4,
-	0xf1, 0x1e, 0xd3, 0xee, 0xb6, 0xca, 0xdd, 0x75, 0x76, 0xb7, 0x4f, 0x97, 0x70, 0xa5, 0x80, 0x15,
-	0x9d, 0x56, 0x8a, 0x17, 0x9d, 0xde, 0x07, 0xc8, 0x23, 0xd7, 0x05, 0xd7, 0x6d, 0xdd, 0xcb, 0xac,
-	0x3f, 0xb6, 0x9a, 0x10, 0xdd, 0x1f, 0x7b, 0x07, 0x87, 0x44, 0x73, 0xdd, 0x02, 0xd3, 0xfa, 0xd5,
-	0x80, 0xab, 0xa5, 0xa5, 0xf4, 0xb5, 0x76, 0xa0, 0x9a, 0xb9, 0xf2, 0x7a, 0x94, 0x8b, 0xba, 0xd1,
-	0x9a, 0x7f, 0xd9, 0xbb, 0x2d, 0x65, 0x2a, 0x9f, 0x50, 0x2e, 0xd0, 0x83, 0x09, 0xf7, 0x15, 0xe9,
-	0xfe, 0xe6, 0x4c, 0xf7, 0x5a, 0xa6, 0x68, 0xff, 0x5d, 0x58, 0x93, 0xee, 0x3f, 0xcc, 0x9a, 0xf4,
-	0x68, 0xe0, 0xf7, 0x29, 0xe7, 0xd9, 0xc0, 0x9e, 0xa9, 0xa1, 0x5d, 0x3d, 0x0e, 0x27, 0xc9, 0xfa,
-	0xe2, 0xdb, 0x60, 0xee, 0x93, 0x21, 0xd7, 0x77, 0x76, 0xca, 0xef, 0x9c, 0x93, 0x3f, 0x26, 0xc3,
-	0xbc, 0x97, 0x19, 0xd9, 0xfa, 0x73, 0x1e, 0x56, 0x4a, 0x33, 0x41, 0xd7, 0xe0, 0xc2, 0xd8, 0xa0,
-	0x4f, 0x52, 0xed, 0x71, 0x79, 0xe4, 0xd1, 0x27, 0x29, 0xba, 0x0f, 0x2d, 0x9f, 0x70, 0xe1, 0xf1,
-	0x71, 0x11, 0xcf, 0x17, 0x81, 0xe7, 0xf7, 0x58, 0xb0, 0xef, 0x45, 0x84, 0x86, 0x91, 0x90, 0x11,
-	0x9a, 0xee, 0x5a, 0x86, 0xcb, 0xbd, 0x74, 0x44, 0xd0, 0xc9, 0x40, 0x1f, 0x49, 0x0c, 0xea, 0x40,
-	0xf3, 0x05, 0x3a, 0x98, 0x47, 0xf5, 0xf9, 0x96, 0xb1, 0x51, 0x75, 0x1b, 0x25, 0x2a, 0x98, 0x47,
-	0x88, 0xc3, 0xda, 0xb4, 0x86, 0x48, 0x71, 0xcc, 0x71, 0x20, 0xbf, 0x13, 0x75, 0x53, 0x26, 0xd5,
-	0x2e, 0x4f, 0x6a, 0x37, 0x47, 0x4f, 0xcc, 0xc6, 0x54, 0xd1, 0x02, 0x8c, 0xa3, 0x6f, 0x0d, 0x58,
-	0x9f, 0xae, 0x7a, 0x40, 0x43, 0xda, 0xc3, 0xb1, 0x20, 0x1e, 0xee, 0x76, 0x53, 0xc2, 0xb9, 0x9a,
-	0xce, 0x05, 0x59, 0xff, 0x76, 0x79, 0xfd, 0xbc, 0x0d, 0x1f, 0x28, 0x1e, 0x19, 0xb7, 0xdb, 0xb5,
-	0x26, 0x3d, 0x7c, 0x39, 0x2a, 0xa1, 0x91, 0xd9, 0xe4, 0x5a, 0x4f, 0xe0, 0xf5, 0x92, 0x2b, 0xa0,
-	0x1a, 0x2c, 0xd0, 0xb8, 0x4b, 0x0e, 0x65, 0x0f, 0x2f, 0xba, 0xea, 0x01, 0x21, 0x30, 0x65, 0xb6,
-	0x15, 0x99, 0xad, 0xfc, 0x8d, 0x5a, 0xb0, 0x5c, 0x48, 0x4d, 0xc7, 0x5e, 0x7c, 0x95, 0x69, 0x25,
-	0x29, 0x63, 0x7b, 0x75, 0x53, 0x9e, 0xa9, 0x07, 0xeb, 0x3b, 0x03, 0x56, 0x5f, 0x70, 0x01, 0x74,
-	0x07, 0xaa, 0x32, 0x22, 0x21, 0xf4, 0x24, 0x55, 0x3b, 0xf5, 0xbf, 0x7e, 0xde, 0xac, 0xe9, 0x3f,
-	0x2c, 0x4d, 0x78, 0x24, 0x52, 0x1a, 0x87, 0x6e, 0x0e, 0x45, 0x6f, 0xc3, 0x52, 0x4a, 0x12, 0x96,
-	0x66, 0xb4, 0xca, 0x0c, 0xda, 0x18, 0x69, 0xfd, 0x61, 0xc0, 0x6b, 0xa7, 0x0e, 0x3e, 0xda, 0x84,
-	0x2b, 0x7b, 0x34, 0xe5, 0xc2, 0x13, 0x87, 0xc5, 0xf1, 0x92, 0x8e, 0xdc, 0x4b, 0xf2, 0x68, 0xf7,
-	0x30, 0x1f, 0xaa, 0xeb, 0xf0, 0xca, 0x18, 0xae, 0x12, 0xac, 0xc8, 0x04, 0x2f, 0x68, 0xe4, 0x43,
-	0x19, 0xa4, 0x03, 0x35, 0x4e, 0x02, 0x16, 0x77, 0xa7, 0x54, 0x55, 0x7a, 0x97, 0xd5, 0x59, 0x51,
-	0x76, 0x1d, 0x5e, 0xcd, 0x09, 0x4a, 0xd7, 0x94, 0xba, 0x17, 0x47, 0x58, 0x29, 0x7c, 0xeb, 0xf7,
-	0x05, 0x58, 0x90, 0xdf, 0x01, 0xf4, 0xbd, 0x01, 0x8b, 0x6a, 0x71, 0xa0, 0xb7, 0xca, 0x47, 0xe8,
-	0xe4, 0xbe, 0x6a, 0x6c, 0x9e, 0x11, 0xad, 0xf2, 0xb1, 0x36, 0xbe, 0xf9, 0xfb, 0xff, 0x1f, 0x2b,
-	0x16, 0x6a, 0x39, 0x33, 0x96, 0x24, 0xfa, 0xc5, 0x80, 0xcb, 0x27, 0xf6, 0x0d, 0xba, 0x3b, 0xa3,
-	0x5c, 0xd9, 0x7e, 0x6b, 0xbc, 0x73, 0x7e, 0xa2, 0xb6, 0xbc, 0x29, 0x2d, 0xdf, 0x44, 0x37, 0xca,
-	0x2d, 0x3f, 0x19, 0x7f, 0xc8, 0xbe, 0x46, 0x3f, 0x19, 0x80, 0x4e, 0x6e, 0x14, 0x74, 0xae, 0xfa,
-	0xc5, 0x7d, 0xd7, 0xb8, 0xf7, 0x12, 0x4c, 0x6d, 0xfd, 0x9a, 0xb4, 0xbe, 0x8a, 0x56, 0x4a, 0xad,
-	0xa3, 0xdf, 0x0c, 0xb8, 0x34, 0xbd, 0x05, 0xd0, 0x9d, 0x19, 0x25, 0x4b, 0x76, 0x4e, 0xe3, 0xee,
-	0xb9, 0x79, 0xda, 0xe8, 0x3d, 0x69, 0x74, 0x0b, 0xb5, 0xcf, 0x94, 0xb1, 0x93, 0x7f, 0x0d, 0x79,
-	0xe7, 0xb3, 0xa7, 0x47, 0x4d, 0xe3, 0xd9, 0x51, 0xd3, 0xf8, 0xef, 0xa8, 0x69, 0xfc, 0x70, 0xdc,
-	0x9c, 0x7b, 0x76, 0xdc, 0x9c, 0xfb, 0xe7, 0xb8, 0x39, 0xf7, 0xd5, 0xed, 0x90, 0x8a, 0x68, 0xe0,
-	0xdb, 0x01, 0xeb, 0x8f, 0x64, 0x83, 0x08, 0xd3, 0x78, 0x5c, 0xe3, 0x70, 0xaa, 0x8a, 0x18, 0x26,
-	0x84, 0xfb, 0x8b, 0xf2, 0xdf, 0xb3, 0xad, 0xe7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x07, 0x9f, 0x31,
-	0x97, 0x82, 0x0a, 0x00, 0x00,
+	// 1050 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x18, 0xcd, 0x26, 0x76, 0x48, 0xbe, 0xb4, 0x90, 0x4e, 0x8d, 0xea, 0x38, 0xe9, 0xc6, 0x59, 0xb5,
+	0x69, 0x84, 0x88, 0x17, 0x37, 0xa4, 0xa5, 0x02, 0x21, 0xe1, 0x88, 0x94, 0x0a, 0x04, 0x61, 0x1b,
+	0x38, 0x70, 0x59, 0xed, 0xae, 0xc7, 0xeb, 0x51, 0xec, 0x9d, 0xed, 0xce, 0x38, 0x8a, 0x85, 0xb8,
+	0x70, 0x43, 0x08, 0x81, 0x84, 0xc4, 0x85, 0x7f, 0x80, 0x38, 0xc2, 0x0d, 0x21, 0x71, 0x40, 0xea,
+	0xb1, 0x82, 0x0b, 0x27, 0x40, 0x09, 0x3f, 0x04, 0xed, 0xb7, 0x63, 0xef, 0xda, 0xc9, 0xd6, 0x49,
+	0x4f, 0xde, 0xdd, 0x79, 0xef, 0x7d, 0x6f, 0xde, 0x7c, 0x33, 0x63, 0xb8, 0xe1, 0x3a, 0x6e, 0xbf,
+	0xc3, 0x03, 0xd3, 0x95, 0x9e, 0xd7, 0xa6, 0xde, 0x41, 0xc8, 0x59, 0x20, 0xcd, 0xc3, 0xba, 0xf9,
+	0xa8, 0x47, 0xa3, 0x7e, 0x2d, 0x8c, 0xb8, 0xe4, 0xa4, 0xac, 0x50, 0xb5, 0x11, 0x54, 0xed, 0xb0,
+	0x5e, 0x29, 0xf9, 0xdc, 0xe7, 0x08, 0x32, 0xe3, 0xa7, 0x04, 0x5f, 0x59, 0xf2, 0xb8, 0xe8, 0x72,
+	0x61, 0x27, 0x03, 0xc9, 0x8b, 0x1a, 0x5a, 0xf1, 0x39, 0xf7, 0x3b, 0xd4, 0x74, 0x42, 0x66, 0x3a,
+	0x41, 0xc0, 0xa5, 0x23, 0x19, 0x0f, 0x06, 0xa3, 0x2f, 0x25, 0x58, 0xd3, 0x75, 0x04, 0x4d, 0x1c,
+	0x98, 0x87, 0x75, 0x97, 0x4a, 0xa7, 0x6e, 0x86, 0x8e, 0xcf, 0x02, 0x04, 0x2b, 0xec, 0xcd, 0x5c,
+	0xeb, 0xa1, 0x13, 0x39, 0xdd, 0x81, 0xa4, 0x9e, 0x95, 0x1c, 0x88, 0x79, 0x9c, 0x29, 0x19, 0xa3,
+	0x04, 0xe4, 0xc3, 0xb8, 0xd0, 0x1e, 0x92, 0x2c, 0xfa, 0xa8, 0x47, 0x85, 0x34, 0x3e, 0x82, 0xab,
+	0x23, 0x5f, 0x45, 0xc8, 0x03, 0x41, 0xc9, 0x9b, 0x30, 0x9b, 0x88, 0x97, 0xb5, 0xaa, 0xb6, 0xb1,
+	0x70, 0xbb, 0x5a, 0xcb, 0x4b, 0xa6, 0x96, 0x30, 0x1b, 0x85, 0xc7, 0x7f, 0xaf, 0x4e, 0x59, 0x8a,
+	0x65, 0xbc, 0x01, 0xd7, 0x51, 0xb6, 0x21, 0xbd, 0x9d, 0x21, 0xfa, 0x41, 0xd0, 0xe2, 0xaa, 0x2e,
+	0x59, 0x86, 0x79, 0x1a, 0x72, 0xaf, 0x6d, 0x07, 0xbd, 0x2e, 0xd6, 0x28, 0x58, 0x73, 0xf8, 0xe1,
+	0xfd, 0x5e, 0xd7, 0x60, 0xa0, 0xe7, 0xb1, 0x95, 0xbf, 0xfb, 0x50, 0x60, 0x41, 0x8b, 0x2b, 0x77,
+	0x5b, 0xf9, 0xee, 0x1a, 0xfb, 0x3b, 0x67, 0x4b, 0x58, 0x28, 0x60, 0xb4, 0xcf, 0x2a, 0x25, 0xb2,
+	0x4e, 0x77, 0x01, 0xd2, 0x25, 0x51, 0x05, 0xd7, 0x6b, 0x6a, 0xad, 0xe3, 0xb0, 0x6b, 0x49, 0x07,
+	0xa9, 0xc8, 0x6b, 0x7b, 0x8e, 0x4f, 0x15, 0xd7, 0xca, 0x30, 0x8d, 0x5f, 0x34, 0x58, 0xcd, 0x2d,
+	0xa5, 0xa6, 0xb5, 0x07, 0xf3, 0xb1, 0x2b, 0xbb, 0xc3, 0x84, 0x2c, 0x6b, 0xd5, 0x99, 0x67, 0x9d,
+	0xdb, 0x5c, 0xac, 0xf2, 0x1e, 0x13, 0x92, 0xdc, 0x1f, 0x71, 0x3f, 0x8d, 0xee, 0x6f, 0x4d, 0x74,
+	0xaf, 0x64, 0xb2, 0xf6, 0x5f, 0x87, 0x15, 0x74, 0xff, 0x76, 0xbc, 0x48, 0x0f, 0x7b, 0x6e, 0x97,
+	0x09, 0x11, 0x37, 0xf4, 0xb9, 0x16, 0xb4, 0x09, 0xd7, 0x73, 0xc8, 0x6a, 0xe2, 0x3b, 0x50, 0x38,
+	0xa0, 0x7d, 0xa1, 0xe6, 0x6c, 0xe6, 0xcf, 0x39, 0x25, 0xbf, 0x4b, 0xfb, 0xe9, 0x5a, 0xc6, 0x64,
+	0xe3, 0xfb, 0x22, 0x2c, 0xe5, 0x66, 0x42, 0xd6, 0xe0, 0xd2, 0xd0, 0xa0, 0x4b, 0x23, 0xe5, 0x71,
+	0x61, 0xe0, 0xd1, 0xa5, 0x11, 0xd9, 0x85, 0xaa, 0x4b, 0x85, 0xb4, 0xc5, 0xb0, 0x88, 0xed, 0x4a,
+	0xcf, 0x76, 0x3b, 0xdc, 0x3b, 0xb0, 0xdb, 0x94, 0xf9, 0x6d, 0x89, 0x11, 0x16, 0xac, 0x95, 0x18,
+	0x97, 0x7a, 0x69, 0x48, 0xaf, 0x11, 0x83, 0xde, 0x41, 0x0c, 0x69, 0x80, 0xfe, 0x14, 0x1d, 0x47,
+	0xb4, 0xcb, 0x33, 0x55, 0x6d, 0x63, 0xde, 0xaa, 0xe4, 0xa8, 0x38, 0xa2, 0x4d, 0x04, 0xac, 0x8c,
+	0x6b, 0xc8, 0xc8, 0x09, 0x84, 0xe3, 0xe1, 0x39, 0x52, 0x2e, 0x60, 0x52, 0xf5, 0xfc, 0xa4, 0xf6,
+	0x53, 0xf4, 0x48, 0x6f, 0x8c, 0x15, 0xcd, 0xc0, 0x04, 0xf9, 0x42, 0x83, 0xf5, 0xf1, 0xaa, 0x87,
+	0xcc, 0x67, 0x1d, 0x27, 0x90, 0xd4, 0x76, 0x9a, 0xcd, 0x88, 0x0a, 0x91, 0x74, 0x67, 0x11, 0xeb,
+	0x6f, 0xe7, 0xd7, 0x4f, 0x97, 0xe1, 0xad, 0x84, 0x47, 0x87, 0xcb, 0x6d, 0x19, 0xa3, 0x1e, 0x3e,
+	0x1e, 0x94, 0x50, 0x48, 0xec, 0xdc, 0x6d, 0xb8, 0x76, 0x56, 0x88, 0x2d, 0x4a, 0xcb, 0xb3, 0xb8,
+	0x06, 0xa5, 0x53, 0xe9, 0xed, 0x52, 0x4a, 0xbe, 0xd3, 0x60, 0x6d, 0x9c, 0xd7, 0xa2, 0xd4, 0x8e,
+	0x28, 0xeb, 0xba, 0xbd, 0x48, 0xd0, 0x2e, 0x0d, 0x64, 0xf9, 0x39, 0x74, 0xbf, 0x34, 0xb2, 0x11,
+	0x06, 0x5b, 0x60, 0x87, 0xb3, 0xa0, 0xf1, 0x4a, 0x7c, 0x9c, 0xfd, 0xf0, 0xcf, 0xea, 0x86, 0xcf,
+	0x64, 0xbb, 0xe7, 0xd6, 0x3c, 0xde, 0x55, 0xe7, 0xbb, 0xfa, 0xd9, 0x14, 0xcd, 0x03, 0x53, 0xf6,
+	0x43, 0x2a, 0x90, 0x20, 0x2c, 0x7d, 0xd4, 0xce, 0x2e, 0xa5, 0x56, 0xb6, 0xa4, 0xf1, 0x95, 0x06,
+	0xd7, 0x72, 0xd6, 0x84, 0x94, 0xa0, 0xc8, 0x82, 0x26, 0x3d, 0xc2, 0xa6, 0xbc, 0x6c, 0x25, 0x2f,
+	0x84, 0x40, 0x01, 0x9b, 0x65, 0x1a, 0x9b, 0x05, 0x9f, 0x49, 0x15, 0x16, 0x32, 0x6d, 0xa0, 0xfa,
+	0x28, 0xfb, 0x29, 0xd6, 0x0a, 0x23, 0xce, 0x5b, 0xe5, 0x02, 0x8e, 0x25, 0x2f, 0x64, 0x11, 0x66,
+	0xe2, 0xe4, 0x8a, 0x98, 0x5c, 0xfc, 0x68, 0x7c, 0xa9, 0xc1, 0xf2, 0x53, 0xd6, 0x88, 0xdc, 0x81,
+	0x79, 0x8c, 0x50, 0x4a, 0xb5, 0x59, 0xe6, 0x1b, 0xe5, 0x3f, 0x7e, 0xda, 0x2c, 0xa9, 0xc8, 0x14,
+	0xe1, 0xa1, 0x8c, 0x58, 0xe0, 0x5b, 0x29, 0x94, 0xbc, 0x0a, 0x73, 0x11, 0x0d, 0x79, 0x14, 0xd3,
+	0xa6, 0x27, 0xd0, 0x86, 0x48, 0xe3, 0x77, 0x0d, 0x5e, 0x3c, 0x73, 0x6f, 0x93, 0x4d, 0xb8, 0xda,
+	0x62, 0x91, 0x90, 0xb6, 0x3c, 0xca, 0xee, 0x20, 0x74, 0x64, 0x2d, 0xe2, 0xd0, 0xfe, 0x51, 0xba,
+	0x6f, 0x6e, 0xc0, 0xf3, 0x43, 0x78, 0x92, 0xe9, 0x34, 0x66, 0x7a, 0x49, 0x21, 0x1f, 0x60, 0xb4,
+	0x26, 0x94, 0x04, 0xf5, 0x78, 0xd0, 0x1c, 0x53, 0x4d, 0xf2, 0xbc, 0x92, 0x8c, 0x65, 0x65, 0xd7,
+	0xe1, 0x85, 0x94, 0x90, 0xe8, 0x16, 0x50, 0xf7, 0xf2, 0x00, 0x8b, 0xc2, 0xb7, 0x7f, 0x2b, 0x42,
+	0x11, 0x8f, 0x3a, 0xf2, 0xb5, 0x06, 0xb3, 0xc9, 0xdd, 0x48, 0x5e, 0xce, 0xdf, 0x25, 0xa7, 0xaf,
+	0xe4, 0xca, 0xe6, 0x39, 0xd1, 0x49, 0x3e, 0xc6, 0xc6, 0xe7, 0x7f, 0xfe, 0xf7, 0xed, 0xb4, 0x41,
+	0xaa, 0xe6, 0x84, 0xff, 0x09, 0xe4, 0x67, 0x0d, 0xae, 0x9c, 0xba, 0x52, 0xc9, 0xdd, 0x09, 0xe5,
+	0xf2, 0xae, 0xf0, 0xca, 0x6b, 0x17, 0x27, 0x2a, 0xcb, 0x9b, 0x68, 0xf9, 0x16, 0xb9, 0x99, 0x6f,
+	0xf9, 0xd3, 0xe1, 0x59, 0xfd, 0x19, 0xf9, 0x51, 0x03, 0x72, 0xfa, 0xd2, 0x24, 0x17, 0xaa, 0x9f,
+	0xbd, 0xd2, 0x2b, 0xf7, 0x9e, 0x81, 0xa9, 0xac, 0xaf, 0xa1, 0xf5, 0x65, 0xb2, 0x94, 0x6b, 0x9d,
+	0xfc, 0xaa, 0xc1, 0xe2, 0xf8, 0x45, 0x47, 0xee, 0x4c, 0x28, 0x99, 0x73, 0xad, 0x56, 0xee, 0x5e,
+	0x98, 0xa7, 0x8c, 0xde, 0x43, 0xa3, 0x5b, 0xa4, 0x7e, 0xae, 0x8c, 0xcd, 0xf4, 0xb4, 0x14, 0x8d,
+	0x0f, 0x1e, 0x1f, 0xeb, 0xda, 0x93, 0x63, 0x5d, 0xfb, 0xf7, 0x58, 0xd7, 0xbe, 0x39, 0xd1, 0xa7,
+	0x9e, 0x9c, 0xe8, 0x53, 0x7f, 0x9d, 0xe8, 0x53, 0x9f, 0x6c, 0x67, 0x4e, 0x43, 0x25, 0xeb, 0xb5,
+	0x1d, 0x16, 0x0c, 0x6b, 0x1c, 0x8d, 0x55, 0xc1, 0x03, 0xd2, 0x9d, 0xc5, 0x7f, 0xa0, 0x5b, 0xff,
+	0x0f, 0x00, 0x9f, 0xca, 0x7f, 0xa2, 0x85, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1267,6 +1303,25 @@ func (m *BTCCheckpointInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.BestSubmissionFeeReimbursement) > 0 {
+		for iNdEx := len(m.BestSubmissionFeeReimbursement) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BestSubmissionFeeReimbursement[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.BestSubmissionBtcFee != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BestSubmissionBtcFee))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.BestSubmissionVigilanteAddressList) > 0 {
 		for iNdEx := len(m.BestSubmissionVigilanteAddressList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1335,6 +1390,11 @@ func (m *TransactionInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Fee != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Proof) > 0 {
 		i -= len(m.Proof)
 		copy(dAtA[i:], m.Proof)
@@ -1591,6 +1651,15 @@ func (m *BTCCheckpointInfoResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.BestSubmissionBtcFee != 0 {
+		n += 1 + sovQuery(uint64(m.BestSubmissionBtcFee))
+	}
+	if len(m.BestSubmissionFeeReimbursement) > 0 {
+		for _, e := range m.BestSubmissionFeeReimbursement {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1615,6 +1684,9 @@ func (m *TransactionInfoResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Fee != 0 {
+		n += 1 + sovQuery(uint64(m.Fee))
+	}
 	return n
 }
 
@@ -2478,6 +2550,59 @@ func (m *BTCCheckpointInfoResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestSubmissionBtcFee", wireType)
+			}
+			m.BestSubmissionBtcFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestSubmissionBtcFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestSubmissionFeeReimbursement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BestSubmissionFeeReimbursement = append(m.BestSubmissionFeeReimbursement, types.Coin{})
+			if err := m.BestSubmissionFeeReimbursement[len(m.BestSubmissionFeeReimbursement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2643,6 +2768,25 @@ func (m *TransactionInfoResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Proof = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
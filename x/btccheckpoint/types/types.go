@@ -13,13 +13,14 @@ import (
 
 // RawCheckpointSubmission Semantically valid checkpoint submission with:
 // - valid submitter address
-// - at least 2 parsed proof
+// - 2 parsed proofs, or 1 parsed proof for a checkpoint carried by a single
+// transaction
 // Modelling proofs as separate Proof1 and Proof2, as this is more explicit than
-// []*ParsedProof.
+// []*ParsedProof. Proof2 is nil for a checkpoint carried by a single transaction.
 type RawCheckpointSubmission struct {
 	Reporter       sdk.AccAddress
 	Proof1         ParsedProof
-	Proof2         ParsedProof
+	Proof2         *ParsedProof
 	CheckpointData btctxformatter.RawBtcCheckpoint
 }
 
@@ -49,22 +50,49 @@ func NewRawCheckpointSubmission(
 	r := RawCheckpointSubmission{
 		Reporter:       a,
 		Proof1:         p1,
-		Proof2:         p2,
+		Proof2:         &p2,
 		CheckpointData: checkpointData,
 	}
 
 	return r
 }
 
+func NewSingleTxCheckpointSubmission(
+	a sdk.AccAddress,
+	p ParsedProof,
+	checkpointData btctxformatter.RawBtcCheckpoint,
+) RawCheckpointSubmission {
+	r := RawCheckpointSubmission{
+		Reporter:       a,
+		Proof1:         p,
+		CheckpointData: checkpointData,
+	}
+
+	return r
+}
+
+// IsSingleTx returns true if the checkpoint is carried by a single transaction
+func (s *RawCheckpointSubmission) IsSingleTx() bool {
+	return s.Proof2 == nil
+}
+
 func (s *RawCheckpointSubmission) GetProofs() []*ParsedProof {
-	return []*ParsedProof{&s.Proof1, &s.Proof2}
+	if s.IsSingleTx() {
+		return []*ParsedProof{&s.Proof1}
+	}
+	return []*ParsedProof{&s.Proof1, s.Proof2}
 }
 
 func (s *RawCheckpointSubmission) GetFirstBlockHash() types.BTCHeaderHashBytes {
 	return s.Proof1.BlockHash
 }
 
+// GetSecondBlockHash returns the block hash of the second proof, or the block
+// hash of the only proof for a checkpoint carried by a single transaction
 func (s *RawCheckpointSubmission) GetSecondBlockHash() types.BTCHeaderHashBytes {
+	if s.IsSingleTx() {
+		return s.Proof1.BlockHash
+	}
 	return s.Proof2.BlockHash
 }
 
@@ -84,10 +112,10 @@ func toTransactionKey(p *ParsedProof) TransactionKey {
 
 func (s *RawCheckpointSubmission) GetSubmissionKey() SubmissionKey {
 	var keys []*TransactionKey
-	k1 := toTransactionKey(&s.Proof1)
-	keys = append(keys, &k1)
-	k2 := toTransactionKey(&s.Proof2)
-	keys = append(keys, &k2)
+	for _, p := range s.GetProofs() {
+		k := toTransactionKey(p)
+		keys = append(keys, &k)
+	}
 	return SubmissionKey{
 		Key: keys,
 	}
@@ -122,6 +150,16 @@ func NewEmptyEpochData() EpochData {
 	}
 }
 
+// GetBTCFee returns the total BTC fee in satoshis paid by the transactions of
+// the submission
+func (sd *SubmissionData) GetBTCFee() uint64 {
+	var fee uint64
+	for _, txInfo := range sd.TxsInfo {
+		fee += txInfo.Fee
+	}
+	return fee
+}
+
 func (s *EpochData) AppendKey(k SubmissionKey) {
 	key := &k
 	s.Keys = append(s.Keys, key)
@@ -168,8 +206,9 @@ func NewTransactionInfoFromSpvProof(proof *BTCSpvProof) *TransactionInfo {
 			Index: proof.BtcTransactionIndex,
 			Hash:  proof.ConfirmingBtcHeader.Hash(),
 		},
-		Transaction: proof.BtcTransaction,
-		Proof:       proof.MerkleNodes,
+		Transaction:      proof.BtcTransaction,
+		Proof:            proof.MerkleNodes,
+		SpentTransaction: proof.SpentBtcTransaction,
 	}
 }
 
@@ -27,6 +27,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdTip())
 	cmd.AddCommand(CmdBaseHeader())
 	cmd.AddCommand(CmdHeaderDepth())
+	cmd.AddCommand(CmdReporter())
+	cmd.AddCommand(CmdReporters())
 
 	return cmd
 }
@@ -198,3 +200,57 @@ func CmdHeaderDepth() *cobra.Command {
 
 	return cmd
 }
+
+func CmdReporter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reporter [address]",
+		Short: "retrieve the bond and the statistics of a reporter of BTC headers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Reporter(context.Background(), types.NewQueryReporterRequest(args[0]))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdReporters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reporters",
+		Short: "retrieve the bonds and the statistics of all reporters of BTC headers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Reporters(context.Background(), types.NewQueryReportersRequest(pageReq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reporters")
+
+	return cmd
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
 
@@ -21,6 +22,8 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdTxInsertHeader())
+	cmd.AddCommand(CmdTxBondReporter())
+	cmd.AddCommand(CmdTxUnbondReporter())
 
 	return cmd
 }
@@ -49,3 +52,52 @@ func CmdTxInsertHeader() *cobra.Command {
 
 	return cmd
 }
+
+func CmdTxBondReporter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bond-reporter [amount]",
+		Short: "post a bond for inserting BTC headers as a reporter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBondReporter(clientCtx.GetFromAddress(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTxUnbondReporter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbond-reporter",
+		Short: "withdraw the whole bond of a reporter",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnbondReporter(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -19,12 +19,22 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, gs types.GenesisState) {
 	}
 
 	k.InsertHeaderInfos(ctx, gs.BtcHeaders)
+
+	if gs.RetargetContext != nil {
+		k.SetRetargetContext(ctx, gs.RetargetContext)
+	}
+
+	for _, reporter := range gs.Reporters {
+		k.SetReporterInfo(ctx, reporter)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		BtcHeaders: k.GetMainChainFrom(ctx, 0),
+		Params:          k.GetParams(ctx),
+		BtcHeaders:      k.GetMainChainFrom(ctx, 0),
+		RetargetContext: k.GetRetargetContext(ctx),
+		Reporters:       k.GetAllReporterInfos(ctx),
 	}
 }
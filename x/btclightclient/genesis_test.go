@@ -21,6 +21,7 @@ import (
 func TestGenesis(t *testing.T) {
 	baseHeaderInfo := types.SimnetGenesisBlock()
 	genesisState := types.GenesisState{
+		Params:     types.DefaultParams(),
 		BtcHeaders: []*types.BTCHeaderInfo{&baseHeaderInfo},
 	}
 
@@ -42,10 +43,9 @@ func TestImportExport(t *testing.T) {
 	address2, err := sdk.AccAddressFromHexUnsafe(sender2.PubKey().Address().String())
 	require.NoError(t, err)
 
-	params := types.NewParams(
-		// only sender1 and sender2 are allowed to update
-		[]string{address1.String(), address2.String()},
-	)
+	params := types.DefaultParams()
+	// only sender1 and sender2 are allowed to update
+	params.InsertHeadersAllowList = []string{address1.String(), address2.String()}
 
 	k, ctx, stServ := keepertest.BTCLightClientKeeperWithCustomParams(t, params)
 	srv := keeper.NewMsgServerImpl(*k)
@@ -17,3 +17,14 @@ func (k Keeper) SetBaseBTCHeader(ctx context.Context, baseBTCHeader types.BTCHea
 	}
 	k.headersState(ctx).insertHeader(&baseBTCHeader)
 }
+
+// GetRetargetContext returns the retarget context of the base BTC header, or
+// nil if it does not exist
+func (k Keeper) GetRetargetContext(ctx context.Context) *types.RetargetContext {
+	return k.headersState(ctx).GetRetargetContext()
+}
+
+// SetRetargetContext sets the retarget context of the base BTC header
+func (k Keeper) SetRetargetContext(ctx context.Context, rc *types.RetargetContext) {
+	k.headersState(ctx).setRetargetContext(rc)
+}
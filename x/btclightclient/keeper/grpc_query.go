@@ -207,3 +207,47 @@ func (k Keeper) HeaderDepth(ctx context.Context, req *types.QueryHeaderDepthRequ
 
 	return &types.QueryHeaderDepthResponse{Depth: uint64(depth)}, nil
 }
+
+func (k Keeper) Reporter(ctx context.Context, req *types.QueryReporterRequest) (*types.QueryReporterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	reporter, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "provided address is not a valid bech32 address")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	ri := k.GetReporterInfo(sdkCtx, reporter)
+	if ri == nil {
+		return nil, status.Errorf(codes.NotFound, "reporter %s not found", req.Address)
+	}
+
+	return &types.QueryReporterResponse{Reporter: ri}, nil
+}
+
+func (k Keeper) Reporters(ctx context.Context, req *types.QueryReportersRequest) (*types.QueryReportersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var reporters []*types.ReporterInfo
+	store := k.reporterInfoStore(sdkCtx)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var ri types.ReporterInfo
+		if err := k.cdc.Unmarshal(value, &ri); err != nil {
+			return err
+		}
+		reporters = append(reporters, &ri)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReportersResponse{Reporters: reporters, Pagination: pageRes}, nil
+}
@@ -19,12 +19,14 @@ import (
 
 type (
 	Keeper struct {
-		cdc          codec.BinaryCodec
-		storeService corestoretypes.KVStoreService
-		hooks        types.BTCLightClientHooks
-		btcConfig    bbn.BtcConfig
-		bl           *types.BtcLightClient
-		authority    string
+		cdc             codec.BinaryCodec
+		storeService    corestoretypes.KVStoreService
+		hooks           types.BTCLightClientHooks
+		bankKeeper      types.BankKeeper
+		incentiveKeeper types.IncentiveKeeper
+		btcConfig       bbn.BtcConfig
+		bl              *types.BtcLightClient
+		authority       string
 	}
 )
 
@@ -33,18 +35,22 @@ var _ types.BtcChainReadStore = (*headersState)(nil)
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService corestoretypes.KVStoreService,
+	bankKeeper types.BankKeeper,
+	incentiveKeeper types.IncentiveKeeper,
 	btcConfig bbn.BtcConfig,
 	authority string,
 ) Keeper {
 	bl := types.NewBtcLightClientFromParams(btcConfig.NetParams())
 
 	return Keeper{
-		cdc:          cdc,
-		storeService: storeService,
-		hooks:        nil,
-		btcConfig:    btcConfig,
-		bl:           bl,
-		authority:    authority,
+		cdc:             cdc,
+		storeService:    storeService,
+		hooks:           nil,
+		bankKeeper:      bankKeeper,
+		incentiveKeeper: incentiveKeeper,
+		btcConfig:       btcConfig,
+		bl:              bl,
+		authority:       authority,
 	}
 }
 
@@ -75,10 +81,12 @@ func (k *Keeper) SetHooks(bh types.BTCLightClientHooks) *Keeper {
 	return k
 }
 
+// insertHeaders inserts the given headers and returns the headers that
+// extended the heaviest chain
 func (k Keeper) insertHeaders(
 	ctx context.Context,
 	headers []*wire.BlockHeader,
-) error {
+) ([]*types.BTCHeaderInfo, error) {
 
 	headerState := k.headersState(ctx)
 
@@ -88,7 +96,7 @@ func (k Keeper) insertHeaders(
 	)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// if we have rollback, first delete all headers up to the rollback point
@@ -105,7 +113,7 @@ func (k Keeper) insertHeaders(
 		k.triggerHeaderInserted(ctx, h)
 		k.triggerRollForward(ctx, h)
 	}
-	return nil
+	return result.HeadersToInsert, nil
 }
 
 // InsertHeaderInfos inserts multiple headers info at the store.
@@ -117,8 +125,13 @@ func (k Keeper) InsertHeaderInfos(ctx context.Context, infos []*types.BTCHeaderI
 }
 
 func (k Keeper) InsertHeaders(ctx context.Context, headers []bbn.BTCHeaderBytes) error {
+	_, err := k.insertHeaderBytes(ctx, headers)
+	return err
+}
+
+func (k Keeper) insertHeaderBytes(ctx context.Context, headers []bbn.BTCHeaderBytes) ([]*types.BTCHeaderInfo, error) {
 	if len(headers) == 0 {
-		return types.ErrEmptyMessage
+		return nil, types.ErrEmptyMessage
 	}
 
 	blockHeaders := make([]*wire.BlockHeader, len(headers))
@@ -457,3 +457,80 @@ func FuzzKeeperValdateHeaderAtDifficultyAdjustmentBoundaries(f *testing.F) {
 		require.True(t, types.IsRetargetBlock(newTip, &chaincfg.SimNetParams))
 	})
 }
+
+func FuzzKeeperValidateHeaderWithRetargetContext(f *testing.F) {
+	// less seeds as we generate longer chains
+	datagen.AddRandomSeedsToFuzzer(f, 3)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		numBlockPerRetarget := types.BlocksPerRetarget(&chaincfg.SimNetParams)
+
+		genesisHeader := bbn.NewBTCHeaderBytesFromBlockHeader(&chaincfg.SimNetParams.GenesisBlock.Header)
+		genesisHash := bbn.NewBTCHeaderHashBytesFromChainhash(chaincfg.SimNetParams.GenesisHash)
+		genesisWork := sdkmath.NewUint(0)
+		genesisInfo := types.NewBTCHeaderInfo(&genesisHeader, &genesisHash, 0, &genesisWork)
+
+		// chain from height 1 up to the last header before the adjustment boundary
+		randomChain := datagen.NewBTCHeaderChainFromParentInfo(r, genesisInfo, uint32(numBlockPerRetarget)-1)
+		chainInfos := randomChain.GetChainInfo()
+		headerAtHeight := func(height uint64) *types.BTCHeaderInfo {
+			if height == 0 {
+				return genesisInfo
+			}
+			return chainInfos[height-1]
+		}
+
+		// base header is not at the adjustment boundary
+		baseHeight := datagen.RandomInt(r, int(numBlockPerRetarget)-2) + 1
+		baseInfo := headerAtHeight(baseHeight)
+		require.False(t, types.IsRetargetBlock(baseInfo, &chaincfg.SimNetParams))
+
+		prevTimestamps := make([]int64, 0, types.MedianTimeBlocks)
+		for h := baseHeight - uint64(types.NumPreviousTimestamps(baseHeight)); h < baseHeight; h++ {
+			prevTimestamps = append(prevTimestamps, headerAtHeight(h).Header.Time().Unix())
+		}
+		retargetCtx := types.NewRetargetContext(&genesisHeader, 0, prevTimestamps)
+		require.NoError(t, retargetCtx.Validate(baseInfo, &chaincfg.SimNetParams))
+
+		// keeper without retarget context cannot validate the adjusted header
+		blcKeeperNoCtx, ctxNoCtx := keepertest.BTCLightClientKeeper(t)
+		blcKeeperNoCtx.SetBaseBTCHeader(ctxNoCtx, *baseInfo)
+		// keeper with retarget context can
+		blcKeeper, ctx := keepertest.BTCLightClientKeeper(t)
+		blcKeeper.SetBaseBTCHeader(ctx, *baseInfo)
+		blcKeeper.SetRetargetContext(ctx, retargetCtx)
+		require.Equal(t, retargetCtx, blcKeeper.GetRetargetContext(ctx))
+
+		// all headers below the adjustment boundary are valid for both
+		remainingChain := keepertest.NewBTCHeaderBytesList(randomChain.Headers[baseHeight:])
+		err := blcKeeperNoCtx.InsertHeaders(ctxNoCtx, remainingChain)
+		require.NoError(t, err)
+		err = blcKeeper.InsertHeaders(ctx, remainingChain)
+		require.NoError(t, err)
+
+		currentTip := blcKeeper.GetTipInfo(ctx)
+		require.Equal(t, uint64(numBlockPerRetarget)-1, currentTip.Height)
+
+		rt := datagen.RetargetInfo{
+			LastRetargetHeader: genesisHeader.ToBlockHeader(),
+			Params:             &chaincfg.SimNetParams,
+		}
+		validAdjustedHeader := datagen.GenRandomBtcdValidHeader(
+			r,
+			currentTip.Header.ToBlockHeader(),
+			nil,
+			&rt,
+		)
+		validAdjustedHeaderBytes := bbn.NewBTCHeaderBytesFromBlockHeader(validAdjustedHeader)
+
+		err = blcKeeperNoCtx.InsertHeaders(ctxNoCtx, []bbn.BTCHeaderBytes{validAdjustedHeaderBytes})
+		require.Error(t, err)
+
+		err = blcKeeper.InsertHeaders(ctx, []bbn.BTCHeaderBytes{validAdjustedHeaderBytes})
+		require.NoError(t, err)
+
+		newTip := blcKeeper.GetTipInfo(ctx)
+		require.Equal(t, uint64(numBlockPerRetarget), newTip.Height)
+		require.True(t, newTip.Header.Eq(&validAdjustedHeaderBytes))
+	})
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/btclightclient params from consensus version 1 to 2.
+// Version 1 params only contained the allow list of reporters, so the reporter
+// bond, header reward and no-work fee decode as coins without denom, which are
+// set to their defaults along with the unbonding period of reporters.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaults := types.DefaultParams()
+	setDefault := func(coin *sdk.Coin, defaultCoin sdk.Coin) {
+		if coin.Denom == "" || coin.Amount.IsNil() {
+			*coin = defaultCoin
+		}
+	}
+	setDefault(&params.ReporterBond, defaults.ReporterBond)
+	setDefault(&params.HeaderReward, defaults.HeaderReward)
+	setDefault(&params.NoWorkFee, defaults.NoWorkFee)
+	params.ReporterUnbondingBlocks = defaults.ReporterUnbondingBlocks
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/btclightclient/keeper"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx, storeService := testkeeper.BTCLightClientKeeperWithCustomParams(t, types.DefaultParams())
+
+	// params as stored by consensus version 1, i.e., only the allow list
+	reporter := datagen.GenRandomAccount().GetAddress().String()
+	var bz []byte
+	bz = protowire.AppendTag(bz, 1, protowire.BytesType)
+	bz = protowire.AppendString(bz, reporter)
+	require.NoError(t, storeService.OpenKVStore(ctx).Set(types.ParamsKey, bz))
+	require.Error(t, k.GetParams(ctx).Validate())
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+
+	params := k.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	defaults := types.DefaultParams()
+	require.Equal(t, []string{reporter}, params.InsertHeadersAllowList)
+	require.Equal(t, defaults.ReporterBond, params.ReporterBond)
+	require.Equal(t, defaults.HeaderReward, params.HeaderReward)
+	require.Equal(t, defaults.NoWorkFee, params.NoWorkFee)
+	require.Equal(t, defaults.ReporterUnbondingBlocks, params.ReporterUnbondingBlocks)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
@@ -47,14 +48,66 @@ func (m msgServer) InsertHeaders(ctx context.Context, msg *types.MsgInsertHeader
 		return nil, types.ErrUnauthorizedReporter.Wrapf("reporter %s is not authorized to insert headers", reporterAddress)
 	}
 
-	err := m.k.InsertHeaders(sdkCtx, msg.Headers)
+	params := m.k.GetParams(sdkCtx)
+	if params.RequireReporterBond() && !m.k.hasReporterBond(sdkCtx, reporterAddress, &params) {
+		return nil, types.ErrInsufficientReporterBond.Wrapf("reporter %s has to post a bond of %s", reporterAddress, params.ReporterBond.String())
+	}
+
+	insertedHeaders, err := m.k.insertHeaderBytes(sdkCtx, msg.Headers)
+
+	// headers that are valid but do not add work to the heaviest chain are
+	// accepted without any effect, and the reporter is charged the no-work fee
+	// out of its bond
+	if errors.Is(err, types.ErrChainWithNotEnoughWork) && m.k.chargeNoWorkFee(sdkCtx, reporterAddress) {
+		return &types.MsgInsertHeadersResponse{}, nil
+	}
 
 	if err != nil {
 		return nil, err
 	}
+
+	m.k.rewardReporter(sdkCtx, reporterAddress, insertedHeaders)
+
 	return &types.MsgInsertHeadersResponse{}, nil
 }
 
+func (m msgServer) BondReporter(ctx context.Context, msg *types.MsgBondReporter) (*types.MsgBondReporterResponse, error) {
+	if msg == nil {
+		return nil, types.ErrEmptyMessage.Wrapf("message is nil")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := msg.ValidateStateless(); err != nil {
+		return nil, types.ErrInvalidMessageFormat.Wrapf("invalid bond reporter message: %v", err)
+	}
+
+	reporterAddress := sdk.MustAccAddressFromBech32(msg.Signer)
+	if err := m.k.bondReporter(sdkCtx, reporterAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBondReporterResponse{}, nil
+}
+
+func (m msgServer) UnbondReporter(ctx context.Context, msg *types.MsgUnbondReporter) (*types.MsgUnbondReporterResponse, error) {
+	if msg == nil {
+		return nil, types.ErrEmptyMessage.Wrapf("message is nil")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	reporterAddress, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, types.ErrInvalidMessageFormat.Wrapf("invalid unbond reporter message: %v", err)
+	}
+
+	amount, err := m.k.unbondReporter(sdkCtx, reporterAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnbondReporterResponse{Amount: amount}, nil
+}
+
 func (ms msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if ms.k.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
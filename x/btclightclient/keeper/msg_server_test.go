@@ -165,10 +165,9 @@ func TestAllowUpdatesOnlyFromReportesInTheList(t *testing.T) {
 	address3, err := sdk.AccAddressFromHexUnsafe(sender3.PubKey().Address().String())
 	require.NoError(t, err)
 
-	params := types.NewParams(
-		// only sender1 and sender2 are allowed to update
-		[]string{address1.String(), address2.String()},
-	)
+	params := types.DefaultParams()
+	// only sender1 and sender2 are allowed to update
+	params.InsertHeadersAllowList = []string{address1.String(), address2.String()}
 
 	srv, blcKeeper, sdkCtx := setupMsgServerWithCustomParams(t, params)
 	ctx := sdk.UnwrapSDKContext(sdkCtx)
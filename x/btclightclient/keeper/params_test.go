@@ -11,7 +11,8 @@ import (
 func TestGetParams(t *testing.T) {
 	k, ctx := testkeeper.BTCLightClientKeeper(t)
 	// using nil as empty params list as, default proto decoder deserializes empty list as nil
-	params := types.NewParams(nil)
+	params := types.DefaultParams()
+	params.InsertHeadersAllowList = nil
 
 	err := k.SetParams(ctx, params)
 	require.NoError(t, err)
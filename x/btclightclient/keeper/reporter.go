@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+// bondReporter transfers the given amount from the reporter's account to the
+// module account and adds it to the bond of the reporter
+func (k Keeper) bondReporter(ctx context.Context, reporter sdk.AccAddress, amount sdk.Coin) error {
+	params := k.GetParams(ctx)
+	if amount.Denom != params.ReporterBond.Denom {
+		return types.ErrInvalidBondAmount.Wrapf("expected denom %s, got %s", params.ReporterBond.Denom, amount.Denom)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, reporter, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	ri := k.getOrCreateReporterInfo(ctx, reporter)
+	ri.Bond = ri.Bond.Add(coins...)
+	k.SetReporterInfo(ctx, ri)
+	return nil
+}
+
+// unbondReporter returns the whole bond of the reporter to its account, and
+// returns the unbonded coins. The bond cannot be withdrawn within
+// ReporterUnbondingBlocks blocks of the latest submission of the reporter.
+func (k Keeper) unbondReporter(ctx context.Context, reporter sdk.AccAddress) (sdk.Coins, error) {
+	ri := k.GetReporterInfo(ctx, reporter)
+	if ri == nil || ri.Bond.IsZero() {
+		return nil, types.ErrReporterNotBonded.Wrapf("reporter %s", reporter.String())
+	}
+
+	if ri.HasSubmitted() {
+		height := sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+		unlockHeight := ri.LastSubmissionHeight + int64(k.GetParams(ctx).ReporterUnbondingBlocks)
+		if height < unlockHeight {
+			return nil, types.ErrReporterBondLocked.Wrapf("reporter %s can unbond from height %d", reporter.String(), unlockHeight)
+		}
+	}
+
+	bond := ri.Bond
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, reporter, bond); err != nil {
+		return nil, err
+	}
+
+	ri.Bond = sdk.NewCoins()
+	k.SetReporterInfo(ctx, ri)
+	return bond, nil
+}
+
+// hasReporterBond returns whether the reporter has posted the bond required
+// by the params
+func (k Keeper) hasReporterBond(ctx context.Context, reporter sdk.AccAddress, params *types.Params) bool {
+	ri := k.GetReporterInfo(ctx, reporter)
+	return ri != nil && ri.HasBond(params.ReporterBond)
+}
+
+// chargeNoWorkFee charges the no-work fee out of the bond of the reporter and
+// sends it to the fee collector. It returns false if the reporter cannot be
+// charged, i.e., the fee is zero or the reporter has not posted a bond.
+func (k Keeper) chargeNoWorkFee(ctx context.Context, reporter sdk.AccAddress) bool {
+	params := k.GetParams(ctx)
+	ri := k.GetReporterInfo(ctx, reporter)
+	if !params.NoWorkFee.IsPositive() || ri == nil || ri.Bond.IsZero() {
+		return false
+	}
+
+	// the fee is bounded by the remaining bond of the reporter
+	fee := ri.Bond.Min(sdk.NewCoins(params.NoWorkFee))
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, fee); err != nil {
+			// the bond is held by the module account, so this can only be a
+			// programming error
+			panic(err)
+		}
+	}
+
+	ri.Bond = ri.Bond.Sub(fee...)
+	ri.FeesCharged = ri.FeesCharged.Add(fee...)
+	ri.NoWorkSubmissions++
+	ri.LastSubmissionHeight = sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+	k.SetReporterInfo(ctx, ri)
+	return true
+}
+
+// rewardReporter records the headers of the reporter that extended the
+// heaviest chain, and rewards the reporter for them via the incentive module
+func (k Keeper) rewardReporter(ctx context.Context, reporter sdk.AccAddress, headers []*types.BTCHeaderInfo) {
+	params := k.GetParams(ctx)
+	ri := k.getOrCreateReporterInfo(ctx, reporter)
+
+	if len(headers) > 0 && params.HeaderReward.IsPositive() && k.incentiveKeeper != nil {
+		reward := sdk.NewCoins(sdk.NewCoin(
+			params.HeaderReward.Denom,
+			params.HeaderReward.Amount.MulRaw(int64(len(headers))),
+		))
+		rewarded := k.incentiveKeeper.RewardBTCHeaderRelaying(ctx, reporter, reward)
+		ri.Rewards = ri.Rewards.Add(rewarded...)
+	}
+
+	ri.HeadersInserted += uint64(len(headers))
+	ri.LastSubmissionHeight = sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+	k.SetReporterInfo(ctx, ri)
+}
+
+func (k Keeper) getOrCreateReporterInfo(ctx context.Context, reporter sdk.AccAddress) *types.ReporterInfo {
+	ri := k.GetReporterInfo(ctx, reporter)
+	if ri == nil {
+		ri = types.NewReporterInfo(reporter)
+	}
+	return ri
+}
+
+func (k Keeper) SetReporterInfo(ctx context.Context, ri *types.ReporterInfo) {
+	store := k.reporterInfoStore(ctx)
+	store.Set(sdk.MustAccAddressFromBech32(ri.Address), k.cdc.MustMarshal(ri))
+}
+
+// GetReporterInfo returns the bond and the statistics of the given reporter,
+// or nil if the reporter has never bonded or submitted headers
+func (k Keeper) GetReporterInfo(ctx context.Context, reporter sdk.AccAddress) *types.ReporterInfo {
+	store := k.reporterInfoStore(ctx)
+	riBytes := store.Get(reporter)
+	if riBytes == nil {
+		return nil
+	}
+
+	var ri types.ReporterInfo
+	k.cdc.MustUnmarshal(riBytes, &ri)
+	return &ri
+}
+
+// GetAllReporterInfos returns the bonds and the statistics of all reporters
+func (k Keeper) GetAllReporterInfos(ctx context.Context) []*types.ReporterInfo {
+	store := k.reporterInfoStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	reporters := []*types.ReporterInfo{}
+	for ; iter.Valid(); iter.Next() {
+		var ri types.ReporterInfo
+		k.cdc.MustUnmarshal(iter.Value(), &ri)
+		reporters = append(reporters, &ri)
+	}
+	return reporters
+}
+
+// reporterInfoStore returns the KVStore of the bonds and statistics of the
+// reporters of BTC headers
+// prefix: ReporterInfoPrefix
+// key: reporter address
+// value: ReporterInfo
+func (k Keeper) reporterInfoStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.ReporterInfoPrefix)
+}
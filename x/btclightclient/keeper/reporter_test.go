@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/app"
+	appparams "github.com/babylonchain/babylon/app/params"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/btclightclient/keeper"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+	incentivetypes "github.com/babylonchain/babylon/x/incentive/types"
+)
+
+func FuzzPermissionlessReporters(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 5)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		k := babylonApp.BTCLightClientKeeper
+		ctx := babylonApp.NewContext(false)
+		srv := keeper.NewMsgServerImpl(k)
+		queryHelper := baseapp.NewQueryServerTestHelper(ctx, babylonApp.InterfaceRegistry())
+		types.RegisterQueryServer(queryHelper, k)
+		queryClient := types.NewQueryClient(queryHelper)
+
+		coin := func(amount int64) sdk.Coin {
+			return sdk.NewInt64Coin(appparams.DefaultBondDenom, amount)
+		}
+		params := types.DefaultParams()
+		params.ReporterBond = coin(1000)
+		params.HeaderReward = coin(10)
+		params.NoWorkFee = coin(600)
+		require.NoError(t, k.SetParams(ctx, params))
+
+		// fund the BTC timestamping gauge of the current epoch
+		epoch := babylonApp.EpochingKeeper.GetEpoch(ctx).EpochNumber
+		babylonApp.IncentiveKeeper.SetBTCTimestampingGauge(ctx, epoch, incentivetypes.NewGauge(coin(1_000_000)))
+
+		initBalance := sdkmath.NewInt(1_000_000)
+		reporters, err := app.AddTestAddrs(babylonApp, ctx, 2, initBalance)
+		require.NoError(t, err)
+		reporter1, reporter2 := reporters[0], reporters[1]
+
+		insertHeaders := func(reporter sdk.AccAddress, chain *datagen.BTCHeaderPartialChain) error {
+			_, err := srv.InsertHeaders(ctx, &types.MsgInsertHeaders{
+				Signer:  reporter.String(),
+				Headers: chain.ChainToBytes(),
+			})
+			return err
+		}
+
+		// reporters without a bond cannot insert headers
+		chain := datagen.NewBTCHeaderChainFromParentInfo(r, k.GetTipInfo(ctx), uint32(datagen.RandomInt(r, 10)+1))
+		err = insertHeaders(reporter1, chain)
+		require.ErrorIs(t, err, types.ErrInsufficientReporterBond)
+
+		// bonds of other denoms are rejected
+		_, err = srv.BondReporter(ctx, types.NewMsgBondReporter(reporter1, sdk.NewInt64Coin("other", 1000)))
+		require.ErrorIs(t, err, types.ErrInvalidBondAmount)
+
+		for _, reporter := range reporters {
+			_, err = srv.BondReporter(ctx, types.NewMsgBondReporter(reporter, params.ReporterBond))
+			require.NoError(t, err)
+		}
+
+		// headers extending the heaviest chain are rewarded
+		err = insertHeaders(reporter1, chain)
+		require.NoError(t, err)
+		numHeaders := uint64(len(chain.Headers))
+		expectedReward := sdk.NewCoins(coin(10 * int64(numHeaders)))
+
+		res, err := queryClient.Reporter(ctx, types.NewQueryReporterRequest(reporter1.String()))
+		require.NoError(t, err)
+		require.Equal(t, numHeaders, res.Reporter.HeadersInserted)
+		require.Equal(t, expectedReward, res.Reporter.Rewards)
+		require.Equal(t, sdk.NewCoins(params.ReporterBond), res.Reporter.Bond)
+		rg := babylonApp.IncentiveKeeper.GetRewardGauge(ctx, incentivetypes.ReporterType, reporter1)
+		require.NotNil(t, rg)
+		require.Equal(t, expectedReward, rg.Coins)
+
+		// submitting the same headers again adds no work, so the submission
+		// is accepted and the fee is charged out of the bond
+		err = insertHeaders(reporter2, chain)
+		require.NoError(t, err)
+		res, err = queryClient.Reporter(ctx, types.NewQueryReporterRequest(reporter2.String()))
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), res.Reporter.HeadersInserted)
+		require.Equal(t, uint64(1), res.Reporter.NoWorkSubmissions)
+		require.Equal(t, sdk.NewCoins(params.NoWorkFee), res.Reporter.FeesCharged)
+		require.Equal(t, sdk.NewCoins(coin(400)), res.Reporter.Bond)
+
+		// the remaining bond is insufficient for inserting more headers
+		chain = datagen.NewBTCHeaderChainFromParentInfo(r, k.GetTipInfo(ctx), 1)
+		err = insertHeaders(reporter2, chain)
+		require.ErrorIs(t, err, types.ErrInsufficientReporterBond)
+
+		// the bond is locked for a while after the latest submission
+		_, err = srv.UnbondReporter(ctx, types.NewMsgUnbondReporter(reporter2))
+		require.ErrorIs(t, err, types.ErrReporterBondLocked)
+		unlockHeight := uint64(ctx.HeaderInfo().Height) + params.ReporterUnbondingBlocks
+		_, err = srv.UnbondReporter(datagen.WithCtxHeight(ctx, unlockHeight-1), types.NewMsgUnbondReporter(reporter2))
+		require.ErrorIs(t, err, types.ErrReporterBondLocked)
+		ctx = datagen.WithCtxHeight(ctx, unlockHeight)
+
+		// unbonding returns the remaining bond
+		unbondRes, err := srv.UnbondReporter(ctx, types.NewMsgUnbondReporter(reporter2))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(coin(400)), unbondRes.Amount)
+		balance := babylonApp.BankKeeper.GetBalance(ctx, reporter2, appparams.DefaultBondDenom)
+		require.Equal(t, initBalance.SubRaw(600), balance.Amount)
+		_, err = srv.UnbondReporter(ctx, types.NewMsgUnbondReporter(reporter2))
+		require.ErrorIs(t, err, types.ErrReporterNotBonded)
+
+		// statistics of all reporters are kept
+		resList, err := queryClient.Reporters(ctx, types.NewQueryReportersRequest(nil))
+		require.NoError(t, err)
+		require.Len(t, resList.Reporters, 2)
+	})
+}
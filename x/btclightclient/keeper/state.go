@@ -16,6 +16,7 @@ import (
 
 type headersState struct {
 	cdc          codec.BinaryCodec
+	store        storetypes.KVStore
 	headers      storetypes.KVStore
 	hashToHeight storetypes.KVStore
 }
@@ -25,6 +26,7 @@ func (k Keeper) headersState(ctx context.Context) headersState {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return headersState{
 		cdc:          k.cdc,
+		store:        storeAdapter,
 		headers:      prefix.NewStore(storeAdapter, types.HeadersObjectPrefix),
 		hashToHeight: prefix.NewStore(storeAdapter, types.HashToHeightPrefix),
 	}
@@ -194,3 +196,21 @@ func (s headersState) BaseHeader() *types.BTCHeaderInfo {
 	s.IterateForwardHeaders(0, handleBaseHeaderFn)
 	return baseHeader
 }
+
+// setRetargetContext stores the retarget context of the base header
+func (s headersState) setRetargetContext(rc *types.RetargetContext) {
+	s.store.Set(types.RetargetContextKey, s.cdc.MustMarshal(rc))
+}
+
+// GetRetargetContext returns the retarget context of the base header, or nil
+// if the base header was set without one
+func (s headersState) GetRetargetContext() *types.RetargetContext {
+	bz := s.store.Get(types.RetargetContextKey)
+	if bz == nil {
+		return nil
+	}
+
+	var rc types.RetargetContext
+	s.cdc.MustUnmarshal(bz, &rc)
+	return &rc
+}
@@ -16,6 +16,8 @@ type BtcChainReadStore interface {
 	GetHeaderByHash(hash *bbn.BTCHeaderHashBytes) (*BTCHeaderInfo, error)
 	GetHeaderByHeight(height uint64) (*BTCHeaderInfo, error)
 	GetTip() *BTCHeaderInfo
+	BaseHeader() *BTCHeaderInfo
+	GetRetargetContext() *RetargetContext
 }
 
 // Copy from neutrino light client
@@ -189,7 +191,9 @@ func (l *lightHeaderCtx) RelativeAncestorCtx(
 	ancestor := l.store.getHeaderAtHeight(ancU64)
 
 	if ancestor == nil {
-		return nil
+		// the ancestor may be below the base header, in which case the retarget
+		// context may still know about it
+		return l.store.getRetargetAncestorCtx(ancU64)
 	}
 
 	return newLightHeaderCtx(
@@ -267,6 +271,11 @@ func (d *DisableHeaderInTheFutureValidationTimeSource) Offset() time.Duration {
 type storeWithExtensionChain struct {
 	headers []*localHeaderInfo
 	store   BtcChainReadStore
+	// retarget context and base header are lazily loaded, as they are only
+	// needed when validating headers close to the base header
+	retargetCtxLoaded bool
+	retargetCtx       *RetargetContext
+	baseHeader        *BTCHeaderInfo
 }
 
 func newStoreWithExtensionChain(
@@ -299,6 +308,52 @@ func (s *storeWithExtensionChain) getHeaderAtHeight(height uint64) *localHeaderI
 	}
 }
 
+func (s *storeWithExtensionChain) loadRetargetContext() {
+	if s.retargetCtxLoaded {
+		return
+	}
+	s.retargetCtxLoaded = true
+	s.retargetCtx = s.store.GetRetargetContext()
+	if s.retargetCtx != nil {
+		s.baseHeader = s.store.BaseHeader()
+	}
+}
+
+// getRetargetAncestorCtx returns the header context of a header below the base
+// header, built from the retarget context. Only the first header of the
+// difficulty adjustment period and the headers whose timestamps are in the
+// retarget context are known. All of them share the difficulty of the period
+// start header.
+func (s *storeWithExtensionChain) getRetargetAncestorCtx(height uint64) blockchain.HeaderCtx {
+	s.loadRetargetContext()
+
+	if s.retargetCtx == nil || s.baseHeader == nil || height >= s.baseHeader.Height {
+		return nil
+	}
+
+	periodStart := s.retargetCtx.PeriodStartHeader
+	if height == s.retargetCtx.PeriodStartHeight {
+		return &lightHeaderCtx{
+			height:    height,
+			bits:      periodStart.Bits(),
+			timestamp: periodStart.Time().Unix(),
+			store:     s,
+		}
+	}
+
+	timestamp, ok := s.retargetCtx.PreviousTimestampAt(s.baseHeader.Height, height)
+	if !ok {
+		return nil
+	}
+
+	return &lightHeaderCtx{
+		height:    height,
+		bits:      periodStart.Bits(),
+		timestamp: timestamp,
+		store:     s,
+	}
+}
+
 func (l *BtcLightClient) processNewHeadersChain(
 	store *storeWithExtensionChain,
 	chainParent *localHeaderInfo,
@@ -407,7 +462,7 @@ func (l *BtcLightClient) InsertHeaders(readStore BtcChainReadStore, headers []*w
 // checkHeader checks if the header is valid and can be added to the store.
 // One criticial condition is that to properly validate difficulty adjustments
 // we should have at least one header which is at difficulty adjustment boundary
-// in store.
+// in store, or a retarget context describing the period of the base header.
 func (l *BtcLightClient) checkHeader(
 	s *storeWithExtensionChain,
 	parentHeaderInfo *localHeaderInfo,
@@ -7,6 +7,9 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -80,8 +83,179 @@ func (m *BTCHeaderInfo) GetHeight() uint64 {
 	return 0
 }
 
+// RetargetContext carries the information needed to validate headers built on
+// top of a base header that is not at a difficulty adjustment boundary:
+//   - The first header of the difficulty adjustment period the base header
+//     belongs to, used to compute the next difficulty adjustment
+//   - Height of that first header in the BTC chain
+//   - Timestamps of the headers preceding the base header, used to compute the
+//     median time past of the first headers after the base header
+type RetargetContext struct {
+	// period_start_header is the first header of the difficulty adjustment
+	// period of the base header
+	PeriodStartHeader *github_com_babylonchain_babylon_types.BTCHeaderBytes `protobuf:"bytes,1,opt,name=period_start_header,json=periodStartHeader,proto3,customtype=github.com/babylonchain/babylon/types.BTCHeaderBytes" json:"period_start_header,omitempty"`
+	// period_start_height is the height of period_start_header
+	PeriodStartHeight uint64 `protobuf:"varint,2,opt,name=period_start_height,json=periodStartHeight,proto3" json:"period_start_height,omitempty"`
+	// previous_timestamps are the unix timestamps of the headers immediately
+	// preceding the base header, ordered from the oldest to the newest. It must
+	// contain min(11, base_height) entries.
+	PreviousTimestamps []int64 `protobuf:"varint,3,rep,packed,name=previous_timestamps,json=previousTimestamps,proto3" json:"previous_timestamps,omitempty"`
+}
+
+func (m *RetargetContext) Reset()         { *m = RetargetContext{} }
+func (m *RetargetContext) String() string { return proto.CompactTextString(m) }
+func (*RetargetContext) ProtoMessage()    {}
+func (*RetargetContext) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{1}
+}
+func (m *RetargetContext) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetargetContext) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetargetContext.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetargetContext) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetargetContext.Merge(m, src)
+}
+func (m *RetargetContext) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetargetContext) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetargetContext.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetargetContext proto.InternalMessageInfo
+
+func (m *RetargetContext) GetPeriodStartHeight() uint64 {
+	if m != nil {
+		return m.PeriodStartHeight
+	}
+	return 0
+}
+
+func (m *RetargetContext) GetPreviousTimestamps() []int64 {
+	if m != nil {
+		return m.PreviousTimestamps
+	}
+	return nil
+}
+
+// ReporterInfo is the bond and the statistics of a reporter of BTC headers
+type ReporterInfo struct {
+	// address is the address of the reporter
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// bond is the bond currently posted by the reporter
+	Bond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=bond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bond"`
+	// headers_inserted is the number of headers submitted by the reporter that
+	// extended the heaviest chain
+	HeadersInserted uint64 `protobuf:"varint,3,opt,name=headers_inserted,json=headersInserted,proto3" json:"headers_inserted,omitempty"`
+	// no_work_submissions is the number of submissions of the reporter that did
+	// not add work to the heaviest chain
+	NoWorkSubmissions uint64 `protobuf:"varint,4,opt,name=no_work_submissions,json=noWorkSubmissions,proto3" json:"no_work_submissions,omitempty"`
+	// rewards is the total rewards of the reporter for relaying headers
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// fees_charged is the total fees charged out of the bond of the reporter
+	FeesCharged github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fees_charged,json=feesCharged,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_charged"`
+	// last_submission_height is the Babylon height of the latest submission of
+	// the reporter
+	LastSubmissionHeight int64 `protobuf:"varint,7,opt,name=last_submission_height,json=lastSubmissionHeight,proto3" json:"last_submission_height,omitempty"`
+}
+
+func (m *ReporterInfo) Reset()         { *m = ReporterInfo{} }
+func (m *ReporterInfo) String() string { return proto.CompactTextString(m) }
+func (*ReporterInfo) ProtoMessage()    {}
+func (*ReporterInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{2}
+}
+func (m *ReporterInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReporterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReporterInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReporterInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReporterInfo.Merge(m, src)
+}
+func (m *ReporterInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReporterInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReporterInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReporterInfo proto.InternalMessageInfo
+
+func (m *ReporterInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ReporterInfo) GetBond() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bond
+	}
+	return nil
+}
+
+func (m *ReporterInfo) GetHeadersInserted() uint64 {
+	if m != nil {
+		return m.HeadersInserted
+	}
+	return 0
+}
+
+func (m *ReporterInfo) GetNoWorkSubmissions() uint64 {
+	if m != nil {
+		return m.NoWorkSubmissions
+	}
+	return 0
+}
+
+func (m *ReporterInfo) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *ReporterInfo) GetFeesCharged() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesCharged
+	}
+	return nil
+}
+
+func (m *ReporterInfo) GetLastSubmissionHeight() int64 {
+	if m != nil {
+		return m.LastSubmissionHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BTCHeaderInfo)(nil), "babylon.btclightclient.v1.BTCHeaderInfo")
+	proto.RegisterType((*RetargetContext)(nil), "babylon.btclightclient.v1.RetargetContext")
+	proto.RegisterType((*ReporterInfo)(nil), "babylon.btclightclient.v1.ReporterInfo")
 }
 
 func init() {
@@ -89,25 +263,45 @@ func init() {
 }
 
 var fileDescriptor_84bf438d909b681d = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0x68, 0x22, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x92, 0x50, 0xf5, 0x7a,
-	0x68, 0xb2, 0x65, 0x86, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x55, 0xfa, 0x20, 0x16, 0x44,
-	0x83, 0xd2, 0x6f, 0x46, 0x2e, 0x5e, 0xa7, 0x10, 0x67, 0x8f, 0xd4, 0xc4, 0x94, 0xd4, 0x22, 0xcf,
-	0xbc, 0xb4, 0x7c, 0xa1, 0x00, 0x2e, 0xb6, 0x0c, 0x30, 0x4f, 0x82, 0x51, 0x81, 0x51, 0x83, 0xc7,
-	0xc9, 0xe2, 0xd6, 0x3d, 0x79, 0x93, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0x7d, 0xa8, 0x0d, 0xc9, 0x19, 0x89, 0x99, 0x79, 0x30, 0x8e, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0xb1,
-	0x1e, 0xdc, 0x20, 0xa7, 0xca, 0x92, 0xd4, 0xe2, 0x20, 0xa8, 0x39, 0x42, 0x01, 0x5c, 0x2c, 0x19,
-	0x89, 0xc5, 0x19, 0x12, 0x4c, 0x60, 0xf3, 0x6c, 0x6e, 0xdd, 0x93, 0xb7, 0x20, 0xd1, 0x3c, 0x8f,
-	0xc4, 0xe2, 0x0c, 0x88, 0x99, 0x60, 0x93, 0x84, 0xc4, 0x40, 0x6e, 0x04, 0x79, 0x4f, 0x82, 0x59,
-	0x81, 0x51, 0x83, 0x25, 0x08, 0xca, 0x13, 0xd2, 0xe3, 0x62, 0x29, 0xcf, 0x2f, 0xca, 0x96, 0x60,
-	0x01, 0xdb, 0x24, 0x75, 0xeb, 0x9e, 0xbc, 0x58, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x71, 0x4a,
-	0xb6, 0x5e, 0x66, 0xbe, 0x7e, 0x6e, 0x62, 0x49, 0x86, 0x5e, 0x68, 0x66, 0x5e, 0x49, 0x10, 0x58,
-	0x9d, 0x53, 0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0x11, 0x72,
-	0x61, 0x05, 0x7a, 0x94, 0x80, 0x9d, 0x9c, 0xc4, 0x06, 0x0e, 0x56, 0x63, 0x40, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x13, 0xc6, 0x69, 0x40, 0xb9, 0x01, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbd, 0x4e, 0x1b, 0x41,
+	0x10, 0xf6, 0xe1, 0x0b, 0x28, 0x0b, 0x11, 0x61, 0x41, 0xe8, 0xa0, 0x38, 0x5b, 0x54, 0x4e, 0xc1,
+	0x5e, 0x4c, 0x50, 0x44, 0x91, 0x26, 0x76, 0x03, 0x1d, 0x3a, 0x88, 0x22, 0xa5, 0x39, 0xed, 0xf9,
+	0x16, 0xdf, 0xca, 0xdc, 0xee, 0x69, 0x67, 0x31, 0xf0, 0x0c, 0x69, 0xf2, 0x1c, 0xa9, 0xf3, 0x10,
+	0x94, 0x28, 0x55, 0x84, 0x22, 0x27, 0x82, 0xc7, 0x48, 0x13, 0xed, 0x8f, 0x81, 0x90, 0x22, 0x8a,
+	0x44, 0x63, 0xdf, 0xcc, 0x37, 0xf7, 0xcd, 0xcc, 0x37, 0x9f, 0x0e, 0x91, 0x9c, 0xe6, 0xe7, 0xc7,
+	0x52, 0x24, 0xb9, 0x1e, 0x1c, 0xf3, 0x61, 0x69, 0x7e, 0x99, 0xd0, 0xc9, 0xb8, 0xfb, 0x20, 0x43,
+	0x6a, 0x25, 0xb5, 0xc4, 0x6b, 0xbe, 0x9e, 0x3c, 0x40, 0xc7, 0xdd, 0xf5, 0x95, 0xa1, 0x1c, 0x4a,
+	0x5b, 0x95, 0x98, 0x27, 0xf7, 0xc2, 0xfa, 0xda, 0x40, 0x42, 0x25, 0x21, 0x73, 0x80, 0x0b, 0x3c,
+	0x14, 0xbb, 0x28, 0xc9, 0x29, 0xb0, 0x64, 0xdc, 0xcd, 0x99, 0xa6, 0xdd, 0x64, 0x20, 0xb9, 0x70,
+	0xf8, 0xc6, 0xaf, 0x00, 0x3d, 0xeb, 0x1d, 0xf6, 0x77, 0x19, 0x2d, 0x98, 0xda, 0x13, 0x47, 0x12,
+	0xef, 0xa3, 0xd9, 0xd2, 0x46, 0x51, 0xd0, 0x0e, 0x3a, 0x0b, 0xbd, 0x9d, 0xab, 0x49, 0x6b, 0x7b,
+	0xc8, 0x75, 0x79, 0x92, 0x93, 0x81, 0xac, 0x12, 0x3f, 0xdc, 0xa0, 0xa4, 0x5c, 0x4c, 0x83, 0x44,
+	0x9f, 0xd7, 0x0c, 0xc8, 0x2d, 0x51, 0xef, 0x5c, 0x33, 0x48, 0x3d, 0x0f, 0xde, 0x47, 0x61, 0x49,
+	0xa1, 0x8c, 0x66, 0x2c, 0xdf, 0x9b, 0xab, 0x49, 0x6b, 0xe7, 0x3f, 0xf9, 0x76, 0x29, 0x94, 0x8e,
+	0xd3, 0x32, 0xe1, 0x55, 0x33, 0xa3, 0x51, 0x26, 0x6a, 0xb6, 0x83, 0x4e, 0x98, 0xfa, 0x08, 0x13,
+	0x14, 0x9e, 0x4a, 0x35, 0x8a, 0x42, 0xdb, 0x69, 0xfd, 0x6a, 0xd2, 0x5a, 0x75, 0xfb, 0x43, 0x31,
+	0x22, 0x5c, 0x26, 0x15, 0xd5, 0x25, 0x79, 0xc7, 0x85, 0x4e, 0x6d, 0xdd, 0xc6, 0xf7, 0x00, 0x2d,
+	0xa6, 0x4c, 0x53, 0x35, 0x64, 0xba, 0x2f, 0x85, 0x66, 0x67, 0x1a, 0x97, 0x68, 0xb9, 0x66, 0x8a,
+	0xcb, 0x22, 0x03, 0x4d, 0x95, 0xce, 0x1e, 0x49, 0x8c, 0x25, 0x47, 0x7a, 0x60, 0x38, 0x5d, 0x1e,
+	0x93, 0xbf, 0x3a, 0xd9, 0x95, 0x66, 0xec, 0x4a, 0x7f, 0xd6, 0xdb, 0xed, 0x12, 0xb4, 0x5c, 0x2b,
+	0x36, 0xe6, 0xf2, 0x04, 0x32, 0xcd, 0x2b, 0x06, 0x9a, 0x56, 0x35, 0x44, 0xcd, 0x76, 0xb3, 0xd3,
+	0x4c, 0xf1, 0x14, 0x3a, 0xbc, 0x45, 0x36, 0x3e, 0x86, 0x68, 0x21, 0x65, 0xb5, 0x54, 0xda, 0xdf,
+	0x76, 0x0b, 0xcd, 0xd1, 0xa2, 0x50, 0x0c, 0xc0, 0xee, 0xf3, 0xb4, 0x17, 0x7d, 0xfd, 0xb2, 0xb9,
+	0xe2, 0x0d, 0xf3, 0xd6, 0x21, 0x07, 0x5a, 0x71, 0x31, 0x4c, 0xa7, 0x85, 0x38, 0x43, 0x61, 0x2e,
+	0x45, 0x11, 0xcd, 0xb4, 0x9b, 0x9d, 0xf9, 0xad, 0x35, 0xe2, 0xab, 0x8d, 0xa1, 0x88, 0x37, 0x14,
+	0xe9, 0x4b, 0x2e, 0x7a, 0x2f, 0x2f, 0x26, 0xad, 0xc6, 0xe7, 0x1f, 0xad, 0xce, 0x3d, 0x7d, 0xbc,
+	0xfb, 0xdc, 0xdf, 0x26, 0x14, 0x23, 0x2f, 0x8e, 0x79, 0x01, 0x52, 0x4b, 0x8c, 0x5f, 0xa0, 0xe7,
+	0x4e, 0x63, 0xc8, 0xb8, 0x00, 0xa6, 0x34, 0x2b, 0xfc, 0x59, 0x17, 0x7d, 0x7e, 0xcf, 0xa7, 0x8d,
+	0x62, 0x42, 0x66, 0xe6, 0x74, 0x19, 0x9c, 0xe4, 0x15, 0x07, 0xe0, 0x52, 0x80, 0x3d, 0x77, 0x98,
+	0x2e, 0x09, 0xf9, 0x5e, 0xaa, 0xd1, 0xc1, 0x1d, 0x80, 0x19, 0x9a, 0x53, 0xec, 0x94, 0xaa, 0x02,
+	0xa2, 0x27, 0x8f, 0x3f, 0xfe, 0x94, 0x1b, 0x0b, 0xb4, 0x70, 0xc4, 0x18, 0x64, 0x83, 0xd2, 0x38,
+	0xa9, 0x88, 0x66, 0x1f, 0xbf, 0xd7, 0xbc, 0x69, 0xd0, 0x77, 0xfc, 0x78, 0x1b, 0xad, 0x1e, 0x53,
+	0xd0, 0xf7, 0x34, 0x98, 0x7a, 0x67, 0xae, 0x1d, 0x74, 0x9a, 0xe9, 0x8a, 0x41, 0xef, 0x74, 0x70,
+	0xf6, 0xe9, 0xed, 0x5f, 0x5c, 0xc7, 0xc1, 0xe5, 0x75, 0x1c, 0xfc, 0xbc, 0x8e, 0x83, 0x4f, 0x37,
+	0x71, 0xe3, 0xf2, 0x26, 0x6e, 0x7c, 0xbb, 0x89, 0x1b, 0x1f, 0x5e, 0xff, 0xcb, 0xd1, 0x67, 0x0f,
+	0x3f, 0x5d, 0x76, 0xb4, 0x7c, 0xd6, 0x7e, 0x43, 0x5e, 0xfd, 0x1e, 0x00, 0xbf, 0x41, 0xdc, 0x16,
+	0xe1, 0x04, 0x00, 0x00,
 }
 
 func (m *BTCHeaderInfo) Marshal() (dAtA []byte, err error) {
@@ -174,6 +368,152 @@ func (m *BTCHeaderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetargetContext) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetargetContext) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetargetContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousTimestamps) > 0 {
+		dAtA2 := make([]byte, len(m.PreviousTimestamps)*10)
+		var j1 int
+		for _, num1 := range m.PreviousTimestamps {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBtclightclient(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PeriodStartHeight != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.PeriodStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PeriodStartHeader != nil {
+		{
+			size := m.PeriodStartHeader.Size()
+			i -= size
+			if _, err := m.PeriodStartHeader.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReporterInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReporterInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReporterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastSubmissionHeight != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.LastSubmissionHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FeesCharged) > 0 {
+		for iNdEx := len(m.FeesCharged) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesCharged[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NoWorkSubmissions != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.NoWorkSubmissions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HeadersInserted != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.HeadersInserted))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bond) > 0 {
+		for iNdEx := len(m.Bond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBtclightclient(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBtclightclient(v)
 	base := offset
@@ -209,6 +549,69 @@ func (m *BTCHeaderInfo) Size() (n int) {
 	return n
 }
 
+func (m *RetargetContext) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodStartHeader != nil {
+		l = m.PeriodStartHeader.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if m.PeriodStartHeight != 0 {
+		n += 1 + sovBtclightclient(uint64(m.PeriodStartHeight))
+	}
+	if len(m.PreviousTimestamps) > 0 {
+		l = 0
+		for _, e := range m.PreviousTimestamps {
+			l += sovBtclightclient(uint64(e))
+		}
+		n += 1 + sovBtclightclient(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ReporterInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if len(m.Bond) > 0 {
+		for _, e := range m.Bond {
+			l = e.Size()
+			n += 1 + l + sovBtclightclient(uint64(l))
+		}
+	}
+	if m.HeadersInserted != 0 {
+		n += 1 + sovBtclightclient(uint64(m.HeadersInserted))
+	}
+	if m.NoWorkSubmissions != 0 {
+		n += 1 + sovBtclightclient(uint64(m.NoWorkSubmissions))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovBtclightclient(uint64(l))
+		}
+	}
+	if len(m.FeesCharged) > 0 {
+		for _, e := range m.FeesCharged {
+			l = e.Size()
+			n += 1 + l + sovBtclightclient(uint64(l))
+		}
+	}
+	if m.LastSubmissionHeight != 0 {
+		n += 1 + sovBtclightclient(uint64(m.LastSubmissionHeight))
+	}
+	return n
+}
+
 func sovBtclightclient(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -389,6 +792,427 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RetargetContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetargetContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetargetContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStartHeader", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderBytes
+			m.PeriodStartHeader = &v
+			if err := m.PeriodStartHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStartHeight", wireType)
+			}
+			m.PeriodStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtclightclient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PreviousTimestamps = append(m.PreviousTimestamps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtclightclient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBtclightclient
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBtclightclient
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PreviousTimestamps) == 0 {
+					m.PreviousTimestamps = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBtclightclient
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PreviousTimestamps = append(m.PreviousTimestamps, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTimestamps", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReporterInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReporterInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReporterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bond = append(m.Bond, types.Coin{})
+			if err := m.Bond[len(m.Bond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadersInserted", wireType)
+			}
+			m.HeadersInserted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadersInserted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWorkSubmissions", wireType)
+			}
+			m.NoWorkSubmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NoWorkSubmissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesCharged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesCharged = append(m.FeesCharged, types.Coin{})
+			if err := m.FeesCharged[len(m.FeesCharged)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSubmissionHeight", wireType)
+			}
+			m.LastSubmissionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSubmissionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBtclightclient(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,4 +16,8 @@ var (
 	ErrChainWithNotEnoughWork   = errorsmod.Register(ModuleName, 1105, "provided chain has not enough work")
 	ErrUnauthorizedReporter     = errorsmod.Register(ModuleName, 1106, "unauthorized reporter")
 	ErrInvalidMessageFormat     = errorsmod.Register(ModuleName, 1107, "invalid message format")
+	ErrInsufficientReporterBond = errorsmod.Register(ModuleName, 1108, "reporter bond is insufficient")
+	ErrReporterNotBonded        = errorsmod.Register(ModuleName, 1109, "reporter has not posted a bond")
+	ErrInvalidBondAmount        = errorsmod.Register(ModuleName, 1110, "invalid bond amount")
+	ErrReporterBondLocked       = errorsmod.Register(ModuleName, 1111, "reporter bond is locked by a recent submission")
 )
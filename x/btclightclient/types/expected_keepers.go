@@ -2,8 +2,20 @@ package types
 
 import (
 	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+type IncentiveKeeper interface {
+	RewardBTCHeaderRelaying(ctx context.Context, reporter sdk.AccAddress, reward sdk.Coins) sdk.Coins
+}
+
 type BTCLightClientHooks interface {
 	AfterBTCRollBack(ctx context.Context, headerInfo *BTCHeaderInfo)       // Must be called after the chain is rolled back
 	AfterBTCRollForward(ctx context.Context, headerInfo *BTCHeaderInfo)    // Must be called after the chain is rolled forward
@@ -55,14 +55,18 @@ func (gs GenesisState) Validate() error {
 		return errors.New("no btc header set on genesis")
 	}
 
-	// We Require that genesis block is difficulty adjustment block, so that we can
-	// properly calculate the difficulty adjustments in the future.
+	// We Require that genesis block is difficulty adjustment block, or that a
+	// retarget context describing its difficulty adjustment period is provided,
+	// so that we can properly calculate the difficulty adjustments in the future.
 	// TODO: Even though number of block per re-target depends on the network, in reality it
 	// is always 2016. Maybe we should consider moving it to param, or try to pass
 	// it through
-	isRetarget := IsRetargetBlock(gs.BtcHeaders[0], &chaincfg.MainNetParams)
-	if !isRetarget {
-		return fmt.Errorf("genesis block must be a difficulty adjustment block")
+	if gs.RetargetContext != nil {
+		if err := gs.RetargetContext.Validate(gs.BtcHeaders[0], &chaincfg.MainNetParams); err != nil {
+			return fmt.Errorf("invalid retarget context in genesis: %w", err)
+		}
+	} else if !IsRetargetBlock(gs.BtcHeaders[0], &chaincfg.MainNetParams) {
+		return fmt.Errorf("genesis block must be a difficulty adjustment block or come with a retarget context")
 	}
 
 	for _, header := range gs.BtcHeaders {
@@ -72,6 +76,17 @@ func (gs GenesisState) Validate() error {
 	}
 	// TODO: validate headers have proper parent-child relationships and proper proof of work
 
+	reporters := make(map[string]bool, len(gs.Reporters))
+	for _, reporter := range gs.Reporters {
+		if err := reporter.Validate(); err != nil {
+			return fmt.Errorf("invalid reporter in genesis: %w", err)
+		}
+		if reporters[reporter.Address] {
+			return fmt.Errorf("duplicate reporter %s in genesis", reporter.Address)
+		}
+		reporters[reporter.Address] = true
+	}
+
 	return nil
 }
 
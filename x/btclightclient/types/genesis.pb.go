@@ -27,6 +27,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params     Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	BtcHeaders []*BTCHeaderInfo `protobuf:"bytes,2,rep,name=btc_headers,json=btcHeaders,proto3" json:"btc_headers,omitempty"`
+	// retarget_context is required if the base header (i.e., the first header
+	// in btc_headers) is not at a difficulty adjustment boundary
+	RetargetContext *RetargetContext `protobuf:"bytes,3,opt,name=retarget_context,json=retargetContext,proto3" json:"retarget_context,omitempty"`
+	// reporters are the bonds and statistics of the reporters of BTC headers
+	Reporters []*ReporterInfo `protobuf:"bytes,4,rep,name=reporters,proto3" json:"reporters,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +81,20 @@ func (m *GenesisState) GetBtcHeaders() []*BTCHeaderInfo {
 	return nil
 }
 
+func (m *GenesisState) GetRetargetContext() *RetargetContext {
+	if m != nil {
+		return m.RetargetContext
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReporters() []*ReporterInfo {
+	if m != nil {
+		return m.Reporters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.btclightclient.v1.GenesisState")
 }
@@ -85,23 +104,27 @@ func init() {
 }
 
 var fileDescriptor_4f95902e4096217a = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0xfa, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25,
-	0xf9, 0x42, 0x92, 0x50, 0x85, 0x7a, 0xa8, 0x0a, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0xaa, 0xf4, 0x41, 0x2c, 0x88, 0x06, 0x29, 0x3d, 0xdc, 0x26, 0xa3, 0x19, 0x01, 0x51,
-	0xaf, 0x86, 0x5b, 0x7d, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x21, 0x4a, 0xb3, 0x18, 0xb9, 0x78,
-	0xdc, 0x21, 0x4e, 0x0b, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2, 0xe7, 0x62, 0x83, 0x28, 0x90, 0x60,
-	0x54, 0x60, 0xd4, 0xe0, 0x36, 0x52, 0xd4, 0xc3, 0xe9, 0x54, 0xbd, 0x00, 0xb0, 0x42, 0x27, 0x96,
-	0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0xda, 0x84, 0x3c, 0xb9, 0xb8, 0x93, 0x4a, 0x92, 0xe3, 0x33,
-	0x52, 0x13, 0x53, 0x52, 0x8b, 0x8a, 0x25, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0x34, 0xf0, 0x98,
-	0xe2, 0x14, 0xe2, 0xec, 0x01, 0x56, 0xec, 0x99, 0x97, 0x96, 0x1f, 0xc4, 0x95, 0x54, 0x92, 0x0c,
-	0xe1, 0x16, 0x3b, 0x05, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x59,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0xe4, 0xe4, 0x8c, 0xc4,
-	0xcc, 0x3c, 0x18, 0x47, 0xbf, 0x02, 0xdd, 0xe3, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0x5f, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xed, 0x4f, 0xca, 0x25, 0xa9, 0x01, 0x00, 0x00,
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0x02, 0x41,
+	0x18, 0xc7, 0x77, 0x55, 0x84, 0xc6, 0xa0, 0x58, 0x3a, 0x98, 0x87, 0xcd, 0x3a, 0xa4, 0x74, 0x98,
+	0x41, 0x83, 0xae, 0x81, 0x12, 0xe5, 0x4d, 0xb6, 0xba, 0x74, 0x91, 0x99, 0x69, 0x9a, 0x5d, 0xd0,
+	0x99, 0x65, 0xf6, 0x4b, 0xf4, 0x2d, 0x7a, 0x9c, 0x1e, 0xc1, 0xa3, 0xc7, 0x4e, 0x11, 0xfa, 0x22,
+	0xe1, 0xcc, 0x46, 0x28, 0xb8, 0x97, 0x61, 0xbe, 0x8f, 0xdf, 0xff, 0xcf, 0x0f, 0x3e, 0xd4, 0x62,
+	0x94, 0xcd, 0xc7, 0x5a, 0x11, 0x06, 0x7c, 0x9c, 0xc8, 0x78, 0xf3, 0x0a, 0x05, 0x64, 0xda, 0x21,
+	0x52, 0x28, 0x91, 0x25, 0x19, 0x4e, 0x8d, 0x06, 0x1d, 0x9c, 0xe6, 0x20, 0xde, 0x06, 0xf1, 0xb4,
+	0xd3, 0x38, 0x91, 0x5a, 0x6a, 0x4b, 0x91, 0xcd, 0xcf, 0x05, 0x1a, 0x78, 0x7f, 0xf3, 0x4e, 0x85,
+	0xe3, 0x2f, 0xf7, 0xf3, 0x29, 0x35, 0x74, 0x92, 0x8b, 0x5c, 0x7c, 0x96, 0xd0, 0xe1, 0xbd, 0x53,
+	0x7b, 0x04, 0x0a, 0x22, 0xb8, 0x45, 0x55, 0x07, 0xd4, 0xfd, 0xa6, 0xdf, 0xae, 0x75, 0xcf, 0xf1,
+	0x5e, 0x55, 0x3c, 0xb4, 0x60, 0xaf, 0xb2, 0xf8, 0x3e, 0xf3, 0xa2, 0x3c, 0x16, 0x0c, 0x50, 0x8d,
+	0x01, 0x1f, 0xc5, 0x82, 0xbe, 0x0a, 0x93, 0xd5, 0x4b, 0xcd, 0x72, 0xbb, 0xd6, 0x6d, 0x17, 0xb4,
+	0xf4, 0x9e, 0xfa, 0x0f, 0x16, 0x1e, 0xa8, 0x37, 0x1d, 0x21, 0x06, 0xdc, 0x8d, 0x59, 0xf0, 0x8c,
+	0x8e, 0x8d, 0x00, 0x6a, 0xa4, 0x80, 0x11, 0xd7, 0x0a, 0xc4, 0x0c, 0xea, 0x65, 0x6b, 0x75, 0x55,
+	0xd0, 0x17, 0xe5, 0x91, 0xbe, 0x4b, 0x44, 0x47, 0x66, 0x7b, 0x11, 0xdc, 0xa1, 0x03, 0x23, 0x52,
+	0x6d, 0x60, 0xe3, 0x57, 0xb1, 0x7e, 0xad, 0xc2, 0x3e, 0xc7, 0x5a, 0xbd, 0xff, 0x64, 0x6f, 0xb8,
+	0x58, 0x85, 0xfe, 0x72, 0x15, 0xfa, 0x3f, 0xab, 0xd0, 0xff, 0x58, 0x87, 0xde, 0x72, 0x1d, 0x7a,
+	0x5f, 0xeb, 0xd0, 0x7b, 0xb9, 0x91, 0x09, 0xc4, 0xef, 0x0c, 0x73, 0x3d, 0x21, 0x79, 0x2f, 0x8f,
+	0x69, 0xa2, 0xfe, 0x06, 0x32, 0xdb, 0x3d, 0x0b, 0xcc, 0x53, 0x91, 0xb1, 0xaa, 0xbd, 0xc9, 0xf5,
+	0xef, 0x00, 0x03, 0x52, 0xab, 0xf8, 0x47, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +147,32 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reporters) > 0 {
+		for iNdEx := len(m.Reporters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reporters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.RetargetContext != nil {
+		{
+			size, err := m.RetargetContext.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BtcHeaders) > 0 {
 		for iNdEx := len(m.BtcHeaders) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -176,6 +225,16 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.RetargetContext != nil {
+		l = m.RetargetContext.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Reporters) > 0 {
+		for _, e := range m.Reporters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -281,6 +340,76 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetargetContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetargetContext == nil {
+				m.RetargetContext = &RetargetContext{}
+			}
+			if err := m.RetargetContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporters = append(m.Reporters, &ReporterInfo{})
+			if err := m.Reporters[len(m.Reporters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
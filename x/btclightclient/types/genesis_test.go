@@ -1,13 +1,25 @@
 package types_test
 
 import (
+	"math/rand"
 	"testing"
 
+	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	periodStart := types.SimnetGenesisBlock()
+	// base header in the middle of the first difficulty adjustment period
+	baseHeader := datagen.GenRandomBTCHeaderInfoWithParent(r, &periodStart)
+	baseHeader.Height = 20
+	prevTimestamps := make([]int64, types.MedianTimeBlocks)
+	for i := range prevTimestamps {
+		prevTimestamps[i] = int64(i)
+	}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -32,6 +44,41 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "base header not at adjustment boundary without retarget context",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				BtcHeaders: []*types.BTCHeaderInfo{baseHeader},
+			},
+			valid: false,
+		},
+		{
+			desc: "base header not at adjustment boundary with retarget context",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				BtcHeaders:      []*types.BTCHeaderInfo{baseHeader},
+				RetargetContext: types.NewRetargetContext(periodStart.Header, 0, prevTimestamps),
+			},
+			valid: true,
+		},
+		{
+			desc: "retarget context with wrong number of timestamps",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				BtcHeaders:      []*types.BTCHeaderInfo{baseHeader},
+				RetargetContext: types.NewRetargetContext(periodStart.Header, 0, prevTimestamps[1:]),
+			},
+			valid: false,
+		},
+		{
+			desc: "retarget context with period start not at adjustment boundary",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				BtcHeaders:      []*types.BTCHeaderInfo{baseHeader},
+				RetargetContext: types.NewRetargetContext(periodStart.Header, 1, prevTimestamps),
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -26,6 +26,8 @@ var (
 	HeadersObjectPrefix = []byte{0x01} // reserve this namespace mapping: Height -> BTCHeaderInfo
 	HashToHeightPrefix  = []byte{0x02} // reserve this namespace mapping: Hash -> Height
 	ParamsKey           = []byte{0x03} // key for params
+	RetargetContextKey  = []byte{0x04} // key for the retarget context of the base header
+	ReporterInfoPrefix  = []byte{0x05} // reserve this namespace mapping: Reporter address -> ReporterInfo
 )
 
 func HeadersObjectKey(height uint64) []byte {
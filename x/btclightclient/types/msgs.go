@@ -9,7 +9,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = (*MsgInsertHeaders)(nil)
+var (
+	_ sdk.Msg = (*MsgInsertHeaders)(nil)
+	_ sdk.Msg = (*MsgBondReporter)(nil)
+	_ sdk.Msg = (*MsgUnbondReporter)(nil)
+)
 
 func NewMsgInsertHeaders(signer sdk.AccAddress, headersHex string) (*MsgInsertHeaders, error) {
 	if len(headersHex) == 0 {
@@ -72,3 +76,27 @@ func (msg *MsgInsertHeaders) ValidateStateless() error {
 
 	return nil
 }
+
+func NewMsgBondReporter(signer sdk.AccAddress, amount sdk.Coin) *MsgBondReporter {
+	return &MsgBondReporter{Signer: signer.String(), Amount: amount}
+}
+
+func (msg *MsgBondReporter) ValidateStateless() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return err
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("bond amount must be positive")
+	}
+
+	return nil
+}
+
+func NewMsgUnbondReporter(signer sdk.AccAddress) *MsgUnbondReporter {
+	return &MsgUnbondReporter{Signer: signer.String()}
+}
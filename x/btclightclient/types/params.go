@@ -3,18 +3,29 @@ package types
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/babylonchain/babylon/app/params"
 )
 
+// DefaultReporterUnbondingBlocks is the default number of blocks after the
+// latest submission of a reporter during which its bond is locked
+const DefaultReporterUnbondingBlocks uint64 = 100
+
 // NewParams creates a new Params instance
-func NewParams(allowedAddresses []string) Params {
+func NewParams(allowedAddresses []string, reporterBond, headerReward, noWorkFee sdk.Coin, reporterUnbondingBlocks uint64) Params {
 	return Params{
-		InsertHeadersAllowList: allowedAddresses,
+		InsertHeadersAllowList:  allowedAddresses,
+		ReporterBond:            reporterBond,
+		HeaderReward:            headerReward,
+		NoWorkFee:               noWorkFee,
+		ReporterUnbondingBlocks: reporterUnbondingBlocks,
 	}
 }
 
-func NewParamsValidate(allowedAddresses []string) (Params, error) {
-	p := NewParams(allowedAddresses)
+func NewParamsValidate(allowedAddresses []string, reporterBond, headerReward, noWorkFee sdk.Coin, reporterUnbondingBlocks uint64) (Params, error) {
+	p := NewParams(allowedAddresses, reporterBond, headerReward, noWorkFee, reporterUnbondingBlocks)
 	if err := p.Validate(); err != nil {
 		return Params{}, err
 	}
@@ -23,8 +34,13 @@ func NewParamsValidate(allowedAddresses []string) (Params, error) {
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
+	zeroCoin := sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.ZeroInt())
 	return NewParams(
 		[]string{},
+		zeroCoin,
+		zeroCoin,
+		zeroCoin,
+		DefaultReporterUnbondingBlocks,
 	)
 }
 
@@ -50,9 +66,28 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := p.ReporterBond.Validate(); err != nil {
+		return fmt.Errorf("invalid reporter bond: %w", err)
+	}
+
+	if err := p.HeaderReward.Validate(); err != nil {
+		return fmt.Errorf("invalid header reward: %w", err)
+	}
+
+	if err := p.NoWorkFee.Validate(); err != nil {
+		return fmt.Errorf("invalid no-work fee: %w", err)
+	}
+
 	return nil
 }
 
 func (p *Params) AllowAllReporters() bool {
 	return len(p.InsertHeadersAllowList) == 0
 }
+
+// RequireReporterBond returns whether reporters have to post a bond in order
+// to insert headers, which is the case for the permissionless mode with a
+// positive reporter bond
+func (p *Params) RequireReporterBond() bool {
+	return p.AllowAllReporters() && p.ReporterBond.IsPositive()
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -28,6 +29,21 @@ type Params struct {
 	// List of addresses which are allowed to insert headers to btc light client
 	// if the list is empty, any address can insert headers
 	InsertHeadersAllowList []string `protobuf:"bytes,1,rep,name=insert_headers_allow_list,json=insertHeadersAllowList,proto3" json:"insert_headers_allow_list,omitempty"`
+	// reporter_bond is the bond a reporter has to post in order to insert
+	// headers when the allow list is empty. Fees of submissions that add no work
+	// are charged out of the bond. A zero bond disables the requirement.
+	ReporterBond types.Coin `protobuf:"bytes,2,opt,name=reporter_bond,json=reporterBond,proto3" json:"reporter_bond"`
+	// header_reward is the reward for each header that extends the heaviest
+	// chain. It is paid out of the BTC timestamping gauge of the current epoch
+	// in the incentive module.
+	HeaderReward types.Coin `protobuf:"bytes,3,opt,name=header_reward,json=headerReward,proto3" json:"header_reward"`
+	// no_work_fee is the fee charged out of the bond of a reporter for each
+	// submission of headers that do not add work to the heaviest chain
+	NoWorkFee types.Coin `protobuf:"bytes,4,opt,name=no_work_fee,json=noWorkFee,proto3" json:"no_work_fee"`
+	// reporter_unbonding_blocks is the number of Babylon blocks after the latest
+	// submission of a reporter during which the reporter cannot withdraw its
+	// bond, so that the bond still backs its recent submissions
+	ReporterUnbondingBlocks uint64 `protobuf:"varint,5,opt,name=reporter_unbonding_blocks,json=reporterUnbondingBlocks,proto3" json:"reporter_unbonding_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +86,34 @@ func (m *Params) GetInsertHeadersAllowList() []string {
 	return nil
 }
 
+func (m *Params) GetReporterBond() types.Coin {
+	if m != nil {
+		return m.ReporterBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetHeaderReward() types.Coin {
+	if m != nil {
+		return m.HeaderReward
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetNoWorkFee() types.Coin {
+	if m != nil {
+		return m.NoWorkFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetReporterUnbondingBlocks() uint64 {
+	if m != nil {
+		return m.ReporterUnbondingBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.btclightclient.v1.Params")
 }
@@ -79,21 +123,30 @@ func init() {
 }
 
 var fileDescriptor_1e4c5f7a17079e1f = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0xfa, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x92, 0x50, 0x75, 0x7a, 0xa8, 0xea, 0xf4, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0xaa, 0xf4, 0x41, 0x2c, 0x88, 0x06, 0x25, 0x4f, 0x2e, 0xb6, 0x00, 0xb0, 0x01, 0x42, 0x96,
-	0x5c, 0x92, 0x99, 0x79, 0xc5, 0xa9, 0x45, 0x25, 0xf1, 0x19, 0xa9, 0x89, 0x29, 0xa9, 0x45, 0xc5,
-	0xf1, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0x39, 0x99, 0xc5, 0x25, 0x12, 0x8c, 0x0a, 0xcc, 0x1a,
-	0x9c, 0x41, 0x62, 0x10, 0x05, 0x1e, 0x10, 0x79, 0x47, 0x90, 0xb4, 0x4f, 0x66, 0x71, 0x89, 0x15,
-	0xcb, 0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0x01, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x65, 0x96, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x75, 0x60,
-	0x72, 0x46, 0x62, 0x66, 0x1e, 0x8c, 0xa3, 0x5f, 0x81, 0xee, 0xaf, 0x92, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0xb0, 0x1b, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x30, 0xe4, 0x07, 0x29, 0xfe,
-	0x00, 0x00, 0x00,
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x8a, 0xdb, 0x30,
+	0x1c, 0xc7, 0xed, 0xc4, 0x0d, 0xc4, 0x69, 0x17, 0x53, 0x5a, 0x3b, 0x83, 0x13, 0x3a, 0x94, 0x4c,
+	0x12, 0x6e, 0xa1, 0xd0, 0x2c, 0xa5, 0x6e, 0x29, 0x1d, 0x3a, 0x04, 0x43, 0x29, 0x74, 0x11, 0x92,
+	0xad, 0xda, 0x22, 0x8e, 0x7e, 0x46, 0x52, 0x92, 0xe6, 0x2d, 0xfa, 0x08, 0x7d, 0x9c, 0x8c, 0xe1,
+	0xa6, 0x9b, 0x8e, 0x23, 0x59, 0xee, 0x31, 0x0e, 0xdb, 0xf1, 0xc1, 0xdd, 0x94, 0x45, 0x48, 0x7c,
+	0xff, 0x7c, 0xf8, 0xf1, 0x93, 0xfb, 0x96, 0x51, 0xb6, 0x2b, 0x41, 0x62, 0x66, 0xd2, 0x52, 0xe4,
+	0x45, 0x7d, 0x72, 0x69, 0xf0, 0x26, 0xc2, 0x15, 0x55, 0x74, 0xa5, 0x51, 0xa5, 0xc0, 0x80, 0x17,
+	0x9c, 0x7d, 0xe8, 0xb1, 0x0f, 0x6d, 0xa2, 0xf1, 0xcb, 0x1c, 0x72, 0x68, 0x5c, 0xb8, 0xbe, 0xb5,
+	0x81, 0x71, 0x98, 0x82, 0x5e, 0x81, 0xc6, 0x8c, 0x6a, 0x8e, 0x37, 0x11, 0xe3, 0x86, 0x46, 0x38,
+	0x05, 0x21, 0x5b, 0xfd, 0xcd, 0x55, 0xcf, 0x1d, 0x2c, 0x1a, 0x82, 0xf7, 0xd1, 0x0d, 0x84, 0xd4,
+	0x5c, 0x19, 0x52, 0x70, 0x9a, 0x71, 0xa5, 0x09, 0x2d, 0x4b, 0xd8, 0x92, 0x52, 0x68, 0xe3, 0xdb,
+	0xd3, 0xfe, 0x6c, 0x98, 0xbc, 0x6a, 0x0d, 0xdf, 0x5b, 0xfd, 0x73, 0x2d, 0xff, 0x10, 0xda, 0x78,
+	0x5f, 0xdd, 0x17, 0x8a, 0x57, 0xa0, 0x0c, 0x57, 0x84, 0x81, 0xcc, 0xfc, 0xde, 0xd4, 0x9e, 0x8d,
+	0xde, 0x05, 0xa8, 0xa5, 0xa3, 0x9a, 0x8e, 0xce, 0x74, 0xf4, 0x05, 0x84, 0x8c, 0x9d, 0xfd, 0xcd,
+	0xc4, 0x4a, 0x9e, 0x77, 0xa9, 0x18, 0x64, 0x56, 0xb7, 0xb4, 0x64, 0xa2, 0xf8, 0x96, 0xaa, 0xcc,
+	0xef, 0x5f, 0xd8, 0xd2, 0xa6, 0x92, 0x26, 0xe4, 0x7d, 0x72, 0x47, 0x12, 0xc8, 0x16, 0xd4, 0x92,
+	0xfc, 0xe1, 0xdc, 0x77, 0x2e, 0xeb, 0x18, 0x4a, 0xf8, 0x05, 0x6a, 0xf9, 0x8d, 0x73, 0x6f, 0xee,
+	0x06, 0x0f, 0xc3, 0xac, 0x65, 0x3d, 0x8e, 0x90, 0x39, 0x61, 0x25, 0xa4, 0x4b, 0xed, 0x3f, 0x9b,
+	0xda, 0x33, 0x27, 0x79, 0xdd, 0x19, 0x7e, 0x76, 0x7a, 0xdc, 0xc8, 0x73, 0xe7, 0xee, 0xff, 0xc4,
+	0x8e, 0x17, 0xfb, 0x63, 0x68, 0x1f, 0x8e, 0xa1, 0x7d, 0x7b, 0x0c, 0xed, 0x7f, 0xa7, 0xd0, 0x3a,
+	0x9c, 0x42, 0xeb, 0xfa, 0x14, 0x5a, 0xbf, 0x3f, 0xe4, 0xc2, 0x14, 0x6b, 0x86, 0x52, 0x58, 0xe1,
+	0xf3, 0x2a, 0xd3, 0x82, 0x0a, 0xd9, 0x3d, 0xf0, 0xdf, 0xa7, 0x3f, 0xc0, 0xec, 0x2a, 0xae, 0xd9,
+	0xa0, 0xd9, 0xd6, 0xfb, 0xfb, 0x01, 0x00, 0x0b, 0x93, 0x4c, 0x37, 0x28, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -123,6 +176,18 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.ReporterBond.Equal(&that1.ReporterBond) {
+		return false
+	}
+	if !this.HeaderReward.Equal(&that1.HeaderReward) {
+		return false
+	}
+	if !this.NoWorkFee.Equal(&that1.NoWorkFee) {
+		return false
+	}
+	if this.ReporterUnbondingBlocks != that1.ReporterUnbondingBlocks {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -145,6 +210,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReporterUnbondingBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReporterUnbondingBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.NoWorkFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.HeaderReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.ReporterBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.InsertHeadersAllowList) > 0 {
 		for iNdEx := len(m.InsertHeadersAllowList) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.InsertHeadersAllowList[iNdEx])
@@ -180,6 +280,15 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = m.ReporterBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.HeaderReward.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.NoWorkFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ReporterUnbondingBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ReporterUnbondingBlocks))
+	}
 	return n
 }
 
@@ -250,6 +359,124 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.InsertHeadersAllowList = append(m.InsertHeadersAllowList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReporterBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HeaderReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWorkFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoWorkFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterUnbondingBlocks", wireType)
+			}
+			m.ReporterUnbondingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReporterUnbondingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -40,3 +40,11 @@ func NewQueryTipRequest() *QueryTipRequest {
 func NewQueryBaseHeaderRequest() *QueryBaseHeaderRequest {
 	return &QueryBaseHeaderRequest{}
 }
+
+func NewQueryReporterRequest(address string) *QueryReporterRequest {
+	return &QueryReporterRequest{Address: address}
+}
+
+func NewQueryReportersRequest(req *query.PageRequest) *QueryReportersRequest {
+	return &QueryReportersRequest{Pagination: req}
+}
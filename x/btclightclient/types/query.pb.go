@@ -811,6 +811,198 @@ func (m *BTCHeaderInfoResponse) GetHeight() uint64 {
 	return 0
 }
 
+// QueryReporterRequest is the request type for the Query/Reporter RPC method.
+type QueryReporterRequest struct {
+	// address is the address of the reporter
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryReporterRequest) Reset()         { *m = QueryReporterRequest{} }
+func (m *QueryReporterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReporterRequest) ProtoMessage()    {}
+func (*QueryReporterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{17}
+}
+func (m *QueryReporterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReporterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReporterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReporterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReporterRequest.Merge(m, src)
+}
+func (m *QueryReporterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReporterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReporterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReporterRequest proto.InternalMessageInfo
+
+func (m *QueryReporterRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryReporterResponse is the response type for the Query/Reporter RPC
+// method.
+type QueryReporterResponse struct {
+	Reporter *ReporterInfo `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+}
+
+func (m *QueryReporterResponse) Reset()         { *m = QueryReporterResponse{} }
+func (m *QueryReporterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReporterResponse) ProtoMessage()    {}
+func (*QueryReporterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{18}
+}
+func (m *QueryReporterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReporterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReporterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReporterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReporterResponse.Merge(m, src)
+}
+func (m *QueryReporterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReporterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReporterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReporterResponse proto.InternalMessageInfo
+
+func (m *QueryReporterResponse) GetReporter() *ReporterInfo {
+	if m != nil {
+		return m.Reporter
+	}
+	return nil
+}
+
+// QueryReportersRequest is the request type for the Query/Reporters RPC
+// method.
+type QueryReportersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReportersRequest) Reset()         { *m = QueryReportersRequest{} }
+func (m *QueryReportersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReportersRequest) ProtoMessage()    {}
+func (*QueryReportersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{19}
+}
+func (m *QueryReportersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReportersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReportersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReportersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReportersRequest.Merge(m, src)
+}
+func (m *QueryReportersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReportersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReportersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReportersRequest proto.InternalMessageInfo
+
+func (m *QueryReportersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryReportersResponse is the response type for the Query/Reporters RPC
+// method.
+type QueryReportersResponse struct {
+	Reporters  []*ReporterInfo     `protobuf:"bytes,1,rep,name=reporters,proto3" json:"reporters,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReportersResponse) Reset()         { *m = QueryReportersResponse{} }
+func (m *QueryReportersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReportersResponse) ProtoMessage()    {}
+func (*QueryReportersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{20}
+}
+func (m *QueryReportersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReportersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReportersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReportersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReportersResponse.Merge(m, src)
+}
+func (m *QueryReportersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReportersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReportersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReportersResponse proto.InternalMessageInfo
+
+func (m *QueryReportersResponse) GetReporters() []*ReporterInfo {
+	if m != nil {
+		return m.Reporters
+	}
+	return nil
+}
+
+func (m *QueryReportersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btclightclient.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btclightclient.v1.QueryParamsResponse")
@@ -829,6 +1021,10 @@ func init() {
 	proto.RegisterType((*QueryHeaderDepthRequest)(nil), "babylon.btclightclient.v1.QueryHeaderDepthRequest")
 	proto.RegisterType((*QueryHeaderDepthResponse)(nil), "babylon.btclightclient.v1.QueryHeaderDepthResponse")
 	proto.RegisterType((*BTCHeaderInfoResponse)(nil), "babylon.btclightclient.v1.BTCHeaderInfoResponse")
+	proto.RegisterType((*QueryReporterRequest)(nil), "babylon.btclightclient.v1.QueryReporterRequest")
+	proto.RegisterType((*QueryReporterResponse)(nil), "babylon.btclightclient.v1.QueryReporterResponse")
+	proto.RegisterType((*QueryReportersRequest)(nil), "babylon.btclightclient.v1.QueryReportersRequest")
+	proto.RegisterType((*QueryReportersResponse)(nil), "babylon.btclightclient.v1.QueryReportersResponse")
 }
 
 func init() {
@@ -836,65 +1032,73 @@ func init() {
 }
 
 var fileDescriptor_3961270631e52721 = []byte{
-	// 920 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x89, 0xeb, 0x26, 0x2f, 0x20, 0x60, 0x48, 0x83, 0xb3, 0x02, 0x27, 0xdd, 0x92,
-	0x1f, 0x4d, 0xf1, 0x4e, 0x9c, 0x00, 0xea, 0x01, 0x09, 0xe1, 0x20, 0x30, 0x48, 0x48, 0xc6, 0x32,
-	0x1c, 0x50, 0xa5, 0x68, 0xec, 0x0c, 0xbb, 0xab, 0xc6, 0x3b, 0x5b, 0xef, 0x26, 0xc4, 0x42, 0x5c,
-	0x38, 0x70, 0x46, 0x70, 0xe3, 0xc0, 0x81, 0x0b, 0x17, 0xe0, 0xd4, 0x3f, 0xa2, 0xc7, 0x0a, 0x2e,
-	0xa8, 0x87, 0x08, 0x25, 0xfc, 0x11, 0x1c, 0xd1, 0xcc, 0xbc, 0xb5, 0xbd, 0x76, 0xea, 0xb5, 0xd5,
-	0x5c, 0xa2, 0xcc, 0xcc, 0x7b, 0xef, 0xfb, 0x79, 0xcf, 0xb3, 0xdf, 0x5d, 0x58, 0x6f, 0xf2, 0x66,
-	0xf7, 0x48, 0x06, 0xac, 0x19, 0xb7, 0x8e, 0x7c, 0xd7, 0x53, 0x7f, 0x45, 0x10, 0xb3, 0x93, 0x32,
-	0x7b, 0x70, 0x2c, 0x3a, 0x5d, 0x27, 0xec, 0xc8, 0x58, 0xd2, 0x15, 0x0c, 0x73, 0xd2, 0x61, 0xce,
-	0x49, 0xd9, 0x5a, 0x72, 0xa5, 0x2b, 0x75, 0x14, 0x53, 0xff, 0x99, 0x04, 0x6b, 0xa5, 0x25, 0xa3,
-	0xb6, 0x8c, 0x0e, 0xcc, 0x81, 0x59, 0xe0, 0xd1, 0xab, 0xae, 0x94, 0xee, 0x91, 0x60, 0x3c, 0xf4,
-	0x19, 0x0f, 0x02, 0x19, 0xf3, 0xd8, 0x97, 0x41, 0x72, 0xba, 0x6d, 0x62, 0x59, 0x93, 0x47, 0xc2,
-	0x20, 0xb0, 0x93, 0x72, 0x53, 0xc4, 0xbc, 0xcc, 0x42, 0xee, 0xfa, 0x81, 0x0e, 0xc6, 0xd8, 0x8d,
-	0xa7, 0xc3, 0x87, 0xbc, 0xc3, 0xdb, 0x58, 0xd3, 0x5e, 0x02, 0xfa, 0xa9, 0xaa, 0x54, 0xd3, 0x9b,
-	0x75, 0xf1, 0xe0, 0x58, 0x44, 0xb1, 0xfd, 0x39, 0xbc, 0x9c, 0xda, 0x8d, 0x42, 0x19, 0x44, 0x82,
-	0xbe, 0x0b, 0x79, 0x93, 0x5c, 0x20, 0x6b, 0x64, 0x6b, 0x71, 0xf7, 0xa6, 0xf3, 0xd4, 0xde, 0x1d,
-	0x93, 0x5a, 0xc9, 0x3d, 0x3a, 0x5b, 0x9d, 0xa9, 0x63, 0x9a, 0x7d, 0x0f, 0xd5, 0xaa, 0x3c, 0xf2,
-	0x44, 0xa2, 0x46, 0x3f, 0x00, 0xe8, 0xf3, 0x63, 0xe9, 0x0d, 0x07, 0x07, 0xa3, 0x9a, 0x75, 0xcc,
-	0xbc, 0xb1, 0x59, 0xa7, 0xc6, 0x5d, 0x81, 0xb9, 0xf5, 0x81, 0x4c, 0xfb, 0x21, 0x41, 0xec, 0xa4,
-	0x3c, 0x62, 0x37, 0x20, 0xef, 0xe9, 0x9d, 0x02, 0x59, 0x9b, 0xdb, 0x7a, 0xae, 0xf2, 0xce, 0x93,
-	0xb3, 0xd5, 0xbb, 0xae, 0x1f, 0x7b, 0xc7, 0x4d, 0xa7, 0x25, 0xdb, 0x0c, 0x9b, 0x68, 0x79, 0xdc,
-	0x0f, 0x92, 0x05, 0x8b, 0xbb, 0xa1, 0x88, 0x9c, 0x4a, 0x63, 0xbf, 0x2a, 0xf8, 0xa1, 0xe8, 0xa8,
-	0x92, 0x95, 0x6e, 0x2c, 0xa2, 0x3a, 0xd6, 0xa2, 0x1f, 0xa6, 0xa8, 0x67, 0x35, 0xf5, 0x66, 0x26,
-	0xb5, 0x41, 0x4a, 0x61, 0x7b, 0xb0, 0xa4, 0xa9, 0xf7, 0x65, 0x10, 0x73, 0x3f, 0xe8, 0x8d, 0xa5,
-	0x06, 0x39, 0x25, 0xa5, 0x07, 0xf2, 0xac, 0xd0, 0xba, 0x92, 0xbd, 0x07, 0x37, 0x86, 0x94, 0x70,
-	0x42, 0x16, 0xcc, 0xb7, 0x70, 0x4f, 0xcb, 0xcd, 0xd7, 0x7b, 0x6b, 0x9b, 0xc1, 0x4a, 0x2a, 0xc9,
-	0x14, 0x44, 0x46, 0x3a, 0xc8, 0x88, 0x2a, 0x77, 0xc1, 0xba, 0x2c, 0x61, 0x02, 0xa9, 0x03, 0xe4,
-	0xfb, 0x84, 0xfb, 0xc1, 0xbe, 0x6a, 0xec, 0xaa, 0x6f, 0xc8, 0xef, 0x04, 0x96, 0x87, 0x15, 0x90,
-	0xeb, 0x63, 0xb8, 0xee, 0xe9, 0xa1, 0x99, 0x5b, 0xb2, 0xb8, 0xbb, 0x33, 0xe6, 0x72, 0xf7, 0x26,
-	0xfc, 0x51, 0xf0, 0xa5, 0xec, 0xfd, 0xa8, 0x49, 0x81, 0xab, 0xbb, 0x1a, 0x2f, 0xc1, 0x0b, 0x1a,
-	0xb7, 0xe1, 0x87, 0xc9, 0xa3, 0x79, 0x0f, 0x5e, 0xec, 0x6f, 0x21, 0x7b, 0x15, 0xf2, 0x46, 0x1a,
-	0x47, 0x33, 0x3d, 0x3a, 0xe6, 0xdb, 0x05, 0x9c, 0x4f, 0x85, 0x47, 0xc2, 0x84, 0x25, 0xba, 0x2d,
-	0x78, 0x65, 0xe4, 0xe4, 0xca, 0xe5, 0x4b, 0x28, 0x62, 0x42, 0xde, 0x17, 0x61, 0xec, 0x5d, 0x76,
-	0xd3, 0x16, 0xf0, 0xa6, 0xed, 0x40, 0x61, 0x34, 0x1c, 0xa1, 0x96, 0xe0, 0xda, 0xa1, 0xda, 0xd0,
-	0x09, 0xb9, 0xba, 0x59, 0xd8, 0xbf, 0x11, 0xb8, 0x71, 0x29, 0x02, 0x7d, 0x0d, 0xc0, 0x40, 0x1c,
-	0x78, 0xe2, 0x14, 0x55, 0x16, 0xcc, 0x4e, 0x55, 0x9c, 0xd2, 0x15, 0x98, 0x57, 0x92, 0xfa, 0x70,
-	0x56, 0x1f, 0x5e, 0x57, 0x6b, 0x75, 0xb4, 0xac, 0xda, 0x57, 0x5d, 0x16, 0xe6, 0xb4, 0x14, 0xae,
-	0xe8, 0x7b, 0x90, 0xfb, 0x4a, 0x76, 0xee, 0x17, 0x72, 0x2a, 0xbc, 0x52, 0x52, 0x46, 0xf8, 0xe4,
-	0x6c, 0x75, 0xd9, 0x5c, 0x83, 0xe8, 0xf0, 0xbe, 0xe3, 0x4b, 0xd6, 0xe6, 0xb1, 0xe7, 0x7c, 0xe6,
-	0x07, 0xf1, 0x9f, 0x0f, 0x4b, 0x8b, 0x78, 0x41, 0xd4, 0xb2, 0xae, 0x53, 0x77, 0xff, 0x5b, 0x80,
-	0x6b, 0xba, 0x43, 0xfa, 0x03, 0x81, 0xbc, 0xb1, 0x54, 0x5a, 0x1a, 0x33, 0xde, 0x51, 0x2f, 0xb7,
-	0x9c, 0x49, 0xc3, 0xcd, 0x20, 0xec, 0xdb, 0xdf, 0xfe, 0xf5, 0xef, 0x8f, 0xb3, 0xb7, 0xe8, 0x4d,
-	0x96, 0xf5, 0x0a, 0xd1, 0x50, 0xc6, 0x6b, 0xb3, 0xa1, 0x52, 0x96, 0x9f, 0x0d, 0x95, 0xb6, 0xf0,
-	0x89, 0xa0, 0xd0, 0x97, 0x7f, 0x22, 0x30, 0x9f, 0x58, 0x0f, 0x65, 0x59, 0x3a, 0x43, 0xa6, 0x6b,
-	0xed, 0x4c, 0x9e, 0x80, 0x68, 0x77, 0x34, 0xda, 0x3a, 0xbd, 0x35, 0x06, 0x2d, 0x71, 0x38, 0xfa,
-	0x07, 0x81, 0xe7, 0x53, 0xbe, 0x48, 0xdf, 0x9c, 0x54, 0x70, 0xd0, 0x77, 0xad, 0xb7, 0xa6, 0xcc,
-	0x42, 0xd6, 0x1d, 0xcd, 0xba, 0x4d, 0xb7, 0x26, 0x60, 0x35, 0x78, 0x3f, 0x13, 0x58, 0xe8, 0x99,
-	0x25, 0xcd, 0x9c, 0xce, 0xb0, 0x73, 0x5b, 0xe5, 0x29, 0x32, 0x10, 0xf2, 0x0d, 0x0d, 0xb9, 0x41,
-	0x5f, 0x1f, 0x03, 0xd9, 0xe6, 0xbe, 0x79, 0xf5, 0xd1, 0xef, 0x08, 0xcc, 0x35, 0xfc, 0x90, 0x6e,
-	0x67, 0x09, 0xf5, 0x3d, 0xd4, 0xba, 0x33, 0x51, 0x2c, 0xe2, 0x6c, 0x68, 0x9c, 0x35, 0x5a, 0x1c,
-	0x83, 0x13, 0xfb, 0x21, 0xfd, 0x85, 0x00, 0xf4, 0xcd, 0x91, 0x66, 0x36, 0x3e, 0x62, 0xb1, 0xd6,
-	0xee, 0x34, 0x29, 0x48, 0x57, 0xd2, 0x74, 0x9b, 0x74, 0x7d, 0x0c, 0x9d, 0x7a, 0xe3, 0x18, 0x27,
-	0xa3, 0xbf, 0x12, 0x58, 0x1c, 0x70, 0x4b, 0x9a, 0x29, 0x39, 0xea, 0xc4, 0xd6, 0xde, 0x54, 0x39,
-	0xc8, 0xc9, 0x34, 0xe7, 0x6d, 0xba, 0x39, 0x86, 0x53, 0x5b, 0x34, 0xfb, 0x5a, 0x3d, 0xc7, 0xdf,
-	0x54, 0x6a, 0x8f, 0xce, 0x8b, 0xe4, 0xf1, 0x79, 0x91, 0xfc, 0x73, 0x5e, 0x24, 0xdf, 0x5f, 0x14,
-	0x67, 0x1e, 0x5f, 0x14, 0x67, 0xfe, 0xbe, 0x28, 0xce, 0x7c, 0xf1, 0x76, 0xd6, 0x57, 0xd0, 0xe9,
-	0x70, 0x6d, 0xfd, 0x59, 0xd4, 0xcc, 0xeb, 0x2f, 0xde, 0xbd, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x93, 0xd5, 0xc3, 0x8e, 0xd8, 0x0b, 0x00, 0x00,
+	// 1052 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x89, 0xeb, 0x38, 0x2f, 0x45, 0xc0, 0x34, 0x09, 0xce, 0x0a, 0x9c, 0x74, 0x4b,
+	0x7e, 0x34, 0xc5, 0x3b, 0x76, 0x02, 0x55, 0x0f, 0x48, 0x08, 0x87, 0x1f, 0x01, 0x09, 0x29, 0x58,
+	0x81, 0x03, 0x8a, 0x14, 0x8d, 0xed, 0xc1, 0xbb, 0x6a, 0xbc, 0xb3, 0xf5, 0x6e, 0x42, 0xa2, 0xaa,
+	0x17, 0x0e, 0x9c, 0x11, 0xdc, 0x38, 0x70, 0x40, 0x42, 0x70, 0x00, 0x4e, 0xfd, 0x07, 0xb8, 0xf5,
+	0x58, 0xc1, 0x05, 0xf5, 0x10, 0xa1, 0x84, 0x3f, 0x04, 0xed, 0xcc, 0x9b, 0xf5, 0xaf, 0xc4, 0xbb,
+	0x56, 0x7d, 0x89, 0x32, 0x33, 0xef, 0xbd, 0xef, 0x67, 0xde, 0xce, 0xec, 0x77, 0x0d, 0x2b, 0x35,
+	0x56, 0x3b, 0x3d, 0x14, 0x1e, 0xad, 0x85, 0xf5, 0x43, 0xb7, 0xe9, 0x44, 0x7f, 0xb9, 0x17, 0xd2,
+	0xe3, 0x32, 0x7d, 0x70, 0xc4, 0xdb, 0xa7, 0xb6, 0xdf, 0x16, 0xa1, 0x20, 0x8b, 0x18, 0x66, 0xf7,
+	0x86, 0xd9, 0xc7, 0x65, 0x73, 0xae, 0x29, 0x9a, 0x42, 0x46, 0xd1, 0xe8, 0x3f, 0x95, 0x60, 0x2e,
+	0xd6, 0x45, 0xd0, 0x12, 0xc1, 0x81, 0x5a, 0x50, 0x03, 0x5c, 0x7a, 0xb5, 0x29, 0x44, 0xf3, 0x90,
+	0x53, 0xe6, 0xbb, 0x94, 0x79, 0x9e, 0x08, 0x59, 0xe8, 0x0a, 0x4f, 0xaf, 0x6e, 0xa8, 0x58, 0x5a,
+	0x63, 0x01, 0x57, 0x08, 0xf4, 0xb8, 0x5c, 0xe3, 0x21, 0x2b, 0x53, 0x9f, 0x35, 0x5d, 0x4f, 0x06,
+	0x63, 0xec, 0xea, 0xd5, 0xf0, 0x3e, 0x6b, 0xb3, 0x96, 0xae, 0x69, 0x5f, 0x1d, 0xd7, 0xb7, 0x1f,
+	0x19, 0x6f, 0xcd, 0x01, 0xf9, 0x34, 0x52, 0xde, 0x95, 0x45, 0xaa, 0xfc, 0xc1, 0x11, 0x0f, 0x42,
+	0xeb, 0x73, 0xb8, 0xd1, 0x33, 0x1b, 0xf8, 0xc2, 0x0b, 0x38, 0x79, 0x07, 0xb2, 0x4a, 0x2c, 0x6f,
+	0x2c, 0x1b, 0xeb, 0xb3, 0x9b, 0x37, 0xed, 0x2b, 0x7b, 0x65, 0xab, 0xd4, 0x4a, 0xe6, 0xc9, 0xd9,
+	0xd2, 0x44, 0x15, 0xd3, 0xac, 0x7d, 0x54, 0xdb, 0x61, 0x81, 0xc3, 0xb5, 0x1a, 0xf9, 0x00, 0xa0,
+	0xb3, 0x5f, 0x2c, 0xbd, 0x6a, 0x63, 0x23, 0xa3, 0xe6, 0xd8, 0xea, 0xf9, 0x60, 0x73, 0xec, 0x5d,
+	0xd6, 0xe4, 0x98, 0x5b, 0xed, 0xca, 0xb4, 0x1e, 0x1b, 0x70, 0xa3, 0xa7, 0x3c, 0x62, 0xef, 0x41,
+	0xd6, 0x91, 0x33, 0x79, 0x63, 0x79, 0x6a, 0xfd, 0x7a, 0xe5, 0xed, 0x67, 0x67, 0x4b, 0xf7, 0x9a,
+	0x6e, 0xe8, 0x1c, 0xd5, 0xec, 0xba, 0x68, 0x51, 0xdc, 0x44, 0xdd, 0x61, 0xae, 0xa7, 0x07, 0x34,
+	0x3c, 0xf5, 0x79, 0x60, 0x57, 0xf6, 0xb6, 0x77, 0x38, 0x6b, 0xf0, 0x76, 0x54, 0xb2, 0x72, 0x1a,
+	0xf2, 0xa0, 0x8a, 0xb5, 0xc8, 0x87, 0x3d, 0xd4, 0x93, 0x92, 0x7a, 0x2d, 0x91, 0x5a, 0x21, 0xf5,
+	0x60, 0x3b, 0x30, 0x27, 0xa9, 0xb7, 0x85, 0x17, 0x32, 0xd7, 0x8b, 0xdb, 0xb2, 0x0b, 0x99, 0x48,
+	0x4a, 0x36, 0xe4, 0x79, 0xa1, 0x65, 0x25, 0x6b, 0x0b, 0xe6, 0xfb, 0x94, 0xb0, 0x43, 0x26, 0xe4,
+	0xea, 0x38, 0x27, 0xe5, 0x72, 0xd5, 0x78, 0x6c, 0x51, 0x58, 0xec, 0x49, 0x52, 0x05, 0x91, 0x91,
+	0x74, 0x33, 0xa2, 0xca, 0x3d, 0x30, 0x2f, 0x4b, 0x48, 0x21, 0x75, 0x80, 0x7c, 0x9f, 0x30, 0xd7,
+	0xdb, 0x8e, 0x36, 0x36, 0xee, 0x13, 0xf2, 0xbb, 0x01, 0x0b, 0xfd, 0x0a, 0xc8, 0xf5, 0x31, 0x4c,
+	0x3b, 0xb2, 0x69, 0xea, 0x94, 0xcc, 0x6e, 0x96, 0x86, 0x1c, 0xee, 0xb8, 0xc3, 0x1f, 0x79, 0x5f,
+	0x8a, 0xf8, 0xa1, 0xea, 0x02, 0xe3, 0x3b, 0x1a, 0x2f, 0xc3, 0x8b, 0x12, 0x77, 0xcf, 0xf5, 0xf5,
+	0xd5, 0xdc, 0x87, 0x97, 0x3a, 0x53, 0xc8, 0xbe, 0x03, 0x59, 0x25, 0x8d, 0xad, 0x19, 0x1d, 0x1d,
+	0xf3, 0xad, 0x3c, 0xf6, 0xa7, 0xc2, 0x02, 0xae, 0xc2, 0xb4, 0x6e, 0x1d, 0x5e, 0x19, 0x58, 0x19,
+	0xbb, 0x7c, 0x11, 0x45, 0x54, 0xc8, 0x7b, 0xdc, 0x0f, 0x9d, 0xcb, 0x4e, 0xda, 0x0c, 0x9e, 0xb4,
+	0x12, 0xe4, 0x07, 0xc3, 0x11, 0x6a, 0x0e, 0xae, 0x35, 0xa2, 0x09, 0x99, 0x90, 0xa9, 0xaa, 0x81,
+	0xf5, 0x9b, 0x01, 0xf3, 0x97, 0x22, 0x90, 0xd7, 0x00, 0x14, 0xc4, 0x81, 0xc3, 0x4f, 0x50, 0x65,
+	0x46, 0xcd, 0xec, 0xf0, 0x13, 0xb2, 0x08, 0xb9, 0x48, 0x52, 0x2e, 0x4e, 0xca, 0xc5, 0xe9, 0x68,
+	0x1c, 0x2d, 0x2d, 0x44, 0xdb, 0x8f, 0x76, 0x99, 0x9f, 0x92, 0x52, 0x38, 0x22, 0xef, 0x42, 0xe6,
+	0x2b, 0xd1, 0xbe, 0x9f, 0xcf, 0x44, 0xe1, 0x95, 0x62, 0xf4, 0x22, 0x7c, 0x76, 0xb6, 0xb4, 0xa0,
+	0x8e, 0x41, 0xd0, 0xb8, 0x6f, 0xbb, 0x82, 0xb6, 0x58, 0xe8, 0xd8, 0x9f, 0xb9, 0x5e, 0xf8, 0xd7,
+	0xe3, 0xe2, 0xac, 0x5a, 0x91, 0xc3, 0xaa, 0x4c, 0xb5, 0x4a, 0xf8, 0x6a, 0xa8, 0x72, 0x5f, 0xb4,
+	0xc3, 0xf8, 0x61, 0x90, 0x3c, 0x4c, 0xb3, 0x46, 0xa3, 0xcd, 0x83, 0x00, 0x49, 0xf5, 0xd0, 0xda,
+	0x87, 0xf9, 0xbe, 0x0c, 0xdc, 0xdf, 0x36, 0xe4, 0xda, 0x38, 0x87, 0x8f, 0x69, 0x6d, 0xc8, 0x63,
+	0xd2, 0xe9, 0xb2, 0x45, 0x71, 0x62, 0x7c, 0x41, 0xf5, 0xf2, 0xd8, 0x5f, 0xe1, 0xbf, 0xea, 0x0b,
+	0xda, 0xa5, 0x80, 0x1b, 0x78, 0x1f, 0x66, 0x34, 0x87, 0xbe, 0xa2, 0xa9, 0x77, 0xd0, 0xc9, 0x1c,
+	0xdb, 0xdd, 0xdc, 0xfc, 0xf3, 0x3a, 0x5c, 0x93, 0xa8, 0xe4, 0x3b, 0x03, 0xb2, 0xca, 0xee, 0x48,
+	0x71, 0x08, 0xd1, 0xa0, 0xcf, 0x9a, 0x76, 0xda, 0x70, 0xa5, 0x6f, 0xdd, 0xfe, 0xfa, 0xef, 0xff,
+	0xbe, 0x9f, 0xbc, 0x45, 0x6e, 0xd2, 0xa4, 0xcf, 0x01, 0x09, 0xa5, 0x7c, 0x30, 0x19, 0xaa, 0xc7,
+	0x8e, 0x4d, 0x3b, 0x6d, 0xf8, 0x08, 0x50, 0xe8, 0x99, 0x3f, 0x18, 0x90, 0xd3, 0xb6, 0x40, 0x68,
+	0x92, 0x4e, 0x9f, 0x21, 0x9a, 0xa5, 0xf4, 0x09, 0x88, 0x76, 0x47, 0xa2, 0xad, 0x90, 0x5b, 0x43,
+	0xd0, 0xb4, 0xfb, 0x90, 0x3f, 0x0c, 0x78, 0xa1, 0xc7, 0xb3, 0xc8, 0x9b, 0x69, 0x05, 0xbb, 0x3d,
+	0xd1, 0x7c, 0x6b, 0xc4, 0x2c, 0x64, 0x2d, 0x49, 0xd6, 0x0d, 0xb2, 0x9e, 0x82, 0x55, 0xe1, 0xfd,
+	0x68, 0xc0, 0x4c, 0x6c, 0x64, 0x24, 0xb1, 0x3b, 0xfd, 0xae, 0x6a, 0x96, 0x47, 0xc8, 0x40, 0xc8,
+	0x37, 0x24, 0xe4, 0x2a, 0x79, 0x7d, 0x08, 0x64, 0x8b, 0xb9, 0xea, 0xb3, 0x84, 0x7c, 0x63, 0xc0,
+	0xd4, 0x9e, 0xeb, 0x93, 0x8d, 0x24, 0xa1, 0x8e, 0xbf, 0x99, 0x77, 0x52, 0xc5, 0x22, 0xce, 0xaa,
+	0xc4, 0x59, 0x26, 0x85, 0x21, 0x38, 0xa1, 0xeb, 0x93, 0x9f, 0x0c, 0x80, 0x8e, 0x71, 0x91, 0xc4,
+	0x8d, 0x0f, 0xd8, 0x9f, 0xb9, 0x39, 0x4a, 0x0a, 0xd2, 0x15, 0x25, 0xdd, 0x1a, 0x59, 0x19, 0x42,
+	0x17, 0xbd, 0x71, 0x94, 0xcb, 0x90, 0x5f, 0x0c, 0x98, 0xed, 0x72, 0x32, 0x92, 0x28, 0x39, 0xe8,
+	0x92, 0xe6, 0xd6, 0x48, 0x39, 0xc8, 0x49, 0x25, 0xe7, 0x6d, 0xb2, 0x36, 0x84, 0x53, 0xda, 0x27,
+	0x7d, 0x18, 0xdd, 0xe3, 0x47, 0xe4, 0x67, 0x03, 0x72, 0xfa, 0xfd, 0x9a, 0x7c, 0x8d, 0xfb, 0xcc,
+	0xcb, 0x2c, 0xa5, 0x4f, 0x40, 0xc0, 0xbb, 0x12, 0xb0, 0x44, 0xec, 0x21, 0x80, 0xf1, 0x1b, 0x9e,
+	0x3e, 0x44, 0x2f, 0x7c, 0x24, 0x2f, 0x88, 0x2e, 0x16, 0x90, 0xd4, 0xba, 0x41, 0xea, 0x0b, 0x32,
+	0xe0, 0x52, 0xa9, 0x2e, 0x48, 0x8c, 0x5a, 0xd9, 0x7d, 0x72, 0x5e, 0x30, 0x9e, 0x9e, 0x17, 0x8c,
+	0x7f, 0xcf, 0x0b, 0xc6, 0xb7, 0x17, 0x85, 0x89, 0xa7, 0x17, 0x85, 0x89, 0x7f, 0x2e, 0x0a, 0x13,
+	0x5f, 0xdc, 0x4d, 0xfa, 0xd4, 0x3f, 0xe9, 0x2f, 0x2c, 0xbf, 0xfd, 0x6b, 0x59, 0xf9, 0xb3, 0x6e,
+	0xeb, 0xff, 0x01, 0x00, 0x71, 0x6b, 0x26, 0x3f, 0xed, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -930,6 +1134,11 @@ type QueryClient interface {
 	// HeaderDepth returns the depth of the header in main chain or error if the
 	// block is not found or it exists on fork
 	HeaderDepth(ctx context.Context, in *QueryHeaderDepthRequest, opts ...grpc.CallOption) (*QueryHeaderDepthResponse, error)
+	// Reporter returns the bond and the statistics of a reporter of BTC headers
+	Reporter(ctx context.Context, in *QueryReporterRequest, opts ...grpc.CallOption) (*QueryReporterResponse, error)
+	// Reporters returns the bonds and the statistics of all reporters of BTC
+	// headers
+	Reporters(ctx context.Context, in *QueryReportersRequest, opts ...grpc.CallOption) (*QueryReportersResponse, error)
 }
 
 type queryClient struct {
@@ -1012,6 +1221,24 @@ func (c *queryClient) HeaderDepth(ctx context.Context, in *QueryHeaderDepthReque
 	return out, nil
 }
 
+func (c *queryClient) Reporter(ctx context.Context, in *QueryReporterRequest, opts ...grpc.CallOption) (*QueryReporterResponse, error) {
+	out := new(QueryReporterResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/Reporter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Reporters(ctx context.Context, in *QueryReportersRequest, opts ...grpc.CallOption) (*QueryReportersResponse, error) {
+	out := new(QueryReportersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/Reporters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
@@ -1035,6 +1262,11 @@ type QueryServer interface {
 	// HeaderDepth returns the depth of the header in main chain or error if the
 	// block is not found or it exists on fork
 	HeaderDepth(context.Context, *QueryHeaderDepthRequest) (*QueryHeaderDepthResponse, error)
+	// Reporter returns the bond and the statistics of a reporter of BTC headers
+	Reporter(context.Context, *QueryReporterRequest) (*QueryReporterResponse, error)
+	// Reporters returns the bonds and the statistics of all reporters of BTC
+	// headers
+	Reporters(context.Context, *QueryReportersRequest) (*QueryReportersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1065,6 +1297,12 @@ func (*UnimplementedQueryServer) BaseHeader(ctx context.Context, req *QueryBaseH
 func (*UnimplementedQueryServer) HeaderDepth(ctx context.Context, req *QueryHeaderDepthRequest) (*QueryHeaderDepthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HeaderDepth not implemented")
 }
+func (*UnimplementedQueryServer) Reporter(ctx context.Context, req *QueryReporterRequest) (*QueryReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reporter not implemented")
+}
+func (*UnimplementedQueryServer) Reporters(ctx context.Context, req *QueryReportersRequest) (*QueryReportersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reporters not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1214,6 +1452,42 @@ func _Query_HeaderDepth_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Reporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReporterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/Reporter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reporter(ctx, req.(*QueryReporterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Reporters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReportersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reporters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/Reporters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reporters(ctx, req.(*QueryReportersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.btclightclient.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1250,6 +1524,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HeaderDepth",
 			Handler:    _Query_HeaderDepth_Handler,
 		},
+		{
+			MethodName: "Reporter",
+			Handler:    _Query_Reporter_Handler,
+		},
+		{
+			MethodName: "Reporters",
+			Handler:    _Query_Reporters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btclightclient/v1/query.proto",
@@ -1836,86 +2118,235 @@ func (m *BTCHeaderInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReporterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryReporterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHashesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryReporterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHashesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Hashes) > 0 {
-		for _, e := range m.Hashes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryReporterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryContainsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryReporterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReporterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Hash != nil {
-		l = m.Hash.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Reporter != nil {
+		{
+			size, err := m.Reporter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryContainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryReportersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReportersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reporters) > 0 {
+		for iNdEx := len(m.Reporters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reporters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for _, e := range m.Hashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Contains {
@@ -2072,6 +2503,64 @@ func (m *BTCHeaderInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryReporterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReporterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reporter != nil {
+		l = m.Reporter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReportersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReportersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reporters) > 0 {
+		for _, e := range m.Reporters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3523,6 +4012,380 @@ func (m *BTCHeaderInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryReporterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReporterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReporterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReporterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReporterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reporter == nil {
+				m.Reporter = &ReporterInfo{}
+			}
+			if err := m.Reporter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporters = append(m.Reporters, &ReporterInfo{})
+			if err := m.Reporters[len(m.Reporters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewReporterInfo(reporter sdk.AccAddress) *ReporterInfo {
+	return &ReporterInfo{
+		Address:     reporter.String(),
+		Bond:        sdk.NewCoins(),
+		Rewards:     sdk.NewCoins(),
+		FeesCharged: sdk.NewCoins(),
+	}
+}
+
+// Validate verifies that the information inside the ReporterInfo is valid.
+func (ri *ReporterInfo) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(ri.Address); err != nil {
+		return fmt.Errorf("invalid reporter address: %w", err)
+	}
+	if err := ri.Bond.Validate(); err != nil {
+		return fmt.Errorf("invalid bond: %w", err)
+	}
+	if err := ri.Rewards.Validate(); err != nil {
+		return fmt.Errorf("invalid rewards: %w", err)
+	}
+	if err := ri.FeesCharged.Validate(); err != nil {
+		return fmt.Errorf("invalid fees charged: %w", err)
+	}
+	return nil
+}
+
+// HasBond returns whether the reporter has posted a bond of at least the
+// given coin
+func (ri *ReporterInfo) HasBond(required sdk.Coin) bool {
+	return ri.Bond.AmountOf(required.Denom).GTE(required.Amount)
+}
+
+// HasSubmitted returns whether the reporter has submitted any headers that
+// were either inserted or charged the no-work fee
+func (ri *ReporterInfo) HasSubmitted() bool {
+	return ri.HeadersInserted > 0 || ri.NoWorkSubmissions > 0
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// MedianTimeBlocks is the number of previous headers used by BTC to compute
+// the median time past of a header. It mirrors the unexported constant in btcd.
+const MedianTimeBlocks = 11
+
+func NewRetargetContext(
+	periodStartHeader *bbn.BTCHeaderBytes,
+	periodStartHeight uint64,
+	previousTimestamps []int64,
+) *RetargetContext {
+	return &RetargetContext{
+		PeriodStartHeader:  periodStartHeader,
+		PeriodStartHeight:  periodStartHeight,
+		PreviousTimestamps: previousTimestamps,
+	}
+}
+
+// NumPreviousTimestamps returns the number of timestamps a retarget context
+// must carry for a base header at the given height.
+func NumPreviousTimestamps(baseHeight uint64) int {
+	if baseHeight < MedianTimeBlocks {
+		return int(baseHeight)
+	}
+	return MedianTimeBlocks
+}
+
+// Validate verifies that the retarget context is consistent with the given
+// base header.
+func (m *RetargetContext) Validate(baseHeader *BTCHeaderInfo, params *chaincfg.Params) error {
+	if m.PeriodStartHeader == nil {
+		return errors.New("period start header is nil")
+	}
+	if baseHeader == nil || baseHeader.Header == nil {
+		return errors.New("base header is nil")
+	}
+
+	blocksPerRetarget := uint64(BlocksPerRetarget(params))
+	if m.PeriodStartHeight%blocksPerRetarget != 0 {
+		return fmt.Errorf("period start height %d is not at a difficulty adjustment boundary", m.PeriodStartHeight)
+	}
+	if m.PeriodStartHeight > baseHeader.Height || baseHeader.Height-m.PeriodStartHeight >= blocksPerRetarget {
+		return fmt.Errorf("period start height %d is not in the difficulty adjustment period of base header at height %d",
+			m.PeriodStartHeight, baseHeader.Height)
+	}
+	if m.PeriodStartHeight == baseHeader.Height && !m.PeriodStartHeader.Eq(baseHeader.Header) {
+		return errors.New("period start header is at base header height but differs from base header")
+	}
+
+	expectedTimestamps := NumPreviousTimestamps(baseHeader.Height)
+	if len(m.PreviousTimestamps) != expectedTimestamps {
+		return fmt.Errorf("expected %d previous timestamps, got %d", expectedTimestamps, len(m.PreviousTimestamps))
+	}
+
+	// if the period start header is among the headers preceding the base header,
+	// its timestamp must match the one provided in the list
+	if ts, ok := m.PreviousTimestampAt(baseHeader.Height, m.PeriodStartHeight); ok {
+		if ts != m.PeriodStartHeader.Time().Unix() {
+			return fmt.Errorf("previous timestamp at height %d does not match period start header timestamp", m.PeriodStartHeight)
+		}
+	}
+
+	return nil
+}
+
+// PreviousTimestampAt returns the timestamp of the header at the given height
+// if it is one of the headers preceding the base header at baseHeight
+func (m *RetargetContext) PreviousTimestampAt(baseHeight uint64, height uint64) (int64, bool) {
+	numTimestamps := uint64(len(m.PreviousTimestamps))
+	if height >= baseHeight || baseHeight-height > numTimestamps {
+		return 0, false
+	}
+	return m.PreviousTimestamps[numTimestamps-(baseHeight-height)], true
+}
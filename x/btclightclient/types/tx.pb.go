@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -114,6 +116,190 @@ func (m *MsgInsertHeadersResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInsertHeadersResponse proto.InternalMessageInfo
 
+// MsgBondReporter defines the message for posting a bond for a reporter
+type MsgBondReporter struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// amount is the amount to add to the bond of the reporter
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgBondReporter) Reset()         { *m = MsgBondReporter{} }
+func (m *MsgBondReporter) String() string { return proto.CompactTextString(m) }
+func (*MsgBondReporter) ProtoMessage()    {}
+func (*MsgBondReporter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f638eee60234021, []int{2}
+}
+func (m *MsgBondReporter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondReporter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondReporter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBondReporter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondReporter.Merge(m, src)
+}
+func (m *MsgBondReporter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondReporter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondReporter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBondReporter proto.InternalMessageInfo
+
+func (m *MsgBondReporter) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgBondReporter) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgBondReporterResponse defines the response for the BondReporter
+// transaction
+type MsgBondReporterResponse struct {
+}
+
+func (m *MsgBondReporterResponse) Reset()         { *m = MsgBondReporterResponse{} }
+func (m *MsgBondReporterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBondReporterResponse) ProtoMessage()    {}
+func (*MsgBondReporterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f638eee60234021, []int{3}
+}
+func (m *MsgBondReporterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondReporterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondReporterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBondReporterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondReporterResponse.Merge(m, src)
+}
+func (m *MsgBondReporterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondReporterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondReporterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBondReporterResponse proto.InternalMessageInfo
+
+// MsgUnbondReporter defines the message for withdrawing the bond of a reporter
+type MsgUnbondReporter struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgUnbondReporter) Reset()         { *m = MsgUnbondReporter{} }
+func (m *MsgUnbondReporter) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondReporter) ProtoMessage()    {}
+func (*MsgUnbondReporter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f638eee60234021, []int{4}
+}
+func (m *MsgUnbondReporter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondReporter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondReporter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbondReporter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondReporter.Merge(m, src)
+}
+func (m *MsgUnbondReporter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondReporter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondReporter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondReporter proto.InternalMessageInfo
+
+func (m *MsgUnbondReporter) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgUnbondReporterResponse defines the response for the UnbondReporter
+// transaction
+type MsgUnbondReporterResponse struct {
+	// amount is the withdrawn bond
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgUnbondReporterResponse) Reset()         { *m = MsgUnbondReporterResponse{} }
+func (m *MsgUnbondReporterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondReporterResponse) ProtoMessage()    {}
+func (*MsgUnbondReporterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f638eee60234021, []int{5}
+}
+func (m *MsgUnbondReporterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondReporterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondReporterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbondReporterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondReporterResponse.Merge(m, src)
+}
+func (m *MsgUnbondReporterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondReporterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondReporterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondReporterResponse proto.InternalMessageInfo
+
+func (m *MsgUnbondReporterResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // MsgUpdateParams defines a message for updating btc light client module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -131,7 +317,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f638eee60234021, []int{2}
+	return fileDescriptor_5f638eee60234021, []int{6}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,7 +368,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f638eee60234021, []int{3}
+	return fileDescriptor_5f638eee60234021, []int{7}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,6 +400,10 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MsgInsertHeaders)(nil), "babylon.btclightclient.v1.MsgInsertHeaders")
 	proto.RegisterType((*MsgInsertHeadersResponse)(nil), "babylon.btclightclient.v1.MsgInsertHeadersResponse")
+	proto.RegisterType((*MsgBondReporter)(nil), "babylon.btclightclient.v1.MsgBondReporter")
+	proto.RegisterType((*MsgBondReporterResponse)(nil), "babylon.btclightclient.v1.MsgBondReporterResponse")
+	proto.RegisterType((*MsgUnbondReporter)(nil), "babylon.btclightclient.v1.MsgUnbondReporter")
+	proto.RegisterType((*MsgUnbondReporterResponse)(nil), "babylon.btclightclient.v1.MsgUnbondReporterResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btclightclient.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btclightclient.v1.MsgUpdateParamsResponse")
 }
@@ -223,35 +413,44 @@ func init() {
 }
 
 var fileDescriptor_5f638eee60234021 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0xfa, 0x25, 0x15, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x92, 0x50, 0x35,
-	0x7a, 0xa8, 0x6a, 0xf4, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xaa, 0xf4, 0x41,
-	0x2c, 0x88, 0x06, 0x29, 0xf1, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0xdc, 0xe2, 0x74, 0x90,
-	0x41, 0xb9, 0xc5, 0xe9, 0x50, 0x09, 0x35, 0xdc, 0xb6, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x43,
-	0xd5, 0x49, 0x42, 0x0c, 0x88, 0x87, 0x98, 0x0c, 0xe1, 0x40, 0xa4, 0x94, 0xba, 0x19, 0xb9, 0x04,
-	0x7c, 0x8b, 0xd3, 0x3d, 0xf3, 0x8a, 0x53, 0x8b, 0x4a, 0x3c, 0x52, 0x13, 0x53, 0x52, 0x8b, 0x8a,
-	0x85, 0xc4, 0xb8, 0xd8, 0x8a, 0x33, 0xd3, 0xf3, 0x52, 0x8b, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38,
-	0x83, 0xa0, 0x3c, 0xa1, 0x20, 0x2e, 0xf6, 0x0c, 0x88, 0x12, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x1e,
-	0x27, 0x8b, 0x5b, 0xf7, 0xe4, 0x4d, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73,
-	0xf5, 0xa1, 0xee, 0x49, 0xce, 0x48, 0xcc, 0xcc, 0x83, 0x71, 0xf4, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0xf5, 0x9c, 0x42, 0x9c, 0x21, 0xc6, 0x3b, 0x55, 0x96, 0xa4, 0x16, 0x07, 0xc1, 0x0c, 0xb2, 0xe2,
-	0x6e, 0x7a, 0xbe, 0x41, 0x0b, 0x6a, 0x81, 0x92, 0x14, 0x97, 0x04, 0xba, 0x63, 0x82, 0x52, 0x8b,
-	0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x95, 0x66, 0x31, 0x72, 0xf1, 0xfb, 0x16, 0xa7, 0x87, 0x16, 0xa4,
-	0x24, 0x96, 0xa4, 0x06, 0x80, 0xbd, 0x27, 0x64, 0xc6, 0xc5, 0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f,
-	0x94, 0x59, 0x52, 0x09, 0x71, 0xab, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22, 0x50, 0x2f, 0x3a, 0xa6,
-	0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0x21, 0x94, 0x0a, 0xd9,
-	0x73, 0xb1, 0x41, 0x02, 0x48, 0x82, 0x49, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x51, 0x0f, 0x67, 0x9c,
-	0xe8, 0x41, 0xac, 0x72, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0xcd, 0x8a, 0x0f, 0xe4,
-	0x6a, 0x84, 0x81, 0x4a, 0x92, 0x5c, 0xe2, 0x68, 0x6e, 0x83, 0xb9, 0xdb, 0xe8, 0x23, 0x23, 0x17,
-	0xb3, 0x6f, 0x71, 0xba, 0x50, 0x31, 0x17, 0x2f, 0x6a, 0x28, 0x6b, 0xe3, 0xb1, 0x14, 0x3d, 0x14,
-	0xa4, 0x8c, 0x49, 0x50, 0x0c, 0x0f, 0x32, 0x06, 0xa1, 0x3c, 0x2e, 0x1e, 0x94, 0x00, 0xd3, 0xc2,
-	0x6f, 0x0c, 0xb2, 0x5a, 0x29, 0x23, 0xe2, 0xd5, 0xc2, 0x6c, 0x94, 0x62, 0x6d, 0x78, 0xbe, 0x41,
-	0x8b, 0xd1, 0x29, 0xe0, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
-	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xcc, 0x08,
-	0xa5, 0x96, 0x0a, 0xf4, 0xc4, 0x0c, 0x4e, 0x3e, 0x49, 0x6c, 0xe0, 0xe4, 0x6a, 0x0c, 0x08, 0x00,
-	0x00, 0xff, 0xff, 0x64, 0x60, 0x2a, 0x32, 0x61, 0x03, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x1c, 0xcd, 0x35, 0xdf, 0x6f, 0x50, 0xaf, 0xa5, 0x80, 0x55, 0xd1, 0xc4, 0x83, 0x1b, 0x32, 0xa0,
+	0x28, 0x50, 0x9b, 0xa4, 0x55, 0xa9, 0xba, 0x20, 0xdc, 0x05, 0x86, 0x48, 0x95, 0xa1, 0x0b, 0x0b,
+	0xf2, 0x8f, 0xd3, 0xc5, 0xa2, 0xbe, 0xb3, 0xfc, 0xb9, 0x44, 0xcd, 0x56, 0xb1, 0xb2, 0x30, 0xf3,
+	0x27, 0x30, 0x75, 0xe0, 0x8f, 0xe8, 0x58, 0xb1, 0x80, 0x18, 0x0a, 0x4a, 0x86, 0xfe, 0x1b, 0xc8,
+	0xb9, 0x73, 0x1a, 0x07, 0x25, 0x4d, 0x97, 0xc4, 0xa7, 0x7b, 0x9f, 0xf7, 0xde, 0xbd, 0x7b, 0x3a,
+	0x5c, 0xf3, 0x5c, 0xaf, 0x7f, 0xcc, 0x99, 0xe5, 0x09, 0xff, 0x38, 0xa4, 0x9d, 0xf4, 0x97, 0x30,
+	0x61, 0xf5, 0x9a, 0x96, 0x38, 0x31, 0xe3, 0x84, 0x0b, 0xae, 0x55, 0x14, 0xc6, 0xcc, 0x63, 0xcc,
+	0x5e, 0x53, 0x5f, 0xa7, 0x9c, 0xf2, 0x11, 0xca, 0x4a, 0xbf, 0xe4, 0x80, 0xbe, 0xe1, 0x73, 0x88,
+	0x38, 0x58, 0x11, 0xd0, 0x94, 0x28, 0x02, 0xaa, 0x36, 0x1e, 0xcf, 0x56, 0x8b, 0xdd, 0xc4, 0x8d,
+	0x40, 0xe1, 0x2a, 0x92, 0xe0, 0xbd, 0x64, 0x96, 0x0b, 0xb5, 0x65, 0x28, 0x6e, 0xcf, 0x05, 0x62,
+	0xf5, 0x9a, 0x1e, 0x11, 0x6e, 0xd3, 0xf2, 0x79, 0xc8, 0xe4, 0x7e, 0xed, 0x13, 0xc2, 0xf7, 0xdb,
+	0x40, 0x5f, 0x33, 0x20, 0x89, 0x78, 0x45, 0xdc, 0x80, 0x24, 0xa0, 0x3d, 0xc4, 0x25, 0x08, 0x29,
+	0x23, 0x49, 0x19, 0x55, 0x51, 0x7d, 0xd9, 0x51, 0x2b, 0xcd, 0xc1, 0x77, 0x3a, 0x12, 0x52, 0x5e,
+	0xaa, 0x16, 0xeb, 0xab, 0xf6, 0xde, 0xaf, 0xcb, 0xcd, 0x1d, 0x1a, 0x8a, 0x4e, 0xd7, 0x33, 0x7d,
+	0x1e, 0x59, 0xca, 0xaf, 0xdf, 0x71, 0x43, 0x96, 0x2d, 0x2c, 0xd1, 0x8f, 0x09, 0x98, 0xf6, 0xdb,
+	0x03, 0x49, 0x6f, 0xf7, 0x05, 0x01, 0x27, 0x23, 0xda, 0x5f, 0xf9, 0x78, 0x75, 0xd6, 0x50, 0x02,
+	0x35, 0x1d, 0x97, 0xa7, 0xcd, 0x38, 0x04, 0x62, 0xce, 0x80, 0xd4, 0x38, 0xbe, 0xd7, 0x06, 0x6a,
+	0x73, 0x16, 0x38, 0x24, 0xe6, 0x89, 0x20, 0xc9, 0x4c, 0x9f, 0xcf, 0x71, 0xc9, 0x8d, 0x78, 0x97,
+	0x89, 0xf2, 0x52, 0x15, 0xd5, 0x57, 0x5a, 0x15, 0x53, 0x65, 0x92, 0xa6, 0x60, 0xaa, 0x14, 0xcc,
+	0x03, 0x1e, 0x32, 0xfb, 0xbf, 0xf3, 0xcb, 0xcd, 0x82, 0xa3, 0xe0, 0x79, 0x33, 0x15, 0xbc, 0x31,
+	0x25, 0x38, 0xf6, 0xb2, 0x87, 0x1f, 0xb4, 0x81, 0x1e, 0x31, 0x6f, 0x01, 0x37, 0x79, 0xd2, 0x53,
+	0x84, 0x2b, 0xff, 0x8c, 0x66, 0xbc, 0x9a, 0x3f, 0x36, 0x8e, 0xaa, 0xc5, 0xf9, 0xc6, 0x9f, 0xa5,
+	0xc6, 0xbf, 0xfe, 0xde, 0xac, 0x4f, 0xc4, 0xaf, 0xee, 0x5a, 0xfe, 0x6d, 0x41, 0xf0, 0x41, 0x65,
+	0x9f, 0x0e, 0x40, 0x76, 0xc8, 0xda, 0x17, 0x34, 0x4a, 0xf2, 0x28, 0x0e, 0x5c, 0x41, 0x0e, 0x47,
+	0x3d, 0xd2, 0x76, 0xf1, 0xb2, 0xdb, 0x15, 0x1d, 0x9e, 0x84, 0xa2, 0x2f, 0xed, 0xdb, 0xe5, 0xef,
+	0xdf, 0xb6, 0xd6, 0x95, 0xfc, 0xcb, 0x20, 0x48, 0x08, 0xc0, 0x1b, 0x91, 0x84, 0x8c, 0x3a, 0xd7,
+	0x50, 0xed, 0x05, 0x2e, 0xc9, 0x26, 0xaa, 0xa4, 0x1f, 0x99, 0x33, 0xcb, 0x6f, 0x4a, 0xa9, 0x2c,
+	0x71, 0x39, 0xb6, 0xbf, 0x96, 0x86, 0x73, 0x4d, 0xa8, 0x42, 0x9f, 0xf4, 0x96, 0x85, 0xd3, 0xfa,
+	0x51, 0xc4, 0xc5, 0x36, 0x50, 0x0d, 0xf0, 0xdd, 0x7c, 0x5d, 0x9f, 0xcc, 0x11, 0x9d, 0xae, 0x93,
+	0xbe, 0x7d, 0x0b, 0xf0, 0xf8, 0xbe, 0x0b, 0x1a, 0xc3, 0xab, 0xb9, 0xea, 0x35, 0xe6, 0xd3, 0x4c,
+	0x62, 0xf5, 0xd6, 0xe2, 0xd8, 0x71, 0x13, 0x04, 0x5e, 0x9b, 0xaa, 0xd7, 0xd3, 0xf9, 0x2c, 0x79,
+	0xb4, 0xbe, 0x73, 0x1b, 0xf4, 0x58, 0x95, 0xe1, 0xd5, 0x5c, 0x2d, 0x6e, 0x38, 0xe5, 0x24, 0x56,
+	0x6f, 0x2d, 0x8e, 0xcd, 0xf4, 0xf4, 0xff, 0x4f, 0xaf, 0xce, 0x1a, 0xc8, 0x3e, 0x3c, 0x1f, 0x18,
+	0xe8, 0x62, 0x60, 0xa0, 0x3f, 0x03, 0x03, 0x7d, 0x1e, 0x1a, 0x85, 0x8b, 0xa1, 0x51, 0xf8, 0x39,
+	0x34, 0x0a, 0xef, 0x76, 0x6f, 0x7a, 0x5c, 0x4e, 0xa6, 0xdf, 0xc6, 0x51, 0xe3, 0xbd, 0xd2, 0xe8,
+	0x75, 0xdb, 0xfe, 0x3b, 0x00, 0xd2, 0x74, 0x1b, 0x6a, 0xb0, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +467,10 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// InsertHeaders adds a batch of headers to the BTC light client chain
 	InsertHeaders(ctx context.Context, in *MsgInsertHeaders, opts ...grpc.CallOption) (*MsgInsertHeadersResponse, error)
+	// BondReporter posts a bond for a reporter of BTC headers
+	BondReporter(ctx context.Context, in *MsgBondReporter, opts ...grpc.CallOption) (*MsgBondReporterResponse, error)
+	// UnbondReporter withdraws the whole bond of a reporter of BTC headers
+	UnbondReporter(ctx context.Context, in *MsgUnbondReporter, opts ...grpc.CallOption) (*MsgUnbondReporterResponse, error)
 	// UpdateParams defines a method for updating btc light client module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -289,6 +492,24 @@ func (c *msgClient) InsertHeaders(ctx context.Context, in *MsgInsertHeaders, opt
 	return out, nil
 }
 
+func (c *msgClient) BondReporter(ctx context.Context, in *MsgBondReporter, opts ...grpc.CallOption) (*MsgBondReporterResponse, error) {
+	out := new(MsgBondReporterResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Msg/BondReporter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnbondReporter(ctx context.Context, in *MsgUnbondReporter, opts ...grpc.CallOption) (*MsgUnbondReporterResponse, error) {
+	out := new(MsgUnbondReporterResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Msg/UnbondReporter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Msg/UpdateParams", in, out, opts...)
@@ -302,6 +523,10 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// InsertHeaders adds a batch of headers to the BTC light client chain
 	InsertHeaders(context.Context, *MsgInsertHeaders) (*MsgInsertHeadersResponse, error)
+	// BondReporter posts a bond for a reporter of BTC headers
+	BondReporter(context.Context, *MsgBondReporter) (*MsgBondReporterResponse, error)
+	// UnbondReporter withdraws the whole bond of a reporter of BTC headers
+	UnbondReporter(context.Context, *MsgUnbondReporter) (*MsgUnbondReporterResponse, error)
 	// UpdateParams defines a method for updating btc light client module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -313,6 +538,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) InsertHeaders(ctx context.Context, req *MsgInsertHeaders) (*MsgInsertHeadersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertHeaders not implemented")
 }
+func (*UnimplementedMsgServer) BondReporter(ctx context.Context, req *MsgBondReporter) (*MsgBondReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondReporter not implemented")
+}
+func (*UnimplementedMsgServer) UnbondReporter(ctx context.Context, req *MsgUnbondReporter) (*MsgUnbondReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondReporter not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -339,6 +570,42 @@ func _Msg_InsertHeaders_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BondReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBondReporter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BondReporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Msg/BondReporter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BondReporter(ctx, req.(*MsgBondReporter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbondReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondReporter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondReporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Msg/UnbondReporter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondReporter(ctx, req.(*MsgUnbondReporter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -365,6 +632,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InsertHeaders",
 			Handler:    _Msg_InsertHeaders_Handler,
 		},
+		{
+			MethodName: "BondReporter",
+			Handler:    _Msg_BondReporter_Handler,
+		},
+		{
+			MethodName: "UnbondReporter",
+			Handler:    _Msg_UnbondReporter_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -441,7 +716,7 @@ func (m *MsgInsertHeadersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgBondReporter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -451,18 +726,18 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBondReporter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBondReporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -471,17 +746,17 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBondReporterResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -491,12 +766,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBondReporterResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBondReporterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -504,70 +779,252 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUnbondReporter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgInsertHeaders) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUnbondReporter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondReporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Headers) > 0 {
-		for _, e := range m.Headers {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInsertHeadersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnbondReporterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgUnbondReporterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUnbondReporterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgInsertHeaders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInsertHeadersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBondReporter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBondReporterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnbondReporter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnbondReporterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
@@ -740,6 +1197,337 @@ func (m *MsgInsertHeadersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgBondReporter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondReporter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondReporter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondReporterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondReporterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondReporter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondReporter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondReporter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondReporterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondReporterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
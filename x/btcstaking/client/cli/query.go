@@ -31,6 +31,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdActivatedHeight())
 	cmd.AddCommand(CmdFinalityProviderDelegations())
 	cmd.AddCommand(CmdDelegation())
+	cmd.AddCommand(CmdStakingCap())
+	cmd.AddCommand(CmdBTCDelegationsByTag())
 
 	return cmd
 }
@@ -126,7 +128,7 @@ func CmdFinalityProviders() *cobra.Command {
 func CmdBTCDelegations() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "btc-delegations [status]",
-		Short: "retrieve all BTC delegations under the given status (pending, active, unbonding, unbonded, any)",
+		Short: "retrieve all BTC delegations under the given status (pending, active, unbonding, unbonded, overflow, any)",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -160,6 +162,38 @@ func CmdBTCDelegations() *cobra.Command {
 	return cmd
 }
 
+func CmdBTCDelegationsByTag() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegations-by-tag [tag_hex]",
+		Short: "retrieve all BTC delegations whose identifiable staking txs carry the given OP_RETURN tag",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BTCDelegationsByTag(cmd.Context(), &types.QueryBTCDelegationsByTagRequest{
+				TagHex:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "btc-delegations-by-tag")
+
+	return cmd
+}
+
 func CmdFinalityProviderPowerAtHeight() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "finality-provider-power-at-height [fp_btc_pk_hex] [height]",
@@ -215,6 +249,30 @@ func CmdActivatedHeight() *cobra.Command {
 	return cmd
 }
 
+func CmdStakingCap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-cap",
+		Short: "get the staking cap, the total active stake and the remaining capacity of the BTC staking protocol",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StakingCap(cmd.Context(), &types.QueryStakingCapRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdFinalityProvidersAtHeight() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "finality-providers-at-height [height]",
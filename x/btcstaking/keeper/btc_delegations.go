@@ -46,6 +46,7 @@ func (k Keeper) AddBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation) e
 
 	// save this BTC delegation
 	k.setBTCDelegation(ctx, btcDel)
+	k.indexBTCDelegationByTag(ctx, btcDel)
 
 	// notify subscriber
 	event := &types.EventBTCDelegationStateUpdate{
@@ -66,6 +66,74 @@ func (k Keeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	return nil
 }
 
+// JailFinalityProvider jails a finality provider with the given PK
+// A jailed finality provider keeps its BTC delegations but is excluded from
+// the active finality provider set until it is unjailed
+func (k Keeper) JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	// ensure finality provider exists
+	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+
+	// ensure finality provider is not slashed or jailed yet
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed
+	}
+	if fp.Jailed {
+		return types.ErrFpAlreadyJailed
+	}
+
+	// set finality provider to be jailed
+	fp.Jailed = true
+	k.SetFinalityProvider(ctx, fp)
+
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return fmt.Errorf("failed to get current BTC tip")
+	}
+
+	// record jailed event. The next `BeginBlock` will consume this
+	// event for updating the finality provider set
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithJailedFP(fp.BtcPk)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+
+	return nil
+}
+
+// UnjailFinalityProvider unjails a jailed finality provider with the given PK
+func (k Keeper) UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	// ensure finality provider exists
+	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+
+	// a slashed finality provider can never be unjailed
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed
+	}
+	if !fp.Jailed {
+		return types.ErrFpNotJailed
+	}
+
+	// set finality provider to be unjailed
+	fp.Jailed = false
+	k.SetFinalityProvider(ctx, fp)
+
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return fmt.Errorf("failed to get current BTC tip")
+	}
+
+	// record unjailed event. The next `BeginBlock` will consume this
+	// event for updating the finality provider set
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithUnjailedFP(fp.BtcPk)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+
+	return nil
+}
+
 // finalityProviderStore returns the KVStore of the finality provider set
 // prefix: FinalityProviderKey
 // key: Bitcoin secp256k1 PK
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzJailFinalityProvider(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		h.NoError(err)
+
+		// generate a finality provider with a BTC delegation
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+		stakingValue := datagen.RandomInt(r, 100000) + 100000
+		_, _, _, delMsg, del := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(stakingValue),
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, delMsg, del)
+
+		// the finality provider has voting power
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		require.NoError(t, err)
+		require.Equal(t, stakingValue, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+
+		// a non-jailed finality provider cannot be unjailed
+		err = h.BTCStakingKeeper.UnjailFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		require.ErrorIs(t, err, types.ErrFpNotJailed)
+
+		// jail the finality provider, after which it has no voting power
+		err = h.BTCStakingKeeper.JailFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		require.NoError(t, err)
+		err = h.BTCStakingKeeper.JailFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		require.ErrorIs(t, err, types.ErrFpAlreadyJailed)
+		babylonHeight++
+		h.SetCtxHeight(babylonHeight)
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		require.NoError(t, err)
+		require.Zero(t, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		dc, err := h.BTCStakingKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		require.NoError(t, err)
+		require.Len(t, dc.FinalityProviders, 1)
+		require.True(t, dc.FinalityProviders[0].IsJailed)
+		require.Zero(t, dc.GetNumActiveFPs(h.BTCStakingKeeper.GetParams(h.Ctx).MaxActiveFinalityProviders))
+		fpAfterJailing, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		require.NoError(t, err)
+		require.True(t, fpAfterJailing.Jailed)
+
+		// unjail the finality provider, after which it regains its voting power
+		err = h.BTCStakingKeeper.UnjailFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		require.NoError(t, err)
+		babylonHeight++
+		h.SetCtxHeight(babylonHeight)
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		require.NoError(t, err)
+		require.Equal(t, stakingValue, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+	})
+}
@@ -26,6 +26,7 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 
 	for _, btcDel := range gs.BtcDelegations {
 		k.setBTCDelegation(ctx, btcDel)
+		k.indexBTCDelegationByTag(ctx, btcDel)
 	}
 
 	for _, fpVP := range gs.VotingPowers {
@@ -51,8 +52,17 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		}
 	}
 
+	// the total active stake is restored from the latest voting power
+	// distribution cache
+	var latestVpCache *types.VotingPowerDistCacheBlkHeight
 	for _, vpCache := range gs.VpDstCache {
 		k.setVotingPowerDistCache(ctx, vpCache.BlockHeight, vpCache.VpDistribution)
+		if latestVpCache == nil || vpCache.BlockHeight > latestVpCache.BlockHeight {
+			latestVpCache = vpCache
+		}
+	}
+	if latestVpCache != nil {
+		k.setTotalActiveStake(ctx, latestVpCache.VpDistribution.GetTotalStakeSat())
 	}
 
 	return nil
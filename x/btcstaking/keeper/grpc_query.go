@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -11,6 +12,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/babylonchain/babylon/btcstaking"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 )
@@ -136,6 +138,10 @@ func (k Keeper) FinalityProviderPowerAtHeight(ctx context.Context, req *types.Qu
 		return nil, types.ErrFpNotFound
 	}
 
+	if k.IsVotingPowerPruned(ctx, req.Height) {
+		return nil, types.ErrVotingPowerTablePruned.Wrapf("height: %d", req.Height)
+	}
+
 	store := k.votingPowerBbnBlockHeightStore(ctx, req.Height)
 	iter := store.ReverseIterator(nil, nil)
 	defer iter.Close()
@@ -174,6 +180,9 @@ func (k Keeper) ActiveFinalityProvidersAtHeight(ctx context.Context, req *types.
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.IsVotingPowerPruned(sdkCtx, req.Height) {
+		return nil, types.ErrVotingPowerTablePruned.Wrapf("height: %d", req.Height)
+	}
 	store := k.votingPowerBbnBlockHeightStore(sdkCtx, req.Height)
 
 	var finalityProvidersWithMeta []*types.FinalityProviderWithMeta
@@ -191,6 +200,7 @@ func (k Keeper) ActiveFinalityProvidersAtHeight(ctx context.Context, req *types.
 				VotingPower:          votingPower,
 				SlashedBabylonHeight: finalityProvider.SlashedBabylonHeight,
 				SlashedBtcHeight:     finalityProvider.SlashedBtcHeight,
+				Jailed:               finalityProvider.Jailed,
 			}
 			finalityProvidersWithMeta = append(finalityProvidersWithMeta, &finalityProviderWithMeta)
 		}
@@ -302,3 +312,61 @@ func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegation
 		BtcDelegation: types.NewBTCDelegationResponse(btcDel, status),
 	}, nil
 }
+
+// StakingCap returns the staking cap, the total active stake and the remaining
+// capacity of the BTC staking protocol
+func (k Keeper) StakingCap(ctx context.Context, req *types.QueryStakingCapRequest) (*types.QueryStakingCapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingCap := k.GetParams(ctx).StakingCapSat
+	totalActiveStake := k.GetTotalActiveStake(ctx)
+
+	return &types.QueryStakingCapResponse{
+		StakingCapSat:       stakingCap,
+		TotalActiveStakeSat: totalActiveStake,
+		RemainingCapSat:     GetRemainingStakingCap(stakingCap, totalActiveStake),
+	}, nil
+}
+
+// BTCDelegationsByTag returns all BTC delegations whose staking txs are
+// identifiable staking txs carrying the given OP_RETURN tag
+func (k Keeper) BTCDelegationsByTag(ctx context.Context, req *types.QueryBTCDelegationsByTagRequest) (*types.QueryBTCDelegationsByTagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tag, err := hex.DecodeString(req.TagHex)
+	if err != nil || len(tag) != btcstaking.TagLen {
+		return nil, status.Errorf(codes.InvalidArgument, "OP_RETURN tag has to be %d bytes in hex format", btcstaking.TagLen)
+	}
+
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	store := k.btcDelegationByTagStore(ctx, tag)
+	var btcDels []*types.BTCDelegationResponse
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		stakingTxHash, err := chainhash.NewHash(key)
+		if err != nil {
+			return err
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHash.String())
+		}
+		status := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+		btcDels = append(btcDels, types.NewBTCDelegationResponse(btcDel, status))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBTCDelegationsByTagResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// Implements BTCStakingHooks interface
+var _ types.BTCStakingHooks = Keeper{}
+
+// AfterBTCDelegationActivated - call hook if registered
+func (k Keeper) AfterBTCDelegationActivated(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcDel *types.BTCDelDistInfo) {
+	if k.hooks != nil {
+		k.hooks.AfterBTCDelegationActivated(ctx, fpBTCPK, btcDel)
+	}
+}
+
+// AfterBTCDelegationUnbonded - call hook if registered
+func (k Keeper) AfterBTCDelegationUnbonded(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcDel *types.BTCDelDistInfo) {
+	if k.hooks != nil {
+		k.hooks.AfterBTCDelegationUnbonded(ctx, fpBTCPK, btcDel)
+	}
+}
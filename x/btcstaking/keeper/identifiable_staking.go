@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/btcstaking"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// parseIdentifiableStakingTx checks whether the given staking tx is an
+// identifiable (V0) staking tx carrying the staking tx tag in the given params.
+// If so, it ensures that the data committed in the OP_RETURN output matches
+// the BTC delegation request and returns the OP_RETURN info to be recorded in
+// the BTC delegation. It returns nil if the staking tx is not identifiable.
+func (k Keeper) parseIdentifiableStakingTx(
+	stakingTx *wire.MsgTx,
+	stakingOutputIdx uint32,
+	req *types.MsgCreateBTCDelegation,
+	params *types.Params,
+) (*types.OpReturnInfo, error) {
+	tag := params.StakingTxTagBytes()
+	if !btcstaking.IsPossibleV0StakingTx(stakingTx, tag) {
+		return nil, nil
+	}
+
+	covenantPKs, err := bbn.NewBTCPKsFromBIP340PKs(params.CovenantPks)
+	if err != nil {
+		// programming error
+		panic("failed to parse covenant PKs in KVStore")
+	}
+	parsedTx, err := btcstaking.ParseV0StakingTx(stakingTx, tag, covenantPKs, params.CovenantQuorum, k.btcNet)
+	if err != nil {
+		return nil, types.ErrInvalidStakingTx.Wrapf("invalid identifiable staking tx: %v", err)
+	}
+
+	// ensure the OP_RETURN data matches the request
+	opReturnData := parsedTx.OpReturnData
+	stakerPK := bbn.NewBIP340PubKeyFromBTCPK(opReturnData.StakerPublicKey.PubKey)
+	if !stakerPK.Equals(req.BtcPk) {
+		return nil, types.ErrInvalidStakingTx.Wrapf("staker PK in OP_RETURN %s does not match the request %s",
+			stakerPK.MarshalHex(), req.BtcPk.MarshalHex())
+	}
+	fpPK := bbn.NewBIP340PubKeyFromBTCPK(opReturnData.FinalityProviderPublicKey.PubKey)
+	if len(req.FpBtcPkList) != 1 || !fpPK.Equals(&req.FpBtcPkList[0]) {
+		return nil, types.ErrInvalidStakingTx.Wrapf("identifiable staking tx has to restake to exactly the finality provider %s in OP_RETURN",
+			fpPK.MarshalHex())
+	}
+	if uint32(opReturnData.StakingTime) != req.StakingTime {
+		return nil, types.ErrInvalidStakingTx.Wrapf("staking time in OP_RETURN %d does not match the request %d",
+			opReturnData.StakingTime, req.StakingTime)
+	}
+	if uint32(parsedTx.StakingOutputIdx) != stakingOutputIdx {
+		return nil, types.ErrInvalidStakingTx.Wrapf("staking output index in identifiable staking tx %d does not match the expected %d",
+			parsedTx.StakingOutputIdx, stakingOutputIdx)
+	}
+
+	return &types.OpReturnInfo{
+		Tag:       opReturnData.Tag,
+		Version:   uint32(opReturnData.Version),
+		OutputIdx: uint32(parsedTx.OpReturnOutputIdx),
+	}, nil
+}
+
+// indexBTCDelegationByTag indexes the given BTC delegation under the OP_RETURN
+// tag of its staking tx, if the staking tx is an identifiable staking tx
+func (k Keeper) indexBTCDelegationByTag(ctx context.Context, btcDel *types.BTCDelegation) {
+	if btcDel.OpReturnInfo == nil {
+		return
+	}
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	store := k.btcDelegationByTagStore(ctx, btcDel.OpReturnInfo.Tag)
+	store.Set(stakingTxHash[:], stakingTxHash[:])
+}
+
+// btcDelegationByTagStore returns the KVStore of the index of BTC delegations
+// under the given OP_RETURN tag
+// prefix: BTCDelegationByTagKey || tag
+// key: BTC delegation's staking tx hash
+// value: BTC delegation's staking tx hash
+func (k Keeper) btcDelegationByTagStore(ctx context.Context, tag []byte) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(storeAdapter, types.BTCDelegationByTagKey)
+	if len(tag) != btcstaking.TagLen {
+		// tags are validated before being indexed, thus this is a programming error
+		panic(fmt.Errorf("invalid OP_RETURN tag length %d", len(tag)))
+	}
+	return prefix.NewStore(indexStore, tag)
+}
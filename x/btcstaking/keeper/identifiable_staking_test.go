@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/btcstaking"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzIdentifiableStakingTx(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters, with a random staking tx tag
+		h.GenAndApplyParams(r)
+		tag := datagen.GenRandomByteArray(r, btcstaking.TagLen)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		bsParams.StakingTxTag = hex.EncodeToString(tag)
+		err := h.BTCStakingKeeper.SetParams(h.Ctx, bsParams)
+		require.NoError(t, err)
+		otherTag := make([]byte, btcstaking.TagLen)
+		copy(otherTag, tag)
+		otherTag[0] ^= 0xff
+
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+		stakingValue := int64(datagen.RandomInt(r, 100000) + 100000)
+		stakingTime := uint16(1000)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+
+		// Case 1: the OP_RETURN data commits to another staker
+		otherSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		opReturnData, err := btcstaking.NewV0OpReturnDataFromParsed(tag, otherSK.PubKey(), fpPK, stakingTime)
+		require.NoError(t, err)
+		_, _, err = h.CreateIdentifiableDelegationCustom(r, delSK, fpPK, stakingValue, stakingTime, opReturnData)
+		require.ErrorIs(t, err, types.ErrInvalidStakingTx)
+
+		// Case 2: the OP_RETURN data commits to another staking time
+		opReturnData, err = btcstaking.NewV0OpReturnDataFromParsed(tag, delSK.PubKey(), fpPK, stakingTime+1)
+		require.NoError(t, err)
+		_, _, err = h.CreateIdentifiableDelegationCustom(r, delSK, fpPK, stakingValue, stakingTime, opReturnData)
+		require.ErrorIs(t, err, types.ErrInvalidStakingTx)
+
+		// Case 3: a staking tx carrying another tag is not identifiable, thus
+		// the BTC delegation is accepted but not indexed
+		opReturnData, err = btcstaking.NewV0OpReturnDataFromParsed(otherTag, delSK.PubKey(), fpPK, stakingTime)
+		require.NoError(t, err)
+		stakingTxHash, _, err := h.CreateIdentifiableDelegationCustom(r, delSK, fpPK, stakingValue, stakingTime, opReturnData)
+		require.NoError(t, err)
+		btcDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		require.NoError(t, err)
+		require.Nil(t, btcDel.OpReturnInfo)
+
+		// Case 4: an identifiable staking tx matching the request is accepted
+		// and indexed under its tag
+		opReturnData, err = btcstaking.NewV0OpReturnDataFromParsed(tag, delSK.PubKey(), fpPK, stakingTime)
+		require.NoError(t, err)
+		stakingTxHash, _, err = h.CreateIdentifiableDelegationCustom(r, delSK, fpPK, stakingValue, stakingTime, opReturnData)
+		require.NoError(t, err)
+		btcDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		require.NoError(t, err)
+		require.NotNil(t, btcDel.OpReturnInfo)
+		require.Equal(t, tag, btcDel.OpReturnInfo.Tag)
+		require.Zero(t, btcDel.OpReturnInfo.Version)
+		require.Equal(t, uint32(1), btcDel.OpReturnInfo.OutputIdx)
+
+		resp, err := h.BTCStakingKeeper.BTCDelegationsByTag(h.Ctx, &types.QueryBTCDelegationsByTagRequest{
+			TagHex: hex.EncodeToString(tag),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.BtcDelegations, 1)
+		require.Equal(t, hex.EncodeToString(btcDel.StakingTx), resp.BtcDelegations[0].StakingTxHex)
+		require.Equal(t, hex.EncodeToString(tag), resp.BtcDelegations[0].OpReturnTagHex)
+
+		resp, err = h.BTCStakingKeeper.BTCDelegationsByTag(h.Ctx, &types.QueryBTCDelegationsByTagRequest{
+			TagHex: hex.EncodeToString(otherTag),
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.BtcDelegations)
+
+		_, err = h.BTCStakingKeeper.BTCDelegationsByTag(h.Ctx, &types.QueryBTCDelegationsByTagRequest{
+			TagHex: hex.EncodeToString(tag[1:]),
+		})
+		require.Error(t, err)
+
+		// Case 5: identifiable staking txs cannot restake to multiple finality
+		// providers, which is caught as the OP_RETURN data only commits to one
+		_, fpPK2, _ := h.CreateFinalityProvider(r)
+		opReturnData, err = btcstaking.NewV0OpReturnDataFromParsed(tag, delSK.PubKey(), fpPK2, stakingTime)
+		require.NoError(t, err)
+		_, _, err = h.CreateIdentifiableDelegationCustom(r, delSK, fpPK, stakingValue, stakingTime, opReturnData)
+		require.ErrorIs(t, err, types.ErrInvalidStakingTx)
+	})
+}
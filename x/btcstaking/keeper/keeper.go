@@ -21,6 +21,7 @@ type (
 		btclcKeeper types.BTCLightClientKeeper
 		btccKeeper  types.BtcCheckpointKeeper
 		ckptKeeper  types.CheckpointingKeeper
+		hooks       types.BTCStakingHooks
 
 		btcNet *chaincfg.Params
 		// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -47,12 +48,23 @@ func NewKeeper(
 		btclcKeeper: btclcKeeper,
 		btccKeeper:  btccKeeper,
 		ckptKeeper:  ckptKeeper,
+		hooks:       nil,
 
 		btcNet:    btcNet,
 		authority: authority,
 	}
 }
 
+// SetHooks sets the BTC staking hooks
+func (k *Keeper) SetHooks(bh types.BTCStakingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set btcstaking hooks twice")
+	}
+	k.hooks = bh
+
+	return k
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -1,11 +1,13 @@
 package keeper_test
 
 import (
+	"math"
 	"math/rand"
 	"testing"
 
 	"cosmossdk.io/core/header"
 	sdkmath "cosmossdk.io/math"
+	"github.com/babylonchain/babylon/btcstaking"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	keepertest "github.com/babylonchain/babylon/testutil/keeper"
 	bbn "github.com/babylonchain/babylon/types"
@@ -102,6 +104,10 @@ func (h *Helper) GenAndApplyCustomParams(
 		MaxActiveFinalityProviders: 100,
 		MinUnbondingTime:           minUnbondingTime,
 		MinUnbondingRate:           sdkmath.LegacyMustNewDecFromStr("0.8"),
+		MinStakingValueSat:         10000,
+		MaxStakingValueSat:         10 * 10e8,
+		MinStakingTimeBlocks:       10,
+		MaxStakingTimeBlocks:       math.MaxUint16,
 	})
 	h.NoError(err)
 	return covenantSKs, covenantPKs
@@ -149,7 +155,7 @@ func (h *Helper) CreateDelegationCustom(
 	unbondingValue int64,
 	unbondingTime uint16,
 ) (string, *btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation, error) {
-	delSK, delPK, err := datagen.GenRandomBTCKeyPair(r)
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
 	h.NoError(err)
 	stakingTimeBlocks := stakingTime
 	bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
@@ -171,6 +177,62 @@ func (h *Helper) CreateDelegationCustom(
 		unbondingTime,
 	)
 	h.NoError(err)
+
+	return h.createDelegationWithStakingInfo(r, delSK, fpPK, testStakingInfo, stakingValue, stakingTime, unbondingValue, unbondingTime)
+}
+
+// CreateIdentifiableDelegationCustom creates a BTC delegation of the given
+// staker whose staking tx carries the given V0 OP_RETURN data
+func (h *Helper) CreateIdentifiableDelegationCustom(
+	r *rand.Rand,
+	delSK *btcec.PrivateKey,
+	fpPK *btcec.PublicKey,
+	stakingValue int64,
+	stakingTime uint16,
+	opReturnData *btcstaking.V0OpReturnData,
+) (string, *types.MsgCreateBTCDelegation, error) {
+	bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+	bcParams := h.BTCCheckpointKeeper.GetParams(h.Ctx)
+	covPKs, err := bbn.NewBTCPKsFromBIP340PKs(bsParams.CovenantPks)
+	h.NoError(err)
+	unbondingTime := uint16(types.MinimumUnbondingTime(bsParams, bcParams)) + 1
+
+	testStakingInfo := datagen.GenBTCStakingSlashingInfoWithOpReturn(
+		r,
+		h.t,
+		h.Net,
+		delSK,
+		[]*btcec.PublicKey{fpPK},
+		covPKs,
+		bsParams.CovenantQuorum,
+		stakingTime,
+		stakingValue,
+		bsParams.SlashingAddress,
+		bsParams.SlashingRate,
+		unbondingTime,
+		opReturnData,
+	)
+
+	stakingTxHash, _, _, msg, err := h.createDelegationWithStakingInfo(
+		r, delSK, fpPK, testStakingInfo, stakingValue, stakingTime, stakingValue-1000, unbondingTime,
+	)
+	return stakingTxHash, msg, err
+}
+
+func (h *Helper) createDelegationWithStakingInfo(
+	r *rand.Rand,
+	delSK *btcec.PrivateKey,
+	fpPK *btcec.PublicKey,
+	testStakingInfo *datagen.TestStakingSlashingInfo,
+	stakingValue int64,
+	stakingTimeBlocks uint16,
+	unbondingValue int64,
+	unbondingTime uint16,
+) (string, *btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation, error) {
+	delPK := delSK.PubKey()
+	bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+	covPKs, err := bbn.NewBTCPKsFromBIP340PKs(bsParams.CovenantPks)
+	h.NoError(err)
 	stakingTxHash := testStakingInfo.StakingTx.TxHash().String()
 
 	// random signer
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/btcstaking params from consensus version 1 to 2.
+// Version 1 params did not bound the staking value and staking time, so these
+// bounds decode as 0 in all stored params versions, and every new BTC
+// delegation would be rejected. The bounds are set to their defaults in the
+// latest params version in place, against which new BTC delegations are
+// created. The staking cap and the staking tx tag keep their zero values, i.e.,
+// no staking cap and no identifiable staking txs, as in version 1.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	sp := m.keeper.getLastParams(ctx)
+	if sp == nil {
+		return nil
+	}
+	defaults := types.DefaultParams()
+	if sp.Params.MinStakingValueSat == 0 {
+		sp.Params.MinStakingValueSat = defaults.MinStakingValueSat
+	}
+	if sp.Params.MaxStakingValueSat == 0 {
+		sp.Params.MaxStakingValueSat = defaults.MaxStakingValueSat
+	}
+	if sp.Params.MinStakingTimeBlocks == 0 {
+		sp.Params.MinStakingTimeBlocks = defaults.MinStakingTimeBlocks
+	}
+	if sp.Params.MaxStakingTimeBlocks == 0 {
+		sp.Params.MaxStakingTimeBlocks = defaults.MaxStakingTimeBlocks
+	}
+	if err := sp.Params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.paramsStore(ctx).Set(uint32ToBytes(sp.Version), m.keeper.cdc.MustMarshal(sp))
+	return nil
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/btcstaking/keeper"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+	// params version 1 as stored by consensus version 1, i.e., without the
+	// bounds of staking value and staking time
+	oldParams := types.DefaultParams()
+	oldParams.MinSlashingTxFeeSat = 23400
+	oldParams.MinStakingValueSat = 0
+	oldParams.MaxStakingValueSat = 0
+	oldParams.MinStakingTimeBlocks = 0
+	oldParams.MaxStakingTimeBlocks = 0
+	oldParams.StakingCapSat = 0
+	oldParams.StakingTxTag = ""
+	sp := types.StoredParams{Version: 1, Params: oldParams}
+	bz, err := sp.Marshal()
+	require.NoError(t, err)
+	storeKey := ctx.MultiStore().(*rootmulti.Store).StoreKeysByName()[types.StoreKey]
+	key := binary.BigEndian.AppendUint32(append([]byte{}, types.ParamsKey...), 1)
+	ctx.KVStore(storeKey).Set(key, bz)
+	require.Error(t, k.GetParams(ctx).Validate())
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+
+	// the bounds are set in place in the latest params version
+	pv := k.GetParamsWithVersion(ctx)
+	require.Equal(t, uint32(1), pv.Version)
+	require.NoError(t, pv.Params.Validate())
+	defaults := types.DefaultParams()
+	require.Equal(t, int64(23400), pv.Params.MinSlashingTxFeeSat)
+	require.Equal(t, defaults.MinStakingValueSat, pv.Params.MinStakingValueSat)
+	require.Equal(t, defaults.MaxStakingValueSat, pv.Params.MaxStakingValueSat)
+	require.Equal(t, defaults.MinStakingTimeBlocks, pv.Params.MinStakingTimeBlocks)
+	require.Equal(t, defaults.MaxStakingTimeBlocks, pv.Params.MaxStakingTimeBlocks)
+	require.Zero(t, pv.Params.StakingCapSat)
+	require.Empty(t, pv.Params.StakingTxTag)
+}
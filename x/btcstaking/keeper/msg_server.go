@@ -190,6 +190,36 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 	// - is smaller than math.MaxUint16 (due to check in req.ValidateBasic())
 	validatedUnbondingTime := uint16(req.UnbondingTime)
 
+	// Check staking time and staking value are within the bounds of the
+	// params version that the delegation is created against
+	if req.StakingTime < vp.Params.MinStakingTimeBlocks || req.StakingTime > vp.Params.MaxStakingTimeBlocks {
+		return nil, types.ErrInvalidStakingTx.Wrapf(
+			"staking time %d is out of bounds. Min: %d, Max: %d",
+			req.StakingTime,
+			vp.Params.MinStakingTimeBlocks,
+			vp.Params.MaxStakingTimeBlocks,
+		)
+	}
+	if req.StakingValue < vp.Params.MinStakingValueSat || req.StakingValue > vp.Params.MaxStakingValueSat {
+		return nil, types.ErrInvalidStakingTx.Wrapf(
+			"staking value %d is out of bounds. Min: %d, Max: %d",
+			req.StakingValue,
+			vp.Params.MinStakingValueSat,
+			vp.Params.MaxStakingValueSat,
+		)
+	}
+
+	// Check the BTC delegation does not exceed the staking cap given the current
+	// total active stake. The staking cap is checked again upon activation as
+	// the total active stake might change in between
+	if exceedsStakingCap(vp.Params.StakingCapSat, ms.GetTotalActiveStake(ctx), uint64(req.StakingValue)) {
+		return nil, types.ErrStakingCapExceeded.Wrapf(
+			"staking value %d exceeds the remaining staking cap %d",
+			req.StakingValue,
+			GetRemainingStakingCap(vp.Params.StakingCapSat, ms.GetTotalActiveStake(ctx)),
+		)
+	}
+
 	stakerAddr, err := sdk.AccAddressFromBech32(req.StakerAddr)
 	if err != nil {
 		return nil, types.ErrInvalidStakingTx.Wrapf("invalid staker addr %s: %v", req.StakerAddr, err)
@@ -257,6 +287,13 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		return nil, types.ErrInvalidStakingTx.Wrap("staking tx does not contain expected staking output")
 	}
 
+	// if the staking tx is an identifiable staking tx, ensure the request
+	// matches the data committed in its OP_RETURN output
+	opReturnInfo, err := ms.parseIdentifiableStakingTx(stakingMsgTx, stakingOutputIdx, req, &vp.Params)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check staking tx timelock has correct values
 	// get startheight and endheight of the timelock
 	stakingTxHeader := ms.btclcKeeper.GetHeaderByHash(ctx, req.StakingTx.Key.Hash)
@@ -346,6 +383,7 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		CovenantSigs:     nil,        // NOTE: covenant signature will be submitted in a separate msg by covenant
 		BtcUndelegation:  nil,        // this will be constructed in below code
 		ParamsVersion:    vp.Version, // version of the params against delegations was validated
+		OpReturnInfo:     opReturnInfo,
 	}
 
 	/*
@@ -638,10 +676,12 @@ func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndele
 		return nil, err
 	}
 
-	// ensure the BTC delegation with the given staking tx hash is active
+	// ensure the BTC delegation with the given staking tx hash is active, or
+	// overflowed such that the staker can exit an overflowed BTC delegation
 	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
 	wValue := ms.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
-	if btcDel.GetStatus(btcTip.Height, wValue, bsParams.CovenantQuorum) != types.BTCDelegationStatus_ACTIVE {
+	btcDelStatus := btcDel.GetStatus(btcTip.Height, wValue, bsParams.CovenantQuorum)
+	if btcDelStatus != types.BTCDelegationStatus_ACTIVE && btcDelStatus != types.BTCDelegationStatus_OVERFLOW {
 		return nil, types.ErrInvalidBTCUndelegateReq.Wrap("cannot unbond an inactive BTC delegation")
 	}
 
@@ -564,7 +564,7 @@ func TestDoNotAllowDelegationWithoutFinalityProvider(t *testing.T) {
 	*/
 	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
 	require.NoError(t, err)
-	stakingTimeBlocks := uint16(5)
+	stakingTimeBlocks := uint16(bsParams.MinStakingTimeBlocks)
 	stakingValue := int64(2 * 10e8)
 	testStakingInfo := datagen.GenBTCStakingSlashingInfo(
 		r,
@@ -816,6 +816,98 @@ func TestMinimalUnbondingRate(t *testing.T) {
 	}
 }
 
+func TestStakingBoundsInDelegation(t *testing.T) {
+	tests := []struct {
+		name         string
+		stakingValue int64
+		stakingTime  uint16
+		err          error
+	}{
+		{
+			name:         "successful delegation when staking value and staking time are within bounds",
+			stakingValue: 20000,
+			stakingTime:  1000,
+			err:          nil,
+		},
+		{
+			name:         "failed delegation when staking value is lower than min staking value",
+			stakingValue: 9999,
+			stakingTime:  1000,
+			err:          types.ErrInvalidStakingTx,
+		},
+		{
+			name:         "failed delegation when staking value is larger than max staking value",
+			stakingValue: 100001,
+			stakingTime:  1000,
+			err:          types.ErrInvalidStakingTx,
+		},
+		{
+			name:         "failed delegation when staking time is lower than min staking time",
+			stakingValue: 20000,
+			stakingTime:  499,
+			err:          types.ErrInvalidStakingTx,
+		},
+		{
+			name:         "failed delegation when staking time is larger than max staking time",
+			stakingValue: 20000,
+			stakingTime:  5001,
+			err:          types.ErrInvalidStakingTx,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(time.Now().Unix()))
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// mock BTC light client and BTC checkpoint modules
+			btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+			btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+			ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+			h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+			// set all parameters, and tighten the staking value and staking time bounds
+			_, _ = h.GenAndApplyParams(r)
+			bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+			bsParams.MinStakingValueSat = 10000
+			bsParams.MaxStakingValueSat = 100000
+			bsParams.MinStakingTimeBlocks = 500
+			bsParams.MaxStakingTimeBlocks = 5000
+			err := h.BTCStakingKeeper.SetParams(h.Ctx, bsParams)
+			require.NoError(t, err)
+
+			changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+			require.NoError(t, err)
+
+			// generate and insert new finality provider
+			_, fpPK, _ := h.CreateFinalityProvider(r)
+
+			// generate and insert new BTC delegation
+			stakingTxHash, _, _, _, err := h.CreateDelegationCustom(
+				r,
+				fpPK,
+				changeAddress.EncodeAddress(),
+				tt.stakingValue,
+				tt.stakingTime,
+				tt.stakingValue-1000,
+				1000,
+			)
+			if tt.err != nil {
+				require.Error(t, err)
+				require.True(t, errors.Is(err, tt.err))
+			} else {
+				require.NoError(t, err)
+				// Retrieve delegation from keeper
+				delegation, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+				require.NoError(t, err)
+				require.Equal(t, tt.stakingTime, delegation.GetStakingTime())
+				require.Equal(t, uint64(tt.stakingValue), delegation.TotalSat)
+			}
+		})
+	}
+}
+
 func createNDelegationsForFinalityProvider(
 	r *rand.Rand,
 	t *testing.T,
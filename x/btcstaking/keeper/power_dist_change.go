@@ -97,6 +97,13 @@ func (k Keeper) recordMetrics(dc *types.VotingPowerDistCache, maxActiveFps uint3
 // - newly active BTC delegations
 // - newly unbonded BTC delegations
 // - slashed finality providers
+// - jailed and unjailed finality providers
+// Newly active BTC delegations that would exceed the staking cap, after
+// releasing the stake of BTC delegations unbonded upon the same events, are
+// marked as overflowed and never gain voting power. BTC delegations gaining or losing
+// voting power under a finality provider, including the BTC delegations of
+// slashed finality providers, are notified via the hooks. The total active stake is
+// updated w.r.t. the new distribution cache.
 func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	ctx context.Context,
 	dc *types.VotingPowerDistCache,
@@ -110,6 +117,19 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	unbondedBTCDels := map[string]struct{}{}
 	// a map where key is slashed finality providers' BTC PK
 	slashedFPs := map[string]struct{}{}
+	// a map where key is jailed/unjailed finality providers' BTC PK and value
+	// is whether the finality provider is jailed after applying all events
+	jailedFPs := map[string]bool{}
+	// the staking cap and the total active stake, which is used for deciding
+	// whether newly active BTC delegations exceed the staking cap. The stake
+	// of BTC delegations unbonded upon these events is released beforehand
+	stakingCap := k.GetParams(ctx).StakingCapSat
+	totalActiveStake := k.GetTotalActiveStake(ctx)
+	if unbondedStake := getUnbondedActiveStake(dc, events); unbondedStake < totalActiveStake {
+		totalActiveStake -= unbondedStake
+	} else {
+		totalActiveStake = 0
+	}
 
 	/*
 		filter and classify all events into new/expired BTC delegations and slashed FPs
@@ -124,6 +144,13 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 				if err != nil {
 					panic(err) // only programming error
 				}
+				// the BTC delegation never gains voting power if it exceeds
+				// the staking cap
+				if exceedsStakingCap(stakingCap, totalActiveStake, btcDel.TotalSat) {
+					k.overflowBTCDelegation(ctx, btcDel)
+					continue
+				}
+				totalActiveStake += btcDel.TotalSat
 				// add the BTC delegation to each restaked finality provider
 				for _, fpBTCPK := range btcDel.FpBtcPkList {
 					fpBTCPKHex := fpBTCPK.MarshalHex()
@@ -136,6 +163,12 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 		case *types.EventPowerDistUpdate_SlashedFp:
 			// slashed finality providers
 			slashedFPs[typedEvent.SlashedFp.Pk.MarshalHex()] = struct{}{}
+		case *types.EventPowerDistUpdate_JailedFp:
+			// jailed finality providers
+			jailedFPs[typedEvent.JailedFp.Pk.MarshalHex()] = true
+		case *types.EventPowerDistUpdate_UnjailedFp:
+			// unjailed finality providers
+			jailedFPs[typedEvent.UnjailedFp.Pk.MarshalHex()] = false
 		}
 	}
 
@@ -159,16 +192,29 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 
 		fpBTCPKHex := fp.BtcPk.MarshalHex()
 
-		// if this finality provider is slashed, continue to avoid recording it
+		// if this finality provider is slashed, its BTC delegations lose their
+		// voting power under it, and continue to avoid recording it
 		if _, ok := slashedFPs[fpBTCPKHex]; ok {
+			for _, btcDel := range dc.FinalityProviders[i].BtcDels {
+				k.AfterBTCDelegationUnbonded(ctx, fp.BtcPk, btcDel)
+			}
 			continue
 		}
 
+		// apply jailing/unjailing of this finality provider, if any. A jailed
+		// finality provider remains in the cache, but is excluded from the
+		// active finality provider set
+		if jailed, ok := jailedFPs[fpBTCPKHex]; ok {
+			fp.IsJailed = jailed
+		}
+
 		// add all BTC delegations that are not unbonded to the new finality provider
 		for j := range dc.FinalityProviders[i].BtcDels {
 			btcDel := *dc.FinalityProviders[i].BtcDels[j]
 			if _, ok := unbondedBTCDels[btcDel.StakingTxHash]; !ok {
 				fp.AddBTCDelDistInfo(&btcDel)
+			} else {
+				k.AfterBTCDelegationUnbonded(ctx, fp.BtcPk, &btcDel)
 			}
 		}
 
@@ -177,6 +223,7 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 			// handle new BTC delegations for this finality provider
 			for _, d := range fpActiveBTCDels {
 				fp.AddBTCDel(d)
+				k.AfterBTCDelegationActivated(ctx, fp.BtcPk, types.NewBTCDelDistInfo(d))
 			}
 			// remove the finality provider entry in activeBTCDels map, so that
 			// after the for loop the rest entries in activeBTCDels belongs to new
@@ -212,12 +259,17 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 		if err != nil {
 			panic(err) // only programming error
 		}
+		// BTC delegations never gain voting power under a slashed finality provider
+		if _, ok := slashedFPs[fpBTCPKHex]; ok || newFP.IsSlashed() {
+			continue
+		}
 		fpDistInfo := types.NewFinalityProviderDistInfo(newFP)
 
 		// add each BTC delegation
 		fpActiveBTCDels := activeBTCDels[fpBTCPKHex]
 		for _, d := range fpActiveBTCDels {
 			fpDistInfo.AddBTCDel(d)
+			k.AfterBTCDelegationActivated(ctx, fpDistInfo.BtcPk, types.NewBTCDelDistInfo(d))
 		}
 
 		// add this finality provider to the new cache if it has voting power
@@ -230,6 +282,9 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	// record them in the new cache
 	newDc.ApplyActiveFinalityProviders(maxActiveFps)
 
+	// record the total active stake w.r.t. the new distribution cache
+	k.setTotalActiveStake(ctx, newDc.GetTotalStakeSat())
+
 	return newDc
 }
 
@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
 	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -77,6 +79,8 @@ func FuzzFinalityProviderEvents(f *testing.F) {
 		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
 		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
 		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+		hooks := newStakeRecordingHooks()
+		h.BTCStakingKeeper.SetHooks(hooks)
 
 		// set all parameters
 		covenantSKs, _ := h.GenAndApplyParams(r)
@@ -114,6 +118,16 @@ func FuzzFinalityProviderEvents(f *testing.F) {
 		h.NoError(err)
 		// ensure the finality provider has voting power at this height
 		require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		require.Equal(t, uint64(stakingValue), hooks.stake(fp.BtcPk, actualDel.StakerAddr))
+
+		// insert another BTC delegation, which is not active yet
+		_, _, _, _, pendingDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
 
 		/*
 			Slash the finality provider and execute BeginBlock
@@ -139,6 +153,20 @@ func FuzzFinalityProviderEvents(f *testing.F) {
 		h.NoError(err)
 		// ensure the finality provider does not have voting power anymore
 		require.Zero(t, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		// ensure the hooks are notified that its BTC delegations lost their stake
+		require.Zero(t, hooks.stake(fp.BtcPk, actualDel.StakerAddr))
+
+		/*
+			a BTC delegation becoming active under the slashed finality
+			provider never gains voting power, and the hooks are not notified
+		*/
+		event := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
+			StakingTxHash: pendingDel.MustGetStakingTxHash().String(),
+			NewState:      types.BTCDelegationStatus_ACTIVE,
+		})
+		newDc := h.BTCStakingKeeper.ProcessAllPowerDistUpdateEvents(h.Ctx, types.NewVotingPowerDistCache(), []*types.EventPowerDistUpdate{event}, 100)
+		require.Empty(t, newDc.FinalityProviders)
+		require.Zero(t, hooks.stake(fp.BtcPk, pendingDel.StakerAddr))
 	})
 }
 
@@ -155,6 +183,8 @@ func FuzzBTCDelegationEvents(f *testing.F) {
 		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
 		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
 		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+		hooks := newStakeRecordingHooks()
+		h.BTCStakingKeeper.SetHooks(hooks)
 
 		// set all parameters
 		covenantSKs, _ := h.GenAndApplyParams(r)
@@ -224,6 +254,8 @@ func FuzzBTCDelegationEvents(f *testing.F) {
 		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
 		h.NoError(err)
 		require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		// ensure the hooks are notified about the new stake
+		require.Equal(t, uint64(stakingValue), hooks.stake(fp.BtcPk, actualDel.StakerAddr))
 
 		// ensure event queue is cleared at BTC tip height
 		events = h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTip.Height, btcTip.Height)
@@ -239,9 +271,34 @@ func FuzzBTCDelegationEvents(f *testing.F) {
 		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
 		h.NoError(err)
 		require.Zero(t, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		require.Zero(t, hooks.stake(fp.BtcPk, actualDel.StakerAddr))
 
 		// ensure the unbonded event is processed and cleared
 		events = h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, unbondedHeight, unbondedHeight)
 		require.Len(t, events, 0)
 	})
 }
+
+// stakeRecordingHooks records the stake of each BTC delegator under each
+// finality provider notified via the BTC staking hooks
+type stakeRecordingHooks struct {
+	stakes map[string]uint64
+}
+
+var _ types.BTCStakingHooks = &stakeRecordingHooks{}
+
+func newStakeRecordingHooks() *stakeRecordingHooks {
+	return &stakeRecordingHooks{stakes: map[string]uint64{}}
+}
+
+func (h *stakeRecordingHooks) AfterBTCDelegationActivated(_ context.Context, fpBTCPK *bbn.BIP340PubKey, btcDel *types.BTCDelDistInfo) {
+	h.stakes[fpBTCPK.MarshalHex()+btcDel.StakerAddr] += btcDel.VotingPower
+}
+
+func (h *stakeRecordingHooks) AfterBTCDelegationUnbonded(_ context.Context, fpBTCPK *bbn.BIP340PubKey, btcDel *types.BTCDelDistInfo) {
+	h.stakes[fpBTCPK.MarshalHex()+btcDel.StakerAddr] -= btcDel.VotingPower
+}
+
+func (h *stakeRecordingHooks) stake(fpBTCPK *bbn.BIP340PubKey, stakerAddr string) uint64 {
+	return h.stakes[fpBTCPK.MarshalHex()+stakerAddr]
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PruneVotingPowerAtHeight removes the voting power table and the voting power
+// distribution cache at the given height. Heights have to be pruned in
+// increasing order. The caller is responsible for ensuring that the given
+// height is no longer needed, i.e., it is finalised or non-finalisable.
+func (k Keeper) PruneVotingPowerAtHeight(ctx context.Context, height uint64) {
+	// the activated height is inferred from the earliest voting power table,
+	// so persist it before the first voting power table gets pruned
+	if !k.hasActivatedHeight(ctx) {
+		if activatedHeight, err := k.GetBTCStakingActivatedHeight(ctx); err == nil {
+			k.setActivatedHeight(ctx, activatedHeight)
+		}
+	}
+
+	// remove all entries of the voting power table at this height
+	store := k.votingPowerBbnBlockHeightStore(ctx, height)
+	keys := [][]byte{}
+	// using an enclosure to ensure iterator is closed right after
+	// the function is done
+	func() {
+		iter := store.Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+	}()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	// remove the voting power distribution cache, which remains if this height
+	// has not been finalised
+	k.RemoveVotingPowerDistCache(ctx, height)
+
+	if height+1 > k.getNextHeightToPrune(ctx) {
+		k.setNextHeightToPrune(ctx, height+1)
+	}
+}
+
+// IsVotingPowerPruned returns whether the voting power table at the given
+// height has been pruned
+func (k Keeper) IsVotingPowerPruned(ctx context.Context, height uint64) bool {
+	return height < k.getNextHeightToPrune(ctx)
+}
+
+// setNextHeightToPrune sets the next height whose voting power table is to be pruned
+func (k Keeper) setNextHeightToPrune(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.NextHeightToPruneKey, sdk.Uint64ToBigEndian(height)); err != nil {
+		panic(err)
+	}
+}
+
+// getNextHeightToPrune gets the next height whose voting power table is to be pruned
+func (k Keeper) getNextHeightToPrune(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.NextHeightToPruneKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setActivatedHeight(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.ActivatedHeightKey, sdk.Uint64ToBigEndian(height)); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) hasActivatedHeight(ctx context.Context) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.ActivatedHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// getActivatedHeight returns the persisted activated height, if any
+func (k Keeper) getActivatedHeight(ctx context.Context) (uint64, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ActivatedHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
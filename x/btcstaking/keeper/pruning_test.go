@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzPruneVotingPowerAtHeight(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		h.NoError(err)
+
+		// generate a finality provider with a BTC delegation
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+		stakingValue := datagen.RandomInt(r, 100000) + 100000
+		_, _, _, delMsg, del := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(stakingValue),
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, delMsg, del)
+
+		// record voting power tables for a number of heights
+		activatedHeight := datagen.RandomInt(r, 10) + 1
+		numHeights := datagen.RandomInt(r, 10) + 2
+		for i := uint64(0); i < numHeights; i++ {
+			h.SetCtxHeight(activatedHeight + i)
+			h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: 30}).AnyTimes()
+			err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+			require.NoError(t, err)
+		}
+
+		// prune a random prefix of heights
+		numPruned := datagen.RandomInt(r, int(numHeights-1)) + 1
+		for i := uint64(0); i < numPruned; i++ {
+			h.BTCStakingKeeper.PruneVotingPowerAtHeight(h.Ctx, activatedHeight+i)
+		}
+
+		for i := uint64(0); i < numHeights; i++ {
+			height := activatedHeight + i
+			if i < numPruned {
+				require.True(t, h.BTCStakingKeeper.IsVotingPowerPruned(h.Ctx, height))
+				require.Empty(t, h.BTCStakingKeeper.GetVotingPowerTable(h.Ctx, height))
+				_, err := h.BTCStakingKeeper.FinalityProviderPowerAtHeight(h.Ctx, &types.QueryFinalityProviderPowerAtHeightRequest{
+					FpBtcPkHex: fp.BtcPk.MarshalHex(),
+					Height:     height,
+				})
+				require.ErrorIs(t, err, types.ErrVotingPowerTablePruned)
+			} else {
+				require.False(t, h.BTCStakingKeeper.IsVotingPowerPruned(h.Ctx, height))
+				require.Equal(t, stakingValue, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, height))
+			}
+		}
+
+		// the activated height remains the same after pruning
+		gotActivatedHeight, err := h.BTCStakingKeeper.GetBTCStakingActivatedHeight(h.Ctx)
+		require.NoError(t, err)
+		require.Equal(t, activatedHeight, gotActivatedHeight)
+	})
+}
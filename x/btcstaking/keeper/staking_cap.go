@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetTotalActiveStake returns the total amount of satoshis that are actively
+// staked, i.e., in BTC delegations that are included in the voting power
+// distribution cache of the last processed height
+func (k Keeper) GetTotalActiveStake(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.TotalActiveStakeKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setTotalActiveStake sets the total amount of satoshis that are actively staked
+func (k Keeper) setTotalActiveStake(ctx context.Context, totalActiveStake uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.TotalActiveStakeKey, sdk.Uint64ToBigEndian(totalActiveStake)); err != nil {
+		panic(err)
+	}
+}
+
+// GetRemainingStakingCap returns the amount of satoshis that can still be
+// staked before reaching the given staking cap. It returns 0 if there is no
+// staking cap or the staking cap is already reached.
+func GetRemainingStakingCap(stakingCap uint64, totalActiveStake uint64) uint64 {
+	if stakingCap == 0 || totalActiveStake >= stakingCap {
+		return 0
+	}
+	return stakingCap - totalActiveStake
+}
+
+// exceedsStakingCap returns whether staking the given amount of satoshis on
+// top of the given total active stake exceeds the given staking cap. A staking
+// cap of 0 means there is no staking cap.
+func exceedsStakingCap(stakingCap uint64, totalActiveStake uint64, stakingValue uint64) bool {
+	return stakingCap > 0 && totalActiveStake+stakingValue > stakingCap
+}
+
+// overflowBTCDelegation marks the given BTC delegation as overflowed, such that
+// it never gains voting power, and notifies subscribers about it
+func (k Keeper) overflowBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
+	btcDel.Overflow = true
+	k.setBTCDelegation(ctx, btcDel)
+
+	event := &types.EventBTCDelegationStateUpdate{
+		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+		NewState:      types.BTCDelegationStatus_OVERFLOW,
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the overflowed BTC delegation: %w", err))
+	}
+}
+
+// getUnbondedActiveStake returns the amount of satoshis of the BTC delegations
+// that are active in the given distribution cache and become unbonded upon the
+// given events. Each BTC delegation is counted once, even if it is restaked to
+// multiple finality providers or has multiple unbonded events.
+func getUnbondedActiveStake(dc *types.VotingPowerDistCache, events []*types.EventPowerDistUpdate) uint64 {
+	unbondedBTCDels := map[string]struct{}{}
+	for _, event := range events {
+		delEvent := event.GetBtcDelStateUpdate()
+		if delEvent != nil && delEvent.NewState == types.BTCDelegationStatus_UNBONDED {
+			unbondedBTCDels[delEvent.StakingTxHash] = struct{}{}
+		}
+	}
+
+	unbondedStake := uint64(0)
+	for _, fp := range dc.FinalityProviders {
+		for _, btcDel := range fp.BtcDels {
+			if _, ok := unbondedBTCDels[btcDel.StakingTxHash]; !ok {
+				continue
+			}
+			// remove the BTC delegation so that it is counted only once
+			delete(unbondedBTCDels, btcDel.StakingTxHash)
+			unbondedStake += btcDel.VotingPower
+		}
+	}
+	return unbondedStake
+}
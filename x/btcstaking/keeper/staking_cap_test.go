@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzStakingCap(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters, with a staking cap that allows for only one of
+		// the BTC delegations below
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		stakingValue := datagen.RandomInt(r, 100000) + 100000
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		bsParams.StakingCapSat = stakingValue + stakingValue/2 + datagen.RandomInt(r, int(stakingValue/4))
+		err := h.BTCStakingKeeper.SetParams(h.Ctx, bsParams)
+		require.NoError(t, err)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate a finality provider with two BTC delegations, both of which
+		// fit in the staking cap upon creation
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+		stakingTxHash1, _, _, delMsg1, del1 := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(stakingValue),
+			1000,
+		)
+		stakingTxHash2, _, _, delMsg2, del2 := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(stakingValue),
+			1000,
+		)
+
+		// activate both BTC delegations
+		h.CreateCovenantSigs(r, covenantSKs, delMsg1, del1)
+		h.CreateCovenantSigs(r, covenantSKs, delMsg2, del2)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		require.NoError(t, err)
+
+		// only the first BTC delegation gains voting power, while the second
+		// one exceeds the staking cap upon activation and overflows
+		require.Equal(t, stakingValue, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		require.Equal(t, stakingValue, h.BTCStakingKeeper.GetTotalActiveStake(h.Ctx))
+		resp1, err := h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash1})
+		require.NoError(t, err)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE.String(), resp1.BtcDelegation.StatusDesc)
+		resp2, err := h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash2})
+		require.NoError(t, err)
+		require.Equal(t, types.BTCDelegationStatus_OVERFLOW.String(), resp2.BtcDelegation.StatusDesc)
+
+		// the remaining staking cap is reported
+		capResp, err := h.BTCStakingKeeper.StakingCap(h.Ctx, &types.QueryStakingCapRequest{})
+		require.NoError(t, err)
+		require.Equal(t, bsParams.StakingCapSat, capResp.StakingCapSat)
+		require.Equal(t, stakingValue, capResp.TotalActiveStakeSat)
+		require.Equal(t, bsParams.StakingCapSat-stakingValue, capResp.RemainingCapSat)
+
+		// a new BTC delegation exceeding the remaining staking cap is rejected
+		_, _, _, _, err = h.CreateDelegationCustom(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(capResp.RemainingCapSat+1),
+			1000,
+			int64(capResp.RemainingCapSat+1)-1000,
+			1000,
+		)
+		require.ErrorIs(t, err, types.ErrStakingCapExceeded)
+	})
+}
+
+func FuzzStakingCap_UnbondingAndActivation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters, with a staking cap that allows for only one of
+		// the BTC delegations below
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		stakingValue := datagen.RandomInt(r, 100000) + 100000
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		bsParams.StakingCapSat = stakingValue + stakingValue/2 + datagen.RandomInt(r, int(stakingValue/4))
+		err := h.BTCStakingKeeper.SetParams(h.Ctx, bsParams)
+		require.NoError(t, err)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate a finality provider with two BTC delegations, both of which
+		// fit in the staking cap upon creation
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+		stakingTxHash1, delSK1, _, delMsg1, del1 := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(stakingValue),
+			1000,
+		)
+		stakingTxHash2, _, _, delMsg2, del2 := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(stakingValue),
+			1000,
+		)
+
+		// activate the first BTC delegation, which takes up the staking cap
+		h.CreateCovenantSigs(r, covenantSKs, delMsg1, del1)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		require.NoError(t, err)
+		require.Equal(t, stakingValue, h.BTCStakingKeeper.GetTotalActiveStake(h.Ctx))
+
+		// unbond the first BTC delegation and activate the second one at the
+		// same BTC height, such that both events are processed together
+		del1, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash1)
+		require.NoError(t, err)
+		delUnbondingSig, err := del1.SignUnbondingTx(&bsParams, h.Net, delSK1)
+		require.NoError(t, err)
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+			Signer:         datagen.GenRandomAccount().Address,
+			StakingTxHash:  stakingTxHash1,
+			UnbondingTxSig: bbn.NewBIP340SignatureFromBTCSig(delUnbondingSig),
+		})
+		require.NoError(t, err)
+		h.CreateCovenantSigs(r, covenantSKs, delMsg2, del2)
+		babylonHeight++
+		h.SetCtxHeight(babylonHeight)
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		require.NoError(t, err)
+
+		// the stake released by the unbonded BTC delegation is taken up by the
+		// second one, which does not overflow
+		require.Equal(t, stakingValue, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		require.Equal(t, stakingValue, h.BTCStakingKeeper.GetTotalActiveStake(h.Ctx))
+		resp1, err := h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash1})
+		require.NoError(t, err)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED.String(), resp1.BtcDelegation.StatusDesc)
+		resp2, err := h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash2})
+		require.NoError(t, err)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE.String(), resp2.BtcDelegation.StatusDesc)
+	})
+}
@@ -127,6 +127,10 @@ func (k Keeper) GetVotingPowerTable(ctx context.Context, height uint64) map[stri
 // i.e., the first height where a finality provider has voting power
 // Before the BTC staking protocol is activated, we don't index or tally any block
 func (k Keeper) GetBTCStakingActivatedHeight(ctx context.Context) (uint64, error) {
+	// the activated height is persisted once the earliest voting power tables get pruned
+	if activatedHeight, ok := k.getActivatedHeight(ctx); ok {
+		return activatedHeight, nil
+	}
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	votingPowerStore := prefix.NewStore(storeAdapter, types.VotingPowerKey)
 	iter := votingPowerStore.Iterator(nil, nil)
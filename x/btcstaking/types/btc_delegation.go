@@ -26,10 +26,12 @@ func NewBTCDelegationStatusFromString(statusStr string) (BTCDelegationStatus, er
 		return BTCDelegationStatus_ACTIVE, nil
 	case "unbonded":
 		return BTCDelegationStatus_UNBONDED, nil
+	case "overflow":
+		return BTCDelegationStatus_OVERFLOW, nil
 	case "any":
 		return BTCDelegationStatus_ANY, nil
 	default:
-		return -1, fmt.Errorf("invalid status string; should be one of {pending, active, unbonding, unbonded, any}")
+		return -1, fmt.Errorf("invalid status string; should be one of {pending, active, unbonding, unbonded, overflow, any}")
 	}
 }
 
@@ -100,6 +102,12 @@ func (d *BTCDelegation) GetStatus(btcHeight uint64, w uint64, covenantQuorum uin
 
 	// at this point, BTC delegation has an active timelock, and Babylon is not
 	// aware of unbonding tx with delegator's signature
+	if d.Overflow {
+		// this BTC delegation exceeded the staking cap upon activation, thus
+		// never gains voting power
+		return BTCDelegationStatus_OVERFLOW
+	}
+
 	if d.HasCovenantQuorums(covenantQuorum) {
 		// this BTC delegation receives covenant quorums on
 		// {slashing/unbonding/unbondingslashing} txs, thus is active
@@ -165,6 +173,9 @@ func (d *BTCDelegation) ValidateBasic() error {
 	if d.DelegatorSig == nil {
 		return fmt.Errorf("empty delegator signature")
 	}
+	if d.OpReturnInfo != nil && len(d.OpReturnInfo.Tag) != btcstaking.TagLen {
+		return fmt.Errorf("OP_RETURN tag has to have exactly %d bytes", btcstaking.TagLen)
+	}
 
 	// ensure staking tx is correctly formatted
 	if _, err := bbn.NewBTCTxFromBytes(d.StakingTx); err != nil {
@@ -37,9 +37,14 @@ func (fp *FinalityProvider) ValidateBasic() error {
 }
 
 // SortFinalityProviders sorts the finality providers slice,
-// from higher to lower voting power
+// from higher to lower voting power, with jailed finality providers
+// placed at the end
 func SortFinalityProviders(fps []*FinalityProviderDistInfo) {
 	sort.SliceStable(fps, func(i, j int) bool {
+		// jailed finality providers are placed after non-jailed ones
+		if fps[i].IsJailed != fps[j].IsJailed {
+			return !fps[i].IsJailed
+		}
 		return fps[i].TotalVotingPower > fps[j].TotalVotingPower
 	})
 }
@@ -44,6 +44,9 @@ const (
 	BTCDelegationStatus_UNBONDED BTCDelegationStatus = 2
 	// ANY is any of the above status
 	BTCDelegationStatus_ANY BTCDelegationStatus = 3
+	// OVERFLOW defines a delegation that has received covenant signatures but
+	// never gains voting power as it exceeded the staking cap upon activation
+	BTCDelegationStatus_OVERFLOW BTCDelegationStatus = 4
 )
 
 var BTCDelegationStatus_name = map[int32]string{
@@ -51,6 +54,7 @@ var BTCDelegationStatus_name = map[int32]string{
 	1: "ACTIVE",
 	2: "UNBONDED",
 	3: "ANY",
+	4: "OVERFLOW",
 }
 
 var BTCDelegationStatus_value = map[string]int32{
@@ -58,6 +62,7 @@ var BTCDelegationStatus_value = map[string]int32{
 	"ACTIVE":   1,
 	"UNBONDED": 2,
 	"ANY":      3,
+	"OVERFLOW": 4,
 }
 
 func (x BTCDelegationStatus) String() string {
@@ -90,6 +95,11 @@ type FinalityProvider struct {
 	// the finality provider is slashed.
 	// if it's 0 then the finality provider is not slashed
 	SlashedBtcHeight uint64 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+	// jailed defines whether the finality provider is jailed due to missing
+	// too many finality votes. A jailed finality provider keeps its BTC
+	// delegations but is excluded from the active finality provider set
+	// until it gets unjailed
+	Jailed bool `protobuf:"varint,8,opt,name=jailed,proto3" json:"jailed,omitempty"`
 }
 
 func (m *FinalityProvider) Reset()         { *m = FinalityProvider{} }
@@ -160,6 +170,13 @@ func (m *FinalityProvider) GetSlashedBtcHeight() uint64 {
 	return 0
 }
 
+func (m *FinalityProvider) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
 // FinalityProviderWithMeta wraps the FinalityProvider with metadata.
 type FinalityProviderWithMeta struct {
 	// btc_pk is the Bitcoin secp256k1 PK of thisfinality provider
@@ -177,6 +194,8 @@ type FinalityProviderWithMeta struct {
 	// the finality provider is slashed.
 	// if it's 0 then the finality provider is not slashed
 	SlashedBtcHeight uint64 `protobuf:"varint,5,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+	// jailed defines whether the finality provider is jailed
+	Jailed bool `protobuf:"varint,6,opt,name=jailed,proto3" json:"jailed,omitempty"`
 }
 
 func (m *FinalityProviderWithMeta) Reset()         { *m = FinalityProviderWithMeta{} }
@@ -240,6 +259,13 @@ func (m *FinalityProviderWithMeta) GetSlashedBtcHeight() uint64 {
 	return 0
 }
 
+func (m *FinalityProviderWithMeta) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
 // BTCDelegation defines a BTC delegation
 type BTCDelegation struct {
 	// staker_addr is the address to receive rewards from BTC delegation.
@@ -286,6 +312,12 @@ type BTCDelegation struct {
 	BtcUndelegation *BTCUndelegation `protobuf:"bytes,14,opt,name=btc_undelegation,json=btcUndelegation,proto3" json:"btc_undelegation,omitempty"`
 	// version of the params used to validate the delegation
 	ParamsVersion uint32 `protobuf:"varint,15,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// overflow defines whether the BTC delegation exceeded the staking cap
+	// upon activation. An overflowed BTC delegation never gains voting power
+	Overflow bool `protobuf:"varint,16,opt,name=overflow,proto3" json:"overflow,omitempty"`
+	// op_return_info is the OP_RETURN data of the staking tx if the staking
+	// tx is an identifiable (V0) staking tx, and nil otherwise
+	OpReturnInfo *OpReturnInfo `protobuf:"bytes,17,opt,name=op_return_info,json=opReturnInfo,proto3" json:"op_return_info,omitempty"`
 }
 
 func (m *BTCDelegation) Reset()         { *m = BTCDelegation{} }
@@ -398,6 +430,86 @@ func (m *BTCDelegation) GetParamsVersion() uint32 {
 	return 0
 }
 
+func (m *BTCDelegation) GetOverflow() bool {
+	if m != nil {
+		return m.Overflow
+	}
+	return false
+}
+
+func (m *BTCDelegation) GetOpReturnInfo() *OpReturnInfo {
+	if m != nil {
+		return m.OpReturnInfo
+	}
+	return nil
+}
+
+// OpReturnInfo is the information in the OP_RETURN output of an identifiable
+// (V0) staking tx, apart from the keys and the staking time that are already
+// part of the BTC delegation
+type OpReturnInfo struct {
+	// tag is the 4-byte tag identifying the staking tx
+	Tag []byte `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// version is the version of the OP_RETURN data
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// output_idx is the index of the OP_RETURN output in the staking tx
+	OutputIdx uint32 `protobuf:"varint,3,opt,name=output_idx,json=outputIdx,proto3" json:"output_idx,omitempty"`
+}
+
+func (m *OpReturnInfo) Reset()         { *m = OpReturnInfo{} }
+func (m *OpReturnInfo) String() string { return proto.CompactTextString(m) }
+func (*OpReturnInfo) ProtoMessage()    {}
+func (*OpReturnInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{3}
+}
+func (m *OpReturnInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpReturnInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpReturnInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OpReturnInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpReturnInfo.Merge(m, src)
+}
+func (m *OpReturnInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpReturnInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpReturnInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpReturnInfo proto.InternalMessageInfo
+
+func (m *OpReturnInfo) GetTag() []byte {
+	if m != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+func (m *OpReturnInfo) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *OpReturnInfo) GetOutputIdx() uint32 {
+	if m != nil {
+		return m.OutputIdx
+	}
+	return 0
+}
+
 // BTCUndelegation contains the information about the early unbonding path of the BTC delegation
 type BTCUndelegation struct {
 	// unbonding_tx is the transaction which will transfer the funds from staking
@@ -432,7 +544,7 @@ func (m *BTCUndelegation) Reset()         { *m = BTCUndelegation{} }
 func (m *BTCUndelegation) String() string { return proto.CompactTextString(m) }
 func (*BTCUndelegation) ProtoMessage()    {}
 func (*BTCUndelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{3}
+	return fileDescriptor_3851ae95ccfaf7db, []int{4}
 }
 func (m *BTCUndelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +603,7 @@ func (m *BTCDelegatorDelegations) Reset()         { *m = BTCDelegatorDelegations
 func (m *BTCDelegatorDelegations) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegations) ProtoMessage()    {}
 func (*BTCDelegatorDelegations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{4}
+	return fileDescriptor_3851ae95ccfaf7db, []int{5}
 }
 func (m *BTCDelegatorDelegations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,7 +648,7 @@ func (m *BTCDelegatorDelegationIndex) Reset()         { *m = BTCDelegatorDelegat
 func (m *BTCDelegatorDelegationIndex) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegationIndex) ProtoMessage()    {}
 func (*BTCDelegatorDelegationIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{5}
+	return fileDescriptor_3851ae95ccfaf7db, []int{6}
 }
 func (m *BTCDelegatorDelegationIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,7 +694,7 @@ func (m *SignatureInfo) Reset()         { *m = SignatureInfo{} }
 func (m *SignatureInfo) String() string { return proto.CompactTextString(m) }
 func (*SignatureInfo) ProtoMessage()    {}
 func (*SignatureInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{6}
+	return fileDescriptor_3851ae95ccfaf7db, []int{7}
 }
 func (m *SignatureInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -624,7 +736,7 @@ func (m *CovenantAdaptorSignatures) Reset()         { *m = CovenantAdaptorSignat
 func (m *CovenantAdaptorSignatures) String() string { return proto.CompactTextString(m) }
 func (*CovenantAdaptorSignatures) ProtoMessage()    {}
 func (*CovenantAdaptorSignatures) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{7}
+	return fileDescriptor_3851ae95ccfaf7db, []int{8}
 }
 func (m *CovenantAdaptorSignatures) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +795,7 @@ func (m *SelectiveSlashingEvidence) Reset()         { *m = SelectiveSlashingEvid
 func (m *SelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
 func (*SelectiveSlashingEvidence) ProtoMessage()    {}
 func (*SelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{8}
+	return fileDescriptor_3851ae95ccfaf7db, []int{9}
 }
 func (m *SelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -731,6 +843,7 @@ func init() {
 	proto.RegisterType((*FinalityProvider)(nil), "babylon.btcstaking.v1.FinalityProvider")
 	proto.RegisterType((*FinalityProviderWithMeta)(nil), "babylon.btcstaking.v1.FinalityProviderWithMeta")
 	proto.RegisterType((*BTCDelegation)(nil), "babylon.btcstaking.v1.BTCDelegation")
+	proto.RegisterType((*OpReturnInfo)(nil), "babylon.btcstaking.v1.OpReturnInfo")
 	proto.RegisterType((*BTCUndelegation)(nil), "babylon.btcstaking.v1.BTCUndelegation")
 	proto.RegisterType((*BTCDelegatorDelegations)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegations")
 	proto.RegisterType((*BTCDelegatorDelegationIndex)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationIndex")
@@ -744,82 +857,89 @@ func init() {
 }
 
 var fileDescriptor_3851ae95ccfaf7db = []byte{
-	// 1191 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x13, 0xc7,
-	0x1b, 0xce, 0xda, 0x8e, 0x43, 0x5e, 0xdb, 0xc4, 0x0c, 0x21, 0x2c, 0x44, 0xbf, 0x24, 0x3f, 0x97,
-	0xa2, 0xa8, 0x25, 0x36, 0x04, 0x5a, 0x95, 0x43, 0x0f, 0x71, 0x1c, 0x4a, 0x04, 0x04, 0x77, 0xed,
-	0x50, 0xb5, 0x95, 0xba, 0x1a, 0xef, 0x4e, 0xd6, 0x23, 0xdb, 0x3b, 0xdb, 0x9d, 0xb1, 0xeb, 0x7c,
-	0x83, 0x5e, 0x2a, 0xf5, 0xda, 0x7b, 0x3f, 0x02, 0x9f, 0xa1, 0xe2, 0x88, 0x38, 0x55, 0x39, 0x44,
-	0x15, 0x9c, 0xfb, 0x1d, 0xaa, 0x99, 0x59, 0xef, 0xae, 0x29, 0xa1, 0x40, 0x72, 0xf3, 0xbc, 0xff,
-	0x9e, 0x77, 0xde, 0xe7, 0xf1, 0x3b, 0x0b, 0xd7, 0x3b, 0xb8, 0x73, 0xd8, 0x67, 0x7e, 0xad, 0x23,
-	0x1c, 0x2e, 0x70, 0x8f, 0xfa, 0x5e, 0x6d, 0x74, 0x2b, 0x75, 0xaa, 0x06, 0x21, 0x13, 0x0c, 0x5d,
-	0x8a, 0xe2, 0xaa, 0x29, 0xcf, 0xe8, 0xd6, 0xd5, 0x45, 0x8f, 0x79, 0x4c, 0x45, 0xd4, 0xe4, 0x2f,
-	0x1d, 0x7c, 0xf5, 0x8a, 0xc3, 0xf8, 0x80, 0x71, 0x5b, 0x3b, 0xf4, 0x21, 0x72, 0x5d, 0xd3, 0xa7,
-	0x5a, 0x82, 0xd5, 0x21, 0x02, 0xdf, 0xaa, 0x4d, 0xa1, 0x5d, 0x5d, 0x7d, 0x73, 0x57, 0x01, 0x0b,
-	0x74, 0x40, 0xe5, 0x59, 0x16, 0xca, 0xf7, 0xa8, 0x8f, 0xfb, 0x54, 0x1c, 0x36, 0x43, 0x36, 0xa2,
-	0x2e, 0x09, 0xd1, 0x0d, 0xc8, 0x61, 0xd7, 0x0d, 0x4d, 0x63, 0xcd, 0x58, 0x9f, 0xaf, 0x9b, 0x2f,
-	0x9e, 0x6e, 0x2c, 0x46, 0xd8, 0x5b, 0xae, 0x1b, 0x12, 0xce, 0x5b, 0x22, 0xa4, 0xbe, 0x67, 0xa9,
-	0x28, 0xb4, 0x03, 0x05, 0x97, 0x70, 0x27, 0xa4, 0x81, 0xa0, 0xcc, 0x37, 0x33, 0x6b, 0xc6, 0x7a,
-	0x61, 0xf3, 0xa3, 0x6a, 0x94, 0x91, 0xdc, 0x51, 0xf5, 0x57, 0x6d, 0x24, 0xa1, 0x56, 0x3a, 0x0f,
-	0x3d, 0x02, 0x70, 0xd8, 0x60, 0x40, 0x39, 0x97, 0x55, 0xb2, 0x0a, 0x7a, 0xe3, 0xe8, 0x78, 0x75,
-	0x59, 0x17, 0xe2, 0x6e, 0xaf, 0x4a, 0x59, 0x6d, 0x80, 0x45, 0xb7, 0xfa, 0x90, 0x78, 0xd8, 0x39,
-	0x6c, 0x10, 0xe7, 0xc5, 0xd3, 0x0d, 0x88, 0x70, 0x1a, 0xc4, 0xb1, 0x52, 0x05, 0xd0, 0x23, 0xc8,
-	0x77, 0x84, 0x63, 0x07, 0x3d, 0x33, 0xb7, 0x66, 0xac, 0x17, 0xeb, 0x9f, 0x1f, 0x1d, 0xaf, 0x6e,
-	0x7a, 0x54, 0x74, 0x87, 0x9d, 0xaa, 0xc3, 0x06, 0xb5, 0x68, 0x30, 0x4e, 0x17, 0x53, 0x7f, 0x72,
-	0xa8, 0x89, 0xc3, 0x80, 0xf0, 0x6a, 0x7d, 0xb7, 0x79, 0xfb, 0xce, 0xcd, 0xe6, 0xb0, 0xf3, 0x80,
-	0x1c, 0x5a, 0xb3, 0x1d, 0xe1, 0x34, 0x7b, 0xe8, 0x4b, 0xc8, 0x06, 0x2c, 0x30, 0x67, 0xd5, 0xe5,
-	0x3e, 0xad, 0xbe, 0x91, 0xc4, 0x6a, 0x33, 0x64, 0xec, 0xe0, 0xf1, 0x41, 0x93, 0x71, 0x4e, 0x54,
-	0x17, 0xf5, 0xf6, 0xb6, 0x25, 0xf3, 0xd0, 0x1d, 0x58, 0xe2, 0x7d, 0xcc, 0xbb, 0xc4, 0xb5, 0xa3,
-	0x54, 0xbb, 0x4b, 0xa8, 0xd7, 0x15, 0x66, 0x7e, 0xcd, 0x58, 0xcf, 0x59, 0x8b, 0x91, 0xb7, 0xae,
-	0x9d, 0xf7, 0x95, 0x0f, 0xdd, 0x00, 0x14, 0x67, 0x09, 0x67, 0x92, 0x31, 0xa7, 0x32, 0xca, 0x93,
-	0x0c, 0xe1, 0xe8, 0xe8, 0xca, 0xcf, 0x19, 0x30, 0x5f, 0xa7, 0xf2, 0x1b, 0x2a, 0xba, 0x8f, 0x88,
-	0xc0, 0xa9, 0x71, 0x18, 0x67, 0x31, 0x8e, 0x25, 0xc8, 0x47, 0xdd, 0x64, 0x54, 0x37, 0xd1, 0x09,
-	0xfd, 0x1f, 0x8a, 0x23, 0x26, 0xa8, 0xef, 0xd9, 0x01, 0xfb, 0x89, 0x84, 0x8a, 0xc6, 0x9c, 0x55,
-	0xd0, 0xb6, 0xa6, 0x34, 0xbd, 0x65, 0x14, 0xb9, 0xf7, 0x1e, 0xc5, 0xec, 0x09, 0xa3, 0xf8, 0x3b,
-	0x0f, 0xa5, 0x7a, 0x7b, 0xbb, 0x41, 0xfa, 0xc4, 0xc3, 0x4a, 0x5d, 0x77, 0xa1, 0x20, 0x89, 0x22,
-	0xa1, 0xfd, 0x4e, 0xca, 0x06, 0x1d, 0x2c, 0x8d, 0xa9, 0xd1, 0x65, 0xce, 0x50, 0x49, 0xd9, 0x0f,
-	0x54, 0xd2, 0xf7, 0x70, 0xfe, 0x20, 0xb0, 0x75, 0x43, 0x76, 0x9f, 0x72, 0x39, 0xb6, 0xec, 0x29,
-	0xba, 0x2a, 0x1c, 0x04, 0x75, 0xd9, 0xd7, 0x43, 0xca, 0x15, 0x7d, 0x5c, 0xe0, 0x50, 0x4c, 0xcf,
-	0xb7, 0xa0, 0x6c, 0x11, 0x11, 0xff, 0x03, 0x20, 0xbe, 0x3b, 0xad, 0xde, 0x79, 0xe2, 0xbb, 0x91,
-	0x7b, 0x19, 0xe6, 0x05, 0x13, 0xb8, 0x6f, 0x73, 0x3c, 0x51, 0xea, 0x39, 0x65, 0x68, 0x61, 0x95,
-	0x1b, 0xdd, 0xd1, 0x16, 0x63, 0xf3, 0x9c, 0x9c, 0xa6, 0x35, 0x1f, 0x59, 0xda, 0x63, 0xc5, 0x71,
-	0xe4, 0x66, 0x43, 0x11, 0x0c, 0x85, 0x4d, 0xdd, 0xb1, 0x39, 0xbf, 0x66, 0xac, 0x97, 0xac, 0x72,
-	0xe4, 0x79, 0xac, 0x1c, 0xbb, 0xee, 0x18, 0x6d, 0x42, 0x41, 0xf1, 0x1e, 0x55, 0x03, 0xc5, 0xcd,
-	0x85, 0xa3, 0xe3, 0x55, 0xc9, 0x7c, 0x2b, 0xf2, 0xb4, 0xc7, 0x16, 0xf0, 0xf8, 0x37, 0xfa, 0x01,
-	0x4a, 0xae, 0xd6, 0x04, 0x0b, 0x6d, 0x4e, 0x3d, 0xb3, 0xa0, 0xb2, 0xee, 0x1e, 0x1d, 0xaf, 0x7e,
-	0xf6, 0x3e, 0xb3, 0x6b, 0x51, 0xcf, 0xc7, 0x62, 0x18, 0x12, 0xab, 0x18, 0xd7, 0x6b, 0x51, 0x0f,
-	0xed, 0x43, 0xc9, 0x61, 0x23, 0xe2, 0x63, 0x5f, 0xc8, 0xf2, 0xdc, 0x2c, 0xae, 0x65, 0xd7, 0x0b,
-	0x9b, 0x37, 0x4f, 0x60, 0x79, 0x3b, 0x8a, 0xdd, 0x72, 0x71, 0xa0, 0x2b, 0xe8, 0xaa, 0xdc, 0x2a,
-	0x4e, 0xca, 0xb4, 0xa8, 0xc7, 0xd1, 0xc7, 0x70, 0x7e, 0xe8, 0x77, 0x98, 0xef, 0xaa, 0xbb, 0xd2,
-	0x01, 0x31, 0x4b, 0x6a, 0x28, 0xa5, 0xd8, 0xda, 0xa6, 0x03, 0x82, 0xbe, 0x86, 0xb2, 0xd4, 0xc5,
-	0xd0, 0x77, 0x63, 0xdd, 0x9b, 0xe7, 0x95, 0xcc, 0xae, 0x9f, 0xd0, 0x40, 0xbd, 0xbd, 0xbd, 0x9f,
-	0x8a, 0xb6, 0x16, 0x3a, 0xc2, 0x49, 0x1b, 0x24, 0x72, 0x80, 0x43, 0x3c, 0xe0, 0xf6, 0x88, 0x84,
-	0x6a, 0x31, 0x2f, 0x68, 0x64, 0x6d, 0x7d, 0xa2, 0x8d, 0x95, 0xdf, 0x72, 0xb0, 0xf0, 0x5a, 0x2d,
-	0xa9, 0xa5, 0x54, 0xd3, 0x63, 0xbd, 0x77, 0xac, 0x42, 0xd2, 0xf2, 0xbf, 0x28, 0xcc, 0xbc, 0x0b,
-	0x85, 0x3f, 0xc2, 0xe5, 0x84, 0xc2, 0x04, 0x40, 0x92, 0x99, 0x3d, 0x2d, 0x99, 0x97, 0xe2, 0xca,
-	0xfb, 0x93, 0xc2, 0x92, 0x55, 0x06, 0x4b, 0x29, 0xd5, 0x4c, 0x1a, 0x96, 0x88, 0xb9, 0xd3, 0x22,
-	0x2e, 0x26, 0xf2, 0x89, 0xea, 0x4a, 0xc0, 0x03, 0x58, 0x4a, 0x64, 0x94, 0xc2, 0xe3, 0xe6, 0xec,
-	0x07, 0xea, 0x69, 0x31, 0xd6, 0x53, 0x02, 0xc3, 0x91, 0x03, 0xcb, 0x31, 0xce, 0xd4, 0x28, 0xf5,
-	0x62, 0xc9, 0x2b, 0xb0, 0x6b, 0x27, 0x80, 0xc5, 0xd5, 0x77, 0xfd, 0x03, 0x66, 0x99, 0x93, 0x42,
-	0xe9, 0xc9, 0xc9, 0x9d, 0x52, 0x69, 0xc1, 0xe5, 0x64, 0x15, 0xb3, 0x30, 0xd9, 0xc9, 0x1c, 0x7d,
-	0x01, 0x39, 0x97, 0xf4, 0xb9, 0x69, 0xbc, 0x15, 0x68, 0x6a, 0x91, 0x5b, 0x2a, 0xa3, 0xb2, 0x07,
-	0xcb, 0x6f, 0x2e, 0xba, 0xeb, 0xbb, 0x64, 0x8c, 0x6a, 0xb0, 0x98, 0x2c, 0x1a, 0xbb, 0x8b, 0x79,
-	0x57, 0xdf, 0x48, 0x02, 0x15, 0xad, 0x0b, 0xf1, 0xca, 0xb9, 0x8f, 0x79, 0x57, 0x35, 0xf9, 0xbb,
-	0x01, 0xa5, 0xa9, 0x0b, 0xa1, 0x7b, 0x90, 0x39, 0xf5, 0x63, 0x99, 0x09, 0x7a, 0xe8, 0x01, 0x64,
-	0xa5, 0x52, 0x32, 0xa7, 0x55, 0x8a, 0xac, 0x52, 0xf9, 0xc5, 0x80, 0x2b, 0x27, 0x92, 0x2c, 0x1f,
-	0x2a, 0x87, 0x8d, 0xce, 0xe0, 0x8d, 0x77, 0xd8, 0xa8, 0xd9, 0x93, 0x7f, 0x60, 0xac, 0x31, 0xb4,
-	0xf6, 0x32, 0x6a, 0x78, 0x05, 0x1c, 0xe3, 0xf2, 0xca, 0x1f, 0x06, 0x5c, 0x69, 0x91, 0x3e, 0x71,
-	0x04, 0x1d, 0x91, 0x89, 0xb4, 0x76, 0xe4, 0x97, 0x87, 0xef, 0x10, 0x74, 0x1d, 0x16, 0x5e, 0x63,
-	0x41, 0xbf, 0xbb, 0x56, 0x69, 0x8a, 0x00, 0x64, 0xc1, 0x7c, 0xfc, 0xa4, 0x9d, 0xf2, 0x8d, 0x9d,
-	0x8b, 0x5e, 0x33, 0xb4, 0x01, 0x17, 0x43, 0x22, 0x35, 0x19, 0x12, 0xd7, 0x8e, 0xaa, 0xf3, 0x9e,
-	0x5e, 0x11, 0x56, 0x39, 0x76, 0xdd, 0x93, 0xe1, 0xad, 0xde, 0x27, 0x3b, 0x70, 0x71, 0x4a, 0x66,
-	0x2d, 0x81, 0xc5, 0x90, 0xa3, 0x02, 0xcc, 0x35, 0x77, 0xf6, 0x1a, 0xbb, 0x7b, 0x5f, 0x95, 0x67,
-	0x10, 0x40, 0x7e, 0x6b, 0xbb, 0xbd, 0xfb, 0x64, 0xa7, 0x6c, 0xa0, 0x22, 0x9c, 0xdb, 0xdf, 0xab,
-	0x3f, 0xde, 0x6b, 0xec, 0x34, 0xca, 0x19, 0x34, 0x07, 0xd9, 0xad, 0xbd, 0x6f, 0xcb, 0xd9, 0xfa,
-	0xc3, 0x67, 0x2f, 0x57, 0x8c, 0xe7, 0x2f, 0x57, 0x8c, 0xbf, 0x5e, 0xae, 0x18, 0xbf, 0xbe, 0x5a,
-	0x99, 0x79, 0xfe, 0x6a, 0x65, 0xe6, 0xcf, 0x57, 0x2b, 0x33, 0xdf, 0xfd, 0xe7, 0x65, 0xc6, 0xe9,
-	0x4f, 0x74, 0x75, 0xb3, 0x4e, 0x5e, 0x7d, 0xa2, 0xdf, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0xc9,
-	0xf5, 0x0c, 0xdf, 0x5b, 0x0c, 0x00, 0x00,
+	// 1298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0x1a, 0x47,
+	0x18, 0xf6, 0x02, 0xc6, 0xe6, 0x05, 0x6c, 0x32, 0x71, 0x9c, 0x8d, 0xad, 0xda, 0x94, 0xa6, 0x11,
+	0x6a, 0x63, 0x48, 0x9c, 0xb4, 0x6a, 0x0e, 0x3d, 0x18, 0x63, 0x37, 0x28, 0x8e, 0x4d, 0x17, 0x9c,
+	0x28, 0xad, 0xd4, 0xd5, 0xb0, 0x3b, 0x2c, 0x5b, 0x60, 0x67, 0xbb, 0x33, 0x10, 0xfc, 0x23, 0x2a,
+	0xf5, 0xda, 0x5b, 0x0f, 0xfd, 0x09, 0xf9, 0x0d, 0x55, 0x8f, 0x51, 0xd4, 0x43, 0xe5, 0x83, 0x15,
+	0x25, 0x7f, 0xa4, 0x9a, 0xd9, 0x65, 0x59, 0x52, 0x3b, 0x5f, 0xf6, 0x8d, 0x79, 0xbf, 0x9e, 0x77,
+	0x9e, 0xf7, 0x99, 0x99, 0x05, 0x6e, 0xb4, 0x70, 0xeb, 0xa8, 0x47, 0x9d, 0x72, 0x8b, 0x1b, 0x8c,
+	0xe3, 0xae, 0xed, 0x58, 0xe5, 0xe1, 0xed, 0xc8, 0xaa, 0xe4, 0x7a, 0x94, 0x53, 0x74, 0x25, 0x88,
+	0x2b, 0x45, 0x3c, 0xc3, 0xdb, 0x2b, 0x4b, 0x16, 0xb5, 0xa8, 0x8c, 0x28, 0x8b, 0x5f, 0x7e, 0xf0,
+	0xca, 0x35, 0x83, 0xb2, 0x3e, 0x65, 0xba, 0xef, 0xf0, 0x17, 0x81, 0xeb, 0xba, 0xbf, 0x2a, 0x4f,
+	0xb0, 0x5a, 0x84, 0xe3, 0xdb, 0xe5, 0x29, 0xb4, 0x95, 0xf5, 0xd3, 0xbb, 0x72, 0xa9, 0xeb, 0x07,
+	0x14, 0x5e, 0xc6, 0x21, 0xb7, 0x6b, 0x3b, 0xb8, 0x67, 0xf3, 0xa3, 0xba, 0x47, 0x87, 0xb6, 0x49,
+	0x3c, 0x74, 0x13, 0x12, 0xd8, 0x34, 0x3d, 0x55, 0xc9, 0x2b, 0xc5, 0x54, 0x45, 0x7d, 0xf1, 0x6c,
+	0x63, 0x29, 0xc0, 0xde, 0x32, 0x4d, 0x8f, 0x30, 0xd6, 0xe0, 0x9e, 0xed, 0x58, 0x9a, 0x8c, 0x42,
+	0x3b, 0x90, 0x36, 0x09, 0x33, 0x3c, 0xdb, 0xe5, 0x36, 0x75, 0xd4, 0x58, 0x5e, 0x29, 0xa6, 0x37,
+	0x3f, 0x2b, 0x05, 0x19, 0x93, 0x3d, 0xca, 0xfe, 0x4a, 0xd5, 0x49, 0xa8, 0x16, 0xcd, 0x43, 0x0f,
+	0x01, 0x0c, 0xda, 0xef, 0xdb, 0x8c, 0x89, 0x2a, 0x71, 0x09, 0xbd, 0x71, 0x7c, 0xb2, 0xbe, 0xea,
+	0x17, 0x62, 0x66, 0xb7, 0x64, 0xd3, 0x72, 0x1f, 0xf3, 0x4e, 0x69, 0x8f, 0x58, 0xd8, 0x38, 0xaa,
+	0x12, 0xe3, 0xc5, 0xb3, 0x0d, 0x08, 0x70, 0xaa, 0xc4, 0xd0, 0x22, 0x05, 0xd0, 0x43, 0x48, 0xb6,
+	0xb8, 0xa1, 0xbb, 0x5d, 0x35, 0x91, 0x57, 0x8a, 0x99, 0xca, 0xd7, 0xc7, 0x27, 0xeb, 0x9b, 0x96,
+	0xcd, 0x3b, 0x83, 0x56, 0xc9, 0xa0, 0xfd, 0x72, 0x40, 0x8c, 0xd1, 0xc1, 0xb6, 0x33, 0x5e, 0x94,
+	0xf9, 0x91, 0x4b, 0x58, 0xa9, 0x52, 0xab, 0xdf, 0xb9, 0x7b, 0xab, 0x3e, 0x68, 0x3d, 0x20, 0x47,
+	0xda, 0x6c, 0x8b, 0x1b, 0xf5, 0x2e, 0xfa, 0x16, 0xe2, 0x2e, 0x75, 0xd5, 0x59, 0xb9, 0xb9, 0x2f,
+	0x4b, 0xa7, 0x0e, 0xb1, 0x54, 0xf7, 0x28, 0x6d, 0x1f, 0xb4, 0xeb, 0x94, 0x31, 0x22, 0xbb, 0xa8,
+	0x34, 0xb7, 0x35, 0x91, 0x87, 0xee, 0xc2, 0x32, 0xeb, 0x61, 0xd6, 0x21, 0xa6, 0x1e, 0xa4, 0xea,
+	0x1d, 0x62, 0x5b, 0x1d, 0xae, 0x26, 0xf3, 0x4a, 0x31, 0xa1, 0x2d, 0x05, 0xde, 0x8a, 0xef, 0xbc,
+	0x2f, 0x7d, 0xe8, 0x26, 0xa0, 0x30, 0x8b, 0x1b, 0xe3, 0x8c, 0x39, 0x99, 0x91, 0x1b, 0x67, 0x70,
+	0x23, 0x88, 0x5e, 0x86, 0xe4, 0xcf, 0xd8, 0xee, 0x11, 0x53, 0x9d, 0xcf, 0x2b, 0xc5, 0x79, 0x2d,
+	0x58, 0x15, 0xfe, 0x88, 0x81, 0xfa, 0xe6, 0x88, 0x1f, 0xdb, 0xbc, 0xf3, 0x90, 0x70, 0x1c, 0xa1,
+	0x49, 0xb9, 0x08, 0x9a, 0x96, 0x21, 0x19, 0x74, 0x19, 0x93, 0x5d, 0x06, 0x2b, 0xf4, 0x29, 0x64,
+	0x86, 0x94, 0xdb, 0x8e, 0xa5, 0xbb, 0xf4, 0x29, 0xf1, 0xe4, 0x78, 0x13, 0x5a, 0xda, 0xb7, 0xd5,
+	0x85, 0xe9, 0x2d, 0x14, 0x25, 0x3e, 0x98, 0xa2, 0xd9, 0x77, 0x52, 0x94, 0x9c, 0xa2, 0xe8, 0x9f,
+	0x39, 0xc8, 0x56, 0x9a, 0xdb, 0x55, 0xd2, 0x23, 0x16, 0x96, 0x6a, 0xbc, 0x07, 0x69, 0x31, 0x58,
+	0xe2, 0xe9, 0xef, 0x75, 0x12, 0xc0, 0x0f, 0x16, 0xc6, 0x08, 0xa5, 0xb1, 0x0b, 0x54, 0x5e, 0xfc,
+	0x23, 0x95, 0xf7, 0x23, 0x2c, 0xb4, 0x5d, 0xdd, 0x6f, 0x48, 0xef, 0xd9, 0x4c, 0xd0, 0x19, 0x3f,
+	0x47, 0x57, 0xe9, 0xb6, 0x5b, 0x11, 0x7d, 0xed, 0xd9, 0x4c, 0x8e, 0x95, 0x71, 0xec, 0xf1, 0x69,
+	0xde, 0xd3, 0xd2, 0x16, 0x50, 0xfe, 0x09, 0x00, 0x71, 0xcc, 0x69, 0xb5, 0xa7, 0x88, 0x63, 0x06,
+	0xee, 0x55, 0x48, 0x71, 0xca, 0x71, 0x4f, 0x67, 0x78, 0xac, 0xec, 0x79, 0x69, 0x68, 0x60, 0x99,
+	0x1b, 0xec, 0x51, 0xe7, 0x23, 0xa9, 0xea, 0x8c, 0x96, 0x0a, 0x2c, 0xcd, 0x91, 0x9c, 0x7d, 0xe0,
+	0xa6, 0x03, 0xee, 0x0e, 0xb8, 0x6e, 0x9b, 0x23, 0x35, 0x95, 0x57, 0x8a, 0x59, 0x2d, 0x17, 0x78,
+	0x0e, 0xa4, 0xa3, 0x66, 0x8e, 0xd0, 0x26, 0xa4, 0xa5, 0x1e, 0x82, 0x6a, 0x20, 0x67, 0x73, 0xe9,
+	0xf8, 0x64, 0x5d, 0x4c, 0xbe, 0x11, 0x78, 0x9a, 0x23, 0x0d, 0x58, 0xf8, 0x1b, 0xfd, 0x04, 0x59,
+	0xd3, 0xd7, 0x04, 0xf5, 0x74, 0x66, 0x5b, 0x6a, 0x5a, 0x66, 0xdd, 0x3b, 0x3e, 0x59, 0xff, 0xea,
+	0x43, 0xb8, 0x6b, 0xd8, 0x96, 0x83, 0xf9, 0xc0, 0x23, 0x5a, 0x26, 0xac, 0xd7, 0xb0, 0x2d, 0x74,
+	0x08, 0x59, 0x83, 0x0e, 0x89, 0x83, 0x1d, 0x2e, 0xca, 0x33, 0x35, 0x93, 0x8f, 0x17, 0xd3, 0x9b,
+	0xb7, 0xce, 0x98, 0xf2, 0x76, 0x10, 0xbb, 0x65, 0x62, 0xd7, 0xaf, 0xe0, 0x57, 0x65, 0x5a, 0x66,
+	0x5c, 0xa6, 0x61, 0x5b, 0x0c, 0x7d, 0x0e, 0x0b, 0x03, 0xa7, 0x45, 0x1d, 0x53, 0xee, 0xd5, 0xee,
+	0x13, 0x35, 0x2b, 0x49, 0xc9, 0x86, 0xd6, 0xa6, 0xdd, 0x27, 0xe8, 0x7b, 0xc8, 0x09, 0x5d, 0x0c,
+	0x1c, 0x33, 0xd4, 0xbd, 0xba, 0x20, 0x65, 0x76, 0xe3, 0x8c, 0x06, 0x2a, 0xcd, 0xed, 0xc3, 0x48,
+	0xb4, 0xb6, 0xd8, 0xe2, 0x46, 0xd4, 0x20, 0x90, 0x5d, 0xec, 0xe1, 0x3e, 0xd3, 0x87, 0xc4, 0x93,
+	0x17, 0xf9, 0xa2, 0x8f, 0xec, 0x5b, 0x1f, 0xf9, 0x46, 0xb4, 0x02, 0xf3, 0x74, 0x48, 0xbc, 0x76,
+	0x8f, 0x3e, 0x55, 0x73, 0xf2, 0x24, 0x86, 0x6b, 0x54, 0x83, 0x05, 0xea, 0xea, 0x1e, 0xe1, 0x03,
+	0xcf, 0xd1, 0x6d, 0xa7, 0x4d, 0xd5, 0x4b, 0xc1, 0x8b, 0x72, 0x7a, 0x4f, 0x07, 0xae, 0x26, 0x63,
+	0x6b, 0x4e, 0x9b, 0x6a, 0x19, 0x1a, 0x59, 0x15, 0x9e, 0x40, 0x26, 0xea, 0x45, 0x39, 0x88, 0x73,
+	0x6c, 0xf9, 0x37, 0x9d, 0x26, 0x7e, 0x22, 0x15, 0xe6, 0xc6, 0x8d, 0xc6, 0x64, 0xa3, 0xe3, 0xa5,
+	0xd0, 0x5e, 0x44, 0x54, 0x71, 0xe9, 0x4c, 0xd1, 0xb1, 0x9a, 0x0a, 0xbf, 0x27, 0x60, 0xf1, 0x0d,
+	0x36, 0xc4, 0x69, 0x88, 0xd0, 0x3e, 0x0a, 0x70, 0xd2, 0x13, 0xd2, 0xff, 0x27, 0xc2, 0xd8, 0xfb,
+	0x88, 0xf0, 0x17, 0xb8, 0x3a, 0x11, 0xe1, 0x04, 0x40, 0xc8, 0x31, 0x7e, 0x5e, 0x39, 0x5e, 0x09,
+	0x2b, 0x1f, 0x8e, 0x0b, 0x0b, 0x5d, 0x52, 0x58, 0x8e, 0xe8, 0x7e, 0xdc, 0xb0, 0x40, 0x4c, 0x9c,
+	0x17, 0x71, 0x69, 0x72, 0x00, 0x82, 0xba, 0x02, 0xb0, 0x0d, 0xcb, 0x93, 0x83, 0x10, 0xc1, 0x63,
+	0xea, 0xec, 0x47, 0x9e, 0x88, 0xa5, 0xf0, 0x44, 0x4c, 0x60, 0x18, 0x32, 0x60, 0x35, 0xc4, 0x99,
+	0xa2, 0xd2, 0xbf, 0x1a, 0x93, 0x12, 0xec, 0xfa, 0x19, 0x60, 0x61, 0x75, 0x29, 0x35, 0x75, 0x5c,
+	0x28, 0xca, 0x9c, 0xb8, 0x15, 0x0b, 0x0d, 0xb8, 0x3a, 0x79, 0x4c, 0xa8, 0x37, 0x79, 0x55, 0x18,
+	0xfa, 0x06, 0x12, 0x26, 0xe9, 0x31, 0x55, 0x79, 0x2b, 0xd0, 0xd4, 0x53, 0xa4, 0xc9, 0x8c, 0xc2,
+	0x3e, 0xac, 0x9e, 0x5e, 0xb4, 0xe6, 0x98, 0x64, 0x84, 0xca, 0xb0, 0x34, 0xb9, 0x2a, 0xf5, 0x0e,
+	0x66, 0x1d, 0x7f, 0x47, 0x02, 0x28, 0xa3, 0x5d, 0x0a, 0x2f, 0xcd, 0xfb, 0x98, 0x75, 0x64, 0x93,
+	0x7f, 0x2a, 0x90, 0x9d, 0xda, 0x10, 0xda, 0x85, 0xd8, 0xb9, 0x3f, 0x03, 0x62, 0x6e, 0x17, 0x3d,
+	0x80, 0xb8, 0x50, 0x4a, 0xec, 0xbc, 0x4a, 0x11, 0x55, 0x0a, 0xbf, 0x2a, 0x70, 0xed, 0xcc, 0x21,
+	0x8b, 0xa7, 0xd6, 0xa0, 0xc3, 0x0b, 0xf8, 0x7a, 0x31, 0xe8, 0xb0, 0xde, 0x15, 0x07, 0x18, 0xfb,
+	0x18, 0xbe, 0xf6, 0x62, 0x92, 0xbc, 0x34, 0x0e, 0x71, 0x59, 0xe1, 0x2f, 0x05, 0xae, 0x35, 0x48,
+	0x8f, 0x18, 0xdc, 0x1e, 0x92, 0xb1, 0xb4, 0x76, 0xc4, 0x37, 0x95, 0x63, 0x10, 0x74, 0x03, 0x16,
+	0xdf, 0x98, 0x82, 0xff, 0xe5, 0xa0, 0x65, 0xa7, 0x06, 0x80, 0x34, 0x48, 0x85, 0x8f, 0xf2, 0x39,
+	0xbf, 0x12, 0xe6, 0x82, 0xf7, 0x18, 0x6d, 0xc0, 0x65, 0x8f, 0x08, 0x4d, 0x7a, 0xc4, 0xd4, 0x83,
+	0xea, 0xac, 0xeb, 0x5f, 0x11, 0x5a, 0x2e, 0x74, 0xed, 0x8a, 0xf0, 0x46, 0xf7, 0x8b, 0x06, 0x5c,
+	0x9e, 0x92, 0x59, 0x83, 0x63, 0x3e, 0x60, 0x28, 0x0d, 0x73, 0xf5, 0x9d, 0xfd, 0x6a, 0x6d, 0xff,
+	0xbb, 0xdc, 0x0c, 0x02, 0x48, 0x6e, 0x6d, 0x37, 0x6b, 0x8f, 0x76, 0x72, 0x0a, 0xca, 0xc0, 0xfc,
+	0xe1, 0x7e, 0xe5, 0x60, 0xbf, 0xba, 0x53, 0xcd, 0xc5, 0xd0, 0x1c, 0xc4, 0xb7, 0xf6, 0x9f, 0xe4,
+	0xe2, 0xc2, 0x7c, 0xf0, 0x68, 0x47, 0xdb, 0xdd, 0x3b, 0x78, 0x9c, 0x4b, 0x54, 0xf6, 0xfe, 0x7e,
+	0xb5, 0xa6, 0x3c, 0x7f, 0xb5, 0xa6, 0xbc, 0x7c, 0xb5, 0xa6, 0xfc, 0xf6, 0x7a, 0x6d, 0xe6, 0xf9,
+	0xeb, 0xb5, 0x99, 0x7f, 0x5f, 0xaf, 0xcd, 0xfc, 0xf0, 0xce, 0xad, 0x8d, 0xa2, 0x7f, 0x51, 0xe4,
+	0x3e, 0x5b, 0x49, 0xf9, 0x17, 0xe5, 0xce, 0x7f, 0x03, 0x00, 0xa3, 0x8e, 0x4e, 0x0f, 0x5b, 0x0d,
+	0x00, 0x00,
 }
 
 func (m *FinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -842,6 +962,16 @@ func (m *FinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.SlashedBtcHeight != 0 {
 		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedBtcHeight))
 		i--
@@ -930,6 +1060,16 @@ func (m *FinalityProviderWithMeta) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SlashedBtcHeight != 0 {
 		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedBtcHeight))
 		i--
@@ -985,6 +1125,32 @@ func (m *BTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OpReturnInfo != nil {
+		{
+			size, err := m.OpReturnInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Overflow {
+		i--
+		if m.Overflow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.ParamsVersion != 0 {
 		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ParamsVersion))
 		i--
@@ -1120,6 +1286,46 @@ func (m *BTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OpReturnInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpReturnInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpReturnInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutputIdx != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.OutputIdx))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BTCUndelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1466,6 +1672,9 @@ func (m *FinalityProvider) Size() (n int) {
 	if m.SlashedBtcHeight != 0 {
 		n += 1 + sovBtcstaking(uint64(m.SlashedBtcHeight))
 	}
+	if m.Jailed {
+		n += 2
+	}
 	return n
 }
 
@@ -1491,6 +1700,9 @@ func (m *FinalityProviderWithMeta) Size() (n int) {
 	if m.SlashedBtcHeight != 0 {
 		n += 1 + sovBtcstaking(uint64(m.SlashedBtcHeight))
 	}
+	if m.Jailed {
+		n += 2
+	}
 	return n
 }
 
@@ -1558,6 +1770,32 @@ func (m *BTCDelegation) Size() (n int) {
 	if m.ParamsVersion != 0 {
 		n += 1 + sovBtcstaking(uint64(m.ParamsVersion))
 	}
+	if m.Overflow {
+		n += 3
+	}
+	if m.OpReturnInfo != nil {
+		l = m.OpReturnInfo.Size()
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *OpReturnInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovBtcstaking(uint64(m.Version))
+	}
+	if m.OutputIdx != 0 {
+		n += 1 + sovBtcstaking(uint64(m.OutputIdx))
+	}
 	return n
 }
 
@@ -1933,6 +2171,26 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -2094,6 +2352,26 @@ func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -2570,6 +2848,184 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overflow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overflow = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpReturnInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OpReturnInfo == nil {
+				m.OpReturnInfo = &OpReturnInfo{}
+			}
+			if err := m.OpReturnInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpReturnInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpReturnInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpReturnInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = append(m.Tag[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tag == nil {
+				m.Tag = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIdx", wireType)
+			}
+			m.OutputIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputIdx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
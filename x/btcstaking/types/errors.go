@@ -31,4 +31,8 @@ var (
 	ErrVotingPowerTableNotUpdated   = errorsmod.Register(ModuleName, 1122, "voting power table has not been updated")
 	ErrVotingPowerDistCacheNotFound = errorsmod.Register(ModuleName, 1123, "the voting power distribution cache is not found")
 	ErrParamsNotFound               = errorsmod.Register(ModuleName, 1124, "the parameters are not found")
+	ErrVotingPowerTablePruned       = errorsmod.Register(ModuleName, 1125, "the voting power table at the given height has been pruned")
+	ErrFpAlreadyJailed              = errorsmod.Register(ModuleName, 1126, "the finality provider has already been jailed")
+	ErrFpNotJailed                  = errorsmod.Register(ModuleName, 1127, "the finality provider is not jailed")
+	ErrStakingCapExceeded           = errorsmod.Register(ModuleName, 1128, "the BTC delegation exceeds the staking cap")
 )
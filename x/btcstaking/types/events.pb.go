@@ -74,6 +74,7 @@ func (m *EventNewFinalityProvider) GetFp() *FinalityProvider {
 // - non-existing -> pending, which happens upon `MsgCreateBTCDelegation`
 // - pending -> active, which happens upon `MsgAddCovenantSigs`
 // - active -> unbonded, which happens upon `MsgBTCUndelegate` or upon staking tx timelock expires
+// - pending -> overflow, which happens when a BTC delegation exceeds the staking cap upon activation
 type EventBTCDelegationStateUpdate struct {
 	// staking_tx_hash is the hash of the staking tx.
 	// It uniquely identifies a BTC delegation
@@ -185,6 +186,8 @@ type EventPowerDistUpdate struct {
 	// Types that are valid to be assigned to Ev:
 	//	*EventPowerDistUpdate_SlashedFp
 	//	*EventPowerDistUpdate_BtcDelStateUpdate
+	//	*EventPowerDistUpdate_JailedFp
+	//	*EventPowerDistUpdate_UnjailedFp
 	Ev isEventPowerDistUpdate_Ev `protobuf_oneof:"ev"`
 }
 
@@ -233,9 +236,17 @@ type EventPowerDistUpdate_SlashedFp struct {
 type EventPowerDistUpdate_BtcDelStateUpdate struct {
 	BtcDelStateUpdate *EventBTCDelegationStateUpdate `protobuf:"bytes,2,opt,name=btc_del_state_update,json=btcDelStateUpdate,proto3,oneof" json:"btc_del_state_update,omitempty"`
 }
+type EventPowerDistUpdate_JailedFp struct {
+	JailedFp *EventPowerDistUpdate_EventJailedFinalityProvider `protobuf:"bytes,3,opt,name=jailed_fp,json=jailedFp,proto3,oneof" json:"jailed_fp,omitempty"`
+}
+type EventPowerDistUpdate_UnjailedFp struct {
+	UnjailedFp *EventPowerDistUpdate_EventUnjailedFinalityProvider `protobuf:"bytes,4,opt,name=unjailed_fp,json=unjailedFp,proto3,oneof" json:"unjailed_fp,omitempty"`
+}
 
 func (*EventPowerDistUpdate_SlashedFp) isEventPowerDistUpdate_Ev()         {}
 func (*EventPowerDistUpdate_BtcDelStateUpdate) isEventPowerDistUpdate_Ev() {}
+func (*EventPowerDistUpdate_JailedFp) isEventPowerDistUpdate_Ev()          {}
+func (*EventPowerDistUpdate_UnjailedFp) isEventPowerDistUpdate_Ev()        {}
 
 func (m *EventPowerDistUpdate) GetEv() isEventPowerDistUpdate_Ev {
 	if m != nil {
@@ -258,11 +269,27 @@ func (m *EventPowerDistUpdate) GetBtcDelStateUpdate() *EventBTCDelegationStateUp
 	return nil
 }
 
+func (m *EventPowerDistUpdate) GetJailedFp() *EventPowerDistUpdate_EventJailedFinalityProvider {
+	if x, ok := m.GetEv().(*EventPowerDistUpdate_JailedFp); ok {
+		return x.JailedFp
+	}
+	return nil
+}
+
+func (m *EventPowerDistUpdate) GetUnjailedFp() *EventPowerDistUpdate_EventUnjailedFinalityProvider {
+	if x, ok := m.GetEv().(*EventPowerDistUpdate_UnjailedFp); ok {
+		return x.UnjailedFp
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventPowerDistUpdate) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*EventPowerDistUpdate_SlashedFp)(nil),
 		(*EventPowerDistUpdate_BtcDelStateUpdate)(nil),
+		(*EventPowerDistUpdate_JailedFp)(nil),
+		(*EventPowerDistUpdate_UnjailedFp)(nil),
 	}
 }
 
@@ -310,12 +337,100 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_DiscardUnknown()
 
 var xxx_messageInfo_EventPowerDistUpdate_EventSlashedFinalityProvider proto.InternalMessageInfo
 
+// EventJailedFinalityProvider defines an event that a finality provider
+// is jailed due to missing too many finality votes
+type EventPowerDistUpdate_EventJailedFinalityProvider struct {
+	Pk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=pk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"pk,omitempty"`
+}
+
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Reset() {
+	*m = EventPowerDistUpdate_EventJailedFinalityProvider{}
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventPowerDistUpdate_EventJailedFinalityProvider) ProtoMessage() {}
+func (*EventPowerDistUpdate_EventJailedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{3, 1}
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPowerDistUpdate_EventJailedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPowerDistUpdate_EventJailedFinalityProvider.Merge(m, src)
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPowerDistUpdate_EventJailedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPowerDistUpdate_EventJailedFinalityProvider proto.InternalMessageInfo
+
+// EventUnjailedFinalityProvider defines an event that a jailed finality
+// provider is unjailed
+type EventPowerDistUpdate_EventUnjailedFinalityProvider struct {
+	Pk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=pk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"pk,omitempty"`
+}
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Reset() {
+	*m = EventPowerDistUpdate_EventUnjailedFinalityProvider{}
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventPowerDistUpdate_EventUnjailedFinalityProvider) ProtoMessage() {}
+func (*EventPowerDistUpdate_EventUnjailedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{3, 2}
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider.Merge(m, src)
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*EventNewFinalityProvider)(nil), "babylon.btcstaking.v1.EventNewFinalityProvider")
 	proto.RegisterType((*EventBTCDelegationStateUpdate)(nil), "babylon.btcstaking.v1.EventBTCDelegationStateUpdate")
 	proto.RegisterType((*EventSelectiveSlashing)(nil), "babylon.btcstaking.v1.EventSelectiveSlashing")
 	proto.RegisterType((*EventPowerDistUpdate)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate")
 	proto.RegisterType((*EventPowerDistUpdate_EventSlashedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventSlashedFinalityProvider")
+	proto.RegisterType((*EventPowerDistUpdate_EventJailedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventJailedFinalityProvider")
+	proto.RegisterType((*EventPowerDistUpdate_EventUnjailedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventUnjailedFinalityProvider")
 }
 
 func init() {
@@ -323,36 +438,40 @@ func init() {
 }
 
 var fileDescriptor_74118427820fff75 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0x6d, 0x0b, 0xa1, 0x66, 0xcb, 0x1f, 0x61, 0x05, 0x14, 0x45, 0x60, 0x2a, 0x1f, 0x4a,
-	0xc5, 0xc1, 0x6e, 0xd3, 0x0a, 0xee, 0x26, 0x0d, 0x41, 0x54, 0x28, 0xb2, 0xcb, 0x85, 0x8b, 0xb5,
-	0x76, 0x26, 0xf6, 0x2a, 0x66, 0xd7, 0xca, 0x4e, 0x9c, 0xe4, 0x5b, 0xf4, 0x63, 0x71, 0xec, 0xb1,
-	0xe2, 0x80, 0x50, 0xf2, 0x45, 0x90, 0x37, 0xa6, 0x44, 0x6d, 0x1c, 0x6e, 0xc9, 0xe8, 0xbd, 0xf7,
-	0x7b, 0x33, 0xb6, 0x89, 0x1d, 0xd1, 0x68, 0x91, 0x09, 0xee, 0x46, 0x18, 0x4b, 0xa4, 0x63, 0xc6,
-	0x13, 0xb7, 0x38, 0x71, 0xa1, 0x00, 0x8e, 0xd2, 0xc9, 0x27, 0x02, 0x85, 0xf9, 0xbc, 0xd2, 0x38,
-	0xff, 0x34, 0x4e, 0x71, 0xd2, 0x6e, 0x26, 0x22, 0x11, 0x4a, 0xe1, 0x96, 0xbf, 0xd6, 0xe2, 0xf6,
-	0xe1, 0xf6, 0xc0, 0x0d, 0xab, 0xd2, 0xd9, 0x01, 0x69, 0x9d, 0x97, 0x90, 0x2f, 0x30, 0xeb, 0x31,
-	0x4e, 0x33, 0x86, 0x8b, 0xc1, 0x44, 0x14, 0x6c, 0x08, 0x13, 0xf3, 0x3d, 0x31, 0x46, 0x79, 0x4b,
-	0x3f, 0xd0, 0x8f, 0xf6, 0x3b, 0x6f, 0x9c, 0xad, 0x74, 0xe7, 0xae, 0xc9, 0x37, 0x46, 0xb9, 0x7d,
-	0xa5, 0x93, 0x57, 0x2a, 0xd5, 0xbb, 0xfc, 0xd0, 0x85, 0x0c, 0x12, 0x8a, 0x4c, 0xf0, 0x00, 0x29,
-	0xc2, 0xd7, 0x7c, 0x48, 0x11, 0xcc, 0x43, 0xf2, 0xb4, 0x0a, 0x09, 0x71, 0x1e, 0xa6, 0x54, 0xa6,
-	0x8a, 0xd3, 0xf0, 0x1f, 0x57, 0xe3, 0xcb, 0x79, 0x9f, 0xca, 0xd4, 0xfc, 0x48, 0x1a, 0x1c, 0x66,
-	0xa1, 0x2c, 0xad, 0x2d, 0xe3, 0x40, 0x3f, 0x7a, 0xd2, 0x79, 0x5b, 0xd3, 0xe4, 0x1e, 0x6b, 0x2a,
-	0xfd, 0x3d, 0x0e, 0x33, 0x85, 0xb5, 0x47, 0xe4, 0x85, 0x6a, 0x14, 0x40, 0x06, 0x31, 0xb2, 0x02,
-	0x82, 0x8c, 0xca, 0x94, 0xf1, 0xc4, 0xbc, 0x20, 0x7b, 0x50, 0x56, 0xe7, 0x31, 0x54, 0xbb, 0x1e,
-	0xd7, 0x10, 0xee, 0x79, 0xcf, 0x2b, 0x9f, 0x7f, 0x9b, 0x60, 0xdf, 0x18, 0xa4, 0xa9, 0x40, 0x03,
-	0x31, 0x83, 0x49, 0x97, 0x49, 0xac, 0x36, 0x66, 0x84, 0xc8, 0xd2, 0x06, 0xc3, 0xf0, 0xf6, 0xa8,
-	0xfd, 0x1a, 0xd0, 0xb6, 0x80, 0xf5, 0x30, 0x58, 0x47, 0xdc, 0xbd, 0x7a, 0x5f, 0xf3, 0x1b, 0x55,
-	0x7a, 0x2f, 0x37, 0x13, 0xd2, 0x8c, 0x30, 0x0e, 0x87, 0x90, 0xad, 0x0f, 0x17, 0x4e, 0x55, 0x82,
-	0xba, 0xdf, 0x7e, 0xe7, 0x6c, 0x17, 0xb4, 0xee, 0x81, 0xf5, 0x35, 0xff, 0x59, 0x84, 0x71, 0x17,
-	0xb2, 0x8d, 0x61, 0x7b, 0x44, 0x5e, 0xee, 0x6a, 0x65, 0xf6, 0x88, 0x91, 0x8f, 0xd5, 0xae, 0x8f,
-	0xbc, 0x77, 0x3f, 0x7f, 0xbd, 0xee, 0x24, 0x0c, 0xd3, 0x69, 0xe4, 0xc4, 0xe2, 0xbb, 0x5b, 0x95,
-	0x88, 0x53, 0xca, 0xf8, 0xdf, 0x3f, 0x2e, 0x2e, 0x72, 0x90, 0x8e, 0xf7, 0x69, 0x70, 0x7a, 0x76,
-	0x3c, 0x98, 0x46, 0x9f, 0x61, 0xe1, 0x1b, 0xf9, 0xd8, 0x7b, 0x40, 0x0c, 0x28, 0xbc, 0x8b, 0x1f,
-	0x4b, 0x4b, 0xbf, 0x5e, 0x5a, 0xfa, 0xef, 0xa5, 0xa5, 0x5f, 0xad, 0x2c, 0xed, 0x7a, 0x65, 0x69,
-	0x37, 0x2b, 0x4b, 0xfb, 0xf6, 0xdf, 0xdc, 0xf9, 0xe6, 0x67, 0xa0, 0x20, 0xd1, 0x43, 0xf5, 0xfe,
-	0x9f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xc4, 0x7d, 0x08, 0x7a, 0x03, 0x00, 0x00,
+	// 525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xda, 0x40,
+	0x10, 0x87, 0xb1, 0x9b, 0x56, 0x30, 0xf4, 0x8f, 0x6a, 0xd1, 0x0a, 0xd1, 0xc6, 0x8d, 0x38, 0xa4,
+	0x51, 0x0f, 0x76, 0x42, 0xa2, 0xf6, 0x4e, 0x09, 0x21, 0x6d, 0x54, 0x21, 0x93, 0x5c, 0x7a, 0xb1,
+	0xd6, 0x66, 0xb0, 0x37, 0xb8, 0xeb, 0x15, 0x5e, 0x0c, 0xbc, 0x45, 0x1e, 0xab, 0xc7, 0x1c, 0xab,
+	0x1e, 0xaa, 0x0a, 0xde, 0xa3, 0xaa, 0x58, 0x9b, 0x04, 0x25, 0x98, 0x28, 0x52, 0x6e, 0xf6, 0x6a,
+	0xe6, 0xfb, 0x66, 0x7e, 0x2b, 0x2d, 0x54, 0x1d, 0xe2, 0x4c, 0x82, 0x90, 0x99, 0x8e, 0x70, 0x23,
+	0x41, 0xfa, 0x94, 0x79, 0x66, 0xbc, 0x67, 0x62, 0x8c, 0x4c, 0x44, 0x06, 0x1f, 0x84, 0x22, 0xd4,
+	0x5e, 0xa5, 0x35, 0xc6, 0x75, 0x8d, 0x11, 0xef, 0x55, 0x4a, 0x5e, 0xe8, 0x85, 0xb2, 0xc2, 0x9c,
+	0x7f, 0x25, 0xc5, 0x95, 0xed, 0xd5, 0xc0, 0xa5, 0x56, 0x59, 0x57, 0xed, 0x40, 0xf9, 0x70, 0x2e,
+	0xf9, 0x86, 0xa3, 0x26, 0x65, 0x24, 0xa0, 0x62, 0xd2, 0x1e, 0x84, 0x31, 0xed, 0xe2, 0x40, 0xfb,
+	0x04, 0x6a, 0x8f, 0x97, 0x95, 0x2d, 0x65, 0xa7, 0x58, 0x7b, 0x6f, 0xac, 0xb4, 0x1b, 0x37, 0x9b,
+	0x2c, 0xb5, 0xc7, 0xab, 0x17, 0x0a, 0x6c, 0x4a, 0x6a, 0xfd, 0xf4, 0x73, 0x03, 0x03, 0xf4, 0x88,
+	0xa0, 0x21, 0xeb, 0x08, 0x22, 0xf0, 0x8c, 0x77, 0x89, 0x40, 0x6d, 0x1b, 0x5e, 0xa4, 0x10, 0x5b,
+	0x8c, 0x6d, 0x9f, 0x44, 0xbe, 0xf4, 0x14, 0xac, 0x67, 0xe9, 0xf1, 0xe9, 0xb8, 0x45, 0x22, 0x5f,
+	0x3b, 0x82, 0x02, 0xc3, 0x91, 0x1d, 0xcd, 0x5b, 0xcb, 0xea, 0x96, 0xb2, 0xf3, 0xbc, 0xf6, 0x21,
+	0x63, 0x92, 0x5b, 0xae, 0x61, 0x64, 0xe5, 0x19, 0x8e, 0xa4, 0xb6, 0xda, 0x83, 0xd7, 0x72, 0xa2,
+	0x0e, 0x06, 0xe8, 0x0a, 0x1a, 0x63, 0x27, 0x20, 0x91, 0x4f, 0x99, 0xa7, 0x9d, 0x40, 0x1e, 0xe7,
+	0xa3, 0x33, 0x17, 0xd3, 0x5d, 0x77, 0x33, 0x0c, 0xb7, 0x7a, 0x0f, 0xd3, 0x3e, 0xeb, 0x8a, 0x50,
+	0xfd, 0xf7, 0x18, 0x4a, 0x52, 0xd4, 0x0e, 0x47, 0x38, 0x68, 0xd0, 0x48, 0xa4, 0x1b, 0x53, 0x80,
+	0x68, 0xde, 0x86, 0x5d, 0xfb, 0x2a, 0xd4, 0x56, 0x86, 0x68, 0x15, 0x20, 0x39, 0xec, 0x24, 0x88,
+	0x9b, 0xa9, 0xb7, 0x72, 0x56, 0x21, 0xa5, 0x37, 0xb9, 0xe6, 0x41, 0xc9, 0x11, 0xae, 0xdd, 0xc5,
+	0x20, 0x09, 0xce, 0x1e, 0xf2, 0xee, 0x22, 0xbf, 0x62, 0xed, 0x60, 0x9d, 0x34, 0xeb, 0xc2, 0x5a,
+	0x39, 0xeb, 0xa5, 0x23, 0xdc, 0x06, 0x06, 0xcb, 0xb7, 0xd8, 0x83, 0xc2, 0x39, 0xa1, 0x41, 0xb2,
+	0xd2, 0x23, 0x49, 0x3f, 0xba, 0xf7, 0x4a, 0x5f, 0x24, 0x61, 0xc5, 0x46, 0xf9, 0x84, 0xdd, 0xe4,
+	0x5a, 0x00, 0xc5, 0x21, 0xbb, 0x36, 0x6d, 0x48, 0xd3, 0xf1, 0xbd, 0x4d, 0x67, 0xec, 0x3c, 0xcb,
+	0x05, 0x0b, 0x7e, 0x93, 0x57, 0x7a, 0xf0, 0x76, 0x5d, 0xd6, 0x5a, 0x13, 0x54, 0xde, 0x97, 0x37,
+	0xf8, 0xb4, 0xfe, 0xf1, 0xf7, 0x9f, 0x77, 0x35, 0x8f, 0x0a, 0x7f, 0xe8, 0x18, 0x6e, 0xf8, 0xc3,
+	0x4c, 0x47, 0x72, 0x7d, 0x42, 0xd9, 0xe2, 0xc7, 0x14, 0x13, 0x8e, 0x91, 0x51, 0x3f, 0x6e, 0xef,
+	0x1f, 0xec, 0xb6, 0x87, 0xce, 0x57, 0x9c, 0x58, 0x2a, 0xef, 0x57, 0x10, 0xde, 0xac, 0x09, 0xe0,
+	0xc1, 0x34, 0x1e, 0x6c, 0xae, 0xdd, 0xfe, 0xa1, 0x44, 0xf5, 0x0d, 0x50, 0x31, 0xae, 0x9f, 0xfc,
+	0x9c, 0xea, 0xca, 0xe5, 0x54, 0x57, 0xfe, 0x4e, 0x75, 0xe5, 0x62, 0xa6, 0xe7, 0x2e, 0x67, 0x7a,
+	0xee, 0xd7, 0x4c, 0xcf, 0x7d, 0xbf, 0x93, 0x3b, 0x5e, 0x7e, 0xac, 0xa4, 0xc4, 0x79, 0x22, 0x5f,
+	0xa9, 0xfd, 0xff, 0x03, 0x00, 0x33, 0x50, 0x05, 0x44, 0x20, 0x05, 0x00, 0x00,
 }
 
 func (m *EventNewFinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -534,6 +653,48 @@ func (m *EventPowerDistUpdate_BtcDelStateUpdate) MarshalToSizedBuffer(dAtA []byt
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventPowerDistUpdate_JailedFp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_JailedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JailedFp != nil {
+		{
+			size, err := m.JailedFp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventPowerDistUpdate_UnjailedFp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_UnjailedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UnjailedFp != nil {
+		{
+			size, err := m.UnjailedFp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -569,6 +730,76 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) MarshalToSizedBuffer
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -658,6 +889,30 @@ func (m *EventPowerDistUpdate_BtcDelStateUpdate) Size() (n int) {
 	}
 	return n
 }
+func (m *EventPowerDistUpdate_JailedFp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JailedFp != nil {
+		l = m.JailedFp.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventPowerDistUpdate_UnjailedFp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnjailedFp != nil {
+		l = m.UnjailedFp.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Size() (n int) {
 	if m == nil {
 		return 0
@@ -671,6 +926,32 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Size() (n int) {
 	return n
 }
 
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1049,6 +1330,76 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 			}
 			m.Ev = &EventPowerDistUpdate_BtcDelStateUpdate{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventJailedFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_JailedFp{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailedFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventUnjailedFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_UnjailedFp{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1155,6 +1506,176 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byt
 	}
 	return nil
 }
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
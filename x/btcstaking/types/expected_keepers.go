@@ -25,3 +25,12 @@ type CheckpointingKeeper interface {
 	GetEpoch(ctx context.Context) *etypes.Epoch
 	GetLastFinalizedEpoch(ctx context.Context) uint64
 }
+
+type BTCStakingHooks interface {
+	// AfterBTCDelegationActivated is called after the given BTC delegation
+	// gains voting power under the given finality provider
+	AfterBTCDelegationActivated(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcDel *BTCDelDistInfo)
+	// AfterBTCDelegationUnbonded is called after the given BTC delegation
+	// loses voting power under the given finality provider
+	AfterBTCDelegationUnbonded(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcDel *BTCDelDistInfo)
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"math"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -32,6 +33,10 @@ func TestGenesisState_Validate(t *testing.T) {
 					SlashingRate:               sdkmath.LegacyMustNewDecFromStr("0.1"),
 					MaxActiveFinalityProviders: 100,
 					MinUnbondingRate:           sdkmath.LegacyMustNewDecFromStr("0.8"),
+					MinStakingValueSat:         types.DefaultParams().MinStakingValueSat,
+					MaxStakingValueSat:         types.DefaultParams().MaxStakingValueSat,
+					MinStakingTimeBlocks:       types.DefaultParams().MinStakingTimeBlocks,
+					MaxStakingTimeBlocks:       types.DefaultParams().MaxStakingTimeBlocks,
 				}},
 			},
 			valid: true,
@@ -68,6 +73,67 @@ func TestGenesisState_Validate(t *testing.T) {
 				}},
 			valid: false,
 		},
+		{
+			desc: "invalid staking value bounds in genesis",
+			genState: &types.GenesisState{
+				Params: []*types.Params{&types.Params{
+					CovenantPks:                types.DefaultParams().CovenantPks,
+					CovenantQuorum:             types.DefaultParams().CovenantQuorum,
+					SlashingAddress:            types.DefaultParams().SlashingAddress,
+					MinSlashingTxFeeSat:        500,
+					MinCommissionRate:          sdkmath.LegacyMustNewDecFromStr("0.5"),
+					SlashingRate:               sdkmath.LegacyMustNewDecFromStr("0.1"),
+					MaxActiveFinalityProviders: 100,
+					MinUnbondingRate:           sdkmath.LegacyMustNewDecFromStr("0.8"),
+					MinStakingValueSat:         2000,
+					MaxStakingValueSat:         1000, // min staking value larger than max
+					MinStakingTimeBlocks:       types.DefaultParams().MinStakingTimeBlocks,
+					MaxStakingTimeBlocks:       types.DefaultParams().MaxStakingTimeBlocks,
+				},
+				}},
+			valid: false,
+		},
+		{
+			desc: "invalid staking time bounds in genesis",
+			genState: &types.GenesisState{
+				Params: []*types.Params{&types.Params{
+					CovenantPks:                types.DefaultParams().CovenantPks,
+					CovenantQuorum:             types.DefaultParams().CovenantQuorum,
+					SlashingAddress:            types.DefaultParams().SlashingAddress,
+					MinSlashingTxFeeSat:        500,
+					MinCommissionRate:          sdkmath.LegacyMustNewDecFromStr("0.5"),
+					SlashingRate:               sdkmath.LegacyMustNewDecFromStr("0.1"),
+					MaxActiveFinalityProviders: 100,
+					MinUnbondingRate:           sdkmath.LegacyMustNewDecFromStr("0.8"),
+					MinStakingValueSat:         types.DefaultParams().MinStakingValueSat,
+					MaxStakingValueSat:         types.DefaultParams().MaxStakingValueSat,
+					MinStakingTimeBlocks:       types.DefaultParams().MinStakingTimeBlocks,
+					MaxStakingTimeBlocks:       math.MaxUint16 + 1, // max staking time overflows uint16
+				},
+				}},
+			valid: false,
+		},
+		{
+			desc: "invalid staking tx tag in genesis",
+			genState: &types.GenesisState{
+				Params: []*types.Params{&types.Params{
+					CovenantPks:                types.DefaultParams().CovenantPks,
+					CovenantQuorum:             types.DefaultParams().CovenantQuorum,
+					SlashingAddress:            types.DefaultParams().SlashingAddress,
+					MinSlashingTxFeeSat:        500,
+					MinCommissionRate:          sdkmath.LegacyMustNewDecFromStr("0.5"),
+					SlashingRate:               sdkmath.LegacyMustNewDecFromStr("0.1"),
+					MaxActiveFinalityProviders: 100,
+					MinUnbondingRate:           sdkmath.LegacyMustNewDecFromStr("0.8"),
+					MinStakingValueSat:         types.DefaultParams().MinStakingValueSat,
+					MaxStakingValueSat:         types.DefaultParams().MaxStakingValueSat,
+					MinStakingTimeBlocks:       types.DefaultParams().MinStakingTimeBlocks,
+					MaxStakingTimeBlocks:       types.DefaultParams().MaxStakingTimeBlocks,
+					StakingTxTag:               "62626e", // 3-byte tag
+				},
+				}},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
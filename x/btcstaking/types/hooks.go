@@ -0,0 +1,28 @@
+package types
+
+import (
+	"context"
+
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// combine multiple BTC staking hooks, all hook functions are run in array sequence
+var _ BTCStakingHooks = &MultiBTCStakingHooks{}
+
+type MultiBTCStakingHooks []BTCStakingHooks
+
+func NewMultiBTCStakingHooks(hooks ...BTCStakingHooks) MultiBTCStakingHooks {
+	return hooks
+}
+
+func (h MultiBTCStakingHooks) AfterBTCDelegationActivated(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcDel *BTCDelDistInfo) {
+	for i := range h {
+		h[i].AfterBTCDelegationActivated(ctx, fpBTCPK, btcDel)
+	}
+}
+
+func (h MultiBTCStakingHooks) AfterBTCDelegationUnbonded(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcDel *BTCDelDistInfo) {
+	for i := range h {
+		h[i].AfterBTCDelegationUnbonded(ctx, fpBTCPK, btcDel)
+	}
+}
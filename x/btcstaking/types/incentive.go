@@ -34,8 +34,18 @@ func (dc *VotingPowerDistCache) ApplyActiveFinalityProviders(maxActiveFPs uint32
 	}
 }
 
+// GetNumActiveFPs returns the number of active finality providers, i.e., the
+// number of non-jailed finality providers capped by maxActiveFPs. It assumes
+// finality providers are sorted such that jailed ones come last
 func (dc *VotingPowerDistCache) GetNumActiveFPs(maxActiveFPs uint32) uint32 {
-	return min(maxActiveFPs, uint32(len(dc.FinalityProviders)))
+	numNonJailedFPs := uint32(0)
+	for _, fp := range dc.FinalityProviders {
+		if fp.IsJailed {
+			break
+		}
+		numNonJailedFPs++
+	}
+	return min(maxActiveFPs, numNonJailedFPs)
 }
 
 // GetActiveFinalityProviders returns the list of active finality providers
@@ -65,6 +75,25 @@ func (dc *VotingPowerDistCache) FilterVotedDistCache(maxActiveFPs uint32, voterB
 	}
 }
 
+// GetTotalStakeSat returns the total amount of satoshis staked in all BTC
+// delegations in the cache, including those under jailed or inactive finality
+// providers. A BTC delegation restaked to multiple finality providers is only
+// counted once.
+func (dc *VotingPowerDistCache) GetTotalStakeSat() uint64 {
+	countedBTCDels := map[string]struct{}{}
+	totalStakeSat := uint64(0)
+	for _, fp := range dc.FinalityProviders {
+		for _, btcDel := range fp.BtcDels {
+			if _, ok := countedBTCDels[btcDel.StakingTxHash]; ok {
+				continue
+			}
+			countedBTCDels[btcDel.StakingTxHash] = struct{}{}
+			totalStakeSat += btcDel.VotingPower
+		}
+	}
+	return totalStakeSat
+}
+
 // GetFinalityProviderPortion returns the portion of a finality provider's voting power out of the total voting power
 func (dc *VotingPowerDistCache) GetFinalityProviderPortion(v *FinalityProviderDistInfo) sdkmath.LegacyDec {
 	return sdkmath.LegacyNewDec(int64(v.TotalVotingPower)).QuoTruncate(sdkmath.LegacyNewDec(int64(dc.TotalVotingPower)))
@@ -77,6 +106,7 @@ func NewFinalityProviderDistInfo(fp *FinalityProvider) *FinalityProviderDistInfo
 		Commission:       fp.Commission,
 		TotalVotingPower: 0,
 		BtcDels:          []*BTCDelDistInfo{},
+		IsJailed:         fp.Jailed,
 	}
 }
 
@@ -85,12 +115,7 @@ func (v *FinalityProviderDistInfo) GetAddress() sdk.AccAddress {
 }
 
 func (v *FinalityProviderDistInfo) AddBTCDel(btcDel *BTCDelegation) {
-	btcDelDistInfo := &BTCDelDistInfo{
-		BtcPk:         btcDel.BtcPk,
-		StakerAddr:    btcDel.StakerAddr,
-		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
-		VotingPower:   btcDel.TotalSat,
-	}
+	btcDelDistInfo := NewBTCDelDistInfo(btcDel)
 	v.BtcDels = append(v.BtcDels, btcDelDistInfo)
 	v.TotalVotingPower += btcDelDistInfo.VotingPower
 }
@@ -100,6 +125,15 @@ func (v *FinalityProviderDistInfo) AddBTCDelDistInfo(d *BTCDelDistInfo) {
 	v.TotalVotingPower += d.VotingPower
 }
 
+func NewBTCDelDistInfo(btcDel *BTCDelegation) *BTCDelDistInfo {
+	return &BTCDelDistInfo{
+		BtcPk:         btcDel.BtcPk,
+		StakerAddr:    btcDel.StakerAddr,
+		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+		VotingPower:   btcDel.TotalSat,
+	}
+}
+
 // GetBTCDelPortion returns the portion of a BTC delegation's voting power out of
 // the finality provider's total voting power
 func (v *FinalityProviderDistInfo) GetBTCDelPortion(d *BTCDelDistInfo) sdkmath.LegacyDec {
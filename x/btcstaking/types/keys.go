@@ -23,4 +23,8 @@ var (
 	BTCHeightKey            = []byte{0x06} // key prefix for the BTC heights
 	VotingPowerDistCacheKey = []byte{0x07} // key prefix for voting power distribution cache
 	PowerDistUpdateKey      = []byte{0x08} // key prefix for power distribution update events
+	NextHeightToPruneKey    = []byte{0x09} // key for the next height whose voting power is to be pruned
+	ActivatedHeightKey      = []byte{0x0a} // key for the height when the BTC staking protocol is activated
+	TotalActiveStakeKey     = []byte{0x0b} // key for the total amount of actively staked satoshis
+	BTCDelegationByTagKey   = []byte{0x0c} // key prefix for the index of BTC delegations by OP_RETURN tag
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math"
 
@@ -17,6 +18,12 @@ import (
 
 const (
 	defaultMaxActiveFinalityProviders uint32 = 100
+	defaultMinStakingValueSat         int64  = 1000
+	defaultMaxStakingValueSat         int64  = 10 * 1e8 // 10 BTC
+	defaultMinStakingTimeBlocks       uint32 = 10
+	defaultMaxStakingTimeBlocks       uint32 = math.MaxUint16
+	// defaultStakingTxTag is the hex encoding of "bbn0"
+	defaultStakingTxTag = "62626e30"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -65,7 +72,12 @@ func DefaultParams() Params {
 		// finalization timeout.
 		MinUnbondingTime: 0,
 		// By default unbonding value is 0.8
-		MinUnbondingRate: sdkmath.LegacyNewDecWithPrec(8, 1), // 8 * 10^{-1} = 0.8
+		MinUnbondingRate:     sdkmath.LegacyNewDecWithPrec(8, 1), // 8 * 10^{-1} = 0.8
+		MinStakingValueSat:   defaultMinStakingValueSat,
+		MaxStakingValueSat:   defaultMaxStakingValueSat,
+		MinStakingTimeBlocks: defaultMinStakingTimeBlocks,
+		MaxStakingTimeBlocks: defaultMaxStakingTimeBlocks,
+		StakingTxTag:         defaultStakingTxTag,
 	}
 }
 
@@ -121,6 +133,67 @@ func validateMinUnbondingTime(minUnbondingTimeBlocks uint32) error {
 	return nil
 }
 
+// validateStakingAmount checks the bounds of the staking value
+func validateStakingAmount(minStakingAmt, maxStakingAmt int64) error {
+	if minStakingAmt <= 0 {
+		return fmt.Errorf("minimum staking amount has to be positive")
+	}
+
+	if maxStakingAmt <= 0 {
+		return fmt.Errorf("maximum staking amount has to be positive")
+	}
+
+	if minStakingAmt > maxStakingAmt {
+		return fmt.Errorf("minimum staking amount cannot be greater than maximum staking amount")
+	}
+
+	return nil
+}
+
+// validateStakingTime checks the bounds of the staking time
+func validateStakingTime(minStakingTime, maxStakingTime uint32) error {
+	if minStakingTime == 0 {
+		return fmt.Errorf("minimum staking time has to be positive")
+	}
+
+	if minStakingTime > math.MaxUint16 {
+		return fmt.Errorf("minimum staking time cannot be greater than %d", math.MaxUint16)
+	}
+
+	if maxStakingTime == 0 {
+		return fmt.Errorf("maximum staking time has to be positive")
+	}
+
+	if maxStakingTime > math.MaxUint16 {
+		return fmt.Errorf("maximum staking time cannot be greater than %d", math.MaxUint16)
+	}
+
+	if minStakingTime > maxStakingTime {
+		return fmt.Errorf("minimum staking time cannot be greater than maximum staking time")
+	}
+
+	return nil
+}
+
+// validateStakingTxTag checks that the staking tx tag is either empty or a
+// hex-encoded tag of exactly btcstaking.TagLen bytes
+func validateStakingTxTag(tag string) error {
+	if len(tag) == 0 {
+		return nil
+	}
+
+	decoded, err := hex.DecodeString(tag)
+	if err != nil {
+		return fmt.Errorf("staking tx tag should be in valid hex format")
+	}
+
+	if len(decoded) != btcstaking.TagLen {
+		return fmt.Errorf("staking tx tag should have exactly %d bytes", btcstaking.TagLen)
+	}
+
+	return nil
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if p.CovenantQuorum == 0 {
@@ -156,6 +229,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateStakingAmount(p.MinStakingValueSat, p.MaxStakingValueSat); err != nil {
+		return err
+	}
+
+	if err := validateStakingTime(p.MinStakingTimeBlocks, p.MaxStakingTimeBlocks); err != nil {
+		return err
+	}
+
+	if err := validateStakingTxTag(p.StakingTxTag); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -182,6 +267,19 @@ func (p Params) MustGetSlashingAddress(btcParams *chaincfg.Params) btcutil.Addre
 	return slashingAddr
 }
 
+// StakingTxTagBytes returns the decoded staking tx tag, which is nil if
+// identifiable staking txs are not recognised
+func (p Params) StakingTxTagBytes() []byte {
+	if len(p.StakingTxTag) == 0 {
+		return nil
+	}
+	tag, err := hex.DecodeString(p.StakingTxTag)
+	if err != nil {
+		panic(fmt.Errorf("invalid staking tx tag in params: %w", err))
+	}
+	return tag
+}
+
 func (p Params) CovenantPksHex() []string {
 	covPksHex := make([]string, 0, len(p.CovenantPks))
 	for _, pk := range p.CovenantPks {
@@ -56,6 +56,21 @@ type Params struct {
 	// must be at least 90% of staking output, for staking request to be considered
 	// valid
 	MinUnbondingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=min_unbonding_rate,json=minUnbondingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_unbonding_rate"`
+	// min_staking_value_sat is the minimum of satoshis locked in staking output
+	MinStakingValueSat int64 `protobuf:"varint,10,opt,name=min_staking_value_sat,json=minStakingValueSat,proto3" json:"min_staking_value_sat,omitempty"`
+	// max_staking_value_sat is the maximum of satoshis locked in staking output
+	MaxStakingValueSat int64 `protobuf:"varint,11,opt,name=max_staking_value_sat,json=maxStakingValueSat,proto3" json:"max_staking_value_sat,omitempty"`
+	// min_staking_time_blocks is the minimum timelock of the staking output in BTC blocks
+	MinStakingTimeBlocks uint32 `protobuf:"varint,12,opt,name=min_staking_time_blocks,json=minStakingTimeBlocks,proto3" json:"min_staking_time_blocks,omitempty"`
+	// max_staking_time_blocks is the maximum timelock of the staking output in BTC blocks
+	MaxStakingTimeBlocks uint32 `protobuf:"varint,13,opt,name=max_staking_time_blocks,json=maxStakingTimeBlocks,proto3" json:"max_staking_time_blocks,omitempty"`
+	// staking_cap_sat is the maximum amount of satoshis that can be actively
+	// staked in the BTC staking protocol. 0 means there is no staking cap.
+	StakingCapSat uint64 `protobuf:"varint,14,opt,name=staking_cap_sat,json=stakingCapSat,proto3" json:"staking_cap_sat,omitempty"`
+	// staking_tx_tag is the 4-byte tag in hex format that identifies identifiable
+	// (V0) staking txs in their OP_RETURN output. Empty means identifiable
+	// staking txs are not recognised.
+	StakingTxTag string `protobuf:"bytes,15,opt,name=staking_tx_tag,json=stakingTxTag,proto3" json:"staking_tx_tag,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -125,6 +140,48 @@ func (m *Params) GetMinUnbondingTime() uint32 {
 	return 0
 }
 
+func (m *Params) GetMinStakingValueSat() int64 {
+	if m != nil {
+		return m.MinStakingValueSat
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStakingValueSat() int64 {
+	if m != nil {
+		return m.MaxStakingValueSat
+	}
+	return 0
+}
+
+func (m *Params) GetMinStakingTimeBlocks() uint32 {
+	if m != nil {
+		return m.MinStakingTimeBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStakingTimeBlocks() uint32 {
+	if m != nil {
+		return m.MaxStakingTimeBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetStakingCapSat() uint64 {
+	if m != nil {
+		return m.StakingCapSat
+	}
+	return 0
+}
+
+func (m *Params) GetStakingTxTag() string {
+	if m != nil {
+		return m.StakingTxTag
+	}
+	return ""
+}
+
 // StoredParams attach information about the version of stored parameters
 type StoredParams struct {
 	// version of the stored parameters. Each parameters update
@@ -191,42 +248,48 @@ func init() {
 }
 
 var fileDescriptor_8d1392776a3e15b9 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xbf, 0xe4, 0x4b, 0xe9, 0x34, 0xa5, 0x65, 0x00, 0x61, 0x82, 0xea, 0x44, 0x61, 0x41,
-	0x90, 0xc0, 0x26, 0x6d, 0xc5, 0x02, 0x56, 0x09, 0xa8, 0x12, 0xa2, 0x8b, 0xe0, 0x14, 0x24, 0xd8,
-	0x8c, 0xc6, 0xf6, 0xd4, 0x19, 0x25, 0x33, 0x13, 0x3c, 0x13, 0xcb, 0x7e, 0x0b, 0x96, 0x2c, 0x79,
-	0x08, 0x1e, 0xa2, 0xcb, 0x8a, 0x15, 0xea, 0x22, 0x42, 0xc9, 0x1b, 0xf0, 0x04, 0xc8, 0x63, 0x3b,
-	0xfc, 0x08, 0x09, 0xc4, 0xce, 0xf7, 0xdc, 0x73, 0xcf, 0xfd, 0xf1, 0x19, 0xd0, 0xf1, 0xb0, 0x97,
-	0x4e, 0x05, 0x77, 0x3c, 0xe5, 0x4b, 0x85, 0x27, 0x94, 0x87, 0x4e, 0xdc, 0x73, 0x66, 0x38, 0xc2,
-	0x4c, 0xda, 0xb3, 0x48, 0x28, 0x01, 0xaf, 0x17, 0x1c, 0xfb, 0x3b, 0xc7, 0x8e, 0x7b, 0xcd, 0x6b,
-	0xa1, 0x08, 0x85, 0x66, 0x38, 0xd9, 0x57, 0x4e, 0x6e, 0xde, 0xf4, 0x85, 0x64, 0x42, 0xa2, 0x3c,
-	0x91, 0x07, 0x79, 0xaa, 0xf3, 0xb5, 0x06, 0xea, 0x43, 0x2d, 0x0c, 0x5f, 0x83, 0x86, 0x2f, 0x62,
-	0xc2, 0x31, 0x57, 0x68, 0x36, 0x91, 0xa6, 0xd1, 0xae, 0x76, 0x1b, 0x83, 0x87, 0x17, 0x8b, 0xd6,
-	0x7e, 0x48, 0xd5, 0x78, 0xee, 0xd9, 0xbe, 0x60, 0x4e, 0xd1, 0xd7, 0x1f, 0x63, 0xca, 0xcb, 0xc0,
-	0x51, 0xe9, 0x8c, 0x48, 0x7b, 0xf0, 0x6c, 0x78, 0x70, 0xf8, 0x60, 0x38, 0xf7, 0x9e, 0x93, 0xd4,
-	0xdd, 0x2a, 0xb5, 0x86, 0x13, 0x09, 0xef, 0x80, 0x9d, 0xb5, 0xf4, 0xdb, 0xb9, 0x88, 0xe6, 0xcc,
-	0xfc, 0xaf, 0x6d, 0x74, 0xb7, 0xdd, 0xcb, 0x25, 0xfc, 0x42, 0xa3, 0xf0, 0x2e, 0xd8, 0x95, 0x53,
-	0x2c, 0xc7, 0x94, 0x87, 0x08, 0x07, 0x41, 0x44, 0xa4, 0x34, 0xab, 0x6d, 0xa3, 0xbb, 0xe9, 0xee,
-	0x94, 0x78, 0x3f, 0x87, 0xe1, 0x21, 0xb8, 0xc1, 0x28, 0x47, 0x6b, 0xba, 0x4a, 0xd0, 0x29, 0x21,
-	0x48, 0x62, 0x65, 0xd6, 0xda, 0x46, 0xb7, 0xea, 0x5e, 0x65, 0x94, 0x8f, 0x8a, 0xec, 0x49, 0x72,
-	0x44, 0xc8, 0x08, 0x2b, 0x38, 0x02, 0x19, 0x8c, 0x7c, 0xc1, 0x18, 0x95, 0x92, 0x0a, 0x8e, 0x22,
-	0xac, 0x88, 0xf9, 0x7f, 0xd6, 0x63, 0x70, 0xfb, 0x6c, 0xd1, 0xaa, 0x5c, 0x2c, 0x5a, 0xb7, 0xf2,
-	0x13, 0xc9, 0x60, 0x62, 0x53, 0xe1, 0x30, 0xac, 0xc6, 0xf6, 0x31, 0x09, 0xb1, 0x9f, 0x3e, 0x25,
-	0xbe, 0x7b, 0x85, 0x51, 0xfe, 0x64, 0x5d, 0xee, 0x62, 0x45, 0xe0, 0x2b, 0xb0, 0xbd, 0x1e, 0x43,
-	0xcb, 0xd5, 0xb5, 0x5c, 0xef, 0x2f, 0xe4, 0x3e, 0x7d, 0xbc, 0x0f, 0x8a, 0x1f, 0x92, 0x89, 0x37,
-	0x4a, 0x1d, 0xad, 0xdb, 0x07, 0x7b, 0x0c, 0x27, 0x08, 0xfb, 0x8a, 0xc6, 0x04, 0x9d, 0x52, 0x8e,
-	0xa7, 0x54, 0xa5, 0xd9, 0x6f, 0x8c, 0x69, 0x40, 0x22, 0x69, 0x6e, 0xe8, 0x23, 0x36, 0x19, 0x4e,
-	0xfa, 0x9a, 0x73, 0x54, 0x50, 0x86, 0x25, 0x03, 0xde, 0x03, 0x30, 0xdb, 0x77, 0xce, 0x3d, 0xc1,
-	0x03, 0x7d, 0x26, 0xca, 0x88, 0x79, 0x49, 0xd7, 0xed, 0x32, 0xca, 0x5f, 0x96, 0x89, 0x13, 0xca,
-	0x08, 0x44, 0xbf, 0xb2, 0xf5, 0x36, 0x9b, 0xff, 0xba, 0xcd, 0x4f, 0x0d, 0xb2, 0x8d, 0x1e, 0xd5,
-	0xde, 0x7f, 0x68, 0x55, 0x3a, 0x04, 0x34, 0x46, 0x4a, 0x44, 0x24, 0x28, 0x9c, 0x67, 0x82, 0x8d,
-	0x98, 0x44, 0xd9, 0x39, 0x4d, 0x43, 0x4f, 0x56, 0x86, 0xf0, 0x31, 0xa8, 0xe7, 0xb6, 0xd7, 0x7e,
-	0xd9, 0xda, 0xdf, 0xb3, 0x7f, 0xeb, 0x7b, 0x3b, 0x17, 0x1a, 0xd4, 0xb2, 0x19, 0xdd, 0xa2, 0x64,
-	0x70, 0x7c, 0xb6, 0xb4, 0x8c, 0xf3, 0xa5, 0x65, 0x7c, 0x59, 0x5a, 0xc6, 0xbb, 0x95, 0x55, 0x39,
-	0x5f, 0x59, 0x95, 0xcf, 0x2b, 0xab, 0xf2, 0xe6, 0x8f, 0x86, 0x4e, 0x7e, 0x7c, 0x7b, 0xda, 0xdd,
-	0x5e, 0x5d, 0x3f, 0x98, 0x83, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x91, 0xdb, 0xb2, 0x9e,
-	0x03, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8e, 0x7f, 0xcd, 0x2f, 0xa5, 0xdb, 0xa4, 0x29, 0xa6, 0x15, 0xa6, 0xa8, 0x49, 0x54, 0x10,
+	0x04, 0x09, 0x1c, 0xd2, 0x16, 0x0e, 0x70, 0x6a, 0x8a, 0x2a, 0x21, 0x7a, 0x08, 0x4e, 0xa8, 0x04,
+	0x97, 0xd5, 0xd8, 0xd9, 0x3a, 0xab, 0x64, 0x77, 0x83, 0x77, 0x13, 0x39, 0x6f, 0xc1, 0x81, 0x03,
+	0x47, 0x1e, 0x82, 0x87, 0xe8, 0xb1, 0xe2, 0x84, 0x7a, 0xa8, 0x50, 0xfb, 0x22, 0x68, 0xd7, 0x76,
+	0x0a, 0xa5, 0x12, 0x88, 0x9b, 0x3d, 0xf3, 0x7d, 0xdf, 0xcc, 0xec, 0xfc, 0x41, 0x1b, 0x3e, 0xf8,
+	0xd3, 0xa1, 0xe0, 0x0d, 0x5f, 0x05, 0x52, 0xc1, 0x80, 0xf2, 0xb0, 0x31, 0x69, 0x36, 0x46, 0x10,
+	0x01, 0x93, 0xee, 0x28, 0x12, 0x4a, 0xd8, 0xab, 0x29, 0xc6, 0xbd, 0xc0, 0xb8, 0x93, 0xe6, 0xda,
+	0x4a, 0x28, 0x42, 0x61, 0x10, 0x0d, 0xfd, 0x95, 0x80, 0xd7, 0x6e, 0x05, 0x42, 0x32, 0x21, 0x71,
+	0xe2, 0x48, 0x7e, 0x12, 0xd7, 0xc6, 0xc7, 0x79, 0x54, 0x68, 0x1b, 0x61, 0xfb, 0x2d, 0x2a, 0x06,
+	0x62, 0x42, 0x38, 0x70, 0x85, 0x47, 0x03, 0xe9, 0x58, 0xb5, 0xb9, 0x7a, 0xb1, 0xf5, 0xf4, 0xe4,
+	0xb4, 0xba, 0x19, 0x52, 0xd5, 0x1f, 0xfb, 0x6e, 0x20, 0x58, 0x23, 0x8d, 0x1b, 0xf4, 0x81, 0xf2,
+	0xec, 0xa7, 0xa1, 0xa6, 0x23, 0x22, 0xdd, 0xd6, 0xcb, 0xf6, 0xd6, 0xf6, 0xe3, 0xf6, 0xd8, 0x7f,
+	0x45, 0xa6, 0xde, 0x62, 0xa6, 0xd5, 0x1e, 0x48, 0xfb, 0x3e, 0x2a, 0xcf, 0xa4, 0xdf, 0x8f, 0x45,
+	0x34, 0x66, 0xce, 0x7f, 0x35, 0xab, 0x5e, 0xf2, 0x96, 0x32, 0xf3, 0x6b, 0x63, 0xb5, 0x1f, 0xa0,
+	0x65, 0x39, 0x04, 0xd9, 0xa7, 0x3c, 0xc4, 0xd0, 0xeb, 0x45, 0x44, 0x4a, 0x67, 0xae, 0x66, 0xd5,
+	0x17, 0xbc, 0x72, 0x66, 0xdf, 0x49, 0xcc, 0xf6, 0x36, 0xba, 0xc9, 0x28, 0xc7, 0x33, 0xb8, 0x8a,
+	0xf1, 0x21, 0x21, 0x58, 0x82, 0x72, 0xf2, 0x35, 0xab, 0x3e, 0xe7, 0xdd, 0x60, 0x94, 0x77, 0x52,
+	0x6f, 0x37, 0xde, 0x23, 0xa4, 0x03, 0xca, 0xee, 0x20, 0x6d, 0xc6, 0x81, 0x60, 0x8c, 0x4a, 0x49,
+	0x05, 0xc7, 0x11, 0x28, 0xe2, 0xfc, 0xaf, 0x63, 0xb4, 0xee, 0x1c, 0x9d, 0x56, 0x73, 0x27, 0xa7,
+	0xd5, 0xdb, 0xc9, 0x13, 0xc9, 0xde, 0xc0, 0xa5, 0xa2, 0xc1, 0x40, 0xf5, 0xdd, 0x7d, 0x12, 0x42,
+	0x30, 0x7d, 0x41, 0x02, 0xef, 0x3a, 0xa3, 0x7c, 0x77, 0x46, 0xf7, 0x40, 0x11, 0xfb, 0x00, 0x95,
+	0x66, 0x69, 0x18, 0xb9, 0x82, 0x91, 0x6b, 0xfe, 0x85, 0xdc, 0xd7, 0x2f, 0x8f, 0x50, 0xda, 0x10,
+	0x2d, 0x5e, 0xcc, 0x74, 0x8c, 0xee, 0x0e, 0x5a, 0x67, 0x10, 0x63, 0x08, 0x14, 0x9d, 0x10, 0x7c,
+	0x48, 0x39, 0x0c, 0xa9, 0x9a, 0xea, 0x36, 0x4e, 0x68, 0x8f, 0x44, 0xd2, 0x99, 0x37, 0x8f, 0xb8,
+	0xc6, 0x20, 0xde, 0x31, 0x98, 0xbd, 0x14, 0xd2, 0xce, 0x10, 0xf6, 0x43, 0x64, 0xeb, 0x7a, 0xc7,
+	0xdc, 0x17, 0xbc, 0x67, 0x9e, 0x89, 0x32, 0xe2, 0x5c, 0x33, 0xbc, 0x65, 0x46, 0xf9, 0x9b, 0xcc,
+	0xd1, 0xa5, 0x8c, 0xd8, 0xf8, 0x32, 0xda, 0x54, 0xb3, 0xf0, 0xaf, 0xd5, 0xfc, 0x12, 0xc0, 0x54,
+	0xd4, 0x44, 0xab, 0xa6, 0x69, 0xc9, 0xc4, 0xe2, 0x09, 0x0c, 0xc7, 0x49, 0xcb, 0x90, 0x69, 0x99,
+	0x8e, 0xde, 0x49, 0x7c, 0x07, 0xda, 0xa5, 0x3b, 0xa6, 0x29, 0x10, 0x5f, 0x41, 0x59, 0x4c, 0x29,
+	0x10, 0x5f, 0xa6, 0x3c, 0x49, 0x47, 0x23, 0xa5, 0xe8, 0x92, 0xb1, 0x3f, 0x14, 0xc1, 0x40, 0x3a,
+	0x45, 0x53, 0xf9, 0xca, 0x45, 0x1c, 0x5d, 0x77, 0xcb, 0xf8, 0x0c, 0x0d, 0xe2, 0x2b, 0x69, 0xa5,
+	0x94, 0x06, 0xf1, 0xef, 0xb4, 0x7b, 0xa8, 0x9c, 0x51, 0x02, 0x18, 0x99, 0xd4, 0x96, 0x6a, 0x56,
+	0x3d, 0xef, 0x95, 0x52, 0xf3, 0x2e, 0x8c, 0x74, 0x56, 0x77, 0xd1, 0xd2, 0x4c, 0x3a, 0xc6, 0x0a,
+	0x42, 0xa7, 0x6c, 0x26, 0xbb, 0x98, 0x5a, 0xbb, 0x71, 0x17, 0xc2, 0x67, 0xf9, 0x4f, 0x9f, 0xab,
+	0xb9, 0x0d, 0x82, 0x8a, 0x1d, 0x25, 0x22, 0xd2, 0x4b, 0x77, 0xd3, 0x41, 0xf3, 0x13, 0x12, 0xe9,
+	0x81, 0x73, 0x2c, 0x93, 0x4a, 0xf6, 0x6b, 0x3f, 0x47, 0x85, 0xe4, 0x30, 0x98, 0x8d, 0x5a, 0xdc,
+	0x5c, 0x77, 0xaf, 0xbc, 0x0c, 0x6e, 0x22, 0xd4, 0xca, 0xeb, 0x2e, 0x7a, 0x29, 0xa5, 0xb5, 0x7f,
+	0x74, 0x56, 0xb1, 0x8e, 0xcf, 0x2a, 0xd6, 0xf7, 0xb3, 0x8a, 0xf5, 0xe1, 0xbc, 0x92, 0x3b, 0x3e,
+	0xaf, 0xe4, 0xbe, 0x9d, 0x57, 0x72, 0xef, 0xfe, 0xb8, 0xf2, 0xf1, 0xcf, 0xd7, 0xc9, 0xec, 0xbf,
+	0x5f, 0x30, 0x27, 0x65, 0xeb, 0xc7, 0x00, 0x93, 0x84, 0x56, 0xb7, 0xc0, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -249,6 +312,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StakingTxTag) > 0 {
+		i -= len(m.StakingTxTag)
+		copy(dAtA[i:], m.StakingTxTag)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.StakingTxTag)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.StakingCapSat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StakingCapSat))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxStakingTimeBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStakingTimeBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MinStakingTimeBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinStakingTimeBlocks))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxStakingValueSat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStakingValueSat))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MinStakingValueSat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinStakingValueSat))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size := m.MinUnbondingRate.Size()
 		i -= size
@@ -406,6 +501,25 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinUnbondingRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.MinStakingValueSat != 0 {
+		n += 1 + sovParams(uint64(m.MinStakingValueSat))
+	}
+	if m.MaxStakingValueSat != 0 {
+		n += 1 + sovParams(uint64(m.MaxStakingValueSat))
+	}
+	if m.MinStakingTimeBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MinStakingTimeBlocks))
+	}
+	if m.MaxStakingTimeBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxStakingTimeBlocks))
+	}
+	if m.StakingCapSat != 0 {
+		n += 1 + sovParams(uint64(m.StakingCapSat))
+	}
+	l = len(m.StakingTxTag)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -703,6 +817,133 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakingValueSat", wireType)
+			}
+			m.MinStakingValueSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStakingValueSat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakingValueSat", wireType)
+			}
+			m.MaxStakingValueSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakingValueSat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakingTimeBlocks", wireType)
+			}
+			m.MinStakingTimeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStakingTimeBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakingTimeBlocks", wireType)
+			}
+			m.MaxStakingTimeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakingTimeBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCapSat", wireType)
+			}
+			m.StakingCapSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingCapSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -28,6 +28,10 @@ func NewBTCDelegationResponse(btcDel *BTCDelegation, status BTCDelegationStatus)
 		resp.SlashingTxHex = hex.EncodeToString(*btcDel.SlashingTx)
 	}
 
+	if btcDel.OpReturnInfo != nil {
+		resp.OpReturnTagHex = hex.EncodeToString(btcDel.OpReturnInfo.Tag)
+	}
+
 	if btcDel.BtcUndelegation != nil {
 		resp.UndelegationResponse = btcDel.BtcUndelegation.ToResponse()
 	}
@@ -68,5 +72,6 @@ func NewFinalityProviderResponse(f *FinalityProvider, bbnBlockHeight, votingPowe
 		SlashedBtcHeight:     f.SlashedBtcHeight,
 		Height:               bbnBlockHeight,
 		VotingPower:          votingPower,
+		Jailed:               f.Jailed,
 	}
 }
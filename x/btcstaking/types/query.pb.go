@@ -941,6 +941,222 @@ func (m *QueryActivatedHeightResponse) GetHeight() uint64 {
 	return 0
 }
 
+// QueryStakingCapRequest is the request type for the Query/StakingCap RPC method.
+type QueryStakingCapRequest struct {
+}
+
+func (m *QueryStakingCapRequest) Reset()         { *m = QueryStakingCapRequest{} }
+func (m *QueryStakingCapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingCapRequest) ProtoMessage()    {}
+func (*QueryStakingCapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{18}
+}
+func (m *QueryStakingCapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingCapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingCapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingCapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingCapRequest.Merge(m, src)
+}
+func (m *QueryStakingCapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingCapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingCapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingCapRequest proto.InternalMessageInfo
+
+// QueryStakingCapResponse is the response type for the Query/StakingCap RPC method.
+type QueryStakingCapResponse struct {
+	// staking_cap_sat is the maximum amount of satoshis that can be actively
+	// staked. 0 means there is no staking cap.
+	StakingCapSat uint64 `protobuf:"varint,1,opt,name=staking_cap_sat,json=stakingCapSat,proto3" json:"staking_cap_sat,omitempty"`
+	// total_active_stake_sat is the total amount of satoshis that are actively
+	// staked in the BTC staking protocol
+	TotalActiveStakeSat uint64 `protobuf:"varint,2,opt,name=total_active_stake_sat,json=totalActiveStakeSat,proto3" json:"total_active_stake_sat,omitempty"`
+	// remaining_cap_sat is the amount of satoshis that can still be staked
+	// before reaching the staking cap. It is 0 if there is no staking cap.
+	RemainingCapSat uint64 `protobuf:"varint,3,opt,name=remaining_cap_sat,json=remainingCapSat,proto3" json:"remaining_cap_sat,omitempty"`
+}
+
+func (m *QueryStakingCapResponse) Reset()         { *m = QueryStakingCapResponse{} }
+func (m *QueryStakingCapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingCapResponse) ProtoMessage()    {}
+func (*QueryStakingCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{19}
+}
+func (m *QueryStakingCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingCapResponse.Merge(m, src)
+}
+func (m *QueryStakingCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingCapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingCapResponse proto.InternalMessageInfo
+
+func (m *QueryStakingCapResponse) GetStakingCapSat() uint64 {
+	if m != nil {
+		return m.StakingCapSat
+	}
+	return 0
+}
+
+func (m *QueryStakingCapResponse) GetTotalActiveStakeSat() uint64 {
+	if m != nil {
+		return m.TotalActiveStakeSat
+	}
+	return 0
+}
+
+func (m *QueryStakingCapResponse) GetRemainingCapSat() uint64 {
+	if m != nil {
+		return m.RemainingCapSat
+	}
+	return 0
+}
+
+// QueryBTCDelegationsByTagRequest is the request type for the
+// Query/BTCDelegationsByTag RPC method.
+type QueryBTCDelegationsByTagRequest struct {
+	// tag_hex is the hex str of the 4-byte OP_RETURN tag
+	TagHex string `protobuf:"bytes,1,opt,name=tag_hex,json=tagHex,proto3" json:"tag_hex,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBTCDelegationsByTagRequest) Reset()         { *m = QueryBTCDelegationsByTagRequest{} }
+func (m *QueryBTCDelegationsByTagRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationsByTagRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationsByTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{20}
+}
+func (m *QueryBTCDelegationsByTagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationsByTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByTagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationsByTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByTagRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationsByTagRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationsByTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByTagRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationsByTagRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationsByTagRequest) GetTagHex() string {
+	if m != nil {
+		return m.TagHex
+	}
+	return ""
+}
+
+func (m *QueryBTCDelegationsByTagRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBTCDelegationsByTagResponse is the response type for the
+// Query/BTCDelegationsByTag RPC method.
+type QueryBTCDelegationsByTagResponse struct {
+	// btc_delegations contains all the BTC delegations carrying the given tag
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBTCDelegationsByTagResponse) Reset()         { *m = QueryBTCDelegationsByTagResponse{} }
+func (m *QueryBTCDelegationsByTagResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationsByTagResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationsByTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{21}
+}
+func (m *QueryBTCDelegationsByTagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationsByTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByTagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationsByTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByTagResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationsByTagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationsByTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByTagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationsByTagResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationsByTagResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
+	}
+	return nil
+}
+
+func (m *QueryBTCDelegationsByTagResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryFinalityProviderDelegationsRequest is the request type for the
 // Query/FinalityProviderDelegations RPC method.
 type QueryFinalityProviderDelegationsRequest struct {
@@ -958,7 +1174,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Reset() {
 func (m *QueryFinalityProviderDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderDelegationsRequest) ProtoMessage()    {}
 func (*QueryFinalityProviderDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{18}
+	return fileDescriptor_74d49d26f7429697, []int{22}
 }
 func (m *QueryFinalityProviderDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1016,7 +1232,7 @@ func (m *QueryFinalityProviderDelegationsResponse) Reset() {
 func (m *QueryFinalityProviderDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderDelegationsResponse) ProtoMessage()    {}
 func (*QueryFinalityProviderDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{19}
+	return fileDescriptor_74d49d26f7429697, []int{23}
 }
 func (m *QueryFinalityProviderDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1070,7 +1286,7 @@ func (m *QueryBTCDelegationRequest) Reset()         { *m = QueryBTCDelegationReq
 func (m *QueryBTCDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationRequest) ProtoMessage()    {}
 func (*QueryBTCDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{20}
+	return fileDescriptor_74d49d26f7429697, []int{24}
 }
 func (m *QueryBTCDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1117,7 +1333,7 @@ func (m *QueryBTCDelegationResponse) Reset()         { *m = QueryBTCDelegationRe
 func (m *QueryBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationResponse) ProtoMessage()    {}
 func (*QueryBTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{21}
+	return fileDescriptor_74d49d26f7429697, []int{25}
 }
 func (m *QueryBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1197,13 +1413,16 @@ type BTCDelegationResponse struct {
 	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,15,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
 	// params version used to validate delegation
 	ParamsVersion uint32 `protobuf:"varint,16,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// op_return_tag_hex is the hex str of the OP_RETURN tag of the staking tx,
+	// which is empty if the staking tx is not an identifiable staking tx
+	OpReturnTagHex string `protobuf:"bytes,17,opt,name=op_return_tag_hex,json=opReturnTagHex,proto3" json:"op_return_tag_hex,omitempty"`
 }
 
 func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
 func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegationResponse) ProtoMessage()    {}
 func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{22}
+	return fileDescriptor_74d49d26f7429697, []int{26}
 }
 func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1330,6 +1549,13 @@ func (m *BTCDelegationResponse) GetParamsVersion() uint32 {
 	return 0
 }
 
+func (m *BTCDelegationResponse) GetOpReturnTagHex() string {
+	if m != nil {
+		return m.OpReturnTagHex
+	}
+	return ""
+}
+
 // BTCUndelegationResponse provides all necessary info about the undeleagation
 type BTCUndelegationResponse struct {
 	// unbonding_tx is the transaction which will transfer the funds from staking
@@ -1362,7 +1588,7 @@ func (m *BTCUndelegationResponse) Reset()         { *m = BTCUndelegationResponse
 func (m *BTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*BTCUndelegationResponse) ProtoMessage()    {}
 func (*BTCUndelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{23}
+	return fileDescriptor_74d49d26f7429697, []int{27}
 }
 func (m *BTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1442,7 +1668,7 @@ func (m *BTCDelegatorDelegationsResponse) Reset()         { *m = BTCDelegatorDel
 func (m *BTCDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegationsResponse) ProtoMessage()    {}
 func (*BTCDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{24}
+	return fileDescriptor_74d49d26f7429697, []int{28}
 }
 func (m *BTCDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1504,13 +1730,15 @@ type FinalityProviderResponse struct {
 	Height uint64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
 	// voting_power is the voting power of this finality provider at the given height
 	VotingPower uint64 `protobuf:"varint,9,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// jailed defines whether the finality provider is jailed
+	Jailed bool `protobuf:"varint,10,opt,name=jailed,proto3" json:"jailed,omitempty"`
 }
 
 func (m *FinalityProviderResponse) Reset()         { *m = FinalityProviderResponse{} }
 func (m *FinalityProviderResponse) String() string { return proto.CompactTextString(m) }
 func (*FinalityProviderResponse) ProtoMessage()    {}
 func (*FinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{25}
+	return fileDescriptor_74d49d26f7429697, []int{29}
 }
 func (m *FinalityProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1588,6 +1816,13 @@ func (m *FinalityProviderResponse) GetVotingPower() uint64 {
 	return 0
 }
 
+func (m *FinalityProviderResponse) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
@@ -1607,6 +1842,10 @@ func init() {
 	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersAtHeightResponse")
 	proto.RegisterType((*QueryActivatedHeightRequest)(nil), "babylon.btcstaking.v1.QueryActivatedHeightRequest")
 	proto.RegisterType((*QueryActivatedHeightResponse)(nil), "babylon.btcstaking.v1.QueryActivatedHeightResponse")
+	proto.RegisterType((*QueryStakingCapRequest)(nil), "babylon.btcstaking.v1.QueryStakingCapRequest")
+	proto.RegisterType((*QueryStakingCapResponse)(nil), "babylon.btcstaking.v1.QueryStakingCapResponse")
+	proto.RegisterType((*QueryBTCDelegationsByTagRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByTagRequest")
+	proto.RegisterType((*QueryBTCDelegationsByTagResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByTagResponse")
 	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
 	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
 	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
@@ -1620,124 +1859,138 @@ func init() {
 func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
 
 var fileDescriptor_74d49d26f7429697 = []byte{
-	// 1870 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x6c, 0xdb, 0xc8,
-	0x19, 0x0e, 0x6d, 0x45, 0x89, 0x7f, 0xd9, 0x8e, 0x33, 0xeb, 0x24, 0x8c, 0x1c, 0xdb, 0x09, 0x9b,
-	0x4d, 0x9c, 0x97, 0x18, 0x2b, 0xde, 0x14, 0xed, 0x76, 0x37, 0xb1, 0xec, 0xdd, 0x24, 0xbb, 0x31,
-	0xa2, 0xd2, 0x49, 0x0b, 0x74, 0x8b, 0x12, 0x14, 0x39, 0xa2, 0x88, 0x58, 0x24, 0xc3, 0x19, 0xb9,
-	0x32, 0x02, 0x5f, 0x7a, 0xe8, 0xa5, 0x28, 0x50, 0xa0, 0xbd, 0xf6, 0xdc, 0x02, 0x3d, 0x36, 0xa7,
-	0x02, 0xbd, 0x6f, 0x6f, 0x8b, 0xec, 0xa1, 0xc5, 0x1e, 0x82, 0x22, 0x29, 0x5a, 0xa0, 0x40, 0xaf,
-	0x3d, 0x17, 0x9c, 0x19, 0x8a, 0x94, 0x44, 0xea, 0x61, 0xbb, 0x37, 0x71, 0xe6, 0x7f, 0x3f, 0xbe,
-	0x99, 0xf9, 0x05, 0x97, 0x6a, 0x46, 0x6d, 0x6f, 0xc7, 0x73, 0xd5, 0x1a, 0x35, 0x09, 0x35, 0x9e,
-	0x3b, 0xae, 0xad, 0xee, 0xae, 0xaa, 0x2f, 0x5a, 0x38, 0xd8, 0x2b, 0xf9, 0x81, 0x47, 0x3d, 0x74,
-	0x46, 0x90, 0x94, 0x62, 0x92, 0xd2, 0xee, 0x6a, 0x71, 0xde, 0xf6, 0x6c, 0x8f, 0x51, 0xa8, 0xe1,
-	0x2f, 0x4e, 0x5c, 0xbc, 0x60, 0x7b, 0x9e, 0xbd, 0x83, 0x55, 0xc3, 0x77, 0x54, 0xc3, 0x75, 0x3d,
-	0x6a, 0x50, 0xc7, 0x73, 0x89, 0xd8, 0x3d, 0x6f, 0x7a, 0xa4, 0xe9, 0x11, 0x9d, 0xb3, 0xf1, 0x0f,
-	0xb1, 0x75, 0x99, 0x7f, 0xa9, 0xb1, 0x11, 0x35, 0x4c, 0x8d, 0xd5, 0xe8, 0x5b, 0x50, 0x5d, 0x17,
-	0x54, 0x35, 0x83, 0x60, 0x6e, 0x64, 0x87, 0xd0, 0x37, 0x6c, 0xc7, 0x65, 0xda, 0x04, 0xad, 0x92,
-	0xee, 0x9a, 0x6f, 0x04, 0x46, 0x33, 0xd2, 0x7a, 0x25, 0x9d, 0x26, 0xe1, 0x29, 0xa7, 0x5b, 0xce,
-	0x90, 0xe5, 0xf9, 0x9c, 0x40, 0x99, 0x07, 0xf4, 0xfd, 0xd0, 0x9c, 0x2a, 0x93, 0xae, 0xe1, 0x17,
-	0x2d, 0x4c, 0xa8, 0xa2, 0xc1, 0x7b, 0x5d, 0xab, 0xc4, 0xf7, 0x5c, 0x82, 0xd1, 0x87, 0x90, 0xe7,
-	0x56, 0xc8, 0xd2, 0x45, 0x69, 0xa5, 0x50, 0x5e, 0x2c, 0xa5, 0x86, 0xb8, 0xc4, 0xd9, 0x2a, 0xb9,
-	0x2f, 0xdf, 0x2c, 0x1f, 0xd3, 0x04, 0x8b, 0xf2, 0x6d, 0x58, 0x48, 0xc8, 0xac, 0xec, 0xfd, 0x00,
-	0x07, 0xc4, 0xf1, 0x5c, 0xa1, 0x12, 0xc9, 0x70, 0x62, 0x97, 0xaf, 0x30, 0xe1, 0x33, 0x5a, 0xf4,
-	0xa9, 0x7c, 0x01, 0x17, 0xd2, 0x19, 0x8f, 0xc2, 0x2a, 0x1b, 0x16, 0x99, 0xf0, 0x4f, 0x1d, 0xd7,
-	0xd8, 0x71, 0xe8, 0x5e, 0x35, 0xf0, 0x76, 0x1d, 0x0b, 0x07, 0x51, 0x28, 0xd0, 0xa7, 0x00, 0x71,
-	0x86, 0x84, 0x86, 0x2b, 0x25, 0x51, 0x02, 0x61, 0x3a, 0x4b, 0xbc, 0xe6, 0x44, 0x3a, 0x4b, 0x55,
-	0xc3, 0xc6, 0x82, 0x57, 0x4b, 0x70, 0x2a, 0x7f, 0x91, 0x60, 0x29, 0x4b, 0x93, 0x70, 0xe4, 0x27,
-	0x80, 0xea, 0x62, 0x33, 0xac, 0x34, 0xbe, 0x2b, 0x4b, 0x17, 0x27, 0x57, 0x0a, 0x65, 0x35, 0xc3,
-	0xa9, 0x5e, 0x69, 0x91, 0x30, 0xed, 0x74, 0xbd, 0x57, 0x0f, 0x7a, 0xd0, 0xe5, 0xca, 0x04, 0x73,
-	0xe5, 0xea, 0x50, 0x57, 0x84, 0xbc, 0xa4, 0x2f, 0xeb, 0x22, 0x23, 0xfd, 0xca, 0x79, 0xcc, 0x2e,
-	0xc1, 0x4c, 0xdd, 0xd7, 0x6b, 0xd4, 0xd4, 0xfd, 0xe7, 0x7a, 0x03, 0xb7, 0x59, 0xd8, 0xa6, 0x34,
-	0xa8, 0xfb, 0x15, 0x6a, 0x56, 0x9f, 0x3f, 0xc4, 0x6d, 0x65, 0x3f, 0x23, 0xee, 0x9d, 0x60, 0xfc,
-	0x18, 0x4e, 0xf7, 0x05, 0x43, 0x84, 0x7f, 0xec, 0x58, 0xcc, 0xf5, 0xc6, 0x42, 0xf9, 0xbd, 0x04,
-	0x45, 0xa6, 0xbf, 0xf2, 0x74, 0x63, 0x13, 0xef, 0x60, 0x9b, 0xb7, 0x7b, 0xe4, 0x40, 0x05, 0xf2,
-	0x84, 0x1a, 0xb4, 0xc5, 0x4b, 0x6a, 0xb6, 0x7c, 0x3d, 0x43, 0x63, 0x17, 0xf7, 0x36, 0xe3, 0xd0,
-	0x04, 0x67, 0x4f, 0xe1, 0x4c, 0x1c, 0xb8, 0x70, 0xfe, 0x2c, 0x89, 0xc6, 0xe9, 0x35, 0x55, 0x04,
-	0xea, 0x19, 0x9c, 0x0a, 0x23, 0x6d, 0xc5, 0x5b, 0xa2, 0x64, 0x6e, 0x8e, 0x62, 0x74, 0x27, 0x46,
-	0xb3, 0x35, 0x6a, 0x26, 0xc4, 0x1f, 0x5d, 0xb1, 0xd4, 0xe1, 0x5a, 0x6a, 0xa6, 0xab, 0xde, 0x4f,
-	0x71, 0xb0, 0x4e, 0x1f, 0x62, 0xc7, 0x6e, 0xd0, 0xd1, 0x2b, 0x07, 0x9d, 0x85, 0x7c, 0x83, 0xf1,
-	0x30, 0xa3, 0x72, 0x9a, 0xf8, 0x52, 0x9e, 0xc0, 0xf5, 0x51, 0xf4, 0x88, 0xa8, 0x5d, 0x82, 0xe9,
-	0x5d, 0x8f, 0x3a, 0xae, 0xad, 0xfb, 0xe1, 0x3e, 0xd3, 0x93, 0xd3, 0x0a, 0x7c, 0x8d, 0xb1, 0x28,
-	0x5b, 0xb0, 0x92, 0x2a, 0x70, 0xa3, 0x15, 0x04, 0xd8, 0xa5, 0x8c, 0x68, 0x8c, 0x8a, 0xcf, 0x8a,
-	0x43, 0xb7, 0x38, 0x61, 0x5e, 0xec, 0xa4, 0x94, 0x74, 0xb2, 0xcf, 0xec, 0x89, 0x7e, 0xb3, 0x7f,
-	0x29, 0xc1, 0x0d, 0xa6, 0x68, 0xdd, 0xa4, 0xce, 0x2e, 0xee, 0x83, 0x9b, 0xde, 0x90, 0x67, 0xa9,
-	0x3a, 0xaa, 0xfa, 0xfd, 0xab, 0x04, 0x37, 0x47, 0xb3, 0xe7, 0x08, 0x61, 0xf0, 0x87, 0x0e, 0x6d,
-	0x6c, 0x61, 0x6a, 0xfc, 0x5f, 0x61, 0x70, 0x51, 0x34, 0x26, 0x73, 0xcc, 0xa0, 0xd8, 0xea, 0x0a,
-	0xac, 0x72, 0x57, 0xa0, 0x64, 0xdf, 0xf6, 0xe0, 0x1c, 0x2b, 0xbf, 0x91, 0xe0, 0x6a, 0x6a, 0xa5,
-	0xa4, 0x00, 0xd5, 0x08, 0xfd, 0x72, 0x54, 0x79, 0xfc, 0x97, 0x94, 0xd1, 0x0f, 0x69, 0xa0, 0x14,
-	0xc0, 0xf9, 0x04, 0x28, 0x79, 0x41, 0x0a, 0x3c, 0xdd, 0x1d, 0x0a, 0x4f, 0x5e, 0x9a, 0x68, 0xed,
-	0x5c, 0x0c, 0x54, 0x5d, 0x04, 0x47, 0x97, 0xd7, 0xcf, 0xe0, 0x7c, 0x3f, 0xe0, 0x46, 0x11, 0xbf,
-	0x05, 0xef, 0x09, 0x63, 0x75, 0xda, 0xd6, 0x1b, 0x06, 0x69, 0x24, 0xe2, 0x3e, 0x27, 0xb6, 0x9e,
-	0xb6, 0x1f, 0x1a, 0xa4, 0x11, 0x76, 0xfd, 0x8b, 0xb4, 0x73, 0xa6, 0x13, 0xa6, 0x6d, 0x98, 0xed,
-	0xc6, 0x6e, 0x71, 0xc2, 0x8d, 0x07, 0xdd, 0x33, 0x5d, 0xd0, 0xad, 0x7c, 0x9d, 0x87, 0x33, 0xe9,
-	0xea, 0xbe, 0x03, 0x85, 0x50, 0x18, 0x0e, 0x74, 0xc3, 0xb2, 0x38, 0xe6, 0x4d, 0x55, 0xe4, 0xd7,
-	0xaf, 0x6e, 0xcd, 0x8b, 0x28, 0xad, 0x5b, 0x56, 0x80, 0x09, 0xd9, 0xa6, 0x81, 0xe3, 0xda, 0x1a,
-	0x70, 0xe2, 0x70, 0x11, 0x6d, 0x41, 0x9e, 0x57, 0x19, 0x0b, 0xec, 0x74, 0xe5, 0xee, 0x37, 0x6f,
-	0x96, 0xcb, 0xb6, 0x43, 0x1b, 0xad, 0x5a, 0xc9, 0xf4, 0x9a, 0xaa, 0xb0, 0xd7, 0x6c, 0x18, 0x8e,
-	0x1b, 0x7d, 0xa8, 0x74, 0xcf, 0xc7, 0xa4, 0x54, 0x79, 0x54, 0xbd, 0xb3, 0x76, 0xbb, 0xda, 0xaa,
-	0x7d, 0x8e, 0xf7, 0xb4, 0xe3, 0xb5, 0xb0, 0x2e, 0xd1, 0x17, 0x30, 0x1b, 0xd7, 0xed, 0x8e, 0x43,
-	0xa8, 0x3c, 0x79, 0x71, 0xf2, 0x10, 0x62, 0x0b, 0xa2, 0xe0, 0x1f, 0x3b, 0xac, 0x29, 0xa6, 0x09,
-	0x35, 0x02, 0xaa, 0x8b, 0xf6, 0xca, 0x71, 0x90, 0x64, 0x6b, 0xbc, 0x07, 0xd1, 0x22, 0x00, 0x76,
-	0xad, 0x88, 0xe0, 0x38, 0x23, 0x98, 0xc2, 0xae, 0x68, 0x51, 0xb4, 0x00, 0x53, 0xd4, 0xa3, 0xc6,
-	0x8e, 0x4e, 0x0c, 0x2a, 0xe7, 0xd9, 0xee, 0x49, 0xb6, 0xb0, 0x6d, 0x50, 0x74, 0x19, 0x66, 0x93,
-	0x15, 0x80, 0xdb, 0xf2, 0x09, 0x96, 0xfc, 0xe9, 0x38, 0xf9, 0xb8, 0x8d, 0xae, 0xc0, 0x29, 0xb2,
-	0x63, 0x90, 0x46, 0x82, 0xec, 0x24, 0x23, 0x9b, 0x89, 0x96, 0x39, 0xdd, 0x07, 0x70, 0x2e, 0xee,
-	0x12, 0xb6, 0xa5, 0x13, 0xc7, 0x66, 0xf4, 0x53, 0x8c, 0x7e, 0xbe, 0xb3, 0xbd, 0x1d, 0xee, 0x6e,
-	0x3b, 0x76, 0xc8, 0xf6, 0x0c, 0x66, 0x4c, 0x6f, 0x17, 0xbb, 0x86, 0x4b, 0x43, 0x7a, 0x22, 0x03,
-	0x6b, 0xaa, 0xdb, 0x19, 0x85, 0xb3, 0x21, 0x68, 0xd7, 0x2d, 0xc3, 0x0f, 0x25, 0x39, 0xb6, 0x6b,
-	0xd0, 0x56, 0x80, 0x89, 0x36, 0x1d, 0x89, 0xd9, 0x76, 0x6c, 0x82, 0x6e, 0x02, 0x8a, 0x7c, 0xf3,
-	0x5a, 0xd4, 0x6f, 0x51, 0xdd, 0xb1, 0xda, 0x72, 0x81, 0x5d, 0xc8, 0xa3, 0xe2, 0x7e, 0xc2, 0x36,
-	0x1e, 0x59, 0xec, 0x28, 0x36, 0x18, 0xa8, 0xcb, 0xd3, 0x17, 0xa5, 0x95, 0x93, 0x9a, 0xf8, 0x42,
-	0xcb, 0xac, 0xce, 0x68, 0x8b, 0xe8, 0x16, 0x26, 0xa6, 0x3c, 0xc3, 0x31, 0x89, 0x2f, 0x6d, 0x62,
-	0x62, 0xa2, 0xf7, 0x61, 0xb6, 0xe5, 0xd6, 0x3c, 0xd7, 0x62, 0xd1, 0x71, 0x9a, 0x58, 0x9e, 0x65,
-	0x2a, 0x66, 0x3a, 0xab, 0x4f, 0x9d, 0x26, 0x46, 0x26, 0x9c, 0x69, 0xb9, 0x71, 0x73, 0xe8, 0x81,
-	0x28, 0x64, 0xf9, 0x14, 0xeb, 0x92, 0x52, 0x76, 0x97, 0x3c, 0x4b, 0xb0, 0x75, 0xfa, 0x64, 0xbe,
-	0x95, 0xb2, 0x1a, 0xda, 0xc2, 0xdf, 0x02, 0x7a, 0xf4, 0xfe, 0x98, 0xe3, 0xb6, 0xf0, 0x55, 0xf1,
-	0xda, 0x50, 0x5e, 0x4d, 0xc2, 0xb9, 0x0c, 0xc1, 0x68, 0x05, 0xe6, 0x12, 0xee, 0xb4, 0x13, 0x80,
-	0x10, 0xbb, 0xc9, 0xb3, 0xfd, 0x11, 0x2c, 0xc4, 0xd9, 0x8e, 0x79, 0xa2, 0x8c, 0x4f, 0x30, 0x26,
-	0xb9, 0x43, 0xf2, 0x2c, 0xa2, 0x10, 0x59, 0x37, 0x61, 0xa1, 0x93, 0xf5, 0x6e, 0xee, 0x4e, 0x0f,
-	0x15, 0xca, 0x97, 0x33, 0xc2, 0xd2, 0x49, 0xfa, 0x23, 0xb7, 0xee, 0x69, 0x72, 0x24, 0x28, 0xa9,
-	0x83, 0xb5, 0x4f, 0x4a, 0xe5, 0xe6, 0xd2, 0x2a, 0xf7, 0x43, 0x28, 0xf6, 0x54, 0x6e, 0xd2, 0x95,
-	0xe3, 0x8c, 0xe5, 0x5c, 0x77, 0xf1, 0xc6, 0x9e, 0xd4, 0xe1, 0x6c, 0x5c, 0xbf, 0x09, 0x5e, 0x22,
-	0xe7, 0x0f, 0x58, 0xc8, 0xf3, 0x9d, 0x42, 0x8e, 0x35, 0x11, 0xc5, 0x84, 0xe5, 0x21, 0x07, 0x0a,
-	0xba, 0x0f, 0x39, 0x0b, 0xef, 0x1c, 0xec, 0xd6, 0xcc, 0x38, 0x95, 0x5f, 0xe4, 0x40, 0xce, 0x7c,
-	0xc8, 0x7c, 0x02, 0x85, 0xb0, 0x0b, 0x02, 0xc7, 0x4f, 0x00, 0xfc, 0xb7, 0xa2, 0x73, 0x29, 0xd6,
-	0xc0, 0x0f, 0xa5, 0xcd, 0x98, 0x54, 0x4b, 0xf2, 0xa1, 0x2d, 0x00, 0xd3, 0x6b, 0x36, 0x1d, 0x42,
-	0xa2, 0xd3, 0x6d, 0xaa, 0x72, 0xeb, 0x9b, 0x37, 0xcb, 0x0b, 0x5c, 0x10, 0xb1, 0x9e, 0x97, 0x1c,
-	0x4f, 0x6d, 0x1a, 0xb4, 0x51, 0x7a, 0x8c, 0x6d, 0xc3, 0xdc, 0xdb, 0xc4, 0xe6, 0xeb, 0x57, 0xb7,
-	0x40, 0xe8, 0xd9, 0xc4, 0xa6, 0x96, 0x10, 0x80, 0x6e, 0x42, 0x8e, 0x9d, 0x01, 0x93, 0x43, 0xce,
-	0x00, 0x46, 0x95, 0x40, 0xff, 0xdc, 0x51, 0xa0, 0xff, 0x47, 0x30, 0xe9, 0x7b, 0x3e, 0x2b, 0x91,
-	0x42, 0xf9, 0x46, 0xd6, 0x73, 0x3d, 0xf0, 0xbc, 0xfa, 0x93, 0x7a, 0xd5, 0x23, 0x04, 0x33, 0x9b,
-	0x2b, 0x4f, 0x37, 0xb4, 0x90, 0x0f, 0xad, 0xc1, 0x59, 0x56, 0x32, 0xd8, 0xd2, 0x05, 0x6b, 0x04,
-	0xe4, 0x1c, 0xaa, 0xe7, 0xc5, 0x6e, 0x85, 0x6f, 0x0a, 0x4c, 0x0f, 0xa1, 0x2d, 0xe2, 0xa2, 0x66,
-	0xc4, 0x71, 0x82, 0x71, 0xcc, 0x45, 0x1c, 0xd4, 0x14, 0xd4, 0xf1, 0xe5, 0xec, 0xe4, 0xc0, 0x0b,
-	0xf8, 0x54, 0xdf, 0x05, 0xbc, 0xfc, 0xdb, 0xd3, 0x70, 0x9c, 0x9d, 0xf9, 0xe8, 0xe7, 0x12, 0xe4,
-	0xf9, 0xd4, 0x01, 0x5d, 0xcb, 0xf0, 0xb2, 0x7f, 0xf8, 0x52, 0xbc, 0x3e, 0x0a, 0x29, 0x2f, 0x2e,
-	0xe5, 0xfd, 0x9f, 0x7d, 0xfd, 0x8f, 0x5f, 0x4f, 0x2c, 0xa3, 0x45, 0x75, 0xd0, 0xd0, 0x08, 0xfd,
-	0x41, 0x82, 0x53, 0x3d, 0xe3, 0x13, 0x54, 0x1e, 0xae, 0xa6, 0x77, 0x48, 0x53, 0xbc, 0x33, 0x16,
-	0x8f, 0xb0, 0x51, 0x65, 0x36, 0x5e, 0x43, 0x57, 0x07, 0xda, 0xa8, 0xbe, 0x14, 0xf0, 0xbb, 0x8f,
-	0xfe, 0x28, 0xc1, 0xe9, 0xbe, 0x67, 0x02, 0x5a, 0x1b, 0xa4, 0x3b, 0x6b, 0x7c, 0x53, 0xfc, 0x60,
-	0x4c, 0x2e, 0x61, 0xf3, 0x2a, 0xb3, 0xf9, 0x06, 0xba, 0x96, 0x61, 0x73, 0xff, 0x03, 0x05, 0xbd,
-	0x96, 0x60, 0xae, 0x57, 0x20, 0xba, 0x33, 0x8e, 0xfa, 0xc8, 0xe6, 0xb5, 0xf1, 0x98, 0x84, 0xc9,
-	0xdb, 0xcc, 0xe4, 0x2d, 0xf4, 0xf9, 0xc8, 0x26, 0xab, 0x2f, 0xbb, 0xde, 0x0e, 0xfb, 0xfd, 0x24,
-	0xe8, 0x77, 0x12, 0xcc, 0x76, 0xcf, 0x1d, 0xd0, 0xea, 0x20, 0xeb, 0x52, 0xc7, 0x29, 0xc5, 0xf2,
-	0x38, 0x2c, 0xc2, 0x9d, 0x12, 0x73, 0x67, 0x05, 0x5d, 0x51, 0x33, 0x47, 0x9d, 0xc9, 0x47, 0x05,
-	0xfa, 0xa7, 0x04, 0xcb, 0x43, 0x5e, 0x98, 0xa8, 0x32, 0xc8, 0x8e, 0xd1, 0x9e, 0xcb, 0xc5, 0x8d,
-	0x43, 0xc9, 0x10, 0xce, 0x7d, 0x97, 0x39, 0xb7, 0x86, 0xca, 0x63, 0xe4, 0x8a, 0x03, 0xd0, 0x3e,
-	0xfa, 0xaf, 0x04, 0x8b, 0x03, 0x67, 0x1c, 0xe8, 0xfe, 0x38, 0xf5, 0x93, 0x36, 0x86, 0x29, 0xae,
-	0x1f, 0x42, 0x82, 0x70, 0xb1, 0xca, 0x5c, 0xfc, 0x0c, 0x3d, 0x3c, 0x78, 0x39, 0x32, 0x84, 0x8d,
-	0x1d, 0xff, 0xb7, 0x04, 0x17, 0x06, 0x0d, 0x4f, 0xd0, 0xbd, 0x71, 0xac, 0x4e, 0x99, 0xe2, 0x14,
-	0xef, 0x1f, 0x5c, 0x80, 0xf0, 0xfa, 0x01, 0xf3, 0x7a, 0x1d, 0xdd, 0x3b, 0xa4, 0xd7, 0x0c, 0xb1,
-	0x7b, 0x06, 0x07, 0x83, 0x11, 0x3b, 0x7d, 0x08, 0x31, 0x18, 0xb1, 0x33, 0x26, 0x13, 0x43, 0x11,
-	0xdb, 0x88, 0xf8, 0xc4, 0x29, 0x8a, 0xfe, 0x23, 0xc1, 0xc2, 0x80, 0xb1, 0x00, 0xfa, 0x78, 0x9c,
-	0xc0, 0xa6, 0x00, 0xc8, 0xbd, 0x03, 0xf3, 0x0b, 0x8f, 0xb6, 0x98, 0x47, 0x0f, 0xd0, 0x27, 0x07,
-	0xcf, 0x4b, 0x12, 0x6c, 0xfe, 0x24, 0xc1, 0x4c, 0x17, 0x6e, 0xa1, 0xdb, 0x23, 0x43, 0x5c, 0xe4,
-	0xd3, 0xea, 0x18, 0x1c, 0xc2, 0x8b, 0x4d, 0xe6, 0xc5, 0xc7, 0xe8, 0x7b, 0xa3, 0x61, 0xa2, 0xfa,
-	0x32, 0x65, 0x52, 0xb1, 0x5f, 0x79, 0xfc, 0xe5, 0xdb, 0x25, 0xe9, 0xab, 0xb7, 0x4b, 0xd2, 0xdf,
-	0xdf, 0x2e, 0x49, 0xbf, 0x7a, 0xb7, 0x74, 0xec, 0xab, 0x77, 0x4b, 0xc7, 0xfe, 0xf6, 0x6e, 0xe9,
-	0xd8, 0x8f, 0x86, 0x5e, 0xe9, 0xda, 0x49, 0x85, 0xec, 0x7e, 0x57, 0xcb, 0xb3, 0xff, 0x91, 0xee,
-	0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x92, 0xc6, 0x90, 0x35, 0x91, 0x1b, 0x00, 0x00,
+	// 2089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4d, 0x6c, 0x1c, 0x49,
+	0x15, 0x4e, 0xc7, 0x93, 0x49, 0xfc, 0xfc, 0x13, 0xbb, 0xe2, 0xd8, 0x93, 0x71, 0x6c, 0x27, 0x4d,
+	0x36, 0xb1, 0x9d, 0x78, 0x3a, 0x1e, 0x7b, 0x13, 0x2d, 0xcb, 0x6e, 0xe2, 0xb1, 0x37, 0x3f, 0xbb,
+	0xb1, 0x62, 0xda, 0x0e, 0x48, 0x2c, 0xa2, 0x55, 0xd3, 0x5d, 0xee, 0x69, 0xe2, 0xe9, 0xee, 0x74,
+	0xd7, 0x98, 0x19, 0x45, 0x3e, 0xb0, 0x07, 0x6e, 0x48, 0x48, 0x20, 0x71, 0x87, 0x03, 0x48, 0x1c,
+	0xc9, 0x09, 0x89, 0x0b, 0x17, 0x96, 0xdb, 0x2a, 0x1c, 0x40, 0x7b, 0x88, 0x50, 0x82, 0x40, 0x42,
+	0xe2, 0xca, 0x11, 0xa1, 0xae, 0xaa, 0x9e, 0xee, 0x99, 0xe9, 0x1e, 0xcf, 0x38, 0xde, 0x03, 0x37,
+	0x77, 0xbd, 0xbf, 0xef, 0xbd, 0xfa, 0xea, 0xd5, 0xd4, 0x33, 0x5c, 0x2e, 0xe3, 0x72, 0x63, 0xcf,
+	0xb1, 0x95, 0x32, 0xd5, 0x7d, 0x8a, 0x9f, 0x5a, 0xb6, 0xa9, 0xec, 0x2f, 0x2b, 0xcf, 0x6a, 0xc4,
+	0x6b, 0x14, 0x5c, 0xcf, 0xa1, 0x0e, 0x3a, 0x2f, 0x54, 0x0a, 0x91, 0x4a, 0x61, 0x7f, 0x39, 0x3f,
+	0x61, 0x3a, 0xa6, 0xc3, 0x34, 0x94, 0xe0, 0x2f, 0xae, 0x9c, 0xbf, 0x68, 0x3a, 0x8e, 0xb9, 0x47,
+	0x14, 0xec, 0x5a, 0x0a, 0xb6, 0x6d, 0x87, 0x62, 0x6a, 0x39, 0xb6, 0x2f, 0xa4, 0x17, 0x74, 0xc7,
+	0xaf, 0x3a, 0xbe, 0xc6, 0xcd, 0xf8, 0x87, 0x10, 0x5d, 0xe1, 0x5f, 0x4a, 0x04, 0xa2, 0x4c, 0x28,
+	0x5e, 0x0e, 0xbf, 0x85, 0xd6, 0xa2, 0xd0, 0x2a, 0x63, 0x9f, 0x70, 0x90, 0x4d, 0x45, 0x17, 0x9b,
+	0x96, 0xcd, 0xa2, 0x09, 0x5d, 0x39, 0x39, 0x35, 0x17, 0x7b, 0xb8, 0x1a, 0x46, 0xbd, 0x9a, 0xac,
+	0x13, 0x7d, 0x09, 0xbd, 0xb9, 0x14, 0x5f, 0x8e, 0xcb, 0x15, 0xe4, 0x09, 0x40, 0xdf, 0x0c, 0xe0,
+	0x6c, 0x31, 0xef, 0x2a, 0x79, 0x56, 0x23, 0x3e, 0x95, 0x55, 0x38, 0xd7, 0xb2, 0xea, 0xbb, 0x8e,
+	0xed, 0x13, 0xf4, 0x3e, 0x64, 0x39, 0x8a, 0x9c, 0x74, 0x49, 0x9a, 0x1f, 0x2a, 0xce, 0x14, 0x12,
+	0x4b, 0x5c, 0xe0, 0x66, 0xa5, 0xcc, 0xe7, 0xaf, 0xe6, 0x4e, 0xa8, 0xc2, 0x44, 0xbe, 0x0d, 0xd3,
+	0x31, 0x9f, 0xa5, 0xc6, 0xb7, 0x88, 0xe7, 0x5b, 0x8e, 0x2d, 0x42, 0xa2, 0x1c, 0x9c, 0xde, 0xe7,
+	0x2b, 0xcc, 0xf9, 0x88, 0x1a, 0x7e, 0xca, 0x9f, 0xc2, 0xc5, 0x64, 0xc3, 0xe3, 0x40, 0x65, 0xc2,
+	0x0c, 0x73, 0x7e, 0xcf, 0xb2, 0xf1, 0x9e, 0x45, 0x1b, 0x5b, 0x9e, 0xb3, 0x6f, 0x19, 0xc4, 0x0b,
+	0x4b, 0x81, 0xee, 0x01, 0x44, 0x3b, 0x24, 0x22, 0x5c, 0x2d, 0x08, 0x0a, 0x04, 0xdb, 0x59, 0xe0,
+	0x9c, 0x13, 0xdb, 0x59, 0xd8, 0xc2, 0x26, 0x11, 0xb6, 0x6a, 0xcc, 0x52, 0xfe, 0x93, 0x04, 0xb3,
+	0x69, 0x91, 0x44, 0x22, 0xdf, 0x03, 0xb4, 0x2b, 0x84, 0x9a, 0x1b, 0x4a, 0x73, 0xd2, 0xa5, 0x81,
+	0xf9, 0xa1, 0xa2, 0x92, 0x92, 0x54, 0xbb, 0xb7, 0xd0, 0x99, 0x3a, 0xbe, 0xdb, 0x1e, 0x07, 0xdd,
+	0x6f, 0x49, 0xe5, 0x24, 0x4b, 0xe5, 0xda, 0xa1, 0xa9, 0x08, 0x7f, 0xf1, 0x5c, 0xd6, 0xc4, 0x8e,
+	0x74, 0x06, 0xe7, 0x35, 0xbb, 0x0c, 0x23, 0xbb, 0xae, 0x56, 0xa6, 0xba, 0xe6, 0x3e, 0xd5, 0x2a,
+	0xa4, 0xce, 0xca, 0x36, 0xa8, 0xc2, 0xae, 0x5b, 0xa2, 0xfa, 0xd6, 0xd3, 0x07, 0xa4, 0x2e, 0x1f,
+	0xa4, 0xd4, 0xbd, 0x59, 0x8c, 0xef, 0xc2, 0x78, 0x47, 0x31, 0x44, 0xf9, 0xfb, 0xae, 0xc5, 0x58,
+	0x7b, 0x2d, 0xe4, 0x5f, 0x4b, 0x90, 0x67, 0xf1, 0x4b, 0x3b, 0xeb, 0x1b, 0x64, 0x8f, 0x98, 0xfc,
+	0xb8, 0x87, 0x09, 0x94, 0x20, 0xeb, 0x53, 0x4c, 0x6b, 0x9c, 0x52, 0xa3, 0xc5, 0xc5, 0x94, 0x88,
+	0x2d, 0xd6, 0xdb, 0xcc, 0x42, 0x15, 0x96, 0xe8, 0x5e, 0x42, 0xb5, 0x8f, 0x42, 0x9c, 0xdf, 0x4b,
+	0xe2, 0xe0, 0xb4, 0x43, 0x15, 0x85, 0x7a, 0x02, 0x67, 0x83, 0x4a, 0x1b, 0x91, 0x48, 0x50, 0xe6,
+	0x46, 0x2f, 0xa0, 0x9b, 0x35, 0x1a, 0x2d, 0x53, 0x3d, 0xe6, 0xfe, 0xf8, 0xc8, 0xb2, 0x0b, 0x0b,
+	0x89, 0x3b, 0xbd, 0xe5, 0xfc, 0x80, 0x78, 0x6b, 0xf4, 0x01, 0xb1, 0xcc, 0x0a, 0xed, 0x9d, 0x39,
+	0x68, 0x12, 0xb2, 0x15, 0x66, 0xc3, 0x40, 0x65, 0x54, 0xf1, 0x25, 0x3f, 0x86, 0xc5, 0x5e, 0xe2,
+	0x88, 0xaa, 0x5d, 0x86, 0xe1, 0x7d, 0x87, 0x5a, 0xb6, 0xa9, 0xb9, 0x81, 0x9c, 0xc5, 0xc9, 0xa8,
+	0x43, 0x7c, 0x8d, 0x99, 0xc8, 0x9b, 0x30, 0x9f, 0xe8, 0x70, 0xbd, 0xe6, 0x79, 0xc4, 0xa6, 0x4c,
+	0xa9, 0x0f, 0xc6, 0xa7, 0xd5, 0xa1, 0xd5, 0x9d, 0x80, 0x17, 0x25, 0x29, 0xc5, 0x93, 0xec, 0x80,
+	0x7d, 0xb2, 0x13, 0xf6, 0x8f, 0x25, 0xb8, 0xce, 0x02, 0xad, 0xe9, 0xd4, 0xda, 0x27, 0xed, 0xe1,
+	0xfc, 0xf6, 0x92, 0xa7, 0x85, 0x3a, 0x2e, 0xfe, 0xfe, 0x45, 0x82, 0x1b, 0xbd, 0xe1, 0x39, 0xc6,
+	0x36, 0xf8, 0x6d, 0x8b, 0x56, 0x36, 0x09, 0xc5, 0x5f, 0x69, 0x1b, 0x9c, 0x81, 0xe9, 0x28, 0x31,
+	0x4c, 0x89, 0xd1, 0x52, 0x58, 0xf9, 0x16, 0x5c, 0x4c, 0x16, 0x77, 0xdf, 0x63, 0x39, 0x07, 0x93,
+	0xcc, 0x6e, 0x9b, 0xa7, 0xb7, 0x8e, 0xdd, 0xd0, 0xe3, 0x2f, 0x24, 0x98, 0xea, 0x10, 0x09, 0x6f,
+	0x57, 0xe1, 0xac, 0xa8, 0x87, 0xa6, 0x63, 0x57, 0xf3, 0x71, 0xe8, 0x76, 0xc4, 0x6f, 0x2a, 0x6f,
+	0x63, 0x8a, 0x56, 0x60, 0x92, 0x3a, 0x14, 0xef, 0x69, 0x98, 0x6d, 0x87, 0x16, 0x48, 0x09, 0x53,
+	0xe7, 0x5c, 0x3a, 0xc7, 0xa4, 0x7c, 0xaf, 0x82, 0x30, 0x24, 0x30, 0x5a, 0x84, 0x71, 0x8f, 0x54,
+	0xb1, 0x65, 0xc7, 0xdd, 0x0f, 0x30, 0xfd, 0xb3, 0x4d, 0x01, 0x0f, 0x20, 0x7f, 0x26, 0xc1, 0x5c,
+	0x42, 0xbf, 0x2a, 0x35, 0x76, 0xb0, 0x19, 0x72, 0x6e, 0x0a, 0x4e, 0x53, 0x6c, 0xc6, 0x0e, 0x4a,
+	0x96, 0x62, 0x33, 0x38, 0xdc, 0xc7, 0x45, 0xba, 0x3f, 0x48, 0x70, 0x29, 0x1d, 0xc4, 0xff, 0x49,
+	0xe7, 0xfc, 0x99, 0x04, 0xd7, 0x12, 0x5b, 0x46, 0xc2, 0x8d, 0xd5, 0x43, 0xe3, 0x3c, 0xae, 0xda,
+	0xfe, 0x53, 0x82, 0xf9, 0xc3, 0x61, 0x89, 0x1a, 0x7b, 0x70, 0x21, 0x56, 0x63, 0xc7, 0x4b, 0xa8,
+	0xf6, 0xad, 0x43, 0xab, 0xed, 0x24, 0xb9, 0x56, 0xa7, 0xa2, 0xba, 0x3b, 0xde, 0x57, 0xb2, 0x01,
+	0x1f, 0xc3, 0x85, 0x4e, 0x12, 0x85, 0x15, 0x5f, 0x82, 0x73, 0xe1, 0x81, 0xa3, 0x75, 0xad, 0x82,
+	0xfd, 0x4a, 0xac, 0xee, 0x63, 0x42, 0xb4, 0x53, 0x7f, 0x80, 0xfd, 0x4a, 0xd0, 0xfe, 0x9f, 0x25,
+	0xfd, 0xe0, 0x68, 0x96, 0x69, 0x1b, 0x46, 0x5b, 0xa9, 0x28, 0x7e, 0xea, 0xf4, 0xc7, 0xc4, 0x91,
+	0x16, 0x26, 0xca, 0xff, 0xcd, 0xc2, 0xf9, 0xe4, 0x70, 0xef, 0xc1, 0x10, 0x3b, 0xf7, 0x9e, 0x86,
+	0x0d, 0x83, 0x5f, 0x7e, 0x83, 0xa5, 0xdc, 0xcb, 0x17, 0x4b, 0x13, 0xa2, 0x4a, 0x6b, 0x86, 0xe1,
+	0x11, 0xdf, 0xdf, 0xa6, 0x9e, 0x65, 0x9b, 0x2a, 0x70, 0xe5, 0x60, 0x11, 0x6d, 0x42, 0x96, 0xb3,
+	0x8c, 0x15, 0x76, 0xb8, 0x74, 0xeb, 0xcb, 0x57, 0x73, 0x45, 0xd3, 0xa2, 0x95, 0x5a, 0xb9, 0xa0,
+	0x3b, 0x55, 0x45, 0xe0, 0xd5, 0x2b, 0xd8, 0xb2, 0xc3, 0x0f, 0x85, 0x36, 0x5c, 0xe2, 0x17, 0x4a,
+	0x0f, 0xb7, 0x56, 0x56, 0x6f, 0x6e, 0xd5, 0xca, 0x9f, 0x90, 0x86, 0x7a, 0xaa, 0x1c, 0xf0, 0x12,
+	0x7d, 0x0a, 0xa3, 0x11, 0x6f, 0xf7, 0x2c, 0x3f, 0x68, 0x2b, 0x03, 0x6f, 0xe1, 0x76, 0x48, 0x10,
+	0xfe, 0x91, 0xc5, 0x0e, 0xc5, 0xb0, 0x4f, 0xb1, 0x47, 0x35, 0xd1, 0x67, 0x33, 0xfc, 0xb6, 0x64,
+	0x6b, 0xbc, 0x19, 0xa3, 0x19, 0x00, 0x62, 0x1b, 0xa1, 0xc2, 0x29, 0xa6, 0x30, 0x48, 0x6c, 0xd1,
+	0xab, 0xd1, 0x34, 0x0c, 0xf2, 0x6e, 0x19, 0x34, 0xbc, 0x2c, 0x93, 0x9e, 0x61, 0x0b, 0x41, 0x57,
+	0xbc, 0x02, 0xa3, 0x71, 0x06, 0x90, 0x7a, 0xee, 0x34, 0xdb, 0xfc, 0xe1, 0x68, 0xf3, 0x49, 0x9d,
+	0x35, 0xe6, 0x3d, 0xec, 0x57, 0x62, 0x6a, 0x67, 0x98, 0xda, 0x48, 0xb8, 0xcc, 0xf5, 0xde, 0x85,
+	0xa9, 0xe8, 0x94, 0x30, 0x91, 0xe6, 0x5b, 0xbc, 0x47, 0x0e, 0x32, 0xfd, 0x89, 0xa6, 0x78, 0x3b,
+	0x90, 0x6e, 0x5b, 0xac, 0x63, 0x3e, 0x81, 0x11, 0xdd, 0xd9, 0x27, 0x36, 0xb6, 0x69, 0xa0, 0xef,
+	0xe7, 0x80, 0x1d, 0xaa, 0x9b, 0x29, 0xc4, 0x59, 0x17, 0xba, 0x6b, 0x06, 0x76, 0x03, 0x4f, 0x96,
+	0x69, 0x63, 0x5a, 0xf3, 0x88, 0xaf, 0x0e, 0x87, 0x6e, 0xb6, 0x2d, 0xd3, 0x47, 0x37, 0x00, 0x85,
+	0xb9, 0x39, 0x35, 0xea, 0xd6, 0xa8, 0x66, 0x19, 0xf5, 0xdc, 0x10, 0x7b, 0x99, 0x85, 0xe4, 0x7e,
+	0xcc, 0x04, 0x0f, 0x0d, 0xf6, 0x9b, 0x8c, 0x5f, 0x27, 0xb9, 0xe1, 0x4b, 0xd2, 0xfc, 0x19, 0x55,
+	0x7c, 0xa1, 0x39, 0xc6, 0x33, 0x5a, 0xf3, 0x35, 0x83, 0xf8, 0x7a, 0x6e, 0x84, 0xf7, 0x24, 0xbe,
+	0xb4, 0x41, 0x7c, 0x1d, 0xbd, 0x03, 0xa3, 0x35, 0xbb, 0xec, 0xd8, 0x06, 0xab, 0x8e, 0x55, 0x25,
+	0xb9, 0x51, 0x16, 0x62, 0xa4, 0xb9, 0xba, 0x63, 0x55, 0x09, 0xd2, 0xe1, 0x7c, 0xcd, 0x8e, 0x0e,
+	0x87, 0xe6, 0x09, 0x22, 0xe7, 0xce, 0xb2, 0x53, 0x52, 0x48, 0x3f, 0x25, 0x4f, 0x6c, 0xa3, 0x83,
+	0xfe, 0xea, 0x44, 0x2d, 0x61, 0x35, 0xc0, 0xc2, 0x1f, 0x85, 0x5a, 0xf8, 0x10, 0x1d, 0xe3, 0x58,
+	0xf8, 0xaa, 0x78, 0x76, 0xa2, 0x05, 0x18, 0x77, 0x5c, 0xcd, 0x23, 0xb4, 0xe6, 0xd9, 0x5a, 0x78,
+	0x8b, 0x8d, 0xb3, 0xcc, 0x46, 0x1d, 0x57, 0x65, 0xeb, 0x3b, 0xec, 0x36, 0x93, 0x5f, 0x0c, 0xc0,
+	0x54, 0x0a, 0x06, 0x34, 0x0f, 0x63, 0xb1, 0xcc, 0xeb, 0xb1, 0xde, 0x11, 0x55, 0x84, 0x13, 0xe3,
+	0x03, 0x98, 0x8e, 0x88, 0x11, 0xd9, 0x84, 0xe4, 0x38, 0xc9, 0x8c, 0x72, 0x4d, 0x95, 0x27, 0xa1,
+	0x86, 0x20, 0x88, 0x0e, 0xd3, 0x4d, 0x82, 0xb4, 0x5a, 0x37, 0x8f, 0xdb, 0x50, 0xf1, 0x4a, 0x4a,
+	0x05, 0x9b, 0xfc, 0x78, 0x68, 0xef, 0x3a, 0x6a, 0x2e, 0x74, 0x14, 0x8f, 0xc1, 0x4e, 0x5a, 0x02,
+	0xc9, 0x33, 0x49, 0x24, 0x7f, 0x1f, 0xf2, 0x6d, 0x24, 0x8f, 0xa7, 0x72, 0x8a, 0x99, 0x4c, 0xb5,
+	0xf2, 0x3c, 0xca, 0x64, 0x17, 0x26, 0x23, 0xaa, 0xc7, 0x6c, 0xfd, 0x5c, 0xf6, 0x88, 0x9c, 0x9f,
+	0x68, 0x72, 0x3e, 0x8a, 0xe4, 0xcb, 0x3a, 0xcc, 0x1d, 0x72, 0xf7, 0xa0, 0xbb, 0x90, 0x31, 0xc8,
+	0xde, 0xd1, 0x7e, 0x2f, 0x30, 0x4b, 0xf9, 0x97, 0x19, 0xc8, 0xa5, 0x3e, 0x7e, 0x3f, 0x82, 0xa1,
+	0xe0, 0xc0, 0x78, 0x96, 0x1b, 0xbb, 0x0b, 0xbe, 0x16, 0x5e, 0x61, 0x51, 0x04, 0x7e, 0x7f, 0x6d,
+	0x44, 0xaa, 0x6a, 0xdc, 0x0e, 0x6d, 0x02, 0xe8, 0x4e, 0xb5, 0x6a, 0xf9, 0x7e, 0x78, 0x11, 0x0e,
+	0x96, 0x96, 0xbe, 0x7c, 0x35, 0x37, 0xcd, 0x1d, 0xf9, 0xc6, 0xd3, 0x82, 0xe5, 0x28, 0x55, 0x4c,
+	0x2b, 0x85, 0x47, 0xc4, 0xc4, 0x7a, 0x63, 0x83, 0xe8, 0x2f, 0x5f, 0x2c, 0x81, 0x88, 0xb3, 0x41,
+	0x74, 0x35, 0xe6, 0x00, 0xdd, 0x80, 0x0c, 0xbb, 0x2e, 0x06, 0x0e, 0xb9, 0x2e, 0x32, 0xb8, 0xf5,
+	0xa2, 0xc8, 0x1c, 0xc7, 0x45, 0xf1, 0x01, 0x0c, 0xb8, 0x8e, 0xcb, 0x28, 0x32, 0x54, 0xbc, 0x9e,
+	0x36, 0xe2, 0xf1, 0x1c, 0x67, 0xf7, 0xf1, 0xee, 0x96, 0xe3, 0xfb, 0x84, 0x61, 0x2e, 0xed, 0xac,
+	0xab, 0x81, 0x1d, 0x5a, 0x85, 0x49, 0x46, 0x19, 0x62, 0x68, 0xc2, 0x34, 0xec, 0xf9, 0xbc, 0xab,
+	0x4f, 0x08, 0x69, 0x89, 0x0b, 0x45, 0xfb, 0x0f, 0xba, 0x60, 0x68, 0x45, 0xf5, 0xd0, 0xe2, 0x34,
+	0xb3, 0x18, 0x0b, 0x2d, 0xa8, 0x2e, 0xb4, 0xa3, 0x1f, 0xf4, 0x67, 0xba, 0x3e, 0xda, 0x06, 0x3b,
+	0x1e, 0x6d, 0x81, 0xe9, 0xf7, 0xb1, 0xb5, 0x47, 0x8c, 0x1c, 0xf0, 0x06, 0xca, 0xbf, 0x8a, 0x3f,
+	0x9c, 0x80, 0x53, 0xec, 0x67, 0x03, 0xfa, 0x91, 0x04, 0x59, 0x3e, 0xc1, 0x42, 0x0b, 0x29, 0xd9,
+	0x77, 0x0e, 0xf2, 0xf2, 0x8b, 0xbd, 0xa8, 0x72, 0xd2, 0xc9, 0xef, 0x7c, 0xf6, 0xe7, 0xbf, 0xff,
+	0xf4, 0xe4, 0x1c, 0x9a, 0x51, 0xba, 0x0d, 0x20, 0xd1, 0x6f, 0x24, 0x38, 0xdb, 0x36, 0x8a, 0x43,
+	0xc5, 0xc3, 0xc3, 0xb4, 0x0f, 0xfc, 0xf2, 0x2b, 0x7d, 0xd9, 0x08, 0x8c, 0x0a, 0xc3, 0xb8, 0x80,
+	0xae, 0x75, 0xc5, 0xa8, 0x3c, 0x17, 0x1d, 0xfc, 0x00, 0xfd, 0x56, 0x82, 0xf1, 0x8e, 0x27, 0x27,
+	0x5a, 0xed, 0x16, 0x3b, 0x6d, 0x14, 0x98, 0x7f, 0xb7, 0x4f, 0x2b, 0x81, 0x79, 0x99, 0x61, 0xbe,
+	0x8e, 0x16, 0x52, 0x30, 0x77, 0x3e, 0x76, 0xd1, 0x4b, 0x09, 0xc6, 0xda, 0x1d, 0xa2, 0x95, 0x7e,
+	0xc2, 0x87, 0x98, 0x57, 0xfb, 0x33, 0x12, 0x90, 0xb7, 0x19, 0xe4, 0x4d, 0xf4, 0x49, 0xcf, 0x90,
+	0x95, 0xe7, 0x2d, 0xcf, 0x8f, 0x83, 0x4e, 0x15, 0xf4, 0x2b, 0x09, 0x46, 0x5b, 0x9f, 0x63, 0x68,
+	0xb9, 0x1b, 0xba, 0xc4, 0xd1, 0x5c, 0xbe, 0xd8, 0x8f, 0x89, 0x48, 0xa7, 0xc0, 0xd2, 0x99, 0x47,
+	0x57, 0x95, 0xd4, 0xb1, 0x79, 0xfc, 0x5d, 0x82, 0xfe, 0x21, 0xc1, 0xdc, 0x21, 0xd3, 0x0a, 0x54,
+	0xea, 0x86, 0xa3, 0xb7, 0xd1, 0x4b, 0x7e, 0xfd, 0xad, 0x7c, 0x88, 0xe4, 0xbe, 0xce, 0x92, 0x5b,
+	0x45, 0xc5, 0x3e, 0xf6, 0x8a, 0x37, 0xa6, 0x03, 0xf4, 0x1f, 0x09, 0x66, 0xba, 0xce, 0xcb, 0xd0,
+	0xdd, 0x7e, 0xf8, 0x93, 0x34, 0xd2, 0xcb, 0xaf, 0xbd, 0x85, 0x07, 0x91, 0xe2, 0x16, 0x4b, 0xf1,
+	0x63, 0xf4, 0xe0, 0xe8, 0x74, 0x64, 0x9d, 0x37, 0x4a, 0xfc, 0x5f, 0x12, 0x5c, 0xec, 0x36, 0x88,
+	0x43, 0x77, 0xfa, 0x41, 0x9d, 0x30, 0x11, 0xcc, 0xdf, 0x3d, 0xba, 0x03, 0x91, 0xf5, 0x7d, 0x96,
+	0xf5, 0x1a, 0xba, 0xf3, 0x96, 0x59, 0xb3, 0x8e, 0xdd, 0x36, 0x84, 0xea, 0xde, 0xb1, 0x93, 0x07,
+	0x5a, 0xf9, 0x95, 0xbe, 0x6c, 0x7a, 0xec, 0xd8, 0x38, 0xb4, 0x13, 0xb7, 0x2b, 0xfa, 0xb7, 0x04,
+	0xd3, 0x5d, 0x26, 0x0b, 0xe8, 0xc3, 0x7e, 0x0a, 0x9b, 0xd0, 0x40, 0xee, 0x1c, 0xd9, 0x5e, 0x64,
+	0xb4, 0xc9, 0x32, 0xba, 0x8f, 0x3e, 0x3a, 0xfa, 0xbe, 0xc4, 0x9b, 0xcd, 0xef, 0x24, 0x18, 0x69,
+	0xe9, 0x5b, 0xe8, 0x66, 0xcf, 0x2d, 0x2e, 0xcc, 0x69, 0xb9, 0x0f, 0x0b, 0x91, 0xc5, 0x06, 0xcb,
+	0xe2, 0x43, 0xf4, 0x8d, 0xde, 0x7a, 0xa2, 0xf2, 0x3c, 0x61, 0xd8, 0x71, 0x80, 0x7e, 0x2e, 0x01,
+	0x44, 0xc3, 0x48, 0xb4, 0xd4, 0x0d, 0x47, 0xc7, 0x3c, 0x33, 0x5f, 0xe8, 0x55, 0x5d, 0x60, 0x5e,
+	0x64, 0x98, 0xaf, 0x20, 0x39, 0x05, 0x73, 0x6c, 0x00, 0x8a, 0xfe, 0x28, 0xc1, 0xb9, 0x84, 0xe1,
+	0x1f, 0xba, 0xd5, 0xfb, 0xfd, 0x11, 0x1f, 0x59, 0xe6, 0x6f, 0xf7, 0x6d, 0x27, 0x40, 0xdf, 0x61,
+	0xa0, 0xdf, 0x43, 0xb7, 0x7b, 0x2b, 0xb4, 0x56, 0x6e, 0x04, 0xaf, 0x4a, 0xe5, 0xb9, 0x78, 0x5a,
+	0x1e, 0x94, 0x1e, 0x7d, 0xfe, 0x7a, 0x56, 0xfa, 0xe2, 0xf5, 0xac, 0xf4, 0xb7, 0xd7, 0xb3, 0xd2,
+	0x4f, 0xde, 0xcc, 0x9e, 0xf8, 0xe2, 0xcd, 0xec, 0x89, 0xbf, 0xbe, 0x99, 0x3d, 0xf1, 0x9d, 0x43,
+	0x7f, 0x4e, 0xd7, 0xe3, 0xb1, 0xd8, 0x6f, 0xeb, 0x72, 0x96, 0xfd, 0xdf, 0x77, 0xe5, 0x7f, 0x03,
+	0x00, 0x3a, 0x01, 0xe1, 0xb8, 0x41, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1775,6 +2028,12 @@ type QueryClient interface {
 	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
 	// BTCDelegation retrieves delegation by corresponding staking tx hash
 	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
+	// StakingCap queries the staking cap, the total active stake and the
+	// remaining capacity of the BTC staking protocol
+	StakingCap(ctx context.Context, in *QueryStakingCapRequest, opts ...grpc.CallOption) (*QueryStakingCapResponse, error)
+	// BTCDelegationsByTag queries all BTC delegations whose staking txs are
+	// identifiable staking txs carrying the given OP_RETURN tag
+	BTCDelegationsByTag(ctx context.Context, in *QueryBTCDelegationsByTagRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByTagResponse, error)
 }
 
 type queryClient struct {
@@ -1884,6 +2143,24 @@ func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationR
 	return out, nil
 }
 
+func (c *queryClient) StakingCap(ctx context.Context, in *QueryStakingCapRequest, opts ...grpc.CallOption) (*QueryStakingCapResponse, error) {
+	out := new(QueryStakingCapResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/StakingCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationsByTag(ctx context.Context, in *QueryBTCDelegationsByTagRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByTagResponse, error) {
+	out := new(QueryBTCDelegationsByTagResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationsByTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1909,6 +2186,12 @@ type QueryServer interface {
 	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
 	// BTCDelegation retrieves delegation by corresponding staking tx hash
 	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
+	// StakingCap queries the staking cap, the total active stake and the
+	// remaining capacity of the BTC staking protocol
+	StakingCap(context.Context, *QueryStakingCapRequest) (*QueryStakingCapResponse, error)
+	// BTCDelegationsByTag queries all BTC delegations whose staking txs are
+	// identifiable staking txs carrying the given OP_RETURN tag
+	BTCDelegationsByTag(context.Context, *QueryBTCDelegationsByTagRequest) (*QueryBTCDelegationsByTagResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1948,6 +2231,12 @@ func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context
 func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
 }
+func (*UnimplementedQueryServer) StakingCap(ctx context.Context, req *QueryStakingCapRequest) (*QueryStakingCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingCap not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationsByTag(ctx context.Context, req *QueryBTCDelegationsByTagRequest) (*QueryBTCDelegationsByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationsByTag not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2151,6 +2440,42 @@ func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StakingCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/StakingCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingCap(ctx, req.(*QueryStakingCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationsByTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationsByTag(ctx, req.(*QueryBTCDelegationsByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.btcstaking.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2199,6 +2524,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BTCDelegation",
 			Handler:    _Query_BTCDelegation_Handler,
 		},
+		{
+			MethodName: "StakingCap",
+			Handler:    _Query_StakingCap_Handler,
+		},
+		{
+			MethodName: "BTCDelegationsByTag",
+			Handler:    _Query_BTCDelegationsByTag_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btcstaking/v1/query.proto",
@@ -2825,7 +3158,7 @@ func (m *QueryActivatedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryStakingCapRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2835,12 +3168,73 @@ func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStakingCapRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStakingCapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingCapSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingCapSat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalActiveStakeSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalActiveStakeSat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StakingCapSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingCapSat))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsByTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsByTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsByTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2857,17 +3251,17 @@ func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []by
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+	if len(m.TagHex) > 0 {
+		i -= len(m.TagHex)
+		copy(dAtA[i:], m.TagHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TagHex)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBTCDelegationsByTagResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2877,12 +3271,12 @@ func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBTCDelegationsByTagResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBTCDelegationsByTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2899,7 +3293,98 @@ func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []b
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.BtcDelegatorDelegations) > 0 {
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegatorDelegations) > 0 {
 		for iNdEx := len(m.BtcDelegatorDelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.BtcDelegatorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
@@ -3001,6 +3486,15 @@ func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OpReturnTagHex) > 0 {
+		i -= len(m.OpReturnTagHex)
+		copy(dAtA[i:], m.OpReturnTagHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OpReturnTagHex)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.ParamsVersion != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
 		i--
@@ -3269,6 +3763,16 @@ func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.VotingPower != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
 		i--
@@ -3606,6 +4110,69 @@ func (m *QueryActivatedHeightResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryStakingCapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStakingCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StakingCapSat != 0 {
+		n += 1 + sovQuery(uint64(m.StakingCapSat))
+	}
+	if m.TotalActiveStakeSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalActiveStakeSat))
+	}
+	if m.RemainingCapSat != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingCapSat))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TagHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3735,6 +4302,10 @@ func (m *BTCDelegationResponse) Size() (n int) {
 	if m.ParamsVersion != 0 {
 		n += 2 + sovQuery(uint64(m.ParamsVersion))
 	}
+	l = len(m.OpReturnTagHex)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3828,6 +4399,9 @@ func (m *FinalityProviderResponse) Size() (n int) {
 	if m.VotingPower != 0 {
 		n += 1 + sovQuery(uint64(m.VotingPower))
 	}
+	if m.Jailed {
+		n += 2
+	}
 	return n
 }
 
@@ -4914,44 +5488,526 @@ func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderWithMeta{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivatedHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivatedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivatedHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivatedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingCapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingCapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakingCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4973,7 +6029,7 @@ func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakingCapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4996,17 +6052,17 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakingCapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakingCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCapSat", wireType)
 			}
-			m.Height = 0
+			m.StakingCapSat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5016,16 +6072,16 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.StakingCapSat |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveStakeSat", wireType)
 			}
-			m.VotingPower = 0
+			m.TotalActiveStakeSat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5035,7 +6091,26 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
+				m.TotalActiveStakeSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCapSat", wireType)
+			}
+			m.RemainingCapSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingCapSat |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5061,7 +6136,7 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationsByTagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5084,17 +6159,17 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationsByTagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationsByTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagHex", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5104,11 +6179,24 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -5166,7 +6254,7 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationsByTagResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5189,15 +6277,15 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationsByTagResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationsByTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5224,8 +6312,8 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderWithMeta{})
-			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5286,125 +6374,6 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivatedHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivatedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivatedHeightResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivatedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6274,6 +7243,38 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpReturnTagHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpReturnTagHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6905,6 +7906,26 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -14,11 +14,15 @@ import (
 
 // BeginBlocker is called at the beginning of every block.
 // Upon each BeginBlock, if reaching the first block after the epoch begins
-// then we store the current validator set with BLS keys
+// then we apply the BLS key rotations of the last epoch and store the current
+// validator set with BLS keys
 func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	epoch := k.GetEpoch(ctx)
 	if epoch.IsFirstBlock(ctx) {
+		if err := k.ApplyBlsKeyRotations(ctx); err != nil {
+			panic(fmt.Errorf("failed to apply BLS key rotations: %w", err))
+		}
 		err := k.InitValidatorBLSSet(ctx)
 		if err != nil {
 			panic(fmt.Errorf("failed to store validator BLS set: %w", err))
@@ -28,6 +28,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdRawCheckpoint())
 	cmd.AddCommand(CmdRawCheckpointList())
 	cmd.AddCommand(CmdRawCheckpoints())
+	cmd.AddCommand(CmdBlsEvidence())
+	cmd.AddCommand(CmdBlsEvidences())
 
 	return cmd
 }
@@ -132,3 +134,76 @@ func CmdRawCheckpoints() *cobra.Command {
 
 	return cmd
 }
+
+// CmdBlsEvidence defines the cobra command to query the evidence against a
+// validator in an epoch
+func CmdBlsEvidence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bls-evidence [epoch_number] [validator_address]",
+		Short: "retrieve the evidence of conflicting BLS sigs against a validator in an epoch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BlsEvidence(context.Background(), &types.QueryBlsEvidenceRequest{
+				EpochNum:         epochNum,
+				ValidatorAddress: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdBlsEvidences defines the cobra command to query the evidences of an epoch
+func CmdBlsEvidences() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bls-evidences [epoch_number]",
+		Short: "retrieve the evidences of conflicting BLS sigs in an epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BlsEvidences(context.Background(), &types.QueryBlsEvidencesRequest{
+				EpochNum:   epochNum,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bls-evidences")
+
+	return cmd
+}
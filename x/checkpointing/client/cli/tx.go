@@ -21,6 +21,12 @@ import (
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 )
 
+const (
+	FlagRotatedKeyFile = "rotated-key-file"
+
+	defaultRotatedKeyFile = "config/priv_validator_key_rotated.json"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -32,6 +38,8 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdWrappedCreateValidator(authcodec.NewBech32Codec(appparams.Bech32PrefixValAddr)))
+	cmd.AddCommand(CmdRotateBlsKey())
+	cmd.AddCommand(CmdSubmitConflictingCheckpoint())
 
 	return cmd
 }
@@ -83,3 +91,94 @@ before running the command (e.g., via babylond create-bls-key).`)
 
 	return cmd
 }
+
+func CmdRotateBlsKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-bls-key",
+		Args:  cobra.NoArgs,
+		Short: "Rotate the BLS key of a validator",
+		Long: strings.TrimSpace(`rotate-bls-key generates a new BLS key for the validator, and submits
+a MsgRotateBlsKey message with a proof-of-possession built with the validator's
+Ed25519 key in priv_validator_key.json. The transaction has to be signed by the
+validator's operator account.
+
+The new BLS key takes effect from the next epoch on. A copy of priv_validator_key.json
+with the new BLS key is written to the file given by --rotated-key-file, which should
+replace priv_validator_key.json once the next epoch begins, followed by a restart of
+the node.
+
+Example:
+$ babylond tx checkpointing rotate-bls-key --from validator --home ./`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			home, _ := cmd.Flags().GetString(flags.FlagHome)
+			rotatedKeyFile, _ := cmd.Flags().GetString(FlagRotatedKeyFile)
+			if rotatedKeyFile == "" {
+				rotatedKeyFile = filepath.Join(home, defaultRotatedKeyFile)
+			}
+
+			msg, err := buildRotateBlsKeyMsg(home, rotatedKeyFile, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	// see the HACK in CmdWrappedCreateValidator
+	userHomeDir, err := os.UserHomeDir()
+	if err != nil {
+		panic(err)
+	}
+	defaultNodeHome := filepath.Join(userHomeDir, ".babylond")
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The node home directory")
+	cmd.Flags().String(FlagRotatedKeyFile, "", fmt.Sprintf("The file to write the validator key with the new BLS key to (default: <home>/%s)", defaultRotatedKeyFile))
+
+	return cmd
+}
+
+func CmdSubmitConflictingCheckpoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-conflicting-checkpoint [checkpoint-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a checkpoint that conflicts with the local checkpoint of the same epoch",
+		Long: strings.TrimSpace(`submit-conflicting-checkpoint submits a RawCheckpoint in JSON whose BLS
+multi-sig is valid but whose block hash differs from the local checkpoint of the
+same epoch. All signers of the checkpoint are slashed, jailed and tombstoned.
+
+Example:
+$ babylond tx checkpointing submit-conflicting-checkpoint checkpoint.json --from submitter`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			ckpt := &types.RawCheckpoint{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, ckpt); err != nil {
+				return fmt.Errorf("failed to parse checkpoint in %s: %w", args[0], err)
+			}
+
+			msg := &types.MsgSubmitConflictingCheckpoint{
+				Submitter:  clientCtx.GetFromAddress().String(),
+				Checkpoint: ckpt,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -23,6 +23,7 @@ import (
 	staketypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	flag "github.com/spf13/pflag"
 
+	"github.com/babylonchain/babylon/crypto/bls12381"
 	"github.com/babylonchain/babylon/privval"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 )
@@ -202,6 +203,39 @@ func buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr string) (commiss
 	return commission, nil
 }
 
+// buildRotateBlsKeyMsg generates a new BLS key for the validator whose keys
+// are in the given home directory, and builds a MsgRotateBlsKey with it. The
+// validator key with the new BLS key is saved to rotatedKeyFile
+func buildRotateBlsKeyMsg(homeDir, rotatedKeyFile string, signer sdk.AccAddress) (*types.MsgRotateBlsKey, error) {
+	nodeCfg := cmtconfig.DefaultConfig()
+	keyPath := filepath.Join(homeDir, nodeCfg.PrivValidatorKeyFile())
+	statePath := filepath.Join(homeDir, nodeCfg.PrivValidatorStateFile())
+	if !cmtos.FileExists(keyPath) {
+		return nil, errors.New("validator key file does not exist")
+	}
+	if cmtos.FileExists(rotatedKeyFile) {
+		return nil, fmt.Errorf("rotated key file %s already exists", rotatedKeyFile)
+	}
+	wrappedPV := privval.LoadWrappedFilePV(keyPath, statePath)
+
+	blsPrivKey := bls12381.GenPrivKey()
+	pop, err := privval.BuildPoP(wrappedPV.GetValPrivKey(), blsPrivKey)
+	if err != nil {
+		return nil, err
+	}
+	blsPubKey := blsPrivKey.PubKey()
+	msg := types.NewMsgRotateBlsKey(signer, &blsPubKey, pop)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	rotatedPV := privval.NewWrappedFilePV(wrappedPV.GetValPrivKey(), blsPrivKey, rotatedKeyFile, statePath)
+	rotatedPV.Key.DelegatorAddress = wrappedPV.Key.DelegatorAddress
+	rotatedPV.Key.Save()
+
+	return msg, nil
+}
+
 func getValKeyFromFile(homeDir string) (*privval.ValidatorKeys, error) {
 	nodeCfg := cmtconfig.DefaultConfig()
 	keyPath := filepath.Join(homeDir, nodeCfg.PrivValidatorKeyFile())
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// HandleConflictingCheckpoint verifies that the given checkpoint carries a
+// valid BLS multi-sig with a quorum over a block hash other than that of the
+// local checkpoint of the same epoch, and then slashes and jails all of its
+// signers that have not been punished in the epoch yet.
+// NOTE: a pair of BLS sigs of a validator over different block hashes is not
+// an evidence, as an honest validator may extend its precommits for different
+// blocks in different rounds at the last height of the epoch. Only a checkpoint
+// with a quorum can be committed to BTC and thus conflict
+func (k Keeper) HandleConflictingCheckpoint(ctx context.Context, submitter sdk.AccAddress, ckpt *types.RawCheckpoint) ([]*types.BlsEvidence, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ckpt.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	localCkpt, err := k.GetRawCheckpoint(ctx, ckpt.EpochNum)
+	if err != nil {
+		return nil, types.ErrInvalidBlsEvidence.Wrapf("no local checkpoint of epoch %d: %v", ckpt.EpochNum, err)
+	}
+	// the block hash of an accumulating checkpoint is not agreed by the
+	// validators yet
+	if localCkpt.Status == types.Accumulating {
+		return nil, types.ErrInvalidBlsEvidence.Wrapf("the local checkpoint of epoch %d is still accumulating", ckpt.EpochNum)
+	}
+	if localCkpt.Ckpt.BlockHash.Equal(*ckpt.BlockHash) {
+		return nil, types.ErrInvalidBlsEvidence.Wrap("the checkpoint does not conflict with the local checkpoint")
+	}
+	if err := k.checkBlsEvidenceAge(ctx, ckpt.EpochNum); err != nil {
+		return nil, err
+	}
+	signerSet, err := k.verifyCheckpointQuorum(ctx, ckpt)
+	if err != nil {
+		return nil, types.ErrInvalidBlsEvidence.Wrapf("invalid checkpoint: %v", err)
+	}
+
+	// report conflicting checkpoint event
+	k.Logger(sdkCtx).Error(types.ErrConflictingCheckpoint.Wrapf("epoch %v", ckpt.EpochNum).Error())
+	if err := sdkCtx.EventManager().EmitTypedEvent(
+		&types.EventConflictingCheckpoint{
+			ConflictingCheckpoint: ckpt,
+			LocalCheckpoint:       localCkpt,
+		},
+	); err != nil {
+		panic(err)
+	}
+
+	evidences := []*types.BlsEvidence{}
+	for _, val := range signerSet {
+		if k.HasBlsEvidence(ctx, ckpt.EpochNum, val.Addr) {
+			continue
+		}
+		evidence := &types.BlsEvidence{
+			EpochNum:         ckpt.EpochNum,
+			ValidatorAddress: val.GetValAddressStr(),
+			BlockHashA:       localCkpt.Ckpt.BlockHash,
+			BlockHashB:       ckpt.BlockHash,
+			Submitter:        submitter.String(),
+			Height:           uint64(sdkCtx.HeaderInfo().Height),
+			VotingPower:      val.Power,
+		}
+		if err := k.handleBlsEvidence(ctx, evidence); err != nil {
+			return nil, err
+		}
+		evidences = append(evidences, evidence)
+	}
+	if len(evidences) == 0 {
+		return nil, types.ErrBlsEvidenceAlreadyExist.Wrapf("all signers of the checkpoint of epoch %d are punished", ckpt.EpochNum)
+	}
+
+	return evidences, nil
+}
+
+// checkBlsEvidenceAge rejects the evidence of the given epoch if it is too
+// old, i.e., both the time and the number of blocks since the last block of the
+// epoch exceed the max age of evidences in the consensus params, as the
+// evidence module does for equivocations
+func (k Keeper) checkBlsEvidenceAge(ctx context.Context, epochNum uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epoch, err := k.epochingKeeper.GetHistoricalEpoch(ctx, epochNum)
+	if err != nil {
+		return types.ErrInvalidBlsEvidence.Wrapf("epoch %d has not ended: %v", epochNum, err)
+	}
+	if epoch.LastBlockTime == nil {
+		return types.ErrInvalidBlsEvidence.Wrapf("epoch %d has not ended", epochNum)
+	}
+
+	cp := sdkCtx.ConsensusParams()
+	if cp.Evidence == nil {
+		return nil
+	}
+	ageDuration := sdkCtx.HeaderInfo().Time.Sub(*epoch.LastBlockTime)
+	ageBlocks := sdkCtx.HeaderInfo().Height - int64(epoch.GetLastBlockHeight())
+	if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
+		return types.ErrInvalidBlsEvidence.Wrapf(
+			"evidence of epoch %d is too old: %d blocks and %v since its last block, max age is %d blocks and %v",
+			epochNum, ageBlocks, ageDuration, cp.Evidence.MaxAgeNumBlocks, cp.Evidence.MaxAgeDuration,
+		)
+	}
+	return nil
+}
+
+// handleBlsEvidence slashes, jails and tombstones the validator of the given
+// evidence through the slashing module, and records the evidence. As with the
+// other staking msgs, the jailed validator leaves the validator set at the end
+// of the current epoch
+func (k Keeper) handleBlsEvidence(ctx context.Context, evidence *types.BlsEvidence) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(evidence.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	val, err := k.epochingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	consAddr, err := val.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	// a tombstoned validator has been punished for another infraction
+	if !k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		epoch, err := k.epochingKeeper.GetHistoricalEpoch(ctx, evidence.EpochNum)
+		if err != nil {
+			return err
+		}
+		fraction, err := k.slashingKeeper.SlashFractionDoubleSign(ctx)
+		if err != nil {
+			return err
+		}
+		// only the stake bonded since the beginning of the epoch is slashed
+		if err := k.slashingKeeper.Slash(ctx, consAddr, fraction, evidence.VotingPower, int64(epoch.FirstBlockHeight)); err != nil {
+			return fmt.Errorf("failed to slash validator %s: %w", valAddr, err)
+		}
+		if !val.IsJailed() {
+			if err := k.slashingKeeper.Jail(ctx, consAddr); err != nil {
+				return fmt.Errorf("failed to jail validator %s: %w", valAddr, err)
+			}
+		}
+		if err := k.slashingKeeper.JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime); err != nil {
+			return err
+		}
+		if err := k.slashingKeeper.Tombstone(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+
+	k.setBlsEvidence(ctx, valAddr, evidence)
+	k.Logger(sdkCtx).Info("validator is punished for signing conflicting block hashes",
+		"validator", evidence.ValidatorAddress,
+		"epoch", evidence.EpochNum,
+	)
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventBlsEvidenceRecorded{Evidence: evidence})
+}
+
+// GetBlsEvidence returns the evidence against the given validator in the
+// given epoch
+func (k Keeper) GetBlsEvidence(ctx context.Context, epochNumber uint64, valAddr sdk.ValAddress) (*types.BlsEvidence, error) {
+	bz := k.blsEvidenceStore(ctx).Get(types.BlsEvidenceKey(epochNumber, valAddr))
+	if bz == nil {
+		return nil, types.ErrBlsEvidenceDoesNotExist.Wrapf("validator %s in epoch %d", valAddr, epochNumber)
+	}
+	var evidence types.BlsEvidence
+	k.cdc.MustUnmarshal(bz, &evidence)
+	return &evidence, nil
+}
+
+// HasBlsEvidence checks whether the given validator has been punished for
+// signing conflicting block hashes in the given epoch
+func (k Keeper) HasBlsEvidence(ctx context.Context, epochNumber uint64, valAddr sdk.ValAddress) bool {
+	return k.blsEvidenceStore(ctx).Has(types.BlsEvidenceKey(epochNumber, valAddr))
+}
+
+func (k Keeper) setBlsEvidence(ctx context.Context, valAddr sdk.ValAddress, evidence *types.BlsEvidence) {
+	k.blsEvidenceStore(ctx).Set(types.BlsEvidenceKey(evidence.EpochNum, valAddr), k.cdc.MustMarshal(evidence))
+}
+
+// blsEvidenceStore returns the KVStore of the evidences against validators
+// signing conflicting block hashes
+// prefix: BlsEvidencePrefix
+// key: (epoch number, validator address)
+// value: BlsEvidence
+func (k Keeper) blsEvidenceStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BlsEvidencePrefix)
+}
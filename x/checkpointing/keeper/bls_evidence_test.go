@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"github.com/boljen/go-bitmap"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testhelper "github.com/babylonchain/babylon/testutil/helper"
+	checkpointingkeeper "github.com/babylonchain/babylon/x/checkpointing/keeper"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+func FuzzSubmitConflictingCheckpoint(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 4)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		genesisValSet, privSigner, err := datagen.GenesisValidatorSetWithPrivSigner(3)
+		require.NoError(t, err)
+		helper := testhelper.NewHelperWithValSet(t, genesisValSet, privSigner)
+		ctx := helper.Ctx
+		ek := helper.App.EpochingKeeper
+		ck := helper.App.CheckpointingKeeper
+		msgServer := checkpointingkeeper.NewMsgServerImpl(ck)
+		queryHelper := baseapp.NewQueryServerTestHelper(ctx, helper.App.InterfaceRegistry())
+		types.RegisterQueryServer(queryHelper, ck)
+		queryClient := types.NewQueryClient(queryHelper)
+		submitter := datagen.GenRandomAccount().GetAddress()
+
+		// go to epoch 2 so that the checkpoint of epoch 1 is sealed
+		for ek.GetEpoch(ctx).EpochNumber == 1 {
+			ctx, err = helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		localCkpt, err := ck.GetRawCheckpoint(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, types.Sealed, localCkpt.Status)
+
+		valSet := ek.GetValidatorSet(ctx, 1)
+		signCkpt := func(blockHash types.BlockHash, signers map[string]bool) *types.RawCheckpoint {
+			bm := bitmap.New(types.BitmapBits)
+			sigs := []bls12381.Signature{}
+			for i, val := range valSet {
+				if !signers[val.GetValAddressStr()] {
+					continue
+				}
+				for _, key := range helper.GenValidators.Keys {
+					if key.ValidatorAddress == val.GetValAddressStr() {
+						sigs = append(sigs, bls12381.Sign(key.PrivateKey, types.GetSignBytes(1, blockHash)))
+						bitmap.Set(bm, i, true)
+					}
+				}
+			}
+			multiSig, err := bls12381.AggrSigList(sigs)
+			require.NoError(t, err)
+			return &types.RawCheckpoint{
+				EpochNum:    1,
+				BlockHash:   &blockHash,
+				Bitmap:      bm,
+				BlsMultiSig: &multiSig,
+			}
+		}
+
+		// the local checkpoint itself does not conflict
+		_, err = msgServer.SubmitConflictingCheckpoint(ctx, &types.MsgSubmitConflictingCheckpoint{
+			Submitter:  submitter.String(),
+			Checkpoint: localCkpt.Ckpt,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidBlsEvidence)
+
+		// a validator precommits another block in an earlier round at the last
+		// height of epoch 1 before precommitting the committed block, so that
+		// its vote extensions carry BLS sigs over two different block hashes.
+		// The BLS sig of the earlier round does not reach a quorum, and is thus
+		// not an evidence
+		roundVal := valSet[r.Intn(len(valSet))]
+		roundCkpt := signCkpt(datagen.GenRandomBlockHash(r), map[string]bool{roundVal.GetValAddressStr(): true})
+		_, err = msgServer.SubmitConflictingCheckpoint(ctx, &types.MsgSubmitConflictingCheckpoint{
+			Submitter:  submitter.String(),
+			Checkpoint: roundCkpt,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidBlsEvidence)
+		stakingVal, err := helper.App.StakingKeeper.GetValidator(ctx, roundVal.Addr)
+		require.NoError(t, err)
+		require.False(t, stakingVal.IsJailed())
+		require.False(t, ck.HasBlsEvidence(ctx, 1, roundVal.Addr))
+
+		// all validators of epoch 1 sign another block hash
+		signers := map[string]bool{}
+		for _, val := range valSet {
+			signers[val.GetValAddressStr()] = true
+		}
+		ckpt := signCkpt(datagen.GenRandomBlockHash(r), signers)
+
+		// the conflicting checkpoint is rejected once it is too old
+		cp := ctx.ConsensusParams()
+		cp.Evidence = &cmtproto.EvidenceParams{MaxAgeNumBlocks: 0, MaxAgeDuration: time.Minute}
+		staleCtx := ctx.WithConsensusParams(cp).WithHeaderInfo(header.Info{
+			Height: ctx.HeaderInfo().Height,
+			Time:   ctx.HeaderInfo().Time.Add(time.Hour),
+		})
+		_, err = msgServer.SubmitConflictingCheckpoint(staleCtx, &types.MsgSubmitConflictingCheckpoint{
+			Submitter:  submitter.String(),
+			Checkpoint: ckpt,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidBlsEvidence)
+
+		// submit the conflicting checkpoint
+		res, err := msgServer.SubmitConflictingCheckpoint(ctx, &types.MsgSubmitConflictingCheckpoint{
+			Submitter:  submitter.String(),
+			Checkpoint: ckpt,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.SlashedValidators, len(signers))
+
+		// all signers are slashed, jailed and tombstoned
+		for _, val := range valSet {
+			stakingVal, err := helper.App.StakingKeeper.GetValidator(ctx, val.Addr)
+			require.NoError(t, err)
+			require.True(t, stakingVal.IsJailed())
+			consAddr, err := stakingVal.GetConsAddr()
+			require.NoError(t, err)
+			require.True(t, helper.App.SlashingKeeper.IsTombstoned(ctx, consAddr))
+			require.True(t, ck.HasBlsEvidence(ctx, 1, val.Addr))
+		}
+
+		// the evidences are recorded
+		evidenceRes, err := queryClient.BlsEvidence(ctx, &types.QueryBlsEvidenceRequest{
+			EpochNum:         1,
+			ValidatorAddress: roundVal.GetValAddressStr(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, submitter.String(), evidenceRes.Evidence.Submitter)
+		require.True(t, localCkpt.Ckpt.BlockHash.Equal(*evidenceRes.Evidence.BlockHashA))
+		require.True(t, ckpt.BlockHash.Equal(*evidenceRes.Evidence.BlockHashB))
+		evidencesRes, err := queryClient.BlsEvidences(ctx, &types.QueryBlsEvidencesRequest{EpochNum: 1})
+		require.NoError(t, err)
+		require.Len(t, evidencesRes.Evidences, len(valSet))
+
+		// the same checkpoint cannot be submitted twice
+		_, err = msgServer.SubmitConflictingCheckpoint(ctx, &types.MsgSubmitConflictingCheckpoint{
+			Submitter:  submitter.String(),
+			Checkpoint: ckpt,
+		})
+		require.ErrorIs(t, err, types.ErrBlsEvidenceAlreadyExist)
+	})
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// RotateBlsKey schedules the BLS key of the given validator to be replaced by
+// the given BLS key at the beginning of the next epoch, so that the validator
+// BLS key set of the current epoch is unchanged. Rotating again within the
+// same epoch overrides the previously scheduled BLS key, which is released so
+// that it can be registered again.
+// The new BLS key is bound to the validator right away, and BLS keys that
+// have been bound to a validator as its BLS key can never be registered by
+// other validators
+func (k Keeper) RotateBlsKey(ctx context.Context, valAddr sdk.ValAddress, key bls12381.PublicKey) error {
+	rs := k.RegistrationState(ctx)
+	blsPubKey, err := rs.GetBlsPubKey(valAddr)
+	if err != nil {
+		return err
+	}
+	if blsPubKey.Equal(key) {
+		return types.ErrBlsKeyUnchanged
+	}
+
+	bkToAddrKey := types.BlsKeyToAddrKey(key)
+	rawAddr := rs.blsKeysToAddr.Get(bkToAddrKey)
+	if rawAddr != nil && !sdk.ValAddress(rawAddr).Equals(valAddr) {
+		return types.ErrBlsKeyAlreadyExist.Wrapf("same BLS public key is registered by another validator")
+	}
+
+	// release the BLS key scheduled previously in this epoch, if any, as it
+	// never becomes the BLS key of the validator
+	if pendingKey, ok := k.GetRotatedBlsPubKey(ctx, valAddr); ok {
+		rs.blsKeysToAddr.Delete(types.BlsKeyToAddrKey(pendingKey))
+	}
+
+	epochNumber := k.GetEpoch(ctx).EpochNumber
+	rs.blsKeysToAddr.Set(bkToAddrKey, valAddr.Bytes())
+	k.blsKeyRotationStore(ctx).Set(types.BlsKeyRotationKey(epochNumber, valAddr), key)
+
+	return nil
+}
+
+// GetRotatedBlsPubKey returns the BLS key the given validator rotates to at
+// the beginning of the next epoch, if any
+func (k Keeper) GetRotatedBlsPubKey(ctx context.Context, valAddr sdk.ValAddress) (bls12381.PublicKey, bool) {
+	epochNumber := k.GetEpoch(ctx).EpochNumber
+	rawBytes := k.blsKeyRotationStore(ctx).Get(types.BlsKeyRotationKey(epochNumber, valAddr))
+	if rawBytes == nil {
+		return nil, false
+	}
+	return rawBytes, true
+}
+
+// ApplyBlsKeyRotations replaces the BLS keys of the validators that have
+// rotated their BLS keys in the last epoch
+// This is called upon BeginBlock, right before the validator BLS key set of
+// the new epoch is stored
+func (k Keeper) ApplyBlsKeyRotations(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epochNumber := k.GetEpoch(ctx).EpochNumber
+	if epochNumber == 0 {
+		return nil
+	}
+	rs := k.RegistrationState(ctx)
+	store := prefix.NewStore(k.blsKeyRotationStore(ctx), sdk.Uint64ToBigEndian(epochNumber-1))
+
+	// collect the rotations first, as the store cannot be written while iterating
+	valAddrs := []sdk.ValAddress{}
+	newKeys := []bls12381.PublicKey{}
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(iter.Key()))
+		newKeys = append(newKeys, iter.Value())
+	}
+	iter.Close()
+
+	for i, valAddr := range valAddrs {
+		oldKey, err := rs.GetBlsPubKey(valAddr)
+		if err != nil {
+			return err
+		}
+		rs.addrToBlsKeys.Set(types.AddrToBlsKeyKey(valAddr), newKeys[i])
+		store.Delete(valAddr)
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventBlsKeyRotated{
+			ValidatorAddress: valAddr.String(),
+			EpochNum:         epochNumber,
+			OldBlsPubKey:     &oldKey,
+			NewBlsPubKey:     &newKeys[i],
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// blsKeyRotationStore returns the KVStore of the BLS keys that validators
+// rotate to at the beginning of the epoch after the one they are submitted in
+// prefix: BlsKeyRotationPrefix
+// key: (epoch number, validator address)
+// value: BLS public key
+func (k Keeper) blsKeyRotationStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BlsKeyRotationPrefix)
+}
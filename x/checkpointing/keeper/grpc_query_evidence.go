@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// BlsEvidence returns the evidence against a validator at a given epoch
+func (k Keeper) BlsEvidence(c context.Context, req *types.QueryBlsEvidenceRequest) (*types.QueryBlsEvidenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	evidence, err := k.GetBlsEvidence(ctx, req.EpochNum, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlsEvidenceResponse{Evidence: evidence}, nil
+}
+
+// BlsEvidences returns the evidences recorded at a given epoch
+func (k Keeper) BlsEvidences(c context.Context, req *types.QueryBlsEvidencesRequest) (*types.QueryBlsEvidencesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(k.blsEvidenceStore(ctx), sdk.Uint64ToBigEndian(req.EpochNum))
+	var evidences []*types.BlsEvidence
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var evidence types.BlsEvidence
+		k.cdc.MustUnmarshal(value, &evidence)
+		evidences = append(evidences, &evidence)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlsEvidencesResponse{Evidences: evidences, Pagination: pageRes}, nil
+}
@@ -26,6 +26,7 @@ type (
 		storeService   corestoretypes.KVStoreService
 		blsSigner      BlsSigner
 		epochingKeeper types.EpochingKeeper
+		slashingKeeper types.SlashingKeeper
 		hooks          types.CheckpointingHooks
 	}
 )
@@ -99,7 +100,7 @@ func (k Keeper) VerifyBLSSig(ctx context.Context, sig *types.BlsSig) error {
 		return err
 	}
 
-	signerBlsKey, err := k.GetBlsPubKey(ctx, signerAddr)
+	signerBlsKey, err := k.GetBlsPubKeyOfEpoch(ctx, sig.GetEpochNum(), signerAddr)
 	if err != nil {
 		return err
 	}
@@ -158,35 +159,60 @@ func (k Keeper) BuildRawCheckpoint(ctx context.Context, epochNum uint64, blockHa
 }
 
 func (k Keeper) VerifyRawCheckpoint(ctx context.Context, ckpt *types.RawCheckpoint) error {
+	_, err := k.verifyCheckpointQuorum(ctx, ckpt)
+	return err
+}
+
+// verifyCheckpointQuorum verifies the BLS multi-sig of the given checkpoint
+// and checks whether its signers accumulate sufficient voting power, and
+// returns the signers
+func (k Keeper) verifyCheckpointQuorum(ctx context.Context, ckpt *types.RawCheckpoint) (epochingtypes.ValidatorSet, error) {
 	// check whether sufficient voting power is accumulated
 	// and verify if the multi signature is valid
 	totalPower := k.GetTotalVotingPower(ctx, ckpt.EpochNum)
-	signerSet, err := k.GetValidatorSet(ctx, ckpt.EpochNum).FindSubset(ckpt.Bitmap)
+	signerSet, err := k.verifyBlsMultiSig(ctx, ckpt)
 	if err != nil {
-		return fmt.Errorf("failed to get the signer set via bitmap of epoch %d: %w", ckpt.EpochNum, err)
+		return nil, err
 	}
 	var sum int64
+	for _, v := range signerSet {
+		sum += v.Power
+	}
+	if sum*3 <= totalPower*2 {
+		return nil, types.ErrInvalidRawCheckpoint.Wrap("insufficient voting power")
+	}
+
+	return signerSet, nil
+}
+
+// verifyBlsMultiSig verifies the BLS multi-sig of the given checkpoint
+// against the BLS keys of its signers in the epoch, and returns the signers
+// regardless of their voting power
+func (k Keeper) verifyBlsMultiSig(ctx context.Context, ckpt *types.RawCheckpoint) (epochingtypes.ValidatorSet, error) {
+	signerSet, err := k.GetValidatorSet(ctx, ckpt.EpochNum).FindSubset(ckpt.Bitmap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the signer set via bitmap of epoch %d: %w", ckpt.EpochNum, err)
+	}
+	if len(signerSet) == 0 {
+		return nil, types.ErrInvalidRawCheckpoint.Wrap("empty signer set")
+	}
 	signersPubKeys := make([]bls12381.PublicKey, len(signerSet))
 	for i, v := range signerSet {
-		signersPubKeys[i], err = k.GetBlsPubKey(ctx, v.Addr)
+		signersPubKeys[i], err = k.GetBlsPubKeyOfEpoch(ctx, ckpt.EpochNum, v.Addr)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		sum += v.Power
-	}
-	if sum*3 <= totalPower*2 {
-		return types.ErrInvalidRawCheckpoint.Wrap("insufficient voting power")
 	}
 	msgBytes := types.GetSignBytes(ckpt.GetEpochNum(), *ckpt.BlockHash)
 	ok, err := bls12381.VerifyMultiSig(*ckpt.BlsMultiSig, signersPubKeys, msgBytes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if !ok {
-		return types.ErrInvalidRawCheckpoint.Wrap("invalid BLS multi-sig")
+		return nil, types.ErrInvalidRawCheckpoint.Wrap("invalid BLS multi-sig")
 	}
 
-	return nil
+	return signerSet, nil
 }
 
 // VerifyCheckpoint verifies checkpoint from BTC. It verifies
@@ -273,6 +299,13 @@ func (k *Keeper) SetEpochingKeeper(ek types.EpochingKeeper) {
 	k.epochingKeeper = ek
 }
 
+// SetSlashingKeeper sets the slashing keeper used to punish validators
+// signing conflicting block hashes. It is set after construction since the
+// slashing keeper is created after the checkpointing keeper
+func (k *Keeper) SetSlashingKeeper(sk types.SlashingKeeper) {
+	k.slashingKeeper = sk
+}
+
 // SetCheckpointSubmitted sets the status of a checkpoint to SUBMITTED,
 // and records the associated state update in lifecycle
 func (k Keeper) SetCheckpointSubmitted(ctx context.Context, epoch uint64) {
@@ -372,7 +405,14 @@ func (k Keeper) CreateRegistration(ctx context.Context, blsPubKey bls12381.Publi
 }
 
 // GetBLSPubKeySet returns the set of BLS public keys in the same order of the validator set for a given epoch
+// The BLS public keys are the ones of the epoch, which may differ from the registered ones if validators have
+// rotated their BLS keys since then. It falls back to the registered BLS public keys if the validator BLS key
+// set of the epoch is not stored
 func (k Keeper) GetBLSPubKeySet(ctx context.Context, epochNumber uint64) ([]*types.ValidatorWithBlsKey, error) {
+	if valBlsSet := k.GetValidatorBlsKeySet(ctx, epochNumber); len(valBlsSet.ValSet) > 0 {
+		return valBlsSet.ValSet, nil
+	}
+
 	valset := k.GetValidatorSet(ctx, epochNumber)
 	valWithblsKeys := make([]*types.ValidatorWithBlsKey, len(valset))
 	for i, val := range valset {
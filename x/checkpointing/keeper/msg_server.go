@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
@@ -45,11 +46,74 @@ func (m msgServer) WrappedCreateValidator(goCtx context.Context, msg *types.MsgW
 	}
 
 	// enqueue the msg into the epoching module
-	queueMsg := epochingtypes.QueuedMessage{
-		Msg: &epochingtypes.QueuedMessage_MsgCreateValidator{MsgCreateValidator: msg.MsgCreateValidator},
+	blockHeight := uint64(ctx.HeaderInfo().Height)
+	txid := tmhash.Sum(ctx.TxBytes())
+	queueMsg, err := epochingtypes.NewQueuedMessage(blockHeight, ctx.HeaderInfo().Time, txid, msg.MsgCreateValidator)
+	if err != nil {
+		return nil, err
+	}
+
+	// lock the self-delegation in the escrow until the end of the epoch, so
+	// that the validator creation cannot fail due to the validator spending it
+	if err := m.k.epochingKeeper.EscrowQueuedMsg(ctx, &queueMsg); err != nil {
+		return nil, err
+	}
+
+	if err := m.k.epochingKeeper.EnqueueMsg(ctx, queueMsg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedCreateValidatorResponse{}, nil
+}
+
+// RotateBlsKey verifies the proof-of-possession of the new BLS key against
+// the validator's Ed25519 key, and schedules the BLS key rotation to the next
+// epoch
+func (m msgServer) RotateBlsKey(goCtx context.Context, msg *types.MsgRotateBlsKey) (*types.MsgRotateBlsKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	valAddr := sdk.ValAddress(signer)
+
+	val, err := m.k.epochingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	valPubKey, err := val.ConsPubKey()
+	if err != nil {
+		return nil, err
+	}
+	if !msg.Key.Pop.IsValid(*msg.Key.Pubkey, valPubKey) {
+		return nil, types.ErrInvalidPoP
 	}
 
-	m.k.epochingKeeper.EnqueueMsg(ctx, queueMsg)
+	if err := m.k.RotateBlsKey(ctx, valAddr, *msg.Key.Pubkey); err != nil {
+		return nil, err
+	}
 
-	return &types.MsgWrappedCreateValidatorResponse{}, err
+	return &types.MsgRotateBlsKeyResponse{}, nil
+}
+
+// SubmitConflictingCheckpoint slashes and jails the signers of a checkpoint
+// that conflicts with the local checkpoint of the same epoch
+func (m msgServer) SubmitConflictingCheckpoint(goCtx context.Context, msg *types.MsgSubmitConflictingCheckpoint) (*types.MsgSubmitConflictingCheckpointResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	submitter, err := sdk.AccAddressFromBech32(msg.Submitter)
+	if err != nil {
+		return nil, err
+	}
+	evidences, err := m.k.HandleConflictingCheckpoint(ctx, submitter, msg.Checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	slashedVals := make([]string, len(evidences))
+	for i, evidence := range evidences {
+		slashedVals[i] = evidence.ValidatorAddress
+	}
+	return &types.MsgSubmitConflictingCheckpointResponse{SlashedValidators: slashedVals}, nil
 }
@@ -20,6 +20,7 @@ import (
 	checkpointingkeeper "github.com/babylonchain/babylon/x/checkpointing/keeper"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+	zctypes "github.com/babylonchain/babylon/x/zoneconcierge/types"
 )
 
 // FuzzWrappedCreateValidator_InsufficientTokens tests adding new validators with zero voting power
@@ -164,15 +165,24 @@ func FuzzWrappedCreateValidator(f *testing.F) {
 		require.NoError(t, err)
 
 		wcvMsgs := make([]*types.MsgWrappedCreateValidator, n)
+		bankKeeper := helper.App.BankKeeper
+		escrowAddr := helper.App.AccountKeeper.GetModuleAddress(epochingtypes.ModuleName)
+		escrowed := math.ZeroInt()
 		for i := 0; i < n; i++ {
 			msg, err := buildMsgWrappedCreateValidator(addrs[i])
 			require.NoError(t, err)
 			wcvMsgs[i] = msg
+			selfDelegation := msg.MsgCreateValidator.Value
+			balanceBefore := bankKeeper.GetBalance(ctx, addrs[i], selfDelegation.Denom)
 			_, err = msgServer.WrappedCreateValidator(ctx, msg)
 			require.NoError(t, err)
 			blsPK, err := ck.GetBlsPubKey(ctx, sdk.ValAddress(addrs[i]))
 			require.NoError(t, err)
 			require.True(t, msg.Key.Pubkey.Equal(blsPK))
+			// the self-delegation is locked in the escrow until the end of the epoch
+			escrowed = escrowed.Add(selfDelegation.Amount)
+			require.Equal(t, balanceBefore.Sub(selfDelegation), bankKeeper.GetBalance(ctx, addrs[i], selfDelegation.Denom))
+			require.True(t, escrowed.Equal(bankKeeper.GetBalance(ctx, escrowAddr, selfDelegation.Denom).Amount))
 		}
 		require.Len(t, ek.GetCurrentEpochMsgs(ctx), n)
 
@@ -185,6 +195,8 @@ func FuzzWrappedCreateValidator(f *testing.F) {
 		require.Equal(t, uint64(2), epoch.EpochNumber)
 		// ensure epoch 2 has initialised an empty msg queue
 		require.Empty(t, ek.GetCurrentEpochMsgs(ctx))
+		// the escrowed self-delegations have been bonded
+		require.True(t, bankKeeper.GetAllBalances(ctx, escrowAddr).IsZero())
 
 		// check whether the length of current validator set equals to 1 + n
 		// since one genesis validator was added when setup
@@ -202,6 +214,137 @@ func FuzzWrappedCreateValidator(f *testing.F) {
 	})
 }
 
+// FuzzRotateBlsKey tests rotating the BLS key of a genesis validator
+// It ensures that the new BLS key only takes effect from the next epoch on
+func FuzzRotateBlsKey(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 4)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		genesisValSet, privSigner, err := datagen.GenesisValidatorSetWithPrivSigner(2)
+		require.NoError(t, err)
+		helper := testhelper.NewHelperWithValSet(t, genesisValSet, privSigner)
+		ctx := helper.Ctx
+		ek := helper.App.EpochingKeeper
+		ck := helper.App.CheckpointingKeeper
+		msgServer := checkpointingkeeper.NewMsgServerImpl(ck)
+
+		// epoch 1 right now
+		require.Equal(t, uint64(1), ek.GetEpoch(ctx).EpochNumber)
+
+		valKey := helper.GenValidators.Keys[0]
+		valAddr, err := sdk.ValAddressFromBech32(valKey.ValidatorAddress)
+		require.NoError(t, err)
+		signer := sdk.AccAddress(valAddr)
+		oldBlsPubKey, err := ck.GetBlsPubKey(ctx, valAddr)
+		require.NoError(t, err)
+		oldValBlsSet := ck.GetValidatorBlsKeySet(ctx, 1)
+
+		// the proof-of-possession has to be built with the validator's Ed25519 key
+		newBlsPrivKey := bls12381.GenPrivKey()
+		newBlsPubKey := newBlsPrivKey.PubKey()
+		pop, err := privval.BuildPoP(helper.GenValidators.Keys[1].PrivKey, newBlsPrivKey)
+		require.NoError(t, err)
+		_, err = msgServer.RotateBlsKey(ctx, types.NewMsgRotateBlsKey(signer, &newBlsPubKey, pop))
+		require.ErrorIs(t, err, types.ErrInvalidPoP)
+
+		// the BLS key of another validator cannot be used
+		otherBlsPrivKey := helper.GenValidators.Keys[1].PrivateKey
+		otherBlsPubKey := otherBlsPrivKey.PubKey()
+		pop, err = privval.BuildPoP(valKey.PrivKey, otherBlsPrivKey)
+		require.NoError(t, err)
+		_, err = msgServer.RotateBlsKey(ctx, types.NewMsgRotateBlsKey(signer, &otherBlsPubKey, pop))
+		require.ErrorIs(t, err, types.ErrBlsKeyAlreadyExist)
+
+		// the BLS key has to be changed
+		pop, err = privval.BuildPoP(valKey.PrivKey, valKey.PrivateKey)
+		require.NoError(t, err)
+		_, err = msgServer.RotateBlsKey(ctx, types.NewMsgRotateBlsKey(signer, &oldBlsPubKey, pop))
+		require.ErrorIs(t, err, types.ErrBlsKeyUnchanged)
+
+		// rotate the BLS key
+		pop, err = privval.BuildPoP(valKey.PrivKey, newBlsPrivKey)
+		require.NoError(t, err)
+		_, err = msgServer.RotateBlsKey(ctx, types.NewMsgRotateBlsKey(signer, &newBlsPubKey, pop))
+		require.NoError(t, err)
+		rotatedBlsPubKey, ok := ck.GetRotatedBlsPubKey(ctx, valAddr)
+		require.True(t, ok)
+		require.True(t, newBlsPubKey.Equal(rotatedBlsPubKey))
+
+		// the new BLS key is bound to the validator right away
+		err = ck.CreateRegistration(ctx, newBlsPubKey, datagen.GenRandomValidatorAddress())
+		require.ErrorIs(t, err, types.ErrBlsKeyAlreadyExist)
+
+		// rotating again within the epoch releases the BLS key scheduled
+		// previously, and then rotating back binds it again
+		replacedBlsPrivKey := bls12381.GenPrivKey()
+		replacedBlsPubKey := replacedBlsPrivKey.PubKey()
+		pop, err = privval.BuildPoP(valKey.PrivKey, replacedBlsPrivKey)
+		require.NoError(t, err)
+		_, err = msgServer.RotateBlsKey(ctx, types.NewMsgRotateBlsKey(signer, &replacedBlsPubKey, pop))
+		require.NoError(t, err)
+		pop, err = privval.BuildPoP(valKey.PrivKey, newBlsPrivKey)
+		require.NoError(t, err)
+		_, err = msgServer.RotateBlsKey(ctx, types.NewMsgRotateBlsKey(signer, &newBlsPubKey, pop))
+		require.NoError(t, err)
+		require.NoError(t, ck.CreateRegistration(ctx, replacedBlsPubKey, datagen.GenRandomValidatorAddress()))
+		err = ck.CreateRegistration(ctx, newBlsPubKey, datagen.GenRandomValidatorAddress())
+		require.ErrorIs(t, err, types.ErrBlsKeyAlreadyExist)
+
+		// the BLS keys of the current epoch are unchanged
+		blsPubKey, err := ck.GetBlsPubKey(ctx, valAddr)
+		require.NoError(t, err)
+		require.True(t, oldBlsPubKey.Equal(blsPubKey))
+		require.Equal(t, oldValBlsSet, ck.GetValidatorBlsKeySet(ctx, 1))
+
+		// go to epoch 2
+		for ek.GetEpoch(ctx).EpochNumber == 1 {
+			ctx, err = helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		_, ok = ck.GetRotatedBlsPubKey(ctx, valAddr)
+		require.False(t, ok)
+		blsPubKey, err = ck.GetBlsPubKey(ctx, valAddr)
+		require.NoError(t, err)
+		require.True(t, newBlsPubKey.Equal(blsPubKey))
+		require.Equal(t, oldValBlsSet, ck.GetValidatorBlsKeySet(ctx, 1))
+		for _, val := range ck.GetValidatorBlsKeySet(ctx, 2).ValSet {
+			if val.ValidatorAddress == valAddr.String() {
+				require.True(t, newBlsPubKey.Equal(val.BlsPubKey))
+			}
+		}
+
+		// the checkpoint of epoch 1 is still verified with the old BLS key
+		ckpt, err := ck.GetRawCheckpoint(ctx, 1)
+		require.NoError(t, err)
+		require.NoError(t, ck.VerifyRawCheckpoint(ctx, ckpt.Ckpt))
+
+		// the BLS key set of epoch 1 still has the old BLS key, thus the proof
+		// that epoch 1 is sealed still carries a validator set that signs the
+		// checkpoint of epoch 1 and is committed to the sealer header
+		blsPubKeySet, err := ck.GetBLSPubKeySet(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, oldValBlsSet.ValSet, blsPubKeySet)
+		epoch1, err := ek.GetHistoricalEpoch(ctx, 1)
+		require.NoError(t, err)
+		proof, err := helper.App.ZoneConciergeKeeper.ProveEpochSealed(ctx, 1)
+		require.NoError(t, err)
+		proofValSet := &types.ValidatorWithBlsKeySet{ValSet: proof.ValidatorSet}
+		signerSet, _, err := proofValSet.FindSubsetWithPowerSum(ckpt.Ckpt.Bitmap)
+		require.NoError(t, err)
+		ok, err = bls12381.VerifyMultiSig(*ckpt.Ckpt.BlsMultiSig, signerSet.GetBLSKeySet(), ckpt.Ckpt.SignedMsg())
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.NoError(t, zctypes.VerifyValSet(epoch1, proofValSet, proof.ProofEpochValSet))
+
+		// the validator signs checkpoints with the new BLS key from epoch 2 on
+		helper.GenValidators.Keys[0].PrivateKey = newBlsPrivKey
+		_, err = helper.ApplyEmptyBlockWithVoteExtension(r)
+		require.NoError(t, err)
+	})
+}
+
 func buildMsgWrappedCreateValidator(addr sdk.AccAddress) (*types.MsgWrappedCreateValidator, error) {
 	bondTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	return buildMsgWrappedCreateValidatorWithAmount(addr, bondTokens)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"cosmossdk.io/store/prefix"
 	"fmt"
+	"github.com/babylonchain/babylon/crypto/bls12381"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,6 +28,20 @@ func (k Keeper) GetCurrentValidatorBlsKeySet(ctx context.Context) *types.Validat
 	return k.GetValidatorBlsKeySet(ctx, epochNumber)
 }
 
+// GetBlsPubKeyOfEpoch returns the BLS public key of the given validator in the
+// given epoch, which may differ from the registered one if the validator has
+// rotated its BLS key since then. It falls back to the registered BLS public
+// key if the validator is not in the validator BLS key set of the epoch
+func (k Keeper) GetBlsPubKeyOfEpoch(ctx context.Context, epochNumber uint64, valAddr sdk.ValAddress) (bls12381.PublicKey, error) {
+	valAddrStr := valAddr.String()
+	for _, val := range k.GetValidatorBlsKeySet(ctx, epochNumber).ValSet {
+		if val.ValidatorAddress == valAddrStr {
+			return val.BlsPubKey, nil
+		}
+	}
+	return k.GetBlsPubKey(ctx, valAddr)
+}
+
 // InitValidatorBLSSet stores the validator set with BLS keys in the beginning of the current epoch
 // This is called upon BeginBlock
 func (k Keeper) InitValidatorBLSSet(ctx context.Context) error {
@@ -15,6 +15,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	// Register messages
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgWrappedCreateValidator{},
+		&MsgRotateBlsKey{},
+		&MsgSubmitConflictingCheckpoint{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
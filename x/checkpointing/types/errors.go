@@ -19,4 +19,8 @@ var (
 	ErrConflictingCheckpoint   = errorsmod.Register(ModuleName, 1213, "Conflicting checkpoint is found")
 	ErrInvalidAppHash          = errorsmod.Register(ModuleName, 1214, "Provided app hash is Invalid")
 	ErrInsufficientVotingPower = errorsmod.Register(ModuleName, 1215, "Accumulated voting power is not greater than 2/3 of total power")
+	ErrBlsKeyUnchanged         = errorsmod.Register(ModuleName, 1216, "the new BLS public key is the same as the registered one")
+	ErrInvalidBlsEvidence      = errorsmod.Register(ModuleName, 1217, "BLS evidence is invalid")
+	ErrBlsEvidenceAlreadyExist = errorsmod.Register(ModuleName, 1218, "BLS evidence against the validator in the epoch already exists")
+	ErrBlsEvidenceDoesNotExist = errorsmod.Register(ModuleName, 1219, "BLS evidence does not exist")
 )
@@ -0,0 +1,598 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/checkpointing/v1/evidence.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlsEvidence records that the BLS key of a validator signs a checkpoint that
+// conflicts with the local checkpoint of the same epoch, for which the
+// validator is slashed and jailed
+type BlsEvidence struct {
+	// epoch_num is the epoch in which the conflicting block hashes are signed
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// validator_address is the address of the misbehaving validator
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// block_hash_a is the block hash of the local checkpoint
+	BlockHashA *BlockHash `protobuf:"bytes,4,opt,name=block_hash_a,json=blockHashA,proto3,customtype=BlockHash" json:"block_hash_a,omitempty"`
+	// block_hash_b is the block hash of the conflicting checkpoint
+	BlockHashB *BlockHash `protobuf:"bytes,5,opt,name=block_hash_b,json=blockHashB,proto3,customtype=BlockHash" json:"block_hash_b,omitempty"`
+	// submitter is the account address of the submitter of the evidence
+	Submitter string `protobuf:"bytes,6,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// height is the Babylon height at which the evidence is recorded
+	Height uint64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// voting_power is the voting power of the validator in the epoch
+	VotingPower int64 `protobuf:"varint,8,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *BlsEvidence) Reset()         { *m = BlsEvidence{} }
+func (m *BlsEvidence) String() string { return proto.CompactTextString(m) }
+func (*BlsEvidence) ProtoMessage()    {}
+func (*BlsEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aafe248e8ffe579, []int{0}
+}
+func (m *BlsEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlsEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlsEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlsEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlsEvidence.Merge(m, src)
+}
+func (m *BlsEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlsEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlsEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlsEvidence proto.InternalMessageInfo
+
+func (m *BlsEvidence) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *BlsEvidence) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *BlsEvidence) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *BlsEvidence) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlsEvidence) GetVotingPower() int64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BlsEvidence)(nil), "babylon.checkpointing.v1.BlsEvidence")
+}
+
+func init() {
+	proto.RegisterFile("babylon/checkpointing/v1/evidence.proto", fileDescriptor_6aafe248e8ffe579)
+}
+
+var fileDescriptor_6aafe248e8ffe579 = []byte{
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4b, 0xf3, 0x30,
+	0x18, 0xc7, 0x97, 0xad, 0xef, 0xde, 0x35, 0x9b, 0x30, 0x8b, 0x48, 0x50, 0xa9, 0xd5, 0x8b, 0x05,
+	0xa1, 0x65, 0x88, 0x1f, 0x60, 0x05, 0x41, 0x3c, 0xa8, 0xf4, 0xe8, 0xa5, 0x24, 0x6d, 0x68, 0xc2,
+	0xda, 0xa6, 0x34, 0x69, 0x75, 0xdf, 0xc2, 0xa3, 0x1f, 0xc9, 0xe3, 0x8e, 0xe2, 0x41, 0x64, 0xfb,
+	0x22, 0xd2, 0xae, 0x53, 0xdc, 0xc1, 0xdb, 0xf3, 0xfc, 0xf2, 0x7b, 0x42, 0xf2, 0x7f, 0xe0, 0x19,
+	0xc1, 0x64, 0x9e, 0x88, 0xcc, 0x0d, 0x19, 0x0d, 0x67, 0xb9, 0xe0, 0x99, 0xe2, 0x59, 0xec, 0x56,
+	0x13, 0x97, 0x56, 0x3c, 0xa2, 0x59, 0x48, 0x9d, 0xbc, 0x10, 0x4a, 0x18, 0xa8, 0x15, 0x9d, 0x5f,
+	0xa2, 0x53, 0x4d, 0x0e, 0xf6, 0x62, 0x11, 0x8b, 0x46, 0x72, 0xeb, 0x6a, 0xed, 0x9f, 0xbe, 0x74,
+	0xe1, 0xd0, 0x4b, 0xe4, 0x55, 0x7b, 0x8b, 0x71, 0x08, 0x75, 0x9a, 0x8b, 0x90, 0x05, 0x59, 0x99,
+	0x22, 0x60, 0x01, 0x5b, 0xf3, 0x07, 0x0d, 0xb8, 0x2d, 0x53, 0xe3, 0x1c, 0xee, 0x56, 0x38, 0xe1,
+	0x11, 0x56, 0xa2, 0x08, 0x70, 0x14, 0x15, 0x54, 0x4a, 0xd4, 0xb5, 0x80, 0xad, 0xfb, 0xe3, 0xef,
+	0x83, 0xe9, 0x9a, 0x1b, 0x2e, 0x1c, 0x91, 0x44, 0x84, 0xb3, 0x80, 0x61, 0xc9, 0x02, 0x8c, 0x34,
+	0x0b, 0xd8, 0x23, 0x6f, 0xe7, 0xfd, 0xe3, 0x58, 0xf7, 0x6a, 0x7e, 0x8d, 0x25, 0xf3, 0x21, 0xd9,
+	0x94, 0xd3, 0xad, 0x01, 0x82, 0xfe, 0xfd, 0x3d, 0xe0, 0x19, 0x47, 0x50, 0x97, 0x25, 0x49, 0xb9,
+	0x52, 0xb4, 0x40, 0xfd, 0xe6, 0x19, 0x3f, 0xc0, 0xd8, 0x87, 0x7d, 0x46, 0x79, 0xcc, 0x14, 0xfa,
+	0xdf, 0x7c, 0xa3, 0xed, 0x8c, 0x13, 0x38, 0xaa, 0x44, 0x1d, 0x4a, 0x90, 0x8b, 0x47, 0x5a, 0xa0,
+	0x81, 0x05, 0xec, 0x9e, 0x3f, 0x5c, 0xb3, 0xfb, 0x1a, 0xdd, 0x68, 0x83, 0xde, 0x58, 0xf3, 0xee,
+	0x5e, 0x97, 0x26, 0x58, 0x2c, 0x4d, 0xf0, 0xb9, 0x34, 0xc1, 0xf3, 0xca, 0xec, 0x2c, 0x56, 0x66,
+	0xe7, 0x6d, 0x65, 0x76, 0x1e, 0x2e, 0x63, 0xae, 0x58, 0x49, 0x9c, 0x50, 0xa4, 0x6e, 0x9b, 0x77,
+	0xc8, 0x30, 0xcf, 0x36, 0x8d, 0xfb, 0xb4, 0xb5, 0x27, 0x35, 0xcf, 0xa9, 0x24, 0xfd, 0x26, 0xf2,
+	0x8b, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x88, 0x23, 0xa7, 0x28, 0xcd, 0x01, 0x00, 0x00,
+}
+
+func (m *BlsEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlsEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlsEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockHashB != nil {
+		{
+			size := m.BlockHashB.Size()
+			i -= size
+			if _, err := m.BlockHashB.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockHashA != nil {
+		{
+			size := m.BlockHashA.Size()
+			i -= size
+			if _, err := m.BlockHashA.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlsEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovEvidence(uint64(m.EpochNum))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.BlockHashA != nil {
+		l = m.BlockHashA.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.BlockHashB != nil {
+		l = m.BlockHashB.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovEvidence(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func sovEvidence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvidence(x uint64) (n int) {
+	return sovEvidence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlsEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlsEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlsEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHashA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BlockHash
+			m.BlockHashA = &v
+			if err := m.BlockHashA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHashB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BlockHash
+			m.BlockHashB = &v
+			if err := m.BlockHashB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvidence(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvidence
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvidence
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvidence
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvidence        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvidence          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvidence = fmt.Errorf("proto: unexpected end of group")
+)
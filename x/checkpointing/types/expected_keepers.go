@@ -2,7 +2,9 @@ package types
 
 import (
 	"context"
+	"time"
 
+	"cosmossdk.io/math"
 	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -13,11 +15,25 @@ import (
 // EpochingKeeper defines the expected interface needed to retrieve epoch info
 type EpochingKeeper interface {
 	GetEpoch(ctx context.Context) *epochingtypes.Epoch
-	EnqueueMsg(ctx context.Context, msg epochingtypes.QueuedMessage)
+	EnqueueMsg(ctx context.Context, msg epochingtypes.QueuedMessage) error
+	EscrowQueuedMsg(ctx context.Context, msg *epochingtypes.QueuedMessage) error
 	GetValidatorSet(ctx context.Context, epochNumer uint64) epochingtypes.ValidatorSet
 	GetTotalVotingPower(ctx context.Context, epochNumber uint64) int64
 	CheckMsgCreateValidator(ctx context.Context, msg *stakingtypes.MsgCreateValidator) error
 	GetPubKeyByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (cmtprotocrypto.PublicKey, error)
+	GetValidator(ctx context.Context, valAddr sdk.ValAddress) (stakingtypes.Validator, error)
+	GetHistoricalEpoch(ctx context.Context, epochNumber uint64) (*epochingtypes.Epoch, error)
+}
+
+// SlashingKeeper defines the expected interface needed to punish validators
+// signing conflicting block hashes
+type SlashingKeeper interface {
+	SlashFractionDoubleSign(ctx context.Context) (math.LegacyDec, error)
+	Slash(ctx context.Context, consAddr sdk.ConsAddress, fraction math.LegacyDec, power, distributionHeight int64) error
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error
+	Tombstone(ctx context.Context, consAddr sdk.ConsAddress) error
+	IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool
 }
 
 // Event Hooks
@@ -33,6 +33,9 @@ var (
 	BlsKeyToAddrPrefix = append(RegistrationPrefix, 0x1) // where we save BLS key set
 
 	LastFinalizedEpochKey = []byte{0x04} // LastFinalizedEpochKey defines the key to store the last finalised epoch
+
+	BlsKeyRotationPrefix = []byte{0x05} // reserve this namespace for BLS keys to be rotated at the next epoch
+	BlsEvidencePrefix    = []byte{0x06} // reserve this namespace for evidences of conflicting BLS signatures
 )
 
 // CkptsObjectKey defines epoch
@@ -50,6 +53,16 @@ func AddrToBlsKeyKey(valAddr sdk.ValAddress) []byte {
 	return valAddr
 }
 
+// BlsKeyRotationKey defines epoch || validator address
+func BlsKeyRotationKey(epoch uint64, valAddr sdk.ValAddress) []byte {
+	return append(sdk.Uint64ToBigEndian(epoch), valAddr...)
+}
+
+// BlsEvidenceKey defines epoch || validator address
+func BlsEvidenceKey(epoch uint64, valAddr sdk.ValAddress) []byte {
+	return append(sdk.Uint64ToBigEndian(epoch), valAddr...)
+}
+
 // BlsKeyToAddrKey defines BLS public key
 func BlsKeyToAddrKey(pk bls12381.PublicKey) []byte {
 	return pk
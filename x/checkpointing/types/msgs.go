@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	ed255192 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -15,6 +16,8 @@ import (
 var (
 	// Ensure that MsgInsertHeader implements all functions of the Msg interface
 	_ sdk.Msg = (*MsgWrappedCreateValidator)(nil)
+	_ sdk.Msg = (*MsgRotateBlsKey)(nil)
+	_ sdk.Msg = (*MsgSubmitConflictingCheckpoint)(nil)
 )
 
 func NewMsgWrappedCreateValidator(msgCreateVal *stakingtypes.MsgCreateValidator, blsPK *bls12381.PublicKey, pop *ProofOfPossession) (*MsgWrappedCreateValidator, error) {
@@ -54,3 +57,43 @@ func (m *MsgWrappedCreateValidator) ValidateBasic() error {
 func (msg MsgWrappedCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return msg.MsgCreateValidator.UnpackInterfaces(unpacker)
 }
+
+func NewMsgRotateBlsKey(signer sdk.AccAddress, blsPK *bls12381.PublicKey, pop *ProofOfPossession) *MsgRotateBlsKey {
+	return &MsgRotateBlsKey{
+		Signer: signer.String(),
+		Key: &BlsKey{
+			Pubkey: blsPK,
+			Pop:    pop,
+		},
+	}
+}
+
+// ValidateBasic validates statelesss message elements
+// The proof-of-possession is verified by the msg server, as the Ed25519 key
+// of the validator is not included in the message
+func (m *MsgRotateBlsKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+	if m.Key == nil || m.Key.Pubkey == nil {
+		return errors.New("BLS public key is nil")
+	}
+	if m.Key.Pubkey.Size() != bls12381.PubKeySize {
+		return fmt.Errorf("BLS public key should be %d bytes", bls12381.PubKeySize)
+	}
+	if m.Key.Pop == nil || m.Key.Pop.BlsSig == nil || len(m.Key.Pop.Ed25519Sig) == 0 {
+		return errors.New("proof-of-possession is empty")
+	}
+	return nil
+}
+
+// ValidateBasic validates statelesss message elements
+func (m *MsgSubmitConflictingCheckpoint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Submitter); err != nil {
+		return fmt.Errorf("invalid submitter address: %w", err)
+	}
+	if m.Checkpoint == nil {
+		return errors.New("checkpoint is nil")
+	}
+	return m.Checkpoint.ValidateBasic()
+}
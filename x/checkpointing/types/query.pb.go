@@ -656,6 +656,216 @@ func (m *QueryRecentEpochStatusCountResponse) GetStatusCount() map[string]uint64
 	return nil
 }
 
+// QueryBlsEvidenceRequest is the request type for the Query/BlsEvidence
+// RPC method
+type QueryBlsEvidenceRequest struct {
+	EpochNum         uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryBlsEvidenceRequest) Reset()         { *m = QueryBlsEvidenceRequest{} }
+func (m *QueryBlsEvidenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlsEvidenceRequest) ProtoMessage()    {}
+func (*QueryBlsEvidenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{12}
+}
+func (m *QueryBlsEvidenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlsEvidenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlsEvidenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlsEvidenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlsEvidenceRequest.Merge(m, src)
+}
+func (m *QueryBlsEvidenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlsEvidenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlsEvidenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlsEvidenceRequest proto.InternalMessageInfo
+
+func (m *QueryBlsEvidenceRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *QueryBlsEvidenceRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryBlsEvidenceResponse is the response type for the Query/BlsEvidence
+// RPC method
+type QueryBlsEvidenceResponse struct {
+	Evidence *BlsEvidence `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (m *QueryBlsEvidenceResponse) Reset()         { *m = QueryBlsEvidenceResponse{} }
+func (m *QueryBlsEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlsEvidenceResponse) ProtoMessage()    {}
+func (*QueryBlsEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{13}
+}
+func (m *QueryBlsEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlsEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlsEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlsEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlsEvidenceResponse.Merge(m, src)
+}
+func (m *QueryBlsEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlsEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlsEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlsEvidenceResponse proto.InternalMessageInfo
+
+func (m *QueryBlsEvidenceResponse) GetEvidence() *BlsEvidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+// QueryBlsEvidencesRequest is the request type for the Query/BlsEvidences
+// RPC method
+type QueryBlsEvidencesRequest struct {
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlsEvidencesRequest) Reset()         { *m = QueryBlsEvidencesRequest{} }
+func (m *QueryBlsEvidencesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlsEvidencesRequest) ProtoMessage()    {}
+func (*QueryBlsEvidencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{14}
+}
+func (m *QueryBlsEvidencesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlsEvidencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlsEvidencesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlsEvidencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlsEvidencesRequest.Merge(m, src)
+}
+func (m *QueryBlsEvidencesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlsEvidencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlsEvidencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlsEvidencesRequest proto.InternalMessageInfo
+
+func (m *QueryBlsEvidencesRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *QueryBlsEvidencesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlsEvidencesResponse is the response type for the Query/BlsEvidences
+// RPC method
+type QueryBlsEvidencesResponse struct {
+	Evidences []*BlsEvidence `protobuf:"bytes,1,rep,name=evidences,proto3" json:"evidences,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlsEvidencesResponse) Reset()         { *m = QueryBlsEvidencesResponse{} }
+func (m *QueryBlsEvidencesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlsEvidencesResponse) ProtoMessage()    {}
+func (*QueryBlsEvidencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{15}
+}
+func (m *QueryBlsEvidencesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlsEvidencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlsEvidencesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlsEvidencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlsEvidencesResponse.Merge(m, src)
+}
+func (m *QueryBlsEvidencesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlsEvidencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlsEvidencesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlsEvidencesResponse proto.InternalMessageInfo
+
+func (m *QueryBlsEvidencesResponse) GetEvidences() []*BlsEvidence {
+	if m != nil {
+		return m.Evidences
+	}
+	return nil
+}
+
+func (m *QueryBlsEvidencesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryLastCheckpointWithStatusRequest is the request type for the
 // Query/LastCheckpointWithStatus RPC method.
 type QueryLastCheckpointWithStatusRequest struct {
@@ -666,7 +876,7 @@ func (m *QueryLastCheckpointWithStatusRequest) Reset()         { *m = QueryLastC
 func (m *QueryLastCheckpointWithStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLastCheckpointWithStatusRequest) ProtoMessage()    {}
 func (*QueryLastCheckpointWithStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{12}
+	return fileDescriptor_113f1ca5c3c2ca44, []int{16}
 }
 func (m *QueryLastCheckpointWithStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +922,7 @@ func (m *QueryLastCheckpointWithStatusResponse) Reset()         { *m = QueryLast
 func (m *QueryLastCheckpointWithStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLastCheckpointWithStatusResponse) ProtoMessage()    {}
 func (*QueryLastCheckpointWithStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{13}
+	return fileDescriptor_113f1ca5c3c2ca44, []int{17}
 }
 func (m *QueryLastCheckpointWithStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -766,7 +976,7 @@ func (m *RawCheckpointResponse) Reset()         { *m = RawCheckpointResponse{} }
 func (m *RawCheckpointResponse) String() string { return proto.CompactTextString(m) }
 func (*RawCheckpointResponse) ProtoMessage()    {}
 func (*RawCheckpointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{14}
+	return fileDescriptor_113f1ca5c3c2ca44, []int{18}
 }
 func (m *RawCheckpointResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -834,7 +1044,7 @@ func (m *CheckpointStateUpdateResponse) Reset()         { *m = CheckpointStateUp
 func (m *CheckpointStateUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckpointStateUpdateResponse) ProtoMessage()    {}
 func (*CheckpointStateUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{15}
+	return fileDescriptor_113f1ca5c3c2ca44, []int{19}
 }
 func (m *CheckpointStateUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +1122,7 @@ func (m *RawCheckpointWithMetaResponse) Reset()         { *m = RawCheckpointWith
 func (m *RawCheckpointWithMetaResponse) String() string { return proto.CompactTextString(m) }
 func (*RawCheckpointWithMetaResponse) ProtoMessage()    {}
 func (*RawCheckpointWithMetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{16}
+	return fileDescriptor_113f1ca5c3c2ca44, []int{20}
 }
 func (m *RawCheckpointWithMetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -990,6 +1200,10 @@ func init() {
 	proto.RegisterType((*QueryRecentEpochStatusCountRequest)(nil), "babylon.checkpointing.v1.QueryRecentEpochStatusCountRequest")
 	proto.RegisterType((*QueryRecentEpochStatusCountResponse)(nil), "babylon.checkpointing.v1.QueryRecentEpochStatusCountResponse")
 	proto.RegisterMapType((map[string]uint64)(nil), "babylon.checkpointing.v1.QueryRecentEpochStatusCountResponse.StatusCountEntry")
+	proto.RegisterType((*QueryBlsEvidenceRequest)(nil), "babylon.checkpointing.v1.QueryBlsEvidenceRequest")
+	proto.RegisterType((*QueryBlsEvidenceResponse)(nil), "babylon.checkpointing.v1.QueryBlsEvidenceResponse")
+	proto.RegisterType((*QueryBlsEvidencesRequest)(nil), "babylon.checkpointing.v1.QueryBlsEvidencesRequest")
+	proto.RegisterType((*QueryBlsEvidencesResponse)(nil), "babylon.checkpointing.v1.QueryBlsEvidencesResponse")
 	proto.RegisterType((*QueryLastCheckpointWithStatusRequest)(nil), "babylon.checkpointing.v1.QueryLastCheckpointWithStatusRequest")
 	proto.RegisterType((*QueryLastCheckpointWithStatusResponse)(nil), "babylon.checkpointing.v1.QueryLastCheckpointWithStatusResponse")
 	proto.RegisterType((*RawCheckpointResponse)(nil), "babylon.checkpointing.v1.RawCheckpointResponse")
@@ -1002,87 +1216,97 @@ func init() {
 }
 
 var fileDescriptor_113f1ca5c3c2ca44 = []byte{
-	// 1266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x5d, 0x6f, 0x1b, 0x45,
-	0x17, 0xee, 0xda, 0x49, 0xf4, 0xfa, 0x38, 0xcd, 0x5b, 0x46, 0xa5, 0x35, 0x6e, 0xeb, 0x94, 0xa5,
-	0x94, 0xb4, 0xa8, 0xbb, 0xb2, 0xd3, 0x7c, 0x50, 0xfa, 0x01, 0x2e, 0x81, 0x4a, 0xfd, 0x20, 0x6c,
-	0x68, 0x91, 0x90, 0xe8, 0x32, 0xbb, 0x99, 0xae, 0x17, 0xaf, 0x77, 0x37, 0x9e, 0x59, 0x27, 0x56,
-	0xa9, 0x90, 0xe0, 0x0f, 0x54, 0x42, 0xe2, 0x8a, 0x7f, 0xc0, 0x0d, 0xdc, 0x71, 0xcd, 0x55, 0x25,
-	0x10, 0xaa, 0x84, 0x90, 0x10, 0x48, 0x50, 0x25, 0x88, 0xdf, 0x81, 0x76, 0x76, 0x1c, 0x7b, 0x6d,
-	0xaf, 0x1d, 0x3b, 0xb9, 0xe1, 0x2e, 0x3e, 0x7b, 0xce, 0xcc, 0x73, 0x9e, 0xf3, 0x31, 0x4f, 0xe0,
-	0x8c, 0x81, 0x8d, 0xa6, 0xe3, 0xb9, 0xaa, 0x59, 0x21, 0x66, 0xd5, 0xf7, 0x6c, 0x97, 0xd9, 0xae,
-	0xa5, 0x36, 0x8a, 0xea, 0x46, 0x40, 0xea, 0x4d, 0xc5, 0xaf, 0x7b, 0xcc, 0x43, 0x39, 0xe1, 0xa5,
-	0xc4, 0xbc, 0x94, 0x46, 0x31, 0x7f, 0xd4, 0xf2, 0x2c, 0x8f, 0x3b, 0xa9, 0xe1, 0x5f, 0x91, 0x7f,
-	0xfe, 0xa4, 0xe5, 0x79, 0x96, 0x43, 0x54, 0xec, 0xdb, 0x2a, 0x76, 0x5d, 0x8f, 0x61, 0x66, 0x7b,
-	0x2e, 0x15, 0x5f, 0x67, 0xc5, 0x57, 0xfe, 0xcb, 0x08, 0x1e, 0xa8, 0xcc, 0xae, 0x11, 0xca, 0x70,
-	0xcd, 0x17, 0x0e, 0x67, 0x13, 0x41, 0x19, 0x0e, 0xd5, 0xab, 0x44, 0xc0, 0xca, 0x9f, 0x4b, 0xf4,
-	0x6b, 0x1b, 0x84, 0xeb, 0x79, 0xd3, 0xa3, 0x35, 0x8f, 0xaa, 0x06, 0xa6, 0x24, 0x4a, 0x4d, 0x6d,
-	0x14, 0x0d, 0xc2, 0x70, 0x51, 0xf5, 0xb1, 0x65, 0xbb, 0x1c, 0x60, 0xe4, 0x2b, 0x7f, 0x23, 0xc1,
-	0xa9, 0xf7, 0x42, 0x17, 0x0d, 0x6f, 0x5e, 0xdf, 0x3d, 0xe8, 0x96, 0x4d, 0x99, 0x46, 0x36, 0x02,
-	0x42, 0x19, 0x2a, 0xc3, 0x14, 0x65, 0x98, 0x05, 0x34, 0x27, 0x9d, 0x96, 0xe6, 0x66, 0x4a, 0xe7,
-	0x95, 0x24, 0x82, 0x94, 0xf6, 0x01, 0x6b, 0x3c, 0x42, 0x13, 0x91, 0xe8, 0x6d, 0x80, 0xf6, 0xcd,
-	0xb9, 0xd4, 0x69, 0x69, 0x2e, 0x5b, 0x3a, 0xab, 0x44, 0x30, 0x95, 0x10, 0xa6, 0x12, 0x55, 0x40,
-	0xc0, 0x54, 0x56, 0xb1, 0x45, 0xc4, 0xfd, 0x5a, 0x47, 0xa4, 0xfc, 0xa3, 0x04, 0x85, 0x24, 0xb4,
-	0xd4, 0xf7, 0x5c, 0x4a, 0xd0, 0xc7, 0xf0, 0xff, 0x3a, 0xde, 0xd4, 0xdb, 0xd8, 0x42, 0xdc, 0xe9,
-	0xb9, 0x6c, 0x69, 0x29, 0x19, 0x77, 0xec, 0xb4, 0x0f, 0x6c, 0x56, 0xb9, 0x4d, 0x18, 0x6e, 0x9d,
-	0xa8, 0xcd, 0xd4, 0x3b, 0x3f, 0x53, 0xf4, 0x4e, 0x9f, 0x64, 0x5e, 0x19, 0x9a, 0x8c, 0x38, 0xac,
-	0x33, 0x9b, 0x65, 0x78, 0xa1, 0x37, 0x99, 0x16, 0xed, 0x27, 0x20, 0x43, 0x7c, 0xcf, 0xac, 0xe8,
-	0x6e, 0x50, 0xe3, 0xcc, 0x4f, 0x68, 0xff, 0xe3, 0x86, 0x3b, 0x41, 0x4d, 0xfe, 0x14, 0xf2, 0xfd,
-	0x22, 0x05, 0x05, 0xf7, 0x61, 0x26, 0x4e, 0x01, 0x8f, 0xdf, 0x07, 0x03, 0x87, 0x63, 0x0c, 0xc8,
-	0xeb, 0xfd, 0x6e, 0xa7, 0x2d, 0xe0, 0xf1, 0x5a, 0x4b, 0x63, 0xd7, 0xfa, 0x89, 0x04, 0x27, 0xfa,
-	0x5e, 0xf3, 0xdf, 0x2b, 0xf4, 0x17, 0x12, 0x9c, 0xe4, 0xa9, 0x94, 0x1d, 0xba, 0x1a, 0x18, 0x8e,
-	0x6d, 0xde, 0x24, 0xcd, 0xce, 0x19, 0x1b, 0x54, 0xec, 0x03, 0x1b, 0x9e, 0x9f, 0x5b, 0xa3, 0xde,
-	0x8b, 0x42, 0x50, 0xba, 0x0e, 0xc7, 0x1b, 0xd8, 0xb1, 0xd7, 0x31, 0xf3, 0xea, 0xfa, 0xa6, 0xcd,
-	0x2a, 0xba, 0xd8, 0x41, 0x2d, 0x6a, 0x2f, 0x24, 0x53, 0x7b, 0xaf, 0x15, 0x18, 0xd2, 0x5a, 0x76,
-	0xe8, 0x4d, 0xd2, 0xd4, 0x8e, 0x36, 0x7a, 0x8d, 0x07, 0x48, 0xeb, 0x22, 0x1c, 0xe7, 0xf9, 0xac,
-	0x84, 0x4c, 0x89, 0x8d, 0xb3, 0x97, 0xe9, 0xb9, 0x0f, 0xb9, 0xde, 0x38, 0x41, 0xc1, 0x01, 0x6c,
-	0x3b, 0x79, 0x05, 0xe4, 0xa8, 0x71, 0x89, 0x49, 0x5c, 0xd6, 0x71, 0xcb, 0x75, 0x2f, 0x68, 0x0f,
-	0xf8, 0x2c, 0x64, 0x23, 0x88, 0x66, 0x68, 0x15, 0x20, 0x81, 0x9b, 0xb8, 0x9f, 0xfc, 0x55, 0x0a,
-	0x5e, 0x1a, 0x78, 0x8e, 0x80, 0x7c, 0x02, 0x32, 0xcc, 0xf6, 0x75, 0x1e, 0xd9, 0xca, 0x95, 0xd9,
-	0x3e, 0xf7, 0xef, 0xbe, 0x25, 0xd5, 0x7d, 0x0b, 0xda, 0x80, 0xe9, 0x08, 0xb6, 0xf0, 0x48, 0xf3,
-	0x42, 0xdf, 0x49, 0x4e, 0x7b, 0x0f, 0x90, 0x94, 0x0e, 0xdb, 0x8a, 0xcb, 0xea, 0x4d, 0x2d, 0x4b,
-	0xdb, 0x96, 0xfc, 0x55, 0x38, 0xd2, 0xed, 0x80, 0x8e, 0x40, 0xba, 0x4a, 0x9a, 0x1c, 0x7e, 0x46,
-	0x0b, 0xff, 0x44, 0x47, 0x61, 0xb2, 0x81, 0x9d, 0x80, 0x08, 0xcc, 0xd1, 0x8f, 0x4b, 0xa9, 0x65,
-	0x49, 0xfe, 0x04, 0xce, 0x70, 0x10, 0xb7, 0x30, 0x65, 0xf1, 0x71, 0x8e, 0x37, 0xc1, 0x41, 0xd4,
-	0xf2, 0x33, 0x78, 0x79, 0xc8, 0x5d, 0xa2, 0x0a, 0xf7, 0x12, 0x96, 0xae, 0xba, 0xc7, 0x6d, 0x94,
-	0xb4, 0x6c, 0x7f, 0x95, 0xe0, 0xf9, 0xfe, 0x6b, 0x7e, 0xe0, 0xd2, 0x38, 0x03, 0x33, 0x86, 0xe3,
-	0x99, 0x55, 0xbd, 0x82, 0x69, 0x45, 0xaf, 0x90, 0x2d, 0x4e, 0x63, 0x46, 0x9b, 0xe6, 0xd6, 0x1b,
-	0x98, 0x56, 0x6e, 0x90, 0x2d, 0x74, 0x0c, 0xa6, 0x0c, 0x9b, 0xd5, 0xb0, 0x9f, 0x4b, 0x9f, 0x96,
-	0xe6, 0xa6, 0x35, 0xf1, 0x0b, 0x61, 0x38, 0x1c, 0x4e, 0x7e, 0x2d, 0x70, 0x98, 0xad, 0x53, 0xdb,
-	0xca, 0x4d, 0x84, 0x9f, 0xcb, 0x57, 0x7e, 0xff, 0x73, 0xf6, 0x35, 0xcb, 0x66, 0x95, 0xc0, 0x50,
-	0x4c, 0xaf, 0xa6, 0x8a, 0xcc, 0xcc, 0x0a, 0xb6, 0x5d, 0x75, 0x57, 0x9f, 0xd4, 0x9b, 0x3e, 0xf3,
-	0x42, 0xf5, 0x52, 0x2c, 0xcd, 0x2f, 0x17, 0x95, 0x35, 0xdb, 0x72, 0x31, 0x0b, 0xea, 0x44, 0xcb,
-	0x1a, 0x0e, 0xbd, 0x1d, 0x1e, 0xb9, 0x66, 0x5b, 0xf2, 0x3f, 0x12, 0x9c, 0x8a, 0xb3, 0x4e, 0xee,
-	0xfa, 0xeb, 0x98, 0xed, 0x8e, 0x3a, 0x7a, 0x03, 0x26, 0xc3, 0x22, 0x90, 0x31, 0xaa, 0x17, 0x05,
-	0x86, 0xcd, 0x2f, 0x7a, 0x7b, 0x9d, 0x50, 0x53, 0x30, 0x00, 0x91, 0xe9, 0x2d, 0x42, 0x4d, 0xf4,
-	0x22, 0x4c, 0x0b, 0x96, 0x88, 0x6d, 0x55, 0x18, 0x67, 0x61, 0x22, 0xc4, 0x19, 0x72, 0xc4, 0x4d,
-	0xe8, 0x1a, 0x40, 0xe4, 0x12, 0x0a, 0x37, 0xce, 0x43, 0xb6, 0x94, 0x57, 0x22, 0x55, 0xa7, 0xb4,
-	0x54, 0x9d, 0xf2, 0x7e, 0x4b, 0xd5, 0x95, 0x27, 0x1e, 0xff, 0x35, 0x2b, 0x69, 0x19, 0x1e, 0x13,
-	0x5a, 0xe5, 0xaf, 0xd3, 0x70, 0x6a, 0xe0, 0xbb, 0x83, 0xae, 0xc3, 0x84, 0x59, 0xf5, 0xc7, 0x6e,
-	0x18, 0x1e, 0xdc, 0xd1, 0xec, 0xa9, 0xb1, 0x65, 0x5a, 0x17, 0x5f, 0xe9, 0x1e, 0xbe, 0x3e, 0x82,
-	0xb0, 0x86, 0x3a, 0xb6, 0xac, 0xba, 0xee, 0x57, 0xf7, 0xd3, 0x15, 0xbb, 0x0f, 0x50, 0x48, 0x15,
-	0x7d, 0xd3, 0xb2, 0xea, 0xab, 0xd5, 0xb0, 0xa3, 0x7d, 0x6f, 0x93, 0xd4, 0x75, 0x1a, 0xd4, 0x72,
-	0x93, 0x51, 0x47, 0x73, 0xc3, 0x5a, 0x50, 0x43, 0x77, 0x21, 0xe3, 0xd8, 0x0f, 0x88, 0xd9, 0x34,
-	0x1d, 0x92, 0x9b, 0x1a, 0xf6, 0xd2, 0x0f, 0x6c, 0x2d, 0xad, 0x7d, 0x52, 0xe9, 0x19, 0xc0, 0x24,
-	0x9f, 0x70, 0xf4, 0x83, 0x04, 0xcf, 0xf5, 0xe8, 0x4a, 0xb4, 0x34, 0x6c, 0x13, 0x26, 0xe8, 0xe6,
-	0xfc, 0xf2, 0xe8, 0x81, 0x11, 0x3a, 0xf9, 0xd2, 0xe7, 0xbf, 0xfc, 0xfd, 0x65, 0xea, 0x22, 0x2a,
-	0xa9, 0x89, 0x9a, 0xbf, 0x4b, 0xf9, 0xa8, 0x0f, 0xa3, 0x22, 0x3d, 0x42, 0xdf, 0x4b, 0x70, 0x38,
-	0x76, 0x32, 0x9a, 0x1f, 0x05, 0x47, 0x0b, 0xfc, 0xc5, 0xd1, 0x82, 0x04, 0xf0, 0xcb, 0x1c, 0xf8,
-	0x22, 0xba, 0xb8, 0x57, 0xe0, 0xea, 0xc3, 0xdd, 0x0d, 0xf6, 0x08, 0x7d, 0x2b, 0xc1, 0x4c, 0x5c,
-	0xeb, 0xa1, 0x91, 0x60, 0xb4, 0xf6, 0x7e, 0x7e, 0x61, 0xc4, 0x28, 0x81, 0xbe, 0xc8, 0xd1, 0xbf,
-	0x8a, 0xce, 0xed, 0x99, 0xf6, 0xb0, 0x65, 0x8e, 0x74, 0xab, 0x29, 0xb4, 0x38, 0xe4, 0xfa, 0x04,
-	0x11, 0x98, 0x5f, 0x1a, 0x39, 0x4e, 0x00, 0xbf, 0xc2, 0x81, 0x2f, 0xa1, 0x05, 0x75, 0xe0, 0xff,
-	0x92, 0x3e, 0x0f, 0xe6, 0x72, 0x2e, 0xc6, 0xfb, 0x77, 0x12, 0x64, 0x3b, 0x5e, 0x72, 0x54, 0x1c,
-	0x82, 0xa3, 0x57, 0x6e, 0xe5, 0x4b, 0xa3, 0x84, 0x08, 0xd4, 0xaf, 0x73, 0xd4, 0x0b, 0x68, 0x3e,
-	0x19, 0x35, 0x07, 0x19, 0x03, 0xab, 0x8a, 0x4d, 0xf5, 0x93, 0x04, 0xc7, 0xfa, 0x6b, 0x10, 0x74,
-	0x79, 0x4c, 0xe9, 0x12, 0x65, 0x72, 0x65, 0x5f, 0xc2, 0x47, 0x5e, 0xe0, 0x49, 0xa9, 0xe8, 0xc2,
-	0xb0, 0xa4, 0x2e, 0x75, 0x8a, 0x2e, 0xf4, 0x87, 0x04, 0xb9, 0x24, 0x85, 0x81, 0xae, 0x0e, 0x81,
-	0x34, 0x44, 0x06, 0xe5, 0xaf, 0x8d, 0x1d, 0x2f, 0x92, 0xba, 0xca, 0x93, 0x5a, 0x46, 0x8b, 0xc9,
-	0x49, 0x39, 0x98, 0x32, 0xbd, 0x7b, 0xb6, 0xc5, 0x4e, 0x2a, 0xbf, 0xfb, 0x64, 0xbb, 0x20, 0x3d,
-	0xdd, 0x2e, 0x48, 0xcf, 0xb6, 0x0b, 0xd2, 0xe3, 0x9d, 0xc2, 0xa1, 0xa7, 0x3b, 0x85, 0x43, 0xbf,
-	0xed, 0x14, 0x0e, 0x7d, 0xb8, 0x30, 0xec, 0xd9, 0xd8, 0xea, 0xba, 0x8a, 0x35, 0x7d, 0x42, 0x8d,
-	0x29, 0xfe, 0xee, 0xce, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xee, 0xf6, 0x49, 0x5b, 0xd1, 0x11,
-	0x00, 0x00,
+	// 1425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5b, 0x8f, 0xdb, 0xd4,
+	0x16, 0xae, 0xe7, 0xa6, 0x66, 0x65, 0x66, 0xce, 0x74, 0xab, 0xa7, 0x4d, 0xd3, 0x36, 0xd3, 0xe3,
+	0xd3, 0xcb, 0xb4, 0x55, 0x6d, 0x25, 0xd3, 0xb9, 0x9c, 0x39, 0xbd, 0xd0, 0x0c, 0x03, 0x95, 0x7a,
+	0x61, 0xf0, 0xd0, 0x22, 0x21, 0xb5, 0x66, 0xc7, 0xd9, 0x75, 0x4c, 0x1c, 0xdb, 0xcd, 0xde, 0xce,
+	0x34, 0x2a, 0x55, 0x25, 0xf8, 0x03, 0x95, 0x90, 0x78, 0xe2, 0x0f, 0x20, 0x5e, 0xe0, 0x0d, 0x89,
+	0x37, 0x9e, 0x2a, 0x81, 0x50, 0x25, 0x84, 0x84, 0x40, 0x02, 0xd4, 0x22, 0xde, 0xf8, 0x0f, 0xc8,
+	0xdb, 0xdb, 0xb9, 0x7b, 0x72, 0xe9, 0x08, 0x89, 0xb7, 0x78, 0x79, 0xad, 0xbd, 0xbf, 0xf5, 0xad,
+	0xb5, 0xd7, 0xfe, 0x1c, 0x38, 0x5e, 0xc0, 0x85, 0xba, 0xed, 0x3a, 0xaa, 0x51, 0x22, 0x46, 0xd9,
+	0x73, 0x2d, 0x87, 0x59, 0x8e, 0xa9, 0xd6, 0xb2, 0xea, 0x7d, 0x9f, 0x54, 0xeb, 0x8a, 0x57, 0x75,
+	0x99, 0x8b, 0x52, 0xc2, 0x4b, 0x69, 0xf3, 0x52, 0x6a, 0xd9, 0xf4, 0x7e, 0xd3, 0x35, 0x5d, 0xee,
+	0xa4, 0x06, 0xbf, 0x42, 0xff, 0xf4, 0x11, 0xd3, 0x75, 0x4d, 0x9b, 0xa8, 0xd8, 0xb3, 0x54, 0xec,
+	0x38, 0x2e, 0xc3, 0xcc, 0x72, 0x1d, 0x2a, 0xde, 0xce, 0x8b, 0xb7, 0xfc, 0xa9, 0xe0, 0xdf, 0x53,
+	0x99, 0x55, 0x21, 0x94, 0xe1, 0x8a, 0x27, 0x1c, 0x4e, 0xc6, 0x82, 0x2a, 0xd8, 0x54, 0x2f, 0x13,
+	0x01, 0x2b, 0x7d, 0x3a, 0xd6, 0xaf, 0x69, 0x10, 0xae, 0xa7, 0x62, 0x5d, 0x49, 0xcd, 0x2a, 0x12,
+	0xc7, 0x20, 0xc2, 0xf1, 0x8c, 0xe1, 0xd2, 0x8a, 0x4b, 0xd5, 0x02, 0xa6, 0x24, 0xe4, 0x40, 0xad,
+	0x65, 0x0b, 0x84, 0xe1, 0xac, 0xea, 0x61, 0xd3, 0x72, 0x78, 0x26, 0xa1, 0xaf, 0xfc, 0x99, 0x04,
+	0x47, 0xdf, 0x0c, 0x5c, 0x34, 0xbc, 0xbd, 0xde, 0x58, 0xf7, 0xba, 0x45, 0x99, 0x46, 0xee, 0xfb,
+	0x84, 0x32, 0x94, 0x87, 0x29, 0xca, 0x30, 0xf3, 0x69, 0x4a, 0x3a, 0x26, 0x2d, 0xcc, 0xe6, 0xce,
+	0x28, 0x71, 0x4c, 0x2a, 0xcd, 0x05, 0xb6, 0x78, 0x84, 0x26, 0x22, 0xd1, 0x6b, 0x00, 0xcd, 0x9d,
+	0x53, 0x63, 0xc7, 0xa4, 0x85, 0x64, 0xee, 0xa4, 0x12, 0xc2, 0x54, 0x02, 0x98, 0x4a, 0x58, 0x2a,
+	0x01, 0x53, 0xd9, 0xc4, 0x26, 0x11, 0xfb, 0x6b, 0x2d, 0x91, 0xf2, 0x37, 0x12, 0x64, 0xe2, 0xd0,
+	0x52, 0xcf, 0x75, 0x28, 0x41, 0xef, 0xc2, 0xbf, 0xaa, 0x78, 0x5b, 0x6f, 0x62, 0x0b, 0x70, 0x8f,
+	0x2f, 0x24, 0x73, 0x2b, 0xf1, 0xb8, 0xdb, 0x56, 0x7b, 0xdb, 0x62, 0xa5, 0x1b, 0x84, 0xe1, 0x68,
+	0x45, 0x6d, 0xb6, 0xda, 0xfa, 0x9a, 0xa2, 0xd7, 0x7b, 0x24, 0x73, 0xaa, 0x6f, 0x32, 0x62, 0xb1,
+	0xd6, 0x6c, 0x56, 0xe1, 0x50, 0x77, 0x32, 0x11, 0xed, 0x87, 0x21, 0x41, 0x3c, 0xd7, 0x28, 0xe9,
+	0x8e, 0x5f, 0xe1, 0xcc, 0x4f, 0x68, 0x7b, 0xb9, 0xe1, 0xa6, 0x5f, 0x91, 0xdf, 0x87, 0x74, 0xaf,
+	0x48, 0x41, 0xc1, 0x5d, 0x98, 0x6d, 0xa7, 0x80, 0xc7, 0xbf, 0x04, 0x03, 0x33, 0x6d, 0x0c, 0xc8,
+	0xc5, 0x5e, 0xbb, 0xd3, 0x08, 0x78, 0x7b, 0xad, 0xa5, 0x91, 0x6b, 0xfd, 0x54, 0x82, 0xc3, 0x3d,
+	0xb7, 0xf9, 0xe7, 0x15, 0xfa, 0x43, 0x09, 0x8e, 0xf0, 0x54, 0xf2, 0x36, 0xdd, 0xf4, 0x0b, 0xb6,
+	0x65, 0x5c, 0x23, 0xf5, 0xd6, 0x33, 0xb6, 0x53, 0xb1, 0x77, 0xed, 0xf0, 0x7c, 0x17, 0x1d, 0xf5,
+	0x6e, 0x14, 0x82, 0xd2, 0x22, 0x1c, 0xac, 0x61, 0xdb, 0x2a, 0x62, 0xe6, 0x56, 0xf5, 0x6d, 0x8b,
+	0x95, 0x74, 0x31, 0xac, 0x22, 0x6a, 0xcf, 0xc5, 0x53, 0x7b, 0x3b, 0x0a, 0x0c, 0x68, 0xcd, 0xdb,
+	0xf4, 0x1a, 0xa9, 0x6b, 0xfb, 0x6b, 0xdd, 0xc6, 0x5d, 0xa4, 0x75, 0x19, 0x0e, 0xf2, 0x7c, 0x36,
+	0x02, 0xa6, 0xc4, 0xc4, 0x19, 0xe4, 0xf4, 0xdc, 0x85, 0x54, 0x77, 0x9c, 0xa0, 0x60, 0x17, 0xa6,
+	0x9d, 0xbc, 0x01, 0x72, 0xd8, 0xb8, 0xc4, 0x20, 0x0e, 0x6b, 0xd9, 0x65, 0xdd, 0xf5, 0x9b, 0x07,
+	0x7c, 0x1e, 0x92, 0x21, 0x44, 0x23, 0xb0, 0x0a, 0x90, 0xc0, 0x4d, 0xdc, 0x4f, 0xfe, 0x78, 0x0c,
+	0xfe, 0xbb, 0xe3, 0x3a, 0x02, 0xf2, 0x61, 0x48, 0x30, 0xcb, 0xd3, 0x79, 0x64, 0x94, 0x2b, 0xb3,
+	0x3c, 0xee, 0xdf, 0xb9, 0xcb, 0x58, 0xe7, 0x2e, 0xe8, 0x3e, 0x4c, 0x87, 0xb0, 0x85, 0xc7, 0x38,
+	0x2f, 0xf4, 0xcd, 0xf8, 0xb4, 0x07, 0x80, 0xa4, 0xb4, 0xd8, 0x36, 0x1c, 0x56, 0xad, 0x6b, 0x49,
+	0xda, 0xb4, 0xa4, 0x2f, 0xc1, 0x5c, 0xa7, 0x03, 0x9a, 0x83, 0xf1, 0x32, 0xa9, 0x73, 0xf8, 0x09,
+	0x2d, 0xf8, 0x89, 0xf6, 0xc3, 0x64, 0x0d, 0xdb, 0x3e, 0x11, 0x98, 0xc3, 0x87, 0xb5, 0xb1, 0x55,
+	0x49, 0x36, 0x44, 0xdd, 0xf3, 0x36, 0xdd, 0x10, 0x37, 0xdf, 0x40, 0x07, 0xe9, 0x2c, 0xec, 0x6b,
+	0xb6, 0x37, 0x2e, 0x16, 0xab, 0x84, 0x52, 0xbe, 0x7a, 0x42, 0x9b, 0x6b, 0xbc, 0xb8, 0x12, 0xda,
+	0xe5, 0x3b, 0x90, 0xea, 0xde, 0x44, 0x30, 0x7e, 0x05, 0xf6, 0x46, 0x57, 0xae, 0x18, 0x70, 0x27,
+	0xe2, 0xf9, 0x6a, 0x5d, 0xa0, 0x11, 0x26, 0x3f, 0xee, 0x5e, 0x9e, 0xfe, 0xad, 0xd3, 0xe0, 0x53,
+	0x09, 0x0e, 0xf5, 0x40, 0x20, 0x32, 0x5c, 0x87, 0x44, 0x04, 0x35, 0x3a, 0xfb, 0x03, 0xa6, 0xd8,
+	0x8c, 0xdb, 0xbd, 0x83, 0xfe, 0x1e, 0x1c, 0xe7, 0x50, 0xaf, 0x63, 0xca, 0xda, 0xe7, 0x77, 0xfb,
+	0xa9, 0xdf, 0x8d, 0xc3, 0xfb, 0x18, 0x4e, 0xf4, 0xd9, 0x4b, 0x50, 0x74, 0x3b, 0xe6, 0x96, 0x55,
+	0x07, 0xbc, 0x7e, 0xe2, 0x6e, 0xd7, 0x1f, 0x24, 0xf8, 0x77, 0xef, 0x7b, 0x7d, 0xc7, 0xbe, 0x38,
+	0x0e, 0xb3, 0x05, 0xdb, 0x35, 0xca, 0x7a, 0x09, 0xd3, 0x92, 0x5e, 0x22, 0x0f, 0x44, 0x67, 0x4f,
+	0x73, 0xeb, 0x55, 0x4c, 0x4b, 0x57, 0xc9, 0x03, 0x74, 0x00, 0xa6, 0x0a, 0x16, 0xab, 0x60, 0x2f,
+	0x35, 0x7e, 0x4c, 0x5a, 0x98, 0xd6, 0xc4, 0x13, 0xc2, 0x30, 0x13, 0x8c, 0xfa, 0x8a, 0x6f, 0x33,
+	0x4b, 0xa7, 0x96, 0x99, 0x9a, 0x08, 0x5e, 0xe7, 0x2f, 0xfe, 0xf4, 0xcb, 0xfc, 0xff, 0x4c, 0x8b,
+	0x95, 0xfc, 0x82, 0x62, 0xb8, 0x15, 0x55, 0x64, 0x66, 0x94, 0xb0, 0xe5, 0xa8, 0x0d, 0x39, 0x5a,
+	0xad, 0x7b, 0xcc, 0x0d, 0x74, 0x6d, 0x36, 0xb7, 0xb8, 0x9a, 0x55, 0xb6, 0x2c, 0xd3, 0xc1, 0xcc,
+	0xaf, 0x12, 0x2d, 0x59, 0xb0, 0xe9, 0x8d, 0x60, 0xc9, 0x2d, 0xcb, 0x94, 0xff, 0x90, 0xe0, 0x68,
+	0x3b, 0xeb, 0xe4, 0x96, 0x57, 0xc4, 0xac, 0x79, 0xac, 0x5e, 0x81, 0xc9, 0xa0, 0x08, 0x64, 0x84,
+	0xea, 0x85, 0x81, 0xc1, 0xb4, 0x13, 0xc3, 0xac, 0x48, 0xa8, 0x21, 0x18, 0x80, 0xd0, 0xf4, 0x2a,
+	0xa1, 0x06, 0xfa, 0x0f, 0x4c, 0x0b, 0x96, 0x88, 0x65, 0x96, 0x18, 0x67, 0x61, 0x42, 0x4b, 0x86,
+	0x1c, 0x71, 0x13, 0xba, 0x0c, 0x10, 0xba, 0x04, 0x92, 0x9e, 0xf3, 0x90, 0xcc, 0xa5, 0x95, 0x50,
+	0xef, 0x2b, 0x91, 0xde, 0x57, 0xde, 0x8a, 0xf4, 0x7e, 0x7e, 0xe2, 0xc9, 0xaf, 0xf3, 0x92, 0x96,
+	0xe0, 0x31, 0x81, 0x55, 0xfe, 0x64, 0x1c, 0x8e, 0xee, 0x28, 0x34, 0xd0, 0x3a, 0x4c, 0x18, 0x65,
+	0x6f, 0xe4, 0x86, 0xe1, 0xc1, 0x2d, 0xcd, 0x3e, 0x36, 0xb2, 0x2e, 0xef, 0xe0, 0x6b, 0xbc, 0x8b,
+	0xaf, 0x3b, 0x10, 0xd4, 0x50, 0xc7, 0xa6, 0x59, 0xd5, 0xbd, 0xf2, 0xcb, 0x74, 0x45, 0x43, 0x71,
+	0x04, 0x54, 0xd1, 0x2b, 0xa6, 0x59, 0xdd, 0x2c, 0x07, 0x1d, 0xed, 0xb9, 0xdb, 0xa4, 0xaa, 0x53,
+	0xbf, 0x92, 0x9a, 0x0c, 0x3b, 0x9a, 0x1b, 0xb6, 0xfc, 0x0a, 0xba, 0x05, 0x09, 0xdb, 0xba, 0x47,
+	0x8c, 0xba, 0x61, 0x93, 0xd4, 0x54, 0x3f, 0x69, 0xb7, 0x63, 0x6b, 0x69, 0xcd, 0x95, 0x72, 0x7f,
+	0xce, 0xc0, 0x24, 0x3f, 0xe1, 0xe8, 0x6b, 0x09, 0xf6, 0x75, 0x7d, 0x48, 0xa0, 0x95, 0x7e, 0x57,
+	0x5f, 0xcc, 0x87, 0x52, 0x7a, 0x75, 0xf8, 0xc0, 0x10, 0x9d, 0xbc, 0xf6, 0xc1, 0xf7, 0xbf, 0x7f,
+	0x34, 0x76, 0x1e, 0xe5, 0xd4, 0xd8, 0x4f, 0xbc, 0x0e, 0xa9, 0xab, 0x3e, 0x0c, 0x8b, 0xf4, 0x08,
+	0x7d, 0x29, 0xc1, 0x4c, 0xdb, 0xca, 0x68, 0x71, 0x18, 0x1c, 0x11, 0xf8, 0xf3, 0xc3, 0x05, 0x09,
+	0xe0, 0x17, 0x38, 0xf0, 0x65, 0x74, 0x7e, 0x50, 0xe0, 0xea, 0xc3, 0xc6, 0x04, 0x7b, 0x84, 0x3e,
+	0x97, 0x60, 0x56, 0x6b, 0x97, 0xdc, 0x43, 0xc1, 0x88, 0xe6, 0x7e, 0x7a, 0x69, 0xc8, 0x28, 0x81,
+	0x3e, 0xcb, 0xd1, 0x9f, 0x45, 0xa7, 0x07, 0xa6, 0x3d, 0x68, 0x99, 0xb9, 0x4e, 0xf9, 0x8c, 0x96,
+	0xfb, 0x6c, 0x1f, 0xa3, 0xfa, 0xd3, 0x2b, 0x43, 0xc7, 0x09, 0xe0, 0x17, 0x39, 0xf0, 0x15, 0xb4,
+	0xa4, 0xee, 0xf8, 0x2f, 0x83, 0xc7, 0x83, 0xb9, 0x7e, 0x6f, 0xe3, 0xfd, 0x0b, 0x09, 0x92, 0x2d,
+	0xd2, 0x0d, 0x65, 0xfb, 0xe0, 0xe8, 0xd6, 0xd7, 0xe9, 0xdc, 0x30, 0x21, 0x02, 0xf5, 0xff, 0x39,
+	0xea, 0x25, 0xb4, 0x18, 0x8f, 0x9a, 0x83, 0x6c, 0x03, 0xab, 0x8a, 0x49, 0xf5, 0xad, 0x04, 0x07,
+	0x7a, 0x8b, 0x4e, 0x74, 0x61, 0x44, 0xad, 0x1a, 0x66, 0x72, 0xf1, 0xa5, 0x94, 0xae, 0xbc, 0xc4,
+	0x93, 0x52, 0xd1, 0xb9, 0x7e, 0x49, 0xad, 0xb5, 0xaa, 0xec, 0x20, 0x9d, 0x64, 0x8b, 0x6a, 0xea,
+	0x5b, 0x82, 0x6e, 0xa9, 0x9b, 0xce, 0x0d, 0x13, 0x22, 0xd0, 0xde, 0xe6, 0x68, 0x37, 0xd1, 0xcd,
+	0xa1, 0x4a, 0x10, 0xf4, 0x52, 0x43, 0xd5, 0xa9, 0x0f, 0xbb, 0x34, 0xf4, 0x23, 0xf4, 0x95, 0x04,
+	0xd3, 0x2d, 0xfb, 0x51, 0x34, 0x04, 0xb8, 0x46, 0x4f, 0x2d, 0x0e, 0x15, 0x23, 0x32, 0xca, 0xf3,
+	0x8c, 0x2e, 0xa0, 0xb5, 0xd1, 0x33, 0x42, 0x3f, 0x4b, 0x90, 0x8a, 0x93, 0x7b, 0xe8, 0x52, 0x1f,
+	0x54, 0x7d, 0x34, 0x69, 0xfa, 0xf2, 0xc8, 0xf1, 0x22, 0xc3, 0x4b, 0x3c, 0xc3, 0x55, 0xb4, 0x1c,
+	0x9f, 0xa1, 0x8d, 0x29, 0xd3, 0x3b, 0x07, 0xad, 0xb8, 0x20, 0xf2, 0x6f, 0x3c, 0x7d, 0x9e, 0x91,
+	0x9e, 0x3d, 0xcf, 0x48, 0xbf, 0x3d, 0xcf, 0x48, 0x4f, 0x5e, 0x64, 0xf6, 0x3c, 0x7b, 0x91, 0xd9,
+	0xf3, 0xe3, 0x8b, 0xcc, 0x9e, 0x77, 0x96, 0xfa, 0xdd, 0xe1, 0x0f, 0x3a, 0xb6, 0x62, 0x75, 0x8f,
+	0xd0, 0xc2, 0x14, 0x17, 0x41, 0x8b, 0x7f, 0x0d, 0x00, 0x08, 0xf2, 0xf2, 0x5e, 0x78, 0x15, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1111,6 +1335,10 @@ type QueryClient interface {
 	// RecentEpochStatusCount queries the number of epochs with each status in
 	// recent epochs
 	RecentEpochStatusCount(ctx context.Context, in *QueryRecentEpochStatusCountRequest, opts ...grpc.CallOption) (*QueryRecentEpochStatusCountResponse, error)
+	// BlsEvidence queries the evidence against a validator at a given epoch
+	BlsEvidence(ctx context.Context, in *QueryBlsEvidenceRequest, opts ...grpc.CallOption) (*QueryBlsEvidenceResponse, error)
+	// BlsEvidences queries the evidences recorded at a given epoch
+	BlsEvidences(ctx context.Context, in *QueryBlsEvidencesRequest, opts ...grpc.CallOption) (*QueryBlsEvidencesResponse, error)
 	// LastCheckpointWithStatus queries the last checkpoint with a given status or
 	// a more matured status
 	LastCheckpointWithStatus(ctx context.Context, in *QueryLastCheckpointWithStatusRequest, opts ...grpc.CallOption) (*QueryLastCheckpointWithStatusResponse, error)
@@ -1178,6 +1406,24 @@ func (c *queryClient) RecentEpochStatusCount(ctx context.Context, in *QueryRecen
 	return out, nil
 }
 
+func (c *queryClient) BlsEvidence(ctx context.Context, in *QueryBlsEvidenceRequest, opts ...grpc.CallOption) (*QueryBlsEvidenceResponse, error) {
+	out := new(QueryBlsEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/BlsEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlsEvidences(ctx context.Context, in *QueryBlsEvidencesRequest, opts ...grpc.CallOption) (*QueryBlsEvidencesResponse, error) {
+	out := new(QueryBlsEvidencesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/BlsEvidences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LastCheckpointWithStatus(ctx context.Context, in *QueryLastCheckpointWithStatusRequest, opts ...grpc.CallOption) (*QueryLastCheckpointWithStatusResponse, error) {
 	out := new(QueryLastCheckpointWithStatusResponse)
 	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/LastCheckpointWithStatus", in, out, opts...)
@@ -1203,6 +1449,10 @@ type QueryServer interface {
 	// RecentEpochStatusCount queries the number of epochs with each status in
 	// recent epochs
 	RecentEpochStatusCount(context.Context, *QueryRecentEpochStatusCountRequest) (*QueryRecentEpochStatusCountResponse, error)
+	// BlsEvidence queries the evidence against a validator at a given epoch
+	BlsEvidence(context.Context, *QueryBlsEvidenceRequest) (*QueryBlsEvidenceResponse, error)
+	// BlsEvidences queries the evidences recorded at a given epoch
+	BlsEvidences(context.Context, *QueryBlsEvidencesRequest) (*QueryBlsEvidencesResponse, error)
 	// LastCheckpointWithStatus queries the last checkpoint with a given status or
 	// a more matured status
 	LastCheckpointWithStatus(context.Context, *QueryLastCheckpointWithStatusRequest) (*QueryLastCheckpointWithStatusResponse, error)
@@ -1230,6 +1480,12 @@ func (*UnimplementedQueryServer) EpochStatus(ctx context.Context, req *QueryEpoc
 func (*UnimplementedQueryServer) RecentEpochStatusCount(ctx context.Context, req *QueryRecentEpochStatusCountRequest) (*QueryRecentEpochStatusCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecentEpochStatusCount not implemented")
 }
+func (*UnimplementedQueryServer) BlsEvidence(ctx context.Context, req *QueryBlsEvidenceRequest) (*QueryBlsEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlsEvidence not implemented")
+}
+func (*UnimplementedQueryServer) BlsEvidences(ctx context.Context, req *QueryBlsEvidencesRequest) (*QueryBlsEvidencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlsEvidences not implemented")
+}
 func (*UnimplementedQueryServer) LastCheckpointWithStatus(ctx context.Context, req *QueryLastCheckpointWithStatusRequest) (*QueryLastCheckpointWithStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastCheckpointWithStatus not implemented")
 }
@@ -1346,6 +1602,42 @@ func _Query_RecentEpochStatusCount_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlsEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlsEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlsEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/BlsEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlsEvidence(ctx, req.(*QueryBlsEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlsEvidences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlsEvidencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlsEvidences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/BlsEvidences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlsEvidences(ctx, req.(*QueryBlsEvidencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LastCheckpointWithStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLastCheckpointWithStatusRequest)
 	if err := dec(in); err != nil {
@@ -1392,6 +1684,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecentEpochStatusCount",
 			Handler:    _Query_RecentEpochStatusCount_Handler,
 		},
+		{
+			MethodName: "BlsEvidence",
+			Handler:    _Query_BlsEvidence_Handler,
+		},
+		{
+			MethodName: "BlsEvidences",
+			Handler:    _Query_BlsEvidences_Handler,
+		},
 		{
 			MethodName: "LastCheckpointWithStatus",
 			Handler:    _Query_LastCheckpointWithStatus_Handler,
@@ -1860,7 +2160,7 @@ func (m *QueryRecentEpochStatusCountResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastCheckpointWithStatusRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBlsEvidenceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1870,25 +2170,32 @@ func (m *QueryLastCheckpointWithStatusRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastCheckpointWithStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBlsEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastCheckpointWithStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBlsEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastCheckpointWithStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBlsEvidenceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1898,19 +2205,19 @@ func (m *QueryLastCheckpointWithStatusResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastCheckpointWithStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBlsEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastCheckpointWithStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBlsEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RawCheckpoint != nil {
+	if m.Evidence != nil {
 		{
-			size, err := m.RawCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1923,7 +2230,7 @@ func (m *QueryLastCheckpointWithStatusResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *RawCheckpointResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBlsEvidencesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1933,40 +2240,26 @@ func (m *RawCheckpointResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RawCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBlsEvidencesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RawCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBlsEvidencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlsMultiSig != nil {
+	if m.Pagination != nil {
 		{
-			size := m.BlsMultiSig.Size()
-			i -= size
-			if _, err := m.BlsMultiSig.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Bitmap) > 0 {
-		i -= len(m.Bitmap)
-		copy(dAtA[i:], m.Bitmap)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bitmap)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BlockHashHex) > 0 {
-		i -= len(m.BlockHashHex)
-		copy(dAtA[i:], m.BlockHashHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHashHex)))
-		i--
 		dAtA[i] = 0x12
 	}
 	if m.EpochNum != 0 {
@@ -1977,7 +2270,7 @@ func (m *RawCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CheckpointStateUpdateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBlsEvidencesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1987,48 +2280,214 @@ func (m *CheckpointStateUpdateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckpointStateUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBlsEvidencesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CheckpointStateUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBlsEvidencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockTime != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
-		if err9 != nil {
-			return 0, err9
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= n9
-		i = encodeVarintQuery(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.StatusDesc) > 0 {
-		i -= len(m.StatusDesc)
-		copy(dAtA[i:], m.StatusDesc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.State != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RawCheckpointWithMetaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if len(m.Evidences) > 0 {
+		for iNdEx := len(m.Evidences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastCheckpointWithStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastCheckpointWithStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastCheckpointWithStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastCheckpointWithStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastCheckpointWithStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastCheckpointWithStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RawCheckpoint != nil {
+		{
+			size, err := m.RawCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RawCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RawCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlsMultiSig != nil {
+		{
+			size := m.BlsMultiSig.Size()
+			i -= size
+			if _, err := m.BlsMultiSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Bitmap) > 0 {
+		i -= len(m.Bitmap)
+		copy(dAtA[i:], m.Bitmap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bitmap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockHashHex) > 0 {
+		i -= len(m.BlockHashHex)
+		copy(dAtA[i:], m.BlockHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHashHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointStateUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointStateUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointStateUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTime != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintQuery(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StatusDesc) > 0 {
+		i -= len(m.StatusDesc)
+		copy(dAtA[i:], m.StatusDesc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RawCheckpointWithMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -2302,6 +2761,70 @@ func (m *QueryRecentEpochStatusCountResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBlsEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlsEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlsEvidencesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlsEvidencesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidences) > 0 {
+		for _, e := range m.Evidences {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryLastCheckpointWithStatusRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3632,6 +4155,418 @@ func (m *QueryRecentEpochStatusCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBlsEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlsEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlsEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlsEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlsEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlsEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &BlsEvidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlsEvidencesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlsEvidencesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlsEvidencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlsEvidencesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlsEvidencesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlsEvidencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidences = append(m.Evidences, &BlsEvidence{})
+			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryLastCheckpointWithStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
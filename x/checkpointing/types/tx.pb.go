@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -107,38 +108,241 @@ func (m *MsgWrappedCreateValidatorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWrappedCreateValidatorResponse proto.InternalMessageInfo
 
+// MsgRotateBlsKey defines a message for a validator to rotate its BLS key
+type MsgRotateBlsKey struct {
+	// signer is the account address of the validator's operator
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// key is the new BLS key of the validator along with a proof-of-possession
+	// built with the validator's Ed25519 key
+	Key *BlsKey `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *MsgRotateBlsKey) Reset()         { *m = MsgRotateBlsKey{} }
+func (m *MsgRotateBlsKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateBlsKey) ProtoMessage()    {}
+func (*MsgRotateBlsKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b16c54750152c21, []int{2}
+}
+func (m *MsgRotateBlsKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateBlsKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateBlsKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateBlsKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateBlsKey.Merge(m, src)
+}
+func (m *MsgRotateBlsKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateBlsKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateBlsKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateBlsKey proto.InternalMessageInfo
+
+func (m *MsgRotateBlsKey) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgRotateBlsKey) GetKey() *BlsKey {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+// MsgRotateBlsKeyResponse defines the MsgRotateBlsKey response type
+type MsgRotateBlsKeyResponse struct {
+}
+
+func (m *MsgRotateBlsKeyResponse) Reset()         { *m = MsgRotateBlsKeyResponse{} }
+func (m *MsgRotateBlsKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateBlsKeyResponse) ProtoMessage()    {}
+func (*MsgRotateBlsKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b16c54750152c21, []int{3}
+}
+func (m *MsgRotateBlsKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateBlsKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateBlsKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateBlsKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateBlsKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateBlsKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateBlsKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateBlsKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateBlsKeyResponse proto.InternalMessageInfo
+
+// MsgSubmitConflictingCheckpoint defines a message for submitting a checkpoint
+// whose BLS multi-sig is valid but whose block hash differs from that of the
+// local checkpoint of the same epoch
+type MsgSubmitConflictingCheckpoint struct {
+	// submitter is the account address of the evidence submitter
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// checkpoint is the conflicting checkpoint
+	Checkpoint *RawCheckpoint `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+}
+
+func (m *MsgSubmitConflictingCheckpoint) Reset()         { *m = MsgSubmitConflictingCheckpoint{} }
+func (m *MsgSubmitConflictingCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitConflictingCheckpoint) ProtoMessage()    {}
+func (*MsgSubmitConflictingCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b16c54750152c21, []int{4}
+}
+func (m *MsgSubmitConflictingCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitConflictingCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitConflictingCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitConflictingCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitConflictingCheckpoint.Merge(m, src)
+}
+func (m *MsgSubmitConflictingCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitConflictingCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitConflictingCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitConflictingCheckpoint proto.InternalMessageInfo
+
+// MsgSubmitConflictingCheckpointResponse defines the
+// MsgSubmitConflictingCheckpoint response type
+type MsgSubmitConflictingCheckpointResponse struct {
+	// slashed_validators is the addresses of the validators slashed by the
+	// evidence
+	SlashedValidators []string `protobuf:"bytes,1,rep,name=slashed_validators,json=slashedValidators,proto3" json:"slashed_validators,omitempty"`
+}
+
+func (m *MsgSubmitConflictingCheckpointResponse) Reset() {
+	*m = MsgSubmitConflictingCheckpointResponse{}
+}
+func (m *MsgSubmitConflictingCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitConflictingCheckpointResponse) ProtoMessage()    {}
+func (*MsgSubmitConflictingCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b16c54750152c21, []int{5}
+}
+func (m *MsgSubmitConflictingCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitConflictingCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitConflictingCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitConflictingCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitConflictingCheckpointResponse.Merge(m, src)
+}
+func (m *MsgSubmitConflictingCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitConflictingCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitConflictingCheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitConflictingCheckpointResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitConflictingCheckpointResponse) GetSlashedValidators() []string {
+	if m != nil {
+		return m.SlashedValidators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgWrappedCreateValidator)(nil), "babylon.checkpointing.v1.MsgWrappedCreateValidator")
 	proto.RegisterType((*MsgWrappedCreateValidatorResponse)(nil), "babylon.checkpointing.v1.MsgWrappedCreateValidatorResponse")
+	proto.RegisterType((*MsgRotateBlsKey)(nil), "babylon.checkpointing.v1.MsgRotateBlsKey")
+	proto.RegisterType((*MsgRotateBlsKeyResponse)(nil), "babylon.checkpointing.v1.MsgRotateBlsKeyResponse")
+	proto.RegisterType((*MsgSubmitConflictingCheckpoint)(nil), "babylon.checkpointing.v1.MsgSubmitConflictingCheckpoint")
+	proto.RegisterType((*MsgSubmitConflictingCheckpointResponse)(nil), "babylon.checkpointing.v1.MsgSubmitConflictingCheckpointResponse")
 }
 
 func init() { proto.RegisterFile("babylon/checkpointing/v1/tx.proto", fileDescriptor_6b16c54750152c21) }
 
 var fileDescriptor_6b16c54750152c21 = []byte{
-	// 357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x3d, 0x4b, 0xfb, 0x40,
-	0x1c, 0xc7, 0x73, 0xff, 0xf2, 0x77, 0x38, 0xb7, 0x50, 0xb4, 0x66, 0x48, 0x1f, 0x04, 0x91, 0x0e,
-	0x77, 0xb4, 0xc5, 0x45, 0xb7, 0x3a, 0x4a, 0x11, 0x3a, 0x28, 0x88, 0x50, 0x2e, 0xe9, 0x71, 0x0d,
-	0x79, 0xb8, 0x90, 0xdf, 0x59, 0x9a, 0x4d, 0x9c, 0xc4, 0xc9, 0xd5, 0xad, 0x2f, 0xa1, 0x2f, 0xc3,
-	0xb1, 0xa3, 0xa3, 0xb4, 0x43, 0x7d, 0x19, 0xd2, 0x24, 0x45, 0xac, 0xde, 0xe2, 0x76, 0x0f, 0x9f,
-	0xdf, 0xf7, 0x81, 0x3b, 0x5c, 0x77, 0x98, 0x93, 0x06, 0x32, 0xa2, 0xee, 0x88, 0xbb, 0x7e, 0x2c,
-	0xbd, 0x48, 0x79, 0x91, 0xa0, 0xe3, 0x16, 0x55, 0x13, 0x12, 0x27, 0x52, 0x49, 0xb3, 0x52, 0x20,
-	0xe4, 0x1b, 0x42, 0xc6, 0x2d, 0xab, 0x2c, 0xa4, 0x90, 0x19, 0x44, 0xd7, 0xab, 0x9c, 0xb7, 0x8e,
-	0xb4, 0x92, 0x4e, 0x00, 0x03, 0x9f, 0xa7, 0x05, 0x57, 0x75, 0x25, 0x84, 0x12, 0x28, 0x28, 0xe6,
-	0xe7, 0x80, 0xc3, 0x15, 0xfb, 0x32, 0xb6, 0xf6, 0x0b, 0x20, 0x84, 0x6c, 0x3a, 0x04, 0x91, 0x5f,
-	0x34, 0xe6, 0x08, 0x1f, 0xf4, 0x40, 0x5c, 0x27, 0x2c, 0x8e, 0xf9, 0xf0, 0x3c, 0xe1, 0x4c, 0xf1,
-	0x2b, 0x16, 0x78, 0x43, 0xa6, 0x64, 0x62, 0xb6, 0x71, 0xc9, 0xe7, 0x69, 0x05, 0xd5, 0xd0, 0xf1,
-	0x6e, 0xbb, 0x46, 0x74, 0xe9, 0x49, 0x37, 0x80, 0x0b, 0x9e, 0xf6, 0xd7, 0xb0, 0x79, 0x8b, 0xcb,
-	0x21, 0x88, 0x81, 0x9b, 0x49, 0x0d, 0xc6, 0x1b, 0xad, 0xca, 0xbf, 0x4c, 0xa4, 0x49, 0xf2, 0x24,
-	0xa4, 0x88, 0x4a, 0x8a, 0xa8, 0xa4, 0x07, 0x62, 0xcb, 0xbd, 0x6f, 0x86, 0x3f, 0xce, 0x4e, 0xeb,
-	0x8f, 0xd3, 0xaa, 0xf1, 0x31, 0xad, 0x1a, 0x0f, 0xab, 0x59, 0xf3, 0x57, 0xa3, 0xc6, 0x21, 0xae,
-	0x6b, 0x1b, 0xf5, 0x39, 0xc4, 0x32, 0x02, 0xde, 0x7e, 0x41, 0xb8, 0xd4, 0x03, 0x61, 0x3e, 0x21,
-	0xbc, 0xa7, 0x29, 0xdf, 0xd1, 0xf7, 0xd5, 0xea, 0x5b, 0x67, 0x7f, 0x18, 0xda, 0x84, 0xb2, 0xfe,
-	0xdf, 0xaf, 0x66, 0x4d, 0xd4, 0xbd, 0x7c, 0x5d, 0xd8, 0x68, 0xbe, 0xb0, 0xd1, 0xfb, 0xc2, 0x46,
-	0xcf, 0x4b, 0xdb, 0x98, 0x2f, 0x6d, 0xe3, 0x6d, 0x69, 0x1b, 0x37, 0x27, 0xc2, 0x53, 0xa3, 0x3b,
-	0x87, 0xb8, 0x32, 0xa4, 0x85, 0x8f, 0x3b, 0x62, 0x5e, 0xb4, 0xd9, 0xd0, 0xc9, 0xd6, 0x4f, 0x51,
-	0x69, 0xcc, 0xc1, 0xd9, 0xc9, 0xde, 0xba, 0xf3, 0x19, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x29, 0x9a,
-	0x31, 0xa2, 0x02, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x8b, 0xd3, 0x4e,
+	0x18, 0xc7, 0x3b, 0x5b, 0x7e, 0x0b, 0x9d, 0xfd, 0x81, 0x18, 0xca, 0x6e, 0x1b, 0x21, 0xfd, 0x23,
+	0xac, 0xbb, 0x85, 0x4d, 0x6c, 0x17, 0x45, 0xea, 0x45, 0xdb, 0x83, 0x07, 0x29, 0x42, 0x16, 0x5c,
+	0x10, 0xa1, 0x4c, 0xd2, 0x71, 0x1a, 0x9a, 0x64, 0x42, 0x9e, 0xd9, 0xba, 0xbd, 0x89, 0x78, 0x10,
+	0x4f, 0x5e, 0xbd, 0x2d, 0xf8, 0x06, 0xf6, 0xe0, 0xc5, 0x77, 0xe0, 0xb1, 0x78, 0xf2, 0x28, 0xed,
+	0x61, 0x7d, 0x19, 0xd2, 0x64, 0xda, 0x74, 0xab, 0x29, 0xeb, 0xde, 0x92, 0x3c, 0x9f, 0xe7, 0xfb,
+	0x7c, 0xbf, 0x33, 0x0f, 0xc1, 0x15, 0x8b, 0x58, 0x23, 0x97, 0xfb, 0x86, 0xdd, 0xa7, 0xf6, 0x20,
+	0xe0, 0x8e, 0x2f, 0x1c, 0x9f, 0x19, 0xc3, 0xba, 0x21, 0x4e, 0xf5, 0x20, 0xe4, 0x82, 0x2b, 0x05,
+	0x89, 0xe8, 0x97, 0x10, 0x7d, 0x58, 0x57, 0xf3, 0x8c, 0x33, 0x1e, 0x41, 0xc6, 0xec, 0x29, 0xe6,
+	0xd5, 0xdd, 0x54, 0x49, 0xcb, 0x85, 0xee, 0x80, 0x8e, 0x24, 0xb7, 0x9f, 0xca, 0x25, 0x1f, 0x24,
+	0x5a, 0xb2, 0x39, 0x78, 0x1c, 0x0c, 0x10, 0x64, 0x10, 0x33, 0x16, 0x15, 0x24, 0xf1, 0xa8, 0xee,
+	0x48, 0xc0, 0x83, 0x48, 0xc0, 0x03, 0x26, 0x0b, 0xc5, 0xb8, 0xd0, 0x8d, 0x5d, 0xc6, 0x2f, 0x71,
+	0xa9, 0x3a, 0x46, 0xb8, 0xd8, 0x01, 0x76, 0x1c, 0x92, 0x20, 0xa0, 0xbd, 0x76, 0x48, 0x89, 0xa0,
+	0xcf, 0x89, 0xeb, 0xf4, 0x88, 0xe0, 0xa1, 0xd2, 0xc0, 0xd9, 0x01, 0x1d, 0x15, 0x50, 0x19, 0xed,
+	0x6d, 0x35, 0xca, 0x7a, 0xda, 0x19, 0xe8, 0x2d, 0x17, 0x9e, 0xd2, 0x91, 0x39, 0x83, 0x95, 0x97,
+	0x38, 0xef, 0x01, 0xeb, 0xda, 0x91, 0x54, 0x77, 0x38, 0xd7, 0x2a, 0x6c, 0x44, 0x22, 0x35, 0x5d,
+	0x8e, 0x97, 0x29, 0x74, 0x99, 0x42, 0xef, 0x00, 0x5b, 0x99, 0x6e, 0x2a, 0xde, 0x1f, 0xdf, 0x9a,
+	0x95, 0xf7, 0x67, 0xa5, 0xcc, 0xaf, 0xb3, 0x52, 0xe6, 0xed, 0xc5, 0x79, 0xed, 0xaf, 0x83, 0xaa,
+	0xb7, 0x71, 0x25, 0x35, 0x91, 0x49, 0x21, 0xe0, 0x3e, 0xd0, 0xea, 0x3b, 0x84, 0x6f, 0x74, 0x80,
+	0x99, 0x5c, 0x10, 0x41, 0x63, 0xfb, 0xca, 0x5d, 0xbc, 0x09, 0x0e, 0xf3, 0x69, 0x18, 0x05, 0xce,
+	0xb5, 0x0a, 0xdf, 0xbf, 0x1c, 0xe4, 0xa5, 0xdd, 0xc7, 0xbd, 0x5e, 0x48, 0x01, 0x8e, 0x44, 0xe8,
+	0xf8, 0xcc, 0x94, 0xdc, 0xfc, 0x7c, 0x36, 0xfe, 0xe1, 0x7c, 0x9a, 0x5b, 0x33, 0xe7, 0x52, 0xa0,
+	0x5a, 0xc4, 0x3b, 0x2b, 0x2e, 0x16, 0x0e, 0xbf, 0x22, 0xac, 0x75, 0x80, 0x1d, 0x9d, 0x58, 0x9e,
+	0x23, 0xda, 0xdc, 0x7f, 0xe5, 0x3a, 0xf6, 0x4c, 0xaf, 0xbd, 0x10, 0x57, 0xee, 0xe3, 0x1c, 0x44,
+	0x65, 0x71, 0x05, 0xcf, 0x09, 0xaa, 0x3c, 0xc1, 0x38, 0xb1, 0x28, 0xdd, 0xdf, 0x49, 0x77, 0x6f,
+	0x92, 0xd7, 0xc9, 0x50, 0x73, 0xa9, 0xb5, 0xb9, 0xbd, 0x7c, 0x1b, 0xc9, 0x80, 0xea, 0x31, 0xde,
+	0x5d, 0x6f, 0x7d, 0x9e, 0x52, 0x39, 0xc0, 0x0a, 0xb8, 0x04, 0xfa, 0xb4, 0x97, 0xdc, 0x20, 0x14,
+	0x50, 0x39, 0xbb, 0x97, 0x33, 0x6f, 0xca, 0xca, 0xe2, 0xf6, 0xa0, 0xf1, 0x39, 0x8b, 0xb3, 0x1d,
+	0x60, 0xca, 0x07, 0x84, 0xb7, 0x53, 0x76, 0xf6, 0x30, 0x3d, 0x48, 0xea, 0x5a, 0xa8, 0x0f, 0xaf,
+	0xd1, 0xb4, 0xc8, 0xe0, 0xe2, 0xff, 0x2f, 0xed, 0xd1, 0xfe, 0x5a, 0xb1, 0x65, 0x54, 0xad, 0x5f,
+	0x19, 0x5d, 0x4c, 0xfb, 0x84, 0xf0, 0xad, 0x75, 0x4b, 0xf1, 0x60, 0xad, 0xe4, 0x9a, 0x4e, 0xf5,
+	0xd1, 0x75, 0x3b, 0xe7, 0xde, 0xd4, 0xff, 0xde, 0x5c, 0x9c, 0xd7, 0x50, 0xeb, 0xd9, 0xb7, 0x89,
+	0x86, 0xc6, 0x13, 0x0d, 0xfd, 0x9c, 0x68, 0xe8, 0xe3, 0x54, 0xcb, 0x8c, 0xa7, 0x5a, 0xe6, 0xc7,
+	0x54, 0xcb, 0xbc, 0xb8, 0xc7, 0x1c, 0xd1, 0x3f, 0xb1, 0x74, 0x9b, 0x7b, 0x86, 0x1c, 0x66, 0xf7,
+	0x89, 0xe3, 0xcf, 0x5f, 0x8c, 0xd3, 0x95, 0x1f, 0xa1, 0x18, 0x05, 0x14, 0xac, 0xcd, 0xe8, 0x67,
+	0x75, 0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x62, 0x17, 0xef, 0xa9, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +359,13 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// WrappedCreateValidator defines a method for registering a new validator
 	WrappedCreateValidator(ctx context.Context, in *MsgWrappedCreateValidator, opts ...grpc.CallOption) (*MsgWrappedCreateValidatorResponse, error)
+	// RotateBlsKey defines a method for a validator to replace its BLS key. The
+	// new BLS key takes effect from the next epoch on
+	RotateBlsKey(ctx context.Context, in *MsgRotateBlsKey, opts ...grpc.CallOption) (*MsgRotateBlsKeyResponse, error)
+	// SubmitConflictingCheckpoint defines a method for submitting a checkpoint
+	// that conflicts with the local checkpoint of the same epoch, as the
+	// evidence against its signers
+	SubmitConflictingCheckpoint(ctx context.Context, in *MsgSubmitConflictingCheckpoint, opts ...grpc.CallOption) (*MsgSubmitConflictingCheckpointResponse, error)
 }
 
 type msgClient struct {
@@ -174,10 +385,35 @@ func (c *msgClient) WrappedCreateValidator(ctx context.Context, in *MsgWrappedCr
 	return out, nil
 }
 
+func (c *msgClient) RotateBlsKey(ctx context.Context, in *MsgRotateBlsKey, opts ...grpc.CallOption) (*MsgRotateBlsKeyResponse, error) {
+	out := new(MsgRotateBlsKeyResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Msg/RotateBlsKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitConflictingCheckpoint(ctx context.Context, in *MsgSubmitConflictingCheckpoint, opts ...grpc.CallOption) (*MsgSubmitConflictingCheckpointResponse, error) {
+	out := new(MsgSubmitConflictingCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Msg/SubmitConflictingCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// WrappedCreateValidator defines a method for registering a new validator
 	WrappedCreateValidator(context.Context, *MsgWrappedCreateValidator) (*MsgWrappedCreateValidatorResponse, error)
+	// RotateBlsKey defines a method for a validator to replace its BLS key. The
+	// new BLS key takes effect from the next epoch on
+	RotateBlsKey(context.Context, *MsgRotateBlsKey) (*MsgRotateBlsKeyResponse, error)
+	// SubmitConflictingCheckpoint defines a method for submitting a checkpoint
+	// that conflicts with the local checkpoint of the same epoch, as the
+	// evidence against its signers
+	SubmitConflictingCheckpoint(context.Context, *MsgSubmitConflictingCheckpoint) (*MsgSubmitConflictingCheckpointResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -187,6 +423,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) WrappedCreateValidator(ctx context.Context, req *MsgWrappedCreateValidator) (*MsgWrappedCreateValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WrappedCreateValidator not implemented")
 }
+func (*UnimplementedMsgServer) RotateBlsKey(ctx context.Context, req *MsgRotateBlsKey) (*MsgRotateBlsKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateBlsKey not implemented")
+}
+func (*UnimplementedMsgServer) SubmitConflictingCheckpoint(ctx context.Context, req *MsgSubmitConflictingCheckpoint) (*MsgSubmitConflictingCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitConflictingCheckpoint not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -210,6 +452,42 @@ func _Msg_WrappedCreateValidator_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateBlsKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateBlsKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateBlsKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Msg/RotateBlsKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateBlsKey(ctx, req.(*MsgRotateBlsKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitConflictingCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitConflictingCheckpoint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitConflictingCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Msg/SubmitConflictingCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitConflictingCheckpoint(ctx, req.(*MsgSubmitConflictingCheckpoint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.checkpointing.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -218,6 +496,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WrappedCreateValidator",
 			Handler:    _Msg_WrappedCreateValidator_Handler,
 		},
+		{
+			MethodName: "RotateBlsKey",
+			Handler:    _Msg_RotateBlsKey_Handler,
+		},
+		{
+			MethodName: "SubmitConflictingCheckpoint",
+			Handler:    _Msg_SubmitConflictingCheckpoint_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/checkpointing/v1/tx.proto",
@@ -293,6 +579,145 @@ func (m *MsgWrappedCreateValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRotateBlsKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateBlsKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateBlsKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Key != nil {
+		{
+			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateBlsKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateBlsKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateBlsKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitConflictingCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitConflictingCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitConflictingCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Checkpoint != nil {
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitConflictingCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitConflictingCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitConflictingCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashedValidators) > 0 {
+		for iNdEx := len(m.SlashedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashedValidators[iNdEx])
+			copy(dAtA[i:], m.SlashedValidators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -330,12 +755,70 @@ func (m *MsgWrappedCreateValidatorResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+func (m *MsgRotateBlsKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateBlsKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitConflictingCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitConflictingCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashedValidators) > 0 {
+		for _, s := range m.SlashedValidators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *MsgWrappedCreateValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -508,6 +991,374 @@ func (m *MsgWrappedCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRotateBlsKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateBlsKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateBlsKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Key == nil {
+				m.Key = &BlsKey{}
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateBlsKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateBlsKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateBlsKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitConflictingCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitConflictingCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitConflictingCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &RawCheckpoint{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitConflictingCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitConflictingCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitConflictingCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedValidators = append(m.SlashedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,11 +5,15 @@ import (
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testhelper "github.com/babylonchain/babylon/testutil/helper"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 )
 
 // FuzzAddBLSSigVoteExtension_MultipleVals tests adding BLS signatures via VoteExtension
@@ -45,6 +49,58 @@ func FuzzAddBLSSigVoteExtension_MultipleVals(f *testing.F) {
 	})
 }
 
+// FuzzAddBLSSigVoteExtension_EpochIntervalChange tests adding BLS signatures
+// via VoteExtension when the epoch interval is updated in the middle of an epoch
+func FuzzAddBLSSigVoteExtension_EpochIntervalChange(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		// generate the validator set with 10 validators as genesis
+		genesisValSet, privSigner, err := datagen.GenesisValidatorSetWithPrivSigner(10)
+		require.NoError(t, err)
+		helper := testhelper.NewHelperWithValSet(t, genesisValSet, privSigner)
+		ek := helper.App.EpochingKeeper
+		ck := helper.App.CheckpointingKeeper
+
+		// go to a random block in the middle of epoch 1
+		interval := ek.GetParams(helper.Ctx).EpochInterval
+		for i := uint64(0); i < datagen.RandomInt(r, int(interval)-1); i++ {
+			_, err := helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		require.Equal(t, uint64(1), ek.GetEpoch(helper.Ctx).EpochNumber)
+
+		// update the epoch interval, which takes effect from epoch 2 on
+		// NOTE: the update is written to the app's store directly, as the
+		// helper's context is a branch that is not written to later blocks
+		updateCtx := helper.App.NewUncachedContext(false, cmtproto.Header{}).WithHeaderInfo(helper.Ctx.HeaderInfo())
+		params := ek.GetParams(updateCtx)
+		params.EpochInterval = datagen.RandomInt(r, 20) + 2
+		_, err = helper.MsgSrvr.UpdateParams(updateCtx, &epochingtypes.MsgUpdateParams{
+			Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			Params:    params,
+		})
+		require.NoError(t, err)
+
+		// go to epoch 3, ensure the checkpoints of epochs 1 and 2 are sealed
+		// with BLS signatures over the last blocks of their own intervals
+		for ek.GetEpoch(helper.Ctx).EpochNumber < 3 {
+			_, err := helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		for epochNum := uint64(1); epochNum <= 2; epochNum++ {
+			ckpt, err := ck.GetRawCheckpoint(helper.Ctx, epochNum)
+			require.NoError(t, err)
+			require.Equal(t, types.Sealed, ckpt.Status)
+		}
+		epoch2, err := ek.GetHistoricalEpoch(helper.Ctx, 2)
+		require.NoError(t, err)
+		require.Equal(t, params.EpochInterval, epoch2.CurrentEpochInterval)
+		require.Equal(t, epoch2.GetSealerBlockHeight(), ek.GetEpoch(helper.Ctx).FirstBlockHeight)
+	})
+}
+
 // FuzzAddBLSSigVoteExtension_InsufficientVotingPower tests adding BLS signatures
 // with insufficient voting power
 func FuzzAddBLSSigVoteExtension_InsufficientVotingPower(f *testing.F) {
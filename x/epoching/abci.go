@@ -69,6 +69,7 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 // EndBlocker is called at the end of every block.
 // If reaching an epoch boundary, then
 // - forward validator-related msgs (bonded -> unbonding) to the staking module
+// - record the execution results of these msgs
 // - trigger AfterEpochEnds hook
 // - emit EndEpoch event
 // NOTE: The epoching module is not responsible for checkpoint-assisted unbonding (unbonding -> unbonded). Instead, it wraps the staking module and exposes interfaces to the checkpointing module. The checkpointing module will do the actual checkpoint-assisted unbonding upon each EndBlock.
@@ -90,6 +91,11 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, e
 		// forward each msg in the msg queue to the right keeper
 		for _, msg := range queuedMsgs {
 			res, err := k.HandleQueuedMsg(ctx, msg)
+			// persist the execution result so that users can learn the
+			// outcome of their queued messages after the epoch has ended
+			if recordErr := k.RecordQueuedMsgResult(ctx, epoch.EpochNumber, msg, err); recordErr != nil {
+				return nil, recordErr
+			}
 			// skip this failed msg and emit and event signalling it
 			// we do not panic here as some users may wrap an invalid message
 			// (e.g., self-delegate coins more than its balance, wrong coding of addresses, ...)
@@ -128,6 +134,10 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, e
 			}
 		}
 
+		// prune the execution results of queued messages of the epochs that
+		// are out of the retention window
+		k.PruneQueuedMsgResults(ctx, epoch.EpochNumber)
+
 		// update validator set
 		validatorSetUpdate = k.ApplyAndReturnValidatorSetUpdates(ctx)
 		sdkCtx.Logger().Info(fmt.Sprintf("Epoching: validator set update of epoch %d: %v", epoch.EpochNumber, validatorSetUpdate))
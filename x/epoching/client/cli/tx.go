@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -32,6 +33,7 @@ func GetTxCmd() *cobra.Command {
 		NewRedelegateCmd(),
 		NewUnbondCmd(),
 		NewCancelUnbondingCmd(),
+		NewWrappedExecCmd(),
 	)
 
 	return cmd
@@ -226,3 +228,43 @@ $ %s tx epoching unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100%s --from m
 
 	return cmd
 }
+
+func NewWrappedExecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wrapped-exec [tx-json-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Execute staking messages on behalf of their delegators via authz grants",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the staking messages in the given transaction file on behalf of
+their delegators, who have granted the signer the corresponding authorizations
+via the authz module. The messages are queued until the end of the epoch.
+
+Example:
+$ %s tx epoching wrapped-exec tx.json --from grantee
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgWrappedExec(clientCtx.GetFromAddress(), theTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
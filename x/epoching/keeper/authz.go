@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/babylonchain/babylon/x/epoching/types"
+)
+
+// acceptAuthzGrant verifies that the grantee is authorised to execute the
+// given staking message on behalf of its delegator, and updates or deletes
+// the grant in the same way the authz module does upon MsgExec
+func (k Keeper) acceptAuthzGrant(ctx context.Context, grantee sdk.AccAddress, msg sdk.Msg) error {
+	granter, err := types.StakingMsgDelegator(msg)
+	if err != nil {
+		return err
+	}
+	// the delegator does not need a grant to act on its own behalf
+	if granter.Equals(grantee) {
+		return nil
+	}
+
+	if k.ak == nil {
+		return authz.ErrNoAuthorizationFound
+	}
+	msgType := sdk.MsgTypeURL(msg)
+	authorization, expiration := k.ak.GetAuthorization(ctx, grantee, granter, msgType)
+	if authorization == nil {
+		return authz.ErrNoAuthorizationFound.Wrapf("grantee %s, granter %s, msg %s", grantee, granter, msgType)
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if resp.Delete {
+		err = k.ak.DeleteGrant(ctx, grantee, granter, msgType)
+	} else if resp.Updated != nil {
+		err = k.ak.SaveGrant(ctx, grantee, granter, resp.Updated, expiration)
+	}
+	if err != nil {
+		return err
+	}
+	if !resp.Accept {
+		return sdkerrors.ErrUnauthorized
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/epoching/types"
+)
+
+func benchEnqueueMsg(b *testing.B, maxQueueSize uint64) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+
+	k, ctx := testkeeper.EpochingKeeper(b)
+	k.InitEpoch(ctx)
+	k.InitMsgQueue(ctx)
+	params := k.GetParams(ctx)
+	params.MaxQueueSize = maxQueueSize
+	params.MaxMsgsPerAccount = 0
+	require.NoError(b, k.SetParams(ctx, params))
+
+	// fill the queue with msgs of random fees
+	valAddr := datagen.GenRandomValidatorAddress()
+	maxFee := uint64(1000)
+	for i := uint64(0); i < maxQueueSize; i++ {
+		fee := datagen.RandomInt(r, int(maxFee)) + 1
+		err := k.EnqueueMsg(types.ContextWithTxFee(ctx, fee), genRandomQueuedUndelegate(b, r, valAddr))
+		require.NoError(b, err)
+	}
+	// commit the filled queue, as iterating a store with many uncommitted
+	// writes is not representative of the cost of a block
+	ctx.MultiStore().(storetypes.CommitMultiStore).Commit()
+
+	msgs := make([]types.QueuedMessage, 0, b.N)
+	for i := 0; i < b.N; i++ {
+		msgs = append(msgs, genRandomQueuedUndelegate(b, r, valAddr))
+	}
+
+	// Reset timer before the benchmark loop starts
+	b.ResetTimer()
+
+	// each msg pays a higher fee than all queued msgs, and thus evicts the
+	// queued msg with the lowest fee
+	for i := 0; i < b.N; i++ {
+		fee := maxFee + uint64(i) + 1
+		if err := k.EnqueueMsg(types.ContextWithTxFee(ctx, fee), msgs[i]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEnqueueMsg_100(b *testing.B)   { benchEnqueueMsg(b, 100) }
+func BenchmarkEnqueueMsg_1000(b *testing.B)  { benchEnqueueMsg(b, 1000) }
+func BenchmarkEnqueueMsg_10000(b *testing.B) { benchEnqueueMsg(b, 10000) }
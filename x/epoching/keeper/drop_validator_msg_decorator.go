@@ -3,6 +3,7 @@ package keeper
 import (
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -25,6 +26,8 @@ func NewDropValidatorMsgDecorator(ek Keeper) *DropValidatorMsgDecorator {
 // - MsgUndelegate
 // - MsgBeginRedelegate
 // - MsgCancelUnbondingDelegation
+// including those nested in authz's MsgExec, which shall be submitted via
+// MsgWrappedExec instead
 func (qmd DropValidatorMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// skip if at genesis block, as genesis state contains txs that bootstrap the initial validator set
 	if ctx.BlockHeight() == 0 {
@@ -40,11 +43,24 @@ func (qmd DropValidatorMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 	return next(ctx, tx, simulate)
 }
 
-// IsValidatorRelatedMsg checks if the given message is of non-wrapped type, which should be rejected
+// IsValidatorRelatedMsg checks if the given message is of non-wrapped type, which should be rejected.
+// Messages nested in authz's MsgExec are checked recursively, and a MsgExec whose
+// nested messages cannot be decoded is rejected as well.
 func (qmd DropValidatorMsgDecorator) IsValidatorRelatedMsg(msg sdk.Msg) bool {
-	switch msg.(type) {
+	switch m := msg.(type) {
 	case *stakingtypes.MsgCreateValidator, *stakingtypes.MsgDelegate, *stakingtypes.MsgUndelegate, *stakingtypes.MsgBeginRedelegate, *stakingtypes.MsgCancelUnbondingDelegation:
 		return true
+	case *authz.MsgExec:
+		nestedMsgs, err := m.GetMessages()
+		if err != nil {
+			return true
+		}
+		for _, nestedMsg := range nestedMsgs {
+			if qmd.IsValidatorRelatedMsg(nestedMsg) {
+				return true
+			}
+		}
+		return false
 	default:
 		return false
 	}
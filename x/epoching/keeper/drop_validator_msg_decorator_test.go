@@ -3,13 +3,22 @@ package keeper
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/epoching/types"
 )
 
 func TestDropValidatorMsgDecorator(t *testing.T) {
+	newMsgExec := func(msgs ...sdk.Msg) *authz.MsgExec {
+		msgExec := authz.NewMsgExec(sdk.AccAddress("grantee"), msgs)
+		return &msgExec
+	}
+
 	testCases := []struct {
 		msg        sdk.Msg
 		expectPass bool
@@ -20,8 +29,14 @@ func TestDropValidatorMsgDecorator(t *testing.T) {
 		{&stakingtypes.MsgUndelegate{}, true},
 		{&stakingtypes.MsgBeginRedelegate{}, true},
 		{&stakingtypes.MsgCancelUnbondingDelegation{}, true},
+		// validator-related messages nested in authz's MsgExec
+		{newMsgExec(&stakingtypes.MsgDelegate{}), true},
+		{newMsgExec(&banktypes.MsgSend{}, &stakingtypes.MsgUndelegate{}), true},
+		{newMsgExec(newMsgExec(&stakingtypes.MsgBeginRedelegate{})), true},
 		// allowed message types
 		{&stakingtypes.MsgEditValidator{}, false},
+		{newMsgExec(&banktypes.MsgSend{}), false},
+		{&types.MsgWrappedExec{Msgs: newMsgExec(&stakingtypes.MsgDelegate{}).Msgs}, false},
 	}
 
 	decorator := NewDropValidatorMsgDecorator(Keeper{})
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -11,6 +13,7 @@ import (
 	"cosmossdk.io/store/prefix"
 	"github.com/babylonchain/babylon/x/epoching/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 // InitMsgQueue initialises the msg queue length of the current epoch to 0
@@ -57,23 +60,270 @@ func (k Keeper) incCurrentQueueLength(ctx context.Context) {
 	store.Set(epochNumberBytes, incrementedQueueLenBytes)
 }
 
-// EnqueueMsg enqueues a message to the queue of the current epoch
-func (k Keeper) EnqueueMsg(ctx context.Context, msg types.QueuedMessage) {
+// EnqueueMsg enqueues a message to the queue of the current epoch. The fee of
+// its tx, as recorded in the context by the QueuedMsgFeeDecorator, is split
+// among all messages queued by the tx, so the messages of the tx queued
+// earlier are re-priced with the message. When the queue is full, the message
+// evicts the queued message with the lowest fee if its fee is higher, and is
+// rejected otherwise. The message is also rejected if its account has reached
+// the maximum number of queued messages in the current epoch. A rejected
+// message is recorded as failed, and the reason of the rejection is returned.
+func (k Keeper) EnqueueMsg(ctx context.Context, msg types.QueuedMessage) error {
+	params := k.GetParams(ctx)
 	epochNumber := k.GetEpoch(ctx).EpochNumber
-	store := k.msgQueueStore(ctx, epochNumber)
+	msg.MsgIndex = k.nextTxMsgIndex(ctx, epochNumber, msg.TxId)
+
+	// enforce the limit of queued messages per account
+	account := queuedMsgAccount(&msg)
+	if params.MaxMsgsPerAccount > 0 && account != nil && k.getAccountQueueLength(ctx, epochNumber, account) >= params.MaxMsgsPerAccount {
+		msg.Fee = types.TxFeeFromContext(ctx)
+		return k.rejectQueuedMsg(ctx, epochNumber, &msg, types.ErrAccountQueueFull)
+	}
+
+	// share the fee of the tx among the messages queued by it so far, so that
+	// a tx cannot queue many messages for the fee of a single one
+	txIndices := k.getTxQueuedMsgIndices(ctx, epochNumber, msg.TxId)
+	msg.Fee = types.TxFeeFromContext(ctx) / uint64(len(txIndices)+1)
+
+	queueLen := k.GetQueueLength(ctx, epochNumber)
+	if params.MaxQueueSize > 0 && queueLen >= params.MaxQueueSize {
+		// the messages of the tx queued earlier pay at least the fee of the
+		// message, so they are never the ones evicted by it
+		evictedIndex, evictedFee, found := k.getLowestFeeQueuedMsg(ctx, epochNumber)
+		if !found || evictedFee >= msg.Fee {
+			return k.rejectQueuedMsg(ctx, epochNumber, &msg, types.ErrQueueFull)
+		}
+		if err := k.evictQueuedMsg(ctx, epochNumber, evictedIndex, evictedFee, msg.Fee); err != nil {
+			return err
+		}
+	} else {
+		// increment queue length
+		k.incCurrentQueueLength(ctx)
+	}
+
+	// the message is queued, so the messages of the tx queued earlier share
+	// the fee with it
+	for _, index := range txIndices {
+		k.repriceQueuedMsg(ctx, epochNumber, index, msg.Fee)
+	}
 
-	// key: index, in this case = queueLenBytes
-	queueLen := k.GetCurrentQueueLength(ctx)
-	queueLenBytes := sdk.Uint64ToBigEndian(queueLen)
-	// value: msgBytes
-	msgBytes, err := k.cdc.MarshalInterface(&msg)
+	// key: index, which is always after the indices of all queued messages so
+	// that messages are executed in the order they are queued, even if some
+	// messages have been evicted from the queue
+	index := k.nextQueueIndex(ctx, epochNumber)
+	k.setQueuedMsg(ctx, epochNumber, index, &msg)
+	k.msgQueueFeeIndexStore(ctx, epochNumber).Set(msgQueueFeeIndexKey(msg.Fee, index), sdk.Uint64ToBigEndian(index))
+	k.txQueuedMsgStore(ctx, epochNumber, msg.TxId).Set(sdk.Uint64ToBigEndian(index), []byte{0x00})
+	if account != nil {
+		k.setAccountQueueLength(ctx, epochNumber, account, k.getAccountQueueLength(ctx, epochNumber, account)+1)
+	}
+
+	return nil
+}
+
+// nextQueueIndex returns the index following the last queued message of the
+// given epoch, or 0 if the queue is empty
+func (k Keeper) nextQueueIndex(ctx context.Context, epochNumber uint64) uint64 {
+	iter := k.msgQueueStore(ctx, epochNumber).ReverseIterator(nil, nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0
+	}
+	return sdk.BigEndianToUint64(iter.Key()) + 1
+}
+
+// setQueuedMsg stores the message at the given index of the queue of the
+// given epoch
+func (k Keeper) setQueuedMsg(ctx context.Context, epochNumber uint64, index uint64, msg *types.QueuedMessage) {
+	msgBytes, err := k.cdc.MarshalInterface(msg)
 	if err != nil {
 		panic(errorsmod.Wrap(types.ErrMarshal, err.Error()))
 	}
-	store.Set(queueLenBytes, msgBytes)
+	k.msgQueueStore(ctx, epochNumber).Set(sdk.Uint64ToBigEndian(index), msgBytes)
+}
 
-	// increment queue length
-	k.incCurrentQueueLength(ctx)
+// getQueuedMsg returns the message at the given index of the queue of the
+// given epoch
+func (k Keeper) getQueuedMsg(ctx context.Context, epochNumber uint64, index uint64) *types.QueuedMessage {
+	bz := k.msgQueueStore(ctx, epochNumber).Get(sdk.Uint64ToBigEndian(index))
+	if bz == nil {
+		return nil
+	}
+	return k.unmarshalQueuedMsg(bz)
+}
+
+func (k Keeper) unmarshalQueuedMsg(bz []byte) *types.QueuedMessage {
+	var sdkMsg sdk.Msg
+	if err := k.cdc.UnmarshalInterface(bz, &sdkMsg); err != nil {
+		panic(errorsmod.Wrap(types.ErrUnmarshal, err.Error()))
+	}
+	queuedMsg, ok := sdkMsg.(*types.QueuedMessage)
+	if !ok {
+		panic("invalid queued message")
+	}
+	return queuedMsg
+}
+
+// getTxQueuedMsgIndices returns the indices of the messages queued by the
+// given tx in the given epoch
+func (k Keeper) getTxQueuedMsgIndices(ctx context.Context, epochNumber uint64, txID []byte) []uint64 {
+	indices := []uint64{}
+	iter := k.txQueuedMsgStore(ctx, epochNumber, txID).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		indices = append(indices, sdk.BigEndianToUint64(iter.Key()))
+	}
+	return indices
+}
+
+// nextTxMsgIndex returns the position of the next message that the given tx
+// submits to the queue of the given epoch, i.e., the number of its messages
+// queued or rejected so far. The messages of a tx are never evicted while the
+// tx is executed, as they pay at least the fee of any later message of the tx,
+// and the rejected ones are the results recorded under the tx so far
+func (k Keeper) nextTxMsgIndex(ctx context.Context, epochNumber uint64, txID []byte) uint64 {
+	// messages without tx IDs, e.g., those queued at genesis, are not told
+	// apart by their position
+	if len(txID) == 0 {
+		return 0
+	}
+	numQueued := uint64(len(k.getTxQueuedMsgIndices(ctx, epochNumber, txID)))
+	numRejected := uint64(len(k.GetQueuedMsgResultsByTx(ctx, txID)))
+	return numQueued + numRejected
+}
+
+// repriceQueuedMsg updates the fee of the queued message at the given index
+// of the queue of the given epoch
+func (k Keeper) repriceQueuedMsg(ctx context.Context, epochNumber uint64, index uint64, fee uint64) {
+	queuedMsg := k.getQueuedMsg(ctx, epochNumber, index)
+	if queuedMsg == nil {
+		// the tx index is inconsistent with the queue
+		panic(fmt.Sprintf("no queued message at index %d of epoch %d", index, epochNumber))
+	}
+	feeIndexStore := k.msgQueueFeeIndexStore(ctx, epochNumber)
+	feeIndexStore.Delete(msgQueueFeeIndexKey(queuedMsg.Fee, index))
+	queuedMsg.Fee = fee
+	k.setQueuedMsg(ctx, epochNumber, index, queuedMsg)
+	feeIndexStore.Set(msgQueueFeeIndexKey(fee, index), sdk.Uint64ToBigEndian(index))
+}
+
+// getLowestFeeQueuedMsg returns the index and the fee of the queued message
+// with the lowest fee in the given epoch. Among messages with the same fee,
+// the latest queued one is returned.
+func (k Keeper) getLowestFeeQueuedMsg(ctx context.Context, epochNumber uint64) (uint64, uint64, bool) {
+	iter := k.msgQueueFeeIndexStore(ctx, epochNumber).Iterator(nil, nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, 0, false
+	}
+	fee := sdk.BigEndianToUint64(iter.Key()[:8])
+	index := sdk.BigEndianToUint64(iter.Value())
+	return index, fee, true
+}
+
+// evictQueuedMsg removes the queued message at the given index from the queue
+// of the given epoch, refunds its escrowed funds, and
+// records it as failed
+func (k Keeper) evictQueuedMsg(ctx context.Context, epochNumber uint64, index uint64, fee uint64, evictedByFee uint64) error {
+	evictedMsg := k.getQueuedMsg(ctx, epochNumber, index)
+	if evictedMsg == nil {
+		// the fee index is inconsistent with the queue
+		panic(fmt.Sprintf("no queued message at index %d of epoch %d", index, epochNumber))
+	}
+	k.msgQueueStore(ctx, epochNumber).Delete(sdk.Uint64ToBigEndian(index))
+	k.msgQueueFeeIndexStore(ctx, epochNumber).Delete(msgQueueFeeIndexKey(fee, index))
+	k.txQueuedMsgStore(ctx, epochNumber, evictedMsg.TxId).Delete(sdk.Uint64ToBigEndian(index))
+	if account := queuedMsgAccount(evictedMsg); account != nil {
+		k.setAccountQueueLength(ctx, epochNumber, account, k.getAccountQueueLength(ctx, epochNumber, account)-1)
+	}
+
+	if err := k.releaseQueuedMsgEscrow(ctx, evictedMsg); err != nil {
+		return err
+	}
+	if err := k.RecordQueuedMsgResult(ctx, epochNumber, evictedMsg, types.ErrQueuedMsgEvicted); err != nil {
+		return err
+	}
+
+	delAddrStr, _ := evictedMsg.DelegatorAndValidator()
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventQueuedMsgEvicted{
+		EpochNumber:      epochNumber,
+		TxId:             evictedMsg.TxId,
+		MsgId:            evictedMsg.MsgId,
+		DelegatorAddress: delAddrStr,
+		Fee:              evictedMsg.Fee,
+		EvictedByFee:     evictedByFee,
+		MsgIndex:         evictedMsg.MsgIndex,
+	})
+}
+
+// rejectQueuedMsg drops the given message instead of queueing it. Its escrowed
+// funds are refunded, it is recorded as failed with the given reason, and an
+// event signalling the rejection is emitted. The reason is returned, so that
+// the caller can accept the rejection via acceptQueuedMsgRejection to keep
+// these state changes and events.
+func (k Keeper) rejectQueuedMsg(ctx context.Context, epochNumber uint64, msg *types.QueuedMessage, reason error) error {
+	if err := k.releaseQueuedMsgEscrow(ctx, msg); err != nil {
+		return err
+	}
+	if err := k.RecordQueuedMsgResult(ctx, epochNumber, msg, reason); err != nil {
+		return err
+	}
+
+	delAddrStr, _ := msg.DelegatorAndValidator()
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventQueuedMsgRejected{
+		EpochNumber:      epochNumber,
+		TxId:             msg.TxId,
+		MsgId:            msg.MsgId,
+		DelegatorAddress: delAddrStr,
+		Fee:              msg.Fee,
+		Reason:           reason.Error(),
+		MsgIndex:         msg.MsgIndex,
+	}); err != nil {
+		return err
+	}
+	return reason
+}
+
+// acceptQueuedMsgRejection returns nil if the given error is the reason of a
+// message being rejected from the queue, and the error otherwise. Msg servers
+// accept rejections, so that the txs of rejected messages do not fail and the
+// failed results and the events of the rejections are kept.
+func acceptQueuedMsgRejection(err error) error {
+	if errors.Is(err, types.ErrQueueFull) || errors.Is(err, types.ErrAccountQueueFull) {
+		return nil
+	}
+	return err
+}
+
+// queuedMsgAccount returns the account queueing the given message, or nil if
+// the message does not carry a valid account, in which case the message
+// fails at the end of the epoch anyway
+func queuedMsgAccount(msg *types.QueuedMessage) sdk.AccAddress {
+	delAddrStr, _ := msg.DelegatorAndValidator()
+	account, err := sdk.AccAddressFromBech32(delAddrStr)
+	if err != nil {
+		return nil
+	}
+	return account
+}
+
+// getAccountQueueLength returns the number of messages queued by the given
+// account in the given epoch
+func (k Keeper) getAccountQueueLength(ctx context.Context, epochNumber uint64, account sdk.AccAddress) uint64 {
+	bz := k.accountQueueLengthStore(ctx, epochNumber).Get(account)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setAccountQueueLength(ctx context.Context, epochNumber uint64, account sdk.AccAddress, queueLen uint64) {
+	store := k.accountQueueLengthStore(ctx, epochNumber)
+	if queueLen == 0 {
+		store.Delete(account)
+		return
+	}
+	store.Set(account, sdk.Uint64ToBigEndian(queueLen))
 }
 
 // GetEpochMsgs returns the set of messages queued in a given epoch
@@ -85,16 +335,7 @@ func (k Keeper) GetEpochMsgs(ctx context.Context, epochNumber uint64) []*types.Q
 	iterator := storetypes.KVStorePrefixIterator(store, nil)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		queuedMsgBytes := iterator.Value()
-		var sdkMsg sdk.Msg
-		if err := k.cdc.UnmarshalInterface(queuedMsgBytes, &sdkMsg); err != nil {
-			panic(errorsmod.Wrap(types.ErrUnmarshal, err.Error()))
-		}
-		queuedMsg, ok := sdkMsg.(*types.QueuedMessage)
-		if !ok {
-			panic("invalid queued message")
-		}
-		queuedMsgs = append(queuedMsgs, queuedMsg)
+		queuedMsgs = append(queuedMsgs, k.unmarshalQueuedMsg(iterator.Value()))
 	}
 
 	return queuedMsgs
@@ -106,7 +347,9 @@ func (k Keeper) GetCurrentEpochMsgs(ctx context.Context) []*types.QueuedMessage
 	return k.GetEpochMsgs(ctx, epochNumber)
 }
 
-// HandleQueuedMsg unwraps a QueuedMessage and forwards it to the staking module
+// HandleQueuedMsg unwraps a QueuedMessage and forwards it to the staking module.
+// The funds of a queued delegation or validator creation are spent from the
+// escrow, and are refunded to the account if the message fails.
 func (k Keeper) HandleQueuedMsg(ctx context.Context, msg *types.QueuedMessage) (*sdk.Result, error) {
 	var (
 		unwrappedMsgWithType sdk.Msg
@@ -119,6 +362,13 @@ func (k Keeper) HandleQueuedMsg(ctx context.Context, msg *types.QueuedMessage) (
 		panic(err)
 	}
 
+	// release the escrowed funds of a delegation or a validator creation right
+	// before executing it, so that the funds are delegated upon success, and are
+	// refunded upon failure as the release is outside of the cached context
+	if err := k.releaseQueuedMsgEscrow(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	// get the handler function from router
 	handler := k.router.Handler(unwrappedMsgWithType)
 
@@ -261,3 +511,46 @@ func (k Keeper) msgQueueLengthStore(ctx context.Context) prefix.Store {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return prefix.NewStore(storeAdapter, types.QueueLengthKey)
 }
+
+// msgQueueFeeIndexKey returns the key of the queued message at the given index
+// with the given fee in the fee index, where the index is inverted so that the
+// latest queued message comes first among messages with the same fee
+func msgQueueFeeIndexKey(fee uint64, index uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(fee), sdk.Uint64ToBigEndian(math.MaxUint64-index)...)
+}
+
+// msgQueueFeeIndexStore returns the index of the queue of msgs of a given epoch
+// by fee, in ascending order
+// prefix: MsgQueueFeeIndexKey || epochNumber
+// key: (fee || (MaxUint64 - index))
+// value: index
+func (k Keeper) msgQueueFeeIndexStore(ctx context.Context, epochNumber uint64) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	feeIndexStore := prefix.NewStore(storeAdapter, types.MsgQueueFeeIndexKey)
+	epochNumberBytes := sdk.Uint64ToBigEndian(epochNumber)
+	return prefix.NewStore(feeIndexStore, epochNumberBytes)
+}
+
+// accountQueueLengthStore returns the number of msgs queued by each account in
+// a given epoch
+// prefix: AccountQueueLengthKey || epochNumber
+// key: account
+// value: number of queued msgs
+func (k Keeper) accountQueueLengthStore(ctx context.Context, epochNumber uint64) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	accountQueueLengthStore := prefix.NewStore(storeAdapter, types.AccountQueueLengthKey)
+	epochNumberBytes := sdk.Uint64ToBigEndian(epochNumber)
+	return prefix.NewStore(accountQueueLengthStore, epochNumberBytes)
+}
+
+// txQueuedMsgStore returns the indices of the msgs queued by a given tx in a
+// given epoch
+// prefix: TxQueuedMsgKey || epochNumber || length-prefixed txID
+// key: index
+// value: nothing
+func (k Keeper) txQueuedMsgStore(ctx context.Context, epochNumber uint64, txID []byte) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	txQueuedMsgStore := prefix.NewStore(storeAdapter, types.TxQueuedMsgKey)
+	epochNumberBytes := sdk.Uint64ToBigEndian(epochNumber)
+	return prefix.NewStore(txQueuedMsgStore, append(epochNumberBytes, address.MustLengthPrefix(txID)...))
+}
@@ -1,16 +1,27 @@
 package keeper_test
 
 import (
+	"bytes"
+	"math"
 	"math/rand"
 	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testhelper "github.com/babylonchain/babylon/testutil/helper"
 	"github.com/babylonchain/babylon/x/epoching/types"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
 	appparams "github.com/babylonchain/babylon/app/params"
@@ -47,7 +58,8 @@ func FuzzEnqueueMsg(f *testing.F) {
 				MsgId: sdk.Uint64ToBigEndian(i),
 				Msg:   &types.QueuedMessage_MsgDelegate{MsgDelegate: &stakingtypes.MsgDelegate{}},
 			}
-			keeper.EnqueueMsg(ctx, msg)
+			err := keeper.EnqueueMsg(ctx, msg)
+			require.NoError(t, err)
 		}
 
 		// ensure that each msg in the queue is correct
@@ -124,6 +136,420 @@ func FuzzHandleQueuedMsg_MsgWrappedDelegate(f *testing.F) {
 	})
 }
 
+// FuzzDelegationEscrow tests the escrow of queued delegations. It enqueues some
+// MsgWrappedDelegate, and checks that the funds are locked in the escrow upon enqueuing,
+// cannot be spent by the delegator before the end of the epoch, and are delegated at the
+// end of the epoch. It also checks that vesting accounts can delegate their locked coins.
+func FuzzDelegationEscrow(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		helper := testhelper.NewHelper(t)
+		ctx, keeper := helper.Ctx, helper.App.EpochingKeeper
+		bankKeeper := helper.App.BankKeeper
+		accountKeeper := helper.App.AccountKeeper
+		escrowAddr := accountKeeper.GetModuleAddress(types.ModuleName)
+		delAddr := helper.GenAccs[0].GetAddress()
+		valAddr := sdk.ValAddress(keeper.GetCurrentValidatorSet(ctx)[0].Addr)
+		denom := coinWithOnePower.Denom
+		initBalance := bankKeeper.GetBalance(ctx, delAddr, denom)
+
+		// delegations exceeding the balance are rejected upon enqueuing
+		msg := types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(
+			delAddr.String(), valAddr.String(), initBalance.AddAmount(sdkmath.OneInt())))
+		_, err := helper.MsgSrvr.WrappedDelegate(ctx, msg)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+		require.Empty(t, keeper.GetCurrentEpochMsgs(ctx))
+
+		// the funds of the queued delegations are locked in the escrow
+		numDels := r.Int63n(10) + 1
+		for i := int64(1); i <= numDels; i++ {
+			helper.WrappedDelegate(delAddr, valAddr, coinWithOnePower.Amount.MulRaw(i))
+		}
+		escrowed := coinWithOnePower.Amount.MulRaw(numDels * (numDels + 1) / 2)
+		require.True(t, escrowed.Equal(bankKeeper.GetBalance(ctx, escrowAddr, denom).Amount))
+		require.True(t, initBalance.Amount.Sub(escrowed).Equal(bankKeeper.GetBalance(ctx, delAddr, denom).Amount))
+
+		// a vesting account delegates its locked coins
+		vestingAddr := datagen.GenRandomAccount().GetAddress()
+		vestingCoins := sdk.NewCoins(coinWithOnePower.AddAmount(coinWithOnePower.Amount))
+		vestingStart := max(ctx.HeaderInfo().Time.Unix(), 0)
+		vestingAcc, err := vestingtypes.NewContinuousVestingAccount(
+			authtypes.NewBaseAccountWithAddress(vestingAddr), vestingCoins, vestingStart, vestingStart+int64((365*24*time.Hour).Seconds()))
+		require.NoError(t, err)
+		accountKeeper.SetAccount(ctx, accountKeeper.NewAccount(ctx, vestingAcc))
+		require.NoError(t, bankKeeper.SendCoins(ctx, delAddr, vestingAddr, vestingCoins))
+		require.True(t, bankKeeper.SpendableCoins(ctx, vestingAddr).IsZero())
+		helper.WrappedDelegate(vestingAddr, valAddr, coinWithOnePower.Amount)
+		escrowed = escrowed.Add(coinWithOnePower.Amount)
+		require.True(t, escrowed.Equal(bankKeeper.GetBalance(ctx, escrowAddr, denom).Amount))
+		vestingAcc = accountKeeper.GetAccount(ctx, vestingAddr).(*vestingtypes.ContinuousVestingAccount)
+		require.Equal(t, sdk.NewCoins(coinWithOnePower), vestingAcc.DelegatedVesting)
+
+		// a delegation queued before the escrow was introduced is not escrowed,
+		// and bonds the funds of its delegator rather than the escrowed ones
+		oldDelAddr := datagen.GenRandomAccount().GetAddress()
+		oldDelBalance := coinWithOnePower.AddAmount(coinWithOnePower.Amount)
+		require.NoError(t, bankKeeper.SendCoins(ctx, delAddr, oldDelAddr, sdk.NewCoins(oldDelBalance)))
+		oldMsg, err := types.NewQueuedMessage(1, ctx.HeaderInfo().Time, []byte("txid"),
+			types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(oldDelAddr.String(), valAddr.String(), coinWithOnePower)))
+		require.NoError(t, err)
+		require.False(t, oldMsg.Escrowed)
+		require.NoError(t, keeper.EnqueueMsg(ctx, oldMsg))
+		require.True(t, escrowed.Equal(bankKeeper.GetBalance(ctx, escrowAddr, denom).Amount))
+
+		// a delegation to a validator that no longer exists at the end of the
+		// epoch fails, and its escrowed funds are refunded
+		failedDelAddr := datagen.GenRandomAccount().GetAddress()
+		require.NoError(t, bankKeeper.SendCoins(ctx, delAddr, failedDelAddr, sdk.NewCoins(coinWithOnePower)))
+		failedMsg, err := types.NewQueuedMessage(1, ctx.HeaderInfo().Time, []byte("failed-txid"),
+			types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(failedDelAddr.String(), datagen.GenRandomValidatorAddress().String(), coinWithOnePower)))
+		require.NoError(t, err)
+		require.NoError(t, keeper.EscrowQueuedMsg(ctx, &failedMsg))
+		require.True(t, failedMsg.Escrowed)
+		require.NoError(t, keeper.EnqueueMsg(ctx, failedMsg))
+		escrowed = escrowed.Add(coinWithOnePower.Amount)
+		require.True(t, escrowed.Equal(bankKeeper.GetBalance(ctx, escrowAddr, denom).Amount))
+		require.True(t, bankKeeper.GetBalance(ctx, failedDelAddr, denom).IsZero())
+
+		// the delegator cannot spend the escrowed funds, but moves away all
+		// the remaining balance before the end of the epoch
+		recipient := datagen.GenRandomAccount().GetAddress()
+		err = bankKeeper.SendCoins(ctx, delAddr, recipient, sdk.NewCoins(initBalance))
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+		remaining := bankKeeper.GetBalance(ctx, delAddr, denom)
+		require.NoError(t, bankKeeper.SendCoins(ctx, delAddr, recipient, sdk.NewCoins(remaining)))
+		require.True(t, bankKeeper.GetBalance(ctx, delAddr, denom).IsZero())
+
+		// go to epoch 2, and ensure the escrowed funds have been delegated
+		epochMsgs := keeper.GetCurrentEpochMsgs(ctx)
+		require.Len(t, epochMsgs, int(numDels)+3)
+		delegationBefore, err := helper.App.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		require.NoError(t, err)
+		for i := uint64(0); i < keeper.GetParams(ctx).EpochInterval; i++ {
+			ctx, err = helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		require.Equal(t, uint64(2), keeper.GetEpoch(ctx).EpochNumber)
+		require.True(t, bankKeeper.GetBalance(ctx, escrowAddr, denom).IsZero())
+		delegationAfter, err := helper.App.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		require.NoError(t, err)
+		require.True(t, delegationAfter.Shares.GT(delegationBefore.Shares))
+		for _, msg := range epochMsgs {
+			result := keeper.GetQueuedMsgResult(ctx, msg.TxId, msg.MsgIndex, msg.MsgId)
+			require.NotNil(t, result)
+			require.Equal(t, !bytes.Equal(msg.MsgId, failedMsg.MsgId), result.Success)
+		}
+
+		// the failed delegation is refunded to its delegator
+		require.Equal(t, coinWithOnePower, bankKeeper.GetBalance(ctx, failedDelAddr, denom))
+		failedResult := keeper.GetQueuedMsgResult(ctx, failedMsg.TxId, failedMsg.MsgIndex, failedMsg.MsgId)
+		require.False(t, failedResult.Success)
+		require.NotEmpty(t, failedResult.Log)
+
+		// the locked coins of the vesting account are delegated
+		_, err = helper.App.StakingKeeper.GetDelegation(ctx, vestingAddr, valAddr)
+		require.NoError(t, err)
+		vestingAcc = accountKeeper.GetAccount(ctx, vestingAddr).(*vestingtypes.ContinuousVestingAccount)
+		require.Equal(t, sdk.NewCoins(coinWithOnePower), vestingAcc.DelegatedVesting)
+		require.True(t, bankKeeper.GetBalance(ctx, vestingAddr, denom).Amount.Equal(coinWithOnePower.Amount))
+
+		// the delegation queued before the escrow is funded by its delegator
+		_, err = helper.App.StakingKeeper.GetDelegation(ctx, oldDelAddr, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, oldDelBalance.Sub(coinWithOnePower), bankKeeper.GetBalance(ctx, oldDelAddr, denom))
+	})
+}
+
+// FuzzEnqueueMsgQueueLimits tests the limits of the message queue. It fills the queue with msgs of random fees,
+// and checks that a new msg evicts the queued msg with the lowest fee only if it pays a higher fee, that evicted
+// delegations are refunded, and that an account cannot queue more msgs than its limit. Rejected msgs are recorded
+// as failed without failing their txs
+func FuzzEnqueueMsgQueueLimits(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		helper := testhelper.NewHelper(t)
+		ctx, keeper := helper.Ctx, helper.App.EpochingKeeper
+		bankKeeper := helper.App.BankKeeper
+		delAddr := helper.GenAccs[0].GetAddress()
+		valAddr := sdk.ValAddress(keeper.GetCurrentValidatorSet(ctx)[0].Addr)
+		denom := coinWithOnePower.Denom
+		initBalance := bankKeeper.GetBalance(ctx, delAddr, denom)
+
+		maxQueueSize := uint64(r.Int63n(10) + 2)
+		params := keeper.GetParams(ctx)
+		params.MaxQueueSize = maxQueueSize
+		params.MaxMsgsPerAccount = 1
+		require.NoError(t, keeper.SetParams(ctx, params))
+
+		// the first msg is a delegation without fee, and each account can
+		// queue a single msg
+		msg := types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr.String(), valAddr.String(), coinWithOnePower))
+		_, err := helper.MsgSrvr.WrappedDelegate(ctx, msg)
+		require.NoError(t, err)
+		delegateMsg := keeper.GetCurrentEpochMsgs(ctx)[0]
+		balance := bankKeeper.GetBalance(ctx, delAddr, denom)
+
+		// a msg of an account that has reached its limit is rejected without
+		// failing its tx: it is refunded, recorded as failed, and the
+		// rejection is reported in the events of the msg result
+		rejectedTxCtx := ctx.WithTxBytes(datagen.GenRandomByteArray(r, 32))
+		res := deliverMsg(t, helper, rejectedTxCtx, msg)
+		require.True(t, resultHasEvent(res, &types.EventQueuedMsgRejected{}))
+		require.True(t, resultHasEvent(res, &types.EventQueuedMsgFailed{}))
+		require.Len(t, keeper.GetCurrentEpochMsgs(ctx), 1)
+		require.Equal(t, balance, bankKeeper.GetBalance(ctx, delAddr, denom))
+		result := keeper.GetQueuedMsgResult(ctx, tmhash.Sum(rejectedTxCtx.TxBytes()), 0, delegateMsg.MsgId)
+		require.NotNil(t, result)
+		require.False(t, result.Success)
+		require.Equal(t, types.ErrAccountQueueFull.ABCICode(), result.Code)
+
+		// fill the queue with msgs of random fees from distinct accounts
+		for i := uint64(1); i < maxQueueSize; i++ {
+			fee := datagen.RandomInt(r, 1000) + 1
+			err := keeper.EnqueueMsg(types.ContextWithTxFee(ctx, fee), genRandomQueuedUndelegate(t, r, valAddr))
+			require.NoError(t, err)
+		}
+		require.Equal(t, maxQueueSize, keeper.GetCurrentQueueLength(ctx))
+
+		// a msg with a higher fee evicts the delegation, which is refunded
+		// and recorded as failed
+		err = keeper.EnqueueMsg(types.ContextWithTxFee(ctx, 1), genRandomQueuedUndelegate(t, r, valAddr))
+		require.NoError(t, err)
+		require.Equal(t, maxQueueSize, keeper.GetCurrentQueueLength(ctx))
+		require.True(t, initBalance.Amount.Equal(bankKeeper.GetBalance(ctx, delAddr, denom).Amount))
+		result = keeper.GetQueuedMsgResult(ctx, delegateMsg.TxId, delegateMsg.MsgIndex, delegateMsg.MsgId)
+		require.NotNil(t, result)
+		require.False(t, result.Success)
+		require.Equal(t, types.ErrQueuedMsgEvicted.ABCICode(), result.Code)
+		epochMsgs := keeper.GetCurrentEpochMsgs(ctx)
+		require.Len(t, epochMsgs, int(maxQueueSize))
+		require.Equal(t, uint64(1), epochMsgs[maxQueueSize-1].Fee)
+
+		// the account of the evicted delegation can queue a msg again
+		_, err = helper.MsgSrvr.WrappedDelegate(types.ContextWithTxFee(ctx, 1001), msg)
+		require.NoError(t, err)
+
+		// a msg whose fee is not higher than the lowest queued fee is rejected
+		lowestFee := uint64(math.MaxUint64)
+		for _, msg := range keeper.GetCurrentEpochMsgs(ctx) {
+			lowestFee = min(lowestFee, msg.Fee)
+		}
+		rejectedMsg := genRandomQueuedUndelegate(t, r, valAddr)
+		rejectedCtx := types.ContextWithTxFee(ctx.WithEventManager(sdk.NewEventManager()), lowestFee)
+		err = keeper.EnqueueMsg(rejectedCtx, rejectedMsg)
+		require.ErrorIs(t, err, types.ErrQueueFull)
+		result = keeper.GetQueuedMsgResult(ctx, rejectedMsg.TxId, rejectedMsg.MsgIndex, rejectedMsg.MsgId)
+		require.NotNil(t, result)
+		require.False(t, result.Success)
+		require.Equal(t, types.ErrQueueFull.ABCICode(), result.Code)
+		for _, msg := range keeper.GetCurrentEpochMsgs(ctx) {
+			require.NotEqual(t, rejectedMsg.TxId, msg.TxId)
+		}
+		require.True(t, hasEvent(rejectedCtx, &types.EventQueuedMsgRejected{}))
+		require.True(t, hasEvent(ctx, &types.EventQueuedMsgEvicted{}))
+	})
+}
+
+// FuzzEnqueueMsgEvictionOrder tests that a msg evicting a queued msg from the middle of a full queue is appended to
+// the queue rather than taking the place of the evicted msg. It queues an undelegation and a msg cancelling it,
+// which can only succeed if the msgs are executed in the order they are queued
+func FuzzEnqueueMsgEvictionOrder(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		helper := testhelper.NewHelper(t)
+		ctx, keeper := helper.Ctx, helper.App.EpochingKeeper
+		delAddr := helper.GenAccs[0].GetAddress()
+		valAddr := sdk.ValAddress(keeper.GetCurrentValidatorSet(ctx)[0].Addr)
+
+		params := keeper.GetParams(ctx)
+		params.MaxQueueSize = 4
+		params.MaxMsgsPerAccount = 2
+		require.NoError(t, keeper.SetParams(ctx, params))
+
+		// fill the queue, where the msg with the lowest fee sits right before
+		// the undelegation
+		err := keeper.EnqueueMsg(types.ContextWithTxFee(ctx, 5), genRandomQueuedUndelegate(t, r, valAddr))
+		require.NoError(t, err)
+		evictedMsg := genRandomQueuedUndelegate(t, r, valAddr)
+		err = keeper.EnqueueMsg(types.ContextWithTxFee(ctx, 1), evictedMsg)
+		require.NoError(t, err)
+		undelegateMsg := types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(delAddr.String(), valAddr.String(), coinWithOnePower))
+		_, err = helper.MsgSrvr.WrappedUndelegate(types.ContextWithTxFee(ctx, 5), undelegateMsg)
+		require.NoError(t, err)
+		err = keeper.EnqueueMsg(types.ContextWithTxFee(ctx, 5), genRandomQueuedUndelegate(t, r, valAddr))
+		require.NoError(t, err)
+
+		// the undelegation is executed at the last block of the epoch, and
+		// the cancellation evicts the msg queued before the undelegation
+		creationHeight := int64(keeper.GetEpoch(ctx).GetLastBlockHeight())
+		cancelMsg := types.NewMsgWrappedCancelUnbondingDelegation(stakingtypes.NewMsgCancelUnbondingDelegation(
+			delAddr.String(), valAddr.String(), creationHeight, coinWithOnePower))
+		_, err = helper.MsgSrvr.WrappedCancelUnbondingDelegation(types.ContextWithTxFee(ctx, 10), cancelMsg)
+		require.NoError(t, err)
+		require.True(t, hasEvent(ctx, &types.EventQueuedMsgEvicted{}))
+
+		// the remaining msgs keep their order, and the cancellation is last
+		epochMsgs := keeper.GetCurrentEpochMsgs(ctx)
+		require.Len(t, epochMsgs, 4)
+		for _, msg := range epochMsgs {
+			require.NotEqual(t, evictedMsg.MsgId, msg.MsgId)
+		}
+		require.NotNil(t, epochMsgs[1].GetMsgUndelegate())
+		require.NotNil(t, epochMsgs[3].GetMsgCancelUnbondingDelegation())
+		undelegateQueuedMsg, cancelQueuedMsg := epochMsgs[1], epochMsgs[3]
+
+		// go to epoch 2, and ensure the undelegation is cancelled
+		delegationBefore, err := helper.App.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		require.NoError(t, err)
+		for i := uint64(0); i < keeper.GetParams(ctx).EpochInterval; i++ {
+			ctx, err = helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		require.Equal(t, uint64(2), keeper.GetEpoch(ctx).EpochNumber)
+		for _, msg := range []*types.QueuedMessage{undelegateQueuedMsg, cancelQueuedMsg} {
+			result := keeper.GetQueuedMsgResult(ctx, msg.TxId, msg.MsgIndex, msg.MsgId)
+			require.NotNil(t, result)
+			require.True(t, result.Success)
+		}
+		result := keeper.GetQueuedMsgResult(ctx, evictedMsg.TxId, evictedMsg.MsgIndex, evictedMsg.MsgId)
+		require.NotNil(t, result)
+		require.Equal(t, types.ErrQueuedMsgEvicted.ABCICode(), result.Code)
+		delegationAfter, err := helper.App.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		require.NoError(t, err)
+		require.True(t, delegationAfter.Shares.Equal(delegationBefore.Shares))
+	})
+}
+
+func genRandomQueuedUndelegate(t testing.TB, r *rand.Rand, valAddr sdk.ValAddress) types.QueuedMessage {
+	delAddr := datagen.GenRandomAccount().GetAddress()
+	msg := types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(delAddr.String(), valAddr.String(), coinWithOnePower))
+	queuedMsg, err := types.NewQueuedMessage(1, time.Now(), datagen.GenRandomByteArray(r, 32), msg)
+	require.NoError(t, err)
+	return queuedMsg
+}
+
+func hasEvent(ctx sdk.Context, event proto.Message) bool {
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == proto.MessageName(event) {
+			return true
+		}
+	}
+	return false
+}
+
+// deliverMsg executes the given msg through the msg service router of the app, as a tx does, and returns its result,
+// which only carries the events of the msg if it succeeds
+func deliverMsg(t *testing.T, helper *testhelper.Helper, ctx sdk.Context, msg sdk.Msg) *sdk.Result {
+	handler := helper.App.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+	res, err := handler(ctx.WithEventManager(sdk.NewEventManager()), msg)
+	require.NoError(t, err)
+	return res
+}
+
+func resultHasEvent(res *sdk.Result, event proto.Message) bool {
+	for _, e := range res.Events {
+		if e.Type == proto.MessageName(event) {
+			return true
+		}
+	}
+	return false
+}
+
+// FuzzHandleQueuedMsg_MsgWrappedExec tests HandleQueueMsg over MsgWrappedExec.
+// A grantee enqueues some delegations on behalf of a granter via MsgWrappedExec, enters a new epoch
+// (which triggers HandleQueueMsg), and check if the newly delegated tokens take effect or not
+func FuzzHandleQueuedMsg_MsgWrappedExec(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// generate the validator set with 10 validators as genesis
+		genesisValSet, privSigner, err := datagen.GenesisValidatorSetWithPrivSigner(10)
+		require.NoError(t, err)
+		helper := testhelper.NewHelperWithValSet(t, genesisValSet, privSigner)
+		ctx, keeper, genAccs := helper.Ctx, helper.App.EpochingKeeper, helper.GenAccs
+		params := keeper.GetParams(ctx)
+
+		// the genesis account grants the grantee to delegate a limited amount of tokens
+		granter := genAccs[0].GetAddress()
+		grantee := datagen.GenRandomAccount().GetAddress()
+		valSet := keeper.GetCurrentValidatorSet(ctx)
+		val := valSet[0].Addr
+		valPower, err := keeper.GetCurrentValidatorVotingPower(ctx, val)
+		require.NoError(t, err)
+
+		numNewDels := r.Int63n(10) + 1
+		limit := sdk.NewCoin(coinWithOnePower.Denom, coinWithOnePower.Amount.MulRaw(numNewDels+1))
+		authorization, err := stakingtypes.NewStakeAuthorization(
+			[]sdk.ValAddress{sdk.ValAddress(val)}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &limit)
+		require.NoError(t, err)
+		expiration := ctx.HeaderInfo().Time.Add(time.Hour)
+		err = helper.App.AuthzKeeper.SaveGrant(ctx, grantee, granter, authorization, &expiration)
+		require.NoError(t, err)
+
+		wrappedExec := func(grantee sdk.AccAddress, amount sdk.Coin) error {
+			msg, err := types.NewMsgWrappedExec(grantee, []sdk.Msg{
+				stakingtypes.NewMsgDelegate(granter.String(), sdk.ValAddress(val).String(), amount),
+			})
+			require.NoError(t, err)
+			require.NoError(t, msg.ValidateBasic())
+			_, err = helper.MsgSrvr.WrappedExec(ctx, msg)
+			return err
+		}
+
+		// accounts without a grant cannot delegate on behalf of the granter
+		err = wrappedExec(datagen.GenRandomAccount().GetAddress(), coinWithOnePower)
+		require.ErrorIs(t, err, authz.ErrNoAuthorizationFound)
+
+		// the grantee delegates on behalf of the granter
+		for i := int64(0); i < numNewDels; i++ {
+			require.NoError(t, wrappedExec(grantee, coinWithOnePower))
+		}
+		// the delegation exceeding the remaining limit is rejected
+		require.Error(t, wrappedExec(grantee, coinWithOnePower.AddAmount(coinWithOnePower.Amount)))
+
+		// ensure the grant has been spent
+		updated, _ := helper.App.AuthzKeeper.GetAuthorization(ctx, grantee, granter, sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}))
+		require.NotNil(t, updated)
+		require.Equal(t, coinWithOnePower, *updated.(*stakingtypes.StakeAuthorization).MaxTokens)
+
+		// ensure the msgs are queued along with the grantee
+		epochMsgs := keeper.GetCurrentEpochMsgs(ctx)
+		require.Equal(t, numNewDels, int64(len(epochMsgs)))
+		for _, msg := range epochMsgs {
+			require.Equal(t, grantee.String(), msg.Grantee)
+			require.Equal(t, granter.String(), msg.GetMsgDelegate().DelegatorAddress)
+		}
+
+		// go to BeginBlock of block 11, and thus entering epoch 2
+		for i := uint64(0); i < params.EpochInterval; i++ {
+			ctx, err = helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		epoch := keeper.GetEpoch(ctx)
+		require.Equal(t, uint64(2), epoch.EpochNumber)
+		require.Empty(t, keeper.GetCurrentEpochMsgs(ctx))
+
+		// ensure the voting power has been added w.r.t. the newly delegated tokens
+		valPower2, err := keeper.GetCurrentValidatorVotingPower(ctx, val)
+		require.NoError(t, err)
+		addedPower := helper.App.StakingKeeper.TokensToConsensusPower(ctx, coinWithOnePower.Amount.MulRaw(numNewDels))
+		require.Equal(t, valPower+addedPower, valPower2)
+
+		// ensure the lifecycle of the granter's delegations is recorded
+		lc := keeper.GetDelegationLifecycle(ctx, granter)
+		require.NotNil(t, lc)
+		require.Equal(t, types.BondState_BONDED, lc.DelLife[len(lc.DelLife)-1].State)
+	})
+}
+
 // FuzzHandleQueuedMsg_MsgWrappedUndelegate tests HandleQueueMsg over MsgWrappedUndelegate.
 // It enqueues some MsgWrappedUndelegate, enters a new epoch (which triggers HandleQueueMsg), and check if the tokens become unbonding or not
 func FuzzHandleQueuedMsg_MsgWrappedUndelegate(f *testing.F) {
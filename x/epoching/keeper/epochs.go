@@ -118,6 +118,8 @@ func (k Keeper) RecordSealerBlockHashForEpoch(ctx context.Context) *types.Epoch
 }
 
 // IncEpoch adds epoch number by 1
+// The new epoch takes the epoch interval in the params, so that updates of the
+// epoch interval during the previous epoch take effect from the new epoch on.
 // CONTRACT: can only be invoked at the first block of an epoch
 func (k Keeper) IncEpoch(ctx context.Context) types.Epoch {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
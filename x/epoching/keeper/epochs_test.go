@@ -4,10 +4,14 @@ import (
 	"math/rand"
 	"testing"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testhelper "github.com/babylonchain/babylon/testutil/helper"
+	"github.com/babylonchain/babylon/x/epoching/types"
 )
 
 func FuzzEpochs(f *testing.F) {
@@ -47,3 +51,75 @@ func FuzzEpochs(f *testing.F) {
 		require.Equal(t, (expectedEpochNumber-1)*epochInterval+1, actualNewEpoch.FirstBlockHeight)
 	})
 }
+
+// FuzzEpochIntervalTransition updates the epoch interval in the middle of an epoch, and ensures that the current
+// epoch keeps its interval while the next epoch takes the new one, and that EpochsInfo reports the boundaries of
+// each epoch accordingly
+func FuzzEpochIntervalTransition(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		helper := testhelper.NewHelper(t)
+		ctx, keeper := helper.Ctx, helper.App.EpochingKeeper
+		oldInterval := keeper.GetParams(ctx).EpochInterval
+
+		// go to a random block in the middle of epoch 1
+		var err error
+		numBlocks := datagen.RandomInt(r, int(oldInterval)-1)
+		for i := uint64(0); i < numBlocks; i++ {
+			ctx, err = helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		epoch := keeper.GetEpoch(ctx)
+		require.Equal(t, uint64(1), epoch.EpochNumber)
+
+		// update the epoch interval to a different value
+		newInterval := oldInterval
+		for newInterval == oldInterval {
+			newInterval = datagen.RandomInt(r, 20) + 2
+		}
+		// NOTE: the update is written to the app's store directly, as the
+		// helper's context is a branch that is not written to later blocks
+		updateCtx := helper.App.NewUncachedContext(false, cmtproto.Header{}).WithHeaderInfo(ctx.HeaderInfo())
+		params := keeper.GetParams(updateCtx)
+		params.EpochInterval = newInterval
+		_, err = helper.MsgSrvr.UpdateParams(updateCtx, &types.MsgUpdateParams{
+			Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			Params:    params,
+		})
+		require.NoError(t, err)
+		require.True(t, hasEvent(updateCtx, &types.EventEpochIntervalScheduled{}))
+
+		// the current epoch keeps its interval
+		require.Equal(t, epoch, keeper.GetEpoch(updateCtx))
+		require.Equal(t, oldInterval, keeper.GetEpoch(updateCtx).CurrentEpochInterval)
+
+		// the next epoch takes the new interval right after the current epoch
+		for keeper.GetEpoch(ctx).EpochNumber < 3 {
+			ctx, err = helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		epoch1, err := keeper.GetHistoricalEpoch(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, oldInterval, epoch1.CurrentEpochInterval)
+		epoch2, err := keeper.GetHistoricalEpoch(ctx, 2)
+		require.NoError(t, err)
+		require.Equal(t, newInterval, epoch2.CurrentEpochInterval)
+		require.Equal(t, epoch1.GetSealerBlockHeight(), epoch2.FirstBlockHeight)
+
+		// EpochsInfo reports contiguous epochs with their own intervals
+		resp, err := keeper.EpochsInfo(ctx, &types.QueryEpochsInfoRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Epochs, 4)
+		for i := 1; i < len(resp.Epochs); i++ {
+			epoch := resp.Epochs[i]
+			require.Equal(t, resp.Epochs[i-1].LastBlockHeight+1, epoch.FirstBlockHeight)
+			require.Equal(t, epoch.FirstBlockHeight+epoch.CurrentEpochInterval-1, epoch.LastBlockHeight)
+		}
+		require.Equal(t, oldInterval, resp.Epochs[1].CurrentEpochInterval)
+		require.Equal(t, newInterval, resp.Epochs[2].CurrentEpochInterval)
+		require.Equal(t, newInterval, resp.Epochs[3].CurrentEpochInterval)
+	})
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/epoching/types"
+)
+
+// EscrowQueuedMsg locks the funds to be bonded by the given message to be
+// queued, i.e., the amount of a MsgDelegate or the self-delegation of a
+// MsgCreateValidator, in the escrow module account of the epoching module
+// until the message is executed at the end of the epoch. The funds are moved
+// with the delegation semantics of the bank module, so that vesting accounts
+// can escrow their locked coins as they can delegate them. The message is
+// marked as escrowed, and has to be queued afterwards.
+func (k Keeper) EscrowQueuedMsg(ctx context.Context, msg *types.QueuedMessage) error {
+	delAddr, amount, err := queuedMsgEscrow(msg)
+	if err != nil || delAddr == nil {
+		return err
+	}
+	if err := k.bk.DelegateCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	msg.Escrowed = true
+	return nil
+}
+
+// releaseQueuedMsgEscrow returns the escrowed funds of a queued message to
+// its account. Messages that are not escrowed, i.e., queued before the escrow
+// was introduced, have nothing to release, and bond the funds from their
+// accounts directly.
+func (k Keeper) releaseQueuedMsgEscrow(ctx context.Context, msg *types.QueuedMessage) error {
+	if !msg.Escrowed {
+		return nil
+	}
+	delAddr, amount, err := queuedMsgEscrow(msg)
+	if err != nil || delAddr == nil {
+		return err
+	}
+	return k.bk.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(amount))
+}
+
+// queuedMsgEscrow returns the account and the amount escrowed for the given
+// queued message, or a nil account if the message does not bond any funds
+func queuedMsgEscrow(msg *types.QueuedMessage) (sdk.AccAddress, sdk.Coin, error) {
+	switch m := msg.Msg.(type) {
+	case *types.QueuedMessage_MsgDelegate:
+		delAddr, err := sdk.AccAddressFromBech32(m.MsgDelegate.DelegatorAddress)
+		if err != nil {
+			return nil, sdk.Coin{}, err
+		}
+		return delAddr, m.MsgDelegate.Amount, nil
+	case *types.QueuedMessage_MsgCreateValidator:
+		// the self-delegation is bonded by the account of the validator
+		valAddr, err := sdk.ValAddressFromBech32(m.MsgCreateValidator.ValidatorAddress)
+		if err != nil {
+			return nil, sdk.Coin{}, err
+		}
+		return sdk.AccAddress(valAddr), m.MsgCreateValidator.Value, nil
+	default:
+		return nil, sdk.Coin{}, nil
+	}
+}
@@ -2,12 +2,14 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 
 	"cosmossdk.io/math"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/babylonchain/babylon/x/epoching/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -207,6 +209,54 @@ func (k Keeper) DelegationLifecycle(c context.Context, req *types.QueryDelegatio
 	}, nil
 }
 
+// QueuedMsgResultsByTx handles the QueryQueuedMsgResultsByTxRequest query
+func (k Keeper) QueuedMsgResultsByTx(c context.Context, req *types.QueryQueuedMsgResultsByTxRequest) (*types.QueryQueuedMsgResultsByTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	txID, err := hex.DecodeString(req.TxHash)
+	if err != nil || len(txID) != tmhash.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash %q", req.TxHash)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryQueuedMsgResultsByTxResponse{
+		Results: k.GetQueuedMsgResultsByTx(ctx, txID),
+	}, nil
+}
+
+// QueuedMsgResultsByDelegator handles the QueryQueuedMsgResultsByDelegatorRequest query
+func (k Keeper) QueuedMsgResultsByDelegator(c context.Context, req *types.QueryQueuedMsgResultsByDelegatorRequest) (*types.QueryQueuedMsgResultsByDelegatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	delAddr, err := sdk.AccAddressFromBech32(req.DelAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	results := []*types.QueuedMessageResult{}
+	store := k.delegatorMsgResultStore(ctx, delAddr)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		// here value is the key of the result, i.e., txID || msgID
+		result := k.getQueuedMsgResultByKey(ctx, value)
+		if result == nil {
+			return errors.New("missing queued message result")
+		}
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueuedMsgResultsByDelegatorResponse{
+		Results:    results,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (k Keeper) EpochValSet(c context.Context, req *types.QueryEpochValSetRequest) (*types.QueryEpochValSetResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
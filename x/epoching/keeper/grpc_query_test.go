@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/core/header"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 
@@ -157,7 +158,8 @@ func FuzzEpochMsgsQuery(f *testing.F) {
 				TxId: txid,
 				Msg:  &types.QueuedMessage_MsgDelegate{MsgDelegate: &stakingtypes.MsgDelegate{}},
 			}
-			keeper.EnqueueMsg(ctx, queuedMsg)
+			err := keeper.EnqueueMsg(ctx, queuedMsg)
+			require.NoError(t, err)
 		}
 		// get epoch msgs
 		req := types.QueryEpochMsgsRequest{
@@ -229,3 +231,113 @@ func FuzzEpochValSetQuery(f *testing.F) {
 		}
 	})
 }
+
+// FuzzQueuedMsgResultsQuery fuzzes the QueuedMsgResultsByTx and QueuedMsgResultsByDelegator queries
+// 1. Enqueue some delegations including two identical ones in the same tx, and a message that will fail upon execution
+// 2. Enter a new epoch, which executes the queued delegations
+// 3. Ensure the execution results of all delegations are persisted and can be queried
+func FuzzQueuedMsgResultsQuery(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 5)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		helper := testhelper.NewHelper(t)
+		ctx, keeper := helper.Ctx, helper.App.EpochingKeeper
+		params := keeper.GetParams(ctx)
+		delAddr := helper.GenAccs[0].GetAddress()
+		valAddr := sdk.ValAddress(keeper.GetCurrentValidatorSet(ctx)[0].Addr)
+
+		// enqueue some delegations with distinct amounts, a delegation
+		// identical to the first one, and a cancellation of a non-existing
+		// unbonding delegation, which will fail upon execution. All msgs are
+		// in the same tx
+		numDels := datagen.RandomInt(r, 5) + 1
+		for i := uint64(1); i <= numDels; i++ {
+			helper.WrappedDelegate(delAddr, valAddr, coinWithOnePower.Amount.MulRaw(int64(i)))
+		}
+		helper.WrappedDelegate(delAddr, valAddr, coinWithOnePower.Amount)
+		helper.WrappedCancelUnbondingDelegation(delAddr, valAddr, coinWithOnePower.Amount, 1)
+		epochMsgs := keeper.GetCurrentEpochMsgs(ctx)
+		require.Len(t, epochMsgs, int(numDels)+2)
+		for i, msg := range epochMsgs {
+			require.Equal(t, epochMsgs[0].TxId, msg.TxId)
+			require.Equal(t, uint64(i), msg.MsgIndex)
+		}
+		require.Equal(t, epochMsgs[0].MsgId, epochMsgs[numDels].MsgId)
+		// no result is available before the epoch ends
+		require.Nil(t, keeper.GetQueuedMsgResult(ctx, epochMsgs[0].TxId, epochMsgs[0].MsgIndex, epochMsgs[0].MsgId))
+
+		// enter epoch 2, which executes the queued delegations
+		var err error
+		for i := uint64(0); i < params.EpochInterval; i++ {
+			ctx, err = helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		require.Equal(t, uint64(2), keeper.GetEpoch(ctx).EpochNumber)
+
+		delegation, err := helper.App.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		require.NoError(t, err)
+		for i, msg := range epochMsgs {
+			result := keeper.GetQueuedMsgResult(ctx, msg.TxId, msg.MsgIndex, msg.MsgId)
+			require.NotNil(t, result)
+			require.Equal(t, uint64(1), result.EpochNumber)
+			require.Equal(t, delAddr.String(), result.DelegatorAddress)
+			require.Equal(t, msg.MsgIndex, result.MsgIndex)
+			if i <= int(numDels) {
+				require.True(t, result.Success)
+				require.True(t, result.Shares.IsPositive())
+				require.True(t, result.Shares.LTE(delegation.Shares))
+			} else {
+				require.False(t, result.Success)
+				require.NotZero(t, result.Code)
+				require.NotEmpty(t, result.Log)
+			}
+		}
+
+		// query the results by the tx hash
+		txHash := hex.EncodeToString(epochMsgs[0].TxId)
+		txRes, err := keeper.QueuedMsgResultsByTx(ctx, &types.QueryQueuedMsgResultsByTxRequest{TxHash: txHash})
+		require.NoError(t, err)
+		require.Len(t, txRes.Results, int(numDels)+2)
+		// the results of the identical delegations are kept apart
+		for i, result := range txRes.Results {
+			require.Equal(t, uint64(i), result.MsgIndex)
+		}
+		_, err = keeper.QueuedMsgResultsByTx(ctx, &types.QueryQueuedMsgResultsByTxRequest{TxHash: "invalid"})
+		require.Error(t, err)
+
+		// query the results by the delegator with pagination
+		delRes, err := keeper.QueuedMsgResultsByDelegator(ctx, &types.QueryQueuedMsgResultsByDelegatorRequest{
+			DelAddr:    delAddr.String(),
+			Pagination: &query.PageRequest{Limit: numDels},
+		})
+		require.NoError(t, err)
+		require.Len(t, delRes.Results, int(numDels))
+		require.NotNil(t, delRes.Pagination.NextKey)
+		delRes, err = keeper.QueuedMsgResultsByDelegator(ctx, &types.QueryQueuedMsgResultsByDelegatorRequest{
+			DelAddr:    delAddr.String(),
+			Pagination: &query.PageRequest{Key: delRes.Pagination.NextKey},
+		})
+		require.NoError(t, err)
+		require.Len(t, delRes.Results, 2)
+
+		// the results are pruned, together with their indexes, once their
+		// epoch is out of the retention window
+		params.MsgResultRetentionEpochs = 1
+		require.NoError(t, keeper.SetParams(ctx, params))
+		// results of epoch 1 are retained until the end of epoch 2
+		keeper.PruneQueuedMsgResults(ctx, 1)
+		require.NotNil(t, keeper.GetQueuedMsgResult(ctx, epochMsgs[0].TxId, epochMsgs[0].MsgIndex, epochMsgs[0].MsgId))
+		keeper.PruneQueuedMsgResults(ctx, 2)
+		for _, msg := range epochMsgs {
+			require.Nil(t, keeper.GetQueuedMsgResult(ctx, msg.TxId, msg.MsgIndex, msg.MsgId))
+		}
+		txRes, err = keeper.QueuedMsgResultsByTx(ctx, &types.QueryQueuedMsgResultsByTxRequest{TxHash: txHash})
+		require.NoError(t, err)
+		require.Empty(t, txRes.Results)
+		delRes, err = keeper.QueuedMsgResultsByDelegator(ctx, &types.QueryQueuedMsgResultsByDelegatorRequest{DelAddr: delAddr.String()})
+		require.NoError(t, err)
+		require.Empty(t, delRes.Results)
+	})
+}
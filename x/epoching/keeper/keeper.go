@@ -18,6 +18,7 @@ type (
 		hooks        types.EpochingHooks
 		bk           types.BankKeeper
 		stk          types.StakingKeeper
+		ak           types.AuthzKeeper
 		router       *baseapp.MsgServiceRouter
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -58,6 +59,13 @@ func (k *Keeper) SetHooks(eh types.EpochingHooks) *Keeper {
 	return k
 }
 
+// SetAuthzKeeper sets the authz keeper, which is used for queuing staking
+// messages on behalf of granters
+func (k *Keeper) SetAuthzKeeper(ak types.AuthzKeeper) *Keeper {
+	k.ak = ak
+	return k
+}
+
 // SetMsgServiceRouter sets the msgServiceRouter
 func (k *Keeper) SetMsgServiceRouter(router *baseapp.MsgServiceRouter) *Keeper {
 	k.router = router
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/epoching/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/epoching params from consensus version 1 to 2.
+// Version 1 params did not contain the retention window of the execution
+// results of queued messages, which decodes as 0, i.e., the results would be
+// retained forever. It is set to its default, and the existing results are
+// indexed by their epoch so that they are pruned as well. Version 1 results
+// are keyed without the position of the message in its tx, so they are
+// re-keyed at position 0 along with their delegator index. The queue limits
+// stay unlimited as in version 1. The msgs queued in the current epoch are not
+// escrowed, and thus bond the funds from their accounts upon execution.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.MsgResultRetentionEpochs == 0 {
+		params.MsgResultRetentionEpochs = types.DefaultMsgResultRetentionEpochs
+	}
+	if err := m.keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	// collect the results before re-keying them, as the store cannot be
+	// written while being iterated
+	resultStore := m.keeper.queuedMsgResultStore(ctx)
+	iter := resultStore.Iterator(nil, nil)
+	oldKeys, results := [][]byte{}, []*types.QueuedMessageResult{}
+	for ; iter.Valid(); iter.Next() {
+		var result types.QueuedMessageResult
+		if err := m.keeper.cdc.Unmarshal(iter.Value(), &result); err != nil {
+			iter.Close()
+			return err
+		}
+		oldKeys = append(oldKeys, iter.Key())
+		results = append(results, &result)
+	}
+	iter.Close()
+
+	for i, result := range results {
+		resultStore.Delete(oldKeys[i])
+		if delAddr, err := sdk.AccAddressFromBech32(result.DelegatorAddress); err == nil {
+			oldIndexKey := append(sdk.Uint64ToBigEndian(result.EpochNumber), oldKeys[i]...)
+			m.keeper.delegatorMsgResultStore(ctx, delAddr).Delete(oldIndexKey)
+		}
+		// setQueuedMsgResult indexes the result by its epoch and delegator
+		m.keeper.setQueuedMsgResult(ctx, result)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/epoching/keeper"
+	"github.com/babylonchain/babylon/x/epoching/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	k, ctx := testkeeper.EpochingKeeper(t)
+
+	// params as stored by consensus version 1, i.e., only the epoch interval
+	var bz []byte
+	bz = protowire.AppendTag(bz, 1, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 360)
+	storeKey := ctx.MultiStore().(*rootmulti.Store).StoreKeysByName()[types.StoreKey]
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+	require.Zero(t, k.GetParams(ctx).MsgResultRetentionEpochs)
+
+	// a result as stored by consensus version 1, i.e., keyed by txID || msgID
+	// and indexed by its delegator but not by its epoch
+	delAddr := sdk.AccAddress(datagen.GenRandomByteArray(r, 20))
+	result := types.QueuedMessageResult{
+		EpochNumber:      1,
+		TxId:             []byte("txid"),
+		MsgId:            []byte("msgid"),
+		DelegatorAddress: delAddr.String(),
+		Success:          true,
+		Shares:           sdkmath.LegacyOneDec(),
+	}
+	resultBz, err := result.Marshal()
+	require.NoError(t, err)
+	v1Key := append(append([]byte{}, result.TxId...), result.MsgId...)
+	resultKey := append(append([]byte{}, types.QueuedMsgResultKey...), v1Key...)
+	ctx.KVStore(storeKey).Set(resultKey, resultBz)
+	delIndexKey := append(append(append([]byte{}, types.DelegatorMsgResultKey...), address.MustLengthPrefix(delAddr)...), sdk.Uint64ToBigEndian(result.EpochNumber)...)
+	delIndexKey = append(delIndexKey, v1Key...)
+	ctx.KVStore(storeKey).Set(delIndexKey, v1Key)
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+
+	params := k.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	require.Equal(t, uint64(360), params.EpochInterval)
+	require.Zero(t, params.MaxQueueSize)
+	require.Zero(t, params.MaxMsgsPerAccount)
+	require.Equal(t, types.DefaultMsgResultRetentionEpochs, params.MsgResultRetentionEpochs)
+
+	// the existing result is re-keyed at position 0, and is found by its
+	// delegator
+	require.Nil(t, ctx.KVStore(storeKey).Get(resultKey))
+	require.Nil(t, ctx.KVStore(storeKey).Get(delIndexKey))
+	require.NotNil(t, k.GetQueuedMsgResult(ctx, result.TxId, 0, result.MsgId))
+	delRes, err := k.QueuedMsgResultsByDelegator(ctx, &types.QueryQueuedMsgResultsByDelegatorRequest{DelAddr: delAddr.String()})
+	require.NoError(t, err)
+	require.Len(t, delRes.Results, 1)
+
+	// the existing result is pruned once its epoch is out of the window
+	k.PruneQueuedMsgResults(ctx, params.MsgResultRetentionEpochs)
+	require.NotNil(t, k.GetQueuedMsgResult(ctx, result.TxId, 0, result.MsgId))
+	k.PruneQueuedMsgResults(ctx, params.MsgResultRetentionEpochs+1)
+	require.Nil(t, k.GetQueuedMsgResult(ctx, result.TxId, 0, result.MsgId))
+	delRes, err = k.QueuedMsgResultsByDelegator(ctx, &types.QueryQueuedMsgResultsByDelegatorRequest{DelAddr: delAddr.String()})
+	require.NoError(t, err)
+	require.Empty(t, delRes.Results)
+}
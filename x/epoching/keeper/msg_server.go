@@ -26,55 +26,7 @@ var _ types.MsgServer = msgServer{}
 // WrappedDelegate handles the MsgWrappedDelegate request
 func (ms msgServer) WrappedDelegate(goCtx context.Context, msg *types.MsgWrappedDelegate) (*types.MsgWrappedDelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if msg.Msg == nil {
-		return nil, types.ErrNoWrappedMsg
-	}
-
-	// verification rules ported from staking module
-	valAddr, valErr := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress)
-	if valErr != nil {
-		return nil, valErr
-	}
-	if _, err := ms.stk.GetValidator(ctx, valAddr); err != nil {
-		return nil, err
-	}
-	if _, err := sdk.AccAddressFromBech32(msg.Msg.DelegatorAddress); err != nil {
-		return nil, err
-	}
-	bondDenom, err := ms.stk.BondDenom(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if msg.Msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Msg.Amount.Denom, bondDenom,
-		)
-	}
-
-	blockHeight := uint64(ctx.HeaderInfo().Height)
-	if blockHeight == 0 {
-		return nil, types.ErrZeroEpochMsg
-	}
-	blockTime := ctx.HeaderInfo().Time
-
-	txid := tmhash.Sum(ctx.TxBytes())
-	queuedMsg, err := types.NewQueuedMessage(blockHeight, blockTime, txid, msg)
-	if err != nil {
-		return nil, err
-	}
-
-	ms.EnqueueMsg(ctx, queuedMsg)
-
-	err = ctx.EventManager().EmitTypedEvents(
-		&types.EventWrappedDelegate{
-			DelegatorAddress: msg.Msg.DelegatorAddress,
-			ValidatorAddress: msg.Msg.ValidatorAddress,
-			Amount:           msg.Msg.Amount.Amount.Uint64(),
-			Denom:            msg.Msg.Amount.GetDenom(),
-			EpochBoundary:    ms.GetEpoch(ctx).GetLastBlockHeight(),
-		},
-	)
-	if err != nil {
+	if err := acceptQueuedMsgRejection(ms.EnqueueWrappedDelegate(ctx, msg)); err != nil {
 		return nil, err
 	}
 
@@ -84,45 +36,59 @@ func (ms msgServer) WrappedDelegate(goCtx context.Context, msg *types.MsgWrapped
 // WrappedUndelegate handles the MsgWrappedUndelegate request
 func (ms msgServer) WrappedUndelegate(goCtx context.Context, msg *types.MsgWrappedUndelegate) (*types.MsgWrappedUndelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := acceptQueuedMsgRejection(ms.enqueueWrappedUndelegate(ctx, msg, "")); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedUndelegateResponse{}, nil
+}
+
+// enqueueWrappedUndelegate verifies the given `MsgWrappedUndelegate` message and enqueues it to the
+// message queue of the current epoch, recording the grantee that queued it on
+// behalf of the delegator, if any
+func (ms msgServer) enqueueWrappedUndelegate(ctx sdk.Context, msg *types.MsgWrappedUndelegate, grantee string) error {
 	if msg.Msg == nil {
-		return nil, types.ErrNoWrappedMsg
+		return types.ErrNoWrappedMsg
 	}
 
 	// verification rules ported from staking module
 	valAddr, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	delegatorAddress, err := sdk.AccAddressFromBech32(msg.Msg.DelegatorAddress)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if _, err := ms.stk.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Msg.Amount.Amount); err != nil {
-		return nil, err
+		return err
 	}
 	bondDenom, err := ms.stk.BondDenom(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if msg.Msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Msg.Amount.Denom, bondDenom,
 		)
 	}
 
 	blockHeight := uint64(ctx.HeaderInfo().Height)
 	if blockHeight == 0 {
-		return nil, types.ErrZeroEpochMsg
+		return types.ErrZeroEpochMsg
 	}
 	blockTime := ctx.HeaderInfo().Time
 
 	txid := tmhash.Sum(ctx.TxBytes())
 	queuedMsg, err := types.NewQueuedMessage(blockHeight, blockTime, txid, msg)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	ms.EnqueueMsg(ctx, queuedMsg)
+	queuedMsg.Grantee = grantee
+	if err := ms.EnqueueMsg(ctx, queuedMsg); err != nil {
+		return err
+	}
 
 	err = ctx.EventManager().EmitTypedEvents(
 		&types.EventWrappedUndelegate{
@@ -134,57 +100,71 @@ func (ms msgServer) WrappedUndelegate(goCtx context.Context, msg *types.MsgWrapp
 		},
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return &types.MsgWrappedUndelegateResponse{}, nil
+	return nil
 }
 
 // WrappedBeginRedelegate handles the MsgWrappedBeginRedelegate request
 func (ms msgServer) WrappedBeginRedelegate(goCtx context.Context, msg *types.MsgWrappedBeginRedelegate) (*types.MsgWrappedBeginRedelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := acceptQueuedMsgRejection(ms.enqueueWrappedBeginRedelegate(ctx, msg, "")); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedBeginRedelegateResponse{}, nil
+}
+
+// enqueueWrappedBeginRedelegate verifies the given `MsgWrappedBeginRedelegate` message and enqueues it to the
+// message queue of the current epoch, recording the grantee that queued it on
+// behalf of the delegator, if any
+func (ms msgServer) enqueueWrappedBeginRedelegate(ctx sdk.Context, msg *types.MsgWrappedBeginRedelegate, grantee string) error {
 	if msg.Msg == nil {
-		return nil, types.ErrNoWrappedMsg
+		return types.ErrNoWrappedMsg
 	}
 
 	// verification rules ported from staking module
 	valSrcAddr, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorSrcAddress)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	delegatorAddress, err := sdk.AccAddressFromBech32(msg.Msg.DelegatorAddress)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if _, err := ms.stk.ValidateUnbondAmount(ctx, delegatorAddress, valSrcAddr, msg.Msg.Amount.Amount); err != nil {
-		return nil, err
+		return err
 	}
 	bondDenom, err := ms.stk.BondDenom(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if msg.Msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Msg.Amount.Denom, bondDenom,
 		)
 	}
 	if _, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorDstAddress); err != nil {
-		return nil, err
+		return err
 	}
 
 	blockHeight := uint64(ctx.HeaderInfo().Height)
 	if blockHeight == 0 {
-		return nil, types.ErrZeroEpochMsg
+		return types.ErrZeroEpochMsg
 	}
 	blockTime := ctx.HeaderInfo().Time
 
 	txid := tmhash.Sum(ctx.TxBytes())
 	queuedMsg, err := types.NewQueuedMessage(blockHeight, blockTime, txid, msg)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	ms.EnqueueMsg(ctx, queuedMsg)
+	queuedMsg.Grantee = grantee
+	if err := ms.EnqueueMsg(ctx, queuedMsg); err != nil {
+		return err
+	}
 	err = ctx.EventManager().EmitTypedEvents(
 		&types.EventWrappedBeginRedelegate{
 			DelegatorAddress:            msg.Msg.DelegatorAddress,
@@ -196,37 +176,48 @@ func (ms msgServer) WrappedBeginRedelegate(goCtx context.Context, msg *types.Msg
 		},
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return &types.MsgWrappedBeginRedelegateResponse{}, nil
+	return nil
 }
 
 // WrappedCancelUnbondingDelegation handles the MsgWrappedCancelUnbondingDelegation request
 func (ms msgServer) WrappedCancelUnbondingDelegation(goCtx context.Context, msg *types.MsgWrappedCancelUnbondingDelegation) (*types.MsgWrappedCancelUnbondingDelegationResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := acceptQueuedMsgRejection(ms.enqueueWrappedCancelUnbondingDelegation(ctx, msg, "")); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedCancelUnbondingDelegationResponse{}, nil
+}
+
+// enqueueWrappedCancelUnbondingDelegation verifies the given `MsgWrappedCancelUnbondingDelegation` message and enqueues it to the
+// message queue of the current epoch, recording the grantee that queued it on
+// behalf of the delegator, if any
+func (ms msgServer) enqueueWrappedCancelUnbondingDelegation(ctx sdk.Context, msg *types.MsgWrappedCancelUnbondingDelegation, grantee string) error {
 	if msg.Msg == nil {
-		return nil, types.ErrNoWrappedMsg
+		return types.ErrNoWrappedMsg
 	}
 
 	// verification rules ported from staking module
 	if _, err := sdk.AccAddressFromBech32(msg.Msg.DelegatorAddress); err != nil {
-		return nil, err
+		return err
 	}
 
 	if _, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress); err != nil {
-		return nil, err
+		return err
 	}
 
 	if !msg.Msg.Amount.IsValid() || !msg.Msg.Amount.Amount.IsPositive() {
-		return nil, errorsmod.Wrap(
+		return errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
 			"invalid amount",
 		)
 	}
 
 	if msg.Msg.CreationHeight <= 0 {
-		return nil, errorsmod.Wrap(
+		return errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
 			"invalid height",
 		)
@@ -234,26 +225,29 @@ func (ms msgServer) WrappedCancelUnbondingDelegation(goCtx context.Context, msg
 
 	bondDenom, err := ms.stk.BondDenom(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if msg.Msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Msg.Amount.Denom, bondDenom,
 		)
 	}
 
 	blockHeight := uint64(ctx.BlockHeader().Height)
 	if blockHeight == 0 {
-		return nil, types.ErrZeroEpochMsg
+		return types.ErrZeroEpochMsg
 	}
 	blockTime := ctx.BlockTime()
 	txid := tmhash.Sum(ctx.TxBytes())
 	queuedMsg, err := types.NewQueuedMessage(blockHeight, blockTime, txid, msg)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	ms.EnqueueMsg(ctx, queuedMsg)
+	queuedMsg.Grantee = grantee
+	if err := ms.EnqueueMsg(ctx, queuedMsg); err != nil {
+		return err
+	}
 	err = ctx.EventManager().EmitTypedEvents(
 		&types.EventWrappedCancelUnbondingDelegation{
 			DelegatorAddress: msg.Msg.DelegatorAddress,
@@ -263,17 +257,69 @@ func (ms msgServer) WrappedCancelUnbondingDelegation(goCtx context.Context, msg
 			EpochBoundary:    ms.GetEpoch(ctx).GetLastBlockHeight(),
 		},
 	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WrappedExec handles the MsgWrappedExec request. Each staking message is
+// executed on behalf of its delegator under the authz grant of the grantee,
+// and is enqueued to the message queue of the current epoch like its wrapped
+// counterpart
+func (ms msgServer) WrappedExec(goCtx context.Context, msg *types.MsgWrappedExec) (*types.MsgWrappedExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
 	if err != nil {
 		return nil, err
 	}
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, types.ErrNoWrappedMsg
+	}
 
-	return &types.MsgWrappedCancelUnbondingDelegationResponse{}, nil
+	for _, m := range msgs {
+		wrappedMsg, err := types.WrapStakingMsg(m)
+		if err != nil {
+			return nil, err
+		}
+		if err := ms.acceptAuthzGrant(ctx, grantee, m); err != nil {
+			return nil, err
+		}
+		// a rejected msg does not prevent the other msgs from being queued
+		if err := acceptQueuedMsgRejection(ms.enqueueWrappedMsg(ctx, wrappedMsg, msg.Grantee)); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgWrappedExecResponse{}, nil
+}
+
+// enqueueWrappedMsg enqueues the given wrapped staking message on behalf of
+// the grantee
+func (ms msgServer) enqueueWrappedMsg(ctx sdk.Context, msg sdk.Msg, grantee string) error {
+	switch m := msg.(type) {
+	case *types.MsgWrappedDelegate:
+		return ms.enqueueWrappedDelegate(ctx, m, grantee)
+	case *types.MsgWrappedUndelegate:
+		return ms.enqueueWrappedUndelegate(ctx, m, grantee)
+	case *types.MsgWrappedBeginRedelegate:
+		return ms.enqueueWrappedBeginRedelegate(ctx, m, grantee)
+	case *types.MsgWrappedCancelUnbondingDelegation:
+		return ms.enqueueWrappedCancelUnbondingDelegation(ctx, m, grantee)
+	default:
+		return types.ErrUnwrappedMsgType.Wrapf("%T", msg)
+	}
 }
 
 // UpdateParams updates the params.
-// TODO investigate when it is the best time to update the params. We can update them
-// when the epoch changes, but we can also update them during the epoch and extend
-// the epoch duration.
+// The epoch interval of the current epoch is kept in its epoch metadata, so an
+// updated epoch interval is scheduled to take effect from the next epoch on.
 func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if ms.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
@@ -283,9 +329,23 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	prevParams := ms.GetParams(ctx)
 	if err := ms.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
+	if req.Params.EpochInterval != prevParams.EpochInterval {
+		epoch := ms.GetEpoch(ctx)
+		err := ctx.EventManager().EmitTypedEvent(&types.EventEpochIntervalScheduled{
+			EpochNumber:          epoch.EpochNumber + 1,
+			FirstBlockHeight:     epoch.GetSealerBlockHeight(),
+			CurrentEpochInterval: epoch.CurrentEpochInterval,
+			EpochInterval:        req.Params.EpochInterval,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
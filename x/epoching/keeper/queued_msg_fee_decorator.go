@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+)
+
+// QueuedMsgFeeDecorator defines an AnteHandler decorator that records the fee
+// of a tx in the context, which is shared by the messages queued by the tx
+// and prioritises them when the message queue of the epoch is full.
+type QueuedMsgFeeDecorator struct {
+	ek Keeper
+}
+
+// NewQueuedMsgFeeDecorator creates a new QueuedMsgFeeDecorator
+func NewQueuedMsgFeeDecorator(ek Keeper) *QueuedMsgFeeDecorator {
+	return &QueuedMsgFeeDecorator{
+		ek: ek,
+	}
+}
+
+// AnteHandle records the fee of the tx in the bond denom. The fee is split
+// among the messages actually queued by the tx upon enqueuing them, which can
+// be more than the messages of the tx, e.g., via MsgWrappedExec or contracts,
+// so that a tx cannot queue many messages for the fee of a single one
+func (qfd QueuedMsgFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+	bondDenom, err := qfd.ek.stk.BondDenom(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	fee := feeTx.GetFee().AmountOf(bondDenom)
+	// saturate fees that do not fit, as they are only compared with each other
+	txFee := uint64(math.MaxUint64)
+	if fee.IsUint64() {
+		txFee = fee.Uint64()
+	}
+
+	return next(epochingtypes.ContextWithTxFee(ctx, txFee), tx, simulate)
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testhelper "github.com/babylonchain/babylon/testutil/helper"
+	"github.com/babylonchain/babylon/x/epoching/keeper"
+	"github.com/babylonchain/babylon/x/epoching/types"
+)
+
+func FuzzQueuedMsgFeeDecorator(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		helper := testhelper.NewHelper(t)
+		decorator := keeper.NewQueuedMsgFeeDecorator(helper.App.EpochingKeeper)
+
+		// the fee in the bond denom is recorded, while fees in other denoms
+		// are ignored
+		numMsgs := datagen.RandomInt(r, 10) + 1
+		msgs := make([]sdk.Msg, 0, numMsgs)
+		for i := uint64(0); i < numMsgs; i++ {
+			msgs = append(msgs, &banktypes.MsgSend{})
+		}
+		bondFee := datagen.RandomInt(r, 100000)
+		txBuilder := helper.App.TxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		txBuilder.SetFeeAmount(sdk.NewCoins(
+			sdk.NewInt64Coin(coinWithOnePower.Denom, int64(bondFee)),
+			sdk.NewInt64Coin("other", int64(datagen.RandomInt(r, 100000)+1)),
+		))
+
+		var txFee uint64
+		_, err := decorator.AnteHandle(helper.Ctx, txBuilder.GetTx(), false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			txFee = types.TxFeeFromContext(ctx)
+			return ctx, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, bondFee, txFee)
+	})
+}
+
+// FuzzQueuedMsgFeeDecorator_MsgWrappedExec tests that the fee of a tx is split among the msgs
+// actually queued by it. A MsgWrappedExec queueing many delegations into a full queue evicts
+// queued msgs only if its fee per queued msg is higher than theirs
+func FuzzQueuedMsgFeeDecorator_MsgWrappedExec(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		helper := testhelper.NewHelper(t)
+		ctx, ek := helper.Ctx, helper.App.EpochingKeeper
+		decorator := keeper.NewQueuedMsgFeeDecorator(ek)
+		delAddr := helper.GenAccs[0].GetAddress()
+		valAddr := sdk.ValAddress(ek.GetCurrentValidatorSet(ctx)[0].Addr)
+
+		// fill the queue with msgs of the same fee from distinct accounts
+		maxQueueSize := datagen.RandomInt(r, 10) + 2
+		params := ek.GetParams(ctx)
+		params.MaxQueueSize = maxQueueSize
+		params.MaxMsgsPerAccount = maxQueueSize
+		require.NoError(t, ek.SetParams(ctx, params))
+		queuedFee := datagen.RandomInt(r, 1000) + 1
+		for i := uint64(0); i < maxQueueSize; i++ {
+			err := ek.EnqueueMsg(types.ContextWithTxFee(ctx, queuedFee), genRandomQueuedUndelegate(t, r, valAddr))
+			require.NoError(t, err)
+		}
+
+		// a tx with a single MsgWrappedExec queueing many delegations
+		numDels := datagen.RandomInt(r, int(maxQueueSize-1)) + 2
+		dels := make([]sdk.Msg, 0, numDels)
+		for i := uint64(0); i < numDels; i++ {
+			dels = append(dels, stakingtypes.NewMsgDelegate(delAddr.String(), valAddr.String(), coinWithOnePower))
+		}
+		msg, err := types.NewMsgWrappedExec(delAddr, dels)
+		require.NoError(t, err)
+		execTx := func(ctx sdk.Context, bondFee uint64) error {
+			txBuilder := helper.App.TxConfig().NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(msg))
+			txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(coinWithOnePower.Denom, int64(bondFee))))
+			ctx = ctx.WithTxBytes(datagen.GenRandomByteArray(r, 32))
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				_, err := helper.MsgSrvr.WrappedExec(ctx, msg)
+				return ctx, err
+			})
+			return err
+		}
+
+		// the fee of the tx is not higher than the queued fee once it is
+		// split among all delegations, so the delegations are queued as long
+		// as their share of the fee is higher, and the rest are rejected
+		cacheCtx, _ := ctx.CacheContext()
+		require.NoError(t, execTx(cacheCtx, queuedFee*numDels))
+		execMsgs := []*types.QueuedMessage{}
+		for _, queuedMsg := range ek.GetCurrentEpochMsgs(cacheCtx) {
+			if queuedMsg.GetMsgDelegate() != nil {
+				execMsgs = append(execMsgs, queuedMsg)
+			}
+		}
+		numExecMsgs := uint64(len(execMsgs))
+		require.Less(t, numExecMsgs, numDels)
+		for _, queuedMsg := range execMsgs {
+			require.Greater(t, queuedMsg.Fee, queuedFee)
+			require.Equal(t, queuedFee*numDels/numExecMsgs, queuedMsg.Fee)
+		}
+		numRejected := uint64(0)
+		for _, e := range cacheCtx.EventManager().Events() {
+			if e.Type == proto.MessageName(&types.EventQueuedMsgRejected{}) {
+				numRejected++
+			}
+		}
+		require.Equal(t, numDels-numExecMsgs, numRejected)
+
+		// a higher fee per delegation evicts as many queued msgs as the
+		// delegations, all of which share the fee of the tx
+		require.NoError(t, execTx(ctx, (queuedFee+1)*numDels))
+		epochMsgs := ek.GetCurrentEpochMsgs(ctx)
+		require.Len(t, epochMsgs, int(maxQueueSize))
+		numExecMsgs = 0
+		for _, queuedMsg := range epochMsgs {
+			if queuedMsg.GetMsgDelegate() != nil {
+				numExecMsgs++
+				require.Equal(t, queuedFee+1, queuedMsg.Fee)
+			} else {
+				require.Equal(t, queuedFee, queuedMsg.Fee)
+			}
+		}
+		require.Equal(t, numDels, numExecMsgs)
+	})
+}
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/babylonchain/babylon/x/epoching/types"
+)
+
+// RecordQueuedMsgResult records the execution result of the given queued
+// message at the end of the given epoch, where execErr is the error returned
+// by HandleQueuedMsg, and emits an event signalling the result
+func (k Keeper) RecordQueuedMsgResult(ctx context.Context, epochNumber uint64, msg *types.QueuedMessage, execErr error) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delAddrStr, valAddrStr := msg.DelegatorAndValidator()
+
+	result := &types.QueuedMessageResult{
+		EpochNumber:      epochNumber,
+		TxId:             msg.TxId,
+		MsgId:            msg.MsgId,
+		DelegatorAddress: delAddrStr,
+		Success:          execErr == nil,
+		Shares:           sdkmath.LegacyZeroDec(),
+		Height:           uint64(sdkCtx.HeaderInfo().Height),
+		MsgIndex:         msg.MsgIndex,
+	}
+	if execErr != nil {
+		result.Codespace, result.Code, result.Log = errorsmod.ABCIInfo(execErr, false)
+	} else {
+		shares, err := k.getDelegationShares(ctx, delAddrStr, valAddrStr)
+		if err != nil {
+			return err
+		}
+		result.Shares = shares
+	}
+
+	k.setQueuedMsgResult(ctx, result)
+
+	if result.Success {
+		return sdkCtx.EventManager().EmitTypedEvent(&types.EventQueuedMsgSucceeded{
+			EpochNumber:      result.EpochNumber,
+			TxId:             result.TxId,
+			MsgId:            result.MsgId,
+			DelegatorAddress: result.DelegatorAddress,
+			Shares:           result.Shares,
+			MsgIndex:         result.MsgIndex,
+		})
+	}
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventQueuedMsgFailed{
+		EpochNumber:      result.EpochNumber,
+		TxId:             result.TxId,
+		MsgId:            result.MsgId,
+		DelegatorAddress: result.DelegatorAddress,
+		Codespace:        result.Codespace,
+		Code:             result.Code,
+		Log:              result.Log,
+		MsgIndex:         result.MsgIndex,
+	})
+}
+
+// getDelegationShares returns the shares of the delegation from the given
+// delegator to the given validator, or zero if the delegation does not exist
+func (k Keeper) getDelegationShares(ctx context.Context, delAddrStr, valAddrStr string) (sdkmath.LegacyDec, error) {
+	delAddr, err := sdk.AccAddressFromBech32(delAddrStr)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	delegation, err := k.stk.GetDelegation(ctx, delAddr, valAddr)
+	if errors.Is(err, stakingtypes.ErrNoDelegation) {
+		// e.g., the delegator has undelegated all of its stake
+		return sdkmath.LegacyZeroDec(), nil
+	} else if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	return delegation.Shares, nil
+}
+
+func (k Keeper) setQueuedMsgResult(ctx context.Context, result *types.QueuedMessageResult) {
+	// messages without IDs cannot be looked up, e.g., those queued at
+	// genesis, so their results are only signalled via events
+	if len(result.TxId) == 0 || len(result.MsgId) == 0 {
+		return
+	}
+	key := queuedMsgResultKey(result.TxId, result.MsgIndex, result.MsgId)
+	k.queuedMsgResultStore(ctx).Set(key, k.cdc.MustMarshal(result))
+
+	// index the result by the epoch, so that it can be pruned once the epoch
+	// is out of the retention window
+	epochIndexKey := append(sdk.Uint64ToBigEndian(result.EpochNumber), key...)
+	k.epochMsgResultStore(ctx).Set(epochIndexKey, key)
+
+	// index the result by the delegator, if the delegator address is valid
+	delAddr, err := sdk.AccAddressFromBech32(result.DelegatorAddress)
+	if err != nil {
+		return
+	}
+	indexKey := append(sdk.Uint64ToBigEndian(result.EpochNumber), key...)
+	k.delegatorMsgResultStore(ctx, delAddr).Set(indexKey, key)
+}
+
+// PruneQueuedMsgResults removes the execution results of queued messages, and
+// their indexes, of the epochs that are out of the retention window w.r.t. the
+// given ending epoch. At most MaxPrunedMsgResultsPerEpoch results are removed
+// at a time, so that a reduced retention window is caught up over multiple
+// epochs. It is a no-op if pruning is disabled
+func (k Keeper) PruneQueuedMsgResults(ctx context.Context, epochNumber uint64) {
+	retention := k.GetParams(ctx).MsgResultRetentionEpochs
+	if retention == 0 || epochNumber < retention {
+		return
+	}
+	// results of epochs up to epochNumber - retention are pruned
+	end := sdk.Uint64ToBigEndian(epochNumber - retention + 1)
+
+	epochStore := k.epochMsgResultStore(ctx)
+	iter := epochStore.Iterator(nil, end)
+	indexKeys := [][]byte{}
+	for ; iter.Valid() && len(indexKeys) < types.MaxPrunedMsgResultsPerEpoch; iter.Next() {
+		indexKeys = append(indexKeys, iter.Key())
+	}
+	iter.Close()
+
+	resultStore := k.queuedMsgResultStore(ctx)
+	for _, indexKey := range indexKeys {
+		// here indexKey is epochNumber || txID || msgIndex || msgID
+		key := indexKey[8:]
+		if result := k.getQueuedMsgResultByKey(ctx, key); result != nil {
+			if delAddr, err := sdk.AccAddressFromBech32(result.DelegatorAddress); err == nil {
+				k.delegatorMsgResultStore(ctx, delAddr).Delete(indexKey)
+			}
+			resultStore.Delete(key)
+		}
+		epochStore.Delete(indexKey)
+	}
+}
+
+// GetQueuedMsgResult returns the execution result of the queued message with
+// the given ID at the given position of the given tx, or nil if the message
+// has not been executed yet
+func (k Keeper) GetQueuedMsgResult(ctx context.Context, txID []byte, msgIndex uint64, msgID []byte) *types.QueuedMessageResult {
+	return k.getQueuedMsgResultByKey(ctx, queuedMsgResultKey(txID, msgIndex, msgID))
+}
+
+// GetQueuedMsgResultsByTx returns the execution results of the queued messages
+// in the given tx, ordered by their positions in the tx
+func (k Keeper) GetQueuedMsgResultsByTx(ctx context.Context, txID []byte) []*types.QueuedMessageResult {
+	store := prefix.NewStore(k.queuedMsgResultStore(ctx), txID)
+	iter := storetypes.KVStorePrefixIterator(store, nil)
+	defer iter.Close()
+
+	results := []*types.QueuedMessageResult{}
+	for ; iter.Valid(); iter.Next() {
+		var result types.QueuedMessageResult
+		k.cdc.MustUnmarshal(iter.Value(), &result)
+		results = append(results, &result)
+	}
+	return results
+}
+
+func (k Keeper) getQueuedMsgResultByKey(ctx context.Context, key []byte) *types.QueuedMessageResult {
+	bz := k.queuedMsgResultStore(ctx).Get(key)
+	if bz == nil {
+		return nil
+	}
+	var result types.QueuedMessageResult
+	k.cdc.MustUnmarshal(bz, &result)
+	return &result
+}
+
+// queuedMsgResultKey returns the key of the execution result of a queued
+// message, i.e., txID || msgIndex || msgID. The position of the message in
+// its tx tells apart the results of identical messages of the same tx
+func queuedMsgResultKey(txID []byte, msgIndex uint64, msgID []byte) []byte {
+	key := append(append([]byte{}, txID...), sdk.Uint64ToBigEndian(msgIndex)...)
+	return append(key, msgID...)
+}
+
+// queuedMsgResultStore returns the KVStore of the execution results of queued
+// messages
+// prefix: QueuedMsgResultKey
+// key: (txID || msgIndex || msgID)
+// value: QueuedMessageResult
+func (k Keeper) queuedMsgResultStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.QueuedMsgResultKey)
+}
+
+// epochMsgResultStore returns the KVStore indexing the execution results of
+// queued messages by epoch
+// prefix: EpochMsgResultKey
+// key: (epochNumber || txID || msgIndex || msgID)
+// value: (txID || msgIndex || msgID)
+func (k Keeper) epochMsgResultStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.EpochMsgResultKey)
+}
+
+// delegatorMsgResultStore returns the KVStore indexing the execution results
+// of the queued messages of a given delegator
+// prefix: DelegatorMsgResultKey || length-prefixed delAddr
+// key: (epochNumber || txID || msgIndex || msgID)
+// value: (txID || msgIndex || msgID)
+func (k Keeper) delegatorMsgResultStore(ctx context.Context, delAddr sdk.AccAddress) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.DelegatorMsgResultKey)
+	return prefix.NewStore(store, address.MustLengthPrefix(delAddr))
+}
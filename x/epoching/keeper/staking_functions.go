@@ -4,6 +4,7 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -115,3 +116,84 @@ func (k Keeper) CheckMsgCreateValidator(ctx context.Context, msg *stakingtypes.M
 func (k Keeper) GetPubKeyByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (cmtprotocrypto.PublicKey, error) {
 	return k.stk.GetPubKeyByConsAddr(ctx, consAddr)
 }
+
+// GetValidator returns the validator with the given address from the staking module
+func (k Keeper) GetValidator(ctx context.Context, valAddr sdk.ValAddress) (stakingtypes.Validator, error) {
+	return k.stk.GetValidator(ctx, valAddr)
+}
+
+// BondDenom returns the bond denom of the staking module
+func (k Keeper) BondDenom(ctx context.Context) (string, error) {
+	return k.stk.BondDenom(ctx)
+}
+
+// EnqueueWrappedDelegate verifies the given `MsgWrappedDelegate` message and
+// enqueues it to the message queue of the current epoch
+// Apart from the msg server, it is used by modules delegating on behalf of
+// users, e.g., x/incentive auto-restaking withdrawn rewards
+func (k Keeper) EnqueueWrappedDelegate(ctx context.Context, msg *types.MsgWrappedDelegate) error {
+	return k.enqueueWrappedDelegate(ctx, msg, "")
+}
+
+// enqueueWrappedDelegate is EnqueueWrappedDelegate that additionally records
+// the grantee that queued the message on behalf of the delegator, if any
+func (k Keeper) enqueueWrappedDelegate(ctx context.Context, msg *types.MsgWrappedDelegate, grantee string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.Msg == nil {
+		return types.ErrNoWrappedMsg
+	}
+
+	// verification rules ported from staking module
+	valAddr, valErr := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress)
+	if valErr != nil {
+		return valErr
+	}
+	if _, err := k.stk.GetValidator(ctx, valAddr); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Msg.DelegatorAddress); err != nil {
+		return err
+	}
+	bondDenom, err := k.stk.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	if msg.Msg.Amount.Denom != bondDenom {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	blockHeight := uint64(sdkCtx.HeaderInfo().Height)
+	if blockHeight == 0 {
+		return types.ErrZeroEpochMsg
+	}
+	blockTime := sdkCtx.HeaderInfo().Time
+
+	txid := tmhash.Sum(sdkCtx.TxBytes())
+	queuedMsg, err := types.NewQueuedMessage(blockHeight, blockTime, txid, msg)
+	if err != nil {
+		return err
+	}
+
+	// lock the delegated funds in the escrow until the end of the epoch, so
+	// that the delegation cannot fail due to the delegator spending them
+	if err := k.EscrowQueuedMsg(ctx, &queuedMsg); err != nil {
+		return err
+	}
+
+	queuedMsg.Grantee = grantee
+	if err := k.EnqueueMsg(ctx, queuedMsg); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvents(
+		&types.EventWrappedDelegate{
+			DelegatorAddress: msg.Msg.DelegatorAddress,
+			ValidatorAddress: msg.Msg.ValidatorAddress,
+			Amount:           msg.Msg.Amount.Amount.Uint64(),
+			Denom:            msg.Msg.Amount.GetDenom(),
+			EpochBoundary:    k.GetEpoch(ctx).GetLastBlockHeight(),
+		},
+	)
+}
@@ -11,6 +11,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWrappedDelegate{}, "epoching/WrappedDelegate", nil)
 	cdc.RegisterConcrete(&MsgWrappedUndelegate{}, "epoching/WrappedUndelegate", nil)
 	cdc.RegisterConcrete(&MsgWrappedBeginRedelegate{}, "epoching/WrappedBeginRedelegate", nil)
+	cdc.RegisterConcrete(&MsgWrappedExec{}, "epoching/WrappedExec", nil)
 	cdc.RegisterConcrete(&QueuedMessage{}, "epoching/QueuedMessage", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "epoching/MsgUpdateParams", nil)
 }
@@ -22,6 +23,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgWrappedDelegate{},
 		&MsgWrappedUndelegate{},
 		&MsgWrappedBeginRedelegate{},
+		&MsgWrappedExec{},
 		&QueuedMessage{},
 		&MsgUpdateParams{},
 	)
@@ -188,3 +188,29 @@ func (qm *QueuedMessage) UnwrapToSdkMsg() sdk.Msg {
 	}
 	return unwrappedMsgWithType
 }
+
+// DelegatorAndValidator returns the address of the delegator signing the
+// queued message, and the address of the validator the delegator ends up
+// delegating to upon executing the message, i.e., the destination validator
+// of a redelegation
+func (qm *QueuedMessage) DelegatorAndValidator() (string, string) {
+	switch unwrappedMsg := qm.Msg.(type) {
+	case *QueuedMessage_MsgCreateValidator:
+		// the validator self-delegates from its account
+		valAddr, err := sdk.ValAddressFromBech32(unwrappedMsg.MsgCreateValidator.ValidatorAddress)
+		if err != nil {
+			return "", unwrappedMsg.MsgCreateValidator.ValidatorAddress
+		}
+		return sdk.AccAddress(valAddr).String(), unwrappedMsg.MsgCreateValidator.ValidatorAddress
+	case *QueuedMessage_MsgDelegate:
+		return unwrappedMsg.MsgDelegate.DelegatorAddress, unwrappedMsg.MsgDelegate.ValidatorAddress
+	case *QueuedMessage_MsgUndelegate:
+		return unwrappedMsg.MsgUndelegate.DelegatorAddress, unwrappedMsg.MsgUndelegate.ValidatorAddress
+	case *QueuedMessage_MsgBeginRedelegate:
+		return unwrappedMsg.MsgBeginRedelegate.DelegatorAddress, unwrappedMsg.MsgBeginRedelegate.ValidatorDstAddress
+	case *QueuedMessage_MsgCancelUnbondingDelegation:
+		return unwrappedMsg.MsgCancelUnbondingDelegation.DelegatorAddress, unwrappedMsg.MsgCancelUnbondingDelegation.ValidatorAddress
+	default:
+		panic(errorsmod.Wrap(ErrInvalidQueuedMessageType, qm.String()))
+	}
+}
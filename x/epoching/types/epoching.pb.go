@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -73,7 +75,9 @@ func (BondState) EnumDescriptor() ([]byte, []int) {
 type Epoch struct {
 	// epoch_number is the number of this epoch
 	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	// current_epoch_interval is the epoch interval at the time of this epoch
+	// current_epoch_interval is the epoch interval at the time of this epoch,
+	// i.e., the number of blocks in this epoch. It is not affected by updates of
+	// the epoch interval during this epoch.
 	CurrentEpochInterval uint64 `protobuf:"varint,2,opt,name=current_epoch_interval,json=currentEpochInterval,proto3" json:"current_epoch_interval,omitempty"`
 	// first_block_height is the height of the first block in this epoch
 	FirstBlockHeight uint64 `protobuf:"varint,3,opt,name=first_block_height,json=firstBlockHeight,proto3" json:"first_block_height,omitempty"`
@@ -189,6 +193,21 @@ type QueuedMessage struct {
 	//	*QueuedMessage_MsgBeginRedelegate
 	//	*QueuedMessage_MsgCancelUnbondingDelegation
 	Msg isQueuedMessage_Msg `protobuf_oneof:"msg"`
+	// grantee is the address that queued the msg on behalf of its signer via an
+	// authz grant, or empty if the msg is queued by its signer
+	Grantee string `protobuf:"bytes,10,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// fee is the fee paid for the msg in the bond denom, i.e., the fee of its
+	// tx divided by the number of msgs queued by the tx, which can differ from
+	// the number of msgs in the tx. It prioritises the msg when the queue of the
+	// epoch is full.
+	Fee uint64 `protobuf:"varint,11,opt,name=fee,proto3" json:"fee,omitempty"`
+	// escrowed is whether the funds to be bonded by the msg are locked in the
+	// escrow. Msgs queued before the escrow was introduced bond the funds from
+	// their accounts directly upon execution.
+	Escrowed bool `protobuf:"varint,12,opt,name=escrowed,proto3" json:"escrowed,omitempty"`
+	// msg_index is the position of the msg among the msgs its tx submits to the
+	// queue, which tells apart identical msgs of the same tx
+	MsgIndex uint64 `protobuf:"varint,13,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
 }
 
 func (m *QueuedMessage) Reset()         { *m = QueuedMessage{} }
@@ -322,6 +341,34 @@ func (m *QueuedMessage) GetMsgCancelUnbondingDelegation() *types.MsgCancelUnbond
 	return nil
 }
 
+func (m *QueuedMessage) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *QueuedMessage) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *QueuedMessage) GetEscrowed() bool {
+	if m != nil {
+		return m.Escrowed
+	}
+	return false
+}
+
+func (m *QueuedMessage) GetMsgIndex() uint64 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*QueuedMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -333,6 +380,138 @@ func (*QueuedMessage) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// QueuedMessageResult is the execution result of a queued message at the end
+// of the epoch it is queued in
+type QueuedMessageResult struct {
+	// epoch_number is the number of the epoch the message is queued in
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// tx_id is the ID of the tx that contains the message
+	TxId []byte `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// msg_id is the original message ID, i.e., hash of the marshaled message
+	MsgId []byte `protobuf:"bytes,3,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	// delegator_address is the address of the delegator that signs the message
+	DelegatorAddress string `protobuf:"bytes,4,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// success is whether the message has been executed successfully
+	Success bool `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// codespace is the codespace of the error upon failure
+	Codespace string `protobuf:"bytes,6,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// code is the code of the error upon failure
+	Code uint32 `protobuf:"varint,7,opt,name=code,proto3" json:"code,omitempty"`
+	// log is the log of the execution, i.e., the error message upon failure
+	Log string `protobuf:"bytes,8,opt,name=log,proto3" json:"log,omitempty"`
+	// shares is the resulting delegation shares of the delegator with the
+	// (destination) validator of the message upon success
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+	// height is the height of the block in which the message is executed
+	Height uint64 `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+	// msg_index is the position of the message among the messages its tx
+	// submits to the queue, which tells apart identical messages of the same tx
+	MsgIndex uint64 `protobuf:"varint,11,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+}
+
+func (m *QueuedMessageResult) Reset()         { *m = QueuedMessageResult{} }
+func (m *QueuedMessageResult) String() string { return proto.CompactTextString(m) }
+func (*QueuedMessageResult) ProtoMessage()    {}
+func (*QueuedMessageResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f2f209d5311f84c, []int{2}
+}
+func (m *QueuedMessageResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedMessageResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedMessageResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedMessageResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedMessageResult.Merge(m, src)
+}
+func (m *QueuedMessageResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedMessageResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedMessageResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedMessageResult proto.InternalMessageInfo
+
+func (m *QueuedMessageResult) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *QueuedMessageResult) GetTxId() []byte {
+	if m != nil {
+		return m.TxId
+	}
+	return nil
+}
+
+func (m *QueuedMessageResult) GetMsgId() []byte {
+	if m != nil {
+		return m.MsgId
+	}
+	return nil
+}
+
+func (m *QueuedMessageResult) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueuedMessageResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *QueuedMessageResult) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *QueuedMessageResult) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *QueuedMessageResult) GetLog() string {
+	if m != nil {
+		return m.Log
+	}
+	return ""
+}
+
+func (m *QueuedMessageResult) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueuedMessageResult) GetMsgIndex() uint64 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
 // ValStateUpdate is a message that records a state update of a validator
 type ValStateUpdate struct {
 	State       BondState  `protobuf:"varint,1,opt,name=state,proto3,enum=babylon.epoching.v1.BondState" json:"state,omitempty"`
@@ -344,7 +523,7 @@ func (m *ValStateUpdate) Reset()         { *m = ValStateUpdate{} }
 func (m *ValStateUpdate) String() string { return proto.CompactTextString(m) }
 func (*ValStateUpdate) ProtoMessage()    {}
 func (*ValStateUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f2f209d5311f84c, []int{2}
+	return fileDescriptor_2f2f209d5311f84c, []int{3}
 }
 func (m *ValStateUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +584,7 @@ func (m *ValidatorLifecycle) Reset()         { *m = ValidatorLifecycle{} }
 func (m *ValidatorLifecycle) String() string { return proto.CompactTextString(m) }
 func (*ValidatorLifecycle) ProtoMessage()    {}
 func (*ValidatorLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f2f209d5311f84c, []int{3}
+	return fileDescriptor_2f2f209d5311f84c, []int{4}
 }
 func (m *ValidatorLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +641,7 @@ func (m *DelegationStateUpdate) Reset()         { *m = DelegationStateUpdate{} }
 func (m *DelegationStateUpdate) String() string { return proto.CompactTextString(m) }
 func (*DelegationStateUpdate) ProtoMessage()    {}
 func (*DelegationStateUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f2f209d5311f84c, []int{4}
+	return fileDescriptor_2f2f209d5311f84c, []int{5}
 }
 func (m *DelegationStateUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,7 +716,7 @@ func (m *DelegationLifecycle) Reset()         { *m = DelegationLifecycle{} }
 func (m *DelegationLifecycle) String() string { return proto.CompactTextString(m) }
 func (*DelegationLifecycle) ProtoMessage()    {}
 func (*DelegationLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f2f209d5311f84c, []int{5}
+	return fileDescriptor_2f2f209d5311f84c, []int{6}
 }
 func (m *DelegationLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -592,7 +771,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f2f209d5311f84c, []int{6}
+	return fileDescriptor_2f2f209d5311f84c, []int{7}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,6 +818,7 @@ func init() {
 	proto.RegisterEnum("babylon.epoching.v1.BondState", BondState_name, BondState_value)
 	proto.RegisterType((*Epoch)(nil), "babylon.epoching.v1.Epoch")
 	proto.RegisterType((*QueuedMessage)(nil), "babylon.epoching.v1.QueuedMessage")
+	proto.RegisterType((*QueuedMessageResult)(nil), "babylon.epoching.v1.QueuedMessageResult")
 	proto.RegisterType((*ValStateUpdate)(nil), "babylon.epoching.v1.ValStateUpdate")
 	proto.RegisterType((*ValidatorLifecycle)(nil), "babylon.epoching.v1.ValidatorLifecycle")
 	proto.RegisterType((*DelegationStateUpdate)(nil), "babylon.epoching.v1.DelegationStateUpdate")
@@ -651,63 +831,77 @@ func init() {
 }
 
 var fileDescriptor_2f2f209d5311f84c = []byte{
-	// 881 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xdf, 0x6e, 0xe3, 0x44,
-	0x14, 0xc6, 0xe3, 0xfc, 0x6b, 0x73, 0x92, 0x74, 0xc3, 0xb4, 0x8b, 0xb2, 0x15, 0x4a, 0x4b, 0x10,
-	0xa8, 0xaa, 0x90, 0x4d, 0x4b, 0xb9, 0x05, 0x35, 0x4d, 0x84, 0x8b, 0x68, 0x56, 0x98, 0x6d, 0x2f,
-	0xb8, 0xc0, 0x1a, 0xdb, 0x53, 0xdb, 0x5a, 0x7b, 0xc6, 0xf2, 0x8c, 0xb3, 0xed, 0x05, 0xef, 0xb0,
-	0xcf, 0x81, 0x78, 0x10, 0x2e, 0xf7, 0x92, 0x3b, 0x50, 0xfb, 0x20, 0x8b, 0x66, 0xec, 0x38, 0x29,
-	0x8d, 0x5a, 0x95, 0xbd, 0xf3, 0x9c, 0xf3, 0x9d, 0x6f, 0xce, 0xf9, 0xe5, 0x68, 0x02, 0x43, 0x07,
-	0x3b, 0xd7, 0x11, 0xa3, 0x06, 0x49, 0x98, 0x1b, 0x84, 0xd4, 0x37, 0x66, 0x07, 0xe5, 0xb7, 0x9e,
-	0xa4, 0x4c, 0x30, 0xb4, 0x59, 0x68, 0xf4, 0x32, 0x3e, 0x3b, 0xd8, 0xde, 0xf1, 0x19, 0xf3, 0x23,
-	0x62, 0x28, 0x89, 0x93, 0x5d, 0x1a, 0x22, 0x8c, 0x09, 0x17, 0x38, 0x4e, 0xf2, 0xaa, 0xed, 0x2d,
-	0x9f, 0xf9, 0x4c, 0x7d, 0x1a, 0xf2, 0xab, 0x88, 0xee, 0xb8, 0x8c, 0xc7, 0x8c, 0x1b, 0x5c, 0xe0,
-	0xd7, 0xf9, 0x6d, 0x0e, 0x11, 0xf8, 0xc0, 0x10, 0x57, 0x85, 0x60, 0x50, 0x08, 0x1c, 0xcc, 0x49,
-	0x99, 0x75, 0x59, 0x48, 0xf3, 0xfc, 0xf0, 0x8f, 0x2a, 0x34, 0x26, 0xb2, 0x0f, 0xf4, 0x29, 0x74,
-	0x54, 0x43, 0x36, 0xcd, 0x62, 0x87, 0xa4, 0x7d, 0x6d, 0x57, 0xdb, 0xab, 0x5b, 0x6d, 0x15, 0x9b,
-	0xaa, 0x10, 0x3a, 0x82, 0x8f, 0xdd, 0x2c, 0x4d, 0x09, 0x15, 0x76, 0x2e, 0x0d, 0xa9, 0x20, 0xe9,
-	0x0c, 0x47, 0xfd, 0xaa, 0x12, 0x6f, 0x15, 0x59, 0x65, 0x78, 0x5a, 0xe4, 0xd0, 0x97, 0x80, 0x2e,
-	0xc3, 0x94, 0x0b, 0xdb, 0x89, 0x98, 0xfb, 0xda, 0x0e, 0x48, 0xe8, 0x07, 0xa2, 0x5f, 0x53, 0x15,
-	0x3d, 0x95, 0x19, 0xc9, 0x84, 0xa9, 0xe2, 0xc8, 0x84, 0x67, 0x11, 0x2e, 0xc5, 0x92, 0x42, 0xbf,
-	0xbe, 0xab, 0xed, 0xb5, 0x0f, 0xb7, 0xf5, 0x1c, 0x91, 0x3e, 0x47, 0xa4, 0xbf, 0x9a, 0x23, 0x1a,
-	0xd5, 0xdf, 0xfe, 0xbd, 0xa3, 0x59, 0x5d, 0x59, 0xa8, 0xbc, 0x64, 0x06, 0x7d, 0x01, 0xcf, 0x38,
-	0xc1, 0x11, 0x49, 0x6d, 0x9c, 0x24, 0x76, 0x80, 0x79, 0xd0, 0x6f, 0xec, 0x6a, 0x7b, 0x1d, 0xab,
-	0x9b, 0x87, 0x8f, 0x93, 0xc4, 0xc4, 0x3c, 0x40, 0xfb, 0xf0, 0x51, 0xa1, 0x2b, 0x1a, 0x94, 0xca,
-	0xa6, 0x52, 0x16, 0x06, 0x79, 0x7f, 0x98, 0x07, 0xc3, 0xf7, 0x75, 0xe8, 0xfe, 0x94, 0x91, 0x8c,
-	0x78, 0x67, 0x84, 0x73, 0xec, 0x13, 0xb4, 0x09, 0x0d, 0x71, 0x65, 0x87, 0x9e, 0xe2, 0xd5, 0xb1,
-	0xea, 0xe2, 0xea, 0xd4, 0x43, 0xcf, 0xa1, 0x19, 0x73, 0x5f, 0x46, 0xab, 0x2a, 0xda, 0x88, 0xb9,
-	0x7f, 0xea, 0x49, 0xc4, 0x2b, 0x18, 0xb4, 0x9d, 0xa5, 0xf1, 0xbf, 0x03, 0xf8, 0x1f, 0x93, 0xb7,
-	0x9c, 0x72, 0xea, 0x5f, 0x61, 0x4b, 0x5e, 0xed, 0xa6, 0x04, 0x0b, 0x62, 0xcf, 0x70, 0x14, 0x7a,
-	0x58, 0xb0, 0x54, 0x8d, 0xde, 0x3e, 0xdc, 0xd7, 0xf3, 0x7d, 0xd0, 0x8b, 0x85, 0xd1, 0x8b, 0x95,
-	0xd0, 0xcf, 0xb8, 0x7f, 0xa2, 0x4a, 0x2e, 0xe6, 0x15, 0x66, 0xc5, 0x42, 0xf1, 0xbd, 0x28, 0x32,
-	0xa1, 0x23, 0xfd, 0x3d, 0x12, 0x11, 0x1f, 0x0b, 0xa2, 0x40, 0xb5, 0x0f, 0x3f, 0x7b, 0xc0, 0x77,
-	0x5c, 0x48, 0xcd, 0x8a, 0xd5, 0x8e, 0x17, 0x47, 0x34, 0x85, 0x0d, 0xe9, 0x94, 0xd1, 0xd2, 0x6b,
-	0x4d, 0x79, 0x7d, 0xfe, 0x80, 0xd7, 0x79, 0x29, 0x36, 0x2b, 0x56, 0x37, 0x5e, 0x0e, 0xcc, 0x27,
-	0x77, 0x88, 0x1f, 0x52, 0x3b, 0x25, 0xa5, 0xeb, 0xfa, 0xa3, 0x93, 0x8f, 0x64, 0x89, 0x45, 0x96,
-	0xac, 0xe5, 0xe4, 0xff, 0x89, 0xa2, 0xdf, 0x60, 0x47, 0x91, 0xc5, 0xd4, 0x25, 0x91, 0x9d, 0x51,
-	0x87, 0x51, 0x2f, 0xa4, 0x25, 0x8a, 0x90, 0xd1, 0x7e, 0x4b, 0x5d, 0x75, 0xf4, 0x10, 0x64, 0x55,
-	0x7d, 0x3e, 0x2f, 0x1e, 0x97, 0xb5, 0x66, 0xc5, 0xfa, 0x24, 0x7e, 0x20, 0x3f, 0x6a, 0x40, 0x2d,
-	0xe6, 0xfe, 0xf0, 0x77, 0x0d, 0x36, 0x2e, 0x70, 0xf4, 0xb3, 0xc0, 0x82, 0x9c, 0x27, 0x9e, 0x6c,
-	0xec, 0x08, 0x1a, 0x5c, 0x1e, 0xd5, 0x0a, 0x6e, 0x1c, 0x0e, 0xf4, 0x15, 0x0f, 0x8c, 0x3e, 0x62,
-	0xd4, 0x53, 0x45, 0x56, 0x2e, 0xbe, 0xb7, 0x8c, 0xd5, 0xc7, 0x96, 0xb1, 0xf6, 0xe4, 0x65, 0x1c,
-	0x32, 0x40, 0xe5, 0xe6, 0xfc, 0x18, 0x5e, 0x12, 0xf7, 0xda, 0x8d, 0x08, 0x7a, 0x01, 0xeb, 0x33,
-	0x1c, 0xd9, 0xd8, 0xf3, 0xf2, 0x57, 0xa6, 0x65, 0xad, 0xcd, 0x70, 0x74, 0xec, 0x79, 0x29, 0xfa,
-	0x36, 0x4f, 0x45, 0xe1, 0x25, 0xe9, 0x57, 0x77, 0x6b, 0x6a, 0xb3, 0x56, 0x4d, 0x73, 0x97, 0x80,
-	0xaa, 0x97, 0xfe, 0xc3, 0xf7, 0x1a, 0x3c, 0x5f, 0x30, 0xfb, 0x70, 0x48, 0xcb, 0xad, 0x56, 0xef,
-	0xb6, 0x7a, 0x00, 0x4d, 0x1c, 0xb3, 0x8c, 0x8a, 0x02, 0xcc, 0x8b, 0xf9, 0xaf, 0x2e, 0x9f, 0xda,
-	0xf2, 0x27, 0x3f, 0x61, 0x21, 0xb5, 0x0a, 0xe1, 0x3d, 0xe4, 0xf5, 0xc7, 0x90, 0x37, 0x9e, 0x8e,
-	0xfc, 0x0d, 0x6c, 0x2e, 0x00, 0xdc, 0x61, 0xee, 0x91, 0xbb, 0xcc, 0x3d, 0x92, 0x0f, 0x32, 0xc9,
-	0x53, 0x4b, 0xcc, 0xf7, 0x57, 0xc2, 0x59, 0xc9, 0x55, 0xd9, 0x28, 0xf4, 0xdf, 0x40, 0x6b, 0xf1,
-	0x4a, 0x20, 0xa8, 0x97, 0x57, 0x75, 0x2c, 0xf5, 0x8d, 0xb6, 0xa0, 0x91, 0xb0, 0x37, 0x24, 0x07,
-	0x59, 0xb3, 0xf2, 0xc3, 0xfe, 0x14, 0x5a, 0x25, 0x75, 0xd4, 0x86, 0xb5, 0x13, 0x6b, 0x72, 0xfc,
-	0x6a, 0x32, 0xee, 0x55, 0x10, 0x40, 0x73, 0xf4, 0x72, 0x3a, 0x9e, 0x8c, 0x7b, 0x1a, 0xea, 0x42,
-	0xeb, 0x7c, 0x2a, 0x4f, 0xa7, 0xd3, 0xef, 0x7b, 0x55, 0xd4, 0x81, 0xf5, 0xfc, 0x38, 0x19, 0xf7,
-	0x6a, 0xb2, 0xca, 0x9a, 0x9c, 0xbd, 0xbc, 0x98, 0x8c, 0x7b, 0xf5, 0xd1, 0x0f, 0x7f, 0xde, 0x0c,
-	0xb4, 0x77, 0x37, 0x03, 0xed, 0x9f, 0x9b, 0x81, 0xf6, 0xf6, 0x76, 0x50, 0x79, 0x77, 0x3b, 0xa8,
-	0xfc, 0x75, 0x3b, 0xa8, 0xfc, 0xf2, 0x95, 0x1f, 0x8a, 0x20, 0x73, 0x74, 0x97, 0xc5, 0x46, 0x31,
-	0x9f, 0x1b, 0xe0, 0x90, 0xce, 0x0f, 0xc6, 0xd5, 0xe2, 0x5f, 0x5b, 0x5c, 0x27, 0x84, 0x3b, 0x4d,
-	0x05, 0xfc, 0xeb, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x6a, 0x0b, 0x3c, 0xd6, 0x07, 0x00,
-	0x00,
+	// 1108 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6e, 0xdb, 0xc6,
+	0x13, 0xc7, 0x45, 0xfd, 0xb3, 0x38, 0x92, 0x12, 0x65, 0xed, 0x04, 0x8c, 0x13, 0x48, 0xfa, 0xe9,
+	0x87, 0x16, 0x86, 0xdb, 0x52, 0xb5, 0x9b, 0x5e, 0x5b, 0x44, 0x91, 0x50, 0xb9, 0x88, 0x15, 0x74,
+	0x1b, 0xfb, 0xd0, 0x43, 0x89, 0x25, 0xb9, 0xa6, 0x08, 0x93, 0x5c, 0x81, 0xbb, 0x92, 0xed, 0x43,
+	0xdf, 0x21, 0xcf, 0x51, 0xf4, 0xd8, 0x87, 0xc8, 0x31, 0xe8, 0xa9, 0xe8, 0x21, 0x2d, 0xec, 0xde,
+	0xfb, 0x08, 0x2d, 0x76, 0x49, 0x51, 0x52, 0x2d, 0xd8, 0x4d, 0x7b, 0xdb, 0x99, 0xf9, 0xce, 0x70,
+	0xe7, 0xb3, 0xb3, 0x2b, 0x41, 0xc7, 0x26, 0xf6, 0x45, 0xc0, 0xa2, 0x2e, 0x9d, 0x30, 0x67, 0xec,
+	0x47, 0x5e, 0x77, 0xb6, 0x97, 0xad, 0xcd, 0x49, 0xcc, 0x04, 0x43, 0x9b, 0xa9, 0xc6, 0xcc, 0xfc,
+	0xb3, 0xbd, 0xed, 0x96, 0xc7, 0x98, 0x17, 0xd0, 0xae, 0x92, 0xd8, 0xd3, 0x93, 0xae, 0xf0, 0x43,
+	0xca, 0x05, 0x09, 0x27, 0x49, 0xd6, 0xf6, 0x96, 0xc7, 0x3c, 0xa6, 0x96, 0x5d, 0xb9, 0x4a, 0xbd,
+	0x0f, 0x1d, 0xc6, 0x43, 0xc6, 0xad, 0x24, 0x90, 0x18, 0x69, 0xa8, 0x95, 0x58, 0x5d, 0x2e, 0xc8,
+	0x69, 0xb2, 0x11, 0x9b, 0x0a, 0xb2, 0xd7, 0x15, 0xe7, 0xa9, 0xa0, 0x99, 0x0a, 0x6c, 0xc2, 0x69,
+	0x16, 0x75, 0x98, 0x1f, 0x25, 0xf1, 0xce, 0x0f, 0x79, 0x28, 0x0d, 0xe4, 0x16, 0xd1, 0xff, 0xa0,
+	0xa6, 0xf6, 0x6a, 0x45, 0xd3, 0xd0, 0xa6, 0xb1, 0xa1, 0xb5, 0xb5, 0x9d, 0x22, 0xae, 0x2a, 0xdf,
+	0x48, 0xb9, 0xd0, 0x13, 0x78, 0xe0, 0x4c, 0xe3, 0x98, 0x46, 0xc2, 0x4a, 0xa4, 0x7e, 0x24, 0x68,
+	0x3c, 0x23, 0x81, 0x91, 0x57, 0xe2, 0xad, 0x34, 0xaa, 0x0a, 0x1e, 0xa4, 0x31, 0xf4, 0x21, 0xa0,
+	0x13, 0x3f, 0xe6, 0xc2, 0xb2, 0x03, 0xe6, 0x9c, 0x5a, 0x63, 0xea, 0x7b, 0x63, 0x61, 0x14, 0x54,
+	0x46, 0x43, 0x45, 0x7a, 0x32, 0x30, 0x54, 0x7e, 0x34, 0x84, 0xbb, 0x01, 0xc9, 0xc4, 0x12, 0x90,
+	0x51, 0x6c, 0x6b, 0x3b, 0xd5, 0xfd, 0x6d, 0x33, 0xa1, 0x67, 0xce, 0xe9, 0x99, 0x2f, 0xe7, 0xf4,
+	0x7a, 0xc5, 0x57, 0xbf, 0xb6, 0x34, 0x5c, 0x97, 0x89, 0xaa, 0x96, 0x8c, 0xa0, 0xf7, 0xe1, 0x2e,
+	0xa7, 0x24, 0xa0, 0xb1, 0x45, 0x26, 0x13, 0x6b, 0x4c, 0xf8, 0xd8, 0x28, 0xb5, 0xb5, 0x9d, 0x1a,
+	0xae, 0x27, 0xee, 0xa7, 0x93, 0xc9, 0x90, 0xf0, 0x31, 0xda, 0x85, 0x7b, 0xa9, 0x2e, 0xdd, 0xa0,
+	0x54, 0x96, 0x95, 0x32, 0x2d, 0x90, 0xec, 0x8f, 0xf0, 0x71, 0xe7, 0xf7, 0x12, 0xd4, 0xbf, 0x9a,
+	0xd2, 0x29, 0x75, 0x0f, 0x29, 0xe7, 0xc4, 0xa3, 0x68, 0x13, 0x4a, 0xe2, 0xdc, 0xf2, 0x5d, 0xc5,
+	0xab, 0x86, 0x8b, 0xe2, 0xfc, 0xc0, 0x45, 0xf7, 0xa1, 0x1c, 0x72, 0x4f, 0x7a, 0xf3, 0xca, 0x5b,
+	0x0a, 0xb9, 0x77, 0xe0, 0x4a, 0xc4, 0x6b, 0x18, 0x54, 0xed, 0xa5, 0xf6, 0x3f, 0x07, 0xf8, 0x17,
+	0x9d, 0xeb, 0x76, 0xd6, 0xf5, 0xb7, 0xb0, 0x25, 0x3f, 0xed, 0xc4, 0x94, 0x08, 0x6a, 0xcd, 0x48,
+	0xe0, 0xbb, 0x44, 0xb0, 0x58, 0xb5, 0x5e, 0xdd, 0xdf, 0x35, 0xd3, 0xf1, 0x49, 0x07, 0xc6, 0x4c,
+	0x47, 0xc2, 0x3c, 0xe4, 0xde, 0x33, 0x95, 0x72, 0x3c, 0xcf, 0x18, 0xe6, 0x30, 0x0a, 0xaf, 0x79,
+	0xd1, 0x10, 0x6a, 0xb2, 0xbe, 0x4b, 0x03, 0xea, 0x11, 0x41, 0x15, 0xa8, 0xea, 0xfe, 0xff, 0x6f,
+	0xa8, 0xdb, 0x4f, 0xa5, 0xc3, 0x1c, 0xae, 0x86, 0x0b, 0x13, 0x8d, 0xe0, 0x8e, 0xac, 0x34, 0x8d,
+	0xb2, 0x5a, 0x1b, 0xaa, 0xd6, 0x7b, 0x37, 0xd4, 0x3a, 0xca, 0xc4, 0xc3, 0x1c, 0xae, 0x87, 0xcb,
+	0x8e, 0x79, 0xe7, 0x36, 0xf5, 0xfc, 0xc8, 0x8a, 0x69, 0x56, 0xb5, 0x72, 0x6b, 0xe7, 0x3d, 0x99,
+	0x82, 0xe9, 0x52, 0x69, 0xd9, 0xf9, 0xdf, 0xbc, 0xe8, 0x3b, 0x68, 0x29, 0xb2, 0x24, 0x72, 0x68,
+	0x60, 0x4d, 0x23, 0x9b, 0x45, 0xae, 0x1f, 0x65, 0x28, 0x7c, 0x16, 0x19, 0xba, 0xfa, 0xd4, 0x93,
+	0x9b, 0x20, 0xab, 0xec, 0xa3, 0x79, 0x72, 0x3f, 0xcb, 0x1d, 0xe6, 0xf0, 0xe3, 0xf0, 0x86, 0x38,
+	0x32, 0x60, 0xc3, 0x8b, 0x49, 0x24, 0x28, 0x35, 0xa0, 0xad, 0xed, 0xe8, 0x78, 0x6e, 0xa2, 0x06,
+	0x14, 0x4e, 0x28, 0x35, 0xaa, 0x6a, 0x9a, 0xe4, 0x12, 0x6d, 0x43, 0x85, 0x72, 0x27, 0x66, 0x67,
+	0xd4, 0x35, 0x6a, 0x6d, 0x6d, 0xa7, 0x82, 0x33, 0x1b, 0x3d, 0x02, 0x5d, 0xcd, 0x66, 0xe4, 0xd2,
+	0x73, 0xa3, 0xae, 0x72, 0x2a, 0x72, 0x3c, 0xa5, 0xdd, 0x2b, 0x41, 0x21, 0xe4, 0x5e, 0xe7, 0x8f,
+	0x3c, 0x6c, 0xae, 0x8c, 0x39, 0xa6, 0x7c, 0x1a, 0x88, 0x7f, 0xf2, 0x46, 0x64, 0xf7, 0x21, 0xbf,
+	0xf6, 0x3e, 0x14, 0x96, 0xef, 0xc3, 0x07, 0x70, 0x2f, 0x85, 0xc7, 0x62, 0x8b, 0xb8, 0x6e, 0x4c,
+	0x39, 0x57, 0x33, 0xaf, 0xe3, 0x46, 0x16, 0x78, 0x9a, 0xf8, 0x65, 0xff, 0x7c, 0xea, 0x38, 0x52,
+	0x52, 0x52, 0x2d, 0xcd, 0x4d, 0xf4, 0x18, 0x74, 0x87, 0xb9, 0x94, 0x4f, 0x88, 0x93, 0xcc, 0xa3,
+	0x8e, 0x17, 0x0e, 0x84, 0xa0, 0x28, 0x0d, 0x35, 0x5c, 0x75, 0xac, 0xd6, 0x92, 0x58, 0xc0, 0x3c,
+	0x35, 0x19, 0x3a, 0x96, 0x4b, 0x74, 0x00, 0x65, 0x3e, 0x26, 0x31, 0xe5, 0xea, 0x0c, 0xf5, 0xde,
+	0xde, 0xeb, 0xb7, 0xad, 0xdc, 0x2f, 0x6f, 0x5b, 0x8f, 0x92, 0xa3, 0xe4, 0xee, 0xa9, 0xe9, 0xb3,
+	0x6e, 0x48, 0xc4, 0xd8, 0x7c, 0x4e, 0x3d, 0xe2, 0x5c, 0xf4, 0xa9, 0xf3, 0xd3, 0x8f, 0x1f, 0x41,
+	0x7a, 0xd2, 0x7d, 0xea, 0xe0, 0xb4, 0x00, 0x7a, 0x00, 0xe5, 0xf4, 0x7e, 0x83, 0xc2, 0x93, 0x5a,
+	0xab, 0xe0, 0xab, 0xab, 0xe0, 0x3b, 0xdf, 0x6b, 0x70, 0xe7, 0x98, 0x04, 0x5f, 0x0b, 0x22, 0xe8,
+	0xd1, 0xc4, 0x95, 0xf3, 0xf6, 0x04, 0x4a, 0x5c, 0x9a, 0x8a, 0xf2, 0x9d, 0xfd, 0xa6, 0xb9, 0xe6,
+	0x27, 0xc5, 0xec, 0xb1, 0xc8, 0x55, 0x49, 0x38, 0x11, 0x5f, 0x7b, 0x63, 0xf2, 0xb7, 0xbd, 0x31,
+	0x85, 0x77, 0x7e, 0x63, 0x3a, 0x0c, 0x50, 0xf6, 0x20, 0x3c, 0xf7, 0x4f, 0xa8, 0x73, 0xe1, 0x04,
+	0x14, 0x3d, 0x84, 0xca, 0x8c, 0x04, 0xea, 0x1c, 0xd5, 0x96, 0x75, 0xbc, 0x31, 0x23, 0x81, 0x3c,
+	0x3e, 0xf4, 0x59, 0x12, 0x0a, 0xfc, 0x13, 0x6a, 0xe4, 0xdb, 0x05, 0xf5, 0x60, 0xac, 0xeb, 0x66,
+	0x95, 0x80, 0xca, 0x97, 0xf5, 0x3b, 0x7f, 0x6a, 0x70, 0x7f, 0x71, 0x15, 0xfe, 0x3b, 0xa4, 0xe5,
+	0xad, 0xe6, 0x57, 0xb7, 0xba, 0x07, 0x65, 0x12, 0xb2, 0x69, 0x24, 0x52, 0x30, 0x0f, 0xe7, 0x97,
+	0x59, 0xfe, 0x82, 0x66, 0x37, 0xf9, 0x19, 0xf3, 0x23, 0x9c, 0x0a, 0xaf, 0x21, 0x2f, 0xde, 0x86,
+	0xbc, 0xf4, 0xee, 0xc8, 0xcf, 0x60, 0x73, 0x01, 0x60, 0x85, 0xb9, 0x4b, 0x57, 0x99, 0xbb, 0x34,
+	0x69, 0x64, 0x90, 0x84, 0x96, 0x98, 0xef, 0xae, 0x85, 0xb3, 0x96, 0xab, 0x2a, 0xa3, 0xd0, 0x7f,
+	0x0a, 0xfa, 0xe2, 0xf1, 0x47, 0x50, 0xcc, 0x3e, 0x55, 0xc3, 0x6a, 0x8d, 0xb6, 0xa0, 0x34, 0x61,
+	0x67, 0x34, 0x01, 0x59, 0xc0, 0x89, 0xb1, 0x3b, 0x02, 0x3d, 0xa3, 0x8e, 0xaa, 0xb0, 0xf1, 0x0c,
+	0x0f, 0x9e, 0xbe, 0x1c, 0xf4, 0x1b, 0x39, 0x04, 0x50, 0xee, 0xbd, 0x18, 0xf5, 0x07, 0xfd, 0x86,
+	0x86, 0xea, 0xa0, 0x1f, 0x8d, 0xa4, 0x75, 0x30, 0xfa, 0xa2, 0x91, 0x47, 0x35, 0xa8, 0x24, 0xe6,
+	0xa0, 0xdf, 0x28, 0xc8, 0x2c, 0x3c, 0x38, 0x7c, 0x71, 0x3c, 0xe8, 0x37, 0x8a, 0xbd, 0x2f, 0x5f,
+	0x5f, 0x36, 0xb5, 0x37, 0x97, 0x4d, 0xed, 0xb7, 0xcb, 0xa6, 0xf6, 0xea, 0xaa, 0x99, 0x7b, 0x73,
+	0xd5, 0xcc, 0xfd, 0x7c, 0xd5, 0xcc, 0x7d, 0xf3, 0xb1, 0xe7, 0x8b, 0xf1, 0xd4, 0x36, 0x1d, 0x16,
+	0x76, 0xd3, 0xfe, 0x9c, 0x31, 0xf1, 0xa3, 0xb9, 0xd1, 0x3d, 0x5f, 0xfc, 0x4f, 0x13, 0x17, 0x13,
+	0xca, 0xed, 0xb2, 0x02, 0xfe, 0xc9, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x1a, 0xf5, 0x21,
+	0xc8, 0x09, 0x00, 0x00,
 }
 
 func (m *Epoch) Marshal() (dAtA []byte, err error) {
@@ -792,6 +986,33 @@ func (m *QueuedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Escrowed {
+		i--
+		if m.Escrowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Fee != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintEpoching(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Msg != nil {
 		{
 			size := m.Msg.Size()
@@ -938,6 +1159,104 @@ func (m *QueuedMessage_MsgCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA [
 	}
 	return len(dAtA) - i, nil
 }
+func (m *QueuedMessageResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedMessageResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedMessageResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Height != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEpoching(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintEpoching(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Code != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintEpoching(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEpoching(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgId) > 0 {
+		i -= len(m.MsgId)
+		copy(dAtA[i:], m.MsgId)
+		i = encodeVarintEpoching(dAtA, i, uint64(len(m.MsgId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintEpoching(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValStateUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1231,6 +1550,19 @@ func (m *QueuedMessage) Size() (n int) {
 	if m.Msg != nil {
 		n += m.Msg.Size()
 	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovEpoching(uint64(l))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovEpoching(uint64(m.Fee))
+	}
+	if m.Escrowed {
+		n += 2
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovEpoching(uint64(m.MsgIndex))
+	}
 	return n
 }
 
@@ -1294,6 +1626,52 @@ func (m *QueuedMessage_MsgCancelUnbondingDelegation) Size() (n int) {
 	}
 	return n
 }
+func (m *QueuedMessageResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEpoching(uint64(m.EpochNumber))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovEpoching(uint64(l))
+	}
+	l = len(m.MsgId)
+	if l > 0 {
+		n += 1 + l + sovEpoching(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEpoching(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovEpoching(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovEpoching(uint64(m.Code))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovEpoching(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovEpoching(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovEpoching(uint64(m.Height))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovEpoching(uint64(m.MsgIndex))
+	}
+	return n
+}
+
 func (m *ValStateUpdate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1938,6 +2316,440 @@ func (m *QueuedMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Msg = &QueuedMessage_MsgCancelUnbondingDelegation{v}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Escrowed = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoching(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedMessageResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoching
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedMessageResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedMessageResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgId = append(m.MsgId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MsgId == nil {
+				m.MsgId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEpoching(dAtA[iNdEx:])
@@ -23,4 +23,7 @@ var (
 	ErrInvalidEpoch              = errorsmod.Register(ModuleName, 12, "the epoch is invalid")
 	ErrInvalidHeight             = errorsmod.Register(ModuleName, 13, "the height is invalid")
 	ErrInsufficientBalance       = errorsmod.Register(ModuleName, 14, "the delegator has insufficient balance to perform delegate")
+	ErrQueueFull                 = errorsmod.Register(ModuleName, 15, "the message queue of the epoch is full and the fee is not higher than the lowest queued fee")
+	ErrAccountQueueFull          = errorsmod.Register(ModuleName, 16, "the account has reached the maximum number of queued messages in the epoch")
+	ErrQueuedMsgEvicted          = errorsmod.Register(ModuleName, 17, "the queued message has been evicted by a message with a higher fee")
 )
@@ -4,8 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cometbft_cometbft_abci_types "github.com/cometbft/cometbft/abci/types"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -583,294 +585,538 @@ func (m *EventWrappedCancelUnbondingDelegation) GetEpochBoundary() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*EventBeginEpoch)(nil), "babylon.epoching.v1.EventBeginEpoch")
-	proto.RegisterType((*EventEndEpoch)(nil), "babylon.epoching.v1.EventEndEpoch")
-	proto.RegisterType((*EventHandleQueuedMsg)(nil), "babylon.epoching.v1.EventHandleQueuedMsg")
-	proto.RegisterType((*EventSlashThreshold)(nil), "babylon.epoching.v1.EventSlashThreshold")
-	proto.RegisterType((*EventWrappedDelegate)(nil), "babylon.epoching.v1.EventWrappedDelegate")
-	proto.RegisterType((*EventWrappedUndelegate)(nil), "babylon.epoching.v1.EventWrappedUndelegate")
-	proto.RegisterType((*EventWrappedBeginRedelegate)(nil), "babylon.epoching.v1.EventWrappedBeginRedelegate")
-	proto.RegisterType((*EventWrappedCancelUnbondingDelegation)(nil), "babylon.epoching.v1.EventWrappedCancelUnbondingDelegation")
+// EventQueuedMsgSucceeded is the event emitted when a queued message has been
+// executed successfully at the end of its epoch
+type EventQueuedMsgSucceeded struct {
+	EpochNumber      uint64                      `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	TxId             []byte                      `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	MsgId            []byte                      `protobuf:"bytes,3,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	DelegatorAddress string                      `protobuf:"bytes,4,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Shares           cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+	MsgIndex         uint64                      `protobuf:"varint,6,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
 }
 
-func init() { proto.RegisterFile("babylon/epoching/v1/events.proto", fileDescriptor_2f0a2c43c7aaeb43) }
+func (m *EventQueuedMsgSucceeded) Reset()         { *m = EventQueuedMsgSucceeded{} }
+func (m *EventQueuedMsgSucceeded) String() string { return proto.CompactTextString(m) }
+func (*EventQueuedMsgSucceeded) ProtoMessage()    {}
+func (*EventQueuedMsgSucceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f0a2c43c7aaeb43, []int{8}
+}
+func (m *EventQueuedMsgSucceeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventQueuedMsgSucceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventQueuedMsgSucceeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventQueuedMsgSucceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQueuedMsgSucceeded.Merge(m, src)
+}
+func (m *EventQueuedMsgSucceeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventQueuedMsgSucceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQueuedMsgSucceeded.DiscardUnknown(m)
+}
 
-var fileDescriptor_2f0a2c43c7aaeb43 = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcd, 0x6e, 0xd4, 0x3c,
-	0x14, 0x6d, 0xe6, 0xef, 0x53, 0xfd, 0xf5, 0xd7, 0x33, 0x8c, 0x22, 0x2a, 0x86, 0x61, 0xa4, 0x8a,
-	0x4a, 0xc0, 0xa4, 0x05, 0x84, 0x10, 0xbb, 0x0e, 0x54, 0x6a, 0x91, 0x40, 0x10, 0xda, 0x22, 0xb1,
-	0x89, 0x9c, 0xd8, 0x24, 0x16, 0x89, 0x1d, 0xd9, 0xce, 0xd0, 0x79, 0x0b, 0x5e, 0x80, 0x1d, 0x0f,
-	0xc0, 0x4b, 0x20, 0x58, 0x76, 0x89, 0x58, 0x20, 0xd4, 0xae, 0x78, 0x0b, 0x14, 0xe7, 0xa7, 0x81,
-	0x4e, 0x51, 0xc5, 0x06, 0xb1, 0xf3, 0x3d, 0xe7, 0xdc, 0xeb, 0x9c, 0x7b, 0x1d, 0x1b, 0xf4, 0x5d,
-	0xe4, 0x4e, 0x42, 0xce, 0x2c, 0x12, 0x73, 0x2f, 0xa0, 0xcc, 0xb7, 0xc6, 0x1b, 0x16, 0x19, 0x13,
-	0xa6, 0xe4, 0x30, 0x16, 0x5c, 0x71, 0xd8, 0xce, 0x15, 0xc3, 0x42, 0x31, 0x1c, 0x6f, 0x5c, 0xec,
-	0xf8, 0xdc, 0xe7, 0x9a, 0xb7, 0xd2, 0x55, 0x26, 0x1d, 0xdc, 0x06, 0x8b, 0x5b, 0x69, 0xea, 0x88,
-	0xf8, 0x94, 0x6d, 0xa5, 0x72, 0x78, 0x05, 0xcc, 0xe9, 0x3c, 0x87, 0x25, 0x91, 0x4b, 0x84, 0x69,
-	0xf4, 0x8d, 0xb5, 0x86, 0xfd, 0xbf, 0xc6, 0x1e, 0x6b, 0x68, 0x70, 0x13, 0xcc, 0xeb, 0xac, 0x2d,
-	0x86, 0xcf, 0x9d, 0xf3, 0xbe, 0x06, 0x3a, 0x3a, 0x69, 0x1b, 0x31, 0x1c, 0x92, 0xa7, 0x09, 0x49,
-	0x08, 0x7e, 0x24, 0x7d, 0x38, 0x04, 0x6d, 0x2e, 0xa8, 0x4f, 0x19, 0x0a, 0x1d, 0x6d, 0xc3, 0x51,
-	0x93, 0x98, 0xe8, 0x12, 0xb3, 0xf6, 0x72, 0x41, 0xe9, 0xd4, 0xdd, 0x49, 0x4c, 0x4e, 0xed, 0x55,
-	0x3b, 0xb5, 0x17, 0xec, 0x82, 0x56, 0x40, 0xa8, 0x1f, 0x28, 0xb3, 0xae, 0xc9, 0x3c, 0x82, 0x6d,
-	0xd0, 0x54, 0x07, 0x0e, 0xc5, 0x66, 0xa3, 0x6f, 0xac, 0xcd, 0xd9, 0x0d, 0x75, 0xb0, 0x83, 0xe1,
-	0x05, 0xd0, 0x8a, 0xa4, 0x9f, 0xa2, 0x4d, 0x8d, 0x36, 0x23, 0xe9, 0xef, 0x60, 0xf8, 0xaa, 0xf2,
-	0x59, 0x48, 0x29, 0x41, 0xdd, 0x44, 0x11, 0x69, 0xb6, 0xfa, 0xf5, 0xb5, 0xb9, 0xd1, 0xbd, 0x2f,
-	0x5f, 0x2f, 0xdf, 0xf1, 0xa9, 0x0a, 0x12, 0x77, 0xe8, 0xf1, 0xc8, 0xf2, 0x78, 0x44, 0x94, 0xfb,
-	0x52, 0x9d, 0x2c, 0x90, 0xeb, 0x51, 0x2b, 0x35, 0x22, 0x87, 0xfa, 0xd3, 0x37, 0x8b, 0x12, 0x36,
-	0x2c, 0xca, 0x96, 0x90, 0x84, 0x1d, 0xd0, 0x24, 0x42, 0x70, 0x61, 0xfe, 0xa7, 0x5d, 0x67, 0xc1,
-	0xe0, 0x9d, 0x01, 0xda, 0x3a, 0xf9, 0x59, 0x88, 0x64, 0xb0, 0x1b, 0x08, 0x22, 0x03, 0x1e, 0x62,
-	0xb8, 0x0e, 0x3a, 0x32, 0x45, 0x08, 0x76, 0xc6, 0x5c, 0x51, 0xe6, 0x3b, 0x31, 0x7f, 0x9d, 0x77,
-	0xbd, 0x6e, 0xc3, 0x9c, 0xdb, 0xd7, 0xd4, 0x93, 0x94, 0x81, 0xd7, 0x01, 0x54, 0x5c, 0xa1, 0xf0,
-	0x67, 0x7d, 0x4d, 0xeb, 0x97, 0x34, 0x53, 0x55, 0xdf, 0x00, 0xb0, 0xac, 0x8f, 0x42, 0x8a, 0x91,
-	0xe2, 0x42, 0x9a, 0xf5, 0xd4, 0xb9, 0xbd, 0x5c, 0x54, 0x2f, 0x89, 0xc1, 0x07, 0x23, 0x9f, 0xec,
-	0x73, 0x81, 0xe2, 0x98, 0xe0, 0x07, 0x24, 0x24, 0x3e, 0x52, 0x04, 0x5e, 0x03, 0xcb, 0x38, 0x5b,
-	0x73, 0xe1, 0x20, 0x8c, 0x05, 0x91, 0x32, 0x9f, 0xeb, 0x52, 0x49, 0x6c, 0x66, 0x78, 0x2a, 0x2e,
-	0x37, 0x2b, 0xc5, 0xb5, 0x4c, 0x5c, 0x12, 0x85, 0xb8, 0x0b, 0x5a, 0x28, 0xe2, 0x09, 0x2b, 0x07,
-	0x9c, 0x45, 0x69, 0x1f, 0x31, 0x61, 0x3c, 0xd2, 0x03, 0x9e, 0xb5, 0xb3, 0x00, 0xae, 0x82, 0x85,
-	0xec, 0xc4, 0xb8, 0x3c, 0x61, 0x18, 0x89, 0x89, 0x9e, 0x74, 0xc3, 0x9e, 0xd7, 0xe8, 0x28, 0x07,
-	0x07, 0x1f, 0x0d, 0xd0, 0xad, 0xfa, 0xd8, 0x63, 0xf8, 0x1f, 0x75, 0xf2, 0xb6, 0x06, 0x56, 0xaa,
-	0x4e, 0xf4, 0xdf, 0x6d, 0x93, 0x3f, 0xb3, 0x73, 0x17, 0x98, 0x92, 0x27, 0xc2, 0x23, 0xce, 0x59,
-	0xae, 0xba, 0x19, 0xbf, 0xff, 0xab, 0xb7, 0x11, 0xb8, 0x84, 0x89, 0x54, 0x94, 0x21, 0x45, 0x39,
-	0x9b, 0x92, 0x5e, 0xd7, 0xe9, 0x2b, 0x15, 0xd1, 0xfe, 0xd9, 0xfd, 0x69, 0x4c, 0xef, 0x4f, 0xf3,
-	0xf7, 0xfd, 0x69, 0x4d, 0xeb, 0xcf, 0x77, 0x03, 0xac, 0x56, 0xfb, 0x73, 0x1f, 0x31, 0x8f, 0x84,
-	0x7b, 0xcc, 0xe5, 0x0c, 0x53, 0xe6, 0xe7, 0x07, 0x98, 0x72, 0xf6, 0x17, 0x06, 0x7f, 0x15, 0x2c,
-	0x7a, 0x82, 0x64, 0x1d, 0xcb, 0x2f, 0xb1, 0x86, 0xfe, 0x4f, 0x17, 0x0a, 0x78, 0x3b, 0xbb, 0xcc,
-	0xce, 0x77, 0x16, 0x46, 0x0f, 0x3f, 0x1d, 0xf5, 0x8c, 0xc3, 0xa3, 0x9e, 0xf1, 0xed, 0xa8, 0x67,
-	0xbc, 0x39, 0xee, 0xcd, 0x1c, 0x1e, 0xf7, 0x66, 0x3e, 0x1f, 0xf7, 0x66, 0x5e, 0xac, 0x57, 0x2e,
-	0xb0, 0xfc, 0xc5, 0xf0, 0x02, 0x44, 0x59, 0x11, 0x58, 0x07, 0x27, 0x4f, 0x8c, 0xbe, 0xc9, 0xdc,
-	0x96, 0x7e, 0x34, 0x6e, 0xfd, 0x08, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x83, 0x9b, 0xc9, 0x83, 0x06,
-	0x00, 0x00,
+var xxx_messageInfo_EventQueuedMsgSucceeded proto.InternalMessageInfo
+
+func (m *EventQueuedMsgSucceeded) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
 }
 
-func (m *EventBeginEpoch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventQueuedMsgSucceeded) GetTxId() []byte {
+	if m != nil {
+		return m.TxId
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventBeginEpoch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventQueuedMsgSucceeded) GetMsgId() []byte {
+	if m != nil {
+		return m.MsgId
+	}
+	return nil
 }
 
-func (m *EventBeginEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+func (m *EventQueuedMsgSucceeded) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventEndEpoch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventQueuedMsgSucceeded) GetMsgIndex() uint64 {
+	if m != nil {
+		return m.MsgIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventEndEpoch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventQueuedMsgFailed is the event emitted when the execution of a queued
+// message has failed at the end of its epoch
+type EventQueuedMsgFailed struct {
+	EpochNumber      uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	TxId             []byte `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	MsgId            []byte `protobuf:"bytes,3,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	DelegatorAddress string `protobuf:"bytes,4,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Codespace        string `protobuf:"bytes,5,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code             uint32 `protobuf:"varint,6,opt,name=code,proto3" json:"code,omitempty"`
+	Log              string `protobuf:"bytes,7,opt,name=log,proto3" json:"log,omitempty"`
+	MsgIndex         uint64 `protobuf:"varint,8,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
 }
 
-func (m *EventEndEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+func (m *EventQueuedMsgFailed) Reset()         { *m = EventQueuedMsgFailed{} }
+func (m *EventQueuedMsgFailed) String() string { return proto.CompactTextString(m) }
+func (*EventQueuedMsgFailed) ProtoMessage()    {}
+func (*EventQueuedMsgFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f0a2c43c7aaeb43, []int{9}
+}
+func (m *EventQueuedMsgFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventQueuedMsgFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventQueuedMsgFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventQueuedMsgFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQueuedMsgFailed.Merge(m, src)
+}
+func (m *EventQueuedMsgFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventQueuedMsgFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQueuedMsgFailed.DiscardUnknown(m)
 }
 
-func (m *EventHandleQueuedMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventQueuedMsgFailed proto.InternalMessageInfo
+
+func (m *EventQueuedMsgFailed) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventHandleQueuedMsg) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventQueuedMsgFailed) GetTxId() []byte {
+	if m != nil {
+		return m.TxId
+	}
+	return nil
 }
 
-func (m *EventHandleQueuedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.OriginalAttributes) > 0 {
-		for iNdEx := len(m.OriginalAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.OriginalAttributes[iNdEx].Size()
-				i -= size
-				if _, err := m.OriginalAttributes[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.MsgId) > 0 {
-		i -= len(m.MsgId)
-		copy(dAtA[i:], m.MsgId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgId)))
-		i--
-		dAtA[i] = 0x2a
+func (m *EventQueuedMsgFailed) GetMsgId() []byte {
+	if m != nil {
+		return m.MsgId
 	}
-	if len(m.TxId) > 0 {
-		i -= len(m.TxId)
-		copy(dAtA[i:], m.TxId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TxId)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *EventQueuedMsgFailed) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	if m.Height != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *EventQueuedMsgFailed) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
 	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *EventQueuedMsgFailed) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	if len(m.OriginalEventType) > 0 {
-		i -= len(m.OriginalEventType)
-		copy(dAtA[i:], m.OriginalEventType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OriginalEventType)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventQueuedMsgFailed) GetLog() string {
+	if m != nil {
+		return m.Log
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSlashThreshold) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventQueuedMsgFailed) GetMsgIndex() uint64 {
+	if m != nil {
+		return m.MsgIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventSlashThreshold) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventQueuedMsgEvicted is the event emitted when a queued message has been
+// evicted from the full queue of its epoch by a message with a higher fee
+type EventQueuedMsgEvicted struct {
+	EpochNumber      uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	TxId             []byte `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	MsgId            []byte `protobuf:"bytes,3,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	DelegatorAddress string `protobuf:"bytes,4,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Fee              uint64 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	// evicted_by_fee is the fee of the message taking the place of the evicted
+	// message
+	EvictedByFee uint64 `protobuf:"varint,6,opt,name=evicted_by_fee,json=evictedByFee,proto3" json:"evicted_by_fee,omitempty"`
+	MsgIndex     uint64 `protobuf:"varint,7,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
 }
 
-func (m *EventSlashThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SlashedValidators) > 0 {
-		for iNdEx := len(m.SlashedValidators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SlashedValidators[iNdEx])
-			copy(dAtA[i:], m.SlashedValidators[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.SlashedValidators[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *EventQueuedMsgEvicted) Reset()         { *m = EventQueuedMsgEvicted{} }
+func (m *EventQueuedMsgEvicted) String() string { return proto.CompactTextString(m) }
+func (*EventQueuedMsgEvicted) ProtoMessage()    {}
+func (*EventQueuedMsgEvicted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f0a2c43c7aaeb43, []int{10}
+}
+func (m *EventQueuedMsgEvicted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventQueuedMsgEvicted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventQueuedMsgEvicted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TotalVotingPower != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TotalVotingPower))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SlashedVotingPower != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SlashedVotingPower))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventQueuedMsgEvicted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQueuedMsgEvicted.Merge(m, src)
+}
+func (m *EventQueuedMsgEvicted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventQueuedMsgEvicted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQueuedMsgEvicted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventQueuedMsgEvicted proto.InternalMessageInfo
+
+func (m *EventQueuedMsgEvicted) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventWrappedDelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventQueuedMsgEvicted) GetTxId() []byte {
+	if m != nil {
+		return m.TxId
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventWrappedDelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventQueuedMsgEvicted) GetMsgId() []byte {
+	if m != nil {
+		return m.MsgId
+	}
+	return nil
 }
 
-func (m *EventWrappedDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochBoundary != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.EpochBoundary))
-		i--
-		dAtA[i] = 0x28
+func (m *EventQueuedMsgEvicted) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *EventQueuedMsgEvicted) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
 	}
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *EventQueuedMsgEvicted) GetEvictedByFee() uint64 {
+	if m != nil {
+		return m.EvictedByFee
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EventQueuedMsgEvicted) GetMsgIndex() uint64 {
+	if m != nil {
+		return m.MsgIndex
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+// EventQueuedMsgRejected is the event emitted when a message cannot be queued,
+// as either the queue of the epoch is full and the fee of the message is not
+// higher than the lowest fee in the queue, or its account has reached the
+// maximum number of queued messages in the epoch
+type EventQueuedMsgRejected struct {
+	EpochNumber      uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	TxId             []byte `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	MsgId            []byte `protobuf:"bytes,3,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	DelegatorAddress string `protobuf:"bytes,4,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Fee              uint64 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Reason           string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	MsgIndex         uint64 `protobuf:"varint,7,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+}
+
+func (m *EventQueuedMsgRejected) Reset()         { *m = EventQueuedMsgRejected{} }
+func (m *EventQueuedMsgRejected) String() string { return proto.CompactTextString(m) }
+func (*EventQueuedMsgRejected) ProtoMessage()    {}
+func (*EventQueuedMsgRejected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f0a2c43c7aaeb43, []int{11}
+}
+func (m *EventQueuedMsgRejected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventQueuedMsgRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventQueuedMsgRejected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventQueuedMsgRejected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQueuedMsgRejected.Merge(m, src)
+}
+func (m *EventQueuedMsgRejected) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventQueuedMsgRejected) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQueuedMsgRejected.DiscardUnknown(m)
 }
 
-func (m *EventWrappedUndelegate) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_EventQueuedMsgRejected proto.InternalMessageInfo
+
+func (m *EventQueuedMsgRejected) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EventQueuedMsgRejected) GetTxId() []byte {
+	if m != nil {
+		return m.TxId
+	}
+	return nil
+}
+
+func (m *EventQueuedMsgRejected) GetMsgId() []byte {
+	if m != nil {
+		return m.MsgId
+	}
+	return nil
+}
+
+func (m *EventQueuedMsgRejected) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *EventQueuedMsgRejected) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *EventQueuedMsgRejected) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventQueuedMsgRejected) GetMsgIndex() uint64 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+// EventEpochIntervalScheduled is the event emitted when the epoch interval has
+// been updated, which takes effect from the next epoch on
+type EventEpochIntervalScheduled struct {
+	// epoch_number is the number of the first epoch with the new epoch interval
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// first_block_height is the height of the first block of that epoch
+	FirstBlockHeight uint64 `protobuf:"varint,2,opt,name=first_block_height,json=firstBlockHeight,proto3" json:"first_block_height,omitempty"`
+	// current_epoch_interval is the epoch interval of the current epoch
+	CurrentEpochInterval uint64 `protobuf:"varint,3,opt,name=current_epoch_interval,json=currentEpochInterval,proto3" json:"current_epoch_interval,omitempty"`
+	// epoch_interval is the new epoch interval
+	EpochInterval uint64 `protobuf:"varint,4,opt,name=epoch_interval,json=epochInterval,proto3" json:"epoch_interval,omitempty"`
+}
+
+func (m *EventEpochIntervalScheduled) Reset()         { *m = EventEpochIntervalScheduled{} }
+func (m *EventEpochIntervalScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventEpochIntervalScheduled) ProtoMessage()    {}
+func (*EventEpochIntervalScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f0a2c43c7aaeb43, []int{12}
+}
+func (m *EventEpochIntervalScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochIntervalScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochIntervalScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochIntervalScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochIntervalScheduled.Merge(m, src)
+}
+func (m *EventEpochIntervalScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochIntervalScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochIntervalScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochIntervalScheduled proto.InternalMessageInfo
+
+func (m *EventEpochIntervalScheduled) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EventEpochIntervalScheduled) GetFirstBlockHeight() uint64 {
+	if m != nil {
+		return m.FirstBlockHeight
+	}
+	return 0
+}
+
+func (m *EventEpochIntervalScheduled) GetCurrentEpochInterval() uint64 {
+	if m != nil {
+		return m.CurrentEpochInterval
+	}
+	return 0
+}
+
+func (m *EventEpochIntervalScheduled) GetEpochInterval() uint64 {
+	if m != nil {
+		return m.EpochInterval
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventBeginEpoch)(nil), "babylon.epoching.v1.EventBeginEpoch")
+	proto.RegisterType((*EventEndEpoch)(nil), "babylon.epoching.v1.EventEndEpoch")
+	proto.RegisterType((*EventHandleQueuedMsg)(nil), "babylon.epoching.v1.EventHandleQueuedMsg")
+	proto.RegisterType((*EventSlashThreshold)(nil), "babylon.epoching.v1.EventSlashThreshold")
+	proto.RegisterType((*EventWrappedDelegate)(nil), "babylon.epoching.v1.EventWrappedDelegate")
+	proto.RegisterType((*EventWrappedUndelegate)(nil), "babylon.epoching.v1.EventWrappedUndelegate")
+	proto.RegisterType((*EventWrappedBeginRedelegate)(nil), "babylon.epoching.v1.EventWrappedBeginRedelegate")
+	proto.RegisterType((*EventWrappedCancelUnbondingDelegation)(nil), "babylon.epoching.v1.EventWrappedCancelUnbondingDelegation")
+	proto.RegisterType((*EventQueuedMsgSucceeded)(nil), "babylon.epoching.v1.EventQueuedMsgSucceeded")
+	proto.RegisterType((*EventQueuedMsgFailed)(nil), "babylon.epoching.v1.EventQueuedMsgFailed")
+	proto.RegisterType((*EventQueuedMsgEvicted)(nil), "babylon.epoching.v1.EventQueuedMsgEvicted")
+	proto.RegisterType((*EventQueuedMsgRejected)(nil), "babylon.epoching.v1.EventQueuedMsgRejected")
+	proto.RegisterType((*EventEpochIntervalScheduled)(nil), "babylon.epoching.v1.EventEpochIntervalScheduled")
+}
+
+func init() { proto.RegisterFile("babylon/epoching/v1/events.proto", fileDescriptor_2f0a2c43c7aaeb43) }
+
+var fileDescriptor_2f0a2c43c7aaeb43 = []byte{
+	// 991 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x41, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x8e, 0xdb, 0xcc, 0x3f, 0x49, 0x93, 0x49, 0xea, 0xbf, 0x69, 0xc0, 0x09, 0x16,
+	0x15, 0x91, 0x68, 0xed, 0x06, 0x2a, 0x84, 0xb8, 0xd5, 0x24, 0x55, 0x83, 0x00, 0xc1, 0xa6, 0x0d,
+	0x12, 0x97, 0xd5, 0xec, 0xce, 0xcb, 0xee, 0x90, 0xf5, 0x8c, 0x35, 0x33, 0x6b, 0xe2, 0x6f, 0xc1,
+	0x17, 0xe0, 0xc6, 0x07, 0xe0, 0xc0, 0x57, 0x40, 0x54, 0xe2, 0x52, 0x71, 0x42, 0x45, 0x8a, 0x50,
+	0x72, 0x81, 0x33, 0x67, 0x24, 0xb4, 0x33, 0xb3, 0x1b, 0x3b, 0x75, 0xaa, 0x88, 0x4b, 0xe0, 0x36,
+	0xef, 0xfd, 0xde, 0xbc, 0x99, 0xdf, 0x7b, 0xb3, 0xbf, 0x7d, 0x68, 0x23, 0x24, 0xe1, 0x28, 0x15,
+	0xbc, 0x0b, 0x03, 0x11, 0x25, 0x8c, 0xc7, 0xdd, 0xe1, 0x56, 0x17, 0x86, 0xc0, 0xb5, 0xea, 0x0c,
+	0xa4, 0xd0, 0x02, 0xaf, 0xb8, 0x88, 0x4e, 0x11, 0xd1, 0x19, 0x6e, 0xdd, 0x5a, 0x8d, 0x45, 0x2c,
+	0x0c, 0xde, 0xcd, 0x57, 0x36, 0xf4, 0xd6, 0x2b, 0x91, 0x50, 0x7d, 0xa1, 0x02, 0x0b, 0x58, 0xc3,
+	0x42, 0xed, 0xfb, 0xe8, 0xc6, 0x4e, 0x9e, 0xb5, 0x07, 0x31, 0xe3, 0x3b, 0x79, 0x26, 0xfc, 0x3a,
+	0x9a, 0x37, 0x29, 0x03, 0x9e, 0xf5, 0x43, 0x90, 0x4d, 0x6f, 0xc3, 0xdb, 0xac, 0xf9, 0xff, 0x33,
+	0xbe, 0x4f, 0x8c, 0xab, 0xfd, 0x36, 0x5a, 0x30, 0xbb, 0x76, 0x38, 0xbd, 0xf4, 0x9e, 0xef, 0x2a,
+	0x68, 0xd5, 0x6c, 0x7a, 0x44, 0x38, 0x4d, 0xe1, 0xb3, 0x0c, 0x32, 0xa0, 0x1f, 0xab, 0x18, 0x77,
+	0xd0, 0x8a, 0x90, 0x2c, 0x66, 0x9c, 0xa4, 0x81, 0x61, 0x18, 0xe8, 0xd1, 0x00, 0x4c, 0x8a, 0x39,
+	0x7f, 0xb9, 0x80, 0xcc, 0xd6, 0xc7, 0xa3, 0x01, 0xbc, 0x70, 0x56, 0xe5, 0x85, 0xb3, 0x70, 0x03,
+	0xd5, 0x13, 0x60, 0x71, 0xa2, 0x9b, 0x55, 0x03, 0x3a, 0x0b, 0xaf, 0xa0, 0x59, 0x7d, 0x14, 0x30,
+	0xda, 0xac, 0x6d, 0x78, 0x9b, 0xf3, 0x7e, 0x4d, 0x1f, 0xed, 0x52, 0x7c, 0x13, 0xd5, 0xfb, 0x2a,
+	0xce, 0xbd, 0xb3, 0xc6, 0x3b, 0xdb, 0x57, 0xf1, 0x2e, 0xc5, 0x87, 0x63, 0xd7, 0x22, 0x5a, 0x4b,
+	0x16, 0x66, 0x1a, 0x54, 0xb3, 0xbe, 0x51, 0xdd, 0x9c, 0xef, 0xbd, 0xff, 0xfc, 0x78, 0xfd, 0xdd,
+	0x98, 0xe9, 0x24, 0x0b, 0x3b, 0x91, 0xe8, 0x77, 0x23, 0xd1, 0x07, 0x1d, 0x1e, 0xe8, 0xb3, 0x05,
+	0x09, 0x23, 0xd6, 0xcd, 0x89, 0xa8, 0x8e, 0xb9, 0xfa, 0x83, 0x22, 0x85, 0x8f, 0x8b, 0xb4, 0xa5,
+	0x4b, 0xe1, 0x55, 0x34, 0x0b, 0x52, 0x0a, 0xd9, 0xbc, 0x66, 0x58, 0x5b, 0xa3, 0xfd, 0xad, 0x87,
+	0x56, 0xcc, 0xe6, 0xbd, 0x94, 0xa8, 0xe4, 0x71, 0x22, 0x41, 0x25, 0x22, 0xa5, 0xf8, 0x1e, 0x5a,
+	0x55, 0xb9, 0x07, 0x68, 0x30, 0x14, 0x9a, 0xf1, 0x38, 0x18, 0x88, 0xaf, 0x5c, 0xd5, 0xab, 0x3e,
+	0x76, 0xd8, 0xbe, 0x81, 0x3e, 0xcd, 0x11, 0x7c, 0x07, 0x61, 0x2d, 0x34, 0x49, 0x27, 0xe3, 0x2b,
+	0x26, 0x7e, 0xc9, 0x20, 0xe3, 0xd1, 0x77, 0x11, 0x2e, 0xf3, 0x93, 0x94, 0x51, 0xa2, 0x85, 0x54,
+	0xcd, 0x6a, 0xce, 0xdc, 0x5f, 0x2e, 0xb2, 0x97, 0x40, 0xfb, 0x07, 0xcf, 0x75, 0xf6, 0x73, 0x49,
+	0x06, 0x03, 0xa0, 0xdb, 0x90, 0x42, 0x4c, 0x34, 0xe0, 0xb7, 0xd0, 0x32, 0xb5, 0x6b, 0x21, 0x03,
+	0x42, 0xa9, 0x04, 0xa5, 0x5c, 0x5f, 0x97, 0x4a, 0xe0, 0x81, 0xf5, 0xe7, 0xc1, 0xe5, 0x61, 0x65,
+	0x70, 0xc5, 0x06, 0x97, 0x40, 0x11, 0xdc, 0x40, 0x75, 0xd2, 0x17, 0x19, 0x2f, 0x1b, 0x6c, 0xad,
+	0xbc, 0x8e, 0x14, 0xb8, 0xe8, 0x9b, 0x06, 0xcf, 0xf9, 0xd6, 0xc0, 0xb7, 0xd1, 0xa2, 0x7d, 0x31,
+	0xa1, 0xc8, 0x38, 0x25, 0x72, 0x64, 0x3a, 0x5d, 0xf3, 0x17, 0x8c, 0xb7, 0xe7, 0x9c, 0xed, 0x1f,
+	0x3d, 0xd4, 0x18, 0xe7, 0xf1, 0x84, 0xd3, 0xff, 0x28, 0x93, 0x6f, 0x2a, 0x68, 0x6d, 0x9c, 0x89,
+	0xf9, 0xba, 0x7d, 0xf8, 0x67, 0x74, 0xde, 0x43, 0x4d, 0x25, 0x32, 0x19, 0x41, 0x70, 0x11, 0xab,
+	0x86, 0xc5, 0xf7, 0xcf, 0x73, 0xeb, 0xa1, 0xd7, 0x28, 0x28, 0xcd, 0x38, 0xd1, 0x4c, 0xf0, 0x29,
+	0xdb, 0xab, 0x66, 0xfb, 0xda, 0x58, 0xd0, 0xfe, 0xc5, 0xf5, 0xa9, 0x4d, 0xaf, 0xcf, 0xec, 0xcb,
+	0xeb, 0x53, 0x9f, 0x56, 0x9f, 0x3f, 0x3c, 0x74, 0x7b, 0xbc, 0x3e, 0x1f, 0x10, 0x1e, 0x41, 0xfa,
+	0x84, 0x87, 0x82, 0x53, 0xc6, 0x63, 0xf7, 0x80, 0x99, 0xe0, 0x57, 0xd0, 0xf8, 0x37, 0xd1, 0x8d,
+	0x48, 0x82, 0xad, 0x98, 0x13, 0xb1, 0x9a, 0xf9, 0x4e, 0x17, 0x0b, 0xf7, 0x23, 0x2b, 0x66, 0x97,
+	0x7c, 0x0b, 0x7f, 0x79, 0xe8, 0xff, 0x86, 0x6b, 0xa9, 0xb8, 0x7b, 0x59, 0x14, 0x01, 0x50, 0xa0,
+	0x97, 0x90, 0xed, 0x33, 0xc9, 0xac, 0x4c, 0x95, 0xcc, 0xea, 0xb8, 0x64, 0x4e, 0x2d, 0x56, 0xed,
+	0x82, 0x62, 0xed, 0xa2, 0xba, 0x4a, 0x88, 0x04, 0x65, 0x3b, 0xd8, 0xdb, 0x7a, 0x7a, 0xbc, 0x3e,
+	0xf3, 0xfc, 0x78, 0x7d, 0xcd, 0xfe, 0x9f, 0x14, 0x3d, 0xec, 0x30, 0xd1, 0xed, 0x13, 0x9d, 0x74,
+	0x3e, 0x82, 0x98, 0x44, 0xa3, 0x6d, 0x88, 0x7e, 0xfe, 0xfe, 0x2e, 0x72, 0xbf, 0xaf, 0x6d, 0x88,
+	0x7c, 0x97, 0x00, 0xaf, 0xa1, 0x39, 0x73, 0x1d, 0x4e, 0xe1, 0xc8, 0x35, 0xfc, 0x7a, 0x7e, 0xa3,
+	0xdc, 0x6e, 0xff, 0x59, 0xa8, 0x53, 0xc9, 0xff, 0x21, 0x61, 0xe9, 0x15, 0x93, 0x7f, 0x15, 0xcd,
+	0x45, 0x82, 0x82, 0x1a, 0x90, 0x08, 0xdc, 0x0b, 0x3e, 0x73, 0x60, 0x8c, 0x6a, 0xb9, 0x61, 0xa8,
+	0x2c, 0xf8, 0x66, 0x8d, 0x97, 0x50, 0x35, 0x15, 0xb1, 0xfb, 0x3f, 0xe4, 0xcb, 0x49, 0xd6, 0xd7,
+	0xcf, 0xb1, 0xfe, 0xdd, 0x43, 0x37, 0x27, 0x59, 0xef, 0x0c, 0x59, 0xa4, 0xaf, 0x98, 0xf6, 0x12,
+	0xaa, 0x1e, 0x00, 0xb8, 0x77, 0x9a, 0x2f, 0xf1, 0x1b, 0x68, 0x11, 0xec, 0xc5, 0x82, 0x70, 0x14,
+	0xe4, 0xa0, 0xed, 0xdf, 0xbc, 0xf3, 0xf6, 0x46, 0x0f, 0x01, 0x26, 0xa9, 0x5e, 0x3b, 0x47, 0xf5,
+	0xd7, 0x42, 0xb6, 0x4b, 0xaa, 0x3e, 0x7c, 0x09, 0xff, 0x42, 0xae, 0x0d, 0x54, 0x97, 0x40, 0x94,
+	0xe0, 0x86, 0xe3, 0x9c, 0xef, 0xac, 0x97, 0xb3, 0xfb, 0xc9, 0x73, 0x52, 0x6e, 0x06, 0xad, 0x5d,
+	0xae, 0x41, 0x0e, 0x49, 0xba, 0x17, 0x25, 0x40, 0xb3, 0x4b, 0xbe, 0xe2, 0x3b, 0x08, 0x1f, 0x30,
+	0xa9, 0x74, 0x10, 0xa6, 0x22, 0x3a, 0x2c, 0x44, 0xc5, 0x8e, 0x4d, 0x4b, 0x06, 0xe9, 0xe5, 0x80,
+	0x93, 0x95, 0xfb, 0xa8, 0x11, 0x65, 0x52, 0xe6, 0x73, 0x98, 0x4d, 0xcc, 0xdc, 0x99, 0x4e, 0xa7,
+	0x56, 0x1d, 0x3a, 0x71, 0x9f, 0x33, 0x31, 0x2a, 0xa3, 0x6b, 0x63, 0x62, 0x54, 0x84, 0xf5, 0x3e,
+	0x7c, 0x7a, 0xd2, 0xf2, 0x9e, 0x9d, 0xb4, 0xbc, 0xdf, 0x4e, 0x5a, 0xde, 0xd7, 0xa7, 0xad, 0x99,
+	0x67, 0xa7, 0xad, 0x99, 0x5f, 0x4e, 0x5b, 0x33, 0x5f, 0xdc, 0x1b, 0x9b, 0xa6, 0xdc, 0x64, 0x1b,
+	0x25, 0x84, 0xf1, 0xc2, 0xe8, 0x1e, 0x9d, 0x8d, 0xc2, 0x66, 0xac, 0x0a, 0xeb, 0x66, 0x82, 0x7d,
+	0xe7, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x81, 0x8d, 0xb8, 0x2b, 0x0b, 0x00, 0x00,
+}
+
+func (m *EventBeginEpoch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -880,51 +1126,25 @@ func (m *EventWrappedUndelegate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventWrappedUndelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBeginEpoch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventWrappedUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBeginEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EpochBoundary != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.EpochBoundary))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventWrappedBeginRedelegate) Marshal() (dAtA []byte, err error) {
+func (m *EventEndEpoch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -934,58 +1154,25 @@ func (m *EventWrappedBeginRedelegate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventWrappedBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventEndEpoch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventWrappedBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventEndEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EpochBoundary != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.EpochBoundary))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.DestinationValidatorAddress) > 0 {
-		i -= len(m.DestinationValidatorAddress)
-		copy(dAtA[i:], m.DestinationValidatorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DestinationValidatorAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SourceValidatorAddress) > 0 {
-		i -= len(m.SourceValidatorAddress)
-		copy(dAtA[i:], m.SourceValidatorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventWrappedCancelUnbondingDelegation) Marshal() (dAtA []byte, err error) {
+func (m *EventHandleQueuedMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -995,12 +1182,129 @@ func (m *EventWrappedCancelUnbondingDelegation) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *EventWrappedCancelUnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventHandleQueuedMsg) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventWrappedCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventHandleQueuedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OriginalAttributes) > 0 {
+		for iNdEx := len(m.OriginalAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.OriginalAttributes[iNdEx].Size()
+				i -= size
+				if _, err := m.OriginalAttributes[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MsgId) > 0 {
+		i -= len(m.MsgId)
+		copy(dAtA[i:], m.MsgId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OriginalEventType) > 0 {
+		i -= len(m.OriginalEventType)
+		copy(dAtA[i:], m.OriginalEventType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OriginalEventType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSlashThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSlashThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSlashThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashedValidators) > 0 {
+		for iNdEx := len(m.SlashedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashedValidators[iNdEx])
+			copy(dAtA[i:], m.SlashedValidators[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.SlashedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SlashedVotingPower != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SlashedVotingPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWrappedDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWrappedDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWrappedDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1010,10 +1314,12 @@ func (m *EventWrappedCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.CreationHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.CreationHeight))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
 	if m.Amount != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
@@ -1037,89 +1343,566 @@ func (m *EventWrappedCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *EventBeginEpoch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovEvents(uint64(m.EpochNumber))
+func (m *EventWrappedUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventEndEpoch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovEvents(uint64(m.EpochNumber))
-	}
-	return n
+func (m *EventWrappedUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventHandleQueuedMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventWrappedUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OriginalEventType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovEvents(uint64(m.EpochNumber))
-	}
-	if m.Height != 0 {
-		n += 1 + sovEvents(uint64(m.Height))
+	if m.EpochBoundary != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochBoundary))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.TxId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.MsgId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.OriginalAttributes) > 0 {
-		for _, e := range m.OriginalAttributes {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSlashThreshold) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventWrappedBeginRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWrappedBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWrappedBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SlashedVotingPower != 0 {
-		n += 1 + sovEvents(uint64(m.SlashedVotingPower))
-	}
-	if m.TotalVotingPower != 0 {
-		n += 1 + sovEvents(uint64(m.TotalVotingPower))
+	if m.EpochBoundary != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochBoundary))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DestinationValidatorAddress) > 0 {
+		i -= len(m.DestinationValidatorAddress)
+		copy(dAtA[i:], m.DestinationValidatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DestinationValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceValidatorAddress) > 0 {
+		i -= len(m.SourceValidatorAddress)
+		copy(dAtA[i:], m.SourceValidatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWrappedCancelUnbondingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWrappedCancelUnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWrappedCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochBoundary != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochBoundary))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQueuedMsgSucceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQueuedMsgSucceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQueuedMsgSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgId) > 0 {
+		i -= len(m.MsgId)
+		copy(dAtA[i:], m.MsgId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQueuedMsgFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQueuedMsgFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQueuedMsgFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Code != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgId) > 0 {
+		i -= len(m.MsgId)
+		copy(dAtA[i:], m.MsgId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQueuedMsgEvicted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQueuedMsgEvicted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQueuedMsgEvicted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EvictedByFee != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EvictedByFee))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Fee != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgId) > 0 {
+		i -= len(m.MsgId)
+		copy(dAtA[i:], m.MsgId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQueuedMsgRejected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQueuedMsgRejected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQueuedMsgRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Fee != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgId) > 0 {
+		i -= len(m.MsgId)
+		copy(dAtA[i:], m.MsgId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEpochIntervalScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochIntervalScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochIntervalScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochInterval != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CurrentEpochInterval != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentEpochInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FirstBlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FirstBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBeginEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *EventEndEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *EventHandleQueuedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginalEventType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNumber))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MsgId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.OriginalAttributes) > 0 {
+		for _, e := range m.OriginalAttributes {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSlashThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashedVotingPower != 0 {
+		n += 1 + sovEvents(uint64(m.SlashedVotingPower))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovEvents(uint64(m.TotalVotingPower))
 	}
 	if len(m.SlashedValidators) > 0 {
 		for _, b := range m.SlashedValidators {
@@ -1127,127 +1910,1379 @@ func (m *EventSlashThreshold) Size() (n int) {
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *EventWrappedDelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.EpochBoundary != 0 {
-		n += 1 + sovEvents(uint64(m.EpochBoundary))
-	}
-	return n
-}
-
-func (m *EventWrappedUndelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.EpochBoundary != 0 {
-		n += 1 + sovEvents(uint64(m.EpochBoundary))
-	}
-	return n
-}
-
-func (m *EventWrappedBeginRedelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SourceValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.DestinationValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.EpochBoundary != 0 {
-		n += 1 + sovEvents(uint64(m.EpochBoundary))
-	}
-	return n
-}
+	return n
+}
+
+func (m *EventWrappedDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.EpochBoundary != 0 {
+		n += 1 + sovEvents(uint64(m.EpochBoundary))
+	}
+	return n
+}
+
+func (m *EventWrappedUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.EpochBoundary != 0 {
+		n += 1 + sovEvents(uint64(m.EpochBoundary))
+	}
+	return n
+}
+
+func (m *EventWrappedBeginRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SourceValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DestinationValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.EpochBoundary != 0 {
+		n += 1 + sovEvents(uint64(m.EpochBoundary))
+	}
+	return n
+}
+
+func (m *EventWrappedCancelUnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CreationHeight))
+	}
+	if m.EpochBoundary != 0 {
+		n += 1 + sovEvents(uint64(m.EpochBoundary))
+	}
+	return n
+}
+
+func (m *EventQueuedMsgSucceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNumber))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MsgId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.MsgIndex != 0 {
+		n += 1 + sovEvents(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *EventQueuedMsgFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNumber))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MsgId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovEvents(uint64(m.Code))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovEvents(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *EventQueuedMsgEvicted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNumber))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MsgId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovEvents(uint64(m.Fee))
+	}
+	if m.EvictedByFee != 0 {
+		n += 1 + sovEvents(uint64(m.EvictedByFee))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovEvents(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *EventQueuedMsgRejected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNumber))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MsgId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovEvents(uint64(m.Fee))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovEvents(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *EventEpochIntervalScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNumber))
+	}
+	if m.FirstBlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.FirstBlockHeight))
+	}
+	if m.CurrentEpochInterval != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentEpochInterval))
+	}
+	if m.EpochInterval != 0 {
+		n += 1 + sovEvents(uint64(m.EpochInterval))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBeginEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBeginEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBeginEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEndEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEndEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEndEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventHandleQueuedMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventHandleQueuedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventHandleQueuedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalEventType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalEventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgId = append(m.MsgId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MsgId == nil {
+				m.MsgId = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAttributes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cometbft_cometbft_abci_types.EventAttribute
+			m.OriginalAttributes = append(m.OriginalAttributes, v)
+			if err := m.OriginalAttributes[len(m.OriginalAttributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlashThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedVotingPower", wireType)
+			}
+			m.SlashedVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedValidators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedValidators = append(m.SlashedValidators, make([]byte, postIndex-iNdEx))
+			copy(m.SlashedValidators[len(m.SlashedValidators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWrappedDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWrappedDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWrappedDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBoundary", wireType)
+			}
+			m.EpochBoundary = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBoundary |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWrappedUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWrappedUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWrappedUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBoundary", wireType)
+			}
+			m.EpochBoundary = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBoundary |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWrappedBeginRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWrappedBeginRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWrappedBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBoundary", wireType)
+			}
+			m.EpochBoundary = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBoundary |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventWrappedCancelUnbondingDelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
-	}
-	if m.CreationHeight != 0 {
-		n += 1 + sovEvents(uint64(m.CreationHeight))
-	}
-	if m.EpochBoundary != 0 {
-		n += 1 + sovEvents(uint64(m.EpochBoundary))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventBeginEpoch) Unmarshal(dAtA []byte) error {
+func (m *EventWrappedCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1270,17 +3305,81 @@ func (m *EventBeginEpoch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBeginEpoch: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWrappedCancelUnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBeginEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWrappedCancelUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.EpochNumber = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1290,66 +3389,35 @@ func (m *EventBeginEpoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventEndEpoch) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventEndEpoch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventEndEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBoundary", wireType)
 			}
-			m.EpochNumber = 0
+			m.EpochBoundary = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1359,7 +3427,7 @@ func (m *EventEndEpoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
+				m.EpochBoundary |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1385,7 +3453,7 @@ func (m *EventEndEpoch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventHandleQueuedMsg) Unmarshal(dAtA []byte) error {
+func (m *EventQueuedMsgSucceeded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1408,45 +3476,13 @@ func (m *EventHandleQueuedMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventHandleQueuedMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventQueuedMsgSucceeded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventHandleQueuedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventQueuedMsgSucceeded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalEventType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginalEventType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
@@ -1465,26 +3501,7 @@ func (m *EventHandleQueuedMsg) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
@@ -1518,7 +3535,7 @@ func (m *EventHandleQueuedMsg) Unmarshal(dAtA []byte) error {
 				m.TxId = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
 			}
@@ -1552,11 +3569,11 @@ func (m *EventHandleQueuedMsg) Unmarshal(dAtA []byte) error {
 				m.MsgId = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1566,30 +3583,27 @@ func (m *EventHandleQueuedMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cometbft_cometbft_abci_types.EventAttribute
-			m.OriginalAttributes = append(m.OriginalAttributes, v)
-			if err := m.OriginalAttributes[len(m.OriginalAttributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1617,8 +3631,29 @@ func (m *EventHandleQueuedMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1640,7 +3675,7 @@ func (m *EventHandleQueuedMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSlashThreshold) Unmarshal(dAtA []byte) error {
+func (m *EventQueuedMsgFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1663,17 +3698,17 @@ func (m *EventSlashThreshold) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSlashThreshold: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventQueuedMsgFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSlashThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventQueuedMsgFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			m.SlashedVotingPower = 0
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1683,16 +3718,16 @@ func (m *EventSlashThreshold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashedVotingPower |= int64(b&0x7F) << shift
+				m.EpochNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
-			m.TotalVotingPower = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1702,14 +3737,29 @@ func (m *EventSlashThreshold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalVotingPower |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1736,60 +3786,12 @@ func (m *EventSlashThreshold) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashedValidators = append(m.SlashedValidators, make([]byte, postIndex-iNdEx))
-			copy(m.SlashedValidators[len(m.SlashedValidators)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventWrappedDelegate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MsgId = append(m.MsgId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MsgId == nil {
+				m.MsgId = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventWrappedDelegate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWrappedDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
@@ -1821,9 +3823,9 @@ func (m *EventWrappedDelegate) Unmarshal(dAtA []byte) error {
 			}
 			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1851,13 +3853,13 @@ func (m *EventWrappedDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.Amount = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1867,14 +3869,14 @@ func (m *EventWrappedDelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.Code |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1902,13 +3904,13 @@ func (m *EventWrappedDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Log = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochBoundary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
 			}
-			m.EpochBoundary = 0
+			m.MsgIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1918,7 +3920,7 @@ func (m *EventWrappedDelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochBoundary |= uint64(b&0x7F) << shift
+				m.MsgIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1944,7 +3946,7 @@ func (m *EventWrappedDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWrappedUndelegate) Unmarshal(dAtA []byte) error {
+func (m *EventQueuedMsgEvicted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1967,17 +3969,17 @@ func (m *EventWrappedUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWrappedUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventQueuedMsgEvicted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWrappedUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventQueuedMsgEvicted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			var stringLen uint64
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1987,29 +3989,16 @@ func (m *EventWrappedUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2019,29 +4008,31 @@ func (m *EventWrappedUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
 			}
-			m.Amount = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2051,14 +4042,29 @@ func (m *EventWrappedUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgId = append(m.MsgId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MsgId == nil {
+				m.MsgId = []byte{}
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2086,13 +4092,13 @@ func (m *EventWrappedUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochBoundary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.EpochBoundary = 0
+			m.Fee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2102,7 +4108,45 @@ func (m *EventWrappedUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochBoundary |= uint64(b&0x7F) << shift
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictedByFee", wireType)
+			}
+			m.EvictedByFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvictedByFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2128,7 +4172,7 @@ func (m *EventWrappedUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWrappedBeginRedelegate) Unmarshal(dAtA []byte) error {
+func (m *EventQueuedMsgRejected) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2151,17 +4195,36 @@ func (m *EventWrappedBeginRedelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWrappedBeginRedelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventQueuedMsgRejected: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWrappedBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventQueuedMsgRejected: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2171,29 +4234,31 @@ func (m *EventWrappedBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2203,27 +4268,29 @@ func (m *EventWrappedBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.MsgId = append(m.MsgId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MsgId == nil {
+				m.MsgId = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2251,13 +4318,13 @@ func (m *EventWrappedBeginRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.Amount = 0
+			m.Fee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2267,14 +4334,14 @@ func (m *EventWrappedBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.Fee |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2302,13 +4369,13 @@ func (m *EventWrappedBeginRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochBoundary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
 			}
-			m.EpochBoundary = 0
+			m.MsgIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2318,7 +4385,7 @@ func (m *EventWrappedBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochBoundary |= uint64(b&0x7F) << shift
+				m.MsgIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2344,7 +4411,7 @@ func (m *EventWrappedBeginRedelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWrappedCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *EventEpochIntervalScheduled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2367,17 +4434,17 @@ func (m *EventWrappedCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWrappedCancelUnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventEpochIntervalScheduled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWrappedCancelUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventEpochIntervalScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			var stringLen uint64
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2387,29 +4454,16 @@ func (m *EventWrappedCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstBlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.FirstBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2419,29 +4473,16 @@ func (m *EventWrappedCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FirstBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochInterval", wireType)
 			}
-			m.Amount = 0
+			m.CurrentEpochInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2451,35 +4492,16 @@ func (m *EventWrappedCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.CurrentEpochInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochBoundary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInterval", wireType)
 			}
-			m.EpochBoundary = 0
+			m.EpochInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2489,7 +4511,7 @@ func (m *EventWrappedCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochBoundary |= uint64(b&0x7F) << shift
+				m.EpochInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
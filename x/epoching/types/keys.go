@@ -28,6 +28,12 @@ var (
 	ValidatorLifecycleKey  = []byte{0x18} // key prefix for validator life cycle
 	DelegationLifecycleKey = []byte{0x19} // key prefix for delegation life cycle
 	ParamsKey              = []byte{0x20} // key prefix for the parameters
+	QueuedMsgResultKey     = []byte{0x21} // key prefix for the execution results of queued messages
+	DelegatorMsgResultKey  = []byte{0x22} // key prefix for the index of execution results by delegator
+	MsgQueueFeeIndexKey    = []byte{0x23} // key prefix for the index of the message queue of an epoch by fee
+	AccountQueueLengthKey  = []byte{0x24} // key prefix for the number of messages queued by an account in an epoch
+	EpochMsgResultKey      = []byte{0x25} // key prefix for the index of execution results by epoch
+	TxQueuedMsgKey         = []byte{0x26} // key prefix for the index of the message queue of an epoch by tx
 )
 
 func KeyPrefix(p string) []byte {
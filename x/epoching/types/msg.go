@@ -1,7 +1,12 @@
 package types
 
 import (
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -11,7 +16,10 @@ var (
 	_ sdk.Msg = &MsgWrappedUndelegate{}
 	_ sdk.Msg = &MsgWrappedBeginRedelegate{}
 	_ sdk.Msg = &MsgWrappedCancelUnbondingDelegation{}
+	_ sdk.Msg = &MsgWrappedExec{}
 	_ sdk.Msg = &MsgUpdateParams{}
+
+	_ cdctypes.UnpackInterfacesMessage = &MsgWrappedExec{}
 )
 
 // NewMsgWrappedDelegate creates a new MsgWrappedDelegate instance.
@@ -41,3 +49,140 @@ func NewMsgWrappedCancelUnbondingDelegation(msg *stakingtypes.MsgCancelUnbonding
 		Msg: msg,
 	}
 }
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgWrappedDelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrNoWrappedMsg
+	}
+	return validateStakingMsg(msg.Msg.DelegatorAddress, []string{msg.Msg.ValidatorAddress}, msg.Msg.Amount)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgWrappedUndelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrNoWrappedMsg
+	}
+	return validateStakingMsg(msg.Msg.DelegatorAddress, []string{msg.Msg.ValidatorAddress}, msg.Msg.Amount)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgWrappedBeginRedelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrNoWrappedMsg
+	}
+	return validateStakingMsg(msg.Msg.DelegatorAddress, []string{msg.Msg.ValidatorSrcAddress, msg.Msg.ValidatorDstAddress}, msg.Msg.Amount)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgWrappedCancelUnbondingDelegation) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrNoWrappedMsg
+	}
+	if msg.Msg.CreationHeight <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid creation height")
+	}
+	return validateStakingMsg(msg.Msg.DelegatorAddress, []string{msg.Msg.ValidatorAddress}, msg.Msg.Amount)
+}
+
+// validateStakingMsg performs the stateless checks that the staking module's
+// msg server performs on the inner staking message, so that an invalid
+// message is rejected before its funds are escrowed and it is queued
+func validateStakingMsg(delegator string, validators []string, amount sdk.Coin) error {
+	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	for _, val := range validators {
+		if _, err := sdk.ValAddressFromBech32(val); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		}
+	}
+	if !amount.IsValid() || !amount.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid amount")
+	}
+	return nil
+}
+
+// NewMsgWrappedExec creates a new MsgWrappedExec instance.
+func NewMsgWrappedExec(grantee sdk.AccAddress, msgs []sdk.Msg) (*MsgWrappedExec, error) {
+	msgsAny, err := tx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgWrappedExec{
+		Grantee: grantee.String(),
+		Msgs:    msgsAny,
+	}, nil
+}
+
+// GetMessages returns the cached staking messages of the MsgWrappedExec.
+func (msg *MsgWrappedExec) GetMessages() ([]sdk.Msg, error) {
+	return tx.GetMsgs(msg.Msgs, "MsgWrappedExec")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg *MsgWrappedExec) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return tx.UnpackInterfaces(unpacker, msg.Msgs)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgWrappedExec) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return fmt.Errorf("invalid grantee address: %w", err)
+	}
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return ErrNoWrappedMsg
+	}
+	for _, m := range msgs {
+		wrappedMsg, err := WrapStakingMsg(m)
+		if err != nil {
+			return err
+		}
+		if err := wrappedMsg.(sdk.HasValidateBasic).ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WrapStakingMsg wraps a staking message into the corresponding epoching
+// message, so that it is queued rather than executed immediately. Only
+// MsgDelegate, MsgUndelegate, MsgBeginRedelegate and
+// MsgCancelUnbondingDelegation are supported.
+func WrapStakingMsg(msg sdk.Msg) (sdk.Msg, error) {
+	switch m := msg.(type) {
+	case *stakingtypes.MsgDelegate:
+		return NewMsgWrappedDelegate(m), nil
+	case *stakingtypes.MsgUndelegate:
+		return NewMsgWrappedUndelegate(m), nil
+	case *stakingtypes.MsgBeginRedelegate:
+		return NewMsgWrappedBeginRedelegate(m), nil
+	case *stakingtypes.MsgCancelUnbondingDelegation:
+		return NewMsgWrappedCancelUnbondingDelegation(m), nil
+	default:
+		return nil, ErrUnwrappedMsgType.Wrapf("cannot wrap message of type %s", sdk.MsgTypeURL(msg))
+	}
+}
+
+// StakingMsgDelegator returns the delegator, i.e., the signer, of a staking
+// message supported by WrapStakingMsg
+func StakingMsgDelegator(msg sdk.Msg) (sdk.AccAddress, error) {
+	var delegator string
+	switch m := msg.(type) {
+	case *stakingtypes.MsgDelegate:
+		delegator = m.DelegatorAddress
+	case *stakingtypes.MsgUndelegate:
+		delegator = m.DelegatorAddress
+	case *stakingtypes.MsgBeginRedelegate:
+		delegator = m.DelegatorAddress
+	case *stakingtypes.MsgCancelUnbondingDelegation:
+		delegator = m.DelegatorAddress
+	default:
+		return nil, ErrUnwrappedMsgType.Wrapf("unsupported message of type %s", sdk.MsgTypeURL(msg))
+	}
+	return sdk.AccAddressFromBech32(delegator)
+}
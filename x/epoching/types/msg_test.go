@@ -87,3 +87,39 @@ func TestMsgDecode(t *testing.T) {
 	require.Equal(t, qmsg.MsgId, qmsg2.MsgId)
 	require.True(t, msgcreateval1.Pubkey.Equal(msgcreateval2.Pubkey))
 }
+
+func TestMsgWrappedValidateBasic(t *testing.T) {
+	delAddr := sdk.AccAddress(valAddr1).String()
+	coinZero := sdk.NewInt64Coin(appparams.DefaultBondDenom, 0)
+
+	testCases := []struct {
+		name  string
+		msg   sdk.HasValidateBasic
+		valid bool
+	}{
+		{"valid delegate", types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, valAddr2.String(), coinPos)), true},
+		{"nil delegate", &types.MsgWrappedDelegate{}, false},
+		{"zero delegate amount", types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, valAddr2.String(), coinZero)), false},
+		{"invalid delegator", types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate("invalid", valAddr2.String(), coinPos)), false},
+		{"valid undelegate", types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(delAddr, valAddr2.String(), coinPos)), true},
+		{"invalid undelegate validator", types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(delAddr, "invalid", coinPos)), false},
+		{"valid redelegate", types.NewMsgWrappedBeginRedelegate(stakingtypes.NewMsgBeginRedelegate(delAddr, valAddr1.String(), valAddr2.String(), coinPos)), true},
+		{"invalid redelegate dst validator", types.NewMsgWrappedBeginRedelegate(stakingtypes.NewMsgBeginRedelegate(delAddr, valAddr1.String(), "invalid", coinPos)), false},
+		{"valid cancel unbonding", types.NewMsgWrappedCancelUnbondingDelegation(stakingtypes.NewMsgCancelUnbondingDelegation(delAddr, valAddr2.String(), 10, coinPos)), true},
+		{"zero cancel unbonding height", types.NewMsgWrappedCancelUnbondingDelegation(stakingtypes.NewMsgCancelUnbondingDelegation(delAddr, valAddr2.String(), 0, coinPos)), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+
+	// MsgWrappedExec validates each of its staking messages
+	execMsg, err := types.NewMsgWrappedExec(valAddr1.Bytes(), []sdk.Msg{stakingtypes.NewMsgDelegate(delAddr, valAddr2.String(), coinZero)})
+	require.NoError(t, err)
+	require.Error(t, execMsg.ValidateBasic())
+}
@@ -5,19 +5,29 @@ import (
 )
 
 const (
-	DefaultEpochInterval uint64 = 10
+	DefaultEpochInterval            uint64 = 10
+	DefaultMaxQueueSize             uint64 = 10000
+	DefaultMaxMsgsPerAccount        uint64 = 1000
+	DefaultMsgResultRetentionEpochs uint64 = 1000
+
+	// MaxPrunedMsgResultsPerEpoch is the maximum number of execution results
+	// of queued messages pruned at the end of an epoch
+	MaxPrunedMsgResultsPerEpoch = 10000
 )
 
 // NewParams creates a new Params instance
-func NewParams(epochInterval uint64) Params {
+func NewParams(epochInterval uint64, maxQueueSize uint64, maxMsgsPerAccount uint64, msgResultRetentionEpochs uint64) Params {
 	return Params{
-		EpochInterval: epochInterval,
+		EpochInterval:            epochInterval,
+		MaxQueueSize:             maxQueueSize,
+		MaxMsgsPerAccount:        maxMsgsPerAccount,
+		MsgResultRetentionEpochs: msgResultRetentionEpochs,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultEpochInterval)
+	return NewParams(DefaultEpochInterval, DefaultMaxQueueSize, DefaultMaxMsgsPerAccount, DefaultMsgResultRetentionEpochs)
 }
 
 // Validate validates the set of params
@@ -25,6 +35,9 @@ func (p Params) Validate() error {
 	if err := validateEpochInterval(p.EpochInterval); err != nil {
 		return err
 	}
+	if err := validateQueueLimits(p.MaxQueueSize, p.MaxMsgsPerAccount); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -41,3 +54,13 @@ func validateEpochInterval(i interface{}) error {
 
 	return nil
 }
+
+// validateQueueLimits ensures that an account can queue no more messages than
+// the queue can hold, where 0 means unlimited
+func validateQueueLimits(maxQueueSize uint64, maxMsgsPerAccount uint64) error {
+	if maxQueueSize > 0 && maxMsgsPerAccount > maxQueueSize {
+		return fmt.Errorf("max msgs per account (%d) must not exceed max queue size (%d)", maxMsgsPerAccount, maxQueueSize)
+	}
+
+	return nil
+}
@@ -25,8 +25,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	// epoch_interval is the number of consecutive blocks to form an epoch
+	// epoch_interval is the number of consecutive blocks to form an epoch.
+	// Each epoch keeps the epoch interval at its beginning, so that updates of
+	// the epoch interval take effect from the next epoch on.
 	EpochInterval uint64 `protobuf:"varint,1,opt,name=epoch_interval,json=epochInterval,proto3" json:"epoch_interval,omitempty" yaml:"epoch_interval"`
+	// max_queue_size is the maximum number of messages that can be queued in
+	// an epoch, where 0 means unlimited. When the queue is full, a new message
+	// evicts the queued message with the lowest fee if its fee is higher, and
+	// is rejected otherwise.
+	MaxQueueSize uint64 `protobuf:"varint,2,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty" yaml:"max_queue_size"`
+	// max_msgs_per_account is the maximum number of messages that an account
+	// can queue in an epoch, where 0 means unlimited
+	MaxMsgsPerAccount uint64 `protobuf:"varint,3,opt,name=max_msgs_per_account,json=maxMsgsPerAccount,proto3" json:"max_msgs_per_account,omitempty" yaml:"max_msgs_per_account"`
+	// msg_result_retention_epochs is the number of most recent epochs whose
+	// execution results of queued messages are retained. Older results are
+	// pruned at the end of each epoch. 0 disables pruning.
+	MsgResultRetentionEpochs uint64 `protobuf:"varint,4,opt,name=msg_result_retention_epochs,json=msgResultRetentionEpochs,proto3" json:"msg_result_retention_epochs,omitempty" yaml:"msg_result_retention_epochs"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +83,27 @@ func (m *Params) GetEpochInterval() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxQueueSize() uint64 {
+	if m != nil {
+		return m.MaxQueueSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMsgsPerAccount() uint64 {
+	if m != nil {
+		return m.MaxMsgsPerAccount
+	}
+	return 0
+}
+
+func (m *Params) GetMsgResultRetentionEpochs() uint64 {
+	if m != nil {
+		return m.MsgResultRetentionEpochs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.epoching.v1.Params")
 }
@@ -76,20 +111,29 @@ func init() {
 func init() { proto.RegisterFile("babylon/epoching/v1/params.proto", fileDescriptor_c9e38cfe55335900) }
 
 var fileDescriptor_c9e38cfe55335900 = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83,
-	0x58, 0x10, 0xa5, 0x4a, 0x01, 0x5c, 0x6c, 0x01, 0x60, 0xad, 0x42, 0x0e, 0x5c, 0x7c, 0x60, 0xe5,
-	0xf1, 0x99, 0x79, 0x25, 0xa9, 0x45, 0x65, 0x89, 0x39, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x2c, 0x4e,
-	0x92, 0x9f, 0xee, 0xc9, 0x8b, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0xca, 0x2b, 0x05, 0xf1,
-	0x82, 0x05, 0x3c, 0xa1, 0x7c, 0x2b, 0x96, 0x17, 0x0b, 0xe4, 0x19, 0x9d, 0xbc, 0x4e, 0x3c, 0x92,
-	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
-	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x20, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
-	0x39, 0x3f, 0x57, 0x1f, 0xea, 0xc2, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x18, 0x47, 0xbf, 0x02, 0xe1,
-	0xa5, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x23, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xa6, 0x7d, 0x61, 0x54, 0xf3, 0x00, 0x00, 0x00,
+	// 339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x4a, 0xc3, 0x40,
+	0x1c, 0xc6, 0x9b, 0x5a, 0x3a, 0x04, 0x2d, 0x18, 0x2b, 0x44, 0x0b, 0x49, 0xb9, 0x41, 0x9c, 0x12,
+	0x8b, 0x5b, 0x17, 0xb5, 0xe0, 0xa0, 0x20, 0xd4, 0xb8, 0xb9, 0x1c, 0x97, 0x70, 0x5c, 0x0f, 0x72,
+	0x77, 0xf1, 0xee, 0x52, 0xd2, 0x3e, 0x85, 0x8f, 0xe0, 0xe3, 0x88, 0x53, 0x47, 0xa7, 0x22, 0xed,
+	0xe2, 0xdc, 0x27, 0x90, 0x5e, 0x13, 0x4a, 0x0b, 0x6e, 0xf9, 0xfe, 0xbf, 0x5f, 0x3e, 0x0e, 0x3e,
+	0xbb, 0x1b, 0xa3, 0x78, 0x92, 0x0a, 0x1e, 0xe2, 0x4c, 0x24, 0x23, 0xca, 0x49, 0x38, 0xee, 0x85,
+	0x19, 0x92, 0x88, 0xa9, 0x20, 0x93, 0x42, 0x0b, 0xe7, 0xa4, 0x34, 0x82, 0xca, 0x08, 0xc6, 0xbd,
+	0xf3, 0x36, 0x11, 0x44, 0x18, 0x1e, 0xae, 0xbf, 0x36, 0x2a, 0xf8, 0xaa, 0xdb, 0xcd, 0xa1, 0xf9,
+	0xd7, 0xb9, 0xb5, 0x5b, 0xc6, 0x87, 0x94, 0x6b, 0x2c, 0xc7, 0x28, 0x75, 0xad, 0xae, 0x75, 0xd9,
+	0x18, 0x9c, 0xad, 0xe6, 0xfe, 0xe9, 0x04, 0xb1, 0xb4, 0x0f, 0x76, 0x39, 0x88, 0x8e, 0xcc, 0xe1,
+	0xa1, 0xcc, 0xce, 0x8d, 0xdd, 0x62, 0xa8, 0x80, 0x6f, 0x39, 0xce, 0x31, 0x54, 0x74, 0x8a, 0xdd,
+	0xfa, 0x7e, 0xc3, 0x2e, 0x07, 0xd1, 0x21, 0x43, 0xc5, 0xf3, 0x3a, 0xbf, 0xd0, 0x29, 0x76, 0x86,
+	0x76, 0x7b, 0x2d, 0x30, 0x45, 0x14, 0xcc, 0xb0, 0x84, 0x28, 0x49, 0x44, 0xce, 0xb5, 0x7b, 0x60,
+	0x6a, 0xfc, 0xd5, 0xdc, 0xef, 0x6c, 0x6b, 0xf6, 0x2d, 0x10, 0x1d, 0x33, 0x54, 0x3c, 0x29, 0xa2,
+	0x86, 0x58, 0xde, 0x6d, 0x6e, 0x0e, 0xb6, 0x3b, 0x4c, 0x11, 0x28, 0xb1, 0xca, 0x53, 0x0d, 0x25,
+	0xd6, 0x98, 0x6b, 0x2a, 0x38, 0x34, 0x0f, 0x57, 0x6e, 0xc3, 0x14, 0x5f, 0xac, 0xe6, 0x3e, 0x28,
+	0x8b, 0xff, 0x97, 0x41, 0xe4, 0x32, 0x45, 0x22, 0x03, 0xa3, 0x8a, 0xdd, 0x1b, 0xd4, 0x6f, 0xfc,
+	0x7e, 0xf8, 0xd6, 0xe0, 0xf1, 0x73, 0xe1, 0x59, 0xb3, 0x85, 0x67, 0xfd, 0x2c, 0x3c, 0xeb, 0x7d,
+	0xe9, 0xd5, 0x66, 0x4b, 0xaf, 0xf6, 0xbd, 0xf4, 0x6a, 0xaf, 0x57, 0x84, 0xea, 0x51, 0x1e, 0x07,
+	0x89, 0x60, 0x61, 0x39, 0x4e, 0x32, 0x42, 0x94, 0x57, 0x21, 0x2c, 0xb6, 0x6b, 0xea, 0x49, 0x86,
+	0x55, 0xdc, 0x34, 0xfb, 0x5c, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x6b, 0x6c, 0xe0, 0xee,
+	0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -114,6 +158,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.EpochInterval != that1.EpochInterval {
 		return false
 	}
+	if this.MaxQueueSize != that1.MaxQueueSize {
+		return false
+	}
+	if this.MaxMsgsPerAccount != that1.MaxMsgsPerAccount {
+		return false
+	}
+	if this.MsgResultRetentionEpochs != that1.MsgResultRetentionEpochs {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -136,6 +189,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MsgResultRetentionEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MsgResultRetentionEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxMsgsPerAccount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMsgsPerAccount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxQueueSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxQueueSize))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.EpochInterval != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.EpochInterval))
 		i--
@@ -164,6 +232,15 @@ func (m *Params) Size() (n int) {
 	if m.EpochInterval != 0 {
 		n += 1 + sovParams(uint64(m.EpochInterval))
 	}
+	if m.MaxQueueSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxQueueSize))
+	}
+	if m.MaxMsgsPerAccount != 0 {
+		n += 1 + sovParams(uint64(m.MaxMsgsPerAccount))
+	}
+	if m.MsgResultRetentionEpochs != 0 {
+		n += 1 + sovParams(uint64(m.MsgResultRetentionEpochs))
+	}
 	return n
 }
 
@@ -221,6 +298,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
+			}
+			m.MaxQueueSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueueSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerAccount", wireType)
+			}
+			m.MaxMsgsPerAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgsPerAccount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResultRetentionEpochs", wireType)
+			}
+			m.MsgResultRetentionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgResultRetentionEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
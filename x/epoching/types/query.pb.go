@@ -825,6 +825,211 @@ func (m *QueryDelegationLifecycleResponse) GetDelLife() *DelegationLifecycle {
 	return nil
 }
 
+// QueryQueuedMsgResultsByTxRequest is the request type for the
+// Query/QueuedMsgResultsByTx RPC method
+type QueryQueuedMsgResultsByTxRequest struct {
+	// tx_hash is the hash of the tx in hex
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *QueryQueuedMsgResultsByTxRequest) Reset()         { *m = QueryQueuedMsgResultsByTxRequest{} }
+func (m *QueryQueuedMsgResultsByTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedMsgResultsByTxRequest) ProtoMessage()    {}
+func (*QueryQueuedMsgResultsByTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1821b530f2ec2711, []int{16}
+}
+func (m *QueryQueuedMsgResultsByTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedMsgResultsByTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedMsgResultsByTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedMsgResultsByTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedMsgResultsByTxRequest.Merge(m, src)
+}
+func (m *QueryQueuedMsgResultsByTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedMsgResultsByTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedMsgResultsByTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedMsgResultsByTxRequest proto.InternalMessageInfo
+
+func (m *QueryQueuedMsgResultsByTxRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// QueryQueuedMsgResultsByTxResponse is the response type for the
+// Query/QueuedMsgResultsByTx RPC method
+type QueryQueuedMsgResultsByTxResponse struct {
+	Results []*QueuedMessageResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *QueryQueuedMsgResultsByTxResponse) Reset()         { *m = QueryQueuedMsgResultsByTxResponse{} }
+func (m *QueryQueuedMsgResultsByTxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedMsgResultsByTxResponse) ProtoMessage()    {}
+func (*QueryQueuedMsgResultsByTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1821b530f2ec2711, []int{17}
+}
+func (m *QueryQueuedMsgResultsByTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedMsgResultsByTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedMsgResultsByTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedMsgResultsByTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedMsgResultsByTxResponse.Merge(m, src)
+}
+func (m *QueryQueuedMsgResultsByTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedMsgResultsByTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedMsgResultsByTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedMsgResultsByTxResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedMsgResultsByTxResponse) GetResults() []*QueuedMessageResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// QueryQueuedMsgResultsByDelegatorRequest is the request type for the
+// Query/QueuedMsgResultsByDelegator RPC method
+type QueryQueuedMsgResultsByDelegatorRequest struct {
+	DelAddr    string             `protobuf:"bytes,1,opt,name=del_addr,json=delAddr,proto3" json:"del_addr,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedMsgResultsByDelegatorRequest) Reset() {
+	*m = QueryQueuedMsgResultsByDelegatorRequest{}
+}
+func (m *QueryQueuedMsgResultsByDelegatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedMsgResultsByDelegatorRequest) ProtoMessage()    {}
+func (*QueryQueuedMsgResultsByDelegatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1821b530f2ec2711, []int{18}
+}
+func (m *QueryQueuedMsgResultsByDelegatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedMsgResultsByDelegatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedMsgResultsByDelegatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedMsgResultsByDelegatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedMsgResultsByDelegatorRequest.Merge(m, src)
+}
+func (m *QueryQueuedMsgResultsByDelegatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedMsgResultsByDelegatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedMsgResultsByDelegatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedMsgResultsByDelegatorRequest proto.InternalMessageInfo
+
+func (m *QueryQueuedMsgResultsByDelegatorRequest) GetDelAddr() string {
+	if m != nil {
+		return m.DelAddr
+	}
+	return ""
+}
+
+func (m *QueryQueuedMsgResultsByDelegatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryQueuedMsgResultsByDelegatorResponse is the response type for the
+// Query/QueuedMsgResultsByDelegator RPC method
+type QueryQueuedMsgResultsByDelegatorResponse struct {
+	Results    []*QueuedMessageResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedMsgResultsByDelegatorResponse) Reset() {
+	*m = QueryQueuedMsgResultsByDelegatorResponse{}
+}
+func (m *QueryQueuedMsgResultsByDelegatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedMsgResultsByDelegatorResponse) ProtoMessage()    {}
+func (*QueryQueuedMsgResultsByDelegatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1821b530f2ec2711, []int{19}
+}
+func (m *QueryQueuedMsgResultsByDelegatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedMsgResultsByDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedMsgResultsByDelegatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedMsgResultsByDelegatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedMsgResultsByDelegatorResponse.Merge(m, src)
+}
+func (m *QueryQueuedMsgResultsByDelegatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedMsgResultsByDelegatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedMsgResultsByDelegatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedMsgResultsByDelegatorResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedMsgResultsByDelegatorResponse) GetResults() []*QueuedMessageResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *QueryQueuedMsgResultsByDelegatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryEpochValSetRequest is the request type for the Query/EpochValSet RPC
 // method
 type QueryEpochValSetRequest struct {
@@ -836,7 +1041,7 @@ func (m *QueryEpochValSetRequest) Reset()         { *m = QueryEpochValSetRequest
 func (m *QueryEpochValSetRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochValSetRequest) ProtoMessage()    {}
 func (*QueryEpochValSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{16}
+	return fileDescriptor_1821b530f2ec2711, []int{20}
 }
 func (m *QueryEpochValSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +1096,7 @@ func (m *QueryEpochValSetResponse) Reset()         { *m = QueryEpochValSetRespon
 func (m *QueryEpochValSetResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochValSetResponse) ProtoMessage()    {}
 func (*QueryEpochValSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{17}
+	return fileDescriptor_1821b530f2ec2711, []int{21}
 }
 func (m *QueryEpochValSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,13 +1168,16 @@ type EpochResponse struct {
 	// the validator set has generated a BLS multisig on the hash,
 	// i.e., hash of the last block in the epoch as hex string.
 	SealerBlockHash string `protobuf:"bytes,6,opt,name=sealer_block_hash,json=sealerBlockHash,proto3" json:"sealer_block_hash,omitempty"`
+	// last_block_height is the height of the last block in this epoch, as
+	// determined by the epoch interval of this epoch
+	LastBlockHeight uint64 `protobuf:"varint,7,opt,name=last_block_height,json=lastBlockHeight,proto3" json:"last_block_height,omitempty"`
 }
 
 func (m *EpochResponse) Reset()         { *m = EpochResponse{} }
 func (m *EpochResponse) String() string { return proto.CompactTextString(m) }
 func (*EpochResponse) ProtoMessage()    {}
 func (*EpochResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{18}
+	return fileDescriptor_1821b530f2ec2711, []int{22}
 }
 func (m *EpochResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1040,6 +1248,13 @@ func (m *EpochResponse) GetSealerBlockHash() string {
 	return ""
 }
 
+func (m *EpochResponse) GetLastBlockHeight() uint64 {
+	if m != nil {
+		return m.LastBlockHeight
+	}
+	return 0
+}
+
 // QueuedMessageResponse is a message that can change the validator set and is delayed
 // to the end of an epoch
 type QueuedMessageResponse struct {
@@ -1054,13 +1269,19 @@ type QueuedMessageResponse struct {
 	// msg is the actual message that is sent by a user and is queued by the
 	// epoching module as string.
 	Msg string `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
+	// grantee is the address that queued the msg on behalf of its signer via an
+	// authz grant, or empty if the msg is queued by its signer
+	Grantee string `protobuf:"bytes,6,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// msg_index is the position of the msg among the msgs its tx submits to the
+	// queue, which tells apart identical msgs of the same tx
+	MsgIndex uint64 `protobuf:"varint,7,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
 }
 
 func (m *QueuedMessageResponse) Reset()         { *m = QueuedMessageResponse{} }
 func (m *QueuedMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*QueuedMessageResponse) ProtoMessage()    {}
 func (*QueuedMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{19}
+	return fileDescriptor_1821b530f2ec2711, []int{23}
 }
 func (m *QueuedMessageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1124,6 +1345,20 @@ func (m *QueuedMessageResponse) GetMsg() string {
 	return ""
 }
 
+func (m *QueuedMessageResponse) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *QueuedMessageResponse) GetMsgIndex() uint64 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
 // QueuedMessageList is a message that contains a list of staking-related
 // messages queued for an epoch
 type QueuedMessageList struct {
@@ -1135,7 +1370,7 @@ func (m *QueuedMessageList) Reset()         { *m = QueuedMessageList{} }
 func (m *QueuedMessageList) String() string { return proto.CompactTextString(m) }
 func (*QueuedMessageList) ProtoMessage()    {}
 func (*QueuedMessageList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{20}
+	return fileDescriptor_1821b530f2ec2711, []int{24}
 }
 func (m *QueuedMessageList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1190,7 +1425,7 @@ func (m *ValStateUpdateResponse) Reset()         { *m = ValStateUpdateResponse{}
 func (m *ValStateUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*ValStateUpdateResponse) ProtoMessage()    {}
 func (*ValStateUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{21}
+	return fileDescriptor_1821b530f2ec2711, []int{25}
 }
 func (m *ValStateUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1257,6 +1492,10 @@ func init() {
 	proto.RegisterType((*QueryValidatorLifecycleResponse)(nil), "babylon.epoching.v1.QueryValidatorLifecycleResponse")
 	proto.RegisterType((*QueryDelegationLifecycleRequest)(nil), "babylon.epoching.v1.QueryDelegationLifecycleRequest")
 	proto.RegisterType((*QueryDelegationLifecycleResponse)(nil), "babylon.epoching.v1.QueryDelegationLifecycleResponse")
+	proto.RegisterType((*QueryQueuedMsgResultsByTxRequest)(nil), "babylon.epoching.v1.QueryQueuedMsgResultsByTxRequest")
+	proto.RegisterType((*QueryQueuedMsgResultsByTxResponse)(nil), "babylon.epoching.v1.QueryQueuedMsgResultsByTxResponse")
+	proto.RegisterType((*QueryQueuedMsgResultsByDelegatorRequest)(nil), "babylon.epoching.v1.QueryQueuedMsgResultsByDelegatorRequest")
+	proto.RegisterType((*QueryQueuedMsgResultsByDelegatorResponse)(nil), "babylon.epoching.v1.QueryQueuedMsgResultsByDelegatorResponse")
 	proto.RegisterType((*QueryEpochValSetRequest)(nil), "babylon.epoching.v1.QueryEpochValSetRequest")
 	proto.RegisterType((*QueryEpochValSetResponse)(nil), "babylon.epoching.v1.QueryEpochValSetResponse")
 	proto.RegisterType((*EpochResponse)(nil), "babylon.epoching.v1.EpochResponse")
@@ -1268,94 +1507,106 @@ func init() {
 func init() { proto.RegisterFile("babylon/epoching/v1/query.proto", fileDescriptor_1821b530f2ec2711) }
 
 var fileDescriptor_1821b530f2ec2711 = []byte{
-	// 1384 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x1b, 0xce, 0x3a, 0x4e, 0xbe, 0xe6, 0x4d, 0xf3, 0x25, 0x9d, 0xb4, 0xfd, 0x52, 0xa7, 0x75, 0xfa,
-	0x6d, 0xa1, 0x2d, 0x49, 0xb3, 0xdb, 0x34, 0x29, 0xd0, 0x1f, 0x50, 0x35, 0x2d, 0x25, 0x41, 0x2d,
-	0x4a, 0x17, 0xe8, 0x81, 0xcb, 0x32, 0xf6, 0x4e, 0xd6, 0x2b, 0xd6, 0xbb, 0xdb, 0x9d, 0xb1, 0x49,
-	0x54, 0x8a, 0x10, 0xe2, 0xc8, 0xa1, 0x12, 0x07, 0x84, 0x90, 0x10, 0x88, 0x23, 0x7f, 0x01, 0x2a,
-	0x07, 0x8e, 0x3d, 0x16, 0x71, 0xe1, 0x04, 0xa8, 0xe5, 0x0f, 0x41, 0xfb, 0xce, 0xac, 0xbd, 0x76,
-	0xd6, 0xb5, 0x13, 0x55, 0xdc, 0xec, 0xf7, 0xe7, 0xf3, 0x3e, 0xef, 0xcc, 0xec, 0x03, 0x73, 0x15,
-	0x5a, 0xd9, 0xf6, 0xc3, 0xc0, 0x64, 0x51, 0x58, 0xad, 0x79, 0x81, 0x6b, 0x36, 0x97, 0xcc, 0xbb,
-	0x0d, 0x16, 0x6f, 0x1b, 0x51, 0x1c, 0x8a, 0x90, 0x4c, 0xab, 0x00, 0x23, 0x0d, 0x30, 0x9a, 0x4b,
-	0xa5, 0x83, 0x6e, 0xe8, 0x86, 0xe8, 0x37, 0x93, 0x5f, 0x32, 0xb4, 0x34, 0xe7, 0x86, 0xa1, 0xeb,
-	0x33, 0x13, 0xff, 0x55, 0x1a, 0x9b, 0xa6, 0xf0, 0xea, 0x8c, 0x0b, 0x5a, 0x8f, 0x54, 0xc0, 0x51,
-	0x15, 0x40, 0x23, 0xcf, 0xa4, 0x41, 0x10, 0x0a, 0x2a, 0xbc, 0x30, 0xe0, 0xca, 0x3b, 0x5f, 0x0d,
-	0x79, 0x3d, 0xe4, 0x66, 0x85, 0x72, 0x26, 0x21, 0x98, 0xcd, 0xa5, 0x0a, 0x13, 0x74, 0xc9, 0x8c,
-	0xa8, 0xeb, 0x05, 0x18, 0xac, 0x62, 0x8f, 0xe7, 0xc1, 0x8e, 0x68, 0x4c, 0xeb, 0x69, 0x35, 0x3d,
-	0x2f, 0xa2, 0x35, 0x03, 0xc6, 0xe8, 0x07, 0x81, 0xdc, 0x4e, 0xfa, 0x6c, 0x60, 0xa2, 0xc5, 0xee,
-	0x36, 0x18, 0x17, 0xfa, 0x06, 0x4c, 0x77, 0x58, 0x79, 0x14, 0x06, 0x9c, 0x91, 0x0b, 0x30, 0x2a,
-	0x1b, 0xcc, 0x68, 0xc7, 0xb5, 0xd3, 0xe3, 0xe7, 0x66, 0x8d, 0x1c, 0x66, 0x0c, 0x99, 0xb4, 0x5a,
-	0x7c, 0xf4, 0xc7, 0xdc, 0x90, 0xa5, 0x12, 0xf4, 0x15, 0x38, 0x84, 0x15, 0xdf, 0x48, 0x02, 0xd7,
-	0x83, 0xcd, 0x50, 0xb5, 0x22, 0xb3, 0x30, 0x86, 0xc9, 0x76, 0xd0, 0xa8, 0x63, 0xd9, 0xa2, 0xb5,
-	0x0f, 0x0d, 0x6f, 0x37, 0xea, 0xba, 0x05, 0x87, 0xbb, 0xb3, 0x14, 0x94, 0x57, 0x61, 0x04, 0xa3,
-	0x14, 0x12, 0x3d, 0x17, 0x09, 0xa6, 0xa5, 0x29, 0x96, 0x4c, 0xd0, 0x3f, 0xc8, 0xd6, 0xe4, 0x59,
-	0x28, 0x37, 0x00, 0xda, 0x2c, 0xab, 0xc2, 0x27, 0x0d, 0xb9, 0x12, 0x23, 0x59, 0x89, 0x21, 0x4f,
-	0x85, 0x5a, 0x89, 0xb1, 0x41, 0x5d, 0xa6, 0x72, 0xad, 0x4c, 0xa6, 0xfe, 0xad, 0x06, 0xff, 0xdb,
-	0xd1, 0x42, 0xe1, 0xbe, 0x08, 0xa3, 0x08, 0x23, 0xa1, 0x70, 0x78, 0x40, 0xe0, 0x2a, 0x83, 0xbc,
-	0xd9, 0x81, 0xaf, 0x80, 0xf8, 0x4e, 0xf5, 0xc5, 0xa7, 0x8a, 0x64, 0x01, 0x96, 0x60, 0x06, 0xf1,
-	0x5d, 0x6b, 0xc4, 0x31, 0x0b, 0x84, 0xea, 0x26, 0x57, 0xef, 0xc2, 0x91, 0x1c, 0x9f, 0x42, 0x7f,
-	0x02, 0x26, 0xaa, 0xd2, 0x6e, 0xb7, 0xd9, 0x2f, 0x5a, 0xfb, 0xab, 0x99, 0x60, 0xf2, 0x22, 0xfc,
-	0x57, 0x6e, 0xb4, 0x12, 0x36, 0x02, 0x87, 0xc6, 0xdb, 0x08, 0xb5, 0x68, 0x4d, 0xa0, 0x75, 0x55,
-	0x19, 0xf5, 0x8f, 0xb3, 0x27, 0xe2, 0x16, 0x77, 0xf9, 0x20, 0x27, 0xa2, 0x6b, 0x47, 0x85, 0x3d,
-	0xef, 0xe8, 0x7b, 0x2d, 0x7b, 0x0c, 0x64, 0x7b, 0x35, 0xe4, 0xeb, 0x50, 0xac, 0x73, 0x37, 0x5d,
-	0xd0, 0x7c, 0xee, 0x82, 0x6e, 0x37, 0x58, 0x83, 0x39, 0xb7, 0x18, 0xe7, 0x59, 0x8e, 0x31, 0xef,
-	0xf9, 0xad, 0xe9, 0x07, 0x0d, 0x66, 0x11, 0xe3, 0x4d, 0x2a, 0x18, 0x17, 0xb9, 0x44, 0x05, 0x4e,
-	0xc7, 0x26, 0xf6, 0xb1, 0xc0, 0x91, 0x5b, 0x98, 0x83, 0x71, 0xc9, 0x62, 0x35, 0x6c, 0x04, 0x42,
-	0xad, 0x00, 0xd0, 0x74, 0x2d, 0xb1, 0x74, 0x31, 0x39, 0xbc, 0x67, 0x26, 0x1f, 0x6a, 0x70, 0x34,
-	0x1f, 0xa5, 0xe2, 0xd3, 0x82, 0x03, 0x3e, 0xba, 0x24, 0x52, 0x3b, 0x43, 0xee, 0xc9, 0xfe, 0xe4,
-	0xde, 0xf4, 0xb8, 0xb0, 0x26, 0xfd, 0xce, 0xda, 0xcf, 0x8f, 0xe3, 0x4b, 0x50, 0x46, 0xf0, 0x77,
-	0xa8, 0xef, 0x39, 0x54, 0x84, 0xf1, 0x4d, 0x6f, 0x93, 0x55, 0xb7, 0xab, 0x7e, 0x3a, 0x2b, 0x39,
-	0x02, 0xfb, 0x9a, 0xd4, 0xb7, 0xa9, 0xe3, 0xc4, 0x48, 0xf2, 0x98, 0xf5, 0x9f, 0x26, 0xf5, 0xaf,
-	0x3a, 0x4e, 0xac, 0x7f, 0xae, 0xc1, 0x5c, 0xcf, 0x6c, 0x35, 0x7d, 0xef, 0x74, 0x72, 0x43, 0xba,
-	0x7c, 0x6f, 0x93, 0xcd, 0x14, 0x90, 0x8f, 0x85, 0x5c, 0x3e, 0xee, 0x50, 0xff, 0x1d, 0x41, 0x05,
-	0x7b, 0x2f, 0x72, 0xa8, 0x68, 0x8f, 0x91, 0xd4, 0x49, 0xfa, 0xe9, 0x97, 0x15, 0x8a, 0xeb, 0xcc,
-	0x67, 0x2e, 0x8e, 0x95, 0x37, 0x84, 0xc3, 0x3a, 0x51, 0x38, 0x4c, 0x0e, 0xe1, 0xc2, 0xf1, 0xde,
-	0xd9, 0x6a, 0x88, 0x6b, 0x32, 0x1d, 0x91, 0xca, 0x77, 0xf1, 0x74, 0x2e, 0xd2, 0xbc, 0x1a, 0x49,
-	0x23, 0x84, 0xf9, 0x49, 0xf6, 0x55, 0x4c, 0x66, 0x62, 0xe2, 0x5f, 0xbd, 0xf2, 0xbf, 0x6a, 0xea,
-	0xd9, 0xeb, 0x00, 0xd0, 0xba, 0xf4, 0xd0, 0x4c, 0x97, 0x98, 0x9e, 0xce, 0x72, 0xaf, 0x6d, 0xc8,
-	0x30, 0x2b, 0x93, 0x41, 0xce, 0x00, 0x11, 0xa1, 0xa0, 0xbe, 0xdd, 0x0c, 0x85, 0x17, 0xb8, 0x76,
-	0x14, 0x7e, 0xc4, 0x62, 0x04, 0x3b, 0x6c, 0x4d, 0xa1, 0xe7, 0x0e, 0x3a, 0x36, 0x12, 0x7b, 0xd7,
-	0xf1, 0x1d, 0xde, 0xfb, 0xf1, 0x7d, 0x58, 0x80, 0x89, 0xce, 0x27, 0xfa, 0xff, 0xb0, 0xbf, 0x45,
-	0x65, 0x85, 0xc5, 0x8a, 0xcd, 0xf1, 0x94, 0xcd, 0x0a, 0x8b, 0xc9, 0x0a, 0x1c, 0xee, 0x78, 0xc5,
-	0x6d, 0x2f, 0x10, 0x2c, 0x6e, 0x52, 0x5f, 0xbd, 0x12, 0x07, 0xb3, 0xcf, 0xf9, 0xba, 0xf2, 0x25,
-	0x13, 0x6e, 0x7a, 0x31, 0x17, 0x76, 0xc5, 0x0f, 0xab, 0x1f, 0xda, 0x35, 0xe6, 0xb9, 0x35, 0x81,
-	0xd8, 0x8b, 0xd6, 0x14, 0x7a, 0x56, 0x13, 0xc7, 0x1a, 0xda, 0xc9, 0x1a, 0x4c, 0xfa, 0xb4, 0x15,
-	0x9c, 0xa8, 0xa0, 0x99, 0x22, 0x8e, 0x59, 0x32, 0xa4, 0x02, 0x32, 0x52, 0x89, 0x64, 0xbc, 0x9b,
-	0x4a, 0xa4, 0xd5, 0xe2, 0x83, 0x3f, 0xe7, 0x34, 0x6b, 0x22, 0x49, 0xc4, 0x5a, 0x89, 0x87, 0x2c,
-	0xc2, 0x34, 0x67, 0xd4, 0x67, 0xb1, 0x4d, 0xa3, 0xc8, 0xae, 0x51, 0x5e, 0xb3, 0x6b, 0x6c, 0x6b,
-	0x66, 0x04, 0x4f, 0xf1, 0x94, 0x74, 0x5d, 0x8d, 0xa2, 0x35, 0xca, 0x6b, 0x6b, 0x6c, 0x8b, 0xcc,
-	0xc3, 0x01, 0x15, 0xae, 0x70, 0x52, 0x5e, 0x9b, 0x19, 0xc5, 0xe0, 0x49, 0xe9, 0x90, 0x30, 0x29,
-	0xaf, 0xe9, 0x3f, 0x69, 0xf8, 0x0d, 0xda, 0xf9, 0x92, 0x93, 0x69, 0x18, 0x11, 0x5b, 0xb6, 0xe7,
-	0xa8, 0xcb, 0x52, 0x14, 0x5b, 0xeb, 0x0e, 0x39, 0x04, 0xa3, 0x75, 0xee, 0x26, 0xd6, 0x02, 0x5a,
-	0x47, 0xea, 0xdc, 0x5d, 0x77, 0x12, 0xc6, 0x73, 0x28, 0x19, 0xaf, 0x64, 0xd8, 0xb8, 0x02, 0xb0,
-	0x07, 0x22, 0xc6, 0x2a, 0x2d, 0x12, 0xa6, 0x60, 0xb8, 0xce, 0x5d, 0x35, 0x74, 0xf2, 0x53, 0x6f,
-	0xc2, 0x81, 0x1d, 0xef, 0xe4, 0x20, 0xcb, 0x4f, 0xbf, 0x6e, 0x85, 0xbd, 0x7d, 0xdd, 0xf4, 0x6f,
-	0x34, 0x38, 0x9c, 0xff, 0x20, 0x91, 0x63, 0x00, 0x3c, 0x31, 0xdb, 0x0e, 0xe3, 0x55, 0xc5, 0xdc,
-	0x18, 0x5a, 0xae, 0x33, 0x5e, 0xdd, 0xc1, 0x53, 0xa1, 0x1f, 0x4f, 0xc3, 0xbb, 0xe6, 0xe9, 0xdc,
-	0xe3, 0x71, 0x18, 0xc1, 0x3b, 0x4e, 0x3e, 0xd5, 0x60, 0x54, 0x2a, 0x51, 0x72, 0xaa, 0xd7, 0x90,
-	0x5d, 0xb2, 0xb7, 0x74, 0xba, 0x7f, 0xa0, 0x1c, 0x55, 0x3f, 0xf1, 0xd9, 0x6f, 0x7f, 0x7f, 0x59,
-	0x38, 0x46, 0x66, 0xcd, 0xde, 0x2a, 0x9c, 0x7c, 0xa5, 0xc1, 0x58, 0x4b, 0xb9, 0x92, 0xf9, 0xde,
-	0xc5, 0xbb, 0x45, 0x71, 0x69, 0x61, 0xa0, 0x58, 0x85, 0x65, 0x09, 0xb1, 0x2c, 0x90, 0x97, 0xcc,
-	0x9e, 0x7a, 0x9f, 0x9b, 0xf7, 0x5a, 0xe7, 0xe2, 0xb5, 0xf9, 0xfb, 0xe4, 0x0b, 0x0d, 0xa0, 0x2d,
-	0x4e, 0x49, 0xbf, 0x76, 0x59, 0x95, 0x5c, 0x3a, 0x33, 0x58, 0xf0, 0x40, 0x44, 0x29, 0x61, 0xfb,
-	0xb5, 0x06, 0xfb, 0xb3, 0x7a, 0x93, 0x2c, 0xf6, 0xee, 0x91, 0xa3, 0x59, 0x4b, 0xc6, 0xa0, 0xe1,
-	0x0a, 0xd4, 0x3c, 0x82, 0x7a, 0x81, 0xe8, 0xb9, 0xa0, 0x3a, 0xde, 0x46, 0xf2, 0x5d, 0xba, 0x44,
-	0xd4, 0x1d, 0xfd, 0x96, 0x98, 0x91, 0x67, 0x7d, 0x97, 0x98, 0x15, 0x49, 0xfa, 0x45, 0x84, 0xb4,
-	0x42, 0xce, 0x0d, 0xbc, 0x44, 0xb3, 0x2e, 0xef, 0x27, 0x27, 0x3f, 0x6a, 0x30, 0xd9, 0x25, 0xbe,
-	0xc8, 0xd9, 0xde, 0xcd, 0xf3, 0xd5, 0x64, 0x69, 0x69, 0x17, 0x19, 0x0a, 0xf4, 0x32, 0x82, 0x5e,
-	0x24, 0x0b, 0xcf, 0x00, 0x7d, 0x51, 0x4a, 0xb7, 0x36, 0xda, 0x9f, 0x35, 0x20, 0x3b, 0xf5, 0x12,
-	0x59, 0xee, 0xdd, 0xbe, 0xa7, 0x36, 0x2b, 0xad, 0xec, 0x2e, 0x49, 0xc1, 0xbe, 0x84, 0xb0, 0xcf,
-	0x93, 0xe5, 0x5c, 0xd8, 0xad, 0x8f, 0x3a, 0xca, 0x1d, 0xcc, 0x34, 0xef, 0xa5, 0x12, 0xee, 0x3e,
-	0xf9, 0x45, 0x83, 0xe9, 0x1c, 0x99, 0x43, 0x9e, 0x01, 0xa5, 0xb7, 0x2e, 0x2b, 0x9d, 0xdf, 0x65,
-	0x96, 0x9a, 0xe0, 0x32, 0x4e, 0xf0, 0x32, 0x59, 0xc9, 0x9d, 0xc0, 0x69, 0x65, 0x66, 0x47, 0x48,
-	0xf5, 0xdf, 0xfd, 0xe4, 0xbc, 0x8c, 0x67, 0x34, 0x10, 0xe9, 0x77, 0xa3, 0x3b, 0xb4, 0x5a, 0x69,
-	0x71, 0xc0, 0x68, 0x05, 0xf5, 0x0a, 0x42, 0xbd, 0x40, 0x5e, 0x19, 0xfc, 0x60, 0xb7, 0x37, 0xc0,
-	0x99, 0x58, 0x7d, 0xeb, 0xd1, 0x93, 0xb2, 0xf6, 0xf8, 0x49, 0x59, 0xfb, 0xeb, 0x49, 0x59, 0x7b,
-	0xf0, 0xb4, 0x3c, 0xf4, 0xf8, 0x69, 0x79, 0xe8, 0xf7, 0xa7, 0xe5, 0xa1, 0xf7, 0xcf, 0xba, 0x9e,
-	0xa8, 0x35, 0x2a, 0x46, 0x35, 0xac, 0xa7, 0xc5, 0xab, 0x35, 0xea, 0x05, 0xad, 0x4e, 0x5b, 0xed,
-	0x5e, 0x62, 0x3b, 0x62, 0xbc, 0x32, 0x8a, 0xdf, 0x90, 0xe5, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xad, 0xee, 0x6f, 0xab, 0xf3, 0x11, 0x00, 0x00,
+	// 1583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x73, 0x14, 0x45,
+	0x14, 0xce, 0x6c, 0x36, 0xbf, 0x5e, 0x08, 0x09, 0x9d, 0x00, 0x61, 0x03, 0x1b, 0x18, 0x14, 0x62,
+	0x42, 0x76, 0x08, 0x09, 0x28, 0x10, 0xa0, 0x08, 0x88, 0x89, 0x05, 0x56, 0x18, 0x91, 0x83, 0x97,
+	0xb1, 0x77, 0xa7, 0x33, 0x3b, 0xe5, 0xec, 0xcc, 0x30, 0xdd, 0xbb, 0x6e, 0x0a, 0x63, 0x59, 0x96,
+	0x47, 0x0e, 0x54, 0x79, 0xb0, 0x2c, 0xab, 0x2c, 0x2d, 0x8f, 0xfc, 0x01, 0x1e, 0xf4, 0x60, 0x95,
+	0x17, 0x8e, 0x58, 0x5e, 0x3c, 0x29, 0x05, 0x56, 0x79, 0xf5, 0xea, 0xcd, 0x9a, 0xee, 0x9e, 0xcd,
+	0xec, 0x66, 0x26, 0xbb, 0x49, 0xa5, 0xbc, 0xed, 0xbe, 0x7e, 0x5f, 0xbf, 0xef, 0x7d, 0x6f, 0xba,
+	0xfb, 0x3d, 0x98, 0x2c, 0xe2, 0xe2, 0xba, 0xe3, 0xb9, 0x1a, 0xf1, 0xbd, 0x52, 0xd9, 0x76, 0x2d,
+	0xad, 0x36, 0xa7, 0x3d, 0xa8, 0x92, 0x60, 0xbd, 0xe0, 0x07, 0x1e, 0xf3, 0xd0, 0xa8, 0x74, 0x28,
+	0x44, 0x0e, 0x85, 0xda, 0x5c, 0x6e, 0xcc, 0xf2, 0x2c, 0x8f, 0xaf, 0x6b, 0xe1, 0x2f, 0xe1, 0x9a,
+	0x9b, 0xb4, 0x3c, 0xcf, 0x72, 0x88, 0xc6, 0xff, 0x15, 0xab, 0x6b, 0x1a, 0xb3, 0x2b, 0x84, 0x32,
+	0x5c, 0xf1, 0xa5, 0xc3, 0x51, 0xe9, 0x80, 0x7d, 0x5b, 0xc3, 0xae, 0xeb, 0x31, 0xcc, 0x6c, 0xcf,
+	0xa5, 0x72, 0x75, 0xba, 0xe4, 0xd1, 0x8a, 0x47, 0xb5, 0x22, 0xa6, 0x44, 0x50, 0xd0, 0x6a, 0x73,
+	0x45, 0xc2, 0xf0, 0x9c, 0xe6, 0x63, 0xcb, 0x76, 0xb9, 0xb3, 0xf4, 0x3d, 0x9e, 0x44, 0xdb, 0xc7,
+	0x01, 0xae, 0x44, 0xbb, 0xa9, 0x49, 0x1e, 0x8d, 0x1c, 0xb8, 0x8f, 0x3a, 0x06, 0xe8, 0x6e, 0x18,
+	0x67, 0x95, 0x03, 0x75, 0xf2, 0xa0, 0x4a, 0x28, 0x53, 0x57, 0x61, 0xb4, 0xc9, 0x4a, 0x7d, 0xcf,
+	0xa5, 0x04, 0x5d, 0x84, 0x5e, 0x11, 0x60, 0x5c, 0x39, 0xae, 0x4c, 0x0d, 0x9e, 0x9b, 0x28, 0x24,
+	0x28, 0x53, 0x10, 0xa0, 0xa5, 0xec, 0xd3, 0x3f, 0x26, 0xbb, 0x74, 0x09, 0x50, 0x17, 0xe0, 0x20,
+	0xdf, 0xf1, 0xcd, 0xd0, 0x71, 0xc5, 0x5d, 0xf3, 0x64, 0x28, 0x34, 0x01, 0x03, 0x1c, 0x6c, 0xb8,
+	0xd5, 0x0a, 0xdf, 0x36, 0xab, 0xf7, 0x73, 0xc3, 0x3b, 0xd5, 0x8a, 0xaa, 0xc3, 0xa1, 0x56, 0x94,
+	0xa4, 0xf2, 0x06, 0xf4, 0x70, 0x2f, 0xc9, 0x44, 0x4d, 0x64, 0xc2, 0x61, 0x11, 0x44, 0x17, 0x00,
+	0xf5, 0x83, 0xf8, 0x9e, 0x34, 0x4e, 0xe5, 0x16, 0xc0, 0xa6, 0xca, 0x72, 0xe3, 0x53, 0x05, 0x51,
+	0x92, 0x42, 0x58, 0x92, 0x82, 0xf8, 0x2a, 0x64, 0x49, 0x0a, 0xab, 0xd8, 0x22, 0x12, 0xab, 0xc7,
+	0x90, 0xea, 0x37, 0x0a, 0x1c, 0xde, 0x12, 0x42, 0xf2, 0xbe, 0x04, 0xbd, 0x9c, 0x46, 0x28, 0x61,
+	0x77, 0x87, 0xc4, 0x25, 0x02, 0xbd, 0xd5, 0xc4, 0x2f, 0xc3, 0xf9, 0x9d, 0x6e, 0xcb, 0x4f, 0x6e,
+	0x12, 0x27, 0x98, 0x83, 0x71, 0xce, 0xef, 0x46, 0x35, 0x08, 0x88, 0xcb, 0x64, 0x34, 0x51, 0x7a,
+	0x0b, 0x8e, 0x24, 0xac, 0x49, 0xf6, 0x27, 0x61, 0xa8, 0x24, 0xec, 0xc6, 0xa6, 0xfa, 0x59, 0x7d,
+	0x5f, 0x29, 0xe6, 0x8c, 0x5e, 0x85, 0xfd, 0xa2, 0xa2, 0x45, 0xaf, 0xea, 0x9a, 0x38, 0x58, 0xe7,
+	0x54, 0xb3, 0xfa, 0x10, 0xb7, 0x2e, 0x49, 0xa3, 0xfa, 0x71, 0xfc, 0x8b, 0xb8, 0x43, 0x2d, 0xda,
+	0xc9, 0x17, 0xd1, 0x52, 0xa3, 0xcc, 0xae, 0x6b, 0xf4, 0x9d, 0x12, 0xff, 0x0c, 0x44, 0x78, 0x99,
+	0xe4, 0x55, 0xc8, 0x56, 0xa8, 0x15, 0x15, 0x68, 0x3a, 0xb1, 0x40, 0x77, 0xab, 0xa4, 0x4a, 0xcc,
+	0x3b, 0x84, 0xd2, 0xb8, 0xc6, 0x1c, 0xb7, 0x77, 0x65, 0xfa, 0x5e, 0x81, 0x09, 0xce, 0xf1, 0x36,
+	0x66, 0x84, 0xb2, 0x44, 0xa1, 0x5c, 0xb3, 0xa9, 0x12, 0xfd, 0xc4, 0x35, 0x45, 0x15, 0x26, 0x61,
+	0x50, 0xa8, 0x58, 0xf2, 0xaa, 0x2e, 0x93, 0x25, 0x00, 0x6e, 0xba, 0x11, 0x5a, 0x5a, 0x94, 0xec,
+	0xde, 0xb5, 0x92, 0x3f, 0x2a, 0x70, 0x34, 0x99, 0xa5, 0xd4, 0x53, 0x87, 0x03, 0x0e, 0x5f, 0x12,
+	0x4c, 0x8d, 0x98, 0xb8, 0xa7, 0xda, 0x8b, 0x7b, 0xdb, 0xa6, 0x4c, 0x1f, 0x76, 0x9a, 0xf7, 0xde,
+	0x3b, 0x8d, 0x2f, 0x43, 0x9e, 0x93, 0xbf, 0x8f, 0x1d, 0xdb, 0xc4, 0xcc, 0x0b, 0x6e, 0xdb, 0x6b,
+	0xa4, 0xb4, 0x5e, 0x72, 0xa2, 0x5c, 0xd1, 0x11, 0xe8, 0xaf, 0x61, 0xc7, 0xc0, 0xa6, 0x19, 0x70,
+	0x91, 0x07, 0xf4, 0xbe, 0x1a, 0x76, 0xae, 0x9b, 0x66, 0xa0, 0x7e, 0xae, 0xc0, 0x64, 0x2a, 0x5a,
+	0x66, 0x9f, 0x0e, 0x47, 0xb7, 0xc4, 0x92, 0x63, 0xaf, 0x91, 0xf1, 0x0c, 0xd7, 0x63, 0x26, 0x51,
+	0x8f, 0xfb, 0xd8, 0x79, 0x97, 0x61, 0x46, 0xde, 0xf3, 0x4d, 0xcc, 0x36, 0xd3, 0x08, 0xf7, 0x09,
+	0xe3, 0xa9, 0x8b, 0x92, 0xc5, 0x4d, 0xe2, 0x10, 0x8b, 0xa7, 0x95, 0x94, 0x84, 0x49, 0x9a, 0x59,
+	0x98, 0x44, 0x24, 0x61, 0xc1, 0xf1, 0x74, 0xb4, 0x4c, 0xe2, 0x86, 0x80, 0x73, 0xa6, 0xe2, 0x5e,
+	0x9c, 0x4a, 0x64, 0x9a, 0xb4, 0x47, 0x18, 0x88, 0xd3, 0xbc, 0x2c, 0x03, 0xc9, 0xf2, 0x52, 0x4b,
+	0x27, 0xb4, 0xea, 0x30, 0xba, 0xb4, 0x7e, 0xaf, 0x1e, 0xf1, 0x3c, 0x0c, 0x7d, 0xac, 0x6e, 0x94,
+	0x31, 0x2d, 0x4b, 0x9a, 0xbd, 0xac, 0xbe, 0x8c, 0x69, 0x59, 0xb5, 0xe0, 0xc4, 0x36, 0x60, 0x49,
+	0x73, 0x09, 0xfa, 0x02, 0x61, 0x96, 0xdf, 0xd7, 0x54, 0x47, 0x87, 0xb7, 0xea, 0x30, 0x3d, 0x02,
+	0xaa, 0x8f, 0x14, 0x38, 0x9d, 0x12, 0x49, 0x66, 0xe7, 0x05, 0xed, 0x55, 0xdd, 0xb3, 0x7b, 0xea,
+	0x07, 0x05, 0xa6, 0xda, 0xd3, 0xd9, 0xbb, 0xfc, 0xf7, 0xee, 0x64, 0x7d, 0x12, 0x7f, 0x04, 0xc3,
+	0x4f, 0x98, 0xb0, 0xff, 0xf5, 0x86, 0xff, 0x55, 0x91, 0xaf, 0x5c, 0x13, 0x81, 0xc6, 0x1d, 0x0f,
+	0xb5, 0xe8, 0xcc, 0x46, 0x62, 0xe5, 0xd3, 0x0e, 0x9f, 0x70, 0xd3, 0x63, 0x08, 0x74, 0x06, 0x10,
+	0xf3, 0x18, 0x76, 0x8c, 0x9a, 0xc7, 0x6c, 0xd7, 0x32, 0x7c, 0xef, 0x23, 0x12, 0x70, 0xb2, 0xdd,
+	0xfa, 0x08, 0x5f, 0xb9, 0xcf, 0x17, 0x56, 0x43, 0x7b, 0x8b, 0xa6, 0xdd, 0xbb, 0xd7, 0xf4, 0xef,
+	0x0c, 0x0c, 0x35, 0xbf, 0xc8, 0x27, 0x60, 0x5f, 0x43, 0xca, 0x22, 0x09, 0xa4, 0x9a, 0x83, 0x91,
+	0x9a, 0x45, 0x12, 0xa0, 0x05, 0x38, 0xd4, 0xf4, 0x68, 0x1b, 0xb6, 0xcb, 0x48, 0x50, 0xc3, 0x8e,
+	0x7c, 0x14, 0xc6, 0xe2, 0xaf, 0xf7, 0x8a, 0x5c, 0x0b, 0x33, 0x5c, 0xb3, 0x03, 0xca, 0x8c, 0xa2,
+	0xe3, 0x95, 0x3e, 0x34, 0xca, 0xc4, 0xb6, 0xca, 0x8c, 0x73, 0xcf, 0xea, 0x23, 0x7c, 0x65, 0x29,
+	0x5c, 0x58, 0xe6, 0x76, 0xb4, 0x0c, 0xc3, 0x0e, 0x6e, 0x38, 0x87, 0x4d, 0xef, 0x78, 0x96, 0xa7,
+	0x99, 0x2b, 0x88, 0x86, 0xb7, 0x10, 0x75, 0xc4, 0x85, 0x7b, 0x51, 0x47, 0xbc, 0x94, 0x7d, 0xfc,
+	0xe7, 0xa4, 0xa2, 0x0f, 0x85, 0x40, 0xbe, 0x57, 0xb8, 0x82, 0x66, 0x61, 0x94, 0x12, 0xec, 0x90,
+	0xc0, 0xc0, 0xbe, 0xcf, 0x6f, 0x02, 0xa3, 0x4c, 0xea, 0xe3, 0x3d, 0xfc, 0x78, 0x8d, 0x88, 0xa5,
+	0xeb, 0xbe, 0x1f, 0x5e, 0x0a, 0xcb, 0xa4, 0x8e, 0xa6, 0xe1, 0x80, 0x74, 0x97, 0x3c, 0xc3, 0xab,
+	0xa3, 0x97, 0x3b, 0x0f, 0x8b, 0x05, 0x41, 0x13, 0xd3, 0x72, 0xe8, 0x1b, 0x23, 0x29, 0x33, 0xea,
+	0xe3, 0x19, 0x0d, 0x37, 0x48, 0x88, 0x84, 0xd4, 0x7f, 0x14, 0xde, 0x9e, 0x6c, 0x7d, 0xe4, 0xd1,
+	0x28, 0xf4, 0xb0, 0xba, 0x61, 0x9b, 0xf2, 0xc4, 0x67, 0x59, 0x7d, 0xc5, 0x44, 0x07, 0xa1, 0xb7,
+	0x42, 0xad, 0xd0, 0x9a, 0xe1, 0xd6, 0x9e, 0x0a, 0xb5, 0x56, 0xcc, 0xb0, 0x3a, 0x09, 0xf2, 0x0d,
+	0x16, 0x63, 0xca, 0x5d, 0x03, 0xd8, 0x85, 0x68, 0x03, 0xc5, 0x86, 0x60, 0x23, 0xd0, 0x5d, 0xa1,
+	0x96, 0x14, 0x28, 0xfc, 0x89, 0xc6, 0xa1, 0xcf, 0x0a, 0xb0, 0xcb, 0x08, 0x91, 0x4a, 0x44, 0x7f,
+	0xc3, 0x83, 0xc7, 0x69, 0xba, 0x26, 0xa9, 0xcb, 0xcc, 0xfb, 0x43, 0xa6, 0xe1, 0x7f, 0xb5, 0x06,
+	0x07, 0xb6, 0xbc, 0xbc, 0x9d, 0x7c, 0x5f, 0x51, 0xbf, 0x94, 0xd9, 0x5d, 0xbf, 0xa4, 0x7e, 0xad,
+	0xc0, 0xa1, 0xe4, 0x27, 0x0e, 0x1d, 0x03, 0xa0, 0xa1, 0xd9, 0x30, 0x09, 0x2d, 0x49, 0xc1, 0x07,
+	0xb8, 0xe5, 0x26, 0xa1, 0xa5, 0x2d, 0xf2, 0x66, 0xda, 0xc9, 0xdb, 0xbd, 0x63, 0x79, 0xcf, 0xfd,
+	0xbb, 0x1f, 0x7a, 0xf8, 0x35, 0x82, 0x3e, 0x55, 0xa0, 0x57, 0xcc, 0x36, 0xe8, 0x74, 0x5a, 0x92,
+	0x2d, 0x83, 0x54, 0x6e, 0xaa, 0xbd, 0xa3, 0x48, 0x55, 0x3d, 0xf9, 0xd9, 0x6f, 0x7f, 0x7d, 0x91,
+	0x39, 0x86, 0x26, 0xb4, 0xf4, 0xb9, 0x0e, 0x7d, 0xa9, 0xc0, 0x40, 0x63, 0x16, 0x42, 0xd3, 0xe9,
+	0x9b, 0xb7, 0x8e, 0x59, 0xb9, 0x99, 0x8e, 0x7c, 0x25, 0x97, 0x39, 0xce, 0x65, 0x06, 0xbd, 0xa6,
+	0xa5, 0x4e, 0x90, 0x54, 0x7b, 0xd8, 0xf8, 0x2e, 0xae, 0x4c, 0x6f, 0xa0, 0x47, 0x0a, 0xc0, 0xe6,
+	0xb8, 0x83, 0xda, 0x85, 0x8b, 0xcf, 0x5d, 0xb9, 0x33, 0x9d, 0x39, 0x77, 0x24, 0x94, 0x1c, 0x95,
+	0xbe, 0x52, 0x60, 0x5f, 0x7c, 0x82, 0x41, 0xb3, 0xe9, 0x31, 0x12, 0xa6, 0xa0, 0x5c, 0xa1, 0x53,
+	0x77, 0x49, 0x6a, 0x9a, 0x93, 0x7a, 0x05, 0xa9, 0x89, 0xa4, 0x9a, 0xae, 0x5f, 0xf4, 0x6d, 0x54,
+	0x44, 0xde, 0xc9, 0xb6, 0x2b, 0x62, 0xac, 0xe1, 0x6f, 0x5b, 0xc4, 0x78, 0xdb, 0xad, 0x5e, 0xe2,
+	0x94, 0x16, 0xd0, 0xb9, 0x8e, 0x8b, 0xa8, 0x55, 0xc4, 0xf9, 0xa4, 0xe8, 0x89, 0x02, 0xc3, 0x2d,
+	0xed, 0x3c, 0x3a, 0x9b, 0x1e, 0x3c, 0x79, 0x3e, 0xc9, 0xcd, 0xed, 0x00, 0x21, 0x49, 0xcf, 0x73,
+	0xd2, 0xb3, 0x68, 0x66, 0x1b, 0xd2, 0x97, 0xc4, 0x30, 0xb0, 0xc9, 0xf6, 0x27, 0x05, 0xd0, 0xd6,
+	0x0e, 0x1c, 0xcd, 0xa7, 0x87, 0x4f, 0xed, 0xf6, 0x73, 0x0b, 0x3b, 0x03, 0x49, 0xda, 0x97, 0x39,
+	0xed, 0xf3, 0x68, 0x3e, 0x91, 0x76, 0xa3, 0x6f, 0xe0, 0x0d, 0x34, 0x47, 0x6a, 0x0f, 0xa3, 0xa1,
+	0x60, 0x03, 0xfd, 0xac, 0xc0, 0x68, 0x42, 0xe3, 0x8c, 0xb6, 0xa1, 0x92, 0xde, 0xe9, 0xe7, 0xce,
+	0xef, 0x10, 0x25, 0x33, 0x58, 0xe4, 0x19, 0x5c, 0x40, 0x0b, 0x89, 0x19, 0x98, 0x0d, 0x64, 0x3c,
+	0x85, 0xa8, 0xf7, 0xdd, 0x40, 0xbf, 0x28, 0x30, 0x96, 0xd4, 0x99, 0xa3, 0x6d, 0xd8, 0x6c, 0x33,
+	0x06, 0xe4, 0x2e, 0xec, 0x14, 0xd6, 0x51, 0x16, 0x0f, 0x38, 0x34, 0x9c, 0x3f, 0x0d, 0xd9, 0xed,
+	0x6a, 0xac, 0xae, 0x3d, 0x94, 0x03, 0xc7, 0x06, 0x7a, 0x2e, 0xe6, 0xed, 0xb4, 0x36, 0x1b, 0x2d,
+	0xee, 0x84, 0x55, 0xeb, 0xb0, 0x90, 0xbb, 0xb2, 0x4b, 0xb4, 0x4c, 0xed, 0x26, 0x4f, 0xed, 0x2a,
+	0x5a, 0xec, 0x34, 0x35, 0x33, 0xda, 0x22, 0x5e, 0xa8, 0x27, 0x0a, 0x0c, 0xc6, 0xfa, 0x61, 0xd4,
+	0xee, 0xea, 0x6d, 0xea, 0xdb, 0x73, 0xb3, 0x1d, 0x7a, 0x4b, 0xca, 0xd7, 0x38, 0xe5, 0x8b, 0xe8,
+	0xf5, 0xce, 0x6f, 0xa0, 0xcd, 0xa3, 0x42, 0x09, 0x5b, 0x7a, 0xfb, 0xe9, 0x8b, 0xbc, 0xf2, 0xec,
+	0x45, 0x5e, 0x79, 0xfe, 0x22, 0xaf, 0x3c, 0x7e, 0x99, 0xef, 0x7a, 0xf6, 0x32, 0xdf, 0xf5, 0xfb,
+	0xcb, 0x7c, 0xd7, 0xfb, 0x67, 0x2d, 0x9b, 0x95, 0xab, 0xc5, 0x42, 0xc9, 0xab, 0x44, 0x9b, 0x97,
+	0xca, 0xd8, 0x76, 0x1b, 0x91, 0xea, 0x9b, 0xb1, 0xd8, 0xba, 0x4f, 0x68, 0xb1, 0x97, 0x3f, 0xf6,
+	0xf3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x2a, 0x63, 0x94, 0xee, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1389,6 +1640,12 @@ type QueryClient interface {
 	ValidatorLifecycle(ctx context.Context, in *QueryValidatorLifecycleRequest, opts ...grpc.CallOption) (*QueryValidatorLifecycleResponse, error)
 	// DelegationLifecycle queries the lifecycle of a given delegation
 	DelegationLifecycle(ctx context.Context, in *QueryDelegationLifecycleRequest, opts ...grpc.CallOption) (*QueryDelegationLifecycleResponse, error)
+	// QueuedMsgResultsByTx queries the execution results of the queued messages
+	// in a given tx
+	QueuedMsgResultsByTx(ctx context.Context, in *QueryQueuedMsgResultsByTxRequest, opts ...grpc.CallOption) (*QueryQueuedMsgResultsByTxResponse, error)
+	// QueuedMsgResultsByDelegator queries the execution results of the queued
+	// messages of a given delegator
+	QueuedMsgResultsByDelegator(ctx context.Context, in *QueryQueuedMsgResultsByDelegatorRequest, opts ...grpc.CallOption) (*QueryQueuedMsgResultsByDelegatorResponse, error)
 	// EpochValSet queries the validator set of a given epoch
 	EpochValSet(ctx context.Context, in *QueryEpochValSetRequest, opts ...grpc.CallOption) (*QueryEpochValSetResponse, error)
 }
@@ -1473,6 +1730,24 @@ func (c *queryClient) DelegationLifecycle(ctx context.Context, in *QueryDelegati
 	return out, nil
 }
 
+func (c *queryClient) QueuedMsgResultsByTx(ctx context.Context, in *QueryQueuedMsgResultsByTxRequest, opts ...grpc.CallOption) (*QueryQueuedMsgResultsByTxResponse, error) {
+	out := new(QueryQueuedMsgResultsByTxResponse)
+	err := c.cc.Invoke(ctx, "/babylon.epoching.v1.Query/QueuedMsgResultsByTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedMsgResultsByDelegator(ctx context.Context, in *QueryQueuedMsgResultsByDelegatorRequest, opts ...grpc.CallOption) (*QueryQueuedMsgResultsByDelegatorResponse, error) {
+	out := new(QueryQueuedMsgResultsByDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/babylon.epoching.v1.Query/QueuedMsgResultsByDelegator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EpochValSet(ctx context.Context, in *QueryEpochValSetRequest, opts ...grpc.CallOption) (*QueryEpochValSetResponse, error) {
 	out := new(QueryEpochValSetResponse)
 	err := c.cc.Invoke(ctx, "/babylon.epoching.v1.Query/EpochValSet", in, out, opts...)
@@ -1503,6 +1778,12 @@ type QueryServer interface {
 	ValidatorLifecycle(context.Context, *QueryValidatorLifecycleRequest) (*QueryValidatorLifecycleResponse, error)
 	// DelegationLifecycle queries the lifecycle of a given delegation
 	DelegationLifecycle(context.Context, *QueryDelegationLifecycleRequest) (*QueryDelegationLifecycleResponse, error)
+	// QueuedMsgResultsByTx queries the execution results of the queued messages
+	// in a given tx
+	QueuedMsgResultsByTx(context.Context, *QueryQueuedMsgResultsByTxRequest) (*QueryQueuedMsgResultsByTxResponse, error)
+	// QueuedMsgResultsByDelegator queries the execution results of the queued
+	// messages of a given delegator
+	QueuedMsgResultsByDelegator(context.Context, *QueryQueuedMsgResultsByDelegatorRequest) (*QueryQueuedMsgResultsByDelegatorResponse, error)
 	// EpochValSet queries the validator set of a given epoch
 	EpochValSet(context.Context, *QueryEpochValSetRequest) (*QueryEpochValSetResponse, error)
 }
@@ -1535,6 +1816,12 @@ func (*UnimplementedQueryServer) ValidatorLifecycle(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) DelegationLifecycle(ctx context.Context, req *QueryDelegationLifecycleRequest) (*QueryDelegationLifecycleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationLifecycle not implemented")
 }
+func (*UnimplementedQueryServer) QueuedMsgResultsByTx(ctx context.Context, req *QueryQueuedMsgResultsByTxRequest) (*QueryQueuedMsgResultsByTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedMsgResultsByTx not implemented")
+}
+func (*UnimplementedQueryServer) QueuedMsgResultsByDelegator(ctx context.Context, req *QueryQueuedMsgResultsByDelegatorRequest) (*QueryQueuedMsgResultsByDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedMsgResultsByDelegator not implemented")
+}
 func (*UnimplementedQueryServer) EpochValSet(ctx context.Context, req *QueryEpochValSetRequest) (*QueryEpochValSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochValSet not implemented")
 }
@@ -1687,6 +1974,42 @@ func _Query_DelegationLifecycle_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueuedMsgResultsByTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedMsgResultsByTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedMsgResultsByTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.epoching.v1.Query/QueuedMsgResultsByTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedMsgResultsByTx(ctx, req.(*QueryQueuedMsgResultsByTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedMsgResultsByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedMsgResultsByDelegatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedMsgResultsByDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.epoching.v1.Query/QueuedMsgResultsByDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedMsgResultsByDelegator(ctx, req.(*QueryQueuedMsgResultsByDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EpochValSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryEpochValSetRequest)
 	if err := dec(in); err != nil {
@@ -1741,6 +2064,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegationLifecycle",
 			Handler:    _Query_DelegationLifecycle_Handler,
 		},
+		{
+			MethodName: "QueuedMsgResultsByTx",
+			Handler:    _Query_QueuedMsgResultsByTx_Handler,
+		},
+		{
+			MethodName: "QueuedMsgResultsByDelegator",
+			Handler:    _Query_QueuedMsgResultsByDelegator_Handler,
+		},
 		{
 			MethodName: "EpochValSet",
 			Handler:    _Query_EpochValSet_Handler,
@@ -2331,7 +2662,7 @@ func (m *QueryDelegationLifecycleResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochValSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryQueuedMsgResultsByTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2341,37 +2672,27 @@ func (m *QueryEpochValSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEpochValSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryQueuedMsgResultsByTxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEpochValSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryQueuedMsgResultsByTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.EpochNum != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochValSetResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryQueuedMsgResultsByTxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2381,37 +2702,20 @@ func (m *QueryEpochValSetResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEpochValSetResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryQueuedMsgResultsByTxResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEpochValSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryQueuedMsgResultsByTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TotalVotingPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalVotingPower))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2425,7 +2729,7 @@ func (m *QueryEpochValSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *EpochResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryQueuedMsgResultsByDelegatorRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2435,37 +2739,227 @@ func (m *EpochResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EpochResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryQueuedMsgResultsByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryQueuedMsgResultsByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SealerBlockHash) > 0 {
-		i -= len(m.SealerBlockHash)
-		copy(dAtA[i:], m.SealerBlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SealerBlockHash)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x12
 	}
-	if len(m.SealerAppHashHex) > 0 {
-		i -= len(m.SealerAppHashHex)
-		copy(dAtA[i:], m.SealerAppHashHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SealerAppHashHex)))
-		i--
+	if len(m.DelAddr) > 0 {
+		i -= len(m.DelAddr)
+		copy(dAtA[i:], m.DelAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedMsgResultsByDelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedMsgResultsByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedMsgResultsByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochValSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochValSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochValSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochValSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochValSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochValSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastBlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SealerBlockHash) > 0 {
+		i -= len(m.SealerBlockHash)
+		copy(dAtA[i:], m.SealerBlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SealerBlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SealerAppHashHex) > 0 {
+		i -= len(m.SealerAppHashHex)
+		copy(dAtA[i:], m.SealerAppHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SealerAppHashHex)))
+		i--
 		dAtA[i] = 0x2a
 	}
 	if m.LastBlockTime != nil {
-		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastBlockTime):])
-		if err12 != nil {
-			return 0, err12
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastBlockTime):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n12
-		i = encodeVarintQuery(dAtA, i, uint64(n12))
+		i -= n14
+		i = encodeVarintQuery(dAtA, i, uint64(n14))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -2507,6 +3001,18 @@ func (m *QueuedMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Msg) > 0 {
 		i -= len(m.Msg)
 		copy(dAtA[i:], m.Msg)
@@ -2515,12 +3021,12 @@ func (m *QueuedMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if m.BlockTime != nil {
-		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
-		if err13 != nil {
-			return 0, err13
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n13
-		i = encodeVarintQuery(dAtA, i, uint64(n13))
+		i -= n15
+		i = encodeVarintQuery(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -2609,12 +3115,12 @@ func (m *ValStateUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.BlockTime != nil {
-		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
-		if err14 != nil {
-			return 0, err14
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
+		if err16 != nil {
+			return 0, err16
 		}
-		i -= n14
-		i = encodeVarintQuery(dAtA, i, uint64(n14))
+		i -= n16
+		i = encodeVarintQuery(dAtA, i, uint64(n16))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2876,6 +3382,70 @@ func (m *QueryDelegationLifecycleResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryQueuedMsgResultsByTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedMsgResultsByTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryQueuedMsgResultsByDelegatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedMsgResultsByDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryEpochValSetRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2941,6 +3511,9 @@ func (m *EpochResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.LastBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastBlockHeight))
+	}
 	return n
 }
 
@@ -2969,6 +3542,13 @@ func (m *QueuedMessageResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MsgIndex))
+	}
 	return n
 }
 
@@ -4483,7 +5063,7 @@ func (m *QueryDelegationLifecycleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochValSetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQueuedMsgResultsByTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4506,7 +5086,411 @@ func (m *QueryEpochValSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochValSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueuedMsgResultsByTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedMsgResultsByTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedMsgResultsByTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedMsgResultsByTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedMsgResultsByTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &QueuedMessageResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedMsgResultsByDelegatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedMsgResultsByDelegatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedMsgResultsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedMsgResultsByDelegatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedMsgResultsByDelegatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedMsgResultsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &QueuedMessageResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochValSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochValSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: QueryEpochValSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
@@ -4913,6 +5897,25 @@ func (m *EpochResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.SealerBlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
+			}
+			m.LastBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5114,6 +6117,57 @@ func (m *QueuedMessageResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txFeeKey is the context key of the fee of the tx being executed
+type txFeeKey struct{}
+
+// ContextWithTxFee returns a copy of the context carrying the given fee of the
+// tx being executed in the bond denom
+func ContextWithTxFee(ctx sdk.Context, fee uint64) sdk.Context {
+	return ctx.WithValue(txFeeKey{}, fee)
+}
+
+// TxFeeFromContext returns the fee of the tx being executed in the bond denom,
+// or 0 if the context does not carry any fee, e.g., at genesis
+func TxFeeFromContext(ctx context.Context) uint64 {
+	fee, ok := sdk.UnwrapSDKContext(ctx).Value(txFeeKey{}).(uint64)
+	if !ok {
+		return 0
+	}
+	return fee
+}
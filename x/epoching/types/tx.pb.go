@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -340,6 +341,101 @@ func (m *MsgWrappedCancelUnbondingDelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWrappedCancelUnbondingDelegationResponse proto.InternalMessageInfo
 
+// MsgWrappedExec is the message for a grantee to queue staking messages on
+// behalf of their signers. Each message is queued as if it were wrapped and
+// sent by its signer, after being accepted by the authz grant from the signer
+// to the grantee.
+type MsgWrappedExec struct {
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// msgs are the staking messages to queue, i.e., MsgDelegate, MsgUndelegate,
+	// MsgBeginRedelegate and MsgCancelUnbondingDelegation
+	Msgs []*types1.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *MsgWrappedExec) Reset()         { *m = MsgWrappedExec{} }
+func (m *MsgWrappedExec) String() string { return proto.CompactTextString(m) }
+func (*MsgWrappedExec) ProtoMessage()    {}
+func (*MsgWrappedExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5fc8fed8f4e58b6, []int{8}
+}
+func (m *MsgWrappedExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrappedExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrappedExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWrappedExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrappedExec.Merge(m, src)
+}
+func (m *MsgWrappedExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrappedExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrappedExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWrappedExec proto.InternalMessageInfo
+
+func (m *MsgWrappedExec) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgWrappedExec) GetMsgs() []*types1.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// MsgWrappedExecResponse is the response to the MsgWrappedExec message
+type MsgWrappedExecResponse struct {
+}
+
+func (m *MsgWrappedExecResponse) Reset()         { *m = MsgWrappedExecResponse{} }
+func (m *MsgWrappedExecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWrappedExecResponse) ProtoMessage()    {}
+func (*MsgWrappedExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5fc8fed8f4e58b6, []int{9}
+}
+func (m *MsgWrappedExecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrappedExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrappedExecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWrappedExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrappedExecResponse.Merge(m, src)
+}
+func (m *MsgWrappedExecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrappedExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrappedExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWrappedExecResponse proto.InternalMessageInfo
+
 // MsgUpdateParams defines a message for updating epoching module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -357,7 +453,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5fc8fed8f4e58b6, []int{8}
+	return fileDescriptor_a5fc8fed8f4e58b6, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +504,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5fc8fed8f4e58b6, []int{9}
+	return fileDescriptor_a5fc8fed8f4e58b6, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,6 +542,8 @@ func init() {
 	proto.RegisterType((*MsgWrappedBeginRedelegateResponse)(nil), "babylon.epoching.v1.MsgWrappedBeginRedelegateResponse")
 	proto.RegisterType((*MsgWrappedCancelUnbondingDelegation)(nil), "babylon.epoching.v1.MsgWrappedCancelUnbondingDelegation")
 	proto.RegisterType((*MsgWrappedCancelUnbondingDelegationResponse)(nil), "babylon.epoching.v1.MsgWrappedCancelUnbondingDelegationResponse")
+	proto.RegisterType((*MsgWrappedExec)(nil), "babylon.epoching.v1.MsgWrappedExec")
+	proto.RegisterType((*MsgWrappedExecResponse)(nil), "babylon.epoching.v1.MsgWrappedExecResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.epoching.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.epoching.v1.MsgUpdateParamsResponse")
 }
@@ -453,44 +551,50 @@ func init() {
 func init() { proto.RegisterFile("babylon/epoching/v1/tx.proto", fileDescriptor_a5fc8fed8f4e58b6) }
 
 var fileDescriptor_a5fc8fed8f4e58b6 = []byte{
-	// 585 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6f, 0x12, 0x41,
-	0x18, 0xc6, 0x77, 0x6d, 0x6d, 0xd2, 0x57, 0x63, 0x75, 0x25, 0x16, 0x56, 0xb2, 0x20, 0x68, 0x54,
-	0xb4, 0xbb, 0x52, 0xb5, 0x6a, 0xe3, 0x41, 0xd1, 0x78, 0x30, 0x21, 0x31, 0x98, 0xc6, 0xc4, 0xc4,
-	0x98, 0x59, 0x76, 0x32, 0x6c, 0x60, 0x67, 0xd6, 0x9d, 0x69, 0x53, 0x4e, 0x36, 0x9e, 0x3c, 0x7a,
-	0xf0, 0x6c, 0xfa, 0x11, 0x7a, 0xf0, 0x43, 0xf4, 0xd8, 0x78, 0xf2, 0x64, 0x0c, 0x1c, 0xea, 0x07,
-	0xf0, 0x03, 0x18, 0xf6, 0x2f, 0x02, 0x0b, 0xe8, 0x8d, 0xe1, 0x7d, 0xde, 0xe7, 0xf9, 0xc1, 0x3e,
-	0x3b, 0x90, 0x37, 0x91, 0xd9, 0xed, 0x30, 0x6a, 0x60, 0x97, 0x35, 0x5b, 0x36, 0x25, 0xc6, 0x4e,
-	0xd5, 0x10, 0xbb, 0xba, 0xeb, 0x31, 0xc1, 0x94, 0xf3, 0xe1, 0x54, 0x8f, 0xa6, 0xfa, 0x4e, 0x55,
-	0xcd, 0x10, 0x46, 0x98, 0x3f, 0x37, 0x06, 0x9f, 0x02, 0xa9, 0x5a, 0x68, 0x32, 0xee, 0x30, 0x6e,
-	0x70, 0x81, 0xda, 0x81, 0x8d, 0x89, 0x05, 0x4a, 0xbc, 0xd4, 0xe2, 0xa4, 0x24, 0x17, 0x79, 0xc8,
-	0xe1, 0xa1, 0x22, 0x17, 0x58, 0xbc, 0x0d, 0xbc, 0x83, 0x43, 0x38, 0x5a, 0x0d, 0xdd, 0x1d, 0xee,
-	0xaf, 0x39, 0x9c, 0x04, 0x83, 0xd2, 0x1b, 0x50, 0xea, 0x9c, 0xbc, 0xf2, 0x90, 0xeb, 0x62, 0xeb,
-	0x29, 0xee, 0x60, 0x82, 0x04, 0x56, 0xee, 0xc2, 0x82, 0xc3, 0x49, 0x56, 0x2e, 0xca, 0xd7, 0x4e,
-	0xad, 0x97, 0xf5, 0xd0, 0x2a, 0x44, 0xd3, 0x43, 0x34, 0xbd, 0xce, 0x49, 0xb4, 0xd1, 0x18, 0xe8,
-	0x37, 0xcf, 0x7e, 0xdc, 0x2f, 0x48, 0xbf, 0xf6, 0x0b, 0xd2, 0x87, 0xe3, 0x83, 0xca, 0xe0, 0x9b,
-	0x52, 0x1e, 0xd4, 0x71, 0xfb, 0x06, 0xe6, 0x2e, 0xa3, 0x1c, 0x97, 0x10, 0x64, 0x92, 0xe9, 0x16,
-	0xb5, 0xa2, 0xf8, 0x7b, 0xc3, 0xf1, 0x57, 0xa6, 0xc4, 0x27, 0x3b, 0x69, 0x00, 0x1a, 0xe4, 0x27,
-	0x45, 0xc4, 0x08, 0x6d, 0xc8, 0x25, 0xf3, 0x1a, 0x26, 0x36, 0x6d, 0xe0, 0x98, 0xe3, 0xe1, 0x30,
-	0x47, 0x65, 0x0a, 0xc7, 0xc8, 0x62, 0x1a, 0x4c, 0x19, 0x2e, 0xa5, 0x86, 0xc5, 0x44, 0xef, 0xa1,
-	0x9c, 0x88, 0x9e, 0x20, 0xda, 0xc4, 0x9d, 0x2d, 0x6a, 0x32, 0x6a, 0xd9, 0x34, 0xfa, 0xbb, 0x6d,
-	0x46, 0x95, 0x67, 0xc3, 0x6c, 0x77, 0xa6, 0xb0, 0xa5, 0x5a, 0xa4, 0x51, 0xae, 0xc1, 0x8d, 0x39,
-	0x00, 0x62, 0xde, 0xcf, 0x32, 0xac, 0x0c, 0x1e, 0x85, 0x6b, 0x21, 0x81, 0x5f, 0xf8, 0x7d, 0x54,
-	0x36, 0x60, 0x19, 0x6d, 0x8b, 0x16, 0xf3, 0x6c, 0xd1, 0xf5, 0x11, 0x97, 0x6b, 0xd9, 0x6f, 0x5f,
-	0xd7, 0x32, 0x21, 0xe5, 0x63, 0xcb, 0xf2, 0x30, 0xe7, 0x2f, 0x85, 0x67, 0x53, 0xd2, 0x48, 0xa4,
-	0xca, 0x03, 0x58, 0x0a, 0x1a, 0x9d, 0x3d, 0xe1, 0xff, 0xae, 0x8b, 0xfa, 0x84, 0x17, 0x48, 0x0f,
-	0x42, 0x6a, 0x8b, 0x87, 0x3f, 0x0a, 0x52, 0x23, 0x5c, 0xd8, 0x3c, 0x33, 0xe0, 0x4f, 0xac, 0x4a,
-	0x39, 0x58, 0x1d, 0xa1, 0x8a, 0x88, 0xd7, 0x7f, 0x2f, 0xc2, 0x42, 0x9d, 0x13, 0xa5, 0x0d, 0x2b,
-	0xa3, 0xc5, 0xbf, 0x3a, 0x31, 0x70, 0xbc, 0xc2, 0xaa, 0x31, 0xa7, 0x30, 0x0a, 0x55, 0xde, 0xc1,
-	0xb9, 0xf1, 0xa2, 0x5f, 0x9f, 0xe1, 0x92, 0x48, 0xd5, 0xea, 0xdc, 0xd2, 0x38, 0x72, 0x4f, 0x86,
-	0x0b, 0x29, 0xcd, 0xd6, 0x67, 0xb8, 0x8d, 0xe8, 0xd5, 0x8d, 0x7f, 0xd3, 0xc7, 0x08, 0x5f, 0x64,
-	0x28, 0xce, 0xac, 0xf2, 0xfd, 0x19, 0xe6, 0xa9, 0x9b, 0xea, 0xa3, 0xff, 0xdd, 0x8c, 0x01, 0x4d,
-	0x38, 0xfd, 0x57, 0x73, 0x2f, 0xa7, 0x39, 0x0e, 0xab, 0xd4, 0x9b, 0xf3, 0xa8, 0xa2, 0x0c, 0xf5,
-	0xe4, 0xde, 0xf1, 0x41, 0x45, 0xae, 0x3d, 0x3f, 0xec, 0x69, 0xf2, 0x51, 0x4f, 0x93, 0x7f, 0xf6,
-	0x34, 0xf9, 0x53, 0x5f, 0x93, 0x8e, 0xfa, 0x9a, 0xf4, 0xbd, 0xaf, 0x49, 0xaf, 0x6f, 0x11, 0x5b,
-	0xb4, 0xb6, 0x4d, 0xbd, 0xc9, 0x1c, 0x23, 0x34, 0x6e, 0xb6, 0x90, 0x4d, 0xa3, 0x83, 0xb1, 0x9b,
-	0x5c, 0xfa, 0xa2, 0xeb, 0x62, 0x6e, 0x2e, 0xf9, 0xb7, 0xf7, 0xed, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xdf, 0xdb, 0xcd, 0x7f, 0x7f, 0x06, 0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4d, 0x4f, 0x13, 0x4f,
+	0x1c, 0xc7, 0xbb, 0x3c, 0xfd, 0xc3, 0x0f, 0x02, 0x7f, 0xd7, 0x06, 0xca, 0x42, 0xb6, 0xb5, 0xd5,
+	0x88, 0x20, 0xb3, 0x52, 0x15, 0x95, 0x78, 0x90, 0x2a, 0x1e, 0x4c, 0x48, 0x4c, 0x0d, 0x31, 0x31,
+	0x31, 0x64, 0xb6, 0x1d, 0xa7, 0x1b, 0xba, 0x33, 0xeb, 0xce, 0x42, 0xe8, 0x49, 0xe2, 0xc9, 0xa3,
+	0x07, 0x2f, 0x5e, 0x0c, 0x2f, 0x81, 0x03, 0x2f, 0x82, 0x78, 0x22, 0x26, 0x26, 0x9e, 0x8c, 0x81,
+	0x03, 0xbe, 0x0c, 0xb3, 0xcf, 0xa5, 0x74, 0xd9, 0xea, 0x8d, 0xe1, 0xf7, 0x7d, 0xf8, 0x6c, 0x3b,
+	0xbf, 0x2d, 0xcc, 0xe8, 0x58, 0x6f, 0x35, 0x39, 0xd3, 0x88, 0xc5, 0x6b, 0x0d, 0x83, 0x51, 0x6d,
+	0x7b, 0x51, 0x73, 0x76, 0x90, 0x65, 0x73, 0x87, 0xcb, 0x97, 0x83, 0x29, 0x0a, 0xa7, 0x68, 0x7b,
+	0x51, 0xc9, 0x52, 0x4e, 0xb9, 0x37, 0xd7, 0xdc, 0xbf, 0x7c, 0xa9, 0x92, 0xaf, 0x71, 0x61, 0x72,
+	0xa1, 0x09, 0x07, 0x6f, 0xfa, 0x31, 0x3a, 0x71, 0x70, 0x9c, 0xa5, 0x14, 0xba, 0x35, 0x59, 0xd8,
+	0xc6, 0xa6, 0x08, 0x14, 0x53, 0x7e, 0xc4, 0x86, 0x9f, 0xed, 0x1f, 0x82, 0xd1, 0x64, 0x90, 0x6e,
+	0x0a, 0xcf, 0x66, 0x0a, 0x1a, 0x7a, 0x28, 0xe7, 0xb4, 0x49, 0x34, 0xef, 0xa4, 0x6f, 0xbd, 0xd1,
+	0x30, 0x6b, 0xf9, 0xa3, 0xe2, 0x6b, 0x90, 0xd7, 0x04, 0x7d, 0x69, 0x63, 0xcb, 0x22, 0xf5, 0x27,
+	0xa4, 0x49, 0x28, 0x76, 0x88, 0x7c, 0x17, 0xfa, 0x4d, 0x41, 0x73, 0x52, 0x41, 0x9a, 0x1d, 0x29,
+	0x97, 0x50, 0xd0, 0x12, 0x50, 0xa3, 0x80, 0x1a, 0xad, 0x09, 0x1a, 0x3a, 0xaa, 0xae, 0x7e, 0xf9,
+	0xff, 0x0f, 0x7b, 0xf9, 0xcc, 0xef, 0xbd, 0x7c, 0xe6, 0xfd, 0xe9, 0xfe, 0x9c, 0xfb, 0x9f, 0xe2,
+	0x0c, 0x28, 0xe7, 0xe3, 0xab, 0x44, 0x58, 0x9c, 0x09, 0x52, 0xc4, 0x90, 0x8d, 0xa7, 0xeb, 0xac,
+	0x1e, 0xd6, 0xdf, 0x6b, 0xaf, 0xbf, 0x76, 0x41, 0x7d, 0xec, 0x49, 0x02, 0x50, 0x61, 0xa6, 0x5b,
+	0x45, 0x84, 0xb0, 0x09, 0x53, 0xf1, 0xbc, 0x42, 0xa8, 0xc1, 0xaa, 0x24, 0xe2, 0x78, 0xd8, 0xce,
+	0x31, 0x77, 0x01, 0x47, 0x87, 0x31, 0x09, 0xa6, 0x04, 0x57, 0x12, 0xcb, 0x22, 0xa2, 0x77, 0x50,
+	0x8a, 0x45, 0x8f, 0x31, 0xab, 0x91, 0xe6, 0x3a, 0xd3, 0x39, 0xab, 0x1b, 0x2c, 0xfc, 0xb8, 0x0d,
+	0xce, 0xe4, 0xa7, 0xed, 0x6c, 0x77, 0x2e, 0x60, 0x4b, 0x8c, 0x48, 0xa2, 0x5c, 0x80, 0xf9, 0x1e,
+	0x00, 0x22, 0xde, 0xcf, 0x12, 0x8c, 0xc5, 0xfa, 0xd5, 0x1d, 0x52, 0x93, 0xcb, 0xf0, 0x1f, 0xb5,
+	0x31, 0x73, 0x08, 0xf1, 0xf8, 0x86, 0x2b, 0xb9, 0x6f, 0x07, 0x0b, 0xd9, 0x00, 0x71, 0xa5, 0x5e,
+	0xb7, 0x89, 0x10, 0x2f, 0x1c, 0xdb, 0x60, 0xb4, 0x1a, 0x0a, 0xe5, 0x55, 0x18, 0x30, 0x05, 0x15,
+	0xb9, 0xbe, 0x42, 0xff, 0xec, 0x48, 0x39, 0x8b, 0xfc, 0x2b, 0x8b, 0xc2, 0x2b, 0x8b, 0x56, 0x58,
+	0xab, 0x32, 0xfd, 0xf5, 0x60, 0x21, 0xb8, 0xe4, 0x48, 0xc7, 0x82, 0xb4, 0x3f, 0x66, 0xd5, 0xb3,
+	0x2f, 0x8f, 0xba, 0x8f, 0x11, 0x86, 0x16, 0x73, 0x30, 0x71, 0x16, 0x2d, 0xa2, 0xfe, 0x24, 0xc1,
+	0xb8, 0x7b, 0x81, 0xac, 0x3a, 0x76, 0xc8, 0x73, 0x6f, 0xc1, 0xe4, 0x25, 0x18, 0xc6, 0x5b, 0x4e,
+	0x83, 0xdb, 0x86, 0xd3, 0x4a, 0x05, 0x8f, 0xa5, 0xf2, 0x03, 0x18, 0xf2, 0x57, 0x34, 0xd7, 0xe7,
+	0x7d, 0x1b, 0xd3, 0xa8, 0xcb, 0x1b, 0x01, 0xf9, 0x25, 0x95, 0x81, 0xc3, 0x9f, 0xf9, 0x4c, 0x35,
+	0x30, 0x2c, 0x8f, 0xb9, 0xb8, 0x71, 0x54, 0x71, 0x0a, 0x26, 0x3b, 0xa8, 0x42, 0xe2, 0xf2, 0xf7,
+	0x41, 0xe8, 0x5f, 0x13, 0x54, 0xde, 0x84, 0xf1, 0xce, 0x75, 0xbd, 0xde, 0xb5, 0xf0, 0xfc, 0xe2,
+	0x29, 0x5a, 0x8f, 0xc2, 0xb0, 0x54, 0x7e, 0x0b, 0x97, 0xce, 0xaf, 0xe7, 0x8d, 0x94, 0x94, 0x58,
+	0xaa, 0x2c, 0xf6, 0x2c, 0x8d, 0x2a, 0x77, 0x25, 0x98, 0x48, 0xd8, 0x47, 0x94, 0x92, 0xd6, 0xa1,
+	0x57, 0x96, 0xfe, 0x4e, 0x1f, 0x21, 0x7c, 0x91, 0xa0, 0x90, 0xba, 0x80, 0xf7, 0x53, 0xc2, 0x13,
+	0x9d, 0xca, 0xa3, 0x7f, 0x75, 0x46, 0x80, 0x1b, 0x30, 0xd2, 0xbe, 0x6f, 0xa5, 0x94, 0x40, 0x57,
+	0xa4, 0xcc, 0xf7, 0x20, 0x8a, 0x0a, 0x74, 0x18, 0x3d, 0xb3, 0x1a, 0x57, 0x93, 0xcc, 0xed, 0x2a,
+	0xe5, 0x66, 0x2f, 0xaa, 0xb0, 0x43, 0x19, 0xdc, 0x3d, 0xdd, 0x9f, 0x93, 0x2a, 0xcf, 0x0e, 0x8f,
+	0x55, 0xe9, 0xe8, 0x58, 0x95, 0x7e, 0x1d, 0xab, 0xd2, 0xc7, 0x13, 0x35, 0x73, 0x74, 0xa2, 0x66,
+	0x7e, 0x9c, 0xa8, 0x99, 0x57, 0xb7, 0xa8, 0xe1, 0x34, 0xb6, 0x74, 0x54, 0xe3, 0xa6, 0x16, 0x04,
+	0xd7, 0x1a, 0xd8, 0x60, 0xe1, 0x41, 0xdb, 0x89, 0x7f, 0x26, 0x9d, 0x96, 0x45, 0x84, 0x3e, 0xe4,
+	0xbd, 0x2e, 0x6e, 0xff, 0x19, 0x00, 0xd4, 0xb6, 0xa9, 0x6e, 0xb1, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -517,6 +621,9 @@ type MsgClient interface {
 	// WrappedCancelUnbondingDelegation defines a method for cancelling unbonding of
 	// coins from a delegator and source validator to a destination validator.
 	WrappedCancelUnbondingDelegation(ctx context.Context, in *MsgWrappedCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgWrappedCancelUnbondingDelegationResponse, error)
+	// WrappedExec defines a method for a grantee to queue staking messages on
+	// behalf of their signers, using the authz grants from the signers.
+	WrappedExec(ctx context.Context, in *MsgWrappedExec, opts ...grpc.CallOption) (*MsgWrappedExecResponse, error)
 	// UpdateParams defines a method for updating epoching module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -565,6 +672,15 @@ func (c *msgClient) WrappedCancelUnbondingDelegation(ctx context.Context, in *Ms
 	return out, nil
 }
 
+func (c *msgClient) WrappedExec(ctx context.Context, in *MsgWrappedExec, opts ...grpc.CallOption) (*MsgWrappedExecResponse, error) {
+	out := new(MsgWrappedExecResponse)
+	err := c.cc.Invoke(ctx, "/babylon.epoching.v1.Msg/WrappedExec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/babylon.epoching.v1.Msg/UpdateParams", in, out, opts...)
@@ -588,6 +704,9 @@ type MsgServer interface {
 	// WrappedCancelUnbondingDelegation defines a method for cancelling unbonding of
 	// coins from a delegator and source validator to a destination validator.
 	WrappedCancelUnbondingDelegation(context.Context, *MsgWrappedCancelUnbondingDelegation) (*MsgWrappedCancelUnbondingDelegationResponse, error)
+	// WrappedExec defines a method for a grantee to queue staking messages on
+	// behalf of their signers, using the authz grants from the signers.
+	WrappedExec(context.Context, *MsgWrappedExec) (*MsgWrappedExecResponse, error)
 	// UpdateParams defines a method for updating epoching module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -608,6 +727,9 @@ func (*UnimplementedMsgServer) WrappedBeginRedelegate(ctx context.Context, req *
 func (*UnimplementedMsgServer) WrappedCancelUnbondingDelegation(ctx context.Context, req *MsgWrappedCancelUnbondingDelegation) (*MsgWrappedCancelUnbondingDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WrappedCancelUnbondingDelegation not implemented")
 }
+func (*UnimplementedMsgServer) WrappedExec(ctx context.Context, req *MsgWrappedExec) (*MsgWrappedExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrappedExec not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -688,6 +810,24 @@ func _Msg_WrappedCancelUnbondingDelegation_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WrappedExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWrappedExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WrappedExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.epoching.v1.Msg/WrappedExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WrappedExec(ctx, req.(*MsgWrappedExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -726,6 +866,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WrappedCancelUnbondingDelegation",
 			Handler:    _Msg_WrappedCancelUnbondingDelegation_Handler,
 		},
+		{
+			MethodName: "WrappedExec",
+			Handler:    _Msg_WrappedExec_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -967,6 +1111,73 @@ func (m *MsgWrappedCancelUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWrappedExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrappedExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrappedExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWrappedExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrappedExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrappedExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1129,6 +1340,34 @@ func (m *MsgWrappedCancelUnbondingDelegationResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWrappedExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWrappedExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1703,6 +1942,172 @@ func (m *MsgWrappedCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
+func (m *MsgWrappedExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrappedExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrappedExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWrappedExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrappedExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrappedExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,6 +12,10 @@ import (
 
 func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// prune historical data out of the retention window, if enabled
+	k.PruneHistoricalData(ctx)
+
 	return nil
 }
 
@@ -25,6 +29,8 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, e
 		k.IndexBlock(ctx)
 		// tally all non-finalised blocks
 		k.TallyBlocks(ctx)
+		// check liveness of finality providers and jail inactive ones
+		k.HandleLiveness(ctx)
 	}
 
 	return []abci.ValidatorUpdate{}, nil
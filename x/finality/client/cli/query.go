@@ -35,6 +35,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListBlocks())
 	cmd.AddCommand(CmdVotesAtHeight())
 	cmd.AddCommand(CmdListEvidences())
+	cmd.AddCommand(CmdSigningInfo())
+	cmd.AddCommand(CmdListSigningInfos())
 
 	return cmd
 }
@@ -244,3 +246,61 @@ func CmdListEvidences() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSigningInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-info [fp_btc_pk_hex]",
+		Short: "show the signing info of a given finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SigningInfo(cmd.Context(), &types.QuerySigningInfoRequest{
+				FpBtcPkHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListSigningInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos",
+		Short: "list the signing info of all finality providers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SigningInfos(cmd.Context(), &types.QuerySigningInfosRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signing-infos")
+
+	return cmd
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/store/prefix"
 	btcstk "github.com/babylonchain/babylon/btcstaking"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/finality/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -32,6 +34,14 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		k.SetPubRandCommit(ctx, prc.FpBtcPk, prc.PubRandCommit)
 	}
 
+	for _, signInfo := range gs.SigningInfos {
+		k.SetSigningInfo(ctx, signInfo)
+	}
+
+	for _, missedBlock := range gs.MissedBlocks {
+		k.setMissedBlockBit(ctx, missedBlock.FpBtcPk, missedBlock.Index, true)
+	}
+
 	return k.SetParams(ctx, gs.Params)
 }
 
@@ -62,6 +72,16 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	signInfos, err := k.signingInfos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	missedBlocks, err := k.missedBlocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		Params:           k.GetParams(ctx),
 		IndexedBlocks:    blocks,
@@ -69,6 +89,8 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		VoteSigs:         voteSigs,
 		PublicRandomness: pubRandomness,
 		PubRandCommit:    prCommit,
+		SigningInfos:     signInfos,
+		MissedBlocks:     missedBlocks,
 	}, nil
 }
 
@@ -198,6 +220,50 @@ func (k Keeper) exportPubRandCommit(ctx context.Context) ([]*types.PubRandCommit
 	return commtRandoms, nil
 }
 
+// signingInfos loads the signing info of all finality providers.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) signingInfos(ctx context.Context) ([]*types.FinalityProviderSigningInfo, error) {
+	iter := k.signingInfoStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	signInfos := make([]*types.FinalityProviderSigningInfo, 0)
+	for ; iter.Valid(); iter.Next() {
+		var signInfo types.FinalityProviderSigningInfo
+		if err := k.cdc.Unmarshal(iter.Value(), &signInfo); err != nil {
+			return nil, err
+		}
+		signInfos = append(signInfos, &signInfo)
+	}
+
+	return signInfos, nil
+}
+
+// missedBlocks iterates over the missed block bitmaps of all finality providers,
+// parses the finality provider public key and the index from the iterator key.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) missedBlocks(ctx context.Context) ([]*types.MissedBlock, error) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.MissedBlockBitmapKey)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	missedBlocks := make([]*types.MissedBlock, 0)
+	for ; iter.Valid(); iter.Next() {
+		// key contains the fp and the index
+		fpBTCPK, index, err := parsePubKeyAndBlkHeightFromStoreKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		missedBlocks = append(missedBlocks, &types.MissedBlock{
+			FpBtcPk: fpBTCPK,
+			Index:   index,
+		})
+	}
+
+	return missedBlocks, nil
+}
+
 // parsePubKeyAndBlkHeightFromStoreKey expects to receive a key with
 // BIP340PubKey(fpBTCPK) || BigEndianUint64(blkHeight)
 func parsePubKeyAndBlkHeightFromStoreKey(key []byte) (fpBTCPK *bbn.BIP340PubKey, blkHeight uint64, err error) {
@@ -92,6 +92,9 @@ func (k Keeper) Block(ctx context.Context, req *types.QueryBlockRequest) (*types
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.IsHeightPruned(sdkCtx, req.Height) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", req.Height)
+	}
 	b, err := k.GetBlock(sdkCtx, req.Height)
 	if err != nil {
 		return nil, err
@@ -142,6 +145,9 @@ func (k Keeper) VotesAtHeight(ctx context.Context, req *types.QueryVotesAtHeight
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.IsHeightPruned(sdkCtx, req.Height) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", req.Height)
+	}
 
 	// get the sig set of babylon block at given height
 	btcPks := []bbn.BIP340PubKey{}
@@ -225,3 +231,45 @@ func (k Keeper) ListEvidences(ctx context.Context, req *types.QueryListEvidences
 	}
 	return resp, nil
 }
+
+// SigningInfo returns the signing info of a given finality provider
+func (k Keeper) SigningInfo(ctx context.Context, req *types.QuerySigningInfoRequest) (*types.QuerySigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+
+	signInfo, err := k.GetSigningInfo(ctx, fpBTCPK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySigningInfoResponse{SigningInfo: *signInfo}, nil
+}
+
+// SigningInfos returns the signing info of all finality providers
+func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRequest) (*types.QuerySigningInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var signInfos []types.FinalityProviderSigningInfo
+	store := k.signingInfoStore(ctx)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var signInfo types.FinalityProviderSigningInfo
+		if err := k.cdc.Unmarshal(value, &signInfo); err != nil {
+			return err
+		}
+		signInfos = append(signInfos, signInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySigningInfosResponse{SigningInfos: signInfos, Pagination: pageRes}, nil
+}
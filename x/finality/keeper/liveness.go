@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HandleLiveness checks the liveness of all finality providers that have
+// voting power at the height `FinalitySigTimeout` blocks before the current
+// height, and jails those that miss too many votes in the sliding window.
+//
+// This function is invoked upon each `EndBlock` after tallying blocks, such
+// that finality providers have `FinalitySigTimeout` blocks to vote for a block
+// before their liveness is checked at this block.
+func (k Keeper) HandleLiveness(ctx context.Context) {
+	params := k.GetParams(ctx)
+	// liveness tracking is disabled without a sliding window, e.g., before the
+	// params are migrated
+	if params.SignedBlocksWindow == 0 || params.MinSignedPerWindow.IsNil() {
+		return
+	}
+	curHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	if curHeight <= params.FinalitySigTimeout {
+		return
+	}
+	height := curHeight - params.FinalitySigTimeout
+
+	// liveness is only checked since the BTC staking protocol is activated
+	activatedHeight, err := k.BTCStakingKeeper.GetBTCStakingActivatedHeight(ctx)
+	if err != nil || height < activatedHeight {
+		return
+	}
+	// votes at pruned heights are no longer available
+	if k.IsHeightPruned(ctx, height) {
+		return
+	}
+
+	// sort finality providers to ensure determinism
+	fpSet := k.BTCStakingKeeper.GetVotingPowerTable(ctx, height)
+	fpBTCPKHexList := make([]string, 0, len(fpSet))
+	for fpBTCPKHex := range fpSet {
+		fpBTCPKHexList = append(fpBTCPKHexList, fpBTCPKHex)
+	}
+	sort.Strings(fpBTCPKHexList)
+
+	voterBTCPKs := k.GetVoters(ctx, height)
+	for _, fpBTCPKHex := range fpBTCPKHexList {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+		if err != nil {
+			// failing to unmarshal finality provider BTC PK in KVStore is a programming error
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		_, voted := voterBTCPKs[fpBTCPKHex]
+		if err := k.HandleFinalityProviderLiveness(ctx, fpBTCPK, !voted, height); err != nil {
+			// failing to handle liveness of a finality provider in the voting
+			// power table is a programming error
+			panic(err)
+		}
+	}
+}
+
+// HandleFinalityProviderLiveness updates the missed block bitmap and the
+// signing info of the given finality provider w.r.t. whether it misses to
+// vote for the block at the given height, and jails the finality provider if
+// it misses too many votes in the sliding window
+func (k Keeper) HandleFinalityProviderLiveness(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, missed bool, height uint64) error {
+	fp, err := k.BTCStakingKeeper.GetFinalityProvider(ctx, fpBTCPK.MustMarshal())
+	if err != nil {
+		return err
+	}
+	// the finality provider is already jailed or slashed, and it will be
+	// removed from the voting power table upon the next `BeginBlock`
+	if fp.Jailed || fp.IsSlashed() {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+
+	// initialise the signing info upon the first block the finality provider
+	// has voting power at
+	signInfo, err := k.GetSigningInfo(ctx, fpBTCPK)
+	if err != nil {
+		signInfo = types.NewFinalityProviderSigningInfo(fpBTCPK, height)
+	}
+
+	// compute the relative index, so we count the blocks the finality
+	// provider *should* have voted for
+	index := signInfo.IndexOffset % params.SignedBlocksWindow
+	signInfo.IndexOffset++
+
+	// update the bitmap and the counter
+	previouslyMissed := k.getMissedBlockBit(ctx, fpBTCPK, index)
+	switch {
+	case !previouslyMissed && missed:
+		// the block at this index was previously voted for, now missed
+		k.setMissedBlockBit(ctx, fpBTCPK, index, true)
+		signInfo.MissedBlocksCounter++
+	case previouslyMissed && !missed:
+		// the block at this index was previously missed, now voted for
+		k.setMissedBlockBit(ctx, fpBTCPK, index, false)
+		signInfo.MissedBlocksCounter--
+	default:
+		// the bit does not change
+	}
+
+	if missed {
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Debug(
+			"finality provider missed vote",
+			"height", height,
+			"finality_provider", fpBTCPK.MarshalHex(),
+			"missed", signInfo.MissedBlocksCounter,
+			"threshold", params.MinSignedPerWindowInt(),
+		)
+	}
+
+	// jail the finality provider if it is past the first window and misses
+	// too many votes in the sliding window
+	minHeight := signInfo.StartHeight + params.SignedBlocksWindow
+	maxMissed := params.SignedBlocksWindow - params.MinSignedPerWindowInt()
+	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
+		if err := k.BTCStakingKeeper.JailFinalityProvider(ctx, fpBTCPK.MustMarshal()); err != nil {
+			return err
+		}
+
+		// the finality provider can unjail itself after the jail duration
+		signInfo.JailedUntil = sdk.UnwrapSDKContext(ctx).HeaderInfo().Time.Add(params.JailDuration)
+		// reset the sliding window, such that the finality provider starts
+		// with a clean window once unjailed
+		signInfo.MissedBlocksCounter = 0
+		signInfo.IndexOffset = 0
+		k.clearMissedBlockBitmap(ctx, fpBTCPK)
+
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Info(
+			"finality provider jailed due to missing too many votes",
+			"height", height,
+			"finality_provider", fpBTCPK.MarshalHex(),
+			"jailed_until", signInfo.JailedUntil,
+		)
+
+		k.SetSigningInfo(ctx, signInfo)
+
+		// emit jailing event
+		eventJailing := types.NewEventJailedFinalityProvider(height, signInfo)
+		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(eventJailing); err != nil {
+			panic(fmt.Errorf("failed to emit EventJailedFinalityProvider event: %w", err))
+		}
+
+		return nil
+	}
+
+	k.SetSigningInfo(ctx, signInfo)
+
+	return nil
+}
+
+// unjailFinalityProvider unjails the given finality provider if its jail
+// duration has passed, and restarts tracking its liveness from the current height
+func (k Keeper) unjailFinalityProvider(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) error {
+	fp, err := k.BTCStakingKeeper.GetFinalityProvider(ctx, fpBTCPK.MustMarshal())
+	if err != nil {
+		return err
+	}
+	if !fp.Jailed {
+		return types.ErrFpNotJailed
+	}
+
+	signInfo, err := k.GetSigningInfo(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+	// ensure the jail duration has passed
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.HeaderInfo().Time.Before(signInfo.JailedUntil) {
+		return types.ErrFpStillJailed.Wrapf("jailed until %v", signInfo.JailedUntil)
+	}
+
+	if err := k.BTCStakingKeeper.UnjailFinalityProvider(ctx, fpBTCPK.MustMarshal()); err != nil {
+		return err
+	}
+
+	// the finality provider is given a whole sliding window before its
+	// liveness is checked again
+	signInfo.StartHeight = uint64(sdkCtx.HeaderInfo().Height)
+	k.SetSigningInfo(ctx, signInfo)
+
+	// emit unjailing event
+	eventUnjailing := types.NewEventUnjailedFinalityProvider(signInfo)
+	if err := sdkCtx.EventManager().EmitTypedEvent(eventUnjailing); err != nil {
+		panic(fmt.Errorf("failed to emit EventUnjailedFinalityProvider event: %w", err))
+	}
+
+	return nil
+}
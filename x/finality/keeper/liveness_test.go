@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/keeper"
+	"github.com/babylonchain/babylon/x/finality/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzHandleLiveness(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// use a small sliding window
+		params := fKeeper.GetParams(ctx)
+		params.SignedBlocksWindow = datagen.RandomInt(r, 20) + 10
+		err := fKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		maxMissed := params.SignedBlocksWindow - params.MinSignedPerWindowInt()
+
+		// a finality provider with voting power at all heights that never votes
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		fpBTCPKBytes := fp.BtcPk.MustMarshal()
+		activatedHeight := datagen.RandomInt(r, 10) + 1
+		bsKeeper.EXPECT().GetBTCStakingActivatedHeight(gomock.Any()).Return(activatedHeight, nil).AnyTimes()
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Any()).Return(map[string]uint64{
+			fp.BtcPk.MarshalHex(): 1,
+		}).AnyTimes()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+
+		// the finality provider is jailed right after its first window
+		jailedHeight := activatedHeight + params.SignedBlocksWindow + 1
+		bsKeeper.EXPECT().JailFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).DoAndReturn(
+			func(_ interface{}, _ []byte) error {
+				fp.Jailed = true
+				return nil
+			}).Times(1)
+
+		blockTime := time.Now()
+		for height := activatedHeight; height <= jailedHeight; height++ {
+			ctx = ctx.WithHeaderInfo(header.Info{
+				Height: int64(height + params.FinalitySigTimeout),
+				Time:   blockTime,
+			})
+			fKeeper.HandleLiveness(ctx)
+
+			signInfo, err := fKeeper.GetSigningInfo(ctx, fp.BtcPk)
+			require.NoError(t, err)
+			require.Equal(t, activatedHeight, signInfo.StartHeight)
+			if height < jailedHeight {
+				require.False(t, fp.Jailed)
+				expectedMissed := height - activatedHeight + 1
+				if expectedMissed > params.SignedBlocksWindow {
+					expectedMissed = params.SignedBlocksWindow
+				}
+				require.Equal(t, expectedMissed, signInfo.MissedBlocksCounter)
+			} else {
+				require.True(t, fp.Jailed)
+				require.Greater(t, params.SignedBlocksWindow, maxMissed)
+				require.Zero(t, signInfo.MissedBlocksCounter)
+				require.Zero(t, signInfo.IndexOffset)
+				require.Equal(t, blockTime.Add(params.JailDuration).Unix(), signInfo.JailedUntil.Unix())
+				require.Empty(t, fKeeper.GetMissedBlockIndices(ctx, fp.BtcPk))
+			}
+		}
+
+		// a jailed finality provider's liveness is no longer tracked
+		ctx = ctx.WithHeaderInfo(header.Info{
+			Height: int64(jailedHeight + 1 + params.FinalitySigTimeout),
+			Time:   blockTime,
+		})
+		fKeeper.HandleLiveness(ctx)
+		signInfo, err := fKeeper.GetSigningInfo(ctx, fp.BtcPk)
+		require.NoError(t, err)
+		require.Zero(t, signInfo.IndexOffset)
+
+		// Case 1: only the finality provider can unjail itself
+		_, err = ms.UnjailFinalityProvider(ctx, &types.MsgUnjailFinalityProvider{
+			Signer:  datagen.GenRandomAccount().Address,
+			FpBtcPk: fp.BtcPk,
+		})
+		require.ErrorIs(t, err, types.ErrUnauthorizedSigner)
+
+		// Case 2: the finality provider cannot unjail itself before the jail duration passes
+		_, err = ms.UnjailFinalityProvider(ctx, &types.MsgUnjailFinalityProvider{
+			Signer:  fp.Addr,
+			FpBtcPk: fp.BtcPk,
+		})
+		require.ErrorIs(t, err, types.ErrFpStillJailed)
+
+		// Case 3: the finality provider can unjail itself after the jail duration passes
+		unjailHeight := jailedHeight + params.FinalitySigTimeout + 2
+		ctx = ctx.WithHeaderInfo(header.Info{
+			Height: int64(unjailHeight),
+			Time:   blockTime.Add(params.JailDuration),
+		})
+		bsKeeper.EXPECT().UnjailFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).DoAndReturn(
+			func(_ interface{}, _ []byte) error {
+				fp.Jailed = false
+				return nil
+			}).Times(1)
+		_, err = ms.UnjailFinalityProvider(ctx, &types.MsgUnjailFinalityProvider{
+			Signer:  fp.Addr,
+			FpBtcPk: fp.BtcPk,
+		})
+		require.NoError(t, err)
+		require.False(t, fp.Jailed)
+		signInfo, err = fKeeper.GetSigningInfo(ctx, fp.BtcPk)
+		require.NoError(t, err)
+		require.Equal(t, unjailHeight, signInfo.StartHeight)
+
+		// Case 4: a non-jailed finality provider cannot be unjailed
+		_, err = ms.UnjailFinalityProvider(ctx, &types.MsgUnjailFinalityProvider{
+			Signer:  fp.Addr,
+			FpBtcPk: fp.BtcPk,
+		})
+		require.ErrorIs(t, err, types.ErrFpNotJailed)
+	})
+}
+
+func TestHandleLiveness_SlashedFinalityProvider(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+	fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+
+	fp, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+	fp.SlashedBabylonHeight = 1
+	bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fp.BtcPk.MustMarshal())).Return(fp, nil).AnyTimes()
+
+	// slashed finality providers are not tracked
+	err = fKeeper.HandleFinalityProviderLiveness(ctx, fp.BtcPk, true, 1)
+	require.NoError(t, err)
+	_, err = fKeeper.GetSigningInfo(ctx, fp.BtcPk)
+	require.ErrorIs(t, err, types.ErrSigningInfoNotFound)
+
+	// unknown finality providers cannot be unjailed
+	unknownFpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+	require.NoError(t, err)
+	bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Any()).Return(nil, bstypes.ErrFpNotFound).AnyTimes()
+	_, err = keeper.NewMsgServerImpl(*fKeeper).UnjailFinalityProvider(ctx, &types.MsgUnjailFinalityProvider{
+		Signer:  fp.Addr,
+		FpBtcPk: unknownFpBTCPK,
+	})
+	require.ErrorIs(t, err, bstypes.ErrFpNotFound)
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/finality params from consensus version 1 to 2.
+// Version 1 params only contained the minimum number of public randomness, so
+// the pruning and liveness params decode as zero values, which are set to their
+// defaults. Pruning remains disabled as in version 1.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaults := types.DefaultParams()
+	if params.MaxPrunedHeightsPerBlock == 0 {
+		params.MaxPrunedHeightsPerBlock = defaults.MaxPrunedHeightsPerBlock
+	}
+	if params.FinalitySigTimeout == 0 {
+		params.FinalitySigTimeout = defaults.FinalitySigTimeout
+	}
+	if params.SignedBlocksWindow == 0 {
+		params.SignedBlocksWindow = defaults.SignedBlocksWindow
+	}
+	if params.MinSignedPerWindow.IsNil() {
+		params.MinSignedPerWindow = defaults.MinSignedPerWindow
+	}
+	if params.JailDuration == 0 {
+		params.JailDuration = defaults.JailDuration
+	}
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/finality/keeper"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := testkeeper.FinalityKeeper(t, nil, nil)
+
+	// params as stored by consensus version 1, i.e., only the minimum number
+	// of public randomness
+	var bz []byte
+	bz = protowire.AppendTag(bz, 1, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, 200)
+	storeKey := ctx.MultiStore().(*rootmulti.Store).StoreKeysByName()[types.StoreKey]
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	oldParams := k.GetParams(ctx)
+	require.Zero(t, oldParams.SignedBlocksWindow)
+	require.True(t, oldParams.MinSignedPerWindow.IsNil())
+	require.Error(t, oldParams.Validate())
+
+	// liveness is skipped rather than panicking before the migration
+	require.NotPanics(t, func() { k.HandleLiveness(ctx) })
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+
+	params := k.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	defaults := types.DefaultParams()
+	require.Equal(t, uint64(200), params.MinPubRand)
+	require.Zero(t, params.PruningRetentionBlocks)
+	require.Equal(t, defaults.MaxPrunedHeightsPerBlock, params.MaxPrunedHeightsPerBlock)
+	require.Equal(t, defaults.FinalitySigTimeout, params.FinalitySigTimeout)
+	require.Equal(t, defaults.SignedBlocksWindow, params.SignedBlocksWindow)
+	require.True(t, defaults.MinSignedPerWindow.Equal(params.MinSignedPerWindow))
+	require.Equal(t, defaults.JailDuration, params.JailDuration)
+}
@@ -44,6 +44,30 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// UnjailFinalityProvider unjails a jailed finality provider after its jail duration
+func (ms msgServer) UnjailFinalityProvider(goCtx context.Context, req *types.MsgUnjailFinalityProvider) (*types.MsgUnjailFinalityProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.FpBtcPk == nil {
+		return nil, bstypes.ErrFpNotFound.Wrap("empty finality provider BTC PK")
+	}
+
+	// ensure the signer is the finality provider
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, req.FpBtcPk.MustMarshal())
+	if err != nil {
+		return nil, err
+	}
+	if fp.Addr != req.Signer {
+		return nil, types.ErrUnauthorizedSigner.Wrapf("expected %s, got %s", fp.Addr, req.Signer)
+	}
+
+	if err := ms.unjailFinalityProvider(ctx, req.FpBtcPk); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnjailFinalityProviderResponse{}, nil
+}
+
 // AddFinalitySig adds a new vote to a given block
 func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinalitySig) (*types.MsgAddFinalitySigResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddFinalitySig)
@@ -81,6 +105,10 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 		return nil, types.ErrInvalidFinalitySig.Wrap("empty finality provider BTC PK")
 	}
 	fpPK := req.FpBtcPk
+	// ensure the voted height has not been pruned
+	if ms.IsHeightPruned(ctx, req.BlockHeight) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", req.BlockHeight)
+	}
 	if ms.BTCStakingKeeper.GetVotingPower(ctx, fpPK.MustMarshal(), req.BlockHeight) == 0 {
 		return nil, types.ErrInvalidFinalitySig.Wrapf("the finality provider %v does not have voting power at height %d", fpPK.MustMarshal(), req.BlockHeight)
 	}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PruneHistoricalData prunes indexed blocks, votes, revealed public randomness,
+// voting power tables and rewards history of heights that are out of the
+// retention window.
+//
+// This function is invoked upon each `BeginBlock`. It only prunes heights below
+// the next height to finalise, i.e., heights that are either finalised or
+// non-finalisable, and prunes at most `MaxPrunedHeightsPerBlock` heights per
+// invocation so that the pruning cost is spread over blocks. Evidences and
+// public randomness commitments are never pruned.
+func (k Keeper) PruneHistoricalData(ctx context.Context) {
+	params := k.GetParams(ctx)
+	if params.PruningRetentionBlocks == 0 {
+		// pruning is disabled
+		return
+	}
+
+	curHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	if curHeight <= params.PruningRetentionBlocks {
+		return
+	}
+
+	// start from the next height to prune, or from the height where the BTC
+	// staking protocol is activated if nothing has been pruned yet
+	startHeight := k.getNextHeightToPrune(ctx)
+	if startHeight == 0 {
+		activatedHeight, err := k.BTCStakingKeeper.GetBTCStakingActivatedHeight(ctx)
+		if err != nil {
+			// nothing to prune before the BTC staking protocol is activated
+			return
+		}
+		startHeight = activatedHeight
+	}
+
+	// never prune heights that are within the retention window, or that are
+	// not finalised yet
+	endHeight := curHeight - params.PruningRetentionBlocks
+	if nextHeightToFinalize := k.getNextHeightToFinalize(ctx); nextHeightToFinalize < endHeight {
+		endHeight = nextHeightToFinalize
+	}
+	if startHeight+params.MaxPrunedHeightsPerBlock < endHeight {
+		endHeight = startHeight + params.MaxPrunedHeightsPerBlock
+	}
+	if startHeight >= endHeight {
+		return
+	}
+
+	for height := startHeight; height < endHeight; height++ {
+		k.pruneHeight(ctx, height)
+	}
+	k.setNextHeightToPrune(ctx, endHeight)
+
+	k.Logger(sdk.UnwrapSDKContext(ctx)).Debug(
+		"pruned historical data",
+		"start_height", startHeight,
+		"end_height", endHeight-1,
+	)
+}
+
+// pruneHeight removes the indexed block, votes, revealed public randomness,
+// voting power table and rewards history at the given height
+func (k Keeper) pruneHeight(ctx context.Context, height uint64) {
+	// only finality providers with voting power at this height can vote, thus
+	// revealed public randomness only exists for them
+	fpBTCPKs := map[string]struct{}{}
+	for pkHex := range k.BTCStakingKeeper.GetVotingPowerTable(ctx, height) {
+		fpBTCPKs[pkHex] = struct{}{}
+	}
+	for pkHex := range k.GetVoters(ctx, height) {
+		fpBTCPKs[pkHex] = struct{}{}
+	}
+	for pkHex := range fpBTCPKs {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(pkHex)
+		if err != nil {
+			// failing to unmarshal finality provider BTC PK in KVStore is a programming error
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		k.pubRandFpStore(ctx, fpBTCPK).Delete(sdk.Uint64ToBigEndian(height))
+	}
+
+	k.deleteSigSet(ctx, height)
+	k.blockStore(ctx).Delete(sdk.Uint64ToBigEndian(height))
+	k.BTCStakingKeeper.PruneVotingPowerAtHeight(ctx, height)
+	k.IncentiveKeeper.PruneRewardsAtHeight(ctx, height)
+}
+
+// IsHeightPruned returns whether the data at the given height has been pruned
+func (k Keeper) IsHeightPruned(ctx context.Context, height uint64) bool {
+	return height < k.getNextHeightToPrune(ctx)
+}
+
+// setNextHeightToPrune sets the next height to prune as the given height
+func (k Keeper) setNextHeightToPrune(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	heightBytes := sdk.Uint64ToBigEndian(height)
+	if err := store.Set(types.NextHeightToPruneKey, heightBytes); err != nil {
+		panic(err)
+	}
+}
+
+// getNextHeightToPrune gets the next height to prune
+func (k Keeper) getNextHeightToPrune(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.NextHeightToPruneKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
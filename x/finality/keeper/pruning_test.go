@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzPruneHistoricalData(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper)
+
+		// activate BTC staking protocol at a random height
+		activatedHeight := datagen.RandomInt(r, 10) + 1
+		numBlocks := datagen.RandomInt(r, 50) + 30
+		curHeight := activatedHeight + numBlocks - 1
+
+		// index blocks, each of which has a vote and revealed public randomness
+		fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		randListInfo, err := datagen.GenRandomPubRandList(r, numBlocks)
+		require.NoError(t, err)
+		for i := activatedHeight; i <= curHeight; i++ {
+			fKeeper.SetBlock(ctx, &types.IndexedBlock{
+				Height:    i,
+				AppHash:   datagen.GenRandomByteArray(r, 32),
+				Finalized: false,
+			})
+			sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			fKeeper.SetSig(ctx, i, fpBTCPK, sig)
+			fKeeper.SetPubRand(ctx, fpBTCPK, i, randListInfo.PRList[i-activatedHeight])
+		}
+
+		// none of the blocks has a finality provider set, so tallying makes
+		// all of them non-finalisable
+		ctx = datagen.WithCtxHeight(ctx, curHeight)
+		bsKeeper.EXPECT().GetBTCStakingActivatedHeight(gomock.Any()).Return(activatedHeight, nil).AnyTimes()
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		fKeeper.TallyBlocks(ctx)
+
+		// pruning is disabled by default
+		fKeeper.PruneHistoricalData(ctx)
+		require.False(t, fKeeper.IsHeightPruned(ctx, activatedHeight))
+
+		// enable pruning
+		params := fKeeper.GetParams(ctx)
+		params.PruningRetentionBlocks = datagen.RandomInt(r, 10) + 1
+		params.MaxPrunedHeightsPerBlock = datagen.RandomInt(r, 10) + 1
+		err = fKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		expectedEndHeight := activatedHeight + params.MaxPrunedHeightsPerBlock
+		bsKeeper.EXPECT().PruneVotingPowerAtHeight(gomock.Any(), gomock.Any()).Times(int(params.MaxPrunedHeightsPerBlock))
+		iKeeper.EXPECT().PruneRewardsAtHeight(gomock.Any(), gomock.Any()).Times(int(params.MaxPrunedHeightsPerBlock))
+		fKeeper.PruneHistoricalData(ctx)
+
+		for i := activatedHeight; i <= curHeight; i++ {
+			if i < expectedEndHeight {
+				require.True(t, fKeeper.IsHeightPruned(ctx, i))
+				require.False(t, fKeeper.HasBlock(ctx, i))
+				require.Nil(t, fKeeper.GetSigSet(ctx, i))
+				require.False(t, fKeeper.HasPubRand(ctx, fpBTCPK, i))
+				_, err := fKeeper.Block(ctx, &types.QueryBlockRequest{Height: i})
+				require.ErrorIs(t, err, types.ErrHeightPruned)
+			} else {
+				require.False(t, fKeeper.IsHeightPruned(ctx, i))
+				require.True(t, fKeeper.HasBlock(ctx, i))
+				require.NotNil(t, fKeeper.GetSigSet(ctx, i))
+				require.True(t, fKeeper.HasPubRand(ctx, fpBTCPK, i))
+			}
+		}
+
+		// keep pruning until reaching the retention window
+		bsKeeper.EXPECT().PruneVotingPowerAtHeight(gomock.Any(), gomock.Any()).AnyTimes()
+		iKeeper.EXPECT().PruneRewardsAtHeight(gomock.Any(), gomock.Any()).AnyTimes()
+		for i := uint64(0); i < numBlocks; i++ {
+			fKeeper.PruneHistoricalData(ctx)
+		}
+		retainedFrom := curHeight - params.PruningRetentionBlocks
+		for i := activatedHeight; i <= curHeight; i++ {
+			require.Equal(t, i < retainedFrom, fKeeper.IsHeightPruned(ctx, i))
+			require.Equal(t, i >= retainedFrom, fKeeper.HasBlock(ctx, i))
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetSigningInfo sets the signing info of a finality provider
+func (k Keeper) SetSigningInfo(ctx context.Context, signInfo *types.FinalityProviderSigningInfo) {
+	store := k.signingInfoStore(ctx)
+	store.Set(signInfo.FpBtcPk.MustMarshal(), k.cdc.MustMarshal(signInfo))
+}
+
+// GetSigningInfo gets the signing info of a finality provider
+func (k Keeper) GetSigningInfo(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) (*types.FinalityProviderSigningInfo, error) {
+	store := k.signingInfoStore(ctx)
+	signInfoBytes := store.Get(fpBTCPK.MustMarshal())
+	if len(signInfoBytes) == 0 {
+		return nil, types.ErrSigningInfoNotFound
+	}
+	var signInfo types.FinalityProviderSigningInfo
+	k.cdc.MustUnmarshal(signInfoBytes, &signInfo)
+	return &signInfo, nil
+}
+
+// IterateSigningInfos iterates over the signing info of all finality providers
+func (k Keeper) IterateSigningInfos(ctx context.Context, handler func(signInfo *types.FinalityProviderSigningInfo) (shouldContinue bool)) {
+	iter := k.signingInfoStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var signInfo types.FinalityProviderSigningInfo
+		k.cdc.MustUnmarshal(iter.Value(), &signInfo)
+		if !handler(&signInfo) {
+			return
+		}
+	}
+}
+
+// getMissedBlockBit returns whether the finality provider misses the block
+// at the given index of its missed block bitmap
+func (k Keeper) getMissedBlockBit(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, index uint64) bool {
+	store := k.missedBlockBitmapFpStore(ctx, fpBTCPK)
+	return store.Has(sdk.Uint64ToBigEndian(index))
+}
+
+// setMissedBlockBit sets whether the finality provider misses the block at
+// the given index of its missed block bitmap
+func (k Keeper) setMissedBlockBit(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, index uint64, missed bool) {
+	store := k.missedBlockBitmapFpStore(ctx, fpBTCPK)
+	if missed {
+		store.Set(sdk.Uint64ToBigEndian(index), []byte{1})
+	} else {
+		store.Delete(sdk.Uint64ToBigEndian(index))
+	}
+}
+
+// clearMissedBlockBitmap removes the missed block bitmap of the finality provider
+func (k Keeper) clearMissedBlockBitmap(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) {
+	store := k.missedBlockBitmapFpStore(ctx, fpBTCPK)
+	keys := [][]byte{}
+
+	// using an enclosure to ensure iterator is closed right after
+	// the function is done
+	func() {
+		iter := store.Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+	}()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetMissedBlockIndices returns the indices of the blocks that the finality
+// provider misses in the sliding window
+func (k Keeper) GetMissedBlockIndices(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) []uint64 {
+	store := k.missedBlockBitmapFpStore(ctx, fpBTCPK)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	indices := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		indices = append(indices, sdk.BigEndianToUint64(iter.Key()))
+	}
+	return indices
+}
+
+// signingInfoStore returns the KVStore of the signing info of finality providers
+// prefix: SigningInfoKey
+// key: finality provider BTC PK
+// value: FinalityProviderSigningInfo
+func (k Keeper) signingInfoStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.SigningInfoKey)
+}
+
+// missedBlockBitmapFpStore returns the KVStore of the missed block bitmap of
+// a finality provider, where only missed blocks are stored
+// prefix: MissedBlockBitmapKey || finality provider BTC PK
+// key: index in the sliding window
+// value: 1
+func (k Keeper) missedBlockBitmapFpStore(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	missedBlockBitmapStore := prefix.NewStore(storeAdapter, types.MissedBlockBitmapKey)
+	return prefix.NewStore(missedBlockBitmapStore, fpBTCPK.MustMarshal())
+}
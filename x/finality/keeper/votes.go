@@ -90,6 +90,28 @@ func (k Keeper) GetVoters(ctx context.Context, height uint64) map[string]struct{
 	return voterBTCPKs
 }
 
+// deleteSigSet removes all EOTS signatures at a given height
+func (k Keeper) deleteSigSet(ctx context.Context, height uint64) {
+	store := k.voteHeightStore(ctx, height)
+	keys := [][]byte{}
+
+	// get all keys
+	// using an enclosure to ensure iterator is closed right after
+	// the function is done
+	func() {
+		iter := store.Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+	}()
+
+	// remove all keys
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // voteHeightStore returns the KVStore of the votes
 // prefix: VoteKey
 // key: (block height || finality provider PK)
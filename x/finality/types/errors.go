@@ -17,4 +17,9 @@ var (
 	ErrEvidenceNotFound      = errorsmod.Register(ModuleName, 1108, "evidence is not found")
 	ErrInvalidFinalitySig    = errorsmod.Register(ModuleName, 1109, "finality signature is not valid")
 	ErrNoSlashableEvidence   = errorsmod.Register(ModuleName, 1110, "there is no slashable evidence")
+	ErrHeightPruned          = errorsmod.Register(ModuleName, 1111, "the data at the given height has been pruned")
+	ErrSigningInfoNotFound   = errorsmod.Register(ModuleName, 1112, "signing info of the finality provider is not found")
+	ErrFpNotJailed           = errorsmod.Register(ModuleName, 1113, "the finality provider is not jailed")
+	ErrFpStillJailed         = errorsmod.Register(ModuleName, 1114, "the finality provider is still jailed")
+	ErrUnauthorizedSigner    = errorsmod.Register(ModuleName, 1115, "the signer is not the finality provider")
 )
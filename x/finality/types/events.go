@@ -5,3 +5,16 @@ func NewEventSlashedFinalityProvider(evidence *Evidence) *EventSlashedFinalityPr
 		Evidence: evidence,
 	}
 }
+
+func NewEventJailedFinalityProvider(height uint64, signInfo *FinalityProviderSigningInfo) *EventJailedFinalityProvider {
+	return &EventJailedFinalityProvider{
+		Height:      height,
+		SigningInfo: signInfo,
+	}
+}
+
+func NewEventUnjailedFinalityProvider(signInfo *FinalityProviderSigningInfo) *EventUnjailedFinalityProvider {
+	return &EventUnjailedFinalityProvider{
+		SigningInfo: signInfo,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/babylonchain/babylon/crypto/eots"
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -112,3 +113,24 @@ func (e *Evidence) ExtractBTCSK() (*btcec.PrivateKey, error) {
 		e.forkMsgToSign(), e.ForkFinalitySig.ToModNScalar(), // msg and sig for fork block
 	)
 }
+
+// NewFinalityProviderSigningInfo creates a new signing info of a finality
+// provider, whose liveness is tracked since the given height
+func NewFinalityProviderSigningInfo(fpBTCPK *bbn.BIP340PubKey, startHeight uint64) *FinalityProviderSigningInfo {
+	return &FinalityProviderSigningInfo{
+		FpBtcPk:             fpBTCPK,
+		StartHeight:         startHeight,
+		IndexOffset:         0,
+		MissedBlocksCounter: 0,
+	}
+}
+
+func (si *FinalityProviderSigningInfo) ValidateBasic() error {
+	if si.FpBtcPk == nil {
+		return fmt.Errorf("empty finality provider BTC PK")
+	}
+	if _, err := si.FpBtcPk.ToBTCPK(); err != nil {
+		return fmt.Errorf("invalid finality provider BTC PK: %w", err)
+	}
+	return nil
+}
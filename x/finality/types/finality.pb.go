@@ -8,15 +8,19 @@ import (
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -233,10 +237,91 @@ func (m *Evidence) GetForkAppHash() []byte {
 	return nil
 }
 
+// FinalityProviderSigningInfo defines a finality provider's signing info for
+// monitoring its liveness
+type FinalityProviderSigningInfo struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// start_height is the height from which the liveness of the finality
+	// provider is tracked
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// index_offset is the number of blocks counted in the sliding window
+	// so far, such that the index of the next block in the missed block
+	// bitmap is index_offset mod signed_blocks_window
+	IndexOffset uint64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// missed_blocks_counter is the number of blocks that the finality
+	// provider misses to vote for in the sliding window
+	MissedBlocksCounter uint64 `protobuf:"varint,4,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// jailed_until is the timestamp until which the finality provider is jailed
+	JailedUntil time.Time `protobuf:"bytes,5,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+}
+
+func (m *FinalityProviderSigningInfo) Reset()         { *m = FinalityProviderSigningInfo{} }
+func (m *FinalityProviderSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderSigningInfo) ProtoMessage()    {}
+func (*FinalityProviderSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{3}
+}
+func (m *FinalityProviderSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderSigningInfo.Merge(m, src)
+}
+func (m *FinalityProviderSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderSigningInfo proto.InternalMessageInfo
+
+func (m *FinalityProviderSigningInfo) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *FinalityProviderSigningInfo) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *FinalityProviderSigningInfo) GetMissedBlocksCounter() uint64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *FinalityProviderSigningInfo) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*IndexedBlock)(nil), "babylon.finality.v1.IndexedBlock")
 	proto.RegisterType((*PubRandCommit)(nil), "babylon.finality.v1.PubRandCommit")
 	proto.RegisterType((*Evidence)(nil), "babylon.finality.v1.Evidence")
+	proto.RegisterType((*FinalityProviderSigningInfo)(nil), "babylon.finality.v1.FinalityProviderSigningInfo")
 }
 
 func init() {
@@ -244,37 +329,46 @@ func init() {
 }
 
 var fileDescriptor_ca5b87e52e3e6d02 = []byte{
-	// 474 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x1b, 0x56, 0xda, 0xee, 0x35, 0x13, 0x10, 0xa6, 0xa9, 0x20, 0x94, 0x95, 0x9e, 0x7a,
-	0x40, 0xcd, 0xc6, 0x26, 0xc4, 0x95, 0xa0, 0xa1, 0x0d, 0x0e, 0x54, 0x0e, 0x27, 0x2e, 0x91, 0xe3,
-	0xb8, 0x89, 0xd5, 0xc6, 0xb6, 0x12, 0xa7, 0x5a, 0xf9, 0x14, 0x7c, 0x2c, 0x8e, 0x3b, 0xa2, 0x1d,
-	0x26, 0xd4, 0x7e, 0x0f, 0x84, 0xe2, 0xba, 0xe9, 0x76, 0x02, 0xb1, 0x9b, 0xfd, 0xf7, 0xd3, 0xff,
-	0xf7, 0xfe, 0xcf, 0x36, 0x0c, 0x22, 0x1c, 0x2d, 0x66, 0x82, 0x7b, 0x13, 0xc6, 0xf1, 0x8c, 0xa9,
-	0x85, 0x37, 0x3f, 0xae, 0xd7, 0x23, 0x99, 0x0b, 0x25, 0x9c, 0xa7, 0xa6, 0x66, 0x54, 0xeb, 0xf3,
-	0xe3, 0xe7, 0xfb, 0x89, 0x48, 0x84, 0x3e, 0xf7, 0xaa, 0xd5, 0xba, 0x74, 0x10, 0x82, 0x7d, 0xc1,
-	0x63, 0x7a, 0x49, 0x63, 0x7f, 0x26, 0xc8, 0xd4, 0x39, 0x80, 0x56, 0x4a, 0x59, 0x92, 0xaa, 0x9e,
-	0xd5, 0xb7, 0x86, 0x4d, 0x64, 0x76, 0xce, 0x33, 0xe8, 0x60, 0x29, 0xc3, 0x14, 0x17, 0x69, 0xef,
-	0x41, 0xdf, 0x1a, 0xda, 0xa8, 0x8d, 0xa5, 0x3c, 0xc7, 0x45, 0xea, 0xbc, 0x80, 0xdd, 0x35, 0xe7,
-	0x1b, 0x8d, 0x7b, 0x3b, 0x7d, 0x6b, 0xd8, 0x41, 0x5b, 0x61, 0xa0, 0x60, 0x6f, 0x5c, 0x46, 0x08,
-	0xf3, 0xf8, 0xbd, 0xc8, 0x32, 0xa6, 0x9c, 0x97, 0x60, 0x17, 0x0a, 0xe7, 0x2a, 0xbc, 0xc3, 0xe9,
-	0x6a, 0xed, 0x7c, 0x0d, 0xeb, 0x83, 0xcd, 0xcb, 0x2c, 0x94, 0x65, 0x14, 0xe6, 0x98, 0xc7, 0x1a,
-	0xd8, 0x44, 0xc0, 0xcb, 0xcc, 0x58, 0x39, 0x2e, 0x00, 0xd1, 0x76, 0x19, 0xe5, 0x4a, 0x43, 0x6d,
-	0x74, 0x4b, 0x19, 0xfc, 0xde, 0x81, 0xce, 0xd9, 0x9c, 0xc5, 0x94, 0x13, 0xea, 0x20, 0xd8, 0x9d,
-	0xc8, 0x30, 0x52, 0x24, 0x94, 0x53, 0x8d, 0xb3, 0xfd, 0x37, 0xd7, 0x37, 0x87, 0xaf, 0x13, 0xa6,
-	0xd2, 0x32, 0x1a, 0x11, 0x91, 0x79, 0x66, 0x60, 0x24, 0xc5, 0x8c, 0x6f, 0x36, 0x9e, 0x5a, 0x48,
-	0x5a, 0x8c, 0xfc, 0x8b, 0xf1, 0xc9, 0xe9, 0xd1, 0xb8, 0x8c, 0x3e, 0xd1, 0x05, 0x6a, 0x4f, 0xa4,
-	0xaf, 0xc8, 0x78, 0x5a, 0xa5, 0x88, 0xaa, 0x81, 0x6d, 0x52, 0xac, 0x5b, 0xec, 0x6a, 0xcd, 0xa4,
-	0x08, 0xa0, 0x53, 0x27, 0xd0, 0x1d, 0xfa, 0x6f, 0xaf, 0x6f, 0x0e, 0x4f, 0xff, 0x8d, 0x1a, 0x90,
-	0x94, 0x8b, 0x3c, 0x37, 0x79, 0x51, 0x5b, 0x9a, 0xe0, 0xaf, 0xc0, 0x21, 0x98, 0x0b, 0xce, 0x08,
-	0x9e, 0x85, 0xf5, 0x8d, 0x34, 0xf5, 0x00, 0x1e, 0xd7, 0x27, 0xef, 0xcc, 0xd5, 0x0c, 0x60, 0x6f,
-	0x22, 0xf2, 0xe9, 0xb6, 0xf0, 0xa1, 0x2e, 0xec, 0x56, 0xe2, 0xa6, 0x86, 0xc3, 0xc1, 0xd6, 0x71,
-	0xf3, 0x60, 0xc2, 0x82, 0x25, 0xbd, 0xd6, 0x7f, 0x36, 0x7d, 0xf6, 0xf9, 0x4b, 0x10, 0xb0, 0x04,
-	0xed, 0xd7, 0xbe, 0x1f, 0x8c, 0x6d, 0xc0, 0x12, 0x27, 0x86, 0x27, 0xba, 0xa7, 0x3b, 0xa8, 0xf6,
-	0x3d, 0x51, 0x8f, 0x2a, 0xcb, 0x5b, 0x14, 0xff, 0xe3, 0x8f, 0xa5, 0x6b, 0x5d, 0x2d, 0x5d, 0xeb,
-	0xd7, 0xd2, 0xb5, 0xbe, 0xaf, 0xdc, 0xc6, 0xd5, 0xca, 0x6d, 0xfc, 0x5c, 0xb9, 0x8d, 0xaf, 0x47,
-	0x7f, 0x03, 0x5c, 0x6e, 0xbf, 0x96, 0x66, 0x45, 0x2d, 0xfd, 0x55, 0x4e, 0xfe, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xab, 0xc1, 0xba, 0xbe, 0x7b, 0x03, 0x00, 0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xdb, 0xd0, 0xa4, 0x1b, 0x57, 0x80, 0x5b, 0xaa, 0x50, 0x90, 0x93, 0xe6, 0xd4, 0x03,
+	0xb2, 0xfb, 0x27, 0xc4, 0x15, 0x57, 0x85, 0x16, 0x0e, 0x8d, 0x36, 0xe5, 0xc2, 0x65, 0xb5, 0xb6,
+	0xd7, 0xf6, 0x12, 0x7b, 0x77, 0x65, 0xaf, 0xab, 0x86, 0xa7, 0xe8, 0xc3, 0xf0, 0x10, 0x3d, 0xf6,
+	0x88, 0x7a, 0x28, 0xa8, 0x7d, 0x0f, 0x84, 0xbc, 0xb6, 0x93, 0x46, 0x1c, 0x40, 0x20, 0x6e, 0x9e,
+	0x6f, 0x66, 0xe7, 0x9b, 0x9f, 0x6f, 0x0c, 0x06, 0x2e, 0x76, 0x27, 0x31, 0x67, 0x76, 0x40, 0x19,
+	0x8e, 0xa9, 0x9c, 0xd8, 0x67, 0x3b, 0xd3, 0x6f, 0x4b, 0xa4, 0x5c, 0x72, 0x63, 0xb5, 0x8a, 0xb1,
+	0xa6, 0xf8, 0xd9, 0xce, 0xc6, 0x5a, 0xc8, 0x43, 0xae, 0xfc, 0x76, 0xf1, 0x55, 0x86, 0x6e, 0xf4,
+	0x42, 0xce, 0xc3, 0x98, 0xd8, 0xca, 0x72, 0xf3, 0xc0, 0x96, 0x34, 0x21, 0x99, 0xc4, 0x89, 0x28,
+	0x03, 0x06, 0x08, 0xe8, 0xc7, 0xcc, 0x27, 0xe7, 0xc4, 0x77, 0x62, 0xee, 0x8d, 0x8d, 0x75, 0xb0,
+	0x14, 0x11, 0x1a, 0x46, 0xb2, 0xab, 0xf5, 0xb5, 0xad, 0x26, 0xac, 0x2c, 0xe3, 0x29, 0x68, 0x63,
+	0x21, 0x50, 0x84, 0xb3, 0xa8, 0xbb, 0xd0, 0xd7, 0xb6, 0x74, 0xd8, 0xc2, 0x42, 0x1c, 0xe1, 0x2c,
+	0x32, 0x9e, 0x83, 0xe5, 0xb2, 0x90, 0xcf, 0xc4, 0xef, 0x2e, 0xf6, 0xb5, 0xad, 0x36, 0x9c, 0x01,
+	0x03, 0x09, 0x56, 0x86, 0xb9, 0x0b, 0x31, 0xf3, 0x0f, 0x78, 0x92, 0x50, 0x69, 0x6c, 0x02, 0x3d,
+	0x93, 0x38, 0x95, 0x68, 0x8e, 0xa7, 0xa3, 0xb0, 0xa3, 0x92, 0xac, 0x0f, 0x74, 0x96, 0x27, 0x48,
+	0xe4, 0x2e, 0x4a, 0x31, 0xf3, 0x15, 0x61, 0x13, 0x02, 0x96, 0x27, 0x55, 0x2a, 0xc3, 0x04, 0xc0,
+	0x53, 0xe9, 0x12, 0xc2, 0xa4, 0x22, 0xd5, 0xe1, 0x3d, 0x64, 0xf0, 0x63, 0x11, 0xb4, 0x0f, 0xcf,
+	0xa8, 0x4f, 0x98, 0x47, 0x0c, 0x08, 0x96, 0x03, 0x81, 0x5c, 0xe9, 0x21, 0x31, 0x56, 0x74, 0xba,
+	0xf3, 0xf2, 0xfa, 0xa6, 0xb7, 0x1b, 0x52, 0x19, 0xe5, 0xae, 0xe5, 0xf1, 0xc4, 0xae, 0x26, 0xea,
+	0x45, 0x98, 0xb2, 0xda, 0xb0, 0xe5, 0x44, 0x90, 0xcc, 0x72, 0x8e, 0x87, 0x7b, 0xfb, 0xdb, 0xc3,
+	0xdc, 0x7d, 0x4f, 0x26, 0xb0, 0x15, 0x08, 0x47, 0x7a, 0xc3, 0x71, 0xd1, 0x85, 0x5b, 0x0c, 0xac,
+	0xee, 0xa2, 0x2c, 0xb1, 0xa3, 0xb0, 0xaa, 0x8b, 0x11, 0x68, 0x4f, 0x3b, 0x50, 0x15, 0x3a, 0xaf,
+	0xae, 0x6f, 0x7a, 0xfb, 0x7f, 0xc6, 0x3a, 0xf2, 0x22, 0xc6, 0xd3, 0xb4, 0xea, 0x17, 0xb6, 0x44,
+	0xd5, 0xf8, 0x0b, 0x60, 0x78, 0x98, 0x71, 0x46, 0x3d, 0x1c, 0xa3, 0xe9, 0x46, 0x9a, 0x6a, 0x00,
+	0x8f, 0xa6, 0x9e, 0xd7, 0xd5, 0x6a, 0x06, 0x60, 0x25, 0xe0, 0xe9, 0x78, 0x16, 0xf8, 0x40, 0x05,
+	0x76, 0x0a, 0xb0, 0x8e, 0x61, 0x60, 0x7d, 0x96, 0xb1, 0x56, 0x14, 0xca, 0x68, 0xd8, 0x5d, 0xfa,
+	0xcb, 0xa2, 0x0f, 0x4f, 0x4e, 0x47, 0x23, 0x1a, 0xc2, 0xb5, 0x69, 0xde, 0x37, 0x55, 0xda, 0x11,
+	0x0d, 0x0d, 0x1f, 0x3c, 0x56, 0x35, 0xcd, 0x51, 0xb5, 0xfe, 0x91, 0xea, 0x61, 0x91, 0xf2, 0x1e,
+	0xcb, 0xe0, 0xcb, 0x02, 0x78, 0x56, 0xdb, 0xc3, 0x94, 0x17, 0x52, 0x48, 0x47, 0x34, 0x64, 0x94,
+	0x85, 0xc7, 0x2c, 0xe0, 0xff, 0x4b, 0x13, 0x73, 0xca, 0x5e, 0xf8, 0x55, 0xd9, 0x9b, 0x40, 0xa7,
+	0xc5, 0xb9, 0x21, 0x1e, 0x04, 0x19, 0x29, 0x95, 0xdb, 0x84, 0x1d, 0x85, 0x9d, 0x28, 0xc8, 0xd8,
+	0x05, 0x4f, 0x12, 0x9a, 0x65, 0xc4, 0x47, 0x4a, 0x4c, 0x19, 0xf2, 0x78, 0xce, 0x24, 0x49, 0xd5,
+	0x92, 0x9b, 0x70, 0xb5, 0x74, 0xaa, 0x6b, 0xcd, 0x0e, 0x4a, 0x97, 0xf1, 0x16, 0xe8, 0x9f, 0x30,
+	0x8d, 0x89, 0x8f, 0x72, 0x26, 0x69, 0xac, 0xd6, 0xdc, 0xd9, 0xdd, 0xb0, 0xca, 0xeb, 0xb7, 0xea,
+	0xeb, 0xb7, 0x4e, 0xeb, 0xeb, 0x77, 0xda, 0x97, 0x37, 0xbd, 0xc6, 0xc5, 0xb7, 0x9e, 0x06, 0x3b,
+	0xe5, 0xcb, 0x0f, 0xc5, 0x43, 0xe7, 0xdd, 0xe5, 0xad, 0xa9, 0x5d, 0xdd, 0x9a, 0xda, 0xf7, 0x5b,
+	0x53, 0xbb, 0xb8, 0x33, 0x1b, 0x57, 0x77, 0x66, 0xe3, 0xeb, 0x9d, 0xd9, 0xf8, 0xb8, 0xfd, 0xbb,
+	0xc9, 0x9c, 0xcf, 0x7e, 0x59, 0x6a, 0x48, 0xee, 0x92, 0xa2, 0xdd, 0xfb, 0x39, 0x00, 0x02, 0x6f,
+	0xe3, 0x77, 0xd3, 0x04, 0x00, 0x00,
 }
 
 func (m *IndexedBlock) Marshal() (dAtA []byte, err error) {
@@ -452,6 +546,64 @@ func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FinalityProviderSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFinality(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFinality(v)
 	base := offset
@@ -537,6 +689,30 @@ func (m *Evidence) Size() (n int) {
 	return n
 }
 
+func (m *FinalityProviderSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovFinality(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovFinality(uint64(m.IndexOffset))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovFinality(uint64(m.MissedBlocksCounter))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovFinality(uint64(l))
+	return n
+}
+
 func sovFinality(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1065,6 +1241,181 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFinality(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -11,5 +13,19 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// TODO: add validate to IndexedBlocks, Evidences, VoteSigs, PublicRandomness
+	for _, signInfo := range gs.SigningInfos {
+		if err := signInfo.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid signing info: %w", err)
+		}
+	}
+	for _, missedBlock := range gs.MissedBlocks {
+		if missedBlock.FpBtcPk == nil {
+			return fmt.Errorf("invalid missed block: empty finality provider BTC PK")
+		}
+		if missedBlock.Index >= gs.Params.SignedBlocksWindow {
+			return fmt.Errorf("invalid missed block: index %d is out of the signed blocks window %d",
+				missedBlock.Index, gs.Params.SignedBlocksWindow)
+		}
+	}
 	return gs.Params.Validate()
 }
@@ -38,6 +38,11 @@ type GenesisState struct {
 	PublicRandomness []*PublicRandomness `protobuf:"bytes,5,rep,name=public_randomness,json=publicRandomness,proto3" json:"public_randomness,omitempty"`
 	// pub_rand_commit contains all the public randomness commitment ever commited from the finality providers.
 	PubRandCommit []*PubRandCommitWithPK `protobuf:"bytes,6,rep,name=pub_rand_commit,json=pubRandCommit,proto3" json:"pub_rand_commit,omitempty"`
+	// signing_infos contains the signing info of all finality providers
+	SigningInfos []*FinalityProviderSigningInfo `protobuf:"bytes,7,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos,omitempty"`
+	// missed_blocks contains the missed blocks in the sliding window of all
+	// finality providers
+	MissedBlocks []*MissedBlock `protobuf:"bytes,8,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +120,20 @@ func (m *GenesisState) GetPubRandCommit() []*PubRandCommitWithPK {
 	return nil
 }
 
+func (m *GenesisState) GetSigningInfos() []*FinalityProviderSigningInfo {
+	if m != nil {
+		return m.SigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedBlocks() []*MissedBlock {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
 // VoteSig the vote of an finality provider
 // with the block of the vote, the finality provider btc public key and the vote signature.
 type VoteSig struct {
@@ -265,51 +284,106 @@ func (m *PubRandCommitWithPK) GetPubRandCommit() *PubRandCommit {
 	return nil
 }
 
+// MissedBlock is a block in the sliding window that a finality provider
+// misses to vote for
+type MissedBlock struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// index is the index of the block in the missed block bitmap
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *MissedBlock) Reset()         { *m = MissedBlock{} }
+func (m *MissedBlock) String() string { return proto.CompactTextString(m) }
+func (*MissedBlock) ProtoMessage()    {}
+func (*MissedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52dc577f74d797d1, []int{4}
+}
+func (m *MissedBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissedBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissedBlock.Merge(m, src)
+}
+func (m *MissedBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissedBlock proto.InternalMessageInfo
+
+func (m *MissedBlock) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.finality.v1.GenesisState")
 	proto.RegisterType((*VoteSig)(nil), "babylon.finality.v1.VoteSig")
 	proto.RegisterType((*PublicRandomness)(nil), "babylon.finality.v1.PublicRandomness")
 	proto.RegisterType((*PubRandCommitWithPK)(nil), "babylon.finality.v1.PubRandCommitWithPK")
+	proto.RegisterType((*MissedBlock)(nil), "babylon.finality.v1.MissedBlock")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/genesis.proto", fileDescriptor_52dc577f74d797d1) }
 
 var fileDescriptor_52dc577f74d797d1 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0xc7, 0xeb, 0xb5, 0xb4, 0xab, 0xdb, 0xc1, 0xf0, 0x38, 0x44, 0x05, 0xd2, 0x36, 0x12, 0x52,
-	0x4f, 0xc9, 0xd6, 0x4d, 0x88, 0x89, 0x5b, 0xd0, 0xc4, 0x5e, 0x0e, 0x44, 0x0e, 0x02, 0x09, 0x0e,
-	0x51, 0x92, 0xba, 0x89, 0xd5, 0x36, 0xb6, 0x6a, 0xb7, 0x5a, 0xbf, 0x05, 0x5f, 0x85, 0x03, 0xdf,
-	0x61, 0xc7, 0x1d, 0xd1, 0x24, 0x2a, 0xd4, 0x7e, 0x11, 0x54, 0x37, 0xdd, 0x46, 0x09, 0x1a, 0x42,
-	0x48, 0xdc, 0xec, 0x27, 0xff, 0xe7, 0xa7, 0xff, 0xf3, 0x12, 0xc3, 0x66, 0xe0, 0x07, 0x93, 0x3e,
-	0x4b, 0xac, 0x2e, 0x4d, 0xfc, 0x3e, 0x95, 0x13, 0x6b, 0xbc, 0x67, 0x45, 0x24, 0x21, 0x82, 0x0a,
-	0x93, 0x0f, 0x99, 0x64, 0x68, 0x27, 0x95, 0x98, 0x2b, 0x89, 0x39, 0xde, 0xab, 0x3d, 0x8a, 0x58,
-	0xc4, 0xd4, 0x77, 0x6b, 0x71, 0x5a, 0x4a, 0x6b, 0x8d, 0x2c, 0x1a, 0xf7, 0x87, 0xfe, 0x20, 0x85,
-	0xd5, 0x8c, 0x2c, 0xc5, 0x35, 0x58, 0x69, 0x8c, 0xcf, 0x79, 0x58, 0x7d, 0xbd, 0xb4, 0xe0, 0x4a,
-	0x5f, 0x12, 0x74, 0x08, 0x8b, 0x4b, 0x88, 0x06, 0x1a, 0xa0, 0x55, 0x69, 0x3f, 0x36, 0x33, 0x2c,
-	0x99, 0x8e, 0x92, 0xd8, 0x85, 0x8b, 0x69, 0x3d, 0x87, 0xd3, 0x04, 0x74, 0x0c, 0xef, 0xd3, 0xa4,
-	0x43, 0xce, 0x49, 0xc7, 0x0b, 0xfa, 0x2c, 0xec, 0x09, 0x6d, 0xa3, 0x91, 0x6f, 0x55, 0xda, 0xcd,
-	0x4c, 0xc4, 0xc9, 0x52, 0x6a, 0x2f, 0x94, 0x78, 0x8b, 0xde, 0xba, 0x09, 0xf4, 0x12, 0x96, 0xc9,
-	0x98, 0x76, 0x48, 0x12, 0x12, 0xa1, 0xe5, 0x15, 0xe4, 0x69, 0x26, 0xe4, 0x28, 0x55, 0xe1, 0x1b,
-	0x3d, 0x3a, 0x84, 0xe5, 0x31, 0x93, 0xc4, 0x13, 0x34, 0x12, 0x5a, 0x41, 0x25, 0x3f, 0xc9, 0x4c,
-	0x7e, 0xc7, 0x24, 0x71, 0x69, 0x84, 0x37, 0xc7, 0xcb, 0x83, 0x40, 0x18, 0x3e, 0xe4, 0xa3, 0xa0,
-	0x4f, 0x43, 0x6f, 0xe8, 0x27, 0x1d, 0x36, 0x48, 0x88, 0x10, 0xda, 0x3d, 0x85, 0x78, 0x96, 0xdd,
-	0x07, 0xa5, 0xc6, 0xd7, 0x62, 0xbc, 0xcd, 0xd7, 0x22, 0xc8, 0x81, 0x0f, 0xf8, 0x28, 0x50, 0x40,
-	0x2f, 0x64, 0x83, 0x01, 0x95, 0x5a, 0x51, 0x11, 0x5b, 0xbf, 0x23, 0x2e, 0x92, 0x5f, 0x29, 0xe5,
-	0x7b, 0x2a, 0x63, 0xe7, 0x0c, 0x6f, 0xf1, 0xdb, 0x41, 0xe3, 0x1b, 0x80, 0xa5, 0xd4, 0x3b, 0x6a,
-	0xc2, 0xaa, 0xea, 0xb5, 0x17, 0x13, 0x1a, 0xc5, 0x52, 0x0d, 0xad, 0x80, 0x2b, 0x2a, 0x76, 0xac,
-	0x42, 0x08, 0xc3, 0x72, 0x97, 0x7b, 0x81, 0x0c, 0x3d, 0xde, 0xd3, 0x36, 0x1a, 0xa0, 0x55, 0xb5,
-	0x9f, 0x5f, 0x4d, 0xeb, 0xed, 0x88, 0xca, 0x78, 0x14, 0x98, 0x21, 0x1b, 0x58, 0xa9, 0x91, 0x30,
-	0xf6, 0x69, 0xb2, 0xba, 0x58, 0x72, 0xc2, 0x89, 0x30, 0xed, 0x13, 0x67, 0xff, 0x60, 0xd7, 0x19,
-	0x05, 0x67, 0x64, 0x82, 0x4b, 0x5d, 0x6e, 0xcb, 0xd0, 0xe9, 0xa1, 0x8f, 0xb0, 0xba, 0x32, 0xbd,
-	0xe8, 0xb3, 0x96, 0x57, 0xd8, 0x17, 0x57, 0xd3, 0xfa, 0xc1, 0x9f, 0x61, 0xdd, 0x30, 0x4e, 0xd8,
-	0x70, 0x78, 0xf4, 0xe6, 0xad, 0xbb, 0x18, 0x41, 0x65, 0x45, 0x73, 0x69, 0x64, 0x4c, 0x01, 0xdc,
-	0x5e, 0x6f, 0xec, 0xff, 0x2a, 0xd4, 0x85, 0x9b, 0xab, 0xe9, 0xfd, 0x75, 0x91, 0xe9, 0x48, 0x71,
-	0x29, 0x1d, 0xa3, 0xf1, 0x05, 0xc0, 0x9d, 0x8c, 0x39, 0xff, 0x5c, 0x00, 0xf8, 0x37, 0x05, 0x9c,
-	0xfe, 0xba, 0x7e, 0x1b, 0xea, 0xc7, 0x36, 0xee, 0x5e, 0xbf, 0xb5, 0xc5, 0xb3, 0x4f, 0x2f, 0x66,
-	0x3a, 0xb8, 0x9c, 0xe9, 0xe0, 0xfb, 0x4c, 0x07, 0x9f, 0xe6, 0x7a, 0xee, 0x72, 0xae, 0xe7, 0xbe,
-	0xce, 0xf5, 0xdc, 0x87, 0xdd, 0xbb, 0x2c, 0x9e, 0xdf, 0x3c, 0x42, 0xca, 0x6d, 0x50, 0x54, 0xef,
-	0xcf, 0xfe, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x48, 0xf9, 0xea, 0x15, 0x05, 0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0x5d, 0x6f, 0xd3, 0x3c,
+	0x14, 0xc7, 0x9b, 0xad, 0x5b, 0x37, 0x37, 0x7d, 0x9e, 0xe1, 0xed, 0x22, 0x1a, 0x90, 0x75, 0x91,
+	0x90, 0x7a, 0x95, 0xec, 0x4d, 0x88, 0x89, 0xbb, 0xa0, 0xc1, 0x5e, 0x84, 0x88, 0x1c, 0x5e, 0x24,
+	0xb8, 0x88, 0x92, 0xd4, 0x4d, 0xac, 0x35, 0x76, 0x14, 0xbb, 0x65, 0xfd, 0x16, 0x7c, 0x19, 0xbe,
+	0xc3, 0xee, 0xd8, 0x25, 0x9a, 0x44, 0x85, 0xb6, 0x2f, 0x82, 0xe2, 0xa4, 0xeb, 0x18, 0x41, 0x43,
+	0x08, 0xc4, 0x5d, 0x8e, 0xf3, 0x3f, 0x3f, 0x9f, 0x63, 0xff, 0x7d, 0xc0, 0x7a, 0xe0, 0x07, 0xa3,
+	0x3e, 0xa3, 0x56, 0x8f, 0x50, 0xbf, 0x4f, 0xc4, 0xc8, 0x1a, 0x6e, 0x5a, 0x11, 0xa6, 0x98, 0x13,
+	0x6e, 0xa6, 0x19, 0x13, 0x0c, 0x2e, 0x97, 0x12, 0x73, 0x22, 0x31, 0x87, 0x9b, 0xab, 0x2b, 0x11,
+	0x8b, 0x98, 0xfc, 0x6f, 0xe5, 0x5f, 0x85, 0x74, 0xb5, 0x5d, 0x45, 0x4b, 0xfd, 0xcc, 0x4f, 0x4a,
+	0xd8, 0xaa, 0x51, 0xa5, 0xb8, 0x02, 0x4b, 0x8d, 0xf1, 0xa9, 0x0e, 0xd4, 0x67, 0x45, 0x09, 0xae,
+	0xf0, 0x05, 0x86, 0xbb, 0x60, 0xbe, 0x80, 0x68, 0x4a, 0x5b, 0xe9, 0x34, 0xb7, 0xee, 0x9a, 0x15,
+	0x25, 0x99, 0x8e, 0x94, 0xd8, 0xf5, 0xd3, 0xf1, 0x5a, 0x0d, 0x95, 0x09, 0x70, 0x1f, 0xfc, 0x47,
+	0x68, 0x17, 0x9f, 0xe0, 0xae, 0x17, 0xf4, 0x59, 0x78, 0xcc, 0xb5, 0x99, 0xf6, 0x6c, 0xa7, 0xb9,
+	0xb5, 0x5e, 0x89, 0x38, 0x28, 0xa4, 0x76, 0xae, 0x44, 0x2d, 0x72, 0x2d, 0xe2, 0xf0, 0x31, 0x58,
+	0xc4, 0x43, 0xd2, 0xc5, 0x34, 0xc4, 0x5c, 0x9b, 0x95, 0x90, 0xfb, 0x95, 0x90, 0xbd, 0x52, 0x85,
+	0xa6, 0x7a, 0xb8, 0x0b, 0x16, 0x87, 0x4c, 0x60, 0x8f, 0x93, 0x88, 0x6b, 0x75, 0x99, 0x7c, 0xaf,
+	0x32, 0xf9, 0x35, 0x13, 0xd8, 0x25, 0x11, 0x5a, 0x18, 0x16, 0x1f, 0x1c, 0x22, 0x70, 0x27, 0x1d,
+	0x04, 0x7d, 0x12, 0x7a, 0x99, 0x4f, 0xbb, 0x2c, 0xa1, 0x98, 0x73, 0x6d, 0x4e, 0x22, 0x1e, 0x54,
+	0x9f, 0x83, 0x54, 0xa3, 0x2b, 0x31, 0x5a, 0x4a, 0x6f, 0xac, 0x40, 0x07, 0xfc, 0x9f, 0x0e, 0x02,
+	0x09, 0xf4, 0x42, 0x96, 0x24, 0x44, 0x68, 0xf3, 0x92, 0xd8, 0xf9, 0x19, 0x31, 0x4f, 0x7e, 0x22,
+	0x95, 0x6f, 0x88, 0x88, 0x9d, 0x23, 0xd4, 0x4a, 0xaf, 0x2f, 0xc2, 0x57, 0xa0, 0xc5, 0x49, 0x44,
+	0x09, 0x8d, 0x3c, 0x42, 0x7b, 0x8c, 0x6b, 0x0d, 0xc9, 0xdb, 0xa8, 0xe4, 0x3d, 0x2d, 0xbf, 0x9d,
+	0x8c, 0xe5, 0x27, 0x94, 0xb9, 0x45, 0xe6, 0x01, 0xed, 0x31, 0xa4, 0xf2, 0x69, 0xc0, 0xe1, 0x1e,
+	0x68, 0x25, 0x84, 0xf3, 0xe9, 0xed, 0x2d, 0x48, 0x6c, 0xbb, 0x12, 0xfb, 0x5c, 0x2a, 0x8b, 0xcb,
+	0x53, 0x93, 0x69, 0xc0, 0x8d, 0x2f, 0x0a, 0x68, 0x94, 0x27, 0x0b, 0xd7, 0x81, 0x2a, 0x59, 0x5e,
+	0x8c, 0x49, 0x14, 0x0b, 0x69, 0xa9, 0x3a, 0x6a, 0xca, 0xb5, 0x7d, 0xb9, 0x04, 0x11, 0x58, 0xec,
+	0xa5, 0x5e, 0x20, 0x42, 0x2f, 0x3d, 0xd6, 0x66, 0xda, 0x4a, 0x47, 0xb5, 0x1f, 0x9e, 0x8f, 0xd7,
+	0xb6, 0x22, 0x22, 0xe2, 0x41, 0x60, 0x86, 0x2c, 0xb1, 0xca, 0xfd, 0xc3, 0xd8, 0x27, 0x74, 0x12,
+	0x58, 0x62, 0x94, 0x62, 0x6e, 0xda, 0x07, 0xce, 0xf6, 0xce, 0x86, 0x33, 0x08, 0x8e, 0xf0, 0x08,
+	0x35, 0x7a, 0xa9, 0x2d, 0x42, 0xe7, 0x18, 0xbe, 0x03, 0xea, 0xa4, 0xd6, 0xdc, 0x05, 0xda, 0xac,
+	0xc4, 0x3e, 0x3a, 0x1f, 0xaf, 0xed, 0xfc, 0x1a, 0xd6, 0x0d, 0x63, 0xca, 0xb2, 0x6c, 0xef, 0xc5,
+	0x4b, 0x37, 0x37, 0x48, 0x73, 0x42, 0x73, 0x49, 0x64, 0x8c, 0x15, 0xb0, 0x74, 0xf3, 0xda, 0xff,
+	0x55, 0xa3, 0x2e, 0x58, 0x98, 0x78, 0xeb, 0xb7, 0x9b, 0x2c, 0x0d, 0x87, 0x1a, 0xa5, 0xc9, 0x8c,
+	0x8f, 0x0a, 0x58, 0xae, 0x70, 0xe1, 0xf7, 0x0d, 0x28, 0x7f, 0xa6, 0x81, 0xc3, 0x1f, 0x1f, 0xc7,
+	0x8c, 0x1c, 0x3b, 0xc6, 0xed, 0x8f, 0xe3, 0xc6, 0xb3, 0x30, 0xde, 0x83, 0xe6, 0x35, 0x57, 0xfe,
+	0x95, 0x72, 0x57, 0xc0, 0x9c, 0x1c, 0x54, 0xb2, 0xc8, 0x3a, 0x2a, 0x02, 0xfb, 0xf0, 0xf4, 0x42,
+	0x57, 0xce, 0x2e, 0x74, 0xe5, 0xeb, 0x85, 0xae, 0x7c, 0xb8, 0xd4, 0x6b, 0x67, 0x97, 0x7a, 0xed,
+	0xf3, 0xa5, 0x5e, 0x7b, 0xbb, 0x71, 0xdb, 0x66, 0x27, 0xd3, 0xd9, 0x2c, 0xf7, 0x0d, 0xe6, 0xe5,
+	0x58, 0xde, 0xfe, 0x36, 0x00, 0x74, 0xf8, 0x11, 0x38, 0x2c, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -332,6 +406,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.PubRandCommit) > 0 {
 		for iNdEx := len(m.PubRandCommit) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -566,6 +668,46 @@ func (m *PubRandCommitWithPK) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MissedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissedBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -615,6 +757,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -675,6 +829,22 @@ func (m *PubRandCommitWithPK) Size() (n int) {
 	return n
 }
 
+func (m *MissedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovGenesis(uint64(m.Index))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -913,6 +1083,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, &FinalityProviderSigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, &MissedBlock{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1333,6 +1571,110 @@ func (m *PubRandCommitWithPK) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MissedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/finality/types"
 	"github.com/stretchr/testify/require"
 )
@@ -22,11 +23,28 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					MinPubRand: 200,
+					MinPubRand:         200,
+					FinalitySigTimeout: 3,
+					SignedBlocksWindow: 100,
+					MinSignedPerWindow: types.DefaultParams().MinSignedPerWindow,
+					JailDuration:       types.DefaultParams().JailDuration,
 				},
 			},
 			valid: true,
 		},
+		{
+			desc: "missed block out of the signed blocks window",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MissedBlocks: []*types.MissedBlock{
+					{
+						FpBtcPk: &bbn.BIP340PubKey{},
+						Index:   types.DefaultParams().SignedBlocksWindow,
+					},
+				},
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -22,4 +22,7 @@ var (
 	ParamsKey               = []byte{0x05} // key prefix for the parameters
 	EvidenceKey             = []byte{0x06} // key prefix for evidences
 	NextHeightToFinalizeKey = []byte{0x07} // key prefix for next height to finalise
+	NextHeightToPruneKey    = []byte{0x08} // key prefix for next height to prune
+	SigningInfoKey          = []byte{0x09} // key prefix for signing info of finality providers
+	MissedBlockBitmapKey    = []byte{0x0a} // key prefix for missed block bitmaps of finality providers
 )
@@ -150,6 +150,32 @@ func (mr *MockBTCStakingKeeperMockRecorder) HasFinalityProvider(ctx, fpBTCPK int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).HasFinalityProvider), ctx, fpBTCPK)
 }
 
+// JailFinalityProvider mocks base method.
+func (m *MockBTCStakingKeeper) JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JailFinalityProvider", ctx, fpBTCPK)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JailFinalityProvider indicates an expected call of JailFinalityProvider.
+func (mr *MockBTCStakingKeeperMockRecorder) JailFinalityProvider(ctx, fpBTCPK interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).JailFinalityProvider), ctx, fpBTCPK)
+}
+
+// PruneVotingPowerAtHeight mocks base method.
+func (m *MockBTCStakingKeeper) PruneVotingPowerAtHeight(ctx context.Context, height uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PruneVotingPowerAtHeight", ctx, height)
+}
+
+// PruneVotingPowerAtHeight indicates an expected call of PruneVotingPowerAtHeight.
+func (mr *MockBTCStakingKeeperMockRecorder) PruneVotingPowerAtHeight(ctx, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneVotingPowerAtHeight", reflect.TypeOf((*MockBTCStakingKeeper)(nil).PruneVotingPowerAtHeight), ctx, height)
+}
+
 // RemoveVotingPowerDistCache mocks base method.
 func (m *MockBTCStakingKeeper) RemoveVotingPowerDistCache(ctx context.Context, height uint64) {
 	m.ctrl.T.Helper()
@@ -176,6 +202,20 @@ func (mr *MockBTCStakingKeeperMockRecorder) SlashFinalityProvider(ctx, fpBTCPK i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).SlashFinalityProvider), ctx, fpBTCPK)
 }
 
+// UnjailFinalityProvider mocks base method.
+func (m *MockBTCStakingKeeper) UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnjailFinalityProvider", ctx, fpBTCPK)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnjailFinalityProvider indicates an expected call of UnjailFinalityProvider.
+func (mr *MockBTCStakingKeeperMockRecorder) UnjailFinalityProvider(ctx, fpBTCPK interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnjailFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).UnjailFinalityProvider), ctx, fpBTCPK)
+}
+
 // MockIncentiveKeeper is a mock of IncentiveKeeper interface.
 type MockIncentiveKeeper struct {
 	ctrl     *gomock.Controller
@@ -199,6 +239,18 @@ func (m *MockIncentiveKeeper) EXPECT() *MockIncentiveKeeperMockRecorder {
 	return m.recorder
 }
 
+// PruneRewardsAtHeight mocks base method.
+func (m *MockIncentiveKeeper) PruneRewardsAtHeight(ctx context.Context, height uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PruneRewardsAtHeight", ctx, height)
+}
+
+// PruneRewardsAtHeight indicates an expected call of PruneRewardsAtHeight.
+func (mr *MockIncentiveKeeperMockRecorder) PruneRewardsAtHeight(ctx, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneRewardsAtHeight", reflect.TypeOf((*MockIncentiveKeeper)(nil).PruneRewardsAtHeight), ctx, height)
+}
+
 // RewardBTCStaking mocks base method.
 func (m *MockIncentiveKeeper) RewardBTCStaking(ctx context.Context, height uint64, filteredDc *types.VotingPowerDistCache) {
 	m.ctrl.T.Helper()
@@ -14,6 +14,7 @@ var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgAddFinalitySig{}
 	_ sdk.Msg = &MsgCommitPubRandList{}
+	_ sdk.Msg = &MsgUnjailFinalityProvider{}
 )
 
 func (m *MsgAddFinalitySig) MsgToSign() []byte {
@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -18,6 +20,13 @@ func ParamKeyTable() paramtypes.KeyTable {
 func DefaultParams() Params {
 	return Params{
 		MinPubRand: 100,
+		// pruning is disabled by default
+		PruningRetentionBlocks:   0,
+		MaxPrunedHeightsPerBlock: 100,
+		FinalitySigTimeout:       3,
+		SignedBlocksWindow:       100,
+		MinSignedPerWindow:       sdkmath.LegacyNewDecWithPrec(5, 1),
+		JailDuration:             24 * time.Hour,
 	}
 }
 
@@ -33,11 +42,53 @@ func validateMinPubRand(minPubRand uint64) error {
 	return nil
 }
 
+func validatePruning(retentionBlocks uint64, maxPrunedHeightsPerBlock uint64) error {
+	if retentionBlocks > 0 && maxPrunedHeightsPerBlock == 0 {
+		return fmt.Errorf("max pruned heights per block cannot be 0 when pruning is enabled")
+	}
+	return nil
+}
+
+func validateLiveness(
+	finalitySigTimeout uint64,
+	signedBlocksWindow uint64,
+	minSignedPerWindow sdkmath.LegacyDec,
+	jailDuration time.Duration,
+) error {
+	if finalitySigTimeout == 0 {
+		return fmt.Errorf("finality sig timeout cannot be 0")
+	}
+	if signedBlocksWindow == 0 {
+		return fmt.Errorf("signed blocks window cannot be 0")
+	}
+	if minSignedPerWindow.IsNil() || minSignedPerWindow.IsNegative() || minSignedPerWindow.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("min signed per window must be in [0, 1], got %v", minSignedPerWindow)
+	}
+	if jailDuration <= 0 {
+		return fmt.Errorf("jail duration must be positive, got %v", jailDuration)
+	}
+	return nil
+}
+
+// MinSignedPerWindowInt returns the minimum number of blocks in the sliding
+// window that a finality provider has to vote for
+func (p Params) MinSignedPerWindowInt() uint64 {
+	// NOTE: RoundInt64 will never panic as minSignedPerWindow is
+	// less than 1 and signed blocks window is a uint64
+	return uint64(p.MinSignedPerWindow.MulInt64(int64(p.SignedBlocksWindow)).RoundInt64())
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if err := validateMinPubRand(p.MinPubRand); err != nil {
 		return err
 	}
+	if err := validatePruning(p.PruningRetentionBlocks, p.MaxPrunedHeightsPerBlock); err != nil {
+		return err
+	}
+	if err := validateLiveness(p.FinalitySigTimeout, p.SignedBlocksWindow, p.MinSignedPerWindow, p.JailDuration); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4,18 +4,24 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +34,27 @@ type Params struct {
 	// min_pub_rand is the minimum number of public randomness each
 	// message should commit
 	MinPubRand uint64 `protobuf:"varint,1,opt,name=min_pub_rand,json=minPubRand,proto3" json:"min_pub_rand,omitempty"`
+	// pruning_retention_blocks is the number of most recent Babylon blocks
+	// whose indexed blocks, votes, revealed public randomness, voting power
+	// tables and rewards history are retained. Older data is pruned once the
+	// corresponding heights are finalised or non-finalisable. 0 disables pruning.
+	PruningRetentionBlocks uint64 `protobuf:"varint,2,opt,name=pruning_retention_blocks,json=pruningRetentionBlocks,proto3" json:"pruning_retention_blocks,omitempty"`
+	// max_pruned_heights_per_block is the maximum number of heights pruned in
+	// a single BeginBlock
+	MaxPrunedHeightsPerBlock uint64 `protobuf:"varint,3,opt,name=max_pruned_heights_per_block,json=maxPrunedHeightsPerBlock,proto3" json:"max_pruned_heights_per_block,omitempty"`
+	// finality_sig_timeout is the number of blocks a finality provider has to
+	// vote for a block before its liveness is checked at this block
+	FinalitySigTimeout uint64 `protobuf:"varint,4,opt,name=finality_sig_timeout,json=finalitySigTimeout,proto3" json:"finality_sig_timeout,omitempty"`
+	// signed_blocks_window is the size of the sliding window used to track
+	// finality provider liveness
+	SignedBlocksWindow uint64 `protobuf:"varint,5,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// min_signed_per_window is the minimum fraction of blocks in the sliding
+	// window that an active finality provider has to vote for. Otherwise it
+	// gets jailed
+	MinSignedPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_signed_per_window"`
+	// jail_duration is the minimum period of time that a jailed finality
+	// provider remains jailed before it can unjail itself
+	JailDuration time.Duration `protobuf:"bytes,7,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -69,6 +96,41 @@ func (m *Params) GetMinPubRand() uint64 {
 	return 0
 }
 
+func (m *Params) GetPruningRetentionBlocks() uint64 {
+	if m != nil {
+		return m.PruningRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrunedHeightsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedHeightsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetFinalitySigTimeout() uint64 {
+	if m != nil {
+		return m.FinalitySigTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetSignedBlocksWindow() uint64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *Params) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.finality.v1.Params")
 }
@@ -76,19 +138,36 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/params.proto", fileDescriptor_25539c9a61c72ee9) }
 
 var fileDescriptor_25539c9a61c72ee9 = []byte{
-	// 192 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xcb, 0xcc, 0x4b, 0xcc, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83,
-	0x58, 0x10, 0xa5, 0x4a, 0x06, 0x5c, 0x6c, 0x01, 0x60, 0xad, 0x42, 0x0a, 0x5c, 0x3c, 0xb9, 0x99,
-	0x79, 0xf1, 0x05, 0xa5, 0x49, 0xf1, 0x45, 0x89, 0x79, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x2c,
-	0x41, 0x5c, 0xb9, 0x99, 0x79, 0x01, 0xa5, 0x49, 0x41, 0x89, 0x79, 0x29, 0x56, 0x2c, 0x33, 0x16,
-	0xc8, 0x33, 0x38, 0x79, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x41,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x05, 0xc9, 0x19, 0x89,
-	0x99, 0x79, 0x30, 0x8e, 0x7e, 0x05, 0xc2, 0xc9, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0x47, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x3d, 0x78, 0xb7, 0xd3, 0x00, 0x00, 0x00,
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x92, 0x3f, 0x6f, 0xd4, 0x30,
+	0x18, 0xc6, 0x13, 0x7a, 0x1c, 0x60, 0xca, 0x12, 0x0a, 0x4a, 0x0b, 0xca, 0x45, 0x4c, 0xb7, 0x90,
+	0xf4, 0x60, 0x41, 0x0c, 0x0c, 0xa7, 0x1b, 0x2a, 0xc4, 0x10, 0xa5, 0x48, 0x48, 0x2c, 0x96, 0x93,
+	0xb8, 0x8e, 0x69, 0x6c, 0x47, 0xb6, 0xd3, 0xde, 0x7d, 0x0b, 0xc6, 0x8e, 0x7c, 0x08, 0x66, 0xe6,
+	0x8e, 0x15, 0x13, 0x62, 0x28, 0xe8, 0xee, 0x8b, 0x20, 0xff, 0x89, 0xd8, 0xee, 0xbd, 0xdf, 0xf3,
+	0xbc, 0xcf, 0x9b, 0xf7, 0x35, 0x48, 0x2b, 0x54, 0x6d, 0x3a, 0xc1, 0xf3, 0x33, 0xca, 0x51, 0x47,
+	0xf5, 0x26, 0xbf, 0x58, 0xe4, 0x3d, 0x92, 0x88, 0xa9, 0xac, 0x97, 0x42, 0x8b, 0xe8, 0xb1, 0x57,
+	0x64, 0xa3, 0x22, 0xbb, 0x58, 0x1c, 0x1d, 0x10, 0x41, 0x84, 0xe5, 0xb9, 0xf9, 0xe5, 0xa4, 0x47,
+	0x87, 0xb5, 0x50, 0x4c, 0x28, 0xe8, 0x80, 0x2b, 0x3c, 0x4a, 0x88, 0x10, 0xa4, 0xc3, 0xb9, 0xad,
+	0xaa, 0xe1, 0x2c, 0x6f, 0x06, 0x89, 0x34, 0x15, 0xdc, 0xf1, 0x17, 0x3f, 0xf6, 0xc0, 0xb4, 0xb0,
+	0xb1, 0x51, 0x0a, 0xf6, 0x19, 0xe5, 0xb0, 0x1f, 0x2a, 0x28, 0x11, 0x6f, 0xe2, 0x30, 0x0d, 0xe7,
+	0x93, 0x12, 0x30, 0xca, 0x8b, 0xa1, 0x2a, 0x11, 0x6f, 0xa2, 0x37, 0x20, 0xee, 0xe5, 0xc0, 0x29,
+	0x27, 0x50, 0x62, 0x8d, 0xb9, 0xe9, 0x03, 0xab, 0x4e, 0xd4, 0xe7, 0x2a, 0xbe, 0x63, 0xd5, 0x4f,
+	0x3d, 0x2f, 0x47, 0xbc, 0xb4, 0x34, 0x7a, 0x07, 0x9e, 0x33, 0xb4, 0x86, 0x86, 0xe2, 0x06, 0xb6,
+	0x98, 0x92, 0x56, 0x2b, 0xd8, 0x63, 0xe9, 0xec, 0xf1, 0x9e, 0x75, 0xc7, 0x0c, 0xad, 0x0b, 0x2b,
+	0x39, 0x71, 0x8a, 0x02, 0x4b, 0xdb, 0x20, 0x3a, 0x06, 0x07, 0xe3, 0x1a, 0xa0, 0xa2, 0x04, 0x6a,
+	0xca, 0xb0, 0x18, 0x74, 0x3c, 0xb1, 0xbe, 0x68, 0x64, 0xa7, 0x94, 0x7c, 0x74, 0xc4, 0x38, 0x14,
+	0x25, 0x26, 0xcd, 0x0d, 0x08, 0x2f, 0x29, 0x6f, 0xc4, 0x65, 0x7c, 0xd7, 0x39, 0x1c, 0x73, 0xd3,
+	0x7d, 0xb2, 0x24, 0x6a, 0xc0, 0x13, 0xf3, 0xfd, 0xde, 0x65, 0x66, 0xf3, 0x96, 0x69, 0x1a, 0xce,
+	0x1f, 0x2c, 0x17, 0xd7, 0xb7, 0xb3, 0xe0, 0xf7, 0xed, 0xec, 0x99, 0xdb, 0xaf, 0x6a, 0xce, 0x33,
+	0x2a, 0x72, 0x86, 0x74, 0x9b, 0x7d, 0xc0, 0x04, 0xd5, 0x9b, 0x15, 0xae, 0x7f, 0x7e, 0x7f, 0x09,
+	0xfc, 0xfa, 0x57, 0xb8, 0x2e, 0x23, 0x46, 0xf9, 0xa9, 0x6d, 0x57, 0x60, 0xe9, 0x53, 0x4e, 0xc0,
+	0xa3, 0x2f, 0x88, 0x76, 0x70, 0xbc, 0x43, 0x7c, 0x2f, 0x0d, 0xe7, 0x0f, 0x5f, 0x1d, 0x66, 0xee,
+	0x50, 0xd9, 0x78, 0xa8, 0x6c, 0xe5, 0x05, 0xcb, 0xfb, 0x26, 0xf8, 0xea, 0xcf, 0x2c, 0x2c, 0xf7,
+	0x8d, 0x73, 0xfc, 0xff, 0xed, 0xe4, 0xea, 0xdb, 0x2c, 0x58, 0xbe, 0xbf, 0xde, 0x26, 0xe1, 0xcd,
+	0x36, 0x09, 0xff, 0x6e, 0x93, 0xf0, 0xeb, 0x2e, 0x09, 0x6e, 0x76, 0x49, 0xf0, 0x6b, 0x97, 0x04,
+	0x9f, 0x8f, 0x09, 0xd5, 0xed, 0x50, 0x65, 0xb5, 0x60, 0xb9, 0x7f, 0x4b, 0x75, 0x8b, 0x28, 0x1f,
+	0x8b, 0x7c, 0xfd, 0xff, 0xf1, 0xe9, 0x4d, 0x8f, 0x55, 0x35, 0xb5, 0xe1, 0xaf, 0xff, 0x0d, 0x00,
+	0xfd, 0xa2, 0x00, 0x85, 0x9d, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -111,6 +190,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FinalitySigTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FinalitySigTimeout))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxPrunedHeightsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPrunedHeightsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PruningRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PruningRetentionBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MinPubRand != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinPubRand))
 		i--
@@ -139,6 +256,22 @@ func (m *Params) Size() (n int) {
 	if m.MinPubRand != 0 {
 		n += 1 + sovParams(uint64(m.MinPubRand))
 	}
+	if m.PruningRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.PruningRetentionBlocks))
+	}
+	if m.MaxPrunedHeightsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxPrunedHeightsPerBlock))
+	}
+	if m.FinalitySigTimeout != 0 {
+		n += 1 + sovParams(uint64(m.FinalitySigTimeout))
+	}
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovParams(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -196,6 +329,149 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningRetentionBlocks", wireType)
+			}
+			m.PruningRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedHeightsPerBlock", wireType)
+			}
+			m.MaxPrunedHeightsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedHeightsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySigTimeout", wireType)
+			}
+			m.FinalitySigTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalitySigTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
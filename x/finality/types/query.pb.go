@@ -921,6 +921,203 @@ func (m *QueryListEvidencesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySigningInfoRequest is the request type for the
+// Query/SigningInfo RPC method.
+type QuerySigningInfoRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK
+	// (in BIP340 format) of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+}
+
+func (m *QuerySigningInfoRequest) Reset()         { *m = QuerySigningInfoRequest{} }
+func (m *QuerySigningInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfoRequest) ProtoMessage()    {}
+func (*QuerySigningInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{17}
+}
+func (m *QuerySigningInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfoRequest.Merge(m, src)
+}
+func (m *QuerySigningInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfoRequest proto.InternalMessageInfo
+
+func (m *QuerySigningInfoRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+// QuerySigningInfoResponse is the response type for the
+// Query/SigningInfo RPC method.
+type QuerySigningInfoResponse struct {
+	SigningInfo FinalityProviderSigningInfo `protobuf:"bytes,1,opt,name=signing_info,json=signingInfo,proto3" json:"signing_info"`
+}
+
+func (m *QuerySigningInfoResponse) Reset()         { *m = QuerySigningInfoResponse{} }
+func (m *QuerySigningInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfoResponse) ProtoMessage()    {}
+func (*QuerySigningInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{18}
+}
+func (m *QuerySigningInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfoResponse.Merge(m, src)
+}
+func (m *QuerySigningInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfoResponse proto.InternalMessageInfo
+
+func (m *QuerySigningInfoResponse) GetSigningInfo() FinalityProviderSigningInfo {
+	if m != nil {
+		return m.SigningInfo
+	}
+	return FinalityProviderSigningInfo{}
+}
+
+// QuerySigningInfosRequest is the request type for the
+// Query/SigningInfos RPC method.
+type QuerySigningInfosRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySigningInfosRequest) Reset()         { *m = QuerySigningInfosRequest{} }
+func (m *QuerySigningInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfosRequest) ProtoMessage()    {}
+func (*QuerySigningInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{19}
+}
+func (m *QuerySigningInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfosRequest.Merge(m, src)
+}
+func (m *QuerySigningInfosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfosRequest proto.InternalMessageInfo
+
+func (m *QuerySigningInfosRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySigningInfosResponse is the response type for the
+// Query/SigningInfos RPC method.
+type QuerySigningInfosResponse struct {
+	// signing_infos is the list of signing info of finality providers
+	SigningInfos []FinalityProviderSigningInfo `protobuf:"bytes,1,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySigningInfosResponse) Reset()         { *m = QuerySigningInfosResponse{} }
+func (m *QuerySigningInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfosResponse) ProtoMessage()    {}
+func (*QuerySigningInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{20}
+}
+func (m *QuerySigningInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfosResponse.Merge(m, src)
+}
+func (m *QuerySigningInfosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfosResponse proto.InternalMessageInfo
+
+func (m *QuerySigningInfosResponse) GetSigningInfos() []FinalityProviderSigningInfo {
+	if m != nil {
+		return m.SigningInfos
+	}
+	return nil
+}
+
+func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("babylon.finality.v1.QueriedBlockStatus", QueriedBlockStatus_name, QueriedBlockStatus_value)
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.finality.v1.QueryParamsRequest")
@@ -942,86 +1139,98 @@ func init() {
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "babylon.finality.v1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryListEvidencesRequest)(nil), "babylon.finality.v1.QueryListEvidencesRequest")
 	proto.RegisterType((*QueryListEvidencesResponse)(nil), "babylon.finality.v1.QueryListEvidencesResponse")
+	proto.RegisterType((*QuerySigningInfoRequest)(nil), "babylon.finality.v1.QuerySigningInfoRequest")
+	proto.RegisterType((*QuerySigningInfoResponse)(nil), "babylon.finality.v1.QuerySigningInfoResponse")
+	proto.RegisterType((*QuerySigningInfosRequest)(nil), "babylon.finality.v1.QuerySigningInfosRequest")
+	proto.RegisterType((*QuerySigningInfosResponse)(nil), "babylon.finality.v1.QuerySigningInfosResponse")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/query.proto", fileDescriptor_32bddab77af6fdae) }
 
 var fileDescriptor_32bddab77af6fdae = []byte{
-	// 1170 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0x38, 0x8d, 0xdb, 0xbc, 0x24, 0xdf, 0x6f, 0x32, 0x49, 0x4b, 0x70, 0x89, 0xe3, 0x6c,
-	0x21, 0x09, 0x49, 0xb5, 0xdb, 0x38, 0xa5, 0xb4, 0x20, 0xd4, 0xc6, 0x90, 0x90, 0x40, 0xea, 0x9a,
-	0x2d, 0xaa, 0xd4, 0x5e, 0xac, 0x5d, 0x67, 0x62, 0xaf, 0xe2, 0xdd, 0xd9, 0x7a, 0x67, 0xad, 0x58,
-	0x55, 0x25, 0xc4, 0xa1, 0x07, 0x04, 0x12, 0x12, 0x17, 0x2e, 0x3d, 0xd0, 0x2b, 0xff, 0x04, 0xc7,
-	0x1e, 0x23, 0xe0, 0x80, 0x2a, 0x11, 0xa1, 0x84, 0x3f, 0x04, 0x79, 0x66, 0xd6, 0xf6, 0x3a, 0xeb,
-	0x1f, 0x0d, 0x11, 0x37, 0xef, 0xec, 0xfb, 0xf1, 0x79, 0x9f, 0xf7, 0xd9, 0xf7, 0xc6, 0x30, 0x6b,
-	0x1a, 0x66, 0xad, 0x4c, 0x1d, 0x6d, 0xd7, 0x72, 0x8c, 0xb2, 0xc5, 0x6a, 0x5a, 0x75, 0x45, 0x7b,
-	0xec, 0x93, 0x4a, 0x4d, 0x75, 0x2b, 0x94, 0x51, 0x3c, 0x29, 0x0d, 0xd4, 0xc0, 0x40, 0xad, 0xae,
-	0x24, 0xa6, 0x8a, 0xb4, 0x48, 0xf9, 0x7b, 0xad, 0xfe, 0x4b, 0x98, 0x26, 0xde, 0x2a, 0x52, 0x5a,
-	0x2c, 0x13, 0xcd, 0x70, 0x2d, 0xcd, 0x70, 0x1c, 0xca, 0x0c, 0x66, 0x51, 0xc7, 0x93, 0x6f, 0x97,
-	0x0a, 0xd4, 0xb3, 0xa9, 0xa7, 0x99, 0x86, 0x47, 0x44, 0x06, 0xad, 0xba, 0x62, 0x12, 0x66, 0xac,
-	0x68, 0xae, 0x51, 0xb4, 0x1c, 0x6e, 0x2c, 0x6d, 0x53, 0x51, 0xa8, 0x5c, 0xa3, 0x62, 0xd8, 0x41,
-	0x34, 0x25, 0xca, 0xa2, 0x01, 0x91, 0xdb, 0x28, 0x53, 0x80, 0xbf, 0xa8, 0xe7, 0xc9, 0x71, 0x47,
-	0x9d, 0x3c, 0xf6, 0x89, 0xc7, 0x94, 0x1c, 0x4c, 0x86, 0x4e, 0x3d, 0x97, 0x3a, 0x1e, 0xc1, 0xb7,
-	0x20, 0x2e, 0x12, 0x4c, 0xa3, 0x14, 0x5a, 0x1c, 0x49, 0x5f, 0x56, 0x23, 0x0a, 0x57, 0x85, 0x53,
-	0xe6, 0xdc, 0xcb, 0xc3, 0xd9, 0x01, 0x5d, 0x3a, 0x28, 0xdf, 0x21, 0x48, 0xf1, 0x90, 0xdb, 0x96,
-	0xc7, 0x72, 0xbe, 0x59, 0xb6, 0x0a, 0xba, 0xe1, 0xec, 0x50, 0xdb, 0x21, 0x5e, 0x90, 0x16, 0xcf,
-	0xc1, 0xd8, 0xae, 0x9b, 0x37, 0x59, 0x21, 0xef, 0xee, 0xe5, 0x4b, 0x64, 0x9f, 0xa7, 0x19, 0xd6,
-	0x61, 0xd7, 0xcd, 0xb0, 0x42, 0x6e, 0x6f, 0x93, 0xec, 0xe3, 0x0d, 0x80, 0x26, 0x13, 0xd3, 0x31,
-	0x0e, 0x63, 0x5e, 0x15, 0xb4, 0xa9, 0x75, 0xda, 0x54, 0xd1, 0x18, 0x49, 0x9b, 0x9a, 0x33, 0x8a,
-	0x44, 0x86, 0xd7, 0x5b, 0x3c, 0x95, 0x83, 0x18, 0xcc, 0x75, 0xc1, 0x23, 0x0b, 0x7e, 0x81, 0x60,
-	0xd4, 0xf5, 0xcd, 0x7c, 0xc5, 0x70, 0x76, 0xf2, 0xb6, 0xe1, 0x4e, 0xa3, 0xd4, 0xe0, 0xe2, 0x48,
-	0x7a, 0x23, 0xb2, 0xee, 0x9e, 0xe1, 0xd4, 0x9c, 0x6f, 0xd6, 0x4f, 0xef, 0x1a, 0xee, 0xba, 0xc3,
-	0x2a, 0xb5, 0xcc, 0xcd, 0x57, 0x87, 0xb3, 0xd7, 0x8b, 0x16, 0x2b, 0xf9, 0xa6, 0x5a, 0xa0, 0xb6,
-	0x26, 0xa3, 0x16, 0x4a, 0x86, 0xe5, 0x04, 0x0f, 0x1a, 0xab, 0xb9, 0xc4, 0x53, 0xef, 0x17, 0x4a,
-	0x0e, 0xad, 0x54, 0x64, 0x04, 0x1d, 0xdc, 0x46, 0x28, 0xfc, 0x69, 0x04, 0x25, 0x0b, 0x3d, 0x29,
-	0x11, 0x90, 0x5a, 0x39, 0x49, 0x7c, 0x04, 0xff, 0x6f, 0x43, 0x88, 0xc7, 0x61, 0x70, 0x8f, 0xd4,
-	0x78, 0x1f, 0xce, 0xe9, 0xf5, 0x9f, 0x78, 0x0a, 0x86, 0xaa, 0x46, 0xd9, 0x27, 0x3c, 0xd1, 0xa8,
-	0x2e, 0x1e, 0x3e, 0x88, 0xdd, 0x44, 0xca, 0x43, 0xb8, 0x28, 0xdd, 0x3f, 0xa6, 0xb6, 0x6d, 0xb1,
-	0x06, 0x8b, 0x29, 0x18, 0x75, 0x7c, 0x3b, 0x1f, 0x10, 0x29, 0xa3, 0x81, 0xe3, 0xdb, 0xd2, 0x1e,
-	0x27, 0x01, 0x0a, 0xdc, 0xc7, 0x26, 0x0e, 0x93, 0x91, 0x5b, 0x4e, 0x94, 0x6f, 0x10, 0xcc, 0xb4,
-	0xd2, 0xdb, 0x9a, 0xe4, 0x3f, 0x97, 0xce, 0xef, 0x31, 0x48, 0x76, 0x02, 0x23, 0x2b, 0xde, 0x87,
-	0xc9, 0x86, 0x6c, 0x44, 0x19, 0x2d, 0xea, 0xd9, 0xea, 0xa9, 0x9e, 0x93, 0x11, 0xd5, 0xd0, 0x69,
-	0xd0, 0x1e, 0x7d, 0xdc, 0x6d, 0x3b, 0x3e, 0x3b, 0x31, 0xd0, 0xb6, 0x6e, 0x76, 0x91, 0xc4, 0x9d,
-	0x56, 0x49, 0x8c, 0xa4, 0x97, 0xa2, 0xa7, 0x42, 0x54, 0x59, 0xad, 0xf2, 0x59, 0x86, 0x09, 0xce,
-	0x41, 0xa6, 0x4c, 0x0b, 0x7b, 0x41, 0x5b, 0x2f, 0x41, 0xbc, 0x44, 0xac, 0x62, 0x89, 0xc9, 0x7c,
-	0xf2, 0x49, 0xb9, 0x2b, 0xc7, 0x96, 0x34, 0x96, 0xb4, 0xbf, 0x0f, 0x43, 0x66, 0xfd, 0x40, 0x8e,
-	0xa7, 0xb9, 0x48, 0x20, 0x5b, 0xce, 0x0e, 0xd9, 0x27, 0x3b, 0xc2, 0x53, 0xd8, 0x2b, 0x3f, 0x21,
-	0xb8, 0xd4, 0x68, 0x00, 0x7f, 0xd3, 0x98, 0x49, 0xb7, 0x21, 0xee, 0x31, 0x83, 0xf9, 0x62, 0xe6,
-	0xfd, 0x2f, 0xbd, 0xd0, 0xb1, 0x7b, 0x96, 0x0c, 0x7a, 0x9f, 0x9b, 0xeb, 0xd2, 0xed, 0xcc, 0x64,
-	0xf7, 0x1c, 0xc1, 0x1b, 0x27, 0x30, 0x36, 0x07, 0x33, 0x2f, 0xc4, 0x93, 0x12, 0xeb, 0xa3, 0x72,
-	0xe9, 0x70, 0x66, 0x82, 0x51, 0x56, 0xe1, 0x4d, 0x0e, 0xef, 0x01, 0x65, 0xc4, 0x5b, 0x63, 0x9b,
-	0xbc, 0x51, 0xbd, 0xfa, 0x68, 0x43, 0x22, 0xca, 0x49, 0x96, 0x75, 0x0f, 0xce, 0x8b, 0x2f, 0x5a,
-	0xd4, 0x35, 0x9a, 0xb9, 0xf1, 0xea, 0x70, 0x36, 0xdd, 0xdf, 0xc0, 0xcc, 0x6c, 0xe5, 0x56, 0xaf,
-	0x5f, 0xcb, 0xf9, 0xe6, 0xe7, 0xa4, 0xa6, 0xc7, 0xcd, 0xfa, 0x10, 0xf0, 0x94, 0x5b, 0x30, 0xc5,
-	0xd3, 0xad, 0x57, 0xad, 0x1d, 0xe2, 0x14, 0x48, 0xff, 0xd3, 0x43, 0xd1, 0xe1, 0x62, 0x9b, 0x6b,
-	0x83, 0xfb, 0x0b, 0x44, 0x9e, 0x49, 0xdd, 0xcd, 0x44, 0xb2, 0xdf, 0x70, 0x6c, 0x98, 0x2b, 0xcf,
-	0x90, 0xe4, 0xac, 0xde, 0xd2, 0xe0, 0x7d, 0xcb, 0x36, 0x1c, 0xf5, 0x98, 0x51, 0x61, 0xf9, 0x10,
-	0x73, 0x23, 0xfc, 0x4c, 0x10, 0x75, 0x66, 0xda, 0x7a, 0x81, 0x64, 0x1f, 0xda, 0x80, 0xc8, 0x12,
-	0x3f, 0x84, 0xe1, 0x00, 0x73, 0xa0, 0xb0, 0x1e, 0x35, 0x36, 0xed, 0xcf, 0x4c, 0x60, 0x4b, 0xb7,
-	0xc5, 0x37, 0x1f, 0xfe, 0xcc, 0xf0, 0x04, 0x8c, 0x65, 0xef, 0x65, 0xf3, 0x1b, 0x5b, 0xd9, 0xb5,
-	0xed, 0xad, 0x47, 0xeb, 0x9f, 0x8c, 0x0f, 0xe0, 0x31, 0x18, 0x6e, 0x3e, 0x22, 0x7c, 0x1e, 0x06,
-	0xd7, 0xb2, 0x0f, 0xc7, 0x63, 0xe9, 0x5f, 0x00, 0x86, 0x78, 0x95, 0xf8, 0x2b, 0x04, 0x71, 0x71,
-	0x4d, 0xc1, 0x9d, 0xbf, 0xe7, 0xf0, 0x9d, 0x28, 0xb1, 0xd8, 0xdb, 0x50, 0x80, 0x56, 0xae, 0x7c,
-	0xfd, 0xdb, 0xdf, 0x3f, 0xc4, 0x66, 0xf0, 0x65, 0xad, 0xf3, 0x15, 0x0d, 0xff, 0x89, 0x60, 0x2a,
-	0xea, 0xb2, 0x80, 0xdf, 0x7b, 0xdd, 0xcb, 0x85, 0x80, 0x77, 0xe3, 0x74, 0x77, 0x12, 0xe5, 0x01,
-	0x07, 0x9b, 0xc3, 0x59, 0xad, 0xdb, 0x6d, 0x31, 0xef, 0x56, 0x68, 0xbd, 0xa3, 0x15, 0x4f, 0x7b,
-	0x12, 0xfa, 0x52, 0x9e, 0x6a, 0x2e, 0x8f, 0xcc, 0x77, 0x9d, 0x08, 0x9d, 0x2f, 0x5b, 0x1e, 0xc3,
-	0xbf, 0x22, 0x98, 0x38, 0xb1, 0xce, 0x70, 0xfa, 0xb5, 0x76, 0x9f, 0xa8, 0x6c, 0xf5, 0x14, 0xfb,
-	0x52, 0xf9, 0x92, 0x97, 0x95, 0xc5, 0xdb, 0xff, 0xa2, 0xac, 0xd0, 0xfe, 0xe6, 0x45, 0x3d, 0x43,
-	0x30, 0xc4, 0xc5, 0x87, 0xe7, 0x3b, 0x83, 0x6a, 0x5d, 0x60, 0x89, 0x85, 0x9e, 0x76, 0x12, 0xf0,
-	0x55, 0x0e, 0x78, 0x1e, 0xbf, 0x1d, 0x09, 0x58, 0x0c, 0x6b, 0xed, 0x89, 0x18, 0x05, 0x4f, 0xf1,
-	0xb7, 0x08, 0xa0, 0xb9, 0x07, 0xf0, 0x72, 0x77, 0x8a, 0x42, 0x1b, 0x2d, 0x71, 0xb5, 0x3f, 0xe3,
-	0xbe, 0xc4, 0x2c, 0x97, 0xc8, 0x73, 0x04, 0x63, 0xa1, 0x11, 0x8e, 0xd5, 0xce, 0x49, 0xa2, 0x16,
-	0x44, 0x42, 0xeb, 0xdb, 0x5e, 0xe2, 0x5a, 0xe6, 0xb8, 0xde, 0xc1, 0x57, 0x22, 0x71, 0x55, 0xeb,
-	0x3e, 0x4d, 0xba, 0x7e, 0x46, 0x70, 0x21, 0x98, 0x4d, 0xf8, 0xdd, 0xce, 0xa9, 0xda, 0xf6, 0x42,
-	0x62, 0xa9, 0x1f, 0x53, 0x09, 0x68, 0x93, 0x03, 0xca, 0xe0, 0x3b, 0xa7, 0x55, 0x5c, 0x30, 0x32,
-	0xf1, 0x8f, 0x08, 0xc6, 0x42, 0x83, 0xb8, 0x1b, 0x9b, 0x51, 0xab, 0xa3, 0x1b, 0x9b, 0x91, 0x13,
-	0x5e, 0x99, 0xe7, 0xe0, 0x53, 0x38, 0x19, 0x09, 0xbe, 0x31, 0xcc, 0x33, 0x9f, 0xbd, 0x3c, 0x4a,
-	0xa2, 0x83, 0xa3, 0x24, 0xfa, 0xeb, 0x28, 0x89, 0xbe, 0x3f, 0x4e, 0x0e, 0x1c, 0x1c, 0x27, 0x07,
-	0xfe, 0x38, 0x4e, 0x0e, 0x3c, 0xba, 0xd6, 0x6b, 0x2d, 0xef, 0x37, 0x43, 0xf2, 0x0d, 0x6d, 0xc6,
-	0xf9, 0x3f, 0xd0, 0xd5, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x4f, 0x9a, 0xb0, 0x5f, 0x0f,
-	0x00, 0x00,
+	// 1306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdf, 0x6f, 0xdb, 0x54,
+	0x14, 0xee, 0xcd, 0xd6, 0x6c, 0x3b, 0x49, 0xa0, 0xbb, 0xeb, 0x46, 0xe7, 0xb1, 0x34, 0xf3, 0x46,
+	0x5b, 0xda, 0x61, 0xb7, 0x69, 0x19, 0xdb, 0x00, 0x6d, 0x0d, 0xb4, 0x34, 0xd0, 0x65, 0xc1, 0x45,
+	0x93, 0x3a, 0x1e, 0x2c, 0x3b, 0xbd, 0x4d, 0xac, 0x26, 0xb6, 0x17, 0xdb, 0x51, 0xa3, 0x69, 0x12,
+	0x42, 0x62, 0x0f, 0x08, 0x24, 0x24, 0x5e, 0xe0, 0x61, 0x0f, 0xec, 0x81, 0x17, 0xfe, 0x0a, 0xde,
+	0xf6, 0x58, 0x01, 0x0f, 0x68, 0x12, 0x15, 0x6a, 0xf9, 0x43, 0x50, 0xae, 0xaf, 0x1d, 0xbb, 0x71,
+	0x9a, 0xb4, 0x44, 0xbc, 0xd9, 0xd7, 0xe7, 0xc7, 0xf7, 0x7d, 0xe7, 0xe4, 0x9e, 0xd3, 0xc2, 0xb8,
+	0xaa, 0xa8, 0xcd, 0xaa, 0xa1, 0x8b, 0x9b, 0x9a, 0xae, 0x54, 0x35, 0xbb, 0x29, 0x36, 0xe6, 0xc4,
+	0x47, 0x0e, 0xa9, 0x37, 0x05, 0xb3, 0x6e, 0xd8, 0x06, 0x3e, 0xc7, 0x0c, 0x04, 0xcf, 0x40, 0x68,
+	0xcc, 0x71, 0xa3, 0x65, 0xa3, 0x6c, 0xd0, 0xef, 0x62, 0xeb, 0xc9, 0x35, 0xe5, 0x5e, 0x2f, 0x1b,
+	0x46, 0xb9, 0x4a, 0x44, 0xc5, 0xd4, 0x44, 0x45, 0xd7, 0x0d, 0x5b, 0xb1, 0x35, 0x43, 0xb7, 0xd8,
+	0xd7, 0xe9, 0x92, 0x61, 0xd5, 0x0c, 0x4b, 0x54, 0x15, 0x8b, 0xb8, 0x19, 0xc4, 0xc6, 0x9c, 0x4a,
+	0x6c, 0x65, 0x4e, 0x34, 0x95, 0xb2, 0xa6, 0x53, 0x63, 0x66, 0x9b, 0x89, 0x42, 0x65, 0x2a, 0x75,
+	0xa5, 0xe6, 0x45, 0xe3, 0xa3, 0x2c, 0x7c, 0x88, 0xd4, 0x86, 0x1f, 0x05, 0xfc, 0x69, 0x2b, 0x4f,
+	0x91, 0x3a, 0x4a, 0xe4, 0x91, 0x43, 0x2c, 0x9b, 0x2f, 0xc2, 0xb9, 0xd0, 0xa9, 0x65, 0x1a, 0xba,
+	0x45, 0xf0, 0x2d, 0x88, 0xbb, 0x09, 0xc6, 0x50, 0x06, 0x4d, 0x25, 0xb2, 0x97, 0x84, 0x08, 0xe2,
+	0x82, 0xeb, 0x94, 0x3b, 0xf9, 0x62, 0x77, 0x7c, 0x48, 0x62, 0x0e, 0xfc, 0xb7, 0x08, 0x32, 0x34,
+	0xe4, 0xaa, 0x66, 0xd9, 0x45, 0x47, 0xad, 0x6a, 0x25, 0x49, 0xd1, 0x37, 0x8c, 0x9a, 0x4e, 0x2c,
+	0x2f, 0x2d, 0xbe, 0x02, 0xa9, 0x4d, 0x53, 0x56, 0xed, 0x92, 0x6c, 0x6e, 0xc9, 0x15, 0xb2, 0x4d,
+	0xd3, 0x9c, 0x91, 0x60, 0xd3, 0xcc, 0xd9, 0xa5, 0xe2, 0xd6, 0x0a, 0xd9, 0xc6, 0xcb, 0x00, 0x6d,
+	0x25, 0xc6, 0x62, 0x14, 0xc6, 0x84, 0xe0, 0xca, 0x26, 0xb4, 0x64, 0x13, 0xdc, 0xc2, 0x30, 0xd9,
+	0x84, 0xa2, 0x52, 0x26, 0x2c, 0xbc, 0x14, 0xf0, 0xe4, 0x77, 0x62, 0x70, 0xe5, 0x10, 0x3c, 0x8c,
+	0xf0, 0x73, 0x04, 0x49, 0xd3, 0x51, 0xe5, 0xba, 0xa2, 0x6f, 0xc8, 0x35, 0xc5, 0x1c, 0x43, 0x99,
+	0x13, 0x53, 0x89, 0xec, 0x72, 0x24, 0xef, 0x9e, 0xe1, 0x84, 0xa2, 0xa3, 0xb6, 0x4e, 0xef, 0x29,
+	0xe6, 0x92, 0x6e, 0xd7, 0x9b, 0xb9, 0x9b, 0x2f, 0x77, 0xc7, 0x17, 0xca, 0x9a, 0x5d, 0x71, 0x54,
+	0xa1, 0x64, 0xd4, 0x44, 0x16, 0xb5, 0x54, 0x51, 0x34, 0xdd, 0x7b, 0x11, 0xed, 0xa6, 0x49, 0x2c,
+	0x61, 0xad, 0x54, 0xd1, 0x8d, 0x7a, 0x9d, 0x45, 0x90, 0xc0, 0xf4, 0x43, 0xe1, 0x8f, 0x22, 0x24,
+	0x99, 0xec, 0x29, 0x89, 0x0b, 0x29, 0xa8, 0x09, 0xf7, 0x3e, 0xbc, 0x7a, 0x00, 0x21, 0x1e, 0x81,
+	0x13, 0x5b, 0xa4, 0x49, 0xeb, 0x70, 0x52, 0x6a, 0x3d, 0xe2, 0x51, 0x18, 0x6e, 0x28, 0x55, 0x87,
+	0xd0, 0x44, 0x49, 0xc9, 0x7d, 0xb9, 0x1d, 0xbb, 0x89, 0xf8, 0x75, 0x38, 0xcf, 0xdc, 0x3f, 0x30,
+	0x6a, 0x35, 0xcd, 0xf6, 0x55, 0xcc, 0x40, 0x52, 0x77, 0x6a, 0xb2, 0x27, 0x24, 0x8b, 0x06, 0xba,
+	0x53, 0x63, 0xf6, 0x38, 0x0d, 0x50, 0xa2, 0x3e, 0x35, 0xa2, 0xdb, 0x2c, 0x72, 0xe0, 0x84, 0xff,
+	0x1a, 0xc1, 0xe5, 0xa0, 0xbc, 0xc1, 0x24, 0xff, 0x7b, 0xeb, 0xfc, 0x11, 0x83, 0x74, 0x37, 0x30,
+	0x8c, 0xf1, 0x36, 0x9c, 0xf3, 0xdb, 0xc6, 0xa5, 0x11, 0xe8, 0x9e, 0x7c, 0xcf, 0xee, 0xe9, 0x8c,
+	0x28, 0x84, 0x4e, 0xbd, 0xf2, 0x48, 0x23, 0xe6, 0x81, 0xe3, 0xc1, 0x35, 0x83, 0x01, 0xe7, 0x23,
+	0x73, 0x46, 0xb4, 0xc4, 0xdd, 0x60, 0x4b, 0x24, 0xb2, 0xd3, 0xd1, 0xb7, 0x42, 0x14, 0xad, 0x60,
+	0xfb, 0xcc, 0xc0, 0x59, 0xaa, 0x41, 0xae, 0x6a, 0x94, 0xb6, 0xbc, 0xb2, 0x5e, 0x80, 0x78, 0x85,
+	0x68, 0xe5, 0x8a, 0xcd, 0xf2, 0xb1, 0x37, 0xfe, 0x1e, 0xe0, 0xa0, 0x31, 0x93, 0xfd, 0x1d, 0x18,
+	0x56, 0x5b, 0x07, 0xec, 0x7a, 0xba, 0x12, 0x09, 0x24, 0xaf, 0x6f, 0x90, 0x6d, 0xb2, 0xe1, 0x7a,
+	0xba, 0xf6, 0xfc, 0x4f, 0x08, 0x2e, 0xf8, 0x05, 0xa0, 0x5f, 0xfc, 0x3b, 0xe9, 0x0e, 0xc4, 0x2d,
+	0x5b, 0xb1, 0x1d, 0xf7, 0xce, 0x7b, 0x25, 0x3b, 0xd9, 0xb5, 0x7a, 0x1a, 0x0b, 0xba, 0x46, 0xcd,
+	0x25, 0xe6, 0x36, 0xb0, 0xb6, 0x7b, 0x86, 0xe0, 0xb5, 0x0e, 0x8c, 0xed, 0x8b, 0x99, 0x12, 0xb1,
+	0x58, 0x8b, 0xf5, 0xc1, 0x9c, 0x39, 0x0c, 0xac, 0x61, 0xf8, 0x79, 0xb8, 0x48, 0xe1, 0x3d, 0x30,
+	0x6c, 0x62, 0x2d, 0xda, 0x2b, 0xb4, 0x50, 0xbd, 0xea, 0x58, 0x03, 0x2e, 0xca, 0x89, 0xd1, 0xba,
+	0x0f, 0xa7, 0xdc, 0x5f, 0xb4, 0xcb, 0x2b, 0x99, 0xbb, 0xf1, 0x72, 0x77, 0x3c, 0xdb, 0xdf, 0x85,
+	0x99, 0xcb, 0x17, 0xe7, 0x17, 0x66, 0x8b, 0x8e, 0xfa, 0x09, 0x69, 0x4a, 0x71, 0xb5, 0x75, 0x09,
+	0x58, 0xfc, 0x2d, 0x18, 0xa5, 0xe9, 0x96, 0x1a, 0xda, 0x06, 0xd1, 0x4b, 0xa4, 0xff, 0xdb, 0x83,
+	0x97, 0xe0, 0xfc, 0x01, 0x57, 0x5f, 0xfb, 0xd3, 0x84, 0x9d, 0xb1, 0xbe, 0xbb, 0x1c, 0xa9, 0xbe,
+	0xef, 0xe8, 0x9b, 0xf3, 0x4f, 0x11, 0x5c, 0xf4, 0x4b, 0xea, 0x7d, 0x0f, 0x4c, 0xc3, 0xa4, 0x65,
+	0x2b, 0x75, 0x5b, 0x0e, 0x29, 0x97, 0xa0, 0x67, 0xae, 0x50, 0x03, 0xeb, 0xad, 0xe7, 0x08, 0xb8,
+	0x28, 0x20, 0x8c, 0xe2, 0xbb, 0x70, 0xc6, 0xc3, 0xec, 0x75, 0x58, 0x0f, 0x8e, 0x6d, 0xfb, 0xc1,
+	0x35, 0xd8, 0x7b, 0xac, 0xff, 0xd7, 0xb4, 0xb2, 0xae, 0xe9, 0xe5, 0xbc, 0xbe, 0x69, 0x1c, 0xa1,
+	0x7e, 0x0e, 0x8c, 0x75, 0x7a, 0x33, 0x7e, 0xeb, 0x90, 0xb4, 0xdc, 0x63, 0x59, 0xd3, 0x37, 0x0d,
+	0x56, 0xc6, 0xd9, 0x48, 0x8a, 0xcb, 0xec, 0xb9, 0x58, 0x37, 0x5a, 0x14, 0xeb, 0x81, 0x78, 0x6c,
+	0xe5, 0x49, 0x58, 0xed, 0x23, 0x5e, 0xed, 0x4c, 0xeb, 0x17, 0x38, 0x5c, 0x3d, 0x74, 0xec, 0xea,
+	0xfd, 0xea, 0xb5, 0x51, 0x38, 0x09, 0x23, 0xf7, 0x39, 0xa4, 0x82, 0xe4, 0xbc, 0x02, 0x1e, 0x97,
+	0x5d, 0x32, 0xc0, 0x6e, 0x70, 0xc5, 0x9d, 0xbe, 0x03, 0xb8, 0xf3, 0x0e, 0xc5, 0x67, 0x21, 0x55,
+	0xb8, 0x5f, 0x90, 0x97, 0xf3, 0x85, 0xc5, 0xd5, 0xfc, 0xc3, 0xa5, 0x0f, 0x47, 0x86, 0x70, 0x0a,
+	0xce, 0xb4, 0x5f, 0x11, 0x3e, 0x05, 0x27, 0x16, 0x0b, 0xeb, 0x23, 0xb1, 0xec, 0x57, 0x29, 0x18,
+	0xa6, 0x22, 0xe0, 0x2f, 0x10, 0xc4, 0xdd, 0x1d, 0x14, 0x77, 0xbf, 0xac, 0xc3, 0x0b, 0x2f, 0x37,
+	0xd5, 0xdb, 0xd0, 0x05, 0xcd, 0x5f, 0xfd, 0xf2, 0xf7, 0x7f, 0xbe, 0x8f, 0x5d, 0xc6, 0x97, 0xc4,
+	0xee, 0xfb, 0x37, 0xfe, 0x0b, 0xc1, 0x68, 0xd4, 0x26, 0x88, 0xdf, 0x3e, 0xea, 0xe6, 0xe8, 0xc2,
+	0xbb, 0x71, 0xbc, 0x85, 0x93, 0x7f, 0x40, 0xc1, 0x16, 0x71, 0x41, 0x3c, 0xec, 0x4f, 0x01, 0xd9,
+	0x64, 0xd5, 0xb6, 0xc4, 0xc7, 0xa1, 0x9f, 0xd1, 0x13, 0xd1, 0xa4, 0x91, 0xe5, 0xba, 0x1f, 0x5a,
+	0xae, 0x6a, 0x96, 0x8d, 0x7f, 0x43, 0x70, 0xb6, 0x63, 0x57, 0xc1, 0xd9, 0x23, 0x2d, 0x36, 0x2e,
+	0xb3, 0xf9, 0x63, 0x2c, 0x43, 0xfc, 0x67, 0x94, 0x56, 0x01, 0xaf, 0xfe, 0x07, 0x5a, 0xa1, 0xe5,
+	0x8c, 0x92, 0x7a, 0x8a, 0x60, 0x98, 0x36, 0x1f, 0x9e, 0xe8, 0x0e, 0x2a, 0xb8, 0x9d, 0x70, 0x93,
+	0x3d, 0xed, 0x18, 0xe0, 0xeb, 0x14, 0xf0, 0x04, 0xbe, 0x16, 0x09, 0xd8, 0x9d, 0xc4, 0xe2, 0x63,
+	0xf7, 0x9e, 0x7f, 0x82, 0xbf, 0x41, 0x00, 0xed, 0x21, 0x8f, 0x67, 0x0e, 0x97, 0x28, 0xb4, 0xae,
+	0x70, 0xd7, 0xfb, 0x33, 0xee, 0xab, 0x99, 0xd9, 0x86, 0xf0, 0x0c, 0x41, 0x2a, 0x34, 0x9f, 0xb1,
+	0xd0, 0x3d, 0x49, 0xd4, 0xf4, 0xe7, 0xc4, 0xbe, 0xed, 0x19, 0xae, 0x19, 0x8a, 0xeb, 0x0d, 0x7c,
+	0x35, 0x12, 0x57, 0xa3, 0xe5, 0xd3, 0x96, 0xeb, 0x17, 0x04, 0xa7, 0xbd, 0xc1, 0x83, 0xdf, 0xec,
+	0x9e, 0xea, 0xc0, 0xd0, 0xe7, 0xa6, 0xfb, 0x31, 0x65, 0x80, 0x56, 0x28, 0xa0, 0x1c, 0xbe, 0x7b,
+	0xdc, 0x8e, 0xf3, 0xe6, 0x21, 0xfe, 0x01, 0x41, 0x2a, 0x34, 0x65, 0x0f, 0x53, 0x33, 0x6a, 0x2f,
+	0xe0, 0xc4, 0xbe, 0xed, 0x19, 0xf8, 0x09, 0x0a, 0x3e, 0x83, 0xd3, 0x91, 0xe0, 0xdb, 0x93, 0xfa,
+	0x67, 0x04, 0x89, 0xc0, 0x85, 0x8f, 0x0f, 0xe9, 0xa5, 0xce, 0x19, 0xcc, 0xbd, 0xd5, 0xa7, 0x35,
+	0x03, 0x75, 0x9b, 0x82, 0x5a, 0xc0, 0xd9, 0x48, 0x50, 0xa1, 0x89, 0x75, 0x50, 0x4c, 0xfc, 0x23,
+	0x82, 0x64, 0x70, 0xd6, 0xe1, 0xfe, 0x72, 0xfb, 0x0a, 0x0a, 0xfd, 0x9a, 0x33, 0xac, 0xd3, 0x14,
+	0xeb, 0x35, 0xcc, 0xf7, 0xc6, 0x9a, 0xfb, 0xf8, 0xc5, 0x5e, 0x1a, 0xed, 0xec, 0xa5, 0xd1, 0xdf,
+	0x7b, 0x69, 0xf4, 0xdd, 0x7e, 0x7a, 0x68, 0x67, 0x3f, 0x3d, 0xf4, 0xe7, 0x7e, 0x7a, 0xe8, 0xe1,
+	0x6c, 0xaf, 0xc5, 0x75, 0xbb, 0x1d, 0x96, 0xee, 0xb0, 0x6a, 0x9c, 0xfe, 0x8f, 0x66, 0xfe, 0xdf,
+	0x01, 0x00, 0x7b, 0x9d, 0x20, 0x0a, 0x81, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1056,6 +1265,10 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// ListEvidences queries is a range query for evidences
 	ListEvidences(ctx context.Context, in *QueryListEvidencesRequest, opts ...grpc.CallOption) (*QueryListEvidencesResponse, error)
+	// SigningInfo queries the signing info of a given finality provider
+	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
+	// SigningInfos queries the signing info of all finality providers
+	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
 }
 
 type queryClient struct {
@@ -1138,6 +1351,24 @@ func (c *queryClient) ListEvidences(ctx context.Context, in *QueryListEvidencesR
 	return out, nil
 }
 
+func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error) {
+	out := new(QuerySigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/SigningInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error) {
+	out := new(QuerySigningInfosResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/SigningInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1160,6 +1391,10 @@ type QueryServer interface {
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// ListEvidences queries is a range query for evidences
 	ListEvidences(context.Context, *QueryListEvidencesRequest) (*QueryListEvidencesResponse, error)
+	// SigningInfo queries the signing info of a given finality provider
+	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
+	// SigningInfos queries the signing info of all finality providers
+	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1190,6 +1425,12 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) ListEvidences(ctx context.Context, req *QueryListEvidencesRequest) (*QueryListEvidencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEvidences not implemented")
 }
+func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfo not implemented")
+}
+func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1339,6 +1580,42 @@ func _Query_ListEvidences_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/SigningInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfo(ctx, req.(*QuerySigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/SigningInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfos(ctx, req.(*QuerySigningInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.finality.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1375,6 +1652,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListEvidences",
 			Handler:    _Query_ListEvidences_Handler,
 		},
+		{
+			MethodName: "SigningInfo",
+			Handler:    _Query_SigningInfo_Handler,
+		},
+		{
+			MethodName: "SigningInfos",
+			Handler:    _Query_SigningInfos_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/finality/v1/query.proto",
@@ -2044,62 +2329,209 @@ func (m *QueryListEvidencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySigningInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QuerySigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListPublicRandomnessRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySigningInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.SigningInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListPublicRandomnessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySigningInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PubRandMap) > 0 {
-		for k, v := range m.PubRandMap {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListPublicRandomnessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPublicRandomnessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PubRandMap) > 0 {
+		for k, v := range m.PubRandMap {
 			_ = k
 			_ = v
 			l = 0
@@ -2325,6 +2757,62 @@ func (m *QueryListEvidencesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4133,6 +4621,377 @@ func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, FinalityProviderSigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
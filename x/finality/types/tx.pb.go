@@ -365,6 +365,92 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUnjailFinalityProvider defines a message for unjailing a jailed finality
+// provider after its jail duration has passed
+type MsgUnjailFinalityProvider struct {
+	// signer is the address of the finality provider
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider to unjail
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+}
+
+func (m *MsgUnjailFinalityProvider) Reset()         { *m = MsgUnjailFinalityProvider{} }
+func (m *MsgUnjailFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailFinalityProvider) ProtoMessage()    {}
+func (*MsgUnjailFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{6}
+}
+func (m *MsgUnjailFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailFinalityProvider.Merge(m, src)
+}
+func (m *MsgUnjailFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailFinalityProvider proto.InternalMessageInfo
+
+func (m *MsgUnjailFinalityProvider) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgUnjailFinalityProviderResponse is the response to the MsgUnjailFinalityProvider message
+type MsgUnjailFinalityProviderResponse struct {
+}
+
+func (m *MsgUnjailFinalityProviderResponse) Reset()         { *m = MsgUnjailFinalityProviderResponse{} }
+func (m *MsgUnjailFinalityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailFinalityProviderResponse) ProtoMessage()    {}
+func (*MsgUnjailFinalityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{7}
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailFinalityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailFinalityProviderResponse.Merge(m, src)
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailFinalityProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailFinalityProviderResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCommitPubRandList)(nil), "babylon.finality.v1.MsgCommitPubRandList")
 	proto.RegisterType((*MsgCommitPubRandListResponse)(nil), "babylon.finality.v1.MsgCommitPubRandListResponse")
@@ -372,57 +458,62 @@ func init() {
 	proto.RegisterType((*MsgAddFinalitySigResponse)(nil), "babylon.finality.v1.MsgAddFinalitySigResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.finality.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.finality.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUnjailFinalityProvider)(nil), "babylon.finality.v1.MsgUnjailFinalityProvider")
+	proto.RegisterType((*MsgUnjailFinalityProviderResponse)(nil), "babylon.finality.v1.MsgUnjailFinalityProviderResponse")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/tx.proto", fileDescriptor_2dd6da066b6baf1d) }
 
 var fileDescriptor_2dd6da066b6baf1d = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x4f, 0x13, 0x4d,
-	0x1c, 0xee, 0x52, 0x28, 0x2f, 0xd3, 0x86, 0x37, 0xec, 0x4b, 0x5e, 0x96, 0x82, 0xdb, 0xda, 0x10,
-	0x83, 0x44, 0x77, 0xa1, 0x20, 0x11, 0x6e, 0xd4, 0x68, 0x50, 0x6c, 0x6c, 0xb6, 0x7a, 0xd1, 0xc3,
-	0x66, 0xff, 0x75, 0x76, 0x02, 0x3b, 0x33, 0xee, 0xcc, 0x12, 0x7a, 0x33, 0x7e, 0x02, 0x0f, 0x7e,
-	0x10, 0x0e, 0x26, 0x9e, 0xbd, 0x71, 0x31, 0x21, 0x9e, 0x0c, 0x87, 0xc6, 0xc0, 0x81, 0xaf, 0x61,
-	0x76, 0x77, 0x4a, 0x29, 0x94, 0x58, 0x3d, 0x78, 0xdb, 0x99, 0xdf, 0x33, 0xf3, 0x3c, 0xfb, 0x7b,
-	0x9e, 0xdf, 0x80, 0x79, 0xdb, 0xb2, 0xdb, 0x7b, 0x04, 0xeb, 0x2d, 0x84, 0xad, 0x3d, 0xc4, 0xdb,
-	0xfa, 0xfe, 0x8a, 0xce, 0x0f, 0x34, 0x1a, 0x12, 0x4e, 0xe4, 0xff, 0x44, 0x55, 0xeb, 0x56, 0xb5,
-	0xfd, 0x95, 0xe2, 0x34, 0x24, 0x90, 0x24, 0x75, 0x3d, 0xfe, 0x4a, 0xa1, 0xc5, 0x5b, 0xdc, 0xc3,
-	0xae, 0x17, 0x06, 0x08, 0x73, 0xdd, 0x09, 0xdb, 0x94, 0x13, 0x9d, 0x86, 0x84, 0xb4, 0x44, 0x79,
-	0xd6, 0x21, 0x2c, 0x20, 0xcc, 0x4c, 0xcf, 0xa5, 0x0b, 0x51, 0x9a, 0x49, 0x57, 0x7a, 0xc0, 0x60,
-	0x4c, 0x1e, 0x30, 0x28, 0x0a, 0xe5, 0x41, 0xda, 0xa8, 0x15, 0x5a, 0x81, 0x38, 0x5a, 0xf9, 0x32,
-	0x02, 0xa6, 0xeb, 0x0c, 0x3e, 0x22, 0x41, 0x80, 0x78, 0x23, 0xb2, 0x0d, 0x0b, 0xbb, 0xcf, 0x11,
-	0xe3, 0xf2, 0xff, 0x20, 0xc7, 0x10, 0xc4, 0x5e, 0xa8, 0x48, 0x65, 0x69, 0x71, 0xc2, 0x10, 0x2b,
-	0xd9, 0x00, 0x13, 0x2d, 0x6a, 0xda, 0xdc, 0x31, 0xe9, 0xae, 0x32, 0x52, 0x96, 0x16, 0x0b, 0xb5,
-	0xf5, 0x93, 0x4e, 0xa9, 0x0a, 0x11, 0xf7, 0x23, 0x5b, 0x73, 0x48, 0xa0, 0x0b, 0x52, 0xc7, 0xb7,
-	0x10, 0xee, 0x2e, 0x74, 0xde, 0xa6, 0x1e, 0xd3, 0x6a, 0x4f, 0x1b, 0xab, 0x6b, 0xcb, 0x8d, 0xc8,
-	0xde, 0xf1, 0xda, 0xc6, 0x78, 0x8b, 0xd6, 0xb8, 0xd3, 0xd8, 0x95, 0x6f, 0x83, 0x02, 0xe3, 0x56,
-	0xc8, 0x4d, 0xdf, 0x43, 0xd0, 0xe7, 0x4a, 0xb6, 0x2c, 0x2d, 0x8e, 0x1a, 0xf9, 0x64, 0x6f, 0x3b,
-	0xd9, 0x92, 0xcb, 0xa0, 0x80, 0xa3, 0xc0, 0xa4, 0x91, 0x6d, 0x86, 0x16, 0x76, 0x95, 0xd1, 0x04,
-	0x02, 0x70, 0x14, 0x08, 0xd1, 0xb2, 0x0a, 0x80, 0x93, 0xfc, 0x45, 0xe0, 0x61, 0xae, 0x8c, 0xc5,
-	0xca, 0x8c, 0x4b, 0x3b, 0xf2, 0x0e, 0xc8, 0x32, 0x04, 0x95, 0x5c, 0x22, 0x79, 0xe3, 0xa4, 0x53,
-	0x7a, 0xf0, 0x3b, 0x92, 0x9b, 0x08, 0x62, 0x8b, 0x47, 0xa1, 0x67, 0xc4, 0xb7, 0x6c, 0xe6, 0xdf,
-	0x9f, 0x1f, 0x2e, 0x89, 0x96, 0x54, 0x54, 0x30, 0x3f, 0xa8, 0x85, 0x86, 0xc7, 0x28, 0xc1, 0xcc,
-	0xab, 0x7c, 0xce, 0x82, 0xa9, 0x3a, 0x83, 0x5b, 0xae, 0xfb, 0x44, 0xd8, 0xd0, 0x44, 0xf0, 0x6f,
-	0x37, 0xd8, 0xde, 0x23, 0xce, 0xee, 0x95, 0x06, 0x27, 0x7b, 0xa2, 0xc1, 0x4d, 0xf0, 0x4f, 0x5f,
-	0x73, 0x0b, 0xb5, 0x87, 0x27, 0x9d, 0xd2, 0xda, 0x70, 0xac, 0x4d, 0xc7, 0xc7, 0x24, 0x0c, 0xc5,
-	0xcf, 0x1b, 0xe3, 0x54, 0x78, 0xa2, 0x81, 0xb1, 0x24, 0xc2, 0x89, 0x1d, 0xf9, 0xaa, 0xa2, 0xf5,
-	0x22, 0xae, 0xa5, 0x11, 0xd7, 0x1a, 0x71, 0xdd, 0x48, 0x61, 0xf2, 0x02, 0x98, 0x4c, 0x75, 0x5a,
-	0x94, 0x9a, 0xbe, 0xc5, 0xfc, 0xd4, 0x2e, 0x23, 0x55, 0xbf, 0x45, 0xe9, 0xb6, 0xc5, 0x7c, 0xf9,
-	0x0d, 0x28, 0x74, 0xf3, 0x6c, 0xc6, 0x96, 0x8e, 0xff, 0xa1, 0xdc, 0xc7, 0x2f, 0x5e, 0x36, 0x9b,
-	0x08, 0x1a, 0xf9, 0x56, 0xcf, 0x96, 0x7e, 0x67, 0xe7, 0xc0, 0xec, 0x35, 0xe3, 0x2e, 0x6c, 0xfd,
-	0x28, 0x81, 0x7f, 0xeb, 0x0c, 0xbe, 0xa2, 0xae, 0xc5, 0xbd, 0x46, 0x32, 0x54, 0xf2, 0x3a, 0x98,
-	0xb0, 0x22, 0xee, 0x93, 0x10, 0xf1, 0x76, 0xea, 0x6b, 0x4d, 0xf9, 0xf6, 0xe9, 0xfe, 0xb4, 0x18,
-	0xd7, 0x2d, 0xd7, 0x0d, 0x3d, 0xc6, 0x9a, 0x3c, 0x44, 0x18, 0x1a, 0x3d, 0xa8, 0xbc, 0x01, 0x72,
-	0xe9, 0x58, 0x26, 0x8e, 0xe7, 0xab, 0x73, 0xda, 0x80, 0x77, 0x43, 0x4b, 0x49, 0x6a, 0xa3, 0x47,
-	0x9d, 0x52, 0xc6, 0x10, 0x07, 0x36, 0x27, 0x63, 0xc1, 0xbd, 0xab, 0x2a, 0xb3, 0x60, 0xe6, 0x8a,
-	0xaa, 0xae, 0xe2, 0xea, 0xd7, 0x11, 0x90, 0xad, 0x33, 0x28, 0xbf, 0x05, 0x53, 0xd7, 0x07, 0xfe,
-	0xee, 0x40, 0xca, 0x41, 0xc1, 0x2e, 0xae, 0x0c, 0x0d, 0xed, 0x52, 0xcb, 0x3e, 0x98, 0xbc, 0x92,
-	0xff, 0x3b, 0x37, 0x5d, 0xd2, 0x8f, 0x2b, 0x6a, 0xc3, 0xe1, 0x2e, 0x98, 0x6c, 0x50, 0xe8, 0xb3,
-	0x64, 0xe1, 0xa6, 0xf3, 0x97, 0x51, 0xc5, 0x7b, 0xc3, 0xa0, 0xba, 0x1c, 0xc5, 0xb1, 0x77, 0xe7,
-	0x87, 0x4b, 0x52, 0xed, 0xd9, 0xd1, 0xa9, 0x2a, 0x1d, 0x9f, 0xaa, 0xd2, 0x8f, 0x53, 0x55, 0xfa,
-	0x70, 0xa6, 0x66, 0x8e, 0xcf, 0xd4, 0xcc, 0xf7, 0x33, 0x35, 0xf3, 0x7a, 0xf9, 0x57, 0x41, 0x3c,
-	0xe8, 0x3d, 0xc9, 0x49, 0x26, 0xed, 0x5c, 0xf2, 0x1e, 0xaf, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff,
-	0x95, 0x73, 0xec, 0x64, 0x4f, 0x06, 0x00, 0x00,
+	// 756 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0xe3, 0x46,
+	0x14, 0x8e, 0x09, 0x84, 0x32, 0x89, 0xa8, 0x70, 0x11, 0x98, 0x40, 0x9d, 0x90, 0xa2, 0x8a, 0xa2,
+	0xd6, 0x86, 0x40, 0x51, 0xe1, 0x46, 0xaa, 0x56, 0xb4, 0x34, 0x6a, 0xe4, 0x94, 0x4b, 0x7b, 0xb0,
+	0xfc, 0x2b, 0xe3, 0x29, 0xf1, 0xcc, 0x74, 0x66, 0x8c, 0xc8, 0x0d, 0xf5, 0x2f, 0xe8, 0xa1, 0x7f,
+	0x08, 0x87, 0x95, 0xf6, 0xbc, 0x37, 0x8e, 0x68, 0x4f, 0x2b, 0x0e, 0xd1, 0x0a, 0x0e, 0xfc, 0x1b,
+	0x2b, 0xff, 0x08, 0x21, 0x90, 0x68, 0xc3, 0x1e, 0xb8, 0x79, 0xe6, 0x7d, 0x33, 0xef, 0x7b, 0xef,
+	0xfb, 0x9e, 0x07, 0xac, 0xd8, 0x96, 0xdd, 0x69, 0x13, 0xac, 0xb7, 0x10, 0xb6, 0xda, 0x48, 0x74,
+	0xf4, 0xd3, 0x2d, 0x5d, 0x9c, 0x69, 0x94, 0x11, 0x41, 0xe4, 0x2f, 0xd2, 0xa8, 0xd6, 0x8b, 0x6a,
+	0xa7, 0x5b, 0xc5, 0x79, 0x48, 0x20, 0x89, 0xe3, 0x7a, 0xf4, 0x95, 0x40, 0x8b, 0x5f, 0x0a, 0x0f,
+	0xbb, 0x1e, 0x0b, 0x10, 0x16, 0xba, 0xc3, 0x3a, 0x54, 0x10, 0x9d, 0x32, 0x42, 0x5a, 0x69, 0x78,
+	0xc9, 0x21, 0x3c, 0x20, 0xdc, 0x4c, 0xce, 0x25, 0x8b, 0x34, 0xb4, 0x98, 0xac, 0xf4, 0x80, 0xc3,
+	0x28, 0x79, 0xc0, 0x61, 0x1a, 0x28, 0x0f, 0xe3, 0x46, 0x2d, 0x66, 0x05, 0xe9, 0xd1, 0xca, 0x9b,
+	0x09, 0x30, 0x5f, 0xe7, 0xf0, 0x47, 0x12, 0x04, 0x48, 0x34, 0x42, 0xdb, 0xb0, 0xb0, 0xfb, 0x1b,
+	0xe2, 0x42, 0x5e, 0x00, 0x39, 0x8e, 0x20, 0xf6, 0x98, 0x22, 0x95, 0xa5, 0xf5, 0x19, 0x23, 0x5d,
+	0xc9, 0x06, 0x98, 0x69, 0x51, 0xd3, 0x16, 0x8e, 0x49, 0x4f, 0x94, 0x89, 0xb2, 0xb4, 0x5e, 0xa8,
+	0xed, 0x5e, 0x77, 0x4b, 0x55, 0x88, 0x84, 0x1f, 0xda, 0x9a, 0x43, 0x02, 0x3d, 0x4d, 0xea, 0xf8,
+	0x16, 0xc2, 0xbd, 0x85, 0x2e, 0x3a, 0xd4, 0xe3, 0x5a, 0xed, 0x97, 0xc6, 0xf6, 0xce, 0x66, 0x23,
+	0xb4, 0x8f, 0xbc, 0x8e, 0x31, 0xdd, 0xa2, 0x35, 0xe1, 0x34, 0x4e, 0xe4, 0x55, 0x50, 0xe0, 0xc2,
+	0x62, 0xc2, 0xf4, 0x3d, 0x04, 0x7d, 0xa1, 0x64, 0xcb, 0xd2, 0xfa, 0xa4, 0x91, 0x8f, 0xf7, 0x0e,
+	0xe3, 0x2d, 0xb9, 0x0c, 0x0a, 0x38, 0x0c, 0x4c, 0x1a, 0xda, 0x26, 0xb3, 0xb0, 0xab, 0x4c, 0xc6,
+	0x10, 0x80, 0xc3, 0x20, 0x25, 0x2d, 0xab, 0x00, 0x38, 0x71, 0x15, 0x81, 0x87, 0x85, 0x32, 0x15,
+	0x31, 0x33, 0x1e, 0xec, 0xc8, 0x47, 0x20, 0xcb, 0x11, 0x54, 0x72, 0x31, 0xe5, 0xbd, 0xeb, 0x6e,
+	0xe9, 0xfb, 0xe7, 0x50, 0x6e, 0x22, 0x88, 0x2d, 0x11, 0x32, 0xcf, 0x88, 0x6e, 0xd9, 0xcf, 0xff,
+	0x7b, 0x77, 0xb1, 0x91, 0xb6, 0xa4, 0xa2, 0x82, 0x95, 0x61, 0x2d, 0x34, 0x3c, 0x4e, 0x09, 0xe6,
+	0x5e, 0xe5, 0x75, 0x16, 0xcc, 0xd5, 0x39, 0x3c, 0x70, 0xdd, 0x9f, 0x53, 0x19, 0x9a, 0x08, 0xbe,
+	0x74, 0x83, 0xed, 0x36, 0x71, 0x4e, 0x1e, 0x35, 0x38, 0xde, 0x4b, 0x1b, 0xdc, 0x04, 0x9f, 0x0d,
+	0x34, 0xb7, 0x50, 0xfb, 0xe1, 0xba, 0x5b, 0xda, 0x19, 0x2f, 0x6b, 0xd3, 0xf1, 0x31, 0x61, 0x2c,
+	0x2d, 0xde, 0x98, 0xa6, 0xa9, 0x26, 0x1a, 0x98, 0x8a, 0x2d, 0x1c, 0xcb, 0x91, 0xaf, 0x2a, 0x5a,
+	0xdf, 0xe2, 0x5a, 0x62, 0x71, 0xad, 0x11, 0xc5, 0x8d, 0x04, 0x26, 0xaf, 0x81, 0xd9, 0x84, 0xa7,
+	0x45, 0xa9, 0xe9, 0x5b, 0xdc, 0x4f, 0xe4, 0x32, 0x12, 0xf6, 0x07, 0x94, 0x1e, 0x5a, 0xdc, 0x97,
+	0xff, 0x02, 0x85, 0x9e, 0x9f, 0xcd, 0x48, 0xd2, 0xe9, 0x4f, 0xa4, 0xfb, 0xd3, 0xef, 0x7f, 0x34,
+	0x9b, 0x08, 0x1a, 0xf9, 0x56, 0x5f, 0x96, 0x41, 0x65, 0x97, 0xc1, 0xd2, 0x13, 0xe1, 0xee, 0x65,
+	0xfd, 0x5f, 0x02, 0x9f, 0xd7, 0x39, 0x3c, 0xa6, 0xae, 0x25, 0xbc, 0x46, 0x3c, 0x54, 0xf2, 0x2e,
+	0x98, 0xb1, 0x42, 0xe1, 0x13, 0x86, 0x44, 0x27, 0xd1, 0xb5, 0xa6, 0xbc, 0x7d, 0xf5, 0xdd, 0x7c,
+	0x3a, 0xae, 0x07, 0xae, 0xcb, 0x3c, 0xce, 0x9b, 0x82, 0x21, 0x0c, 0x8d, 0x3e, 0x54, 0xde, 0x03,
+	0xb9, 0x64, 0x2c, 0x63, 0xc5, 0xf3, 0xd5, 0x65, 0x6d, 0xc8, 0x7f, 0x43, 0x4b, 0x92, 0xd4, 0x26,
+	0x2f, 0xbb, 0xa5, 0x8c, 0x91, 0x1e, 0xd8, 0x9f, 0x8d, 0x08, 0xf7, 0xaf, 0xaa, 0x2c, 0x81, 0xc5,
+	0x47, 0xac, 0x1e, 0x32, 0x8e, 0xea, 0x39, 0xc6, 0x7f, 0x5b, 0xa8, 0xdd, 0x2b, 0xa9, 0xc1, 0xc8,
+	0x29, 0x72, 0x3d, 0xf6, 0x92, 0x86, 0x1c, 0xec, 0xf2, 0x57, 0x60, 0x75, 0x24, 0xab, 0x1e, 0xf7,
+	0xea, 0x55, 0x16, 0x64, 0xeb, 0x1c, 0xca, 0xff, 0x80, 0xb9, 0xa7, 0x3f, 0xab, 0x6f, 0x86, 0xb6,
+	0x6b, 0xd8, 0x50, 0x16, 0xb7, 0xc6, 0x86, 0xf6, 0x52, 0xcb, 0x3e, 0x98, 0x7d, 0x34, 0xbb, 0x5f,
+	0x8f, 0xba, 0x64, 0x10, 0x57, 0xd4, 0xc6, 0xc3, 0xdd, 0x67, 0xb2, 0x41, 0x61, 0xc0, 0x4e, 0x6b,
+	0xa3, 0xce, 0x3f, 0x44, 0x15, 0xbf, 0x1d, 0x07, 0x75, 0x9f, 0xe3, 0x5c, 0x02, 0x0b, 0x23, 0x1c,
+	0x30, 0x92, 0xee, 0x70, 0x7c, 0x71, 0xf7, 0x79, 0xf8, 0x1e, 0x85, 0xe2, 0xd4, 0xf9, 0xdd, 0xc5,
+	0x86, 0x54, 0xfb, 0xf5, 0xf2, 0x46, 0x95, 0xae, 0x6e, 0x54, 0xe9, 0xfd, 0x8d, 0x2a, 0xfd, 0x77,
+	0xab, 0x66, 0xae, 0x6e, 0xd5, 0xcc, 0xbb, 0x5b, 0x35, 0xf3, 0xe7, 0xe6, 0xc7, 0xbc, 0x75, 0xd6,
+	0x7f, 0xd1, 0x62, 0x9b, 0xd9, 0xb9, 0xf8, 0x39, 0xdb, 0xfe, 0x30, 0x00, 0xfd, 0xb7, 0xcb, 0xf3,
+	0x8e, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -444,6 +535,8 @@ type MsgClient interface {
 	// TODO: msg for evidence of equivocation. this is not specified yet
 	// UpdateParams updates the finality module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UnjailFinalityProvider unjails a jailed finality provider
+	UnjailFinalityProvider(ctx context.Context, in *MsgUnjailFinalityProvider, opts ...grpc.CallOption) (*MsgUnjailFinalityProviderResponse, error)
 }
 
 type msgClient struct {
@@ -481,6 +574,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UnjailFinalityProvider(ctx context.Context, in *MsgUnjailFinalityProvider, opts ...grpc.CallOption) (*MsgUnjailFinalityProviderResponse, error) {
+	out := new(MsgUnjailFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/UnjailFinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CommitPubRandList commits a list of public randomness for EOTS
@@ -490,6 +592,8 @@ type MsgServer interface {
 	// TODO: msg for evidence of equivocation. this is not specified yet
 	// UpdateParams updates the finality module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UnjailFinalityProvider unjails a jailed finality provider
+	UnjailFinalityProvider(context.Context, *MsgUnjailFinalityProvider) (*MsgUnjailFinalityProviderResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -505,6 +609,9 @@ func (*UnimplementedMsgServer) AddFinalitySig(ctx context.Context, req *MsgAddFi
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UnjailFinalityProvider(ctx context.Context, req *MsgUnjailFinalityProvider) (*MsgUnjailFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailFinalityProvider not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -564,6 +671,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UnjailFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailFinalityProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/UnjailFinalityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailFinalityProvider(ctx, req.(*MsgUnjailFinalityProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.finality.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -580,6 +705,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UnjailFinalityProvider",
+			Handler:    _Msg_UnjailFinalityProvider_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/finality/v1/tx.proto",
@@ -855,6 +984,71 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUnjailFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -975,6 +1169,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUnjailFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1746,6 +1966,173 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUnjailFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
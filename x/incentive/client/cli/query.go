@@ -26,6 +26,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdQueryRewardGauges(),
 		CmdQueryBTCStakingGauge(),
 		CmdQueryBTCTimestampingGauge(),
+		CmdQueryWithdrawalSettings(),
+		CmdQueryBTCDelegationRewards(),
+		CmdQueryFinalityProviderRewards(),
 	)
 
 	return cmd
@@ -130,3 +133,115 @@ func CmdQueryBTCTimestampingGauge() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryWithdrawalSettings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawal-settings [address]",
+		Short: "shows the withdraw address and the auto-restaking validator of a given stakeholder address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryWithdrawalSettingsRequest{
+				Address: args[0],
+			}
+			res, err := queryClient.WithdrawalSettings(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBTCDelegationRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegation-rewards [staking-tx-hash] [start-height] [end-height]",
+		Short: "shows rewards accrued by a given BTC delegation under each of its finality providers within a height range",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			endHeight, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBTCDelegationRewardsRequest{
+				StakingTxHashHex: args[0],
+				StartHeight:      startHeight,
+				EndHeight:        endHeight,
+			}
+			res, err := queryClient.BTCDelegationRewards(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryFinalityProviderRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-rewards [fp-btc-pk-hex] [start-height] [end-height]",
+		Short: "shows rewards accrued by a given finality provider and its BTC delegations within a height range",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			endHeight, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFinalityProviderRewardsRequest{
+				FpBtcPkHex:  args[0],
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+			}
+			res, err := queryClient.FinalityProviderRewards(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -22,6 +22,8 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewWithdrawRewardCmd(),
+		NewSetWithdrawAddressCmd(),
+		NewSetAutoRestakeCmd(),
 	)
 
 	return cmd
@@ -51,3 +53,55 @@ func NewWithdrawRewardCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewSetWithdrawAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-withdraw-address [withdraw-address]",
+		Short: "set the address receiving the withdrawn rewards of the stakeholder behind the transaction submitter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetWithdrawAddress{
+				Address:         clientCtx.FromAddress.String(),
+				WithdrawAddress: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetAutoRestakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-restake [validator-address]",
+		Short: "restake withdrawn rewards of the stakeholder behind the transaction submitter to a given validator, or disable auto-restaking if no validator is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetAutoRestake{
+				Address: clientCtx.FromAddress.String(),
+			}
+			if len(args) == 1 {
+				msg.ValidatorAddress = args[0]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
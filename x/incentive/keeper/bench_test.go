@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"runtime/pprof"
+	"testing"
+	"time"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func benchRewardBTCStaking(b *testing.B, numFPs int, numDelsUnderFP int) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+
+	ctrl := gomock.NewController(b)
+	defer ctrl.Finish()
+
+	// create incentive keeper
+	bankKeeper := types.NewMockBankKeeper(ctrl)
+	ik, ctx := testkeeper.IncentiveKeeper(b, bankKeeper, nil, nil)
+	hooks := ik.Hooks()
+
+	// generate finality providers, each with a number of active BTC delegations
+	dc := bstypes.NewVotingPowerDistCache()
+	for i := 0; i < numFPs; i++ {
+		fp, err := datagen.GenRandomFinalityProviderDistInfo(r)
+		require.NoError(b, err)
+		fp.BtcDels = nil
+		fp.TotalVotingPower = 0
+		for j := 0; j < numDelsUnderFP; j++ {
+			btcDel, err := datagen.GenRandomBTCDelDistInfo(r)
+			require.NoError(b, err)
+			fp.AddBTCDelDistInfo(btcDel)
+			hooks.AfterBTCDelegationActivated(ctx, fp.BtcPk, btcDel)
+		}
+		dc.AddFinalityProviderDistInfo(fp)
+	}
+	dc.ApplyActiveFinalityProviders(uint32(numFPs))
+
+	// rewards to be distributed at each height
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ubbn", 1000000000))
+
+	// Start the CPU profiler
+	cpuProfileFile := fmt.Sprintf("/tmp/incentive-rewardbtcstaking-%d-%d-cpu.pprof", numFPs, numDelsUnderFP)
+	f, err := os.Create(cpuProfileFile)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer f.Close()
+	if err := pprof.StartCPUProfile(f); err != nil {
+		b.Fatal(err)
+	}
+	defer pprof.StopCPUProfile()
+
+	// Reset timer before the benchmark loop starts
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		height := uint64(i + 1)
+		ik.SetBTCStakingGauge(ctx, height, types.NewGauge(rewards...))
+		ik.RewardBTCStaking(ctx, height, dc)
+	}
+}
+
+func BenchmarkRewardBTCStaking_10_1(b *testing.B)    { benchRewardBTCStaking(b, 10, 1) }
+func BenchmarkRewardBTCStaking_10_10(b *testing.B)   { benchRewardBTCStaking(b, 10, 10) }
+func BenchmarkRewardBTCStaking_10_100(b *testing.B)  { benchRewardBTCStaking(b, 10, 100) }
+func BenchmarkRewardBTCStaking_100_1(b *testing.B)   { benchRewardBTCStaking(b, 100, 1) }
+func BenchmarkRewardBTCStaking_100_10(b *testing.B)  { benchRewardBTCStaking(b, 100, 10) }
+func BenchmarkRewardBTCStaking_100_100(b *testing.B) { benchRewardBTCStaking(b, 100, 100) }
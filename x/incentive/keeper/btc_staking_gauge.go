@@ -19,6 +19,10 @@ func (k Keeper) RewardBTCStaking(ctx context.Context, height uint64, filteredDc
 		// failing to get a reward gauge at previous height is a programming error
 		panic("failed to get a reward gauge at previous height")
 	}
+	// the rewards of this height are shared by the BTC delegations with voting
+	// power at this height
+	k.applyBTCDelegationStakeChanges(ctx, height)
+	distributedCoins := sdk.NewCoins()
 	// reward each of the finality provider and its BTC delegations in proportion
 	for _, fp := range filteredDc.FinalityProviders {
 		// get coins that will be allocated to the finality provider and its BTC delegations
@@ -27,23 +31,47 @@ func (k Keeper) RewardBTCStaking(ctx context.Context, height uint64, filteredDc
 		// reward the finality provider with commission
 		coinsForCommission := types.GetCoinsPortion(coinsForFpsAndDels, *fp.Commission)
 		k.accumulateRewardGauge(ctx, types.FinalityProviderType, fp.GetAddress(), coinsForCommission)
-		// reward the rest of coins to each BTC delegation proportional to its voting power portion
+		// reward the rest of coins to the BTC delegations of the finality provider,
+		// which is distributed to each BTC delegation proportional to its voting
+		// power portion only upon stake changes or withdrawal
 		coinsForBTCDels := coinsForFpsAndDels.Sub(coinsForCommission...)
-		for _, btcDel := range fp.BtcDels {
-			btcDelPortion := fp.GetBTCDelPortion(btcDel)
-			coinsForDel := types.GetCoinsPortion(coinsForBTCDels, btcDelPortion)
-			k.accumulateRewardGauge(ctx, types.BTCDelegationType, btcDel.GetAddress(), coinsForDel)
-		}
+		k.addFinalityProviderRewardsForBTCDelegations(ctx, fp.BtcPk, coinsForBTCDels)
+		// record the rewards at this height for querying rewards over heights,
+		// where the rewards per satoshi follow the stakes in the rewards trackers
+		k.setFinalityProviderHeightRewards(ctx, fp.BtcPk, height, &types.FinalityProviderHeightRewards{
+			Commission:                 coinsForCommission,
+			BtcDelegationRewards:       coinsForBTCDels,
+			BtcDelegationRewardsPerSat: k.getBTCDelegationRewardsPerSat(ctx, fp.BtcPk, coinsForBTCDels),
+		})
+		distributedCoins = distributedCoins.Add(coinsForFpsAndDels...)
 	}
 
-	// TODO: handle the change in the gauge due to the truncating operations
+	// record the distribution, and handle the remainder in the gauge due to
+	// the truncating operations
+	remainder := gauge.SetDistributed(distributedCoins)
+	k.SetBTCStakingGauge(ctx, height, gauge)
+	k.handleGaugeRemainder(ctx, remainder, func(coins sdk.Coins) {
+		k.addToBTCStakingGauge(ctx, height+1, coins)
+	})
+}
+
+// addToBTCStakingGauge adds the given coins to the BTC staking gauge at the
+// given height, or creates the gauge if it does not exist yet
+func (k Keeper) addToBTCStakingGauge(ctx context.Context, height uint64, coins sdk.Coins) {
+	gauge := k.GetBTCStakingGauge(ctx, height)
+	if gauge == nil {
+		gauge = types.NewGauge(coins...)
+	} else {
+		gauge.Coins = gauge.Coins.Add(coins...)
+	}
+	k.SetBTCStakingGauge(ctx, height, gauge)
 }
 
 func (k Keeper) accumulateBTCStakingReward(ctx context.Context, btcStakingReward sdk.Coins) {
-	// update BTC staking gauge
+	// update BTC staking gauge, which might already contain the remainder
+	// carried over from the previous height
 	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
-	gauge := types.NewGauge(btcStakingReward...)
-	k.SetBTCStakingGauge(ctx, height, gauge)
+	k.addToBTCStakingGauge(ctx, height, btcStakingReward)
 
 	// transfer the BTC staking reward from fee collector account to incentive module account
 	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, btcStakingReward)
@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
 	"github.com/babylonchain/babylon/x/incentive/types"
@@ -36,6 +37,13 @@ func FuzzRewardBTCStaking(f *testing.F) {
 		dc, err := datagen.GenRandomVotingPowerDistCache(r, 100)
 		require.NoError(t, err)
 
+		// all BTC delegations in the cache are active
+		for _, fp := range dc.FinalityProviders {
+			for _, btcDel := range fp.BtcDels {
+				keeper.Hooks().AfterBTCDelegationActivated(ctx, fp.BtcPk, btcDel)
+			}
+		}
+
 		// expected values
 		distributedCoins := sdk.NewCoins()
 		fpRewardMap := map[string]sdk.Coins{}     // key: address, value: reward
@@ -71,15 +79,45 @@ func FuzzRewardBTCStaking(f *testing.F) {
 			require.NotNil(t, rg)
 			require.Equal(t, reward, rg.Coins)
 		}
+		// rewards of BTC delegations are only accounted upon query or
+		// withdrawal, and may differ due to truncation by at most 1 unit
 		for addrStr, reward := range btcDelRewardMap {
 			addr, err := sdk.AccAddressFromBech32(addrStr)
 			require.NoError(t, err)
-			rg := keeper.GetRewardGauge(ctx, types.BTCDelegationType, addr)
-			require.NotNil(t, rg)
-			require.Equal(t, reward, rg.Coins)
+			require.Nil(t, keeper.GetRewardGauge(ctx, types.BTCDelegationType, addr))
+			actualReward := sdk.NewCoins()
+			resp, err := keeper.RewardGauges(ctx, &types.QueryRewardGaugesRequest{Address: addrStr})
+			if err == nil {
+				actualReward = resp.RewardGauges[types.BTCDelegationType.String()].Coins
+			} else {
+				// the reward might be truncated to nothing
+				require.ErrorIs(t, err, types.ErrRewardGaugeNotFound)
+			}
+			requireCoinsAlmostEqual(t, reward, actualReward)
 		}
 
 		// assert distributedCoins is a subset of coins in gauge
 		require.True(t, gauge.Coins.IsAllGTE(distributedCoins))
+
+		// assert the remainder due to truncation is recorded, and is carried
+		// over to the gauge at the next height
+		distributedGauge := keeper.GetBTCStakingGauge(ctx, height)
+		require.True(t, distributedGauge.IsDistributed())
+		require.NoError(t, distributedGauge.ValidateDistribution())
+		nextGauge := keeper.GetBTCStakingGauge(ctx, height+1)
+		if distributedGauge.RemainderCoins.IsZero() {
+			require.Nil(t, nextGauge)
+		} else {
+			require.Equal(t, distributedGauge.RemainderCoins, nextGauge.Coins)
+		}
 	})
 }
+
+// requireCoinsAlmostEqual asserts that the given coins differ by at most 1
+// unit in each denomination
+func requireCoinsAlmostEqual(t *testing.T, expected sdk.Coins, actual sdk.Coins) {
+	for _, denom := range expected.Add(actual...).Denoms() {
+		diff := expected.AmountOf(denom).Sub(actual.AmountOf(denom)).Abs()
+		require.True(t, diff.LTE(sdkmath.OneInt()), "expected %s, got %s", expected, actual)
+	}
+}
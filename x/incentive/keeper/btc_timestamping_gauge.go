@@ -11,14 +11,66 @@ import (
 )
 
 // RewardBTCTimestamping distributes rewards to submitters/reporters of a checkpoint at a given epoch
-// according to the reward distribution cache
-func (k Keeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rdi *btcctypes.RewardDistInfo) {
+// according to the reward distribution cache. The submitter of the best submission is also reimbursed
+// for the BTC fees of its transactions, and the reimbursed coins are returned. The reimbursement is
+// funded by the fee collector rather than the gauge, so that it never reduces the fixed portions of
+// the gauge, and is bounded by both the requested reimbursement, which is capped by the maximum
+// reimbursement of the btccheckpoint module, and the coins in the fee collector
+func (k Keeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rdi *btcctypes.RewardDistInfo) sdk.Coins {
 	gauge := k.GetBTCTimestampingGauge(ctx, epoch)
 	if gauge == nil {
 		// failing to get a reward gauge at a finalised epoch is a programming error
 		panic("failed to get a reward gauge at a finalized epoch")
 	}
 
+	distributedCoins := k.distributeBTCTimestampingGauge(ctx, gauge.Coins, rdi)
+
+	// record the distribution, and handle the remainder in the gauge due to
+	// the truncating operations
+	remainder := gauge.SetDistributed(distributedCoins)
+	k.SetBTCTimestampingGauge(ctx, epoch, gauge)
+	k.handleGaugeRemainder(ctx, remainder, func(coins sdk.Coins) {
+		k.addToBTCTimestampingGauge(ctx, epoch+1, coins)
+	})
+
+	return k.reimburseBTCFees(ctx, rdi.Best.Submitter, rdi.BestFeeReimbursement)
+}
+
+// reimburseBTCFees transfers the given reimbursement of BTC fees, bounded by
+// the coins in the fee collector, from the fee collector to the reward gauge
+// of the given submitter, and returns the reimbursed coins
+func (k Keeper) reimburseBTCFees(ctx context.Context, submitter sdk.AccAddress, reimbursement sdk.Coins) sdk.Coins {
+	if reimbursement.IsZero() {
+		return sdk.NewCoins()
+	}
+
+	feeCollector := k.accountKeeper.GetModuleAccount(ctx, k.feeCollectorName)
+	reimbursedCoins := k.bankKeeper.GetAllBalances(ctx, feeCollector.GetAddress()).Min(reimbursement)
+	if reimbursedCoins.IsZero() {
+		return reimbursedCoins
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, reimbursedCoins)
+	if err != nil {
+		// the coins are bounded by the balance of the fee collector, thus
+		// this can only be programming error and is unrecoverable
+		panic(err)
+	}
+	k.accumulateRewardGauge(ctx, types.SubmitterType, submitter, reimbursedCoins)
+
+	return reimbursedCoins
+}
+
+// distributeBTCTimestampingGauge distributes the given coins of a gauge to
+// submitters/reporters in the reward distribution cache, and returns the
+// distributed coins
+func (k Keeper) distributeBTCTimestampingGauge(ctx context.Context, coins sdk.Coins, rdi *btcctypes.RewardDistInfo) sdk.Coins {
+	distributedCoins := sdk.NewCoins()
+	reward := func(sType types.StakeholderType, addr sdk.AccAddress, coins sdk.Coins) {
+		k.accumulateRewardGauge(ctx, sType, addr, coins)
+		distributedCoins = distributedCoins.Add(coins...)
+	}
+
 	params := k.GetParams(ctx)
 	btcTimestampingPortion := params.BTCTimestampingPortion()
 	// TODO: parameterise bestPortion
@@ -26,26 +78,26 @@ func (k Keeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rdi *bt
 
 	// distribute coins to best submitter
 	submitterPortion := params.SubmitterPortion.QuoTruncate(btcTimestampingPortion)
-	coinsToSubmitters := gauge.GetCoinsPortion(submitterPortion)
+	coinsToSubmitters := types.GetCoinsPortion(coins, submitterPortion)
 	coinsToBestSubmitter := types.GetCoinsPortion(coinsToSubmitters, bestPortion)
-	k.accumulateRewardGauge(ctx, types.SubmitterType, rdi.Best.Submitter, coinsToBestSubmitter)
+	reward(types.SubmitterType, rdi.Best.Submitter, coinsToBestSubmitter)
 	restCoinsToSubmitters := coinsToSubmitters.Sub(coinsToBestSubmitter...)
 
 	// distribute coins to best reporter
 	reporterPortion := params.ReporterPortion.QuoTruncate(btcTimestampingPortion)
-	coinsToReporters := gauge.GetCoinsPortion(reporterPortion)
+	coinsToReporters := types.GetCoinsPortion(coins, reporterPortion)
 	coinsToBestReporter := types.GetCoinsPortion(coinsToReporters, bestPortion)
-	k.accumulateRewardGauge(ctx, types.ReporterType, rdi.Best.Reporter, coinsToBestReporter)
+	reward(types.ReporterType, rdi.Best.Reporter, coinsToBestReporter)
 	restCoinsToReporters := coinsToReporters.Sub(coinsToBestReporter...)
 
 	// if there is only 1 submission, distribute the rest to submitter and reporter, then skip the rest logic
 	if len(rdi.Others) == 0 {
 		// give rest coins to the best submitter
-		k.accumulateRewardGauge(ctx, types.SubmitterType, rdi.Best.Submitter, restCoinsToSubmitters)
+		reward(types.SubmitterType, rdi.Best.Submitter, restCoinsToSubmitters)
 		// give rest coins to the best reporter
-		k.accumulateRewardGauge(ctx, types.ReporterType, rdi.Best.Reporter, restCoinsToReporters)
+		reward(types.ReporterType, rdi.Best.Reporter, restCoinsToReporters)
 		// skip the rest logic
-		return
+		return distributedCoins
 	}
 
 	// distribute the rest to each of the other submitters
@@ -54,7 +106,7 @@ func (k Keeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rdi *bt
 	coinsToEachOtherSubmitter := types.GetCoinsPortion(restCoinsToSubmitters, eachOtherSubmitterPortion)
 	if coinsToEachOtherSubmitter.IsAllPositive() {
 		for _, submission := range rdi.Others {
-			k.accumulateRewardGauge(ctx, types.SubmitterType, submission.Submitter, coinsToEachOtherSubmitter)
+			reward(types.SubmitterType, submission.Submitter, coinsToEachOtherSubmitter)
 		}
 	}
 
@@ -64,25 +116,43 @@ func (k Keeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rdi *bt
 	coinsToEachOtherReporter := types.GetCoinsPortion(restCoinsToReporters, eachOtherReporterPortion)
 	if coinsToEachOtherReporter.IsAllPositive() {
 		for _, submission := range rdi.Others {
-			k.accumulateRewardGauge(ctx, types.ReporterType, submission.Reporter, coinsToEachOtherReporter)
+			reward(types.ReporterType, submission.Reporter, coinsToEachOtherReporter)
 		}
 	}
+
+	return distributedCoins
 }
 
-func (k Keeper) accumulateBTCTimestampingReward(ctx context.Context, btcTimestampingReward sdk.Coins) {
+// RewardBTCHeaderRelaying rewards a reporter for relaying BTC headers out of
+// the BTC timestamping gauge of the current epoch. The reward is bounded by the
+// coins in the gauge, and the actual reward is returned
+func (k Keeper) RewardBTCHeaderRelaying(ctx context.Context, reporter sdk.AccAddress, reward sdk.Coins) sdk.Coins {
 	epoch := k.epochingKeeper.GetEpoch(ctx)
-
-	// update BTC timestamping reward gauge
 	gauge := k.GetBTCTimestampingGauge(ctx, epoch.EpochNumber)
 	if gauge == nil {
-		// if this epoch does not have a gauge yet, create a new one
-		gauge = types.NewGauge(btcTimestampingReward...)
-	} else {
-		// if this epoch already has a gauge, accumulate coins in the gauge
-		gauge.Coins = gauge.Coins.Add(btcTimestampingReward...)
+		// no BTC timestamping reward has been accumulated in this epoch yet
+		return sdk.NewCoins()
+	}
+
+	actualReward := gauge.Coins.Min(reward)
+	if actualReward.IsZero() {
+		return actualReward
 	}
 
+	// the coins are already in the incentive module account, so moving them
+	// from the gauge to the reporter's reward gauge is enough
+	gauge.Coins = gauge.Coins.Sub(actualReward...)
 	k.SetBTCTimestampingGauge(ctx, epoch.EpochNumber, gauge)
+	k.accumulateRewardGauge(ctx, types.ReporterType, reporter, actualReward)
+
+	return actualReward
+}
+
+func (k Keeper) accumulateBTCTimestampingReward(ctx context.Context, btcTimestampingReward sdk.Coins) {
+	epoch := k.epochingKeeper.GetEpoch(ctx)
+
+	// update BTC timestamping reward gauge
+	k.addToBTCTimestampingGauge(ctx, epoch.EpochNumber, btcTimestampingReward)
 
 	// transfer the BTC timestamping reward from fee collector account to incentive module account
 	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, btcTimestampingReward)
@@ -92,6 +162,20 @@ func (k Keeper) accumulateBTCTimestampingReward(ctx context.Context, btcTimestam
 	}
 }
 
+// addToBTCTimestampingGauge adds the given coins to the BTC timestamping gauge
+// at the given epoch, or creates the gauge if it does not exist yet
+func (k Keeper) addToBTCTimestampingGauge(ctx context.Context, epoch uint64, coins sdk.Coins) {
+	gauge := k.GetBTCTimestampingGauge(ctx, epoch)
+	if gauge == nil {
+		// if this epoch does not have a gauge yet, create a new one
+		gauge = types.NewGauge(coins...)
+	} else {
+		// if this epoch already has a gauge, accumulate coins in the gauge
+		gauge.Coins = gauge.Coins.Add(coins...)
+	}
+	k.SetBTCTimestampingGauge(ctx, epoch, gauge)
+}
+
 func (k Keeper) SetBTCTimestampingGauge(ctx context.Context, epoch uint64, gauge *types.Gauge) {
 	store := k.btcTimestampingGaugeStore(ctx)
 	gaugeBytes := k.cdc.MustMarshal(gauge)
@@ -7,8 +7,10 @@ import (
 	"cosmossdk.io/math"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	"github.com/babylonchain/babylon/x/incentive/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -119,5 +121,113 @@ func FuzzRewardBTCTimestamping(f *testing.F) {
 
 		// assert distributedCoins is a subset of coins in gauge
 		require.True(t, gauge.Coins.IsAllGTE(distributedCoins))
+
+		// assert the remainder due to truncation is recorded, and is carried
+		// over to the gauge at the next epoch
+		distributedGauge := keeper.GetBTCTimestampingGauge(ctx, epoch)
+		require.True(t, distributedGauge.IsDistributed())
+		require.NoError(t, distributedGauge.ValidateDistribution())
+		nextGauge := keeper.GetBTCTimestampingGauge(ctx, epoch+1)
+		if distributedGauge.RemainderCoins.IsZero() {
+			require.Nil(t, nextGauge)
+		} else {
+			require.Equal(t, distributedGauge.RemainderCoins, nextGauge.Coins)
+		}
+	})
+}
+
+func FuzzRewardBTCTimestamping_FeeReimbursement(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+		accountKeeper := types.NewMockAccountKeeper(ctrl)
+		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, accountKeeper, nil)
+		epoch := datagen.RandomInt(r, 1000) + 1
+
+		gauge := datagen.GenRandomGauge(r)
+		keeper.SetBTCTimestampingGauge(ctx, epoch, gauge)
+
+		// the fee collector holds some fees
+		feeCollectorAcc := authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
+		feeCollectorCoins := datagen.GenRandomGauge(r).Coins
+		accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), authtypes.FeeCollectorName).Return(feeCollectorAcc).Times(1)
+		bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(feeCollectorCoins).Times(1)
+
+		// request a reimbursement that may exceed the coins in the fee collector
+		rdi := datagen.GenRandomBTCTimestampingRewardDistInfo(r)
+		feeCoin := feeCollectorCoins[r.Intn(len(feeCollectorCoins))]
+		requestedAmount := feeCoin.Amount.MulRaw(2).QuoRaw(int64(datagen.RandomInt(r, 4) + 1))
+		rdi.BestFeeReimbursement = sdk.NewCoins(sdk.NewCoin(feeCoin.Denom, requestedAmount))
+
+		// the reimbursement is capped by the coins in the fee collector, and
+		// is transferred from the fee collector
+		expectedReimbursement := feeCollectorCoins.Min(rdi.BestFeeReimbursement)
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, types.ModuleName, expectedReimbursement).
+			Return(nil).Times(1)
+		reimbursedCoins := keeper.RewardBTCTimestamping(ctx, epoch, rdi)
+		require.Equal(t, expectedReimbursement, reimbursedCoins)
+		rg := keeper.GetRewardGauge(ctx, types.SubmitterType, rdi.Best.Submitter)
+		require.NotNil(t, rg)
+		require.True(t, rg.Coins.IsAllGTE(reimbursedCoins))
+
+		// the reimbursement is not part of the distribution of the gauge, which
+		// is distributed by the fixed portions as without any reimbursement
+		distributedGauge := keeper.GetBTCTimestampingGauge(ctx, epoch)
+		require.NoError(t, distributedGauge.ValidateDistribution())
+		keeperWithoutReimbursement, ctx2 := testkeeper.IncentiveKeeper(t, bankKeeper, accountKeeper, nil)
+		keeperWithoutReimbursement.SetBTCTimestampingGauge(ctx2, epoch, gauge)
+		rdiWithoutReimbursement := *rdi
+		rdiWithoutReimbursement.BestFeeReimbursement = sdk.NewCoins()
+		keeperWithoutReimbursement.RewardBTCTimestamping(ctx2, epoch, &rdiWithoutReimbursement)
+		require.Equal(t, keeperWithoutReimbursement.GetBTCTimestampingGauge(ctx2, epoch), distributedGauge)
+		rgWithoutReimbursement := keeperWithoutReimbursement.GetRewardGauge(ctx2, types.SubmitterType, rdi.Best.Submitter)
+		require.NotNil(t, rgWithoutReimbursement)
+		require.Equal(t, rgWithoutReimbursement.Coins.Add(reimbursedCoins...), rg.Coins)
+	})
+}
+
+func FuzzRewardBTCHeaderRelaying(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, epochingKeeper)
+		epoch := datagen.RandomInt(r, 1000) + 1
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epoch}).AnyTimes()
+
+		reporter := datagen.GenRandomAccount().GetAddress()
+
+		// no reward without a gauge at the current epoch
+		reward := keeper.RewardBTCHeaderRelaying(ctx, reporter, datagen.GenRandomGauge(r).Coins)
+		require.True(t, reward.IsZero())
+		require.Nil(t, keeper.GetRewardGauge(ctx, types.ReporterType, reporter))
+
+		gauge := datagen.GenRandomGauge(r)
+		keeper.SetBTCTimestampingGauge(ctx, epoch, gauge)
+
+		// request a reward that may exceed the coins in the gauge
+		gaugeCoin := gauge.Coins[r.Intn(len(gauge.Coins))]
+		requestedAmount := gaugeCoin.Amount.MulRaw(2).QuoRaw(int64(datagen.RandomInt(r, 4) + 1))
+		requested := sdk.NewCoins(sdk.NewCoin(gaugeCoin.Denom, requestedAmount))
+
+		reward = keeper.RewardBTCHeaderRelaying(ctx, reporter, requested)
+
+		// the reward is capped by the coins in the gauge, and is moved from
+		// the gauge to the reward gauge of the reporter
+		require.Equal(t, gauge.Coins.Min(requested), reward)
+		rg := keeper.GetRewardGauge(ctx, types.ReporterType, reporter)
+		require.NotNil(t, rg)
+		require.Equal(t, reward, rg.Coins)
+		require.True(t, gauge.Coins.Sub(reward...).Equal(keeper.GetBTCTimestampingGauge(ctx, epoch).Coins))
 	})
 }
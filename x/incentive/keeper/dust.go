@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// handleGaugeRemainder handles the undistributed remainder of a reward gauge
+// due to truncation. If a dust recipient is specified in params, the remainder
+// is sent to it. Otherwise, or if the dust recipient cannot receive coins, the
+// remainder is carried over via the given function.
+func (k Keeper) handleGaugeRemainder(ctx context.Context, remainder sdk.Coins, carryOver func(sdk.Coins)) {
+	if !remainder.IsAllPositive() {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	if recipient := params.DustRecipientAddress(); recipient != nil {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, remainder)
+		if err == nil {
+			return
+		}
+		// e.g., the dust recipient is a blocked address. In this case, carry
+		// over the remainder so that it is not lost
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Error(
+			"failed to send the remainder of a reward gauge to the dust recipient, carrying it over",
+			"recipient", params.DustRecipient,
+			"remainder", remainder.String(),
+			"error", err,
+		)
+	}
+
+	carryOver(remainder)
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/incentive/keeper"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzGaugeRemainderToDustRecipient(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock bank keeper
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+
+		// create incentive keeper with a dust recipient
+		ik, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, nil)
+		dustRecipient := datagen.GenRandomAccount().GetAddress()
+		params := types.DefaultParams()
+		params.DustRecipient = dustRecipient.String()
+		err := ik.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// distribute a random gauge at a random epoch, where the remainder is
+		// sent to the dust recipient
+		epoch := datagen.RandomInt(r, 1000) + 1
+		ik.SetBTCTimestampingGauge(ctx, epoch, datagen.GenRandomGauge(r))
+		rdi := datagen.GenRandomBTCTimestampingRewardDistInfo(r)
+		var sentDust sdk.Coins
+		bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(dustRecipient), gomock.Any()).
+			DoAndReturn(func(_ interface{}, _ string, _ sdk.AccAddress, coins sdk.Coins) error {
+				sentDust = coins
+				return nil
+			}).MaxTimes(1)
+		ik.RewardBTCTimestamping(ctx, epoch, rdi)
+
+		distributedGauge := ik.GetBTCTimestampingGauge(ctx, epoch)
+		require.NoError(t, distributedGauge.ValidateDistribution())
+		require.True(t, distributedGauge.RemainderCoins.Equal(sentDust))
+		require.Nil(t, ik.GetBTCTimestampingGauge(ctx, epoch+1))
+
+		// the invariant holds
+		_, broken := keeper.GaugeRemainderInvariant(*ik)(ctx)
+		require.False(t, broken)
+
+		// the invariant is broken if a distributed gauge is tampered with
+		distributedGauge.Coins = distributedGauge.Coins.Add(sdk.NewInt64Coin("ubbn", 1))
+		ik.SetBTCTimestampingGauge(ctx, epoch, distributedGauge)
+		_, broken = keeper.GaugeRemainderInvariant(*ik)(ctx)
+		require.True(t, broken)
+	})
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/incentive/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,6 +25,11 @@ func (k Keeper) RewardGauges(goCtx context.Context, req *types.QueryRewardGauges
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// rewards of BTC delegations are accounted lazily, thus account them in
+	// a cached context that is discarded afterwards
+	ctx, _ = ctx.CacheContext()
+	k.accountAllBTCDelegationRewards(ctx, address)
+
 	rgMap := map[string]*types.RewardGauge{}
 
 	// find reward gauge
@@ -71,3 +78,75 @@ func (k Keeper) BTCTimestampingGauge(goCtx context.Context, req *types.QueryBTCT
 
 	return &types.QueryBTCTimestampingGaugeResponse{Gauge: gauge}, nil
 }
+
+func (k Keeper) WithdrawalSettings(goCtx context.Context, req *types.QueryWithdrawalSettingsRequest) (*types.QueryWithdrawalSettingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &types.QueryWithdrawalSettingsResponse{
+		WithdrawAddress: k.GetWithdrawAddress(ctx, address).String(),
+	}
+	if valAddr := k.GetAutoRestakeValidator(ctx, address); valAddr != nil {
+		resp.AutoRestakeValidatorAddress = valAddr.String()
+	}
+
+	return resp, nil
+}
+
+func (k Keeper) BTCDelegationRewards(goCtx context.Context, req *types.QueryBTCDelegationRewardsRequest) (*types.QueryBTCDelegationRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := types.ValidateHeightRange(req.StartHeight, req.EndHeight); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if k.IsRewardsHistoryPruned(ctx, req.StartHeight) {
+		return nil, types.ErrRewardsHistoryPruned.Wrapf("start height %d", req.StartHeight)
+	}
+
+	rewards := k.getBTCDelegationRewards(ctx, stakingTxHash, req.StartHeight, req.EndHeight)
+
+	return &types.QueryBTCDelegationRewardsResponse{Rewards: rewards}, nil
+}
+
+func (k Keeper) FinalityProviderRewards(goCtx context.Context, req *types.QueryFinalityProviderRewardsRequest) (*types.QueryFinalityProviderRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := types.ValidateHeightRange(req.StartHeight, req.EndHeight); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if k.IsRewardsHistoryPruned(ctx, req.StartHeight) {
+		return nil, types.ErrRewardsHistoryPruned.Wrapf("start height %d", req.StartHeight)
+	}
+
+	commission, btcDelRewards := sdk.NewCoins(), sdk.NewCoins()
+	k.iterateFinalityProviderHeightRewards(ctx, fpBTCPK, req.StartHeight, req.EndHeight, func(_ uint64, rewards *types.FinalityProviderHeightRewards) {
+		commission = commission.Add(rewards.Commission...)
+		btcDelRewards = btcDelRewards.Add(rewards.BtcDelegationRewards...)
+	})
+
+	return &types.QueryFinalityProviderRewardsResponse{
+		Commission:           commission,
+		BtcDelegationRewards: btcDelRewards,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+type Hooks struct {
+	k Keeper
+}
+
+// ensures Hooks implements BTCStakingHooks interfaces
+var _ bstypes.BTCStakingHooks = Hooks{}
+
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// AfterBTCDelegationActivated records the new stake of the BTC delegator under
+// the finality provider, which starts being tracked once the current height is
+// rewarded, and records the activation of the BTC delegation
+func (h Hooks) AfterBTCDelegationActivated(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcDel *bstypes.BTCDelDistInfo) {
+	h.k.addBTCDelegationStakeChange(ctx, fpBTCPK, btcDel, false)
+	h.k.recordBTCDelegationActivated(ctx, fpBTCPK, btcDel)
+}
+
+// AfterBTCDelegationUnbonded records the unbonded stake of the BTC delegator
+// under the finality provider, which stops being tracked once the current
+// height is rewarded, and records the unbonding of the BTC delegation
+func (h Hooks) AfterBTCDelegationUnbonded(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcDel *bstypes.BTCDelDistInfo) {
+	h.k.addBTCDelegationStakeChange(ctx, fpBTCPK, btcDel, true)
+	h.k.recordBTCDelegationUnbonded(ctx, fpBTCPK, btcDel)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all invariants of the incentive module
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "gauge-remainder", GaugeRemainderInvariant(k))
+}
+
+// GaugeRemainderInvariant checks that, for each distributed BTC staking and
+// BTC timestamping gauge, the distributed coins plus the undistributed
+// remainder equal the coins in the gauge
+func GaugeRemainderInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		check := func(gaugeType string, key []byte, gaugeBytes []byte) {
+			var gauge types.Gauge
+			k.cdc.MustUnmarshal(gaugeBytes, &gauge)
+			if err := gauge.ValidateDistribution(); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\t%s gauge at %d: %v\n", gaugeType, sdk.BigEndianToUint64(key), err)
+			}
+		}
+
+		stakingIter := k.btcStakingGaugeStore(ctx).Iterator(nil, nil)
+		for ; stakingIter.Valid(); stakingIter.Next() {
+			check("BTC staking", stakingIter.Key(), stakingIter.Value())
+		}
+		stakingIter.Close()
+
+		timestampingIter := k.btcTimestampingGaugeStore(ctx).Iterator(nil, nil)
+		for ; timestampingIter.Valid(); timestampingIter.Next() {
+			check("BTC timestamping", timestampingIter.Key(), timestampingIter.Value())
+		}
+		timestampingIter.Close()
+
+		return sdk.FormatInvariant(types.ModuleName, "gauge-remainder",
+			fmt.Sprintf("distributed gauges with inconsistent remainders:\n%s", msg)), broken
+	}
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/incentive/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper           Keeper
+	btcStakingKeeper types.BTCStakingKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper, btcStakingKeeper types.BTCStakingKeeper) Migrator {
+	return Migrator{keeper: keeper, btcStakingKeeper: btcStakingKeeper}
+}
+
+// Migrate1to2 migrates the x/incentive store from consensus version 1 to 2.
+// Version 1 did not track the stake of BTC delegations in rewards trackers,
+// so the BTC delegations that are already active would earn nothing, and the
+// first new BTC delegator of a finality provider would take all its rewards.
+// The rewards trackers of the finality providers and their BTC delegations
+// are seeded from the voting power distribution cache of the last height,
+// which has not been finalised yet and thus still exists.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	height := uint64(ctx.HeaderInfo().Height)
+	if height == 0 {
+		return nil
+	}
+	dc, err := m.btcStakingKeeper.GetVotingPowerDistCache(ctx, height-1)
+	if errors.Is(err, bstypes.ErrVotingPowerDistCacheNotFound) {
+		// no BTC delegation is active at the last height
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, fp := range dc.FinalityProviders {
+		for _, btcDel := range fp.BtcDels {
+			delAddr, err := sdk.AccAddressFromBech32(btcDel.StakerAddr)
+			if err != nil {
+				return err
+			}
+			m.keeper.addBTCDelegationStake(ctx, fp.BtcPk, delAddr, btcDel.VotingPower)
+			m.keeper.recordBTCDelegationActivated(ctx, fp.BtcPk, btcDel)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/incentive/keeper"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ik, ctx := testkeeper.IncentiveKeeper(t, nil, nil, nil)
+	upgradeHeight := uint64(10)
+	ctx = datagen.WithCtxHeight(ctx, upgradeHeight)
+
+	// a finality provider without commission, whose BTC delegations of two
+	// delegators are active before the upgrade, where A has two of them
+	fp, err := datagen.GenRandomFinalityProviderDistInfo(r)
+	require.NoError(t, err)
+	zeroCommission := sdkmath.LegacyZeroDec()
+	fp.Commission = &zeroCommission
+	fp.BtcDels = nil
+	btcDelA1, err := datagen.GenRandomBTCDelDistInfo(r)
+	require.NoError(t, err)
+	btcDelA2, err := datagen.GenRandomBTCDelDistInfo(r)
+	require.NoError(t, err)
+	btcDelA2.StakerAddr = btcDelA1.StakerAddr
+	btcDelB, err := datagen.GenRandomBTCDelDistInfo(r)
+	require.NoError(t, err)
+	for _, btcDel := range []*bstypes.BTCDelDistInfo{btcDelA1, btcDelA2, btcDelB} {
+		fp.AddBTCDelDistInfo(btcDel)
+	}
+	dc := bstypes.NewVotingPowerDistCache()
+	dc.AddFinalityProviderDistInfo(fp)
+	dc.ApplyActiveFinalityProviders(1)
+	delA, satA := btcDelA1.GetAddress(), btcDelA1.VotingPower+btcDelA2.VotingPower
+	delB, satB := btcDelB.GetAddress(), btcDelB.VotingPower
+
+	bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+	bsKeeper.EXPECT().GetVotingPowerDistCache(gomock.Any(), gomock.Eq(upgradeHeight-1)).Return(dc, nil).Times(1)
+	require.NoError(t, keeper.NewMigrator(*ik, bsKeeper).Migrate1to2(ctx))
+
+	// the BTC delegations are tracked from the upgrade on
+	require.Equal(t, satA, ik.GetBTCDelegationRewardsTracker(ctx, fp.BtcPk, delA).TotalActiveSat)
+	require.Equal(t, satB, ik.GetBTCDelegationRewardsTracker(ctx, fp.BtcPk, delB).TotalActiveSat)
+
+	// the rewards of the last height before the upgrade are shared by both
+	// delegators, and A's rewards are accounted upon its unbonding
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("ubbn", int64(datagen.RandomInt(r, 1000000)+1000000)))
+	ik.SetBTCStakingGauge(ctx, upgradeHeight-1, types.NewGauge(rewards...))
+	ik.RewardBTCStaking(ctx, upgradeHeight-1, dc)
+	hooks := ik.Hooks()
+	hooks.AfterBTCDelegationUnbonded(ctx, fp.BtcPk, btcDelA1)
+	hooks.AfterBTCDelegationUnbonded(ctx, fp.BtcPk, btcDelA2)
+	ik.SetBTCStakingGauge(ctx, upgradeHeight, types.NewGauge())
+	ik.RewardBTCStaking(ctx, upgradeHeight, bstypes.NewVotingPowerDistCache())
+	require.Nil(t, ik.GetBTCDelegationRewardsTracker(ctx, fp.BtcPk, delA))
+	portionA := sdkmath.LegacyNewDec(int64(satA)).QuoInt64(int64(satA + satB))
+	rgA := ik.GetRewardGauge(ctx, types.BTCDelegationType, delA)
+	require.NotNil(t, rgA)
+	requireCoinsAlmostEqual(t, types.GetCoinsPortion(rewards, portionA), rgA.Coins)
+
+	// the migration is a no-op without any active BTC delegation
+	bsKeeper.EXPECT().GetVotingPowerDistCache(gomock.Any(), gomock.Any()).Return(nil, bstypes.ErrVotingPowerDistCacheNotFound).Times(1)
+	require.NoError(t, keeper.NewMigrator(*ik, bsKeeper).Migrate1to2(ctx))
+}
@@ -55,13 +55,60 @@ func (ms msgServer) WithdrawReward(goCtx context.Context, req *types.MsgWithdraw
 	}
 
 	// withdraw reward, i.e., send withdrawable reward to the stakeholder address and clear the reward gauge
-	withdrawnCoins, err := ms.withdrawReward(ctx, sType, addr)
+	withdrawnCoins, restakedCoins, err := ms.withdrawReward(ctx, sType, addr)
 	if err != nil {
 		return nil, err
 	}
 
 	// all good
 	return &types.MsgWithdrawRewardResponse{
-		Coins: withdrawnCoins,
+		Coins:         withdrawnCoins,
+		RestakedCoins: restakedCoins,
 	}, nil
 }
+
+// SetWithdrawAddress sets the address receiving the withdrawn rewards of a given stakeholder
+func (ms msgServer) SetWithdrawAddress(goCtx context.Context, req *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	withdrawAddr, err := sdk.AccAddressFromBech32(req.WithdrawAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := ms.Keeper.SetWithdrawAddress(ctx, addr, withdrawAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetWithdrawAddressResponse{}, nil
+}
+
+// SetAutoRestake enables or disables auto-restaking the withdrawn rewards of a given stakeholder
+func (ms msgServer) SetAutoRestake(goCtx context.Context, req *types.MsgSetAutoRestake) (*types.MsgSetAutoRestakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// empty validator address disables auto-restaking
+	var valAddr sdk.ValAddress
+	if req.ValidatorAddress != "" {
+		valAddr, err = sdk.ValAddressFromBech32(req.ValidatorAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		// the withdrawn rewards can only be restaked to an existing validator
+		if _, err := ms.epochingKeeper.GetValidator(ctx, valAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	ms.SetAutoRestakeValidator(ctx, addr, valAddr)
+
+	return &types.MsgSetAutoRestakeResponse{}, nil
+}
@@ -7,8 +7,13 @@ import (
 
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	"github.com/babylonchain/babylon/x/incentive/keeper"
 	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -63,3 +68,129 @@ func FuzzWithdrawReward(f *testing.F) {
 		require.True(t, newRg.IsFullyWithdrawn())
 	})
 }
+
+func FuzzWithdrawRewardWithSettings(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock bank, account and epoching keepers
+		bk := types.NewMockBankKeeper(ctrl)
+		ak := types.NewMockAccountKeeper(ctrl)
+		ek := types.NewMockEpochingKeeper(ctrl)
+
+		ik, ctx := testkeeper.IncentiveKeeper(t, bk, ak, ek)
+		ms := keeper.NewMsgServerImpl(*ik)
+
+		// generate and set a random reward gauge with the bond denom
+		bondDenom := "ubbn"
+		rg := datagen.GenRandomRewardGauge(r)
+		rg.Coins = rg.Coins.Add(sdk.NewInt64Coin(bondDenom, int64(datagen.RandomInt(r, 100000)+1)))
+		sType := datagen.GenRandomStakeholderType(r)
+		sAddr := datagen.GenRandomAccount().GetAddress()
+		ik.SetRewardGauge(ctx, sType, sAddr, rg)
+
+		// blocked addresses and module accounts cannot be withdraw addresses
+		blockedAddr := datagen.GenRandomAccount().GetAddress()
+		bk.EXPECT().BlockedAddr(gomock.Eq(blockedAddr)).Return(true).Times(1)
+		_, err := ms.SetWithdrawAddress(ctx, &types.MsgSetWithdrawAddress{
+			Address:         sAddr.String(),
+			WithdrawAddress: blockedAddr.String(),
+		})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		moduleAcc := authtypes.NewEmptyModuleAccount(types.ModuleName)
+		bk.EXPECT().BlockedAddr(gomock.Eq(moduleAcc.GetAddress())).Return(false).Times(1)
+		ak.EXPECT().GetAccount(gomock.Any(), gomock.Eq(moduleAcc.GetAddress())).Return(moduleAcc).Times(1)
+		_, err = ms.SetWithdrawAddress(ctx, &types.MsgSetWithdrawAddress{
+			Address:         sAddr.String(),
+			WithdrawAddress: moduleAcc.GetAddress().String(),
+		})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+		// a non-existing validator cannot be the auto-restaking validator
+		valAddr := sdk.ValAddress(datagen.GenRandomAccount().GetAddress())
+		ek.EXPECT().GetValidator(gomock.Any(), gomock.Eq(valAddr)).Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).Times(1)
+		_, err = ms.SetAutoRestake(ctx, &types.MsgSetAutoRestake{
+			Address:          sAddr.String(),
+			ValidatorAddress: valAddr.String(),
+		})
+		require.ErrorIs(t, err, stakingtypes.ErrNoValidatorFound)
+
+		// set a withdraw address and an auto-restaking validator
+		withdrawAddr := datagen.GenRandomAccount().GetAddress()
+		bk.EXPECT().BlockedAddr(gomock.Eq(withdrawAddr)).Return(false).Times(1)
+		ak.EXPECT().GetAccount(gomock.Any(), gomock.Eq(withdrawAddr)).Return(nil).Times(1)
+		_, err = ms.SetWithdrawAddress(ctx, &types.MsgSetWithdrawAddress{
+			Address:         sAddr.String(),
+			WithdrawAddress: withdrawAddr.String(),
+		})
+		require.NoError(t, err)
+		ek.EXPECT().GetValidator(gomock.Any(), gomock.Eq(valAddr)).Return(stakingtypes.Validator{}, nil).Times(1)
+		_, err = ms.SetAutoRestake(ctx, &types.MsgSetAutoRestake{
+			Address:          sAddr.String(),
+			ValidatorAddress: valAddr.String(),
+		})
+		require.NoError(t, err)
+		settings, err := ik.WithdrawalSettings(ctx, &types.QueryWithdrawalSettingsRequest{Address: sAddr.String()})
+		require.NoError(t, err)
+		require.Equal(t, withdrawAddr.String(), settings.WithdrawAddress)
+		require.Equal(t, valAddr.String(), settings.AutoRestakeValidatorAddress)
+
+		// the coins in the bond denom are sent to the stakeholder and restaked,
+		// while the rest goes to the withdraw address
+		withdrawableCoins := rg.GetWithdrawableCoins()
+		restakedCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, withdrawableCoins.AmountOf(bondDenom)))
+		restCoins := withdrawableCoins.Sub(restakedCoins...)
+		ek.EXPECT().BondDenom(gomock.Any()).Return(bondDenom, nil).Times(1)
+		bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(sAddr), gomock.Eq(restakedCoins)).Times(1)
+		ek.EXPECT().EnqueueWrappedDelegate(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, msg *epochingtypes.MsgWrappedDelegate) error {
+				require.Equal(t, sAddr.String(), msg.Msg.DelegatorAddress)
+				require.Equal(t, valAddr.String(), msg.Msg.ValidatorAddress)
+				require.Equal(t, restakedCoins[0], msg.Msg.Amount)
+				return nil
+			}).Times(1)
+		if !restCoins.IsZero() {
+			bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(withdrawAddr), gomock.Eq(restCoins)).Times(1)
+		}
+
+		resp, err := ms.WithdrawReward(ctx, &types.MsgWithdrawReward{
+			Type:    sType.String(),
+			Address: sAddr.String(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, withdrawableCoins, resp.Coins)
+		require.Equal(t, restakedCoins, resp.RestakedCoins)
+
+		// if the delegation cannot be queued, the coins in the bond denom are
+		// withdrawn to the withdraw address as well
+		ik.SetRewardGauge(ctx, sType, sAddr, rg)
+		ek.EXPECT().BondDenom(gomock.Any()).Return(bondDenom, nil).Times(1)
+		bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(sAddr), gomock.Eq(restakedCoins)).Times(1)
+		ek.EXPECT().EnqueueWrappedDelegate(gomock.Any(), gomock.Any()).Return(epochingtypes.ErrQueueFull).Times(1)
+		bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(withdrawAddr), gomock.Eq(withdrawableCoins)).Times(1)
+		resp, err = ms.WithdrawReward(ctx, &types.MsgWithdrawReward{
+			Type:    sType.String(),
+			Address: sAddr.String(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, withdrawableCoins, resp.Coins)
+		require.True(t, resp.RestakedCoins.IsZero())
+
+		// disabling auto-restaking and resetting the withdraw address
+		_, err = ms.SetAutoRestake(ctx, &types.MsgSetAutoRestake{Address: sAddr.String()})
+		require.NoError(t, err)
+		_, err = ms.SetWithdrawAddress(ctx, &types.MsgSetWithdrawAddress{
+			Address:         sAddr.String(),
+			WithdrawAddress: sAddr.String(),
+		})
+		require.NoError(t, err)
+		settings, err = ik.WithdrawalSettings(ctx, &types.QueryWithdrawalSettingsRequest{Address: sAddr.String()})
+		require.NoError(t, err)
+		require.Equal(t, sAddr.String(), settings.WithdrawAddress)
+		require.Empty(t, settings.AutoRestakeValidatorAddress)
+	})
+}
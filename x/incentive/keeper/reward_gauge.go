@@ -2,32 +2,97 @@ package keeper
 
 import (
 	"context"
+
 	"cosmossdk.io/store/prefix"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	"github.com/babylonchain/babylon/x/incentive/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-func (k Keeper) withdrawReward(ctx context.Context, sType types.StakeholderType, addr sdk.AccAddress) (sdk.Coins, error) {
+// withdrawReward withdraws the reward of the given stakeholder in the given type,
+// and returns the withdrawn coins, as well as the part of them that is restaked.
+// The withdrawn coins are sent to the withdraw address of the stakeholder. If
+// auto-restaking is enabled, the withdrawn coins in the bond denom are instead
+// sent to the stakeholder address and delegated to the auto-restaking validator
+// via x/epoching.
+func (k Keeper) withdrawReward(ctx context.Context, sType types.StakeholderType, addr sdk.AccAddress) (sdk.Coins, sdk.Coins, error) {
+	// rewards of BTC delegations are accounted lazily, thus account them
+	// in the reward gauge before withdrawal
+	if sType == types.BTCDelegationType {
+		k.accountAllBTCDelegationRewards(ctx, addr)
+	}
 	// retrieve reward gauge of the given stakeholder
 	rg := k.GetRewardGauge(ctx, sType, addr)
 	if rg == nil {
-		return nil, types.ErrRewardGaugeNotFound
+		return nil, nil, types.ErrRewardGaugeNotFound
 	}
 	// get withdrawable coins
 	withdrawableCoins := rg.GetWithdrawableCoins()
 	if !withdrawableCoins.IsAllPositive() {
-		return nil, types.ErrNoWithdrawableCoins
+		return nil, nil, types.ErrNoWithdrawableCoins
 	}
-	// transfer withdrawable coins from incentive module account to the stakeholder's address
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, withdrawableCoins); err != nil {
-		return nil, err
+	// restake the withdrawable coins in the bond denom, if auto-restaking is enabled
+	restakedCoins, err := k.restakeReward(ctx, addr, withdrawableCoins)
+	if err != nil {
+		return nil, nil, err
+	}
+	// transfer the rest of withdrawable coins from incentive module account to
+	// the stakeholder's withdraw address
+	if restCoins := withdrawableCoins.Sub(restakedCoins...); !restCoins.IsZero() {
+		withdrawAddr := k.GetWithdrawAddress(ctx, addr)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, restCoins); err != nil {
+			return nil, nil, err
+		}
 	}
 	// empty reward gauge
 	rg.SetFullyWithdrawn()
 	k.SetRewardGauge(ctx, sType, addr, rg)
 	// all good, return
-	return withdrawableCoins, nil
+	return withdrawableCoins, restakedCoins, nil
+}
+
+// restakeReward sends the given coins in the bond denom to the given stakeholder
+// and delegates them to its auto-restaking validator via x/epoching, if
+// auto-restaking is enabled. It returns the restaked coins. If the delegation
+// cannot be queued, e.g., due to the limits of the epoch's message queue, the
+// coins are not restaked and are withdrawn as the other coins.
+func (k Keeper) restakeReward(ctx context.Context, addr sdk.AccAddress, coins sdk.Coins) (sdk.Coins, error) {
+	valAddr := k.GetAutoRestakeValidator(ctx, addr)
+	if valAddr == nil {
+		return sdk.NewCoins(), nil
+	}
+	bondDenom, err := k.epochingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	amount := coins.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+	restakedCoin := sdk.NewCoin(bondDenom, amount)
+
+	// restake in a cached context, so that nothing is written if the
+	// delegation cannot be queued
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+	// the delegated coins have to be in the stakeholder address when the
+	// queued delegation is executed at the end of the epoch
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, addr, sdk.NewCoins(restakedCoin)); err != nil {
+		return nil, err
+	}
+	msg := epochingtypes.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(addr.String(), valAddr.String(), restakedCoin),
+	)
+	if err := k.epochingKeeper.EnqueueWrappedDelegate(cacheCtx, msg); err != nil {
+		k.Logger(sdkCtx).Info("failed to restake withdrawn rewards, withdraw them instead",
+			"address", addr.String(), "validator", valAddr.String(), "error", err)
+		return sdk.NewCoins(), nil
+	}
+	writeCache()
+
+	return sdk.NewCoins(restakedCoin), nil
 }
 
 // accumulateRewardGauge accumulates the given reward of of a given stakeholder in a given type
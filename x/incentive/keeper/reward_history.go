@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setFinalityProviderHeightRewards records the rewards distributed to the
+// given finality provider and its BTC delegations at the given height
+func (k Keeper) setFinalityProviderHeightRewards(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, height uint64, rewards *types.FinalityProviderHeightRewards) {
+	store := k.fpHeightRewardsStore(ctx, height)
+	store.Set(fpBTCPK.MustMarshal(), k.cdc.MustMarshal(rewards))
+}
+
+// iterateFinalityProviderHeightRewards iterates over the rewards of the given
+// finality provider at each height within [startHeight, endHeight]
+func (k Keeper) iterateFinalityProviderHeightRewards(
+	ctx context.Context,
+	fpBTCPK *bbn.BIP340PubKey,
+	startHeight uint64,
+	endHeight uint64,
+	handler func(height uint64, rewards *types.FinalityProviderHeightRewards),
+) {
+	for height := startHeight; height <= endHeight; height++ {
+		rewardsBytes := k.fpHeightRewardsStore(ctx, height).Get(fpBTCPK.MustMarshal())
+		if rewardsBytes == nil {
+			continue
+		}
+		var rewards types.FinalityProviderHeightRewards
+		k.cdc.MustUnmarshal(rewardsBytes, &rewards)
+		handler(height, &rewards)
+	}
+}
+
+// recordBTCDelegationActivated records that the given BTC delegation has
+// voting power under the given finality provider since the current height
+func (k Keeper) recordBTCDelegationActivated(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcDel *bstypes.BTCDelDistInfo) {
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	activity := &types.BTCDelegationActivity{
+		StakerAddr:  btcDel.StakerAddr,
+		Sat:         btcDel.VotingPower,
+		StartHeight: height,
+	}
+	k.setBTCDelegationActivity(ctx, mustParseStakingTxHash(btcDel.StakingTxHash), fpBTCPK, activity)
+}
+
+// recordBTCDelegationUnbonded records that the given BTC delegation no longer
+// has voting power under the given finality provider since the current height
+func (k Keeper) recordBTCDelegationUnbonded(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcDel *bstypes.BTCDelDistInfo) {
+	stakingTxHash := mustParseStakingTxHash(btcDel.StakingTxHash)
+	store := k.btcDelegationActivityStore(ctx, stakingTxHash)
+	activityBytes := store.Get(fpBTCPK.MustMarshal())
+	if activityBytes == nil {
+		// the BTC delegation became active before the activity is recorded
+		return
+	}
+	var activity types.BTCDelegationActivity
+	k.cdc.MustUnmarshal(activityBytes, &activity)
+	activity.EndHeight = uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	k.setBTCDelegationActivity(ctx, stakingTxHash, fpBTCPK, &activity)
+	// index the ended activity by its end height for pruning
+	key := append(stakingTxHash[:], fpBTCPK.MustMarshal()...)
+	k.btcDelegationActivityEndHeightStore(ctx, activity.EndHeight).Set(key, []byte{0x00})
+}
+
+func (k Keeper) setBTCDelegationActivity(ctx context.Context, stakingTxHash *chainhash.Hash, fpBTCPK *bbn.BIP340PubKey, activity *types.BTCDelegationActivity) {
+	store := k.btcDelegationActivityStore(ctx, stakingTxHash)
+	store.Set(fpBTCPK.MustMarshal(), k.cdc.MustMarshal(activity))
+}
+
+// getBTCDelegationRewards returns the rewards accrued by the given BTC
+// delegation under each of its finality providers within [startHeight, endHeight],
+// which is computed from the rewards of the finality providers at each height
+func (k Keeper) getBTCDelegationRewards(ctx context.Context, stakingTxHash *chainhash.Hash, startHeight uint64, endHeight uint64) []*types.BTCDelegationRewardsEntry {
+	entries := []*types.BTCDelegationRewardsEntry{}
+
+	store := k.btcDelegationActivityStore(ctx, stakingTxHash)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		fpBTCPK, err := bbn.NewBIP340PubKey(iter.Key())
+		if err != nil {
+			panic(err) // only programming error
+		}
+		var activity types.BTCDelegationActivity
+		k.cdc.MustUnmarshal(iter.Value(), &activity)
+
+		// the heights at which the BTC delegation is active within the range
+		lo, hi := max(startHeight, activity.StartHeight), endHeight
+		if activity.EndHeight > 0 {
+			hi = min(hi, activity.EndHeight-1)
+		}
+
+		// the rewards per satoshi are derived from the stakes tracked by the
+		// rewards trackers, thus the rewards are consistent with the
+		// rewards accounted upon withdrawal
+		rewardsPerSat := sdk.NewDecCoins()
+		if lo <= hi {
+			k.iterateFinalityProviderHeightRewards(ctx, fpBTCPK, lo, hi, func(_ uint64, rewards *types.FinalityProviderHeightRewards) {
+				rewardsPerSat = rewardsPerSat.Add(rewards.BtcDelegationRewardsPerSat...)
+			})
+		}
+		sat := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(activity.Sat))
+		coins, _ := rewardsPerSat.MulDecTruncate(sat).TruncateDecimal()
+		entries = append(entries, &types.BTCDelegationRewardsEntry{
+			FpBtcPkHex: fpBTCPK.MarshalHex(),
+			Coins:      coins,
+		})
+	}
+
+	return entries
+}
+
+// PruneRewardsAtHeight removes the rewards of finality providers at the given
+// height, as well as the activities of BTC delegations that have no voting
+// power after the given height, after which the rewards history at the given
+// height can no longer be queried. Heights have to be pruned in increasing
+// order. The caller is responsible for ensuring that the given height is
+// finalised or non-finalisable, i.e., its rewards are distributed if any
+func (k Keeper) PruneRewardsAtHeight(ctx context.Context, height uint64) {
+	// remove the rewards of all finality providers at this height
+	rewardsStore := k.fpHeightRewardsStore(ctx, height)
+	for _, key := range collectKeys(rewardsStore) {
+		rewardsStore.Delete(key)
+	}
+
+	// remove the activities ending at the next height, i.e., the activities
+	// whose last height with voting power is this height
+	endHeightStore := k.btcDelegationActivityEndHeightStore(ctx, height+1)
+	for _, key := range collectKeys(endHeightStore) {
+		stakingTxHash, err := chainhash.NewHash(key[:chainhash.HashSize])
+		if err != nil {
+			panic(err) // only programming error
+		}
+		k.btcDelegationActivityStore(ctx, stakingTxHash).Delete(key[chainhash.HashSize:])
+		endHeightStore.Delete(key)
+	}
+
+	if height+1 > k.getNextHeightToPrune(ctx) {
+		k.setNextHeightToPrune(ctx, height+1)
+	}
+}
+
+// IsRewardsHistoryPruned returns whether the rewards history at the given
+// height has been pruned
+func (k Keeper) IsRewardsHistoryPruned(ctx context.Context, height uint64) bool {
+	return height < k.getNextHeightToPrune(ctx)
+}
+
+// setNextHeightToPrune sets the next height whose rewards history is to be pruned
+func (k Keeper) setNextHeightToPrune(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.NextHeightToPruneKey, sdk.Uint64ToBigEndian(height)); err != nil {
+		panic(err)
+	}
+}
+
+// getNextHeightToPrune gets the next height whose rewards history is to be pruned
+func (k Keeper) getNextHeightToPrune(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.NextHeightToPruneKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// collectKeys returns all keys in the given store, so that they can be
+// deleted without writing to the store while iterating over it
+func collectKeys(store prefix.Store) [][]byte {
+	keys := [][]byte{}
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	return keys
+}
+
+func mustParseStakingTxHash(stakingTxHashStr string) *chainhash.Hash {
+	stakingTxHash, err := chainhash.NewHashFromStr(stakingTxHashStr)
+	if err != nil {
+		panic(err) // only programming error
+	}
+	return stakingTxHash
+}
+
+// fpHeightRewardsStore returns the KVStore of the rewards of each finality
+// provider at the given height
+// prefix: FinalityProviderHeightRewardsKey || height
+// key: finality provider's BTC PK
+// value: FinalityProviderHeightRewards
+func (k Keeper) fpHeightRewardsStore(ctx context.Context, height uint64) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FinalityProviderHeightRewardsKey)
+	return prefix.NewStore(store, sdk.Uint64ToBigEndian(height))
+}
+
+// btcDelegationActivityStore returns the KVStore of the activity of a BTC
+// delegation under each of its finality providers
+// prefix: BTCDelegationActivityKey || staking tx hash
+// key: finality provider's BTC PK
+// value: BTCDelegationActivity
+func (k Keeper) btcDelegationActivityStore(ctx context.Context, stakingTxHash *chainhash.Hash) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.BTCDelegationActivityKey)
+	return prefix.NewStore(store, stakingTxHash[:])
+}
+
+// btcDelegationActivityEndHeightStore returns the KVStore of the activities of
+// BTC delegations that end at the given height
+// prefix: BTCDelegationActivityEndHeightKey || end height
+// key: (staking tx hash || finality provider's BTC PK)
+// value: nothing
+func (k Keeper) btcDelegationActivityEndHeightStore(ctx context.Context, endHeight uint64) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.BTCDelegationActivityEndHeightKey)
+	return prefix.NewStore(store, sdk.Uint64ToBigEndian(endHeight))
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzRewardsOverHeights(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// create incentive keeper
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+		ik, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, nil)
+		hooks := ik.Hooks()
+
+		fp, err := datagen.GenRandomFinalityProviderDistInfo(r)
+		require.NoError(t, err)
+		btcDelA, err := datagen.GenRandomBTCDelDistInfo(r)
+		require.NoError(t, err)
+		btcDelA.StakingTxHash = datagen.GenRandomBtcdHash(r).String()
+		btcDelB, err := datagen.GenRandomBTCDelDistInfo(r)
+		require.NoError(t, err)
+		btcDelB.StakingTxHash = datagen.GenRandomBtcdHash(r).String()
+
+		// A is active since height 1, and B is active within [3, 5)
+		numHeights := uint64(6)
+		rewardsPerSatA, rewardsPerSatB := sdk.NewDecCoins(), sdk.NewDecCoins()
+		expectedCommission, expectedDelRewards := sdk.NewCoins(), sdk.NewCoins()
+		for height := uint64(1); height <= numHeights; height++ {
+			ctx = datagen.WithCtxHeight(ctx, height)
+			switch height {
+			case 1:
+				hooks.AfterBTCDelegationActivated(ctx, fp.BtcPk, btcDelA)
+			case 3:
+				hooks.AfterBTCDelegationActivated(ctx, fp.BtcPk, btcDelB)
+			case 5:
+				hooks.AfterBTCDelegationUnbonded(ctx, fp.BtcPk, btcDelB)
+			}
+
+			// distribute rewards at this height to active BTC delegations
+			fp.BtcDels = nil
+			fp.TotalVotingPower = 0
+			fp.AddBTCDelDistInfo(btcDelA)
+			if height >= 3 && height < 5 {
+				fp.AddBTCDelDistInfo(btcDelB)
+			}
+			dc := bstypes.NewVotingPowerDistCache()
+			dc.AddFinalityProviderDistInfo(fp)
+			dc.ApplyActiveFinalityProviders(1)
+			gauge := datagen.GenRandomGauge(r)
+			ik.SetBTCStakingGauge(ctx, height, gauge)
+			ik.RewardBTCStaking(ctx, height, dc)
+
+			// expected rewards
+			commission := types.GetCoinsPortion(gauge.Coins, *fp.Commission)
+			delRewards := gauge.Coins.Sub(commission...)
+			expectedCommission = expectedCommission.Add(commission...)
+			expectedDelRewards = expectedDelRewards.Add(delRewards...)
+			// the rewards per satoshi follow the stakes in the rewards trackers
+			totalSat := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(fp.TotalVotingPower))
+			rewardsPerSat := sdk.NewDecCoinsFromCoins(delRewards...).QuoDecTruncate(totalSat)
+			if height >= 2 {
+				rewardsPerSatA = rewardsPerSatA.Add(rewardsPerSat...)
+			}
+			if height >= 3 && height < 5 {
+				rewardsPerSatB = rewardsPerSatB.Add(rewardsPerSat...)
+			}
+		}
+		expectedA, _ := rewardsPerSatA.MulDecTruncate(sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(btcDelA.VotingPower))).TruncateDecimal()
+		expectedB, _ := rewardsPerSatB.MulDecTruncate(sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(btcDelB.VotingPower))).TruncateDecimal()
+
+		// rewards of A within [2, numHeights]
+		respA, err := ik.BTCDelegationRewards(ctx, &types.QueryBTCDelegationRewardsRequest{
+			StakingTxHashHex: btcDelA.StakingTxHash,
+			StartHeight:      2,
+			EndHeight:        numHeights,
+		})
+		require.NoError(t, err)
+		require.Len(t, respA.Rewards, 1)
+		require.Equal(t, fp.BtcPk.MarshalHex(), respA.Rewards[0].FpBtcPkHex)
+		require.True(t, expectedA.Equal(respA.Rewards[0].Coins), "expected %s, got %s", expectedA, respA.Rewards[0].Coins)
+
+		// rewards of B within [1, numHeights], where B is only active in [3, 5)
+		respB, err := ik.BTCDelegationRewards(ctx, &types.QueryBTCDelegationRewardsRequest{
+			StakingTxHashHex: btcDelB.StakingTxHash,
+			StartHeight:      1,
+			EndHeight:        numHeights,
+		})
+		require.NoError(t, err)
+		require.Len(t, respB.Rewards, 1)
+		require.True(t, expectedB.Equal(respB.Rewards[0].Coins), "expected %s, got %s", expectedB, respB.Rewards[0].Coins)
+
+		// rewards of the finality provider within [1, numHeights]
+		respFP, err := ik.FinalityProviderRewards(ctx, &types.QueryFinalityProviderRewardsRequest{
+			FpBtcPkHex:  fp.BtcPk.MarshalHex(),
+			StartHeight: 1,
+			EndHeight:   numHeights,
+		})
+		require.NoError(t, err)
+		require.True(t, expectedCommission.Equal(respFP.Commission))
+		require.True(t, expectedDelRewards.Equal(respFP.BtcDelegationRewards))
+		// the rewards of BTC delegations never exceed the total
+		require.True(t, respFP.BtcDelegationRewards.IsAllGTE(respB.Rewards[0].Coins.Add(respA.Rewards[0].Coins...)))
+
+		// invalid height ranges
+		_, err = ik.FinalityProviderRewards(ctx, &types.QueryFinalityProviderRewardsRequest{
+			FpBtcPkHex:  fp.BtcPk.MarshalHex(),
+			StartHeight: 2,
+			EndHeight:   1,
+		})
+		require.Error(t, err)
+		_, err = ik.BTCDelegationRewards(ctx, &types.QueryBTCDelegationRewardsRequest{
+			StakingTxHashHex: btcDelA.StakingTxHash,
+			StartHeight:      1,
+			EndHeight:        1 + types.MaxRewardsQueryHeightRange,
+		})
+		require.Error(t, err)
+
+		// pruning the rewards history until height 4 removes the rewards at
+		// these heights, as well as the activity of B that ends at height 5
+		for height := uint64(1); height <= 4; height++ {
+			ik.PruneRewardsAtHeight(ctx, height)
+		}
+		_, err = ik.FinalityProviderRewards(ctx, &types.QueryFinalityProviderRewardsRequest{
+			FpBtcPkHex:  fp.BtcPk.MarshalHex(),
+			StartHeight: 4,
+			EndHeight:   numHeights,
+		})
+		require.ErrorIs(t, err, types.ErrRewardsHistoryPruned)
+		respB, err = ik.BTCDelegationRewards(ctx, &types.QueryBTCDelegationRewardsRequest{
+			StakingTxHashHex: btcDelB.StakingTxHash,
+			StartHeight:      5,
+			EndHeight:        numHeights,
+		})
+		require.NoError(t, err)
+		require.Empty(t, respB.Rewards)
+		respA, err = ik.BTCDelegationRewards(ctx, &types.QueryBTCDelegationRewardsRequest{
+			StakingTxHashHex: btcDelA.StakingTxHash,
+			StartHeight:      5,
+			EndHeight:        numHeights,
+		})
+		require.NoError(t, err)
+		require.Len(t, respA.Rewards, 1)
+	})
+}
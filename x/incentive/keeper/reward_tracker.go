@@ -0,0 +1,415 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+/*
+	Rewards of BTC delegations are accounted lazily, following the F1 fee
+	distribution scheme of the cosmos-sdk distribution module
+	(https://github.com/cosmos/cosmos-sdk/tree/v0.50.6/x/distribution).
+
+	Each finality provider accumulates the rewards for its BTC delegations in
+	its current period. A period ends whenever the stake of a BTC delegator
+	under the finality provider changes, or a BTC delegator withdraws rewards,
+	upon which the cumulative rewards per satoshi at the end of the period are
+	recorded. The rewards of a BTC delegator are then computed from the
+	cumulative rewards per satoshi between its start period and the ended period,
+	without iterating over all BTC delegations upon each block.
+
+	The rewards of a height are only distributed once the height is finalised,
+	which can be many heights after the stake changes at later heights. Thus a
+	stake change is not applied to the rewards trackers until the height at
+	which it takes effect is rewarded, so that the rewards of each height are
+	shared by the BTC delegations with voting power at that height.
+*/
+
+// addBTCDelegationStakeChange records a change of the stake of the given BTC
+// delegation under the given finality provider at the current height, which
+// is applied once the current height is rewarded
+func (k Keeper) addBTCDelegationStakeChange(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcDel *bstypes.BTCDelDistInfo, unbonded bool) {
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	store := k.btcDelegationStakeChangeStore(ctx)
+
+	// get the index of the change at this height, which starts from 0
+	index := uint64(0)
+	iter := store.ReverseIterator(sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(height+1))
+	if iter.Valid() {
+		index = sdk.BigEndianToUint64(iter.Key()[8:]) + 1
+	}
+	iter.Close()
+
+	change := &types.BTCDelegationStakeChange{
+		FpBtcPk:    fpBTCPK,
+		StakerAddr: btcDel.StakerAddr,
+		Sat:        btcDel.VotingPower,
+		Unbonded:   unbonded,
+	}
+	key := append(sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(index)...)
+	store.Set(key, k.cdc.MustMarshal(change))
+}
+
+// applyBTCDelegationStakeChanges applies the stake changes taking effect at or
+// before the given height to the rewards trackers, in the order they happened
+func (k Keeper) applyBTCDelegationStakeChanges(ctx context.Context, height uint64) {
+	store := k.btcDelegationStakeChangeStore(ctx)
+
+	// collect the changes first to avoid writing to the store while iterating
+	// over it
+	keys := [][]byte{}
+	changes := []*types.BTCDelegationStakeChange{}
+	func() {
+		iter := store.Iterator(nil, sdk.Uint64ToBigEndian(height+1))
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			var change types.BTCDelegationStakeChange
+			k.cdc.MustUnmarshal(iter.Value(), &change)
+			keys = append(keys, iter.Key())
+			changes = append(changes, &change)
+		}
+	}()
+
+	for i, change := range changes {
+		delAddr := sdk.MustAccAddressFromBech32(change.StakerAddr)
+		if change.Unbonded {
+			k.subBTCDelegationStake(ctx, change.FpBtcPk, delAddr, change.Sat)
+		} else {
+			k.addBTCDelegationStake(ctx, change.FpBtcPk, delAddr, change.Sat)
+		}
+		store.Delete(keys[i])
+	}
+}
+
+// addFinalityProviderRewardsForBTCDelegations adds the given rewards to the
+// current period of the given finality provider
+func (k Keeper) addFinalityProviderRewardsForBTCDelegations(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, rewards sdk.Coins) {
+	// if rewards contain nothing, do nothing
+	if !rewards.IsAllPositive() {
+		return
+	}
+	currentRewards := k.getOrInitFinalityProviderCurrentRewards(ctx, fpBTCPK)
+	currentRewards.CurrentRewards = currentRewards.CurrentRewards.Add(rewards...)
+	k.setFinalityProviderCurrentRewards(ctx, fpBTCPK, currentRewards)
+}
+
+// getBTCDelegationRewardsPerSat returns the given rewards of the BTC
+// delegations of the given finality provider divided by their total stake in
+// the rewards trackers, truncated. It returns nothing if the finality provider
+// has no tracked stake, in which case the rewards are carried over to the
+// next period
+func (k Keeper) getBTCDelegationRewardsPerSat(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, rewards sdk.Coins) sdk.DecCoins {
+	currentRewards := k.getOrInitFinalityProviderCurrentRewards(ctx, fpBTCPK)
+	if currentRewards.TotalActiveSat == 0 {
+		return sdk.NewDecCoins()
+	}
+	totalActiveSat := math.LegacyNewDecFromInt(math.NewIntFromUint64(currentRewards.TotalActiveSat))
+	return sdk.NewDecCoinsFromCoins(rewards...).QuoDecTruncate(totalActiveSat)
+}
+
+// addBTCDelegationStake accounts the rewards of the given BTC delegator under
+// the given finality provider so far, and then adds the given stake to it
+func (k Keeper) addBTCDelegationStake(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, delAddr sdk.AccAddress, sat uint64) {
+	totalActiveSat, endedPeriod := k.accountBTCDelegationRewards(ctx, fpBTCPK, delAddr)
+
+	currentRewards := k.getOrInitFinalityProviderCurrentRewards(ctx, fpBTCPK)
+	currentRewards.TotalActiveSat += sat
+	k.setFinalityProviderCurrentRewards(ctx, fpBTCPK, currentRewards)
+
+	k.initBTCDelegationRewardsTracker(ctx, fpBTCPK, delAddr, endedPeriod, totalActiveSat+sat)
+}
+
+// subBTCDelegationStake accounts the rewards of the given BTC delegator under
+// the given finality provider so far, and then subtracts the given stake from it
+func (k Keeper) subBTCDelegationStake(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, delAddr sdk.AccAddress, sat uint64) {
+	totalActiveSat, endedPeriod := k.accountBTCDelegationRewards(ctx, fpBTCPK, delAddr)
+	// the stake might not be tracked if it became active before the rewards
+	// trackers are introduced
+	if sat > totalActiveSat {
+		sat = totalActiveSat
+	}
+
+	currentRewards := k.getOrInitFinalityProviderCurrentRewards(ctx, fpBTCPK)
+	currentRewards.TotalActiveSat -= sat
+	k.setFinalityProviderCurrentRewards(ctx, fpBTCPK, currentRewards)
+
+	k.initBTCDelegationRewardsTracker(ctx, fpBTCPK, delAddr, endedPeriod, totalActiveSat-sat)
+}
+
+// accountAllBTCDelegationRewards accounts the rewards of the given BTC delegator
+// under all finality providers it stakes to in its reward gauge
+func (k Keeper) accountAllBTCDelegationRewards(ctx context.Context, delAddr sdk.AccAddress) {
+	// collect the finality providers first to avoid writing to the store
+	// while iterating over it
+	fpBTCPKs := []*bbn.BIP340PubKey{}
+	func() {
+		iter := k.btcDelegatorToFPStore(ctx, delAddr).Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			fpBTCPK, err := bbn.NewBIP340PubKey(iter.Key())
+			if err != nil {
+				// failing to unmarshal finality provider BTC PK in KVStore is a programming error
+				panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+			}
+			fpBTCPKs = append(fpBTCPKs, fpBTCPK)
+		}
+	}()
+
+	for _, fpBTCPK := range fpBTCPKs {
+		totalActiveSat, endedPeriod := k.accountBTCDelegationRewards(ctx, fpBTCPK, delAddr)
+		k.initBTCDelegationRewardsTracker(ctx, fpBTCPK, delAddr, endedPeriod, totalActiveSat)
+	}
+}
+
+// accountBTCDelegationRewards ends the current period of the given finality
+// provider, moves the rewards of the given BTC delegator since its start period
+// to its reward gauge, and removes its rewards tracker. It returns the stake
+// of the BTC delegator and the ended period, from which the caller is expected
+// to initialise a new rewards tracker
+func (k Keeper) accountBTCDelegationRewards(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, delAddr sdk.AccAddress) (uint64, uint64) {
+	endedPeriod := k.incrementFinalityProviderPeriod(ctx, fpBTCPK)
+
+	tracker := k.GetBTCDelegationRewardsTracker(ctx, fpBTCPK, delAddr)
+	if tracker == nil {
+		return 0, endedPeriod
+	}
+
+	rewards := k.calculateBTCDelegationRewards(ctx, fpBTCPK, tracker, endedPeriod)
+	k.accumulateRewardGauge(ctx, types.BTCDelegationType, delAddr, rewards)
+
+	// the rewards tracker no longer references its start period
+	k.decrementReferenceCount(ctx, fpBTCPK, tracker.StartPeriod)
+	k.deleteBTCDelegationRewardsTracker(ctx, fpBTCPK, delAddr)
+
+	return tracker.TotalActiveSat, endedPeriod
+}
+
+// calculateBTCDelegationRewards calculates the rewards of a BTC delegator
+// between its start period and the given end period
+func (k Keeper) calculateBTCDelegationRewards(
+	ctx context.Context,
+	fpBTCPK *bbn.BIP340PubKey,
+	tracker *types.BTCDelegationRewardsTracker,
+	endPeriod uint64,
+) sdk.Coins {
+	if tracker.TotalActiveSat == 0 {
+		return sdk.NewCoins()
+	}
+
+	start := k.getFinalityProviderHistoricalRewards(ctx, fpBTCPK, tracker.StartPeriod)
+	end := k.getFinalityProviderHistoricalRewards(ctx, fpBTCPK, endPeriod)
+	if start == nil || end == nil {
+		// historical rewards referenced by a rewards tracker or the current
+		// period are never pruned, thus this is a programming error
+		panic(fmt.Errorf("historical rewards of finality provider %s not found", fpBTCPK.MarshalHex()))
+	}
+	rewardsPerSat := end.CumulativeRewardsPerSat.Sub(start.CumulativeRewardsPerSat)
+	if rewardsPerSat.IsAnyNegative() {
+		panic("negative rewards per satoshi")
+	}
+
+	totalActiveSat := math.LegacyNewDecFromInt(math.NewIntFromUint64(tracker.TotalActiveSat))
+	rewards, _ := rewardsPerSat.MulDecTruncate(totalActiveSat).TruncateDecimal()
+	return rewards
+}
+
+// incrementFinalityProviderPeriod ends the current period of the given finality
+// provider, records its cumulative rewards per satoshi, and starts a new period.
+// It returns the ended period
+func (k Keeper) incrementFinalityProviderPeriod(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) uint64 {
+	currentRewards := k.getOrInitFinalityProviderCurrentRewards(ctx, fpBTCPK)
+
+	// rewards of a period without any stake cannot be attributed to anyone,
+	// thus carry them over to the next period
+	rewardsPerSat := sdk.NewDecCoins()
+	nextRewards := currentRewards.CurrentRewards
+	if currentRewards.TotalActiveSat > 0 {
+		rewardsPerSat = currentRewards.GetRewardsPerSat()
+		nextRewards = sdk.NewCoins()
+	}
+
+	// record the cumulative rewards per satoshi at the end of this period,
+	// which is referenced by the next period
+	prev := k.getFinalityProviderHistoricalRewards(ctx, fpBTCPK, currentRewards.Period-1)
+	if prev == nil {
+		panic(fmt.Errorf("historical rewards of finality provider %s not found", fpBTCPK.MarshalHex()))
+	}
+	k.setFinalityProviderHistoricalRewards(ctx, fpBTCPK, currentRewards.Period,
+		types.NewFinalityProviderHistoricalRewards(prev.CumulativeRewardsPerSat.Add(rewardsPerSat...), 1))
+	// the current period no longer references the previous period
+	k.decrementReferenceCount(ctx, fpBTCPK, currentRewards.Period-1)
+
+	endedPeriod := currentRewards.Period
+	k.setFinalityProviderCurrentRewards(ctx, fpBTCPK,
+		types.NewFinalityProviderCurrentRewards(nextRewards, endedPeriod+1, currentRewards.TotalActiveSat))
+
+	return endedPeriod
+}
+
+// getOrInitFinalityProviderCurrentRewards gets the current rewards of the given
+// finality provider, or initialises the finality provider's rewards if it has
+// not received any BTC delegation or rewards yet
+func (k Keeper) getOrInitFinalityProviderCurrentRewards(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) *types.FinalityProviderCurrentRewards {
+	store := k.fpCurrentRewardsStore(ctx)
+	bz := store.Get(fpBTCPK.MustMarshal())
+	if bz != nil {
+		var currentRewards types.FinalityProviderCurrentRewards
+		k.cdc.MustUnmarshal(bz, &currentRewards)
+		return &currentRewards
+	}
+
+	// the historical rewards at period 0 is zero and is referenced by the
+	// first period
+	k.setFinalityProviderHistoricalRewards(ctx, fpBTCPK, 0, types.NewFinalityProviderHistoricalRewards(sdk.NewDecCoins(), 1))
+	currentRewards := types.NewFinalityProviderCurrentRewards(sdk.NewCoins(), 1, 0)
+	k.setFinalityProviderCurrentRewards(ctx, fpBTCPK, currentRewards)
+	return currentRewards
+}
+
+func (k Keeper) setFinalityProviderCurrentRewards(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, currentRewards *types.FinalityProviderCurrentRewards) {
+	store := k.fpCurrentRewardsStore(ctx)
+	store.Set(fpBTCPK.MustMarshal(), k.cdc.MustMarshal(currentRewards))
+}
+
+func (k Keeper) getFinalityProviderHistoricalRewards(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, period uint64) *types.FinalityProviderHistoricalRewards {
+	store := k.fpHistoricalRewardsStore(ctx, fpBTCPK)
+	bz := store.Get(sdk.Uint64ToBigEndian(period))
+	if bz == nil {
+		return nil
+	}
+	var historicalRewards types.FinalityProviderHistoricalRewards
+	k.cdc.MustUnmarshal(bz, &historicalRewards)
+	return &historicalRewards
+}
+
+func (k Keeper) setFinalityProviderHistoricalRewards(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, period uint64, historicalRewards *types.FinalityProviderHistoricalRewards) {
+	store := k.fpHistoricalRewardsStore(ctx, fpBTCPK)
+	store.Set(sdk.Uint64ToBigEndian(period), k.cdc.MustMarshal(historicalRewards))
+}
+
+// incrementReferenceCount increments the reference count of the historical
+// rewards of the given finality provider at the given period
+func (k Keeper) incrementReferenceCount(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, period uint64) {
+	historicalRewards := k.getFinalityProviderHistoricalRewards(ctx, fpBTCPK, period)
+	if historicalRewards == nil {
+		panic(fmt.Errorf("historical rewards of finality provider %s at period %d not found", fpBTCPK.MarshalHex(), period))
+	}
+	historicalRewards.ReferenceCount++
+	k.setFinalityProviderHistoricalRewards(ctx, fpBTCPK, period, historicalRewards)
+}
+
+// decrementReferenceCount decrements the reference count of the historical
+// rewards of the given finality provider at the given period, and prunes
+// the historical rewards once nothing references it
+func (k Keeper) decrementReferenceCount(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, period uint64) {
+	historicalRewards := k.getFinalityProviderHistoricalRewards(ctx, fpBTCPK, period)
+	if historicalRewards == nil || historicalRewards.ReferenceCount == 0 {
+		panic(fmt.Errorf("cannot decrement the reference count of historical rewards of finality provider %s at period %d",
+			fpBTCPK.MarshalHex(), period))
+	}
+	historicalRewards.ReferenceCount--
+	if historicalRewards.ReferenceCount == 0 {
+		k.fpHistoricalRewardsStore(ctx, fpBTCPK).Delete(sdk.Uint64ToBigEndian(period))
+		return
+	}
+	k.setFinalityProviderHistoricalRewards(ctx, fpBTCPK, period, historicalRewards)
+}
+
+// initBTCDelegationRewardsTracker starts tracking the given stake of the given
+// BTC delegator under the given finality provider from the given period. The
+// rewards tracker is removed if the BTC delegator has no stake anymore
+func (k Keeper) initBTCDelegationRewardsTracker(
+	ctx context.Context,
+	fpBTCPK *bbn.BIP340PubKey,
+	delAddr sdk.AccAddress,
+	startPeriod uint64,
+	totalActiveSat uint64,
+) {
+	if totalActiveSat == 0 {
+		k.btcDelegatorToFPStore(ctx, delAddr).Delete(fpBTCPK.MustMarshal())
+		return
+	}
+
+	k.incrementReferenceCount(ctx, fpBTCPK, startPeriod)
+	tracker := types.NewBTCDelegationRewardsTracker(startPeriod, totalActiveSat)
+	k.btcDelegationRewardsTrackerStore(ctx, fpBTCPK).Set(delAddr.Bytes(), k.cdc.MustMarshal(tracker))
+	k.btcDelegatorToFPStore(ctx, delAddr).Set(fpBTCPK.MustMarshal(), []byte{0x00})
+}
+
+// GetBTCDelegationRewardsTracker returns the rewards tracker of the given BTC
+// delegator under the given finality provider
+func (k Keeper) GetBTCDelegationRewardsTracker(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, delAddr sdk.AccAddress) *types.BTCDelegationRewardsTracker {
+	bz := k.btcDelegationRewardsTrackerStore(ctx, fpBTCPK).Get(delAddr.Bytes())
+	if bz == nil {
+		return nil
+	}
+	var tracker types.BTCDelegationRewardsTracker
+	k.cdc.MustUnmarshal(bz, &tracker)
+	return &tracker
+}
+
+func (k Keeper) deleteBTCDelegationRewardsTracker(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, delAddr sdk.AccAddress) {
+	k.btcDelegationRewardsTrackerStore(ctx, fpBTCPK).Delete(delAddr.Bytes())
+}
+
+// fpCurrentRewardsStore returns the KVStore of the current rewards of each
+// finality provider
+// prefix: FinalityProviderCurrentRewardsKey
+// key: finality provider's BTC PK
+// value: FinalityProviderCurrentRewards
+func (k Keeper) fpCurrentRewardsStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.FinalityProviderCurrentRewardsKey)
+}
+
+// fpHistoricalRewardsStore returns the KVStore of the historical rewards of
+// the given finality provider
+// prefix: FinalityProviderHistoricalRewardsKey || finality provider's BTC PK
+// key: period
+// value: FinalityProviderHistoricalRewards
+func (k Keeper) fpHistoricalRewardsStore(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FinalityProviderHistoricalRewardsKey)
+	return prefix.NewStore(store, fpBTCPK.MustMarshal())
+}
+
+// btcDelegationRewardsTrackerStore returns the KVStore of the rewards trackers
+// of BTC delegators under the given finality provider
+// prefix: BTCDelegationRewardsTrackerKey || finality provider's BTC PK
+// key: BTC delegator's address
+// value: BTCDelegationRewardsTracker
+func (k Keeper) btcDelegationRewardsTrackerStore(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.BTCDelegationRewardsTrackerKey)
+	return prefix.NewStore(store, fpBTCPK.MustMarshal())
+}
+
+// btcDelegationStakeChangeStore returns the KVStore of the stake changes of
+// BTC delegators that are not applied to the rewards trackers yet
+// prefix: BTCDelegationStakeChangeKey
+// key: (height, index)
+// value: BTCDelegationStakeChange
+func (k Keeper) btcDelegationStakeChangeStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCDelegationStakeChangeKey)
+}
+
+// btcDelegatorToFPStore returns the KVStore of the finality providers the
+// given BTC delegator stakes to
+// prefix: BTCDelegatorToFPKey || length-prefixed BTC delegator's address
+// key: finality provider's BTC PK
+// value: nothing
+func (k Keeper) btcDelegatorToFPStore(ctx context.Context, delAddr sdk.AccAddress) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.BTCDelegatorToFPKey)
+	return prefix.NewStore(store, address.MustLengthPrefix(delAddr))
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/incentive/keeper"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzBTCDelegationRewardsTracker(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock bank keeper
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+
+		// create incentive keeper
+		ik, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, nil)
+		ms := keeper.NewMsgServerImpl(*ik)
+		hooks := ik.Hooks()
+
+		// a finality provider without commission, so that all rewards go to
+		// its BTC delegations
+		fp, err := datagen.GenRandomFinalityProviderDistInfo(r)
+		require.NoError(t, err)
+		zeroCommission := sdkmath.LegacyZeroDec()
+		fp.Commission = &zeroCommission
+		fp.BtcDels = nil
+
+		// two BTC delegations of two delegators with random stakes
+		btcDelA, err := datagen.GenRandomBTCDelDistInfo(r)
+		require.NoError(t, err)
+		btcDelB, err := datagen.GenRandomBTCDelDistInfo(r)
+		require.NoError(t, err)
+		delA, satA := btcDelA.GetAddress(), btcDelA.VotingPower
+		delB, satB := btcDelB.GetAddress(), btcDelB.VotingPower
+
+		// rewardAtHeight distributes the given rewards to the finality provider
+		// at the given height
+		rewardAtHeight := func(height uint64, amount int64) sdk.Coins {
+			rewards := sdk.NewCoins(sdk.NewInt64Coin("ubbn", amount))
+			ik.SetBTCStakingGauge(ctx, height, types.NewGauge(rewards...))
+			dc := bstypes.NewVotingPowerDistCache()
+			fp.TotalVotingPower = 1
+			dc.AddFinalityProviderDistInfo(fp)
+			dc.ApplyActiveFinalityProviders(1)
+			ik.RewardBTCStaking(ctx, height, dc)
+			return rewards
+		}
+		amount := func() int64 { return int64(datagen.RandomInt(r, 1000000) + 1000000) }
+
+		// A is staking since height 1, and B is staking since height 2.
+		// Heights are rewarded only after they are finalised, thus height 1
+		// is rewarded after B becomes active
+		hooks.AfterBTCDelegationActivated(datagen.WithCtxHeight(ctx, 1), fp.BtcPk, btcDelA)
+		hooks.AfterBTCDelegationActivated(datagen.WithCtxHeight(ctx, 2), fp.BtcPk, btcDelB)
+		require.Nil(t, ik.GetBTCDelegationRewardsTracker(ctx, fp.BtcPk, delA))
+		require.Nil(t, ik.GetBTCDelegationRewardsTracker(ctx, fp.BtcPk, delB))
+
+		// only A is staking at height 1
+		rewards1 := rewardAtHeight(1, amount())
+		require.Equal(t, satA, ik.GetBTCDelegationRewardsTracker(ctx, fp.BtcPk, delA).TotalActiveSat)
+		require.Nil(t, ik.GetBTCDelegationRewardsTracker(ctx, fp.BtcPk, delB))
+
+		// both A and B are staking at height 2, and A unbonds at height 3
+		// before height 2 is rewarded
+		hooks.AfterBTCDelegationUnbonded(datagen.WithCtxHeight(ctx, 3), fp.BtcPk, btcDelA)
+		rewards2 := rewardAtHeight(2, amount())
+		require.Equal(t, satA, ik.GetBTCDelegationRewardsTracker(ctx, fp.BtcPk, delA).TotalActiveSat)
+		require.Equal(t, satB, ik.GetBTCDelegationRewardsTracker(ctx, fp.BtcPk, delB).TotalActiveSat)
+		require.Nil(t, ik.GetRewardGauge(ctx, types.BTCDelegationType, delA))
+
+		// only B is staking at height 3, and A's rewards are accounted in its
+		// reward gauge once its unbonding is applied
+		rewards3 := rewardAtHeight(3, amount())
+		require.Nil(t, ik.GetBTCDelegationRewardsTracker(ctx, fp.BtcPk, delA))
+		portionA := sdkmath.LegacyNewDec(int64(satA)).QuoInt64(int64(satA + satB))
+		expectedA := rewards1.Add(types.GetCoinsPortion(rewards2, portionA)...)
+		rgA := ik.GetRewardGauge(ctx, types.BTCDelegationType, delA)
+		require.NotNil(t, rgA)
+		requireCoinsAlmostEqual(t, expectedA, rgA.Coins)
+
+		// B's rewards are accounted upon withdrawal
+		require.Nil(t, ik.GetRewardGauge(ctx, types.BTCDelegationType, delB))
+		expectedB := types.GetCoinsPortion(rewards2, sdkmath.LegacyOneDec().Sub(portionA)).Add(rewards3...)
+		var withdrawnB sdk.Coins
+		bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(delB), gomock.Any()).
+			DoAndReturn(func(_ interface{}, _ string, _ sdk.AccAddress, coins sdk.Coins) error {
+				withdrawnB = coins
+				return nil
+			}).Times(1)
+		resp, err := ms.WithdrawReward(ctx, &types.MsgWithdrawReward{
+			Type:    types.BTCDelegationType.String(),
+			Address: delB.String(),
+		})
+		require.NoError(t, err)
+		requireCoinsAlmostEqual(t, expectedB, resp.Coins)
+		require.Equal(t, resp.Coins, withdrawnB)
+
+		// B keeps staking after withdrawal, with nothing left to withdraw
+		require.Equal(t, satB, ik.GetBTCDelegationRewardsTracker(ctx, fp.BtcPk, delB).TotalActiveSat)
+		_, err = ms.WithdrawReward(ctx, &types.MsgWithdrawReward{
+			Type:    types.BTCDelegationType.String(),
+			Address: delB.String(),
+		})
+		require.ErrorIs(t, err, types.ErrNoWithdrawableCoins)
+
+		// the total distributed rewards never exceed the total rewards
+		totalRewards := rewards1.Add(rewards2...).Add(rewards3...)
+		require.True(t, totalRewards.IsAllGTE(rgA.Coins.Add(resp.Coins...)))
+	})
+}
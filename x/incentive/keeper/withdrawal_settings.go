@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetWithdrawAddress sets the address receiving the withdrawn rewards of the
+// given stakeholder. Setting the stakeholder address itself removes the entry.
+// Similar to x/distribution, blocked addresses and module accounts cannot
+// receive withdrawn rewards
+func (k Keeper) SetWithdrawAddress(ctx context.Context, addr sdk.AccAddress, withdrawAddr sdk.AccAddress) error {
+	store := k.withdrawAddressStore(ctx)
+	if addr.Equals(withdrawAddr) {
+		store.Delete(addr)
+		return nil
+	}
+	if k.bankKeeper.BlockedAddr(withdrawAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAddr)
+	}
+	if _, ok := k.accountKeeper.GetAccount(ctx, withdrawAddr).(sdk.ModuleAccountI); ok {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s is not allowed to receive withdrawn rewards", withdrawAddr)
+	}
+	store.Set(addr, withdrawAddr)
+	return nil
+}
+
+// GetWithdrawAddress returns the address receiving the withdrawn rewards of
+// the given stakeholder, which is the stakeholder address itself if not set
+func (k Keeper) GetWithdrawAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccAddress {
+	store := k.withdrawAddressStore(ctx)
+	withdrawAddrBytes := store.Get(addr)
+	if withdrawAddrBytes == nil {
+		return addr
+	}
+	return withdrawAddrBytes
+}
+
+// SetAutoRestakeValidator sets the validator to which the withdrawn rewards
+// of the given stakeholder are restaked. A nil validator address disables
+// auto-restaking
+func (k Keeper) SetAutoRestakeValidator(ctx context.Context, addr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := k.autoRestakeValidatorStore(ctx)
+	if valAddr.Empty() {
+		store.Delete(addr)
+		return
+	}
+	store.Set(addr, valAddr)
+}
+
+// GetAutoRestakeValidator returns the validator to which the withdrawn
+// rewards of the given stakeholder are restaked, or nil if auto-restaking is
+// disabled
+func (k Keeper) GetAutoRestakeValidator(ctx context.Context, addr sdk.AccAddress) sdk.ValAddress {
+	store := k.autoRestakeValidatorStore(ctx)
+	valAddrBytes := store.Get(addr)
+	if valAddrBytes == nil {
+		return nil
+	}
+	return valAddrBytes
+}
+
+// withdrawAddressStore returns the KVStore of the withdraw address of each
+// stakeholder
+// prefix: WithdrawAddressKey
+// key: stakeholder address
+// value: withdraw address
+func (k Keeper) withdrawAddressStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.WithdrawAddressKey)
+}
+
+// autoRestakeValidatorStore returns the KVStore of the auto-restaking
+// validator of each stakeholder
+// prefix: AutoRestakeValidatorKey
+// key: stakeholder address
+// value: validator address
+func (k Keeper) autoRestakeValidatorStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.AutoRestakeValidatorKey)
+}
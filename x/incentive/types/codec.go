@@ -10,6 +10,8 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdrawReward{}, "incentive/MsgWithdrawReward", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "incentive/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, "incentive/MsgSetWithdrawAddress", nil)
+	cdc.RegisterConcrete(&MsgSetAutoRestake{}, "incentive/MsgSetAutoRestake", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -18,6 +20,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgWithdrawReward{},
 		&MsgUpdateParams{},
+		&MsgSetWithdrawAddress{},
+		&MsgSetAutoRestake{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
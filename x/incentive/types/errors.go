@@ -10,4 +10,6 @@ var (
 	ErrBTCTimestampingGaugeNotFound = errorsmod.Register(ModuleName, 1101, "BTC timestamping gauge not found")
 	ErrRewardGaugeNotFound          = errorsmod.Register(ModuleName, 1102, "reward gauge not found")
 	ErrNoWithdrawableCoins          = errorsmod.Register(ModuleName, 1103, "no coin is withdrawable")
+	ErrInvalidHeightRange           = errorsmod.Register(ModuleName, 1104, "invalid height range")
+	ErrRewardsHistoryPruned         = errorsmod.Register(ModuleName, 1105, "the rewards history at the given height has been pruned")
 )
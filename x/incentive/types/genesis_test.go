@@ -18,6 +18,18 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "invalid dust recipient",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					SubmitterPortion:  types.DefaultParams().SubmitterPortion,
+					ReporterPortion:   types.DefaultParams().ReporterPortion,
+					BtcStakingPortion: types.DefaultParams().BtcStakingPortion,
+					DustRecipient:     "invalid",
+				},
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
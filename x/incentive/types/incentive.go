@@ -17,6 +17,34 @@ func (g *Gauge) GetCoinsPortion(portion math.LegacyDec) sdk.Coins {
 	return GetCoinsPortion(g.Coins, portion)
 }
 
+// SetDistributed records the given coins as distributed from the gauge, and
+// returns the undistributed remainder, which is also recorded in the gauge
+func (g *Gauge) SetDistributed(distributedCoins sdk.Coins) sdk.Coins {
+	g.DistributedCoins = distributedCoins
+	// NOTE: Sub panics if more coins than the gauge are distributed, which
+	// can only be a programming error
+	g.RemainderCoins = g.Coins.Sub(distributedCoins...)
+	return g.RemainderCoins
+}
+
+// IsDistributed returns whether the gauge has been distributed
+func (g *Gauge) IsDistributed() bool {
+	return !g.DistributedCoins.IsZero() || !g.RemainderCoins.IsZero()
+}
+
+// ValidateDistribution ensures that the distributed coins plus the remainder
+// equal the coins in a distributed gauge
+func (g *Gauge) ValidateDistribution() error {
+	if !g.IsDistributed() {
+		return nil
+	}
+	if total := g.DistributedCoins.Add(g.RemainderCoins...); !total.Equal(g.Coins) {
+		return fmt.Errorf("distributed coins %s plus remainder %s do not equal coins %s in the gauge",
+			g.DistributedCoins, g.RemainderCoins, g.Coins)
+	}
+	return nil
+}
+
 func NewRewardGauge(coins ...sdk.Coin) *RewardGauge {
 	return &RewardGauge{
 		Coins:          coins,
@@ -44,6 +72,35 @@ func (rg *RewardGauge) Add(coins sdk.Coins) {
 	rg.Coins = rg.Coins.Add(coins...)
 }
 
+func NewFinalityProviderCurrentRewards(currentRewards sdk.Coins, period uint64, totalActiveSat uint64) *FinalityProviderCurrentRewards {
+	return &FinalityProviderCurrentRewards{
+		CurrentRewards: currentRewards,
+		Period:         period,
+		TotalActiveSat: totalActiveSat,
+	}
+}
+
+func NewFinalityProviderHistoricalRewards(cumulativeRewardsPerSat sdk.DecCoins, referenceCount uint32) *FinalityProviderHistoricalRewards {
+	return &FinalityProviderHistoricalRewards{
+		CumulativeRewardsPerSat: cumulativeRewardsPerSat,
+		ReferenceCount:          referenceCount,
+	}
+}
+
+func NewBTCDelegationRewardsTracker(startPeriod uint64, totalActiveSat uint64) *BTCDelegationRewardsTracker {
+	return &BTCDelegationRewardsTracker{
+		StartPeriod:    startPeriod,
+		TotalActiveSat: totalActiveSat,
+	}
+}
+
+// GetRewardsPerSat returns the current rewards divided by the total active
+// satoshis, truncated
+func (r *FinalityProviderCurrentRewards) GetRewardsPerSat() sdk.DecCoins {
+	totalActiveSat := math.LegacyNewDecFromInt(math.NewIntFromUint64(r.TotalActiveSat))
+	return sdk.NewDecCoinsFromCoins(r.CurrentRewards...).QuoDecTruncate(totalActiveSat)
+}
+
 func GetCoinsPortion(coinsInt sdk.Coins, portion math.LegacyDec) sdk.Coins {
 	// coins with decimal value
 	coins := sdk.NewDecCoinsFromCoins(coinsInt...)
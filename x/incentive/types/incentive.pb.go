@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -31,6 +33,13 @@ type Gauge struct {
 	// coins are coins that have been in the gauge
 	// Can have multiple coin denoms
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// distributed_coins are coins in the gauge that have been distributed to
+	// stakeholders. It is empty if the gauge has not been distributed yet
+	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// remainder_coins are coins in the gauge that are left undistributed due
+	// to truncation upon distribution. They are either sent to the dust
+	// recipient in params or carried over to the next gauge
+	RemainderCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=remainder_coins,json=remainderCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remainder_coins"`
 }
 
 func (m *Gauge) Reset()         { *m = Gauge{} }
@@ -73,6 +82,20 @@ func (m *Gauge) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DistributedCoins
+	}
+	return nil
+}
+
+func (m *Gauge) GetRemainderCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainderCoins
+	}
+	return nil
+}
+
 // RewardGauge is an object that stores rewards distributed to a BTC staking/timestamping stakeholder
 // code adapted from https://github.com/osmosis-labs/osmosis/blob/v18.0.0/proto/osmosis/incentives/gauge.proto
 type RewardGauge struct {
@@ -130,32 +153,466 @@ func (m *RewardGauge) GetWithdrawnCoins() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+// FinalityProviderCurrentRewards is the rewards for the BTC delegations of a
+// finality provider in its current period, which is yet to be accounted in
+// the historical rewards
+// code adapted from https://github.com/cosmos/cosmos-sdk/blob/v0.50.6/proto/cosmos/distribution/v1beta1/distribution.proto
+type FinalityProviderCurrentRewards struct {
+	// current_rewards are the rewards accumulated in the current period
+	CurrentRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=current_rewards,json=currentRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"current_rewards"`
+	// period is the current period of the finality provider
+	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// total_active_sat is the total amount of satoshis actively staked to
+	// the finality provider in the current period
+	TotalActiveSat uint64 `protobuf:"varint,3,opt,name=total_active_sat,json=totalActiveSat,proto3" json:"total_active_sat,omitempty"`
+}
+
+func (m *FinalityProviderCurrentRewards) Reset()         { *m = FinalityProviderCurrentRewards{} }
+func (m *FinalityProviderCurrentRewards) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderCurrentRewards) ProtoMessage()    {}
+func (*FinalityProviderCurrentRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3954bc4942045a7a, []int{2}
+}
+func (m *FinalityProviderCurrentRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderCurrentRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderCurrentRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderCurrentRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderCurrentRewards.Merge(m, src)
+}
+func (m *FinalityProviderCurrentRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderCurrentRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderCurrentRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderCurrentRewards proto.InternalMessageInfo
+
+func (m *FinalityProviderCurrentRewards) GetCurrentRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CurrentRewards
+	}
+	return nil
+}
+
+func (m *FinalityProviderCurrentRewards) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *FinalityProviderCurrentRewards) GetTotalActiveSat() uint64 {
+	if m != nil {
+		return m.TotalActiveSat
+	}
+	return 0
+}
+
+// FinalityProviderHistoricalRewards is the cumulative rewards per satoshi of
+// a finality provider at the end of a period
+type FinalityProviderHistoricalRewards struct {
+	// cumulative_rewards_per_sat is the cumulative rewards per staked satoshi
+	// from the first period up to the end of this period
+	CumulativeRewardsPerSat github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_rewards_per_sat,json=cumulativeRewardsPerSat,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_rewards_per_sat"`
+	// reference_count is the number of objects referencing this historical
+	// rewards, i.e., the BTC delegation rewards trackers starting at this
+	// period and the next period of the finality provider
+	ReferenceCount uint32 `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
+}
+
+func (m *FinalityProviderHistoricalRewards) Reset()         { *m = FinalityProviderHistoricalRewards{} }
+func (m *FinalityProviderHistoricalRewards) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderHistoricalRewards) ProtoMessage()    {}
+func (*FinalityProviderHistoricalRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3954bc4942045a7a, []int{3}
+}
+func (m *FinalityProviderHistoricalRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderHistoricalRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderHistoricalRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderHistoricalRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderHistoricalRewards.Merge(m, src)
+}
+func (m *FinalityProviderHistoricalRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderHistoricalRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderHistoricalRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderHistoricalRewards proto.InternalMessageInfo
+
+func (m *FinalityProviderHistoricalRewards) GetCumulativeRewardsPerSat() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CumulativeRewardsPerSat
+	}
+	return nil
+}
+
+func (m *FinalityProviderHistoricalRewards) GetReferenceCount() uint32 {
+	if m != nil {
+		return m.ReferenceCount
+	}
+	return 0
+}
+
+// BTCDelegationRewardsTracker tracks the stake of a BTC delegator under a
+// finality provider and the period since which its rewards are not accounted
+type BTCDelegationRewardsTracker struct {
+	// start_period is the period of the finality provider since which the
+	// rewards of the BTC delegator are not yet accounted
+	StartPeriod uint64 `protobuf:"varint,1,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	// total_active_sat is the total amount of satoshis actively staked by the
+	// BTC delegator to the finality provider
+	TotalActiveSat uint64 `protobuf:"varint,2,opt,name=total_active_sat,json=totalActiveSat,proto3" json:"total_active_sat,omitempty"`
+}
+
+func (m *BTCDelegationRewardsTracker) Reset()         { *m = BTCDelegationRewardsTracker{} }
+func (m *BTCDelegationRewardsTracker) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationRewardsTracker) ProtoMessage()    {}
+func (*BTCDelegationRewardsTracker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3954bc4942045a7a, []int{4}
+}
+func (m *BTCDelegationRewardsTracker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegationRewardsTracker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationRewardsTracker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCDelegationRewardsTracker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationRewardsTracker.Merge(m, src)
+}
+func (m *BTCDelegationRewardsTracker) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationRewardsTracker) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationRewardsTracker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCDelegationRewardsTracker proto.InternalMessageInfo
+
+func (m *BTCDelegationRewardsTracker) GetStartPeriod() uint64 {
+	if m != nil {
+		return m.StartPeriod
+	}
+	return 0
+}
+
+func (m *BTCDelegationRewardsTracker) GetTotalActiveSat() uint64 {
+	if m != nil {
+		return m.TotalActiveSat
+	}
+	return 0
+}
+
+// FinalityProviderHeightRewards is the rewards distributed to a finality
+// provider and its BTC delegations at a finalized height
+type FinalityProviderHeightRewards struct {
+	// commission is the commission rewarded to the finality provider
+	Commission github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=commission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commission"`
+	// btc_delegation_rewards are the rewards shared by the BTC delegations of
+	// the finality provider, in proportion to their stakes
+	BtcDelegationRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=btc_delegation_rewards,json=btcDelegationRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"btc_delegation_rewards"`
+	// btc_delegation_rewards_per_sat are the rewards per satoshi of the BTC
+	// delegations of the finality provider, which is computed from the stakes
+	// tracked by the rewards trackers at this height
+	BtcDelegationRewardsPerSat github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=btc_delegation_rewards_per_sat,json=btcDelegationRewardsPerSat,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"btc_delegation_rewards_per_sat"`
+}
+
+func (m *FinalityProviderHeightRewards) Reset()         { *m = FinalityProviderHeightRewards{} }
+func (m *FinalityProviderHeightRewards) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderHeightRewards) ProtoMessage()    {}
+func (*FinalityProviderHeightRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3954bc4942045a7a, []int{5}
+}
+func (m *FinalityProviderHeightRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderHeightRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderHeightRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderHeightRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderHeightRewards.Merge(m, src)
+}
+func (m *FinalityProviderHeightRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderHeightRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderHeightRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderHeightRewards proto.InternalMessageInfo
+
+func (m *FinalityProviderHeightRewards) GetCommission() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Commission
+	}
+	return nil
+}
+
+func (m *FinalityProviderHeightRewards) GetBtcDelegationRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BtcDelegationRewards
+	}
+	return nil
+}
+
+func (m *FinalityProviderHeightRewards) GetBtcDelegationRewardsPerSat() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.BtcDelegationRewardsPerSat
+	}
+	return nil
+}
+
+// BTCDelegationActivity records the range of heights during which a BTC
+// delegation has voting power under a finality provider
+type BTCDelegationActivity struct {
+	// staker_addr is the address to receive rewards from the BTC delegation
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// sat is the amount of satoshis staked by the BTC delegation
+	Sat uint64 `protobuf:"varint,2,opt,name=sat,proto3" json:"sat,omitempty"`
+	// start_height is the height since which the BTC delegation has voting
+	// power (inclusive)
+	StartHeight uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height since which the BTC delegation no longer has
+	// voting power (exclusive). It is 0 if the BTC delegation is still active
+	EndHeight uint64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *BTCDelegationActivity) Reset()         { *m = BTCDelegationActivity{} }
+func (m *BTCDelegationActivity) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationActivity) ProtoMessage()    {}
+func (*BTCDelegationActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3954bc4942045a7a, []int{6}
+}
+func (m *BTCDelegationActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegationActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCDelegationActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationActivity.Merge(m, src)
+}
+func (m *BTCDelegationActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCDelegationActivity proto.InternalMessageInfo
+
+func (m *BTCDelegationActivity) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
+}
+
+func (m *BTCDelegationActivity) GetSat() uint64 {
+	if m != nil {
+		return m.Sat
+	}
+	return 0
+}
+
+func (m *BTCDelegationActivity) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *BTCDelegationActivity) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// BTCDelegationStakeChange is a change of the stake of a BTC delegator under a
+// finality provider, which is applied to the rewards tracker of the BTC
+// delegator once the height at which the change takes effect is rewarded
+type BTCDelegationStakeChange struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// staker_addr is the address to receive rewards from the BTC delegation
+	StakerAddr string `protobuf:"bytes,2,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// sat is the amount of satoshis added to or removed from the stake
+	Sat uint64 `protobuf:"varint,3,opt,name=sat,proto3" json:"sat,omitempty"`
+	// unbonded is whether the satoshis are removed from the stake
+	Unbonded bool `protobuf:"varint,4,opt,name=unbonded,proto3" json:"unbonded,omitempty"`
+}
+
+func (m *BTCDelegationStakeChange) Reset()         { *m = BTCDelegationStakeChange{} }
+func (m *BTCDelegationStakeChange) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationStakeChange) ProtoMessage()    {}
+func (*BTCDelegationStakeChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3954bc4942045a7a, []int{7}
+}
+func (m *BTCDelegationStakeChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegationStakeChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationStakeChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCDelegationStakeChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationStakeChange.Merge(m, src)
+}
+func (m *BTCDelegationStakeChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationStakeChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationStakeChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCDelegationStakeChange proto.InternalMessageInfo
+
+func (m *BTCDelegationStakeChange) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
+}
+
+func (m *BTCDelegationStakeChange) GetSat() uint64 {
+	if m != nil {
+		return m.Sat
+	}
+	return 0
+}
+
+func (m *BTCDelegationStakeChange) GetUnbonded() bool {
+	if m != nil {
+		return m.Unbonded
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Gauge)(nil), "babylon.incentive.Gauge")
 	proto.RegisterType((*RewardGauge)(nil), "babylon.incentive.RewardGauge")
+	proto.RegisterType((*FinalityProviderCurrentRewards)(nil), "babylon.incentive.FinalityProviderCurrentRewards")
+	proto.RegisterType((*FinalityProviderHistoricalRewards)(nil), "babylon.incentive.FinalityProviderHistoricalRewards")
+	proto.RegisterType((*BTCDelegationRewardsTracker)(nil), "babylon.incentive.BTCDelegationRewardsTracker")
+	proto.RegisterType((*FinalityProviderHeightRewards)(nil), "babylon.incentive.FinalityProviderHeightRewards")
+	proto.RegisterType((*BTCDelegationActivity)(nil), "babylon.incentive.BTCDelegationActivity")
+	proto.RegisterType((*BTCDelegationStakeChange)(nil), "babylon.incentive.BTCDelegationStakeChange")
 }
 
 func init() { proto.RegisterFile("babylon/incentive/incentive.proto", fileDescriptor_3954bc4942045a7a) }
 
 var fileDescriptor_3954bc4942045a7a = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0x45, 0xb0, 0xf4,
-	0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0xa1, 0x4a, 0xf4, 0xe0, 0x12, 0x52, 0x22, 0xe9, 0xf9,
-	0xe9, 0xf9, 0x60, 0x59, 0x7d, 0x10, 0x0b, 0xa2, 0x50, 0x4a, 0x2e, 0x39, 0xbf, 0x38, 0x37, 0xbf,
-	0x58, 0x3f, 0x29, 0xb1, 0x38, 0x55, 0xbf, 0xcc, 0x30, 0x29, 0xb5, 0x24, 0xd1, 0x50, 0x3f, 0x39,
-	0x3f, 0x33, 0x0f, 0x22, 0xaf, 0x94, 0xc5, 0xc5, 0xea, 0x9e, 0x58, 0x9a, 0x9e, 0x2a, 0x94, 0xc8,
-	0xc5, 0x0a, 0x12, 0x2e, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0xd4, 0x83, 0x68, 0xd4,
-	0x03, 0x69, 0xd4, 0x83, 0x6a, 0xd4, 0x73, 0xce, 0xcf, 0xcc, 0x73, 0x32, 0x38, 0x71, 0x4f, 0x9e,
-	0x61, 0xd5, 0x7d, 0x79, 0x8d, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d,
-	0xa8, 0x2d, 0x10, 0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x18, 0xac, 0xa1,
-	0x38, 0x08, 0x62, 0xb2, 0xd2, 0x33, 0x46, 0x2e, 0xee, 0xa0, 0xd4, 0xf2, 0xc4, 0xa2, 0x14, 0x7a,
-	0x59, 0x29, 0x54, 0xc2, 0xc5, 0x5f, 0x9e, 0x59, 0x92, 0x91, 0x52, 0x94, 0x58, 0x9e, 0x17, 0x0f,
-	0xb1, 0x8c, 0x89, 0xfa, 0x96, 0xf1, 0xc1, 0xed, 0x00, 0xf3, 0x9d, 0xbc, 0x4f, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
-	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x10, 0xc9, 0x4c, 0x68, 0x14, 0x26, 0x67, 0x24, 0x66,
-	0xe6, 0xc1, 0x38, 0xfa, 0x15, 0x48, 0x91, 0x0e, 0xb6, 0x22, 0x89, 0x0d, 0x1c, 0x51, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x83, 0xc5, 0xfb, 0x16, 0x02, 0x00, 0x00,
+	// 764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x4f, 0x1b, 0x39,
+	0x18, 0xce, 0x24, 0xc0, 0x82, 0x61, 0x03, 0x8c, 0x58, 0x36, 0x64, 0x97, 0x01, 0x72, 0xd9, 0x48,
+	0x2b, 0x12, 0x3e, 0x56, 0x2b, 0xed, 0x91, 0x04, 0xed, 0x87, 0xb8, 0x44, 0x13, 0x4e, 0x7b, 0x19,
+	0x79, 0x6c, 0x33, 0xf1, 0x26, 0xb1, 0x23, 0xdb, 0x03, 0xe4, 0xb8, 0x7f, 0xa0, 0xea, 0xa5, 0x7f,
+	0xa1, 0xaa, 0x7a, 0xee, 0x8f, 0xe0, 0x88, 0x7a, 0x29, 0x42, 0x2a, 0xad, 0x40, 0xea, 0xef, 0xa8,
+	0xc6, 0x36, 0x93, 0x00, 0xa9, 0xda, 0x4a, 0xa4, 0xa7, 0xcc, 0xfb, 0xe1, 0xf7, 0x79, 0x9f, 0x67,
+	0x1e, 0x6b, 0x02, 0x36, 0x42, 0x18, 0xf6, 0x3b, 0x9c, 0x55, 0x29, 0x43, 0x84, 0x29, 0x7a, 0x4c,
+	0x06, 0x4f, 0x95, 0x9e, 0xe0, 0x8a, 0xbb, 0x8b, 0xb6, 0xa5, 0x92, 0x16, 0x8a, 0x4b, 0x11, 0x8f,
+	0xb8, 0xae, 0x56, 0x93, 0x27, 0xd3, 0x58, 0x5c, 0x41, 0x5c, 0x76, 0xb9, 0x0c, 0x4c, 0xc1, 0x04,
+	0xb6, 0xe4, 0x99, 0xa8, 0x1a, 0x42, 0x49, 0xaa, 0xc7, 0xdb, 0x21, 0x51, 0x70, 0xbb, 0x8a, 0x38,
+	0x65, 0xa6, 0x5e, 0xba, 0xcc, 0x82, 0xc9, 0xbf, 0x60, 0x1c, 0x11, 0x17, 0x82, 0xc9, 0x24, 0x2f,
+	0x0b, 0xce, 0x7a, 0xae, 0x3c, 0xbb, 0xb3, 0x52, 0xb1, 0x73, 0x92, 0x93, 0x15, 0x7b, 0xb2, 0x52,
+	0xe7, 0x94, 0xd5, 0xb6, 0xce, 0xae, 0xd6, 0x32, 0x2f, 0xdf, 0xad, 0x95, 0x23, 0xaa, 0x5a, 0x71,
+	0x58, 0x41, 0xbc, 0x6b, 0x41, 0xed, 0xcf, 0xa6, 0xc4, 0xed, 0xaa, 0xea, 0xf7, 0x88, 0xd4, 0x07,
+	0xa4, 0x6f, 0x26, 0xbb, 0xa7, 0x60, 0x11, 0x53, 0xa9, 0x04, 0x0d, 0x63, 0x45, 0x70, 0x60, 0xe0,
+	0xb2, 0x8f, 0x0f, 0xb7, 0x30, 0x84, 0xa2, 0x33, 0xae, 0x02, 0xf3, 0x82, 0x74, 0x21, 0x65, 0x98,
+	0x08, 0x8b, 0x9b, 0x7b, 0x7c, 0xdc, 0x7c, 0x8a, 0xa1, 0xe3, 0xd2, 0x07, 0x07, 0xcc, 0xfa, 0xe4,
+	0x04, 0x0a, 0xfc, 0xcd, 0x24, 0x56, 0x60, 0xfe, 0x84, 0xaa, 0x16, 0x16, 0xf0, 0x84, 0x8d, 0x4f,
+	0xe0, 0x7c, 0x8a, 0x61, 0x88, 0x5e, 0x38, 0xc0, 0xfb, 0x93, 0x32, 0xd8, 0xa1, 0xaa, 0xdf, 0x10,
+	0xfc, 0x98, 0x26, 0x12, 0xc4, 0x42, 0x10, 0xa6, 0x0c, 0x7f, 0xbd, 0x18, 0x32, 0x99, 0x40, 0x98,
+	0xd4, 0x38, 0x54, 0xc8, 0xa3, 0xbb, 0xa8, 0xcb, 0x60, 0xaa, 0x47, 0x04, 0xe5, 0xb8, 0x90, 0x5d,
+	0x77, 0xca, 0x13, 0xbe, 0x8d, 0xdc, 0x32, 0x58, 0x50, 0x5c, 0xc1, 0x4e, 0x00, 0x51, 0x72, 0xaf,
+	0x02, 0x09, 0x55, 0x21, 0xa7, 0x3b, 0xf2, 0x3a, 0xbf, 0xa7, 0xd3, 0x4d, 0xa8, 0x4a, 0x6f, 0x1d,
+	0xb0, 0x71, 0x9f, 0xda, 0xdf, 0x54, 0x2a, 0x2e, 0x28, 0x82, 0x9d, 0x5b, 0x9c, 0x27, 0x0e, 0x28,
+	0xa2, 0xb8, 0x1b, 0x77, 0xa0, 0x1e, 0x67, 0x19, 0x06, 0x3d, 0x22, 0xf4, 0x68, 0xc3, 0xf4, 0xe7,
+	0x91, 0x4c, 0xf7, 0x09, 0xd2, 0x64, 0x77, 0x2d, 0xd9, 0x5f, 0xbf, 0x80, 0xac, 0x3d, 0x23, 0xfd,
+	0x1f, 0x07, 0xa0, 0x76, 0x95, 0x06, 0x11, 0x4d, 0xa8, 0xdc, 0x5f, 0x12, 0xc3, 0x1f, 0x11, 0x41,
+	0x18, 0x22, 0x01, 0xe2, 0x31, 0x53, 0x5a, 0x81, 0xef, 0xfd, 0x7c, 0x9a, 0xae, 0x27, 0xd9, 0xd2,
+	0x7f, 0xe0, 0xa7, 0xda, 0x61, 0x7d, 0x9f, 0x74, 0x48, 0x04, 0x15, 0xe5, 0xcc, 0x8e, 0x39, 0x14,
+	0x10, 0xb5, 0x89, 0x70, 0x37, 0xc0, 0x9c, 0x54, 0x50, 0xa8, 0xc0, 0xca, 0xe8, 0x68, 0x91, 0x66,
+	0x75, 0xae, 0xf1, 0x69, 0x2d, 0xb3, 0x23, 0xb5, 0x7c, 0x91, 0x03, 0xab, 0x0f, 0xb4, 0x24, 0x34,
+	0x6a, 0xa5, 0xef, 0xab, 0x0d, 0x00, 0xe2, 0xdd, 0x2e, 0x95, 0x92, 0x72, 0x36, 0x0e, 0x83, 0x0c,
+	0x8d, 0x77, 0xff, 0x77, 0xc0, 0x72, 0xa8, 0x50, 0x80, 0x53, 0xf2, 0xa9, 0x35, 0xc7, 0x70, 0x67,
+	0x96, 0x42, 0x85, 0x1e, 0xc8, 0xec, 0x3e, 0x73, 0x80, 0x37, 0x7a, 0x87, 0xd4, 0x3c, 0xb9, 0x71,
+	0x99, 0xa7, 0x38, 0x6a, 0x23, 0xe3, 0x9f, 0xd2, 0x73, 0x07, 0xfc, 0x70, 0xc7, 0x17, 0xfa, 0x2d,
+	0x52, 0xd5, 0x77, 0xff, 0x00, 0xc9, 0xdb, 0x6f, 0x13, 0x11, 0x40, 0x8c, 0x85, 0x36, 0xc4, 0x4c,
+	0xad, 0xf0, 0xfa, 0xd5, 0xe6, 0x92, 0x5d, 0x70, 0x0f, 0x63, 0x41, 0xa4, 0x6c, 0x2a, 0x41, 0x59,
+	0xe4, 0x03, 0xd3, 0x9c, 0x24, 0xdd, 0x05, 0x90, 0x1b, 0x98, 0x23, 0x79, 0x1c, 0xd8, 0xab, 0xa5,
+	0x6d, 0x60, 0xef, 0xa0, 0xb1, 0x97, 0x71, 0x86, 0xbb, 0x0a, 0x00, 0x61, 0xf8, 0xb6, 0x61, 0x42,
+	0x37, 0xcc, 0x10, 0x86, 0x4d, 0xb9, 0xf4, 0xc6, 0x01, 0x85, 0x3b, 0x8b, 0x36, 0x13, 0xbc, 0x7a,
+	0x0b, 0xb2, 0x88, 0xb8, 0x3e, 0x98, 0x39, 0xea, 0x05, 0x89, 0xbe, 0xbd, 0xb6, 0xde, 0x74, 0xae,
+	0xf6, 0xfb, 0xe5, 0xd5, 0xda, 0xce, 0x90, 0x4a, 0xf6, 0x1b, 0x8b, 0x5a, 0x90, 0xb2, 0xdb, 0xc0,
+	0x0a, 0x55, 0xfb, 0xa7, 0xb1, 0xfb, 0xdb, 0x56, 0x23, 0x0e, 0x0f, 0x48, 0xdf, 0xff, 0xee, 0xa8,
+	0x57, 0x53, 0xa8, 0xd1, 0xbe, 0xcf, 0x3f, 0xfb, 0xf5, 0xfc, 0x73, 0x03, 0xfe, 0x45, 0x30, 0x1d,
+	0xb3, 0x90, 0x33, 0x4c, 0xb0, 0xa6, 0x36, 0xed, 0xa7, 0x71, 0xed, 0xe0, 0xec, 0xda, 0x73, 0xce,
+	0xaf, 0x3d, 0xe7, 0xfd, 0xb5, 0xe7, 0x3c, 0xbd, 0xf1, 0x32, 0xe7, 0x37, 0x5e, 0xe6, 0xe2, 0xc6,
+	0xcb, 0xfc, 0xbb, 0xfd, 0xb9, 0xfd, 0x4f, 0x87, 0xfe, 0x55, 0x68, 0x2e, 0xe1, 0x94, 0xfe, 0xdc,
+	0xef, 0x7e, 0x1c, 0x00, 0xf4, 0x8a, 0x2c, 0xfe, 0x77, 0x08, 0x00, 0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -178,6 +635,34 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemainderCoins) > 0 {
+		for iNdEx := len(m.RemainderCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainderCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DistributedCoins) > 0 {
+		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -246,21 +731,310 @@ func (m *RewardGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintIncentive(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIncentive(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FinalityProviderCurrentRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Gauge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FinalityProviderCurrentRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderCurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalActiveSat != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.TotalActiveSat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Period != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CurrentRewards) > 0 {
+		for iNdEx := len(m.CurrentRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderHistoricalRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderHistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderHistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReferenceCount != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.ReferenceCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CumulativeRewardsPerSat) > 0 {
+		for iNdEx := len(m.CumulativeRewardsPerSat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeRewardsPerSat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegationRewardsTracker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationRewardsTracker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationRewardsTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalActiveSat != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.TotalActiveSat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartPeriod != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.StartPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderHeightRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderHeightRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderHeightRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BtcDelegationRewardsPerSat) > 0 {
+		for iNdEx := len(m.BtcDelegationRewardsPerSat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegationRewardsPerSat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BtcDelegationRewards) > 0 {
+		for iNdEx := len(m.BtcDelegationRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegationRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Commission) > 0 {
+		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegationActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sat != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.Sat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegationStakeChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationStakeChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationStakeChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unbonded {
+		i--
+		if m.Unbonded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sat != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.Sat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintIncentive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIncentive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIncentive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Gauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if len(m.Coins) > 0 {
@@ -269,6 +1043,18 @@ func (m *Gauge) Size() (n int) {
 			n += 1 + l + sovIncentive(uint64(l))
 		}
 	}
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if len(m.RemainderCoins) > 0 {
+		for _, e := range m.RemainderCoins {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -290,16 +1076,725 @@ func (m *RewardGauge) Size() (n int) {
 			n += 1 + l + sovIncentive(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *FinalityProviderCurrentRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrentRewards) > 0 {
+		for _, e := range m.CurrentRewards {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if m.Period != 0 {
+		n += 1 + sovIncentive(uint64(m.Period))
+	}
+	if m.TotalActiveSat != 0 {
+		n += 1 + sovIncentive(uint64(m.TotalActiveSat))
+	}
+	return n
+}
+
+func (m *FinalityProviderHistoricalRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeRewardsPerSat) > 0 {
+		for _, e := range m.CumulativeRewardsPerSat {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if m.ReferenceCount != 0 {
+		n += 1 + sovIncentive(uint64(m.ReferenceCount))
+	}
+	return n
+}
+
+func (m *BTCDelegationRewardsTracker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartPeriod != 0 {
+		n += 1 + sovIncentive(uint64(m.StartPeriod))
+	}
+	if m.TotalActiveSat != 0 {
+		n += 1 + sovIncentive(uint64(m.TotalActiveSat))
+	}
+	return n
+}
+
+func (m *FinalityProviderHeightRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if len(m.BtcDelegationRewards) > 0 {
+		for _, e := range m.BtcDelegationRewards {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if len(m.BtcDelegationRewardsPerSat) > 0 {
+		for _, e := range m.BtcDelegationRewardsPerSat {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BTCDelegationActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if m.Sat != 0 {
+		n += 1 + sovIncentive(uint64(m.Sat))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovIncentive(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovIncentive(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *BTCDelegationStakeChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if m.Sat != 0 {
+		n += 1 + sovIncentive(uint64(m.Sat))
+	}
+	if m.Unbonded {
+		n += 2
+	}
+	return n
+}
+
+func sovIncentive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIncentive(x uint64) (n int) {
+	return sovIncentive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainderCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainderCoins = append(m.RemainderCoins, types.Coin{})
+			if err := m.RemainderCoins[len(m.RemainderCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardGauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardGauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnCoins = append(m.WithdrawnCoins, types.Coin{})
+			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderCurrentRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderCurrentRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentRewards = append(m.CurrentRewards, types.Coin{})
+			if err := m.CurrentRewards[len(m.CurrentRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveSat", wireType)
+			}
+			m.TotalActiveSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalActiveSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderHistoricalRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderHistoricalRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardsPerSat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeRewardsPerSat = append(m.CumulativeRewardsPerSat, types.DecCoin{})
+			if err := m.CumulativeRewardsPerSat[len(m.CumulativeRewardsPerSat)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			}
+			m.ReferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCDelegationRewardsTracker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegationRewardsTracker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegationRewardsTracker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPeriod", wireType)
+			}
+			m.StartPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveSat", wireType)
+			}
+			m.TotalActiveSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalActiveSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovIncentive(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIncentive(x uint64) (n int) {
-	return sovIncentive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Gauge) Unmarshal(dAtA []byte) error {
+func (m *FinalityProviderHeightRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -322,15 +1817,15 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProviderHeightRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProviderHeightRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -357,8 +1852,76 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commission = append(m.Commission, types.Coin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegationRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegationRewards = append(m.BtcDelegationRewards, types.Coin{})
+			if err := m.BtcDelegationRewards[len(m.BtcDelegationRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegationRewardsPerSat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegationRewardsPerSat = append(m.BtcDelegationRewardsPerSat, types.DecCoin{})
+			if err := m.BtcDelegationRewardsPerSat[len(m.BtcDelegationRewardsPerSat)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -383,7 +1946,7 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardGauge) Unmarshal(dAtA []byte) error {
+func (m *BTCDelegationActivity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -406,17 +1969,17 @@ func (m *RewardGauge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardGauge: wiretype end group for non-group")
+			return fmt.Errorf("proto: BTCDelegationActivity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BTCDelegationActivity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentive
@@ -426,31 +1989,136 @@ func (m *RewardGauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIncentive
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIncentive
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sat", wireType)
+			}
+			m.Sat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCDelegationStakeChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegationStakeChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegationStakeChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentive
@@ -460,26 +2128,98 @@ func (m *RewardGauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthIncentive
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIncentive
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawnCoins = append(m.WithdrawnCoins, types.Coin{})
-			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sat", wireType)
+			}
+			m.Sat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unbonded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentive(dAtA[iNdEx:])
@@ -19,4 +19,20 @@ var (
 	BTCStakingGaugeKey      = []byte{0x02} // key prefix for BTC staking gauge at each height
 	BTCTimestampingGaugeKey = []byte{0x03} // key prefix for BTC timestamping gauge at each height
 	RewardGaugeKey          = []byte{0x04} // key prefix for reward gauge for a given stakeholder in a given type
+
+	FinalityProviderCurrentRewardsKey    = []byte{0x05} // key prefix for the current rewards of each finality provider
+	FinalityProviderHistoricalRewardsKey = []byte{0x06} // key prefix for the historical rewards of each finality provider at each period
+	BTCDelegationRewardsTrackerKey       = []byte{0x07} // key prefix for the rewards tracker of each BTC delegator under each finality provider
+	BTCDelegatorToFPKey                  = []byte{0x08} // key prefix for the finality providers each BTC delegator stakes to
+
+	WithdrawAddressKey      = []byte{0x09} // key prefix for the withdraw address of each stakeholder
+	AutoRestakeValidatorKey = []byte{0x0a} // key prefix for the auto-restaking validator of each stakeholder
+
+	FinalityProviderHeightRewardsKey = []byte{0x0b} // key prefix for the rewards of each finality provider at each height
+	BTCDelegationActivityKey         = []byte{0x0c} // key prefix for the activity of each BTC delegation under each finality provider
+
+	BTCDelegationStakeChangeKey = []byte{0x0d} // key prefix for the stake changes of BTC delegators to be applied at each height
+
+	BTCDelegationActivityEndHeightKey = []byte{0x0e} // key prefix for the ended activities of BTC delegations at each height
+	NextHeightToPruneKey              = []byte{0x0f} // key for the next height whose rewards history is to be pruned
 )
@@ -8,8 +8,10 @@ import (
 	context "context"
 	reflect "reflect"
 
+	types2 "github.com/babylonchain/babylon/x/btcstaking/types"
 	types "github.com/babylonchain/babylon/x/epoching/types"
 	types0 "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -87,6 +89,20 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// BlockedAddr mocks base method.
+func (m *MockBankKeeper) BlockedAddr(addr types0.AccAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockedAddr", addr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// BlockedAddr indicates an expected call of BlockedAddr.
+func (mr *MockBankKeeperMockRecorder) BlockedAddr(addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockedAddr", reflect.TypeOf((*MockBankKeeper)(nil).BlockedAddr), addr)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types0.AccAddress) types0.Coins {
 	m.ctrl.T.Helper()
@@ -166,6 +182,35 @@ func (m *MockEpochingKeeper) EXPECT() *MockEpochingKeeperMockRecorder {
 	return m.recorder
 }
 
+// BondDenom mocks base method.
+func (m *MockEpochingKeeper) BondDenom(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BondDenom", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BondDenom indicates an expected call of BondDenom.
+func (mr *MockEpochingKeeperMockRecorder) BondDenom(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockEpochingKeeper)(nil).BondDenom), ctx)
+}
+
+// EnqueueWrappedDelegate mocks base method.
+func (m *MockEpochingKeeper) EnqueueWrappedDelegate(ctx context.Context, msg *types.MsgWrappedDelegate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueWrappedDelegate", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueWrappedDelegate indicates an expected call of EnqueueWrappedDelegate.
+func (mr *MockEpochingKeeperMockRecorder) EnqueueWrappedDelegate(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueWrappedDelegate", reflect.TypeOf((*MockEpochingKeeper)(nil).EnqueueWrappedDelegate), ctx, msg)
+}
+
 // GetEpoch mocks base method.
 func (m *MockEpochingKeeper) GetEpoch(ctx context.Context) *types.Epoch {
 	m.ctrl.T.Helper()
@@ -179,3 +224,56 @@ func (mr *MockEpochingKeeperMockRecorder) GetEpoch(ctx interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpoch", reflect.TypeOf((*MockEpochingKeeper)(nil).GetEpoch), ctx)
 }
+
+// GetValidator mocks base method.
+func (m *MockEpochingKeeper) GetValidator(ctx context.Context, valAddr types0.ValAddress) (types1.Validator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidator", ctx, valAddr)
+	ret0, _ := ret[0].(types1.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidator indicates an expected call of GetValidator.
+func (mr *MockEpochingKeeperMockRecorder) GetValidator(ctx, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidator", reflect.TypeOf((*MockEpochingKeeper)(nil).GetValidator), ctx, valAddr)
+}
+
+// MockBTCStakingKeeper is a mock of BTCStakingKeeper interface.
+type MockBTCStakingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBTCStakingKeeperMockRecorder
+}
+
+// MockBTCStakingKeeperMockRecorder is the mock recorder for MockBTCStakingKeeper.
+type MockBTCStakingKeeperMockRecorder struct {
+	mock *MockBTCStakingKeeper
+}
+
+// NewMockBTCStakingKeeper creates a new mock instance.
+func NewMockBTCStakingKeeper(ctrl *gomock.Controller) *MockBTCStakingKeeper {
+	mock := &MockBTCStakingKeeper{ctrl: ctrl}
+	mock.recorder = &MockBTCStakingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBTCStakingKeeper) EXPECT() *MockBTCStakingKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetVotingPowerDistCache mocks base method.
+func (m *MockBTCStakingKeeper) GetVotingPowerDistCache(ctx context.Context, height uint64) (*types2.VotingPowerDistCache, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVotingPowerDistCache", ctx, height)
+	ret0, _ := ret[0].(*types2.VotingPowerDistCache)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVotingPowerDistCache indicates an expected call of GetVotingPowerDistCache.
+func (mr *MockBTCStakingKeeperMockRecorder) GetVotingPowerDistCache(ctx, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVotingPowerDistCache", reflect.TypeOf((*MockBTCStakingKeeper)(nil).GetVotingPowerDistCache), ctx, height)
+}
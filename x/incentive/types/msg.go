@@ -8,4 +8,6 @@ import (
 var (
 	_ sdk.Msg = &MsgWithdrawReward{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetWithdrawAddress{}
+	_ sdk.Msg = &MsgSetAutoRestake{}
 )
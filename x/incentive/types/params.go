@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -44,6 +45,15 @@ func (p *Params) BTCTimestampingPortion() math.LegacyDec {
 	return sum
 }
 
+// DustRecipientAddress returns the address receiving the undistributed
+// remainder of reward gauges, or nil if the remainder is carried over
+func (p *Params) DustRecipientAddress() sdk.AccAddress {
+	if p.DustRecipient == "" {
+		return nil
+	}
+	return sdk.MustAccAddressFromBech32(p.DustRecipient)
+}
+
 // BTCStakingPortion calculates the sum of portions of all BTC staking stakeholders
 func (p *Params) BTCStakingPortion() math.LegacyDec {
 	return p.BtcStakingPortion
@@ -66,6 +76,12 @@ func (p Params) Validate() error {
 		return fmt.Errorf("sum of all portions should be less than 1")
 	}
 
+	if p.DustRecipient != "" {
+		if _, err := sdk.AccAddressFromBech32(p.DustRecipient); err != nil {
+			return fmt.Errorf("invalid DustRecipient: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -38,6 +38,11 @@ type Params struct {
 	// NOTE: the portion of each Finality Provider/delegation is calculated by using its voting
 	// power and finality provider's commission
 	BtcStakingPortion cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=btc_staking_portion,json=btcStakingPortion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"btc_staking_portion"`
+	// dust_recipient is the bech32 address receiving the undistributed
+	// remainder of each reward gauge due to truncation, e.g., the community
+	// pool or a burn address. If empty, the remainder is carried over to the
+	// gauge of the next height (BTC staking) or epoch (BTC timestamping)
+	DustRecipient string `protobuf:"bytes,4,opt,name=dust_recipient,json=dustRecipient,proto3" json:"dust_recipient,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -72,6 +77,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDustRecipient() string {
+	if m != nil {
+		return m.DustRecipient
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.incentive.Params")
 }
@@ -79,26 +91,28 @@ func init() {
 func init() { proto.RegisterFile("babylon/incentive/params.proto", fileDescriptor_c42276168f0adf4b) }
 
 var fileDescriptor_c42276168f0adf4b = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0xd5, 0x2f, 0x48,
-	0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xca, 0xeb, 0xc1,
-	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xb2, 0xfa, 0x20, 0x16, 0x44, 0xa1, 0x94, 0x64,
-	0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02, 0xc2, 0x81, 0x48, 0x29, 0xad, 0x67, 0xe2,
-	0x62, 0x0b, 0x00, 0x1b, 0x2a, 0x14, 0xc7, 0x25, 0x58, 0x5c, 0x9a, 0x94, 0x9b, 0x59, 0x52, 0x92,
-	0x5a, 0x14, 0x5f, 0x90, 0x5f, 0x54, 0x92, 0x99, 0x9f, 0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9,
-	0x64, 0x78, 0xe2, 0x9e, 0x3c, 0xc3, 0xad, 0x7b, 0xf2, 0xd2, 0x10, 0xbd, 0xc5, 0x29, 0xd9, 0x7a,
-	0x99, 0xf9, 0xfa, 0xb9, 0x89, 0x25, 0x19, 0x7a, 0x3e, 0xa9, 0xe9, 0x89, 0xc9, 0x95, 0x2e, 0xa9,
-	0xc9, 0x97, 0xb6, 0xe8, 0x72, 0x41, 0x8d, 0x76, 0x49, 0x4d, 0x0e, 0x12, 0x80, 0x9b, 0x15, 0x00,
-	0x31, 0x4a, 0x28, 0x86, 0x4b, 0xa0, 0x28, 0x15, 0x64, 0x2e, 0x92, 0xf1, 0x4c, 0xe4, 0x1a, 0xcf,
-	0x0f, 0x33, 0x0a, 0x66, 0x7a, 0x22, 0x97, 0x70, 0x52, 0x49, 0x72, 0x7c, 0x71, 0x49, 0x62, 0x76,
-	0x66, 0x5e, 0x3a, 0xdc, 0x02, 0x66, 0x72, 0x2d, 0x10, 0x4c, 0x2a, 0x49, 0x0e, 0x86, 0x18, 0x06,
-	0xb5, 0xc2, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x87, 0x46, 0x4d, 0x72, 0x46, 0x62, 0x66, 0x1e, 0x8c, 0xa3, 0x5f, 0x81, 0x14, 0x93, 0x25, 0x95,
-	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x58, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x55, 0x13,
-	0x48, 0x09, 0xeb, 0x01, 0x00, 0x00,
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd2, 0xb1, 0x4e, 0x32, 0x41,
+	0x10, 0x07, 0xf0, 0x3b, 0x3e, 0x42, 0xf2, 0x6d, 0xa2, 0x02, 0x52, 0x20, 0x26, 0x8b, 0xb1, 0xb2,
+	0xe1, 0x2e, 0xc4, 0xce, 0xc6, 0x48, 0xe8, 0xb4, 0x20, 0xd0, 0x19, 0xe3, 0x65, 0x77, 0x6f, 0x73,
+	0x6c, 0xf0, 0x76, 0x2f, 0xbb, 0x83, 0x91, 0xb7, 0xb0, 0xb4, 0xf4, 0x21, 0x78, 0x08, 0x4a, 0x42,
+	0x65, 0x2c, 0x88, 0x81, 0x17, 0xf0, 0x11, 0x0c, 0xb7, 0x77, 0x17, 0x6a, 0xba, 0x9d, 0xfc, 0x67,
+	0x7e, 0x53, 0xcc, 0x22, 0x4c, 0x09, 0x9d, 0xbd, 0x28, 0xe9, 0x0b, 0xc9, 0xb8, 0x04, 0xf1, 0xca,
+	0xfd, 0x84, 0x68, 0x12, 0x1b, 0x2f, 0xd1, 0x0a, 0x54, 0xbd, 0x96, 0xe5, 0x5e, 0x91, 0xb7, 0x1a,
+	0x91, 0x8a, 0x54, 0x9a, 0xfa, 0xbb, 0x97, 0x6d, 0x6c, 0x9d, 0x31, 0x65, 0x62, 0x65, 0x02, 0x1b,
+	0xd8, 0xc2, 0x46, 0x97, 0xbf, 0x25, 0x54, 0x19, 0xa4, 0x68, 0xfd, 0x19, 0xd5, 0xcc, 0x94, 0xc6,
+	0x02, 0x80, 0xeb, 0x20, 0x51, 0x1a, 0x84, 0x92, 0x4d, 0xf7, 0xc2, 0xbd, 0xfa, 0xdf, 0xeb, 0x2e,
+	0xd6, 0x6d, 0xe7, 0x7b, 0xdd, 0x3e, 0xb7, 0xb3, 0x26, 0x9c, 0x78, 0x42, 0xf9, 0x31, 0x81, 0xb1,
+	0xf7, 0xc0, 0x23, 0xc2, 0x66, 0x7d, 0xce, 0x56, 0xf3, 0x0e, 0xca, 0xe8, 0x3e, 0x67, 0xc3, 0x6a,
+	0x61, 0x0d, 0x2c, 0x55, 0x7f, 0x42, 0x55, 0xcd, 0x77, 0xee, 0x1e, 0x5f, 0x3a, 0x94, 0x3f, 0xc9,
+	0xa9, 0x5c, 0x27, 0xe8, 0x94, 0x02, 0x0b, 0x0c, 0x90, 0x89, 0x90, 0x51, 0xb1, 0xe0, 0xdf, 0xa1,
+	0x0b, 0x6a, 0x14, 0xd8, 0xc8, 0x62, 0xf9, 0x8a, 0x5b, 0x74, 0x1c, 0x4e, 0x0d, 0x04, 0x9a, 0x33,
+	0x91, 0x08, 0x2e, 0xa1, 0x59, 0x4e, 0xf5, 0xe6, 0x6a, 0xde, 0x69, 0x64, 0xa3, 0x77, 0x61, 0xa8,
+	0xb9, 0x31, 0x23, 0xd0, 0x42, 0x46, 0xc3, 0xa3, 0x5d, 0xff, 0x30, 0x6f, 0xbf, 0x29, 0x7f, 0x7c,
+	0xb6, 0x9d, 0xde, 0xfd, 0x62, 0x83, 0xdd, 0xe5, 0x06, 0xbb, 0x3f, 0x1b, 0xec, 0xbe, 0x6f, 0xb1,
+	0xb3, 0xdc, 0x62, 0xe7, 0x6b, 0x8b, 0x9d, 0xc7, 0x6e, 0x24, 0x60, 0x3c, 0xa5, 0x1e, 0x53, 0xb1,
+	0x9f, 0xdd, 0x96, 0x8d, 0x89, 0x90, 0x79, 0xe1, 0xbf, 0xed, 0x7d, 0x05, 0x98, 0x25, 0xdc, 0xd0,
+	0x4a, 0x7a, 0xc6, 0xeb, 0xbf, 0x01, 0x00, 0xe4, 0xa2, 0x42, 0x36, 0x2c, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +135,13 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DustRecipient) > 0 {
+		i -= len(m.DustRecipient)
+		copy(dAtA[i:], m.DustRecipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DustRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size := m.BtcStakingPortion.Size()
 		i -= size
@@ -177,6 +198,10 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.BtcStakingPortion.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = len(m.DustRecipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -317,6 +342,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
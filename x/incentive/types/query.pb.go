@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -389,6 +391,397 @@ func (m *QueryBTCTimestampingGaugeResponse) GetGauge() *Gauge {
 	return nil
 }
 
+// QueryWithdrawalSettingsRequest is request type for the Query/WithdrawalSettings RPC method.
+type QueryWithdrawalSettingsRequest struct {
+	// address is the address of the stakeholder in bech32 string
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryWithdrawalSettingsRequest) Reset()         { *m = QueryWithdrawalSettingsRequest{} }
+func (m *QueryWithdrawalSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalSettingsRequest) ProtoMessage()    {}
+func (*QueryWithdrawalSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{8}
+}
+func (m *QueryWithdrawalSettingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalSettingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWithdrawalSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalSettingsRequest.Merge(m, src)
+}
+func (m *QueryWithdrawalSettingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalSettingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWithdrawalSettingsRequest proto.InternalMessageInfo
+
+func (m *QueryWithdrawalSettingsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryWithdrawalSettingsResponse is response type for the Query/WithdrawalSettings RPC method.
+type QueryWithdrawalSettingsResponse struct {
+	// withdraw_address is the address receiving the withdrawn rewards of the
+	// stakeholder, which is the stakeholder address if not set
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	// auto_restake_validator_address is the address of the validator to
+	// restake the withdrawn rewards to. It is empty if auto-restaking is disabled
+	AutoRestakeValidatorAddress string `protobuf:"bytes,2,opt,name=auto_restake_validator_address,json=autoRestakeValidatorAddress,proto3" json:"auto_restake_validator_address,omitempty"`
+}
+
+func (m *QueryWithdrawalSettingsResponse) Reset()         { *m = QueryWithdrawalSettingsResponse{} }
+func (m *QueryWithdrawalSettingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalSettingsResponse) ProtoMessage()    {}
+func (*QueryWithdrawalSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{9}
+}
+func (m *QueryWithdrawalSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalSettingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWithdrawalSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalSettingsResponse.Merge(m, src)
+}
+func (m *QueryWithdrawalSettingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalSettingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWithdrawalSettingsResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawalSettingsResponse) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+func (m *QueryWithdrawalSettingsResponse) GetAutoRestakeValidatorAddress() string {
+	if m != nil {
+		return m.AutoRestakeValidatorAddress
+	}
+	return ""
+}
+
+// QueryBTCDelegationRewardsRequest is request type for the Query/BTCDelegationRewards RPC method.
+type QueryBTCDelegationRewardsRequest struct {
+	// staking_tx_hash_hex is the staking tx hash of the BTC delegation in hex string
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// start_height is the first height of the queried range (inclusive)
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height of the queried range (inclusive)
+	EndHeight uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *QueryBTCDelegationRewardsRequest) Reset()         { *m = QueryBTCDelegationRewardsRequest{} }
+func (m *QueryBTCDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationRewardsRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{10}
+}
+func (m *QueryBTCDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationRewardsRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationRewardsRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+func (m *QueryBTCDelegationRewardsRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryBTCDelegationRewardsRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// BTCDelegationRewardsEntry is the rewards accrued by a BTC delegation under
+// a finality provider
+type BTCDelegationRewardsEntry struct {
+	// fp_btc_pk_hex is the BTC PK of the finality provider in hex string
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// coins are the rewards accrued under the finality provider
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *BTCDelegationRewardsEntry) Reset()         { *m = BTCDelegationRewardsEntry{} }
+func (m *BTCDelegationRewardsEntry) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationRewardsEntry) ProtoMessage()    {}
+func (*BTCDelegationRewardsEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{11}
+}
+func (m *BTCDelegationRewardsEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegationRewardsEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationRewardsEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCDelegationRewardsEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationRewardsEntry.Merge(m, src)
+}
+func (m *BTCDelegationRewardsEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationRewardsEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationRewardsEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCDelegationRewardsEntry proto.InternalMessageInfo
+
+func (m *BTCDelegationRewardsEntry) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+func (m *BTCDelegationRewardsEntry) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+// QueryBTCDelegationRewardsResponse is response type for the Query/BTCDelegationRewards RPC method.
+type QueryBTCDelegationRewardsResponse struct {
+	// rewards are the rewards accrued by the BTC delegation under each of its
+	// finality providers over the queried range
+	Rewards []*BTCDelegationRewardsEntry `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (m *QueryBTCDelegationRewardsResponse) Reset()         { *m = QueryBTCDelegationRewardsResponse{} }
+func (m *QueryBTCDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationRewardsResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{12}
+}
+func (m *QueryBTCDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationRewardsResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationRewardsResponse) GetRewards() []*BTCDelegationRewardsEntry {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// QueryFinalityProviderRewardsRequest is request type for the Query/FinalityProviderRewards RPC method.
+type QueryFinalityProviderRewardsRequest struct {
+	// fp_btc_pk_hex is the BTC PK of the finality provider in hex string
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// start_height is the first height of the queried range (inclusive)
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height of the queried range (inclusive)
+	EndHeight uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *QueryFinalityProviderRewardsRequest) Reset()         { *m = QueryFinalityProviderRewardsRequest{} }
+func (m *QueryFinalityProviderRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderRewardsRequest) ProtoMessage()    {}
+func (*QueryFinalityProviderRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{13}
+}
+func (m *QueryFinalityProviderRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderRewardsRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderRewardsRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+func (m *QueryFinalityProviderRewardsRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryFinalityProviderRewardsRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// QueryFinalityProviderRewardsResponse is response type for the Query/FinalityProviderRewards RPC method.
+type QueryFinalityProviderRewardsResponse struct {
+	// commission is the commission accrued by the finality provider over the
+	// queried range
+	Commission github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=commission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commission"`
+	// btc_delegation_rewards are the rewards accrued by the BTC delegations
+	// of the finality provider over the queried range
+	BtcDelegationRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=btc_delegation_rewards,json=btcDelegationRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"btc_delegation_rewards"`
+}
+
+func (m *QueryFinalityProviderRewardsResponse) Reset()         { *m = QueryFinalityProviderRewardsResponse{} }
+func (m *QueryFinalityProviderRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderRewardsResponse) ProtoMessage()    {}
+func (*QueryFinalityProviderRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{14}
+}
+func (m *QueryFinalityProviderRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderRewardsResponse.Merge(m, src)
+}
+func (m *QueryFinalityProviderRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderRewardsResponse) GetCommission() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Commission
+	}
+	return nil
+}
+
+func (m *QueryFinalityProviderRewardsResponse) GetBtcDelegationRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BtcDelegationRewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.incentive.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.incentive.QueryParamsResponse")
@@ -399,51 +792,87 @@ func init() {
 	proto.RegisterType((*QueryBTCStakingGaugeResponse)(nil), "babylon.incentive.QueryBTCStakingGaugeResponse")
 	proto.RegisterType((*QueryBTCTimestampingGaugeRequest)(nil), "babylon.incentive.QueryBTCTimestampingGaugeRequest")
 	proto.RegisterType((*QueryBTCTimestampingGaugeResponse)(nil), "babylon.incentive.QueryBTCTimestampingGaugeResponse")
+	proto.RegisterType((*QueryWithdrawalSettingsRequest)(nil), "babylon.incentive.QueryWithdrawalSettingsRequest")
+	proto.RegisterType((*QueryWithdrawalSettingsResponse)(nil), "babylon.incentive.QueryWithdrawalSettingsResponse")
+	proto.RegisterType((*QueryBTCDelegationRewardsRequest)(nil), "babylon.incentive.QueryBTCDelegationRewardsRequest")
+	proto.RegisterType((*BTCDelegationRewardsEntry)(nil), "babylon.incentive.BTCDelegationRewardsEntry")
+	proto.RegisterType((*QueryBTCDelegationRewardsResponse)(nil), "babylon.incentive.QueryBTCDelegationRewardsResponse")
+	proto.RegisterType((*QueryFinalityProviderRewardsRequest)(nil), "babylon.incentive.QueryFinalityProviderRewardsRequest")
+	proto.RegisterType((*QueryFinalityProviderRewardsResponse)(nil), "babylon.incentive.QueryFinalityProviderRewardsResponse")
 }
 
 func init() { proto.RegisterFile("babylon/incentive/query.proto", fileDescriptor_e1a59cc0c7c44135) }
 
 var fileDescriptor_e1a59cc0c7c44135 = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0xe3, 0xb4, 0x09, 0xf4, 0x5a, 0x04, 0x3d, 0x22, 0xe4, 0x38, 0xc5, 0x24, 0x96, 0x40,
-	0x95, 0x00, 0x5b, 0xcd, 0x1f, 0x15, 0x90, 0x00, 0x29, 0x08, 0x31, 0x20, 0x45, 0xe0, 0x76, 0x62,
-	0x89, 0x2e, 0xce, 0xc9, 0xb1, 0x1a, 0xfb, 0x5c, 0xfb, 0x5c, 0x08, 0x55, 0x16, 0x3e, 0x01, 0x12,
-	0x13, 0x3b, 0x0b, 0xdf, 0x82, 0xb1, 0x63, 0x25, 0x16, 0x26, 0x04, 0x09, 0x1f, 0x04, 0xe5, 0xee,
-	0x1c, 0xb9, 0x8d, 0x5d, 0x5a, 0xb6, 0xf3, 0xfb, 0x3e, 0xef, 0xf3, 0xfe, 0x72, 0x7e, 0x1c, 0x70,
-	0xb3, 0x87, 0x7a, 0xa3, 0x21, 0xf1, 0x0c, 0xc7, 0xb3, 0xb0, 0x47, 0x9d, 0x03, 0x6c, 0xec, 0x47,
-	0x38, 0x18, 0xe9, 0x7e, 0x40, 0x28, 0x81, 0xeb, 0xa2, 0xad, 0xcf, 0xdb, 0x4a, 0xc9, 0x26, 0x36,
-	0x61, 0x5d, 0x63, 0x76, 0xe2, 0x42, 0x65, 0xc3, 0x26, 0xc4, 0x1e, 0x62, 0x03, 0xf9, 0x8e, 0x81,
-	0x3c, 0x8f, 0x50, 0x44, 0x1d, 0xe2, 0x85, 0xa2, 0xab, 0x2e, 0x6e, 0xf1, 0x51, 0x80, 0xdc, 0xb8,
-	0x5f, 0x5b, 0xec, 0xcf, 0x4f, 0x5c, 0xa2, 0x95, 0x00, 0x7c, 0x3d, 0x03, 0x7b, 0xc5, 0xe6, 0x4c,
-	0xbc, 0x1f, 0xe1, 0x90, 0x6a, 0x1d, 0x70, 0xfd, 0x44, 0x35, 0xf4, 0x89, 0x17, 0x62, 0xb8, 0x0d,
-	0x8a, 0xdc, 0x5f, 0x96, 0xaa, 0xd2, 0xe6, 0x6a, 0xbd, 0xac, 0x2f, 0xfc, 0x0e, 0x9d, 0x8f, 0xb4,
-	0x97, 0x8f, 0x7e, 0xde, 0xca, 0x99, 0x42, 0xae, 0x35, 0x81, 0xcc, 0xfc, 0x4c, 0xfc, 0x16, 0x05,
-	0xfd, 0x17, 0x28, 0xb2, 0x71, 0xbc, 0x0b, 0xca, 0xe0, 0x12, 0xea, 0xf7, 0x03, 0x1c, 0x72, 0xd7,
-	0x15, 0x33, 0x7e, 0xd4, 0x7e, 0x4b, 0xa0, 0x9c, 0x32, 0x26, 0x60, 0x2c, 0x70, 0x25, 0x60, 0xf5,
-	0xae, 0xcd, 0x1a, 0xb2, 0x54, 0x5d, 0xda, 0x5c, 0xad, 0x3f, 0x49, 0x61, 0xca, 0x34, 0xd1, 0x93,
-	0xc5, 0xe7, 0x1e, 0x0d, 0x46, 0xe6, 0x5a, 0x90, 0x28, 0x29, 0x5d, 0xb0, 0xbe, 0x20, 0x81, 0xd7,
-	0xc0, 0xd2, 0x1e, 0x1e, 0x09, 0xda, 0xd9, 0x11, 0x36, 0x41, 0xe1, 0x00, 0x0d, 0x23, 0x2c, 0xe7,
-	0xd9, 0xbd, 0xa8, 0x29, 0x0c, 0x09, 0x1b, 0x93, 0x8b, 0x1f, 0xe5, 0x1f, 0x48, 0x5a, 0x0b, 0x54,
-	0x18, 0x5d, 0x7b, 0xf7, 0xd9, 0x0e, 0x45, 0x7b, 0x8e, 0x67, 0x73, 0x89, 0xb8, 0x9c, 0x1b, 0xa0,
-	0x38, 0xc0, 0x8e, 0x3d, 0xa0, 0x6c, 0xdb, 0xb2, 0x29, 0x9e, 0xb4, 0x0e, 0xd8, 0x48, 0x1f, 0x13,
-	0x97, 0xa3, 0x83, 0x02, 0xbb, 0x15, 0xf1, 0xa2, 0xe4, 0x14, 0x20, 0x81, 0xc2, 0x64, 0xda, 0x53,
-	0x50, 0x8d, 0xfd, 0x76, 0x1d, 0x17, 0x87, 0x14, 0xb9, 0xfe, 0x69, 0x96, 0x0a, 0x58, 0xc1, 0x3e,
-	0xb1, 0x06, 0x5d, 0x2f, 0x72, 0x05, 0xce, 0x65, 0x56, 0xe8, 0x44, 0xae, 0xb6, 0x03, 0x6a, 0x67,
-	0x18, 0xfc, 0x1f, 0x55, 0xfd, 0x73, 0x01, 0x14, 0x98, 0x2b, 0x7c, 0x0f, 0x8a, 0x3c, 0x58, 0xf0,
-	0x76, 0xd6, 0xfb, 0x3d, 0x91, 0x60, 0xe5, 0xce, 0xbf, 0x64, 0x1c, 0x49, 0xab, 0x7d, 0xf8, 0xfe,
-	0xe7, 0x53, 0xbe, 0x02, 0xcb, 0x46, 0xd6, 0xb7, 0x04, 0xbf, 0x48, 0x60, 0x2d, 0x19, 0x02, 0x78,
-	0xf7, 0x7c, 0x11, 0xe3, 0x20, 0xf7, 0x2e, 0x92, 0x47, 0xed, 0x21, 0xc3, 0x69, 0xc0, 0xad, 0x14,
-	0x1c, 0xf1, 0x59, 0x18, 0x87, 0xe2, 0x30, 0x36, 0x92, 0xf9, 0x87, 0x5f, 0x25, 0x70, 0xf5, 0x54,
-	0x1c, 0xa0, 0x9e, 0xb5, 0x3c, 0x3d, 0x6e, 0x8a, 0x71, 0x6e, 0xbd, 0xe0, 0x6d, 0x31, 0x5e, 0x03,
-	0xde, 0x4f, 0xe1, 0xed, 0x51, 0xab, 0x1b, 0xf2, 0x21, 0x8e, 0x68, 0x1c, 0xf2, 0xf4, 0x8e, 0xe1,
-	0x37, 0x09, 0x94, 0xd2, 0x92, 0x02, 0x1b, 0x67, 0x00, 0x64, 0x05, 0x53, 0x69, 0x5e, 0x6c, 0x48,
-	0xa0, 0x3f, 0x66, 0xe8, 0xdb, 0xb0, 0x95, 0x81, 0x4e, 0x13, 0x93, 0x31, 0xff, 0x3c, 0xff, 0xe3,
-	0xf6, 0xcb, 0xa3, 0x89, 0x2a, 0x1d, 0x4f, 0x54, 0xe9, 0xd7, 0x44, 0x95, 0x3e, 0x4e, 0xd5, 0xdc,
-	0xf1, 0x54, 0xcd, 0xfd, 0x98, 0xaa, 0xb9, 0x37, 0x5b, 0xb6, 0x43, 0x07, 0x51, 0x4f, 0xb7, 0x88,
-	0x1b, 0x5b, 0x5b, 0x03, 0xe4, 0x78, 0xf3, 0x3d, 0xef, 0x12, 0x9b, 0xe8, 0xc8, 0xc7, 0x61, 0xaf,
-	0xc8, 0xfe, 0x8c, 0x1b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x1f, 0x12, 0x0c, 0x37, 0x06,
-	0x00, 0x00,
+	// 1083 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0x4d, 0x4f, 0xdc, 0xc6,
+	0x1b, 0xc0, 0xf1, 0x12, 0xc8, 0x9f, 0x07, 0xa2, 0x90, 0x09, 0xca, 0x7f, 0x59, 0x92, 0x05, 0xdc,
+	0x17, 0x51, 0x35, 0xd8, 0xe5, 0xad, 0xa4, 0x48, 0x69, 0xd2, 0xa5, 0x49, 0x91, 0x2a, 0x21, 0x6a,
+	0x50, 0x2b, 0xf5, 0x62, 0xcd, 0xda, 0x83, 0x6d, 0xed, 0xae, 0xc7, 0xf1, 0xcc, 0x02, 0x5b, 0xc4,
+	0xa1, 0x3d, 0xf6, 0xd4, 0x2a, 0x87, 0x9e, 0x2b, 0xf5, 0xd2, 0x7e, 0x87, 0x4a, 0x3d, 0x46, 0xea,
+	0x25, 0x55, 0x2f, 0x3d, 0xf5, 0x05, 0xfa, 0x2d, 0x7a, 0xa9, 0x3c, 0x33, 0x5e, 0x0c, 0x6b, 0x53,
+	0xb6, 0x2f, 0x27, 0xbc, 0xf3, 0xbc, 0xcc, 0xef, 0x79, 0x99, 0xe7, 0x11, 0x70, 0xa7, 0x8e, 0xeb,
+	0x9d, 0x26, 0x0d, 0xcd, 0x20, 0x74, 0x48, 0xc8, 0x83, 0x3d, 0x62, 0x3e, 0x69, 0x93, 0xb8, 0x63,
+	0x44, 0x31, 0xe5, 0x14, 0xdd, 0x50, 0x62, 0xa3, 0x2b, 0xae, 0x4c, 0x78, 0xd4, 0xa3, 0x42, 0x6a,
+	0x26, 0x5f, 0x52, 0xb1, 0x72, 0xdb, 0xa3, 0xd4, 0x6b, 0x12, 0x13, 0x47, 0x81, 0x89, 0xc3, 0x90,
+	0x72, 0xcc, 0x03, 0x1a, 0x32, 0x25, 0xad, 0x3a, 0x94, 0xb5, 0x28, 0x33, 0xeb, 0x98, 0x11, 0x73,
+	0x6f, 0xa1, 0x4e, 0x38, 0x5e, 0x30, 0x1d, 0x1a, 0x84, 0xa9, 0xbc, 0x97, 0x22, 0xc2, 0x31, 0x6e,
+	0xa5, 0xf6, 0xb3, 0xbd, 0xf2, 0xee, 0x97, 0x54, 0xd1, 0x27, 0x00, 0xbd, 0x97, 0x80, 0x6f, 0x09,
+	0x3b, 0x8b, 0x3c, 0x69, 0x13, 0xc6, 0xf5, 0x4d, 0xb8, 0x79, 0xe6, 0x94, 0x45, 0x34, 0x64, 0x04,
+	0xad, 0xc2, 0xb0, 0xf4, 0x5f, 0xd6, 0x66, 0xb4, 0xb9, 0xd1, 0xc5, 0x49, 0xa3, 0x27, 0x4e, 0x43,
+	0x9a, 0xd4, 0xae, 0x3c, 0xfb, 0x79, 0x7a, 0xc0, 0x52, 0xea, 0xfa, 0x32, 0x94, 0x85, 0x3f, 0x8b,
+	0xec, 0xe3, 0xd8, 0x7d, 0x07, 0xb7, 0x3d, 0x92, 0xde, 0x85, 0xca, 0x70, 0x15, 0xbb, 0x6e, 0x4c,
+	0x98, 0xf4, 0x3a, 0x62, 0xa5, 0x3f, 0xf5, 0xdf, 0x34, 0x98, 0xcc, 0x31, 0x53, 0x30, 0x0e, 0x5c,
+	0x8b, 0xc5, 0xb9, 0xed, 0x09, 0x41, 0x59, 0x9b, 0x19, 0x9c, 0x1b, 0x5d, 0x7c, 0x33, 0x87, 0xa9,
+	0xd0, 0x89, 0x91, 0x3d, 0x7c, 0x14, 0xf2, 0xb8, 0x63, 0x8d, 0xc5, 0x99, 0xa3, 0x8a, 0x0d, 0x37,
+	0x7a, 0x54, 0xd0, 0x38, 0x0c, 0x36, 0x48, 0x47, 0xd1, 0x26, 0x9f, 0x68, 0x19, 0x86, 0xf6, 0x70,
+	0xb3, 0x4d, 0xca, 0x25, 0x91, 0x97, 0x6a, 0x0e, 0x43, 0xc6, 0x8d, 0x25, 0x95, 0xd7, 0x4a, 0xf7,
+	0x34, 0x7d, 0x05, 0xa6, 0x04, 0x5d, 0x6d, 0x67, 0x7d, 0x9b, 0xe3, 0x46, 0x10, 0x7a, 0x52, 0x45,
+	0x25, 0xe7, 0x16, 0x0c, 0xfb, 0x24, 0xf0, 0x7c, 0x2e, 0x6e, 0xbb, 0x62, 0xa9, 0x5f, 0xfa, 0x26,
+	0xdc, 0xce, 0x37, 0x53, 0xc9, 0x31, 0x60, 0x48, 0x64, 0x45, 0x15, 0xaa, 0x9c, 0x03, 0xa4, 0x50,
+	0x84, 0x9a, 0xfe, 0x00, 0x66, 0x52, 0x7f, 0x3b, 0x41, 0x8b, 0x30, 0x8e, 0x5b, 0xd1, 0x79, 0x96,
+	0x29, 0x18, 0x21, 0x11, 0x75, 0x7c, 0x3b, 0x6c, 0xb7, 0x14, 0xce, 0xff, 0xc4, 0xc1, 0x66, 0xbb,
+	0xa5, 0x6f, 0xc3, 0xec, 0x05, 0x0e, 0xfe, 0x26, 0xd5, 0x1a, 0x54, 0x85, 0xd3, 0x0f, 0x02, 0xee,
+	0xbb, 0x31, 0xde, 0xc7, 0xcd, 0x6d, 0xc2, 0x79, 0x10, 0x7a, 0x97, 0x68, 0x9e, 0xcf, 0x35, 0x98,
+	0x2e, 0x34, 0x56, 0x3c, 0xaf, 0xc0, 0xf8, 0xbe, 0x92, 0xda, 0x67, 0xdd, 0x5c, 0x4f, 0xcf, 0xdf,
+	0x92, 0xc7, 0x68, 0x1d, 0xaa, 0xb8, 0xcd, 0xa9, 0x1d, 0x27, 0xa1, 0x35, 0x88, 0xbd, 0x87, 0x9b,
+	0x81, 0x8b, 0x39, 0x8d, 0xbb, 0x86, 0x25, 0x61, 0x38, 0x95, 0x68, 0x59, 0x52, 0xe9, 0xfd, 0x54,
+	0x47, 0x39, 0xd1, 0x9f, 0x6a, 0xa7, 0x69, 0x7e, 0x9b, 0x34, 0x89, 0x27, 0x5e, 0xbb, 0xec, 0x8c,
+	0x6e, 0x48, 0xf3, 0x70, 0x93, 0xc9, 0x92, 0xda, 0xfc, 0xc0, 0xf6, 0x31, 0xf3, 0x6d, 0x9f, 0x1c,
+	0x28, 0xae, 0x71, 0x25, 0xda, 0x39, 0xd8, 0xc0, 0xcc, 0xdf, 0x20, 0x07, 0x68, 0x16, 0xc6, 0x18,
+	0xc7, 0x31, 0xb7, 0x55, 0x9f, 0x94, 0x44, 0x61, 0x46, 0xc5, 0xd9, 0x86, 0x38, 0x42, 0x77, 0x00,
+	0x48, 0xe8, 0xa6, 0x0a, 0x83, 0x42, 0x61, 0x84, 0x84, 0xae, 0x14, 0xeb, 0x5f, 0x6a, 0x30, 0x99,
+	0x07, 0x24, 0x9b, 0x7d, 0x16, 0xae, 0xed, 0x46, 0x76, 0x9d, 0x3b, 0x76, 0xd4, 0xc8, 0x80, 0xc0,
+	0x6e, 0x54, 0xe3, 0xce, 0x56, 0x23, 0x41, 0xc0, 0x30, 0x94, 0x0c, 0xa5, 0x24, 0x05, 0x83, 0x62,
+	0x2a, 0xc8, 0xb1, 0x65, 0x24, 0x63, 0xcb, 0x50, 0x63, 0xcb, 0x58, 0xa7, 0x41, 0x58, 0x7b, 0x2d,
+	0x99, 0x0a, 0xdf, 0xfc, 0x32, 0x3d, 0xe7, 0x05, 0xdc, 0x6f, 0xd7, 0x0d, 0x87, 0xb6, 0x4c, 0x35,
+	0xe3, 0xe4, 0x9f, 0x79, 0xe6, 0x36, 0x4c, 0xde, 0x89, 0x08, 0x13, 0x06, 0xcc, 0x92, 0x9e, 0xf5,
+	0xc6, 0x69, 0x7b, 0xe5, 0x24, 0x4e, 0x95, 0xf3, 0x31, 0x5c, 0x95, 0x8f, 0x37, 0x9d, 0x05, 0x77,
+	0x73, 0x1a, 0xac, 0x30, 0x52, 0x2b, 0x35, 0xd6, 0x3f, 0xd5, 0xe0, 0x05, 0x71, 0xdb, 0xe3, 0x20,
+	0xc4, 0xcd, 0x80, 0x77, 0xb6, 0x62, 0xba, 0x17, 0xb8, 0x24, 0x3e, 0x57, 0xa9, 0x4b, 0xa4, 0xe6,
+	0x9f, 0x57, 0xe7, 0x8b, 0x12, 0xbc, 0x78, 0x31, 0x8c, 0x8a, 0xbe, 0x01, 0xe0, 0xd0, 0x56, 0x2b,
+	0x60, 0x2c, 0xa0, 0x61, 0x59, 0xfb, 0xf7, 0x4b, 0x91, 0x71, 0x8f, 0x3e, 0xd6, 0xe0, 0x56, 0x12,
+	0xb8, 0xdb, 0x4d, 0xa5, 0x9d, 0xa6, 0xfe, 0x3f, 0x68, 0x82, 0x89, 0x3a, 0x77, 0x7a, 0x8a, 0xb6,
+	0xf8, 0xc7, 0x08, 0x0c, 0x89, 0xcc, 0xa0, 0x8f, 0x60, 0x58, 0xae, 0x1d, 0xf4, 0x52, 0xd1, 0xf4,
+	0x3f, 0xb3, 0xdf, 0x2a, 0x2f, 0xff, 0x95, 0x9a, 0xcc, 0xa9, 0x3e, 0xfb, 0xc9, 0x8f, 0xbf, 0x3f,
+	0x2d, 0x4d, 0xa1, 0x49, 0xb3, 0x68, 0xd3, 0xa2, 0xaf, 0x34, 0x18, 0xcb, 0xae, 0x08, 0xf4, 0xea,
+	0xe5, 0x16, 0x90, 0x04, 0xb9, 0xdb, 0xcf, 0xb6, 0xd2, 0xdf, 0x10, 0x38, 0x4b, 0x68, 0x21, 0x07,
+	0x47, 0x8d, 0x21, 0xf3, 0x50, 0x7d, 0x1c, 0x99, 0xd9, 0xed, 0x88, 0xbe, 0xd6, 0xe0, 0xfa, 0xb9,
+	0x65, 0x81, 0x8c, 0xa2, 0xcb, 0xf3, 0x97, 0x51, 0xc5, 0xbc, 0xb4, 0xbe, 0xe2, 0x5d, 0x11, 0xbc,
+	0x26, 0x9a, 0xcf, 0xe1, 0x4d, 0xba, 0x27, 0x9d, 0x73, 0x02, 0xd1, 0x3c, 0x94, 0x4f, 0xe0, 0x08,
+	0x7d, 0xa7, 0xc1, 0x44, 0xde, 0x1e, 0x41, 0x4b, 0x17, 0x00, 0x14, 0xad, 0xad, 0xca, 0x72, 0x7f,
+	0x46, 0x0a, 0xfd, 0xbe, 0x40, 0x5f, 0x45, 0x2b, 0x05, 0xe8, 0x3c, 0x63, 0x99, 0xf2, 0x77, 0xb7,
+	0xe3, 0x11, 0xfa, 0x56, 0x03, 0xd4, 0xbb, 0x78, 0xd0, 0x42, 0x11, 0x4b, 0xe1, 0x86, 0xab, 0x2c,
+	0xf6, 0x63, 0xa2, 0xe0, 0x1f, 0x0a, 0xf8, 0x35, 0x74, 0xef, 0x52, 0x7d, 0xb2, 0xdf, 0x75, 0x64,
+	0xb3, 0x14, 0xf4, 0x7b, 0x59, 0x82, 0x9e, 0x47, 0x77, 0x61, 0x09, 0x8a, 0x56, 0x5a, 0x65, 0xb9,
+	0x3f, 0x23, 0x15, 0xc5, 0x86, 0x88, 0xa2, 0x86, 0x1e, 0x16, 0x94, 0xe0, 0x74, 0xf6, 0x30, 0xf3,
+	0x30, 0x67, 0x65, 0xa6, 0x2f, 0x80, 0xa1, 0x1f, 0x34, 0xf8, 0x7f, 0xc1, 0xf8, 0x44, 0xaf, 0x17,
+	0xb1, 0x5d, 0x3c, 0xfc, 0x2b, 0xab, 0x7d, 0xdb, 0xa9, 0xb0, 0x1e, 0x89, 0xb0, 0x1e, 0xa0, 0xfb,
+	0x39, 0x61, 0xed, 0x2a, 0x5b, 0x3b, 0x52, 0xc6, 0xcc, 0x3c, 0x3c, 0xb3, 0x62, 0xba, 0x31, 0xd5,
+	0xde, 0x7d, 0x76, 0x5c, 0xd5, 0x9e, 0x1f, 0x57, 0xb5, 0x5f, 0x8f, 0xab, 0xda, 0x67, 0x27, 0xd5,
+	0x81, 0xe7, 0x27, 0xd5, 0x81, 0x9f, 0x4e, 0xaa, 0x03, 0x1f, 0x2e, 0x64, 0xe6, 0xaa, 0xba, 0xc2,
+	0xf1, 0x71, 0x10, 0x76, 0xef, 0x3b, 0xc8, 0xdc, 0x28, 0xc6, 0x6c, 0x7d, 0x58, 0xfc, 0x33, 0xb0,
+	0xf4, 0xe7, 0x00, 0x9a, 0xd7, 0xd8, 0xec, 0xd7, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -466,6 +895,15 @@ type QueryClient interface {
 	BTCStakingGauge(ctx context.Context, in *QueryBTCStakingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCStakingGaugeResponse, error)
 	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
 	BTCTimestampingGauge(ctx context.Context, in *QueryBTCTimestampingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCTimestampingGaugeResponse, error)
+	// WithdrawalSettings queries the withdraw address and the auto-restaking
+	// validator of a given stakeholder address
+	WithdrawalSettings(ctx context.Context, in *QueryWithdrawalSettingsRequest, opts ...grpc.CallOption) (*QueryWithdrawalSettingsResponse, error)
+	// BTCDelegationRewards queries the rewards accrued by a given BTC
+	// delegation under each of its finality providers over a height range
+	BTCDelegationRewards(ctx context.Context, in *QueryBTCDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationRewardsResponse, error)
+	// FinalityProviderRewards queries the rewards accrued by a given finality
+	// provider and its BTC delegations over a height range
+	FinalityProviderRewards(ctx context.Context, in *QueryFinalityProviderRewardsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderRewardsResponse, error)
 }
 
 type queryClient struct {
@@ -512,6 +950,33 @@ func (c *queryClient) BTCTimestampingGauge(ctx context.Context, in *QueryBTCTime
 	return out, nil
 }
 
+func (c *queryClient) WithdrawalSettings(ctx context.Context, in *QueryWithdrawalSettingsRequest, opts ...grpc.CallOption) (*QueryWithdrawalSettingsResponse, error) {
+	out := new(QueryWithdrawalSettingsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/WithdrawalSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationRewards(ctx context.Context, in *QueryBTCDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationRewardsResponse, error) {
+	out := new(QueryBTCDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/BTCDelegationRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderRewards(ctx context.Context, in *QueryFinalityProviderRewardsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderRewardsResponse, error) {
+	out := new(QueryFinalityProviderRewardsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/FinalityProviderRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -522,6 +987,15 @@ type QueryServer interface {
 	BTCStakingGauge(context.Context, *QueryBTCStakingGaugeRequest) (*QueryBTCStakingGaugeResponse, error)
 	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
 	BTCTimestampingGauge(context.Context, *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error)
+	// WithdrawalSettings queries the withdraw address and the auto-restaking
+	// validator of a given stakeholder address
+	WithdrawalSettings(context.Context, *QueryWithdrawalSettingsRequest) (*QueryWithdrawalSettingsResponse, error)
+	// BTCDelegationRewards queries the rewards accrued by a given BTC
+	// delegation under each of its finality providers over a height range
+	BTCDelegationRewards(context.Context, *QueryBTCDelegationRewardsRequest) (*QueryBTCDelegationRewardsResponse, error)
+	// FinalityProviderRewards queries the rewards accrued by a given finality
+	// provider and its BTC delegations over a height range
+	FinalityProviderRewards(context.Context, *QueryFinalityProviderRewardsRequest) (*QueryFinalityProviderRewardsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -540,6 +1014,15 @@ func (*UnimplementedQueryServer) BTCStakingGauge(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) BTCTimestampingGauge(ctx context.Context, req *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BTCTimestampingGauge not implemented")
 }
+func (*UnimplementedQueryServer) WithdrawalSettings(ctx context.Context, req *QueryWithdrawalSettingsRequest) (*QueryWithdrawalSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalSettings not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationRewards(ctx context.Context, req *QueryBTCDelegationRewardsRequest) (*QueryBTCDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationRewards not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderRewards(ctx context.Context, req *QueryFinalityProviderRewardsRequest) (*QueryFinalityProviderRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderRewards not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -617,26 +1100,92 @@ func _Query_BTCTimestampingGauge_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.incentive.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "RewardGauges",
-			Handler:    _Query_RewardGauges_Handler,
-		},
-		{
-			MethodName: "BTCStakingGauge",
-			Handler:    _Query_BTCStakingGauge_Handler,
+func _Query_WithdrawalSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/WithdrawalSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalSettings(ctx, req.(*QueryWithdrawalSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/BTCDelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationRewards(ctx, req.(*QueryBTCDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/FinalityProviderRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderRewards(ctx, req.(*QueryFinalityProviderRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.incentive.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RewardGauges",
+			Handler:    _Query_RewardGauges_Handler,
+		},
+		{
+			MethodName: "BTCStakingGauge",
+			Handler:    _Query_BTCStakingGauge_Handler,
 		},
 		{
 			MethodName: "BTCTimestampingGauge",
 			Handler:    _Query_BTCTimestampingGauge_Handler,
 		},
+		{
+			MethodName: "WithdrawalSettings",
+			Handler:    _Query_WithdrawalSettings_Handler,
+		},
+		{
+			MethodName: "BTCDelegationRewards",
+			Handler:    _Query_BTCDelegationRewards_Handler,
+		},
+		{
+			MethodName: "FinalityProviderRewards",
+			Handler:    _Query_FinalityProviderRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/incentive/query.proto",
@@ -903,6 +1452,285 @@ func (m *QueryBTCTimestampingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryWithdrawalSettingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalSettingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalSettingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalSettingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalSettingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalSettingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AutoRestakeValidatorAddress) > 0 {
+		i -= len(m.AutoRestakeValidatorAddress)
+		copy(dAtA[i:], m.AutoRestakeValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AutoRestakeValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegationRewardsEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationRewardsEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationRewardsEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BtcDelegationRewards) > 0 {
+		for iNdEx := len(m.BtcDelegationRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegationRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Commission) > 0 {
+		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -962,70 +1790,742 @@ func (m *QueryRewardGaugesResponse) Size() (n int) {
 				l = v.Size()
 				l += 1 + sovQuery(uint64(l))
 			}
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryBTCStakingGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBTCStakingGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCTimestampingGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryBTCTimestampingGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalSettingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalSettingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AutoRestakeValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *BTCDelegationRewardsEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BtcDelegationRewards) > 0 {
+		for _, e := range m.BtcDelegationRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardGaugesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardGaugesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardGaugesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardGauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardGauges == nil {
+				m.RewardGauges = make(map[string]*RewardGauge)
+			}
+			var mapkey string
+			var mapvalue *RewardGauge
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RewardGauge{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RewardGauges[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCStakingGaugeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCStakingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCStakingGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCStakingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryBTCStakingGaugeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	return n
-}
-
-func (m *QueryBTCStakingGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Gauge != nil {
-		l = m.Gauge.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryBTCTimestampingGaugeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNum))
-	}
-	return n
-}
 
-func (m *QueryBTCTimestampingGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Gauge != nil {
-		l = m.Gauge.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1048,12 +2548,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCTimestampingGaugeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCTimestampingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1075,7 +2594,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1098,15 +2617,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCTimestampingGaugeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCTimestampingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1133,7 +2652,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1158,7 +2680,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardGaugesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawalSettingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1181,10 +2703,10 @@ func (m *QueryRewardGaugesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardGaugesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawalSettingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawalSettingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1240,7 +2762,7 @@ func (m *QueryRewardGaugesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawalSettingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1263,17 +2785,17 @@ func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardGaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawalSettingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawalSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardGauges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1283,120 +2805,55 @@ func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RewardGauges == nil {
-				m.RewardGauges = make(map[string]*RewardGauge)
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeValidatorAddress", wireType)
 			}
-			var mapkey string
-			var mapvalue *RewardGauge
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &RewardGauge{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.RewardGauges[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRestakeValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1419,7 +2876,7 @@ func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1442,17 +2899,49 @@ func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCStakingGaugeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCStakingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.Height = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1462,7 +2951,26 @@ func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1488,7 +2996,7 @@ func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
+func (m *BTCDelegationRewardsEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1511,15 +3019,47 @@ func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCStakingGaugeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BTCDelegationRewardsEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCStakingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BTCDelegationRewardsEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1546,10 +3086,92 @@ func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gauge == nil {
-				m.Gauge = &Gauge{}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &BTCDelegationRewardsEntry{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1574,7 +3196,7 @@ func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1597,17 +3219,49 @@ func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCTimestampingGaugeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCTimestampingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.EpochNum = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1617,7 +3271,26 @@ func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1643,7 +3316,7 @@ func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1666,15 +3339,15 @@ func (m *QueryBTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCTimestampingGaugeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCTimestampingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1701,10 +3374,42 @@ func (m *QueryBTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gauge == nil {
-				m.Gauge = &Gauge{}
+			m.Commission = append(m.Commission, types.Coin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegationRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegationRewards = append(m.BtcDelegationRewards, types.Coin{})
+			if err := m.BtcDelegationRewards[len(m.BtcDelegationRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
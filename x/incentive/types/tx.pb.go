@@ -92,6 +92,9 @@ func (m *MsgWithdrawReward) GetAddress() string {
 type MsgWithdrawRewardResponse struct {
 	// coins is the withdrawed coins
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// restaked_coins is the part of the withdrawed coins that is restaked
+	// to the validator specified via MsgSetAutoRestake
+	RestakedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=restaked_coins,json=restakedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"restaked_coins"`
 }
 
 func (m *MsgWithdrawRewardResponse) Reset()         { *m = MsgWithdrawRewardResponse{} }
@@ -134,6 +137,205 @@ func (m *MsgWithdrawRewardResponse) GetCoins() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+func (m *MsgWithdrawRewardResponse) GetRestakedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RestakedCoins
+	}
+	return nil
+}
+
+// MsgSetWithdrawAddress defines a message for setting the address receiving
+// the withdrawn rewards of a stakeholder
+type MsgSetWithdrawAddress struct {
+	// address is the address of the stakeholder in bech32 string
+	// signer of this msg has to be this address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// withdraw_address is the address receiving the withdrawn rewards of the
+	// stakeholder in bech32 string
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *MsgSetWithdrawAddress) Reset()         { *m = MsgSetWithdrawAddress{} }
+func (m *MsgSetWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4de6776d39a3a22, []int{2}
+}
+func (m *MsgSetWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawAddress.Merge(m, src)
+}
+func (m *MsgSetWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawAddress proto.InternalMessageInfo
+
+func (m *MsgSetWithdrawAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgSetWithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+// MsgSetWithdrawAddressResponse is the response to the MsgSetWithdrawAddress message
+type MsgSetWithdrawAddressResponse struct {
+}
+
+func (m *MsgSetWithdrawAddressResponse) Reset()         { *m = MsgSetWithdrawAddressResponse{} }
+func (m *MsgSetWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4de6776d39a3a22, []int{3}
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawAddressResponse.Merge(m, src)
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawAddressResponse proto.InternalMessageInfo
+
+// MsgSetAutoRestake defines a message for enabling or disabling auto-restaking
+// the withdrawn rewards of a stakeholder. Upon withdrawal, the withdrawn
+// rewards in the bond denom are delegated from the stakeholder address to the
+// given validator via x/epoching, while the rest goes to the withdraw address
+type MsgSetAutoRestake struct {
+	// address is the address of the stakeholder in bech32 string
+	// signer of this msg has to be this address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// validator_address is the address of the validator to restake to in
+	// bech32 string. Empty validator_address disables auto-restaking
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *MsgSetAutoRestake) Reset()         { *m = MsgSetAutoRestake{} }
+func (m *MsgSetAutoRestake) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRestake) ProtoMessage()    {}
+func (*MsgSetAutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4de6776d39a3a22, []int{4}
+}
+func (m *MsgSetAutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRestake.Merge(m, src)
+}
+func (m *MsgSetAutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRestake proto.InternalMessageInfo
+
+func (m *MsgSetAutoRestake) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgSetAutoRestake) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// MsgSetAutoRestakeResponse is the response to the MsgSetAutoRestake message
+type MsgSetAutoRestakeResponse struct {
+}
+
+func (m *MsgSetAutoRestakeResponse) Reset()         { *m = MsgSetAutoRestakeResponse{} }
+func (m *MsgSetAutoRestakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRestakeResponse) ProtoMessage()    {}
+func (*MsgSetAutoRestakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4de6776d39a3a22, []int{5}
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRestakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRestakeResponse.Merge(m, src)
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRestakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRestakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRestakeResponse proto.InternalMessageInfo
+
 // MsgUpdateParams defines a message for updating incentive module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -151,7 +353,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4de6776d39a3a22, []int{2}
+	return fileDescriptor_b4de6776d39a3a22, []int{6}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,7 +404,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4de6776d39a3a22, []int{3}
+	return fileDescriptor_b4de6776d39a3a22, []int{7}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,6 +436,10 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MsgWithdrawReward)(nil), "babylon.incentive.MsgWithdrawReward")
 	proto.RegisterType((*MsgWithdrawRewardResponse)(nil), "babylon.incentive.MsgWithdrawRewardResponse")
+	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "babylon.incentive.MsgSetWithdrawAddress")
+	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "babylon.incentive.MsgSetWithdrawAddressResponse")
+	proto.RegisterType((*MsgSetAutoRestake)(nil), "babylon.incentive.MsgSetAutoRestake")
+	proto.RegisterType((*MsgSetAutoRestakeResponse)(nil), "babylon.incentive.MsgSetAutoRestakeResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.incentive.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.incentive.MsgUpdateParamsResponse")
 }
@@ -241,37 +447,45 @@ func init() {
 func init() { proto.RegisterFile("babylon/incentive/tx.proto", fileDescriptor_b4de6776d39a3a22) }
 
 var fileDescriptor_b4de6776d39a3a22 = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6b, 0xd4, 0x40,
-	0x1c, 0xdd, 0xb1, 0x7f, 0xa4, 0x63, 0xa9, 0x74, 0x28, 0x34, 0x9b, 0x43, 0x5a, 0x82, 0x87, 0x65,
-	0xb1, 0x19, 0xb7, 0x82, 0x42, 0x6f, 0xc6, 0xa3, 0x2c, 0x4a, 0x44, 0x04, 0x0f, 0xca, 0x24, 0x19,
-	0x26, 0x83, 0x66, 0x26, 0x64, 0xa6, 0xdb, 0xee, 0x45, 0xc4, 0x4f, 0x20, 0x7e, 0x0c, 0x4f, 0x3d,
-	0xf8, 0x21, 0x7a, 0x2c, 0x3d, 0x79, 0x52, 0xd9, 0x3d, 0xf4, 0x6b, 0xc8, 0x64, 0x26, 0xed, 0xda,
-	0x14, 0xf4, 0x34, 0xf3, 0xdb, 0xf7, 0xe6, 0xfd, 0xde, 0xef, 0xfd, 0x36, 0xd0, 0x4f, 0x49, 0x3a,
-	0xfd, 0x20, 0x05, 0xe6, 0x22, 0xa3, 0x42, 0xf3, 0x09, 0xc5, 0xfa, 0x38, 0xaa, 0x6a, 0xa9, 0x25,
-	0xda, 0x74, 0x58, 0x74, 0x89, 0xf9, 0x5b, 0x4c, 0x32, 0xd9, 0xa0, 0xd8, 0xdc, 0x2c, 0xd1, 0xef,
-	0x67, 0x52, 0x95, 0x52, 0xbd, 0xb3, 0x80, 0x2d, 0x1c, 0xb4, 0x6d, 0x2b, 0x5c, 0x2a, 0x86, 0x27,
-	0x23, 0x73, 0x38, 0x20, 0x70, 0x40, 0x4a, 0x14, 0xc5, 0x93, 0x51, 0x4a, 0x35, 0x19, 0xe1, 0x4c,
-	0x72, 0xd1, 0xe2, 0x5d, 0x63, 0x15, 0xa9, 0x49, 0xe9, 0x84, 0xc3, 0xe7, 0x70, 0x73, 0xac, 0xd8,
-	0x6b, 0xae, 0x8b, 0xbc, 0x26, 0x47, 0x09, 0x3d, 0x22, 0x75, 0x8e, 0x10, 0x5c, 0xd6, 0xd3, 0x8a,
-	0x7a, 0x60, 0x17, 0x0c, 0xd6, 0x92, 0xe6, 0x8e, 0x3c, 0x78, 0x9b, 0xe4, 0x79, 0x4d, 0x95, 0xf2,
-	0x6e, 0x35, 0x3f, 0xb7, 0xe5, 0xc1, 0xfa, 0xe7, 0x8b, 0x93, 0x61, 0x5b, 0x85, 0x1f, 0x61, 0xbf,
-	0x23, 0x98, 0x50, 0x55, 0x49, 0xa1, 0x28, 0x22, 0x70, 0xc5, 0x78, 0x53, 0x1e, 0xd8, 0x5d, 0x1a,
-	0xdc, 0xd9, 0xef, 0x47, 0x6e, 0x48, 0xe3, 0x3e, 0x72, 0xee, 0xa3, 0xa7, 0x92, 0x8b, 0xf8, 0xc1,
-	0xe9, 0xcf, 0x9d, 0xde, 0xb7, 0x5f, 0x3b, 0x03, 0xc6, 0x75, 0x71, 0x98, 0x46, 0x99, 0x2c, 0x5d,
-	0x22, 0xee, 0xd8, 0x53, 0xf9, 0x7b, 0x6c, 0x9c, 0xa9, 0xe6, 0x81, 0x4a, 0xac, 0x72, 0xf8, 0x15,
-	0xc0, 0xbb, 0x63, 0xc5, 0x5e, 0x55, 0x39, 0xd1, 0xf4, 0x45, 0x33, 0x2a, 0x7a, 0x04, 0xd7, 0xc8,
-	0xa1, 0x2e, 0x64, 0xcd, 0xf5, 0xd4, 0x0e, 0x15, 0x7b, 0xe7, 0xdf, 0xf7, 0xb6, 0x5c, 0xf7, 0x27,
-	0xd6, 0xfa, 0x4b, 0x5d, 0x73, 0xc1, 0x92, 0x2b, 0x2a, 0x7a, 0x0c, 0x57, 0x6d, 0x58, 0xcd, 0xc8,
-	0xc6, 0x6f, 0x67, 0x95, 0x91, 0x6d, 0x11, 0x2f, 0x1b, 0xbf, 0x89, 0xa3, 0x1f, 0x6c, 0x98, 0x48,
-	0xae, 0x84, 0xc2, 0x3e, 0xdc, 0xbe, 0xe6, 0xa9, 0x8d, 0x64, 0xff, 0x1c, 0xc0, 0xa5, 0xb1, 0x62,
-	0x28, 0x87, 0x1b, 0xd7, 0xb6, 0x70, 0xef, 0x86, 0x6e, 0x9d, 0x68, 0xfd, 0xfb, 0xff, 0xc3, 0xba,
-	0x5c, 0xc0, 0x5b, 0xb8, 0xfe, 0x57, 0x32, 0xe1, 0xcd, 0xaf, 0x17, 0x39, 0xfe, 0xf0, 0xdf, 0x9c,
-	0x56, 0xdf, 0x5f, 0xf9, 0x74, 0x71, 0x32, 0x04, 0xf1, 0xb3, 0xd3, 0x59, 0x00, 0xce, 0x66, 0x01,
-	0xf8, 0x3d, 0x0b, 0xc0, 0x97, 0x79, 0xd0, 0x3b, 0x9b, 0x07, 0xbd, 0x1f, 0xf3, 0xa0, 0xf7, 0x66,
-	0xb4, 0xb0, 0x4f, 0x27, 0x9b, 0x15, 0x84, 0x8b, 0xb6, 0xc0, 0xc7, 0x8b, 0x9f, 0x90, 0x59, 0x6f,
-	0xba, 0xda, 0xfc, 0x53, 0x1f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x73, 0x0d, 0x40, 0x64,
-	0x03, 0x00, 0x00,
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3d, 0x6f, 0xd3, 0x50,
+	0x14, 0x8d, 0xfb, 0x85, 0x7a, 0x29, 0x69, 0xfb, 0x54, 0xd4, 0xc4, 0x08, 0xb7, 0xb2, 0x3a, 0x84,
+	0xd0, 0xda, 0x4d, 0x91, 0x40, 0xea, 0xd6, 0x30, 0xa2, 0x08, 0xe4, 0x08, 0x21, 0x31, 0x10, 0x3d,
+	0xc7, 0x4f, 0x8e, 0x69, 0xe3, 0x67, 0xf9, 0xbd, 0x24, 0xcd, 0x86, 0xf8, 0x05, 0x88, 0x9f, 0xd1,
+	0xa9, 0x03, 0x3f, 0xa2, 0x63, 0xc5, 0xc4, 0x04, 0x28, 0x19, 0xba, 0xf3, 0x0b, 0x90, 0xfd, 0x9e,
+	0xdd, 0x24, 0x36, 0x90, 0x81, 0xc9, 0xbe, 0x3e, 0xc7, 0xe7, 0x1c, 0xdf, 0x77, 0xaf, 0x41, 0xb5,
+	0xb1, 0x3d, 0x3c, 0xa3, 0xbe, 0xe9, 0xf9, 0x6d, 0xe2, 0x73, 0xaf, 0x4f, 0x4c, 0x7e, 0x6e, 0x04,
+	0x21, 0xe5, 0x14, 0x6d, 0x4a, 0xcc, 0x48, 0x31, 0x75, 0xcb, 0xa5, 0x2e, 0x8d, 0x51, 0x33, 0xba,
+	0x13, 0x44, 0xb5, 0xdc, 0xa6, 0xac, 0x4b, 0x59, 0x4b, 0x00, 0xa2, 0x90, 0xd0, 0xb6, 0xa8, 0xcc,
+	0x2e, 0x73, 0xcd, 0x7e, 0x2d, 0xba, 0x48, 0x40, 0x93, 0x80, 0x8d, 0x19, 0x31, 0xfb, 0x35, 0x9b,
+	0x70, 0x5c, 0x33, 0xdb, 0xd4, 0xf3, 0x13, 0x3c, 0x1b, 0x2c, 0xc0, 0x21, 0xee, 0x4a, 0x61, 0xfd,
+	0x25, 0x6c, 0x36, 0x98, 0xfb, 0xc6, 0xe3, 0x1d, 0x27, 0xc4, 0x03, 0x8b, 0x0c, 0x70, 0xe8, 0x20,
+	0x04, 0x4b, 0x7c, 0x18, 0x90, 0x92, 0xb2, 0xab, 0x54, 0x56, 0xad, 0xf8, 0x1e, 0x95, 0xe0, 0x0e,
+	0x76, 0x9c, 0x90, 0x30, 0x56, 0x5a, 0x88, 0x1f, 0x27, 0xe5, 0xf1, 0xda, 0xc7, 0x9b, 0xcb, 0x6a,
+	0x52, 0xe9, 0xbf, 0x14, 0x28, 0x67, 0x14, 0x2d, 0xc2, 0x02, 0xea, 0x33, 0x82, 0x30, 0x2c, 0x47,
+	0xe1, 0x58, 0x49, 0xd9, 0x5d, 0xac, 0xdc, 0x3d, 0x2a, 0x1b, 0xf2, 0x2b, 0xa3, 0xf8, 0x86, 0x8c,
+	0x6f, 0x3c, 0xa7, 0x9e, 0x5f, 0x3f, 0xbc, 0xfa, 0xbe, 0x53, 0xb8, 0xf8, 0xb1, 0x53, 0x71, 0x3d,
+	0xde, 0xe9, 0xd9, 0x46, 0x9b, 0x76, 0x65, 0x4b, 0xe4, 0xe5, 0x80, 0x39, 0xa7, 0x66, 0x14, 0x8d,
+	0xc5, 0x2f, 0x30, 0x4b, 0x28, 0xa3, 0x10, 0x8a, 0x21, 0x61, 0x1c, 0x9f, 0x12, 0xa7, 0x25, 0xbc,
+	0x16, 0xfe, 0xbf, 0xd7, 0xbd, 0xc4, 0x22, 0x2e, 0xf5, 0xf7, 0x70, 0xbf, 0xc1, 0xdc, 0x26, 0xe1,
+	0xc9, 0x67, 0x9f, 0x88, 0x6e, 0x4c, 0x76, 0x4d, 0x99, 0xea, 0x1a, 0x7a, 0x04, 0x1b, 0x03, 0x49,
+	0x6e, 0x4d, 0x37, 0x76, 0x7d, 0x30, 0x2d, 0x32, 0xd3, 0xe0, 0x1d, 0x78, 0x98, 0xeb, 0x95, 0xf4,
+	0x58, 0xef, 0xc4, 0x47, 0xda, 0x24, 0xfc, 0xa4, 0xc7, 0xa9, 0x25, 0x72, 0xfe, 0x25, 0xc8, 0x63,
+	0xd8, 0xec, 0xe3, 0x33, 0xcf, 0xc1, 0x9c, 0x86, 0x33, 0x49, 0x36, 0x52, 0x20, 0x3f, 0xca, 0x03,
+	0x28, 0x67, 0x9c, 0xd2, 0x18, 0x9f, 0x15, 0x58, 0x6f, 0x30, 0xf7, 0x75, 0xe0, 0x60, 0x4e, 0x5e,
+	0xc5, 0x33, 0x87, 0x9e, 0xc2, 0x2a, 0xee, 0xf1, 0x0e, 0x0d, 0x3d, 0x3e, 0x14, 0x39, 0xea, 0xa5,
+	0xaf, 0x5f, 0x0e, 0xb6, 0xe4, 0xc9, 0x48, 0x97, 0x26, 0x0f, 0x3d, 0xdf, 0xb5, 0x6e, 0xa9, 0xe8,
+	0x19, 0xac, 0x88, 0xa9, 0x8d, 0x83, 0x45, 0x67, 0x99, 0xd9, 0x29, 0x43, 0x58, 0xd4, 0x97, 0xa2,
+	0xb3, 0xb4, 0x24, 0xfd, 0xb8, 0x18, 0xe5, 0xbd, 0x15, 0xd2, 0xcb, 0xb0, 0x3d, 0x93, 0x29, 0xc9,
+	0x7b, 0x74, 0xb1, 0x08, 0x8b, 0x0d, 0xe6, 0x22, 0x07, 0x8a, 0x33, 0xeb, 0xb0, 0x97, 0xe3, 0x96,
+	0x19, 0x71, 0x75, 0x7f, 0x1e, 0x56, 0xba, 0x08, 0xef, 0x60, 0x6d, 0xaa, 0x33, 0x7a, 0xfe, 0xdb,
+	0x93, 0x1c, 0xb5, 0xfa, 0x6f, 0x4e, 0xaa, 0x1f, 0x00, 0xca, 0x19, 0xc7, 0x4a, 0xbe, 0x42, 0x96,
+	0xa9, 0x1e, 0xce, 0xcb, 0x4c, 0x1d, 0x1d, 0x28, 0xce, 0xcc, 0xdc, 0xde, 0x1f, 0x35, 0x26, 0x58,
+	0xea, 0xfe, 0x3c, 0xac, 0xc4, 0x45, 0x5d, 0xfe, 0x70, 0x73, 0x59, 0x55, 0xea, 0x2f, 0xae, 0x46,
+	0x9a, 0x72, 0x3d, 0xd2, 0x94, 0x9f, 0x23, 0x4d, 0xf9, 0x34, 0xd6, 0x0a, 0xd7, 0x63, 0xad, 0xf0,
+	0x6d, 0xac, 0x15, 0xde, 0xd6, 0x26, 0x76, 0x58, 0x0a, 0xb7, 0x3b, 0xd8, 0xf3, 0x93, 0xc2, 0x3c,
+	0x9f, 0xfc, 0x47, 0x47, 0x2b, 0x6d, 0xaf, 0xc4, 0xbf, 0xc2, 0x27, 0xbf, 0x07, 0x00, 0xf8, 0x3c,
+	0x93, 0xa5, 0xc5, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -290,6 +504,12 @@ type MsgClient interface {
 	WithdrawReward(ctx context.Context, in *MsgWithdrawReward, opts ...grpc.CallOption) (*MsgWithdrawRewardResponse, error)
 	// UpdateParams updates the incentive module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetWithdrawAddress defines a method to set the address receiving the
+	// withdrawn rewards of a stakeholder
+	SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error)
+	// SetAutoRestake defines a method to enable or disable auto-restaking the
+	// withdrawn rewards of a stakeholder
+	SetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, opts ...grpc.CallOption) (*MsgSetAutoRestakeResponse, error)
 }
 
 type msgClient struct {
@@ -318,12 +538,36 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error) {
+	out := new(MsgSetWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Msg/SetWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, opts ...grpc.CallOption) (*MsgSetAutoRestakeResponse, error) {
+	out := new(MsgSetAutoRestakeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Msg/SetAutoRestake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// WithdrawReward defines a method to withdraw rewards of a stakeholder
 	WithdrawReward(context.Context, *MsgWithdrawReward) (*MsgWithdrawRewardResponse, error)
 	// UpdateParams updates the incentive module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetWithdrawAddress defines a method to set the address receiving the
+	// withdrawn rewards of a stakeholder
+	SetWithdrawAddress(context.Context, *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error)
+	// SetAutoRestake defines a method to enable or disable auto-restaking the
+	// withdrawn rewards of a stakeholder
+	SetAutoRestake(context.Context, *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -336,6 +580,12 @@ func (*UnimplementedMsgServer) WithdrawReward(ctx context.Context, req *MsgWithd
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetWithdrawAddress(ctx context.Context, req *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawAddress not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoRestake(ctx context.Context, req *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoRestake not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -377,6 +627,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Msg/SetWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, req.(*MsgSetWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoRestake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRestake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoRestake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Msg/SetAutoRestake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoRestake(ctx, req.(*MsgSetAutoRestake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.incentive.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -389,6 +675,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetWithdrawAddress",
+			Handler:    _Msg_SetWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "SetAutoRestake",
+			Handler:    _Msg_SetAutoRestake_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/incentive/tx.proto",
@@ -451,6 +745,20 @@ func (m *MsgWithdrawRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.RestakedCoins) > 0 {
+		for iNdEx := len(m.RestakedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RestakedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -468,7 +776,7 @@ func (m *MsgWithdrawRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetWithdrawAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -478,37 +786,34 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -518,12 +823,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -531,52 +836,233 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetAutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgWithdrawReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgSetAutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetAutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAutoRestakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoRestakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRestakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgWithdrawReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RestakedCoins) > 0 {
+		for _, e := range m.RestakedCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAutoRestakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -781,6 +1267,368 @@ func (m *MsgWithdrawRewardResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestakedCoins = append(m.RestakedCoins, types.Coin{})
+			if err := m.RestakedCoins[len(m.RestakedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoRestake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoRestake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoRestakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoRestakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoRestakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
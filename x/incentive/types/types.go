@@ -1 +1,18 @@
 package types
+
+// MaxRewardsQueryHeightRange is the maximum number of heights in the height
+// range of querying rewards of BTC delegations and finality providers
+const MaxRewardsQueryHeightRange uint64 = 10000
+
+// ValidateHeightRange ensures that the given height range [startHeight, endHeight]
+// is valid for querying rewards
+func ValidateHeightRange(startHeight uint64, endHeight uint64) error {
+	if startHeight > endHeight {
+		return ErrInvalidHeightRange.Wrapf("start height %d is larger than end height %d", startHeight, endHeight)
+	}
+	if endHeight-startHeight >= MaxRewardsQueryHeightRange {
+		return ErrInvalidHeightRange.Wrapf("height range [%d, %d] exceeds the maximum of %d heights",
+			startHeight, endHeight, MaxRewardsQueryHeightRange)
+	}
+	return nil
+}
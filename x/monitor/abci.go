@@ -0,0 +1,19 @@
+package monitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/babylonchain/babylon/x/monitor/keeper"
+	"github.com/babylonchain/babylon/x/monitor/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// EndBlocker checks the liveness of checkpoints that are not finalized yet,
+// and raises alarms for those delayed beyond the thresholds
+func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+	k.CheckCheckpointLiveness(ctx)
+	return []abci.ValidatorUpdate{}, nil
+}
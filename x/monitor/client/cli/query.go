@@ -1,11 +1,14 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 
 	"github.com/babylonchain/babylon/x/monitor/types"
 )
@@ -21,5 +24,89 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdQueryParams())
+
+	cmd.AddCommand(CmdEndedEpochBtcHeight())
+	cmd.AddCommand(CmdReportedCheckpointBtcHeight())
+	cmd.AddCommand(CmdCheckpointLiveness())
+	return cmd
+}
+
+func CmdEndedEpochBtcHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ended-epoch-btc-height <epoch_number>",
+		Short: "retrieve the BTC light client height when the given epoch ended",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryEndedEpochBtcHeightRequest{EpochNum: epochNum}
+			resp, err := queryClient.EndedEpochBtcHeight(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdReportedCheckpointBtcHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reported-checkpoint-btc-height <checkpoint_hash>",
+		Short: "retrieve the BTC light client height when the checkpoint with the given hash was reported",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryReportedCheckpointBtcHeightRequest{CkptHash: args[0]}
+			resp, err := queryClient.ReportedCheckpointBtcHeight(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdCheckpointLiveness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-liveness <epoch_number>",
+		Short: "retrieve the BTC-block delays between the given epoch ending and its checkpoint being reported, confirmed and finalized",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryCheckpointLivenessRequest{EpochNum: epochNum}
+			resp, err := queryClient.CheckpointLiveness(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/x/monitor/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetCheckpointLiveness returns the liveness record of the checkpoint of the
+// given epoch
+func (k Keeper) GetCheckpointLiveness(ctx context.Context, epoch uint64) (*types.CheckpointLiveness, error) {
+	store := k.checkpointLivenessStore(ctx)
+	bz := store.Get(sdk.Uint64ToBigEndian(epoch))
+	if bz == nil {
+		return nil, types.ErrEpochNotEnded.Wrapf("epoch %d", epoch)
+	}
+	var liveness types.CheckpointLiveness
+	k.cdc.MustUnmarshal(bz, &liveness)
+	return &liveness, nil
+}
+
+func (k Keeper) setCheckpointLiveness(ctx context.Context, liveness *types.CheckpointLiveness) {
+	store := k.checkpointLivenessStore(ctx)
+	store.Set(sdk.Uint64ToBigEndian(liveness.EpochNum), k.cdc.MustMarshal(liveness))
+}
+
+// initCheckpointLiveness starts tracking the liveness of the checkpoint of
+// the given epoch, which ends at the current BTC light client height
+func (k Keeper) initCheckpointLiveness(ctx context.Context, epoch uint64) {
+	tipHeight := k.btcLightClientKeeper.GetTipInfo(ctx).Height
+	k.setCheckpointLiveness(ctx, types.NewCheckpointLiveness(epoch, tipHeight))
+	k.pendingCheckpointLivenessStore(ctx).Set(sdk.Uint64ToBigEndian(epoch), []byte{})
+}
+
+// recordCheckpointStage records that the checkpoint of the given epoch
+// reaches the given stage at the current BTC light client height, and raises
+// an alarm if it is too late. A stage that is already reached is not updated
+func (k Keeper) recordCheckpointStage(ctx context.Context, epoch uint64, stage types.CheckpointStage) {
+	liveness, err := k.GetCheckpointLiveness(ctx, epoch)
+	if err != nil {
+		// the epoch ended before the liveness is tracked
+		return
+	}
+	if liveness.StageBtcHeight(stage) > 0 {
+		return
+	}
+
+	tipHeight := k.btcLightClientKeeper.GetTipInfo(ctx).Height
+	liveness.SetStageBtcHeight(stage, tipHeight)
+	k.checkCheckpointLiveness(ctx, liveness, tipHeight, k.GetParams(ctx))
+	k.setCheckpointLiveness(ctx, liveness)
+
+	if stage == types.CheckpointStage_FINALIZED {
+		k.pendingCheckpointLivenessStore(ctx).Delete(sdk.Uint64ToBigEndian(epoch))
+	}
+}
+
+// resetCheckpointStages resets the reported and confirmed stages of the
+// checkpoint of the given epoch, as the checkpoint is no longer on BTC
+func (k Keeper) resetCheckpointStages(ctx context.Context, epoch uint64) {
+	liveness, err := k.GetCheckpointLiveness(ctx, epoch)
+	if err != nil {
+		return
+	}
+	liveness.SetStageBtcHeight(types.CheckpointStage_REPORTED, 0)
+	liveness.SetStageBtcHeight(types.CheckpointStage_CONFIRMED, 0)
+	k.setCheckpointLiveness(ctx, liveness)
+}
+
+// CheckCheckpointLiveness checks the liveness of the checkpoints of all
+// epochs that are not finalized yet against the current BTC light client
+// height, and raises alarms for the stages that are delayed
+func (k Keeper) CheckCheckpointLiveness(ctx context.Context) {
+	params := k.GetParams(ctx)
+	minThreshold := params.MinDelayThreshold()
+	if minThreshold == 0 {
+		return
+	}
+	tipHeight := k.btcLightClientKeeper.GetTipInfo(ctx).Height
+
+	pendingStore := k.pendingCheckpointLivenessStore(ctx)
+	iter := pendingStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		liveness, err := k.GetCheckpointLiveness(ctx, sdk.BigEndianToUint64(iter.Key()))
+		if err != nil {
+			panic(err) // only programming error
+		}
+		// later epochs end at higher BTC heights, so none of them is delayed
+		if liveness.EpochEndBtcHeight+minThreshold >= tipHeight {
+			break
+		}
+		if k.checkCheckpointLiveness(ctx, liveness, tipHeight, params) {
+			k.setCheckpointLiveness(ctx, liveness)
+		}
+	}
+}
+
+// checkCheckpointLiveness raises an alarm for each stage of the given
+// checkpoint that is delayed beyond its threshold, and returns whether any
+// alarm is raised. An alarm is raised at most once per stage
+func (k Keeper) checkCheckpointLiveness(ctx context.Context, liveness *types.CheckpointLiveness, tipHeight uint64, params types.Params) bool {
+	alarmed := false
+	for _, stage := range types.CheckpointStages {
+		threshold := params.DelayThreshold(stage)
+		if threshold == 0 || liveness.IsAlarmed(stage) {
+			continue
+		}
+		reached, delay := liveness.StageDelay(stage, tipHeight)
+		if delay <= threshold {
+			continue
+		}
+
+		liveness.AlarmedStages = append(liveness.AlarmedStages, stage)
+		alarmed = true
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Error("checkpoint is delayed",
+			"epoch", liveness.EpochNum,
+			"stage", stage.String(),
+			"delay", delay,
+			"threshold", threshold,
+		)
+		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventCheckpointLivenessAlarm{
+			EpochNum:  liveness.EpochNum,
+			Stage:     stage,
+			Reached:   reached,
+			Delay:     delay,
+			Threshold: threshold,
+		}); err != nil {
+			panic(err)
+		}
+	}
+	return alarmed
+}
+
+// checkpointLivenessStore returns the KVStore of the liveness record of the
+// checkpoint of each ended epoch
+// prefix: CheckpointLivenessPrefix
+// key: epoch number
+// value: CheckpointLiveness
+func (k Keeper) checkpointLivenessStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.CheckpointLivenessPrefix)
+}
+
+// pendingCheckpointLivenessStore returns the KVStore of the ended epochs
+// whose checkpoints are not finalized yet
+// prefix: PendingCheckpointLivenessPrefix
+// key: epoch number
+// value: empty
+func (k Keeper) pendingCheckpointLivenessStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.PendingCheckpointLivenessPrefix)
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/app"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/monitor/types"
+)
+
+func livenessAlarms(ctx sdk.Context) []*types.EventCheckpointLivenessAlarm {
+	alarms := []*types.EventCheckpointLivenessAlarm{}
+	for _, event := range ctx.EventManager().Events() {
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			continue
+		}
+		if alarm, ok := typedEvent.(*types.EventCheckpointLivenessAlarm); ok {
+			alarms = append(alarms, alarm)
+		}
+	}
+	return alarms
+}
+
+func FuzzCheckpointLiveness(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		ctx := babylonApp.NewContext(false)
+		lck := babylonApp.BTCLightClientKeeper
+		mk := babylonApp.MonitorKeeper
+		hooks := mk.Hooks()
+
+		queryHelper := baseapp.NewQueryServerTestHelper(ctx, babylonApp.InterfaceRegistry())
+		types.RegisterQueryServer(queryHelper, mk)
+		queryClient := types.NewQueryClient(queryHelper)
+
+		// insertHeaders extends the BTC light client by n headers
+		insertHeaders := func(n uint32) {
+			tip := lck.GetTipInfo(ctx)
+			chain := datagen.GenRandomValidChainStartingFrom(r, tip.Height, tip.Header.ToBlockHeader(), nil, n)
+			err := lck.InsertHeaders(ctx, datagen.HeaderToHeaderBytes(chain))
+			require.NoError(t, err)
+		}
+		// checkLiveness runs the liveness check with a fresh event manager and
+		// returns the raised alarms
+		checkLiveness := func() []*types.EventCheckpointLivenessAlarm {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			mk.CheckCheckpointLiveness(ctx)
+			return livenessAlarms(ctx)
+		}
+
+		// random thresholds
+		reportedThreshold := datagen.RandomInt(r, 10) + 5
+		confirmedThreshold := reportedThreshold + datagen.RandomInt(r, 10) + 5
+		finalizedThreshold := confirmedThreshold + datagen.RandomInt(r, 10) + 5
+		params := types.NewParams(reportedThreshold, confirmedThreshold, finalizedThreshold)
+		err := mk.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// epoch 1 ends
+		epochEndHeight := lck.GetTipInfo(ctx).Height
+		hooks.AfterEpochEnds(ctx, 1)
+
+		// no alarm as long as the delay does not exceed the threshold
+		insertHeaders(uint32(reportedThreshold))
+		require.Empty(t, checkLiveness())
+
+		// the checkpoint is not reported within the threshold
+		insertHeaders(1)
+		alarms := checkLiveness()
+		require.Len(t, alarms, 1)
+		require.Equal(t, types.CheckpointStage_REPORTED, alarms[0].Stage)
+		require.False(t, alarms[0].Reached)
+		require.Equal(t, reportedThreshold+1, alarms[0].Delay)
+		require.Equal(t, reportedThreshold, alarms[0].Threshold)
+
+		// the alarm is raised only once
+		require.Empty(t, checkLiveness())
+
+		// the checkpoint is reported and then confirmed in time
+		ckpt := datagen.GenRandomRawCheckpoint(r)
+		ckpt.EpochNum = 1
+		err = hooks.AfterRawCheckpointBlsSigVerified(ctx, ckpt)
+		require.NoError(t, err)
+		err = hooks.AfterRawCheckpointConfirmed(ctx, 1)
+		require.NoError(t, err)
+		require.Empty(t, checkLiveness())
+
+		// the checkpoint is finalized late without the liveness being checked
+		// in between
+		insertHeaders(uint32(finalizedThreshold - reportedThreshold))
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		err = hooks.AfterRawCheckpointFinalized(ctx, 1)
+		require.NoError(t, err)
+		alarms = livenessAlarms(ctx)
+		require.Len(t, alarms, 1)
+		require.Equal(t, types.CheckpointStage_FINALIZED, alarms[0].Stage)
+		require.True(t, alarms[0].Reached)
+		require.Equal(t, finalizedThreshold+1, alarms[0].Delay)
+
+		// the finalized checkpoint is no longer checked
+		insertHeaders(uint32(finalizedThreshold))
+		require.Empty(t, checkLiveness())
+
+		// query the liveness of epoch 1
+		resp, err := queryClient.CheckpointLiveness(ctx, &types.QueryCheckpointLivenessRequest{EpochNum: 1})
+		require.NoError(t, err)
+		require.Equal(t, epochEndHeight, resp.EpochEndBtcHeight)
+		require.Len(t, resp.Stages, 3)
+		expectedDelays := []uint64{reportedThreshold + 1, reportedThreshold + 1, finalizedThreshold + 1}
+		expectedAlarmed := []bool{true, false, true}
+		for i, stage := range resp.Stages {
+			require.Equal(t, types.CheckpointStages[i], stage.Stage)
+			require.True(t, stage.Reached)
+			require.Equal(t, epochEndHeight+expectedDelays[i], stage.BtcHeight)
+			require.Equal(t, expectedDelays[i], stage.Delay)
+			require.Equal(t, params.DelayThreshold(stage.Stage), stage.Threshold)
+			require.Equal(t, expectedAlarmed[i], stage.Alarmed)
+		}
+
+		// the liveness of an epoch that has not ended is unknown
+		_, err = queryClient.CheckpointLiveness(ctx, &types.QueryCheckpointLivenessRequest{EpochNum: 2})
+		require.ErrorIs(t, err, types.ErrEpochNotEnded)
+	})
+}
@@ -11,6 +11,15 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
 func (k Keeper) EndedEpochBtcHeight(c context.Context, req *types.QueryEndedEpochBtcHeightRequest) (*types.QueryEndedEpochBtcHeightResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -42,3 +51,41 @@ func (k Keeper) ReportedCheckpointBtcHeight(c context.Context, req *types.QueryR
 
 	return &types.QueryReportedCheckpointBtcHeightResponse{BtcLightClientHeight: btcHeight}, nil
 }
+
+func (k Keeper) CheckpointLiveness(c context.Context, req *types.QueryCheckpointLivenessRequest) (*types.QueryCheckpointLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	liveness, err := k.GetCheckpointLiveness(ctx, req.EpochNum)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	tipHeight := k.btcLightClientKeeper.GetTipInfo(ctx).Height
+	stages := make([]*types.CheckpointStageLiveness, 0, len(types.CheckpointStages))
+	for _, stage := range types.CheckpointStages {
+		reached, delay := liveness.StageDelay(stage, tipHeight)
+		btcHeight := tipHeight
+		if reached {
+			btcHeight = liveness.StageBtcHeight(stage)
+		}
+		stages = append(stages, &types.CheckpointStageLiveness{
+			Stage:     stage,
+			Reached:   reached,
+			BtcHeight: btcHeight,
+			Delay:     delay,
+			Threshold: params.DelayThreshold(stage),
+			Alarmed:   liveness.IsAlarmed(stage),
+		})
+	}
+
+	return &types.QueryCheckpointLivenessResponse{
+		EpochNum:          liveness.EpochNum,
+		EpochEndBtcHeight: liveness.EpochEndBtcHeight,
+		Stages:            stages,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
 	etypes "github.com/babylonchain/babylon/x/epoching/types"
+	"github.com/babylonchain/babylon/x/monitor/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -25,6 +26,7 @@ func (h Hooks) AfterEpochBegins(ctx context.Context, epoch uint64) {}
 
 func (h Hooks) AfterEpochEnds(ctx context.Context, epoch uint64) {
 	h.k.updateBtcLightClientHeightForEpoch(ctx, epoch)
+	h.k.initCheckpointLiveness(ctx, epoch)
 }
 
 func (h Hooks) BeforeSlashThreshold(ctx context.Context, valSet etypes.ValidatorSet) {}
@@ -36,17 +38,21 @@ func (h Hooks) AfterRawCheckpointSealed(ctx context.Context, epoch uint64) error
 	return nil
 }
 func (h Hooks) AfterRawCheckpointConfirmed(ctx context.Context, epoch uint64) error {
+	h.k.recordCheckpointStage(ctx, epoch, types.CheckpointStage_CONFIRMED)
 	return nil
 }
 
 func (h Hooks) AfterRawCheckpointForgotten(ctx context.Context, ckpt *checkpointingtypes.RawCheckpoint) error {
+	h.k.resetCheckpointStages(ctx, ckpt.EpochNum)
 	return h.k.removeCheckpointRecord(ctx, ckpt)
 }
 
 func (h Hooks) AfterRawCheckpointFinalized(ctx context.Context, epoch uint64) error {
+	h.k.recordCheckpointStage(ctx, epoch, types.CheckpointStage_FINALIZED)
 	return nil
 }
 
 func (h Hooks) AfterRawCheckpointBlsSigVerified(ctx context.Context, ckpt *checkpointingtypes.RawCheckpoint) error {
+	h.k.recordCheckpointStage(ctx, ckpt.EpochNum, types.CheckpointStage_REPORTED)
 	return h.k.updateBtcLightClientHeightForCheckpoint(ctx, ckpt)
 }
@@ -18,6 +18,9 @@ type (
 		cdc                  codec.BinaryCodec
 		storeService         corestoretypes.KVStoreService
 		btcLightClientKeeper types.BTCLightClientKeeper
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
 	}
 )
 
@@ -25,11 +28,13 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService corestoretypes.KVStoreService,
 	bk types.BTCLightClientKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
 		cdc:                  cdc,
 		storeService:         storeService,
 		btcLightClientKeeper: bk,
+		authority:            authority,
 	}
 }
 
@@ -0,0 +1,461 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/monitor/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventCheckpointLivenessAlarm is emitted when the checkpoint of an epoch
+// reaches a stage later than the threshold, or has not reached the stage
+// within the threshold. It is emitted at most once per epoch and stage
+type EventCheckpointLivenessAlarm struct {
+	// epoch_num is the number of the epoch
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// stage is the stage of the checkpoint that is delayed
+	Stage CheckpointStage `protobuf:"varint,2,opt,name=stage,proto3,enum=babylon.monitor.v1.CheckpointStage" json:"stage,omitempty"`
+	// reached is whether the checkpoint has reached the stage
+	Reached bool `protobuf:"varint,3,opt,name=reached,proto3" json:"reached,omitempty"`
+	// delay is the number of BTC blocks between the epoch ending and the
+	// checkpoint reaching the stage, or the current BTC tip if not reached
+	Delay uint64 `protobuf:"varint,4,opt,name=delay,proto3" json:"delay,omitempty"`
+	// threshold is the maximum delay allowed for the stage
+	Threshold uint64 `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *EventCheckpointLivenessAlarm) Reset()         { *m = EventCheckpointLivenessAlarm{} }
+func (m *EventCheckpointLivenessAlarm) String() string { return proto.CompactTextString(m) }
+func (*EventCheckpointLivenessAlarm) ProtoMessage()    {}
+func (*EventCheckpointLivenessAlarm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5a4f8ce518ee1ea, []int{0}
+}
+func (m *EventCheckpointLivenessAlarm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCheckpointLivenessAlarm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCheckpointLivenessAlarm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCheckpointLivenessAlarm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCheckpointLivenessAlarm.Merge(m, src)
+}
+func (m *EventCheckpointLivenessAlarm) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCheckpointLivenessAlarm) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCheckpointLivenessAlarm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCheckpointLivenessAlarm proto.InternalMessageInfo
+
+func (m *EventCheckpointLivenessAlarm) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *EventCheckpointLivenessAlarm) GetStage() CheckpointStage {
+	if m != nil {
+		return m.Stage
+	}
+	return CheckpointStage_REPORTED
+}
+
+func (m *EventCheckpointLivenessAlarm) GetReached() bool {
+	if m != nil {
+		return m.Reached
+	}
+	return false
+}
+
+func (m *EventCheckpointLivenessAlarm) GetDelay() uint64 {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
+func (m *EventCheckpointLivenessAlarm) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventCheckpointLivenessAlarm)(nil), "babylon.monitor.v1.EventCheckpointLivenessAlarm")
+}
+
+func init() { proto.RegisterFile("babylon/monitor/v1/events.proto", fileDescriptor_b5a4f8ce518ee1ea) }
+
+var fileDescriptor_b5a4f8ce518ee1ea = []byte{
+	// 274 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x50, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0xad, 0xbf, 0xaf, 0x81, 0xd6, 0x03, 0x83, 0xc5, 0x60, 0x41, 0x65, 0x22, 0x58, 0x32, 0xd9,
+	0x2a, 0x4c, 0x8c, 0x80, 0x18, 0x90, 0x10, 0x43, 0xd8, 0x58, 0x90, 0x93, 0x5c, 0xd5, 0x11, 0x89,
+	0x1d, 0xc5, 0x4e, 0x44, 0xde, 0x82, 0x87, 0x62, 0x60, 0xec, 0xc8, 0x88, 0x92, 0x17, 0x41, 0x4d,
+	0x13, 0x3a, 0xc0, 0x78, 0xee, 0xf9, 0xb9, 0x47, 0x07, 0x9f, 0x44, 0x32, 0x6a, 0x32, 0xa3, 0x45,
+	0x6e, 0x74, 0xea, 0x4c, 0x29, 0xea, 0xa5, 0x80, 0x1a, 0xb4, 0xb3, 0xbc, 0x28, 0x8d, 0x33, 0x84,
+	0x0c, 0x02, 0x3e, 0x08, 0x78, 0xbd, 0x3c, 0xf2, 0xff, 0x30, 0x8d, 0x74, 0xef, 0x3a, 0x7d, 0x47,
+	0x78, 0x71, 0xbb, 0x89, 0xb9, 0x51, 0x10, 0xbf, 0x14, 0x26, 0xd5, 0xee, 0x3e, 0xad, 0x41, 0x83,
+	0xb5, 0x57, 0x99, 0x2c, 0x73, 0x72, 0x8c, 0xe7, 0x50, 0x98, 0x58, 0x3d, 0xeb, 0x2a, 0xa7, 0xc8,
+	0x47, 0xc1, 0x34, 0x9c, 0xf5, 0x87, 0x87, 0x2a, 0x27, 0x97, 0xd8, 0xb3, 0x4e, 0xae, 0x80, 0xfe,
+	0xf3, 0x51, 0x70, 0x70, 0x7e, 0xc6, 0x7f, 0x77, 0xe0, 0xbb, 0xe0, 0xc7, 0x8d, 0x34, 0xdc, 0x3a,
+	0x08, 0xc5, 0xfb, 0x25, 0xc8, 0x58, 0x41, 0x42, 0xff, 0xfb, 0x28, 0x98, 0x85, 0x23, 0x24, 0x87,
+	0xd8, 0x4b, 0x20, 0x93, 0x0d, 0x9d, 0xf6, 0xdf, 0xb6, 0x80, 0x2c, 0xf0, 0xdc, 0xa9, 0x12, 0xac,
+	0x32, 0x59, 0x42, 0xbd, 0x9e, 0xd9, 0x1d, 0xae, 0xef, 0x3e, 0x5a, 0x86, 0xd6, 0x2d, 0x43, 0x5f,
+	0x2d, 0x43, 0x6f, 0x1d, 0x9b, 0xac, 0x3b, 0x36, 0xf9, 0xec, 0xd8, 0xe4, 0x49, 0xac, 0x52, 0xa7,
+	0xaa, 0x88, 0xc7, 0x26, 0x17, 0x43, 0xbb, 0x58, 0xc9, 0x54, 0x8f, 0x40, 0xbc, 0xfe, 0x8c, 0xe3,
+	0x9a, 0x02, 0x6c, 0xb4, 0xd7, 0x0f, 0x73, 0xf1, 0x3d, 0x00, 0x41, 0x45, 0x8a, 0x3b, 0x71, 0x01,
+	0x00, 0x00,
+}
+
+func (m *EventCheckpointLivenessAlarm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCheckpointLivenessAlarm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCheckpointLivenessAlarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Delay != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Delay))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reached {
+		i--
+		if m.Reached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Stage != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCheckpointLivenessAlarm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNum))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovEvents(uint64(m.Stage))
+	}
+	if m.Reached {
+		n += 2
+	}
+	if m.Delay != 0 {
+		n += 1 + sovEvents(uint64(m.Delay))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovEvents(uint64(m.Threshold))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCheckpointLivenessAlarm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCheckpointLivenessAlarm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCheckpointLivenessAlarm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= CheckpointStage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reached = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			m.Delay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
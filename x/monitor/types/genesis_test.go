@@ -18,6 +18,20 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "disabled thresholds are valid",
+			genState: &types.GenesisState{
+				Params: types.NewParams(0, 0, types.DefaultReportedDelayThreshold),
+			},
+			valid: true,
+		},
+		{
+			desc: "threshold of a later stage smaller than an earlier stage",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultConfirmedDelayThreshold, types.DefaultReportedDelayThreshold, 0),
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -26,6 +26,9 @@ const (
 var (
 	EpochEndLightClientHeightPrefix           = []byte{1}
 	CheckpointReportedLightClientHeightPrefix = []byte{2}
+	ParamsKey                                 = []byte{3}
+	CheckpointLivenessPrefix                  = []byte{4}
+	PendingCheckpointLivenessPrefix           = []byte{5}
 )
 
 func KeyPrefix(p string) []byte {
@@ -0,0 +1,69 @@
+package types
+
+import "slices"
+
+// CheckpointStages are the stages that the checkpoint of an epoch goes
+// through, in order
+var CheckpointStages = []CheckpointStage{
+	CheckpointStage_REPORTED,
+	CheckpointStage_CONFIRMED,
+	CheckpointStage_FINALIZED,
+}
+
+// NewCheckpointLiveness creates the liveness record of an epoch that ends at
+// the given BTC light client height
+func NewCheckpointLiveness(epochNum uint64, epochEndBtcHeight uint64) *CheckpointLiveness {
+	return &CheckpointLiveness{
+		EpochNum:          epochNum,
+		EpochEndBtcHeight: epochEndBtcHeight,
+	}
+}
+
+// StageBtcHeight returns the BTC light client height at which the checkpoint
+// reaches the given stage, or 0 if not reached
+func (cl *CheckpointLiveness) StageBtcHeight(stage CheckpointStage) uint64 {
+	switch stage {
+	case CheckpointStage_REPORTED:
+		return cl.ReportedBtcHeight
+	case CheckpointStage_CONFIRMED:
+		return cl.ConfirmedBtcHeight
+	case CheckpointStage_FINALIZED:
+		return cl.FinalizedBtcHeight
+	default:
+		return 0
+	}
+}
+
+// SetStageBtcHeight records the BTC light client height at which the
+// checkpoint reaches the given stage
+func (cl *CheckpointLiveness) SetStageBtcHeight(stage CheckpointStage, btcHeight uint64) {
+	switch stage {
+	case CheckpointStage_REPORTED:
+		cl.ReportedBtcHeight = btcHeight
+	case CheckpointStage_CONFIRMED:
+		cl.ConfirmedBtcHeight = btcHeight
+	case CheckpointStage_FINALIZED:
+		cl.FinalizedBtcHeight = btcHeight
+	}
+}
+
+// StageDelay returns whether the checkpoint has reached the given stage,
+// and the number of BTC blocks between the epoch ending and the checkpoint
+// reaching the stage, or the given BTC tip height if not reached
+func (cl *CheckpointLiveness) StageDelay(stage CheckpointStage, tipHeight uint64) (bool, uint64) {
+	reached := cl.StageBtcHeight(stage) > 0
+	height := tipHeight
+	if reached {
+		height = cl.StageBtcHeight(stage)
+	}
+	// the BTC light client tip might move backwards upon a fork
+	if height < cl.EpochEndBtcHeight {
+		return reached, 0
+	}
+	return reached, height - cl.EpochEndBtcHeight
+}
+
+// IsAlarmed returns whether an alarm is raised for the given stage
+func (cl *CheckpointLiveness) IsAlarmed(stage CheckpointStage) bool {
+	return slices.Contains(cl.AlarmedStages, stage)
+}
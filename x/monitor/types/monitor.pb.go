@@ -0,0 +1,598 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/monitor/v1/monitor.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CheckpointStage is a stage that the checkpoint of an epoch goes through
+// after the epoch ends
+type CheckpointStage int32
+
+const (
+	// REPORTED means the checkpoint is reported to Babylon
+	CheckpointStage_REPORTED CheckpointStage = 0
+	// CONFIRMED means the checkpoint is k-deep on BTC
+	CheckpointStage_CONFIRMED CheckpointStage = 1
+	// FINALIZED means the checkpoint is w-deep on BTC
+	CheckpointStage_FINALIZED CheckpointStage = 2
+)
+
+var CheckpointStage_name = map[int32]string{
+	0: "REPORTED",
+	1: "CONFIRMED",
+	2: "FINALIZED",
+}
+
+var CheckpointStage_value = map[string]int32{
+	"REPORTED":  0,
+	"CONFIRMED": 1,
+	"FINALIZED": 2,
+}
+
+func (x CheckpointStage) String() string {
+	return proto.EnumName(CheckpointStage_name, int32(x))
+}
+
+func (CheckpointStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5b4616c249e8d12d, []int{0}
+}
+
+// CheckpointLiveness records the BTC light client heights at which an epoch
+// ends and its checkpoint reaches each stage. A height of 0 means the stage
+// is not reached yet
+type CheckpointLiveness struct {
+	// epoch_num is the number of the epoch
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// epoch_end_btc_height is the BTC light client height when the epoch ends
+	EpochEndBtcHeight uint64 `protobuf:"varint,2,opt,name=epoch_end_btc_height,json=epochEndBtcHeight,proto3" json:"epoch_end_btc_height,omitempty"`
+	// reported_btc_height is the BTC light client height when the checkpoint
+	// is reported
+	ReportedBtcHeight uint64 `protobuf:"varint,3,opt,name=reported_btc_height,json=reportedBtcHeight,proto3" json:"reported_btc_height,omitempty"`
+	// confirmed_btc_height is the BTC light client height when the checkpoint
+	// is confirmed
+	ConfirmedBtcHeight uint64 `protobuf:"varint,4,opt,name=confirmed_btc_height,json=confirmedBtcHeight,proto3" json:"confirmed_btc_height,omitempty"`
+	// finalized_btc_height is the BTC light client height when the checkpoint
+	// is finalized
+	FinalizedBtcHeight uint64 `protobuf:"varint,5,opt,name=finalized_btc_height,json=finalizedBtcHeight,proto3" json:"finalized_btc_height,omitempty"`
+	// alarmed_stages are the stages for which an alarm is raised
+	AlarmedStages []CheckpointStage `protobuf:"varint,6,rep,packed,name=alarmed_stages,json=alarmedStages,proto3,enum=babylon.monitor.v1.CheckpointStage" json:"alarmed_stages,omitempty"`
+}
+
+func (m *CheckpointLiveness) Reset()         { *m = CheckpointLiveness{} }
+func (m *CheckpointLiveness) String() string { return proto.CompactTextString(m) }
+func (*CheckpointLiveness) ProtoMessage()    {}
+func (*CheckpointLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b4616c249e8d12d, []int{0}
+}
+func (m *CheckpointLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointLiveness.Merge(m, src)
+}
+func (m *CheckpointLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointLiveness proto.InternalMessageInfo
+
+func (m *CheckpointLiveness) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *CheckpointLiveness) GetEpochEndBtcHeight() uint64 {
+	if m != nil {
+		return m.EpochEndBtcHeight
+	}
+	return 0
+}
+
+func (m *CheckpointLiveness) GetReportedBtcHeight() uint64 {
+	if m != nil {
+		return m.ReportedBtcHeight
+	}
+	return 0
+}
+
+func (m *CheckpointLiveness) GetConfirmedBtcHeight() uint64 {
+	if m != nil {
+		return m.ConfirmedBtcHeight
+	}
+	return 0
+}
+
+func (m *CheckpointLiveness) GetFinalizedBtcHeight() uint64 {
+	if m != nil {
+		return m.FinalizedBtcHeight
+	}
+	return 0
+}
+
+func (m *CheckpointLiveness) GetAlarmedStages() []CheckpointStage {
+	if m != nil {
+		return m.AlarmedStages
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("babylon.monitor.v1.CheckpointStage", CheckpointStage_name, CheckpointStage_value)
+	proto.RegisterType((*CheckpointLiveness)(nil), "babylon.monitor.v1.CheckpointLiveness")
+}
+
+func init() { proto.RegisterFile("babylon/monitor/v1/monitor.proto", fileDescriptor_5b4616c249e8d12d) }
+
+var fileDescriptor_5b4616c249e8d12d = []byte{
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x31, 0x4f, 0xf2, 0x40,
+	0x18, 0xc7, 0x5b, 0xe0, 0x25, 0x70, 0x79, 0x41, 0x3c, 0x19, 0x48, 0x4c, 0x1a, 0xa2, 0x0b, 0x71,
+	0x68, 0x45, 0x67, 0x07, 0x81, 0x12, 0x6b, 0x10, 0x4c, 0x75, 0x62, 0x21, 0xed, 0x71, 0x70, 0x17,
+	0xe9, 0x5d, 0xd3, 0x3b, 0x88, 0xf8, 0x29, 0xfc, 0x4c, 0x4e, 0x8e, 0x8c, 0x8e, 0x06, 0xbe, 0x88,
+	0xe1, 0xa0, 0x34, 0xd5, 0xad, 0xff, 0xe7, 0xff, 0xfb, 0xa5, 0x97, 0xe7, 0x01, 0x75, 0xdf, 0xf3,
+	0x97, 0x33, 0xce, 0xac, 0x80, 0x33, 0x2a, 0x79, 0x64, 0x2d, 0x9a, 0xf1, 0xa7, 0x19, 0x46, 0x5c,
+	0x72, 0x08, 0xf7, 0x84, 0x19, 0x8f, 0x17, 0xcd, 0xb3, 0x8f, 0x0c, 0x80, 0x6d, 0x82, 0xd1, 0x4b,
+	0xc8, 0x29, 0x93, 0x3d, 0xba, 0xc0, 0x0c, 0x0b, 0x01, 0x4f, 0x41, 0x11, 0x87, 0x1c, 0x91, 0x11,
+	0x9b, 0x07, 0x35, 0xbd, 0xae, 0x37, 0x72, 0x6e, 0x41, 0x0d, 0xfa, 0xf3, 0x00, 0x5a, 0xa0, 0xba,
+	0x2b, 0x31, 0x1b, 0x8f, 0x7c, 0x89, 0x46, 0x04, 0xd3, 0x29, 0x91, 0xb5, 0x8c, 0xe2, 0x8e, 0x55,
+	0x67, 0xb3, 0x71, 0x4b, 0xa2, 0x3b, 0x55, 0x40, 0x13, 0x9c, 0x44, 0x38, 0xe4, 0x91, 0xc4, 0x29,
+	0x3e, 0xbb, 0xe3, 0xe3, 0x2a, 0xe1, 0x2f, 0x41, 0x15, 0x71, 0x36, 0xa1, 0x51, 0x90, 0x16, 0x72,
+	0x4a, 0x80, 0x87, 0x2e, 0x65, 0x4c, 0x28, 0xf3, 0x66, 0xf4, 0x2d, 0x6d, 0xfc, 0xdb, 0x19, 0x87,
+	0x2e, 0x31, 0xee, 0x41, 0xd9, 0x9b, 0x79, 0xea, 0x0f, 0x42, 0x7a, 0x53, 0x2c, 0x6a, 0xf9, 0x7a,
+	0xb6, 0x51, 0xbe, 0x3a, 0x37, 0xff, 0x6e, 0xc9, 0x4c, 0x36, 0xf4, 0xb4, 0x65, 0xdd, 0xd2, 0x5e,
+	0x55, 0x49, 0x5c, 0xdc, 0x80, 0xa3, 0x5f, 0x04, 0xfc, 0x0f, 0x0a, 0xae, 0xfd, 0x38, 0x70, 0x9f,
+	0xed, 0x4e, 0x45, 0x83, 0x25, 0x50, 0x6c, 0x0f, 0xfa, 0x5d, 0xc7, 0x7d, 0xb0, 0x3b, 0x15, 0x7d,
+	0x1b, 0xbb, 0x4e, 0xff, 0xb6, 0xe7, 0x0c, 0xed, 0x4e, 0x25, 0xd3, 0x72, 0x3e, 0xd7, 0x86, 0xbe,
+	0x5a, 0x1b, 0xfa, 0xf7, 0xda, 0xd0, 0xdf, 0x37, 0x86, 0xb6, 0xda, 0x18, 0xda, 0xd7, 0xc6, 0xd0,
+	0x86, 0xd6, 0x94, 0x4a, 0x32, 0xf7, 0x4d, 0xc4, 0x03, 0x6b, 0xff, 0x2c, 0x44, 0x3c, 0xca, 0xe2,
+	0x60, 0xbd, 0x1e, 0xae, 0x2d, 0x97, 0x21, 0x16, 0x7e, 0x5e, 0x5d, 0xfa, 0xfa, 0x67, 0x00, 0xbd,
+	0x9b, 0x6b, 0x00, 0x0d, 0x02, 0x00, 0x00,
+}
+
+func (m *CheckpointLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AlarmedStages) > 0 {
+		dAtA2 := make([]byte, len(m.AlarmedStages)*10)
+		var j1 int
+		for _, num := range m.AlarmedStages {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintMonitor(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FinalizedBtcHeight != 0 {
+		i = encodeVarintMonitor(dAtA, i, uint64(m.FinalizedBtcHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ConfirmedBtcHeight != 0 {
+		i = encodeVarintMonitor(dAtA, i, uint64(m.ConfirmedBtcHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReportedBtcHeight != 0 {
+		i = encodeVarintMonitor(dAtA, i, uint64(m.ReportedBtcHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochEndBtcHeight != 0 {
+		i = encodeVarintMonitor(dAtA, i, uint64(m.EpochEndBtcHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintMonitor(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMonitor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMonitor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CheckpointLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovMonitor(uint64(m.EpochNum))
+	}
+	if m.EpochEndBtcHeight != 0 {
+		n += 1 + sovMonitor(uint64(m.EpochEndBtcHeight))
+	}
+	if m.ReportedBtcHeight != 0 {
+		n += 1 + sovMonitor(uint64(m.ReportedBtcHeight))
+	}
+	if m.ConfirmedBtcHeight != 0 {
+		n += 1 + sovMonitor(uint64(m.ConfirmedBtcHeight))
+	}
+	if m.FinalizedBtcHeight != 0 {
+		n += 1 + sovMonitor(uint64(m.FinalizedBtcHeight))
+	}
+	if len(m.AlarmedStages) > 0 {
+		l = 0
+		for _, e := range m.AlarmedStages {
+			l += sovMonitor(uint64(e))
+		}
+		n += 1 + sovMonitor(uint64(l)) + l
+	}
+	return n
+}
+
+func sovMonitor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMonitor(x uint64) (n int) {
+	return sovMonitor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CheckpointLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMonitor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEndBtcHeight", wireType)
+			}
+			m.EpochEndBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochEndBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedBtcHeight", wireType)
+			}
+			m.ReportedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedBtcHeight", wireType)
+			}
+			m.ConfirmedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBtcHeight", wireType)
+			}
+			m.FinalizedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType == 0 {
+				var v CheckpointStage
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMonitor
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CheckpointStage(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AlarmedStages = append(m.AlarmedStages, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMonitor
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMonitor
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMonitor
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.AlarmedStages) == 0 {
+					m.AlarmedStages = make([]CheckpointStage, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CheckpointStage
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMonitor
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CheckpointStage(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AlarmedStages = append(m.AlarmedStages, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlarmedStages", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMonitor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMonitor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMonitor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMonitor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMonitor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMonitor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMonitor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMonitor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMonitor = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	DefaultReportedDelayThreshold  uint64 = 12
+	DefaultConfirmedDelayThreshold uint64 = 24
+	DefaultFinalizedDelayThreshold uint64 = 120
+)
+
+// NewParams creates a new Params instance
+func NewParams(reportedDelayThreshold uint64, confirmedDelayThreshold uint64, finalizedDelayThreshold uint64) Params {
+	return Params{
+		ReportedDelayThreshold:  reportedDelayThreshold,
+		ConfirmedDelayThreshold: confirmedDelayThreshold,
+		FinalizedDelayThreshold: finalizedDelayThreshold,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultReportedDelayThreshold,
+		DefaultConfirmedDelayThreshold,
+		DefaultFinalizedDelayThreshold,
+	)
+}
+
+// Validate validates the set of params. Each enabled threshold has to be no
+// smaller than the enabled thresholds of the earlier stages
+func (p Params) Validate() error {
+	maxEarlierThreshold := uint64(0)
+	for _, stage := range CheckpointStages {
+		threshold := p.DelayThreshold(stage)
+		if threshold == 0 {
+			continue
+		}
+		if threshold < maxEarlierThreshold {
+			return fmt.Errorf("delay threshold of stage %s (%d) should not be smaller than that of earlier stages (%d)", stage, threshold, maxEarlierThreshold)
+		}
+		maxEarlierThreshold = threshold
+	}
+	return nil
+}
+
+// DelayThreshold returns the delay threshold of the given stage
+func (p Params) DelayThreshold(stage CheckpointStage) uint64 {
+	switch stage {
+	case CheckpointStage_REPORTED:
+		return p.ReportedDelayThreshold
+	case CheckpointStage_CONFIRMED:
+		return p.ConfirmedDelayThreshold
+	case CheckpointStage_FINALIZED:
+		return p.FinalizedDelayThreshold
+	default:
+		return 0
+	}
+}
+
+// MinDelayThreshold returns the smallest enabled delay threshold, or 0 if all
+// thresholds are disabled
+func (p Params) MinDelayThreshold() uint64 {
+	minThreshold := uint64(0)
+	for _, stage := range CheckpointStages {
+		threshold := p.DelayThreshold(stage)
+		if threshold > 0 && (minThreshold == 0 || threshold < minThreshold) {
+			minThreshold = threshold
+		}
+	}
+	return minThreshold
+}
@@ -0,0 +1,414 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/monitor/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// reported_delay_threshold is the maximum number of BTC blocks between an
+	// epoch ending and its checkpoint being reported to Babylon. 0 disables
+	// the alarm
+	ReportedDelayThreshold uint64 `protobuf:"varint,1,opt,name=reported_delay_threshold,json=reportedDelayThreshold,proto3" json:"reported_delay_threshold,omitempty" yaml:"reported_delay_threshold"`
+	// confirmed_delay_threshold is the maximum number of BTC blocks between an
+	// epoch ending and its checkpoint being confirmed. 0 disables the alarm
+	ConfirmedDelayThreshold uint64 `protobuf:"varint,2,opt,name=confirmed_delay_threshold,json=confirmedDelayThreshold,proto3" json:"confirmed_delay_threshold,omitempty" yaml:"confirmed_delay_threshold"`
+	// finalized_delay_threshold is the maximum number of BTC blocks between an
+	// epoch ending and its checkpoint being finalized. 0 disables the alarm
+	FinalizedDelayThreshold uint64 `protobuf:"varint,3,opt,name=finalized_delay_threshold,json=finalizedDelayThreshold,proto3" json:"finalized_delay_threshold,omitempty" yaml:"finalized_delay_threshold"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03a7a0bed09bba40, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetReportedDelayThreshold() uint64 {
+	if m != nil {
+		return m.ReportedDelayThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetConfirmedDelayThreshold() uint64 {
+	if m != nil {
+		return m.ConfirmedDelayThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetFinalizedDelayThreshold() uint64 {
+	if m != nil {
+		return m.FinalizedDelayThreshold
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "babylon.monitor.v1.Params")
+}
+
+func init() { proto.RegisterFile("babylon/monitor/v1/params.proto", fileDescriptor_03a7a0bed09bba40) }
+
+var fileDescriptor_03a7a0bed09bba40 = []byte{
+	// 270 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x4a, 0x4c, 0xaa,
+	0xcc, 0xc9, 0xcf, 0xd3, 0xcf, 0xcd, 0xcf, 0xcb, 0x2c, 0xc9, 0x2f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f,
+	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
+	0x83, 0x2a, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58,
+	0x10, 0x95, 0x4a, 0x1b, 0x99, 0xb8, 0xd8, 0x02, 0xc0, 0x5a, 0x85, 0x62, 0xb9, 0x24, 0x8a, 0x52,
+	0x0b, 0xf2, 0x8b, 0x4a, 0x52, 0x53, 0xe2, 0x53, 0x52, 0x73, 0x12, 0x2b, 0xe3, 0x4b, 0x32, 0x8a,
+	0x52, 0x8b, 0x33, 0xf2, 0x73, 0x52, 0x24, 0x18, 0x15, 0x18, 0x35, 0x58, 0x9c, 0x94, 0x3f, 0xdd,
+	0x93, 0x97, 0xaf, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2, 0xa5, 0x52, 0x29, 0x48, 0x0c, 0x26, 0xe5,
+	0x02, 0x92, 0x09, 0x81, 0x49, 0x08, 0x25, 0x70, 0x49, 0x26, 0xe7, 0xe7, 0xa5, 0x65, 0x16, 0xe5,
+	0x62, 0x31, 0x9f, 0x09, 0x6c, 0xbe, 0xca, 0xa7, 0x7b, 0xf2, 0x0a, 0x10, 0xf3, 0x71, 0x2a, 0x55,
+	0x0a, 0x12, 0x87, 0xcb, 0x61, 0xda, 0x90, 0x96, 0x99, 0x97, 0x98, 0x93, 0x59, 0x85, 0xc5, 0x06,
+	0x66, 0x74, 0x1b, 0x70, 0x2a, 0x55, 0x0a, 0x12, 0x87, 0xcb, 0xa1, 0xda, 0x60, 0xc5, 0xf2, 0x62,
+	0x81, 0x3c, 0xa3, 0x93, 0xe7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24,
+	0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9,
+	0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0xa3, 0x20, 0x39, 0x23,
+	0x31, 0x33, 0x0f, 0xc6, 0xd1, 0xaf, 0x80, 0x47, 0x59, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b,
+	0x38, 0x16, 0x8c, 0x01, 0x03, 0x00, 0xe4, 0x99, 0x5d, 0x3a, 0xd2, 0x01, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ReportedDelayThreshold != that1.ReportedDelayThreshold {
+		return false
+	}
+	if this.ConfirmedDelayThreshold != that1.ConfirmedDelayThreshold {
+		return false
+	}
+	if this.FinalizedDelayThreshold != that1.FinalizedDelayThreshold {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalizedDelayThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FinalizedDelayThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfirmedDelayThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ConfirmedDelayThreshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReportedDelayThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReportedDelayThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReportedDelayThreshold != 0 {
+		n += 1 + sovParams(uint64(m.ReportedDelayThreshold))
+	}
+	if m.ConfirmedDelayThreshold != 0 {
+		n += 1 + sovParams(uint64(m.ConfirmedDelayThreshold))
+	}
+	if m.FinalizedDelayThreshold != 0 {
+		n += 1 + sovParams(uint64(m.FinalizedDelayThreshold))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedDelayThreshold", wireType)
+			}
+			m.ReportedDelayThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedDelayThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedDelayThreshold", wireType)
+			}
+			m.ConfirmedDelayThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmedDelayThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedDelayThreshold", wireType)
+			}
+			m.FinalizedDelayThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedDelayThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
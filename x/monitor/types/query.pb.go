@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -28,6 +29,89 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // QueryEndedEpochBtcHeightRequest defines a query type for EndedEpochBtcHeight
 // RPC method
 type QueryEndedEpochBtcHeightRequest struct {
@@ -38,7 +122,7 @@ func (m *QueryEndedEpochBtcHeightRequest) Reset()         { *m = QueryEndedEpoch
 func (m *QueryEndedEpochBtcHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEndedEpochBtcHeightRequest) ProtoMessage()    {}
 func (*QueryEndedEpochBtcHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8aafb034c55a8f2, []int{0}
+	return fileDescriptor_a8aafb034c55a8f2, []int{2}
 }
 func (m *QueryEndedEpochBtcHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -85,7 +169,7 @@ func (m *QueryEndedEpochBtcHeightResponse) Reset()         { *m = QueryEndedEpoc
 func (m *QueryEndedEpochBtcHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEndedEpochBtcHeightResponse) ProtoMessage()    {}
 func (*QueryEndedEpochBtcHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8aafb034c55a8f2, []int{1}
+	return fileDescriptor_a8aafb034c55a8f2, []int{3}
 }
 func (m *QueryEndedEpochBtcHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,7 +218,7 @@ func (m *QueryReportedCheckpointBtcHeightRequest) Reset() {
 func (m *QueryReportedCheckpointBtcHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryReportedCheckpointBtcHeightRequest) ProtoMessage()    {}
 func (*QueryReportedCheckpointBtcHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8aafb034c55a8f2, []int{2}
+	return fileDescriptor_a8aafb034c55a8f2, []int{4}
 }
 func (m *QueryReportedCheckpointBtcHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +267,7 @@ func (m *QueryReportedCheckpointBtcHeightResponse) Reset() {
 func (m *QueryReportedCheckpointBtcHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryReportedCheckpointBtcHeightResponse) ProtoMessage()    {}
 func (*QueryReportedCheckpointBtcHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8aafb034c55a8f2, []int{3}
+	return fileDescriptor_a8aafb034c55a8f2, []int{5}
 }
 func (m *QueryReportedCheckpointBtcHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,43 +303,271 @@ func (m *QueryReportedCheckpointBtcHeightResponse) GetBtcLightClientHeight() uin
 	return 0
 }
 
+// QueryCheckpointLivenessRequest defines a query type for CheckpointLiveness
+// RPC method
+type QueryCheckpointLivenessRequest struct {
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+}
+
+func (m *QueryCheckpointLivenessRequest) Reset()         { *m = QueryCheckpointLivenessRequest{} }
+func (m *QueryCheckpointLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointLivenessRequest) ProtoMessage()    {}
+func (*QueryCheckpointLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{6}
+}
+func (m *QueryCheckpointLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointLivenessRequest.Merge(m, src)
+}
+func (m *QueryCheckpointLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointLivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointLivenessRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointLivenessRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+// CheckpointStageLiveness is the liveness of the checkpoint of an epoch
+// regarding a stage
+type CheckpointStageLiveness struct {
+	// stage is the stage of the checkpoint
+	Stage CheckpointStage `protobuf:"varint,1,opt,name=stage,proto3,enum=babylon.monitor.v1.CheckpointStage" json:"stage,omitempty"`
+	// reached is whether the checkpoint has reached the stage
+	Reached bool `protobuf:"varint,2,opt,name=reached,proto3" json:"reached,omitempty"`
+	// btc_height is the BTC light client height when the checkpoint reaches
+	// the stage, or the current BTC tip height if not reached
+	BtcHeight uint64 `protobuf:"varint,3,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
+	// delay is the number of BTC blocks between the epoch ending and btc_height
+	Delay uint64 `protobuf:"varint,4,opt,name=delay,proto3" json:"delay,omitempty"`
+	// threshold is the maximum delay allowed for the stage, where 0 means no
+	// limit
+	Threshold uint64 `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// alarmed is whether an alarm is raised for the stage
+	Alarmed bool `protobuf:"varint,6,opt,name=alarmed,proto3" json:"alarmed,omitempty"`
+}
+
+func (m *CheckpointStageLiveness) Reset()         { *m = CheckpointStageLiveness{} }
+func (m *CheckpointStageLiveness) String() string { return proto.CompactTextString(m) }
+func (*CheckpointStageLiveness) ProtoMessage()    {}
+func (*CheckpointStageLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{7}
+}
+func (m *CheckpointStageLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointStageLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointStageLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointStageLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointStageLiveness.Merge(m, src)
+}
+func (m *CheckpointStageLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointStageLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointStageLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointStageLiveness proto.InternalMessageInfo
+
+func (m *CheckpointStageLiveness) GetStage() CheckpointStage {
+	if m != nil {
+		return m.Stage
+	}
+	return CheckpointStage_REPORTED
+}
+
+func (m *CheckpointStageLiveness) GetReached() bool {
+	if m != nil {
+		return m.Reached
+	}
+	return false
+}
+
+func (m *CheckpointStageLiveness) GetBtcHeight() uint64 {
+	if m != nil {
+		return m.BtcHeight
+	}
+	return 0
+}
+
+func (m *CheckpointStageLiveness) GetDelay() uint64 {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
+func (m *CheckpointStageLiveness) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *CheckpointStageLiveness) GetAlarmed() bool {
+	if m != nil {
+		return m.Alarmed
+	}
+	return false
+}
+
+// QueryCheckpointLivenessResponse defines a response type for
+// CheckpointLiveness RPC method
+type QueryCheckpointLivenessResponse struct {
+	// epoch_num is the number of the epoch
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// epoch_end_btc_height is the BTC light client height when the epoch ends
+	EpochEndBtcHeight uint64 `protobuf:"varint,2,opt,name=epoch_end_btc_height,json=epochEndBtcHeight,proto3" json:"epoch_end_btc_height,omitempty"`
+	// stages is the liveness of the checkpoint regarding each stage
+	Stages []*CheckpointStageLiveness `protobuf:"bytes,3,rep,name=stages,proto3" json:"stages,omitempty"`
+}
+
+func (m *QueryCheckpointLivenessResponse) Reset()         { *m = QueryCheckpointLivenessResponse{} }
+func (m *QueryCheckpointLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointLivenessResponse) ProtoMessage()    {}
+func (*QueryCheckpointLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{8}
+}
+func (m *QueryCheckpointLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointLivenessResponse.Merge(m, src)
+}
+func (m *QueryCheckpointLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointLivenessResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointLivenessResponse) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *QueryCheckpointLivenessResponse) GetEpochEndBtcHeight() uint64 {
+	if m != nil {
+		return m.EpochEndBtcHeight
+	}
+	return 0
+}
+
+func (m *QueryCheckpointLivenessResponse) GetStages() []*CheckpointStageLiveness {
+	if m != nil {
+		return m.Stages
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.monitor.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.monitor.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryEndedEpochBtcHeightRequest)(nil), "babylon.monitor.v1.QueryEndedEpochBtcHeightRequest")
 	proto.RegisterType((*QueryEndedEpochBtcHeightResponse)(nil), "babylon.monitor.v1.QueryEndedEpochBtcHeightResponse")
 	proto.RegisterType((*QueryReportedCheckpointBtcHeightRequest)(nil), "babylon.monitor.v1.QueryReportedCheckpointBtcHeightRequest")
 	proto.RegisterType((*QueryReportedCheckpointBtcHeightResponse)(nil), "babylon.monitor.v1.QueryReportedCheckpointBtcHeightResponse")
+	proto.RegisterType((*QueryCheckpointLivenessRequest)(nil), "babylon.monitor.v1.QueryCheckpointLivenessRequest")
+	proto.RegisterType((*CheckpointStageLiveness)(nil), "babylon.monitor.v1.CheckpointStageLiveness")
+	proto.RegisterType((*QueryCheckpointLivenessResponse)(nil), "babylon.monitor.v1.QueryCheckpointLivenessResponse")
 }
 
 func init() { proto.RegisterFile("babylon/monitor/v1/query.proto", fileDescriptor_a8aafb034c55a8f2) }
 
 var fileDescriptor_a8aafb034c55a8f2 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x1b, 0xff, 0xd1, 0xce, 0x71, 0x14, 0x94, 0x56, 0x62, 0xc9, 0x41, 0x0b, 0x62, 0x86,
-	0x5a, 0x3d, 0x29, 0x1e, 0x5a, 0x2a, 0x15, 0x44, 0x30, 0x37, 0xbd, 0x84, 0xc9, 0x74, 0xc8, 0x0c,
-	0x4d, 0x66, 0xa6, 0x99, 0x49, 0xb1, 0x94, 0x5e, 0x3c, 0x7a, 0x12, 0xfc, 0x22, 0x7e, 0x0c, 0x2f,
-	0x42, 0xc1, 0x8b, 0xc7, 0xa5, 0xdd, 0x0f, 0xb2, 0x64, 0x9a, 0xcd, 0x65, 0xd3, 0x2d, 0xbb, 0x7b,
-	0xcc, 0xfb, 0xe6, 0xf9, 0xcd, 0xfb, 0xbc, 0xcf, 0x0c, 0x70, 0x23, 0x1c, 0x2d, 0x13, 0x29, 0x50,
-	0x2a, 0x05, 0x37, 0x32, 0x43, 0x8b, 0x3e, 0x9a, 0xe7, 0x34, 0x5b, 0xfa, 0x2a, 0x93, 0x46, 0x42,
-	0x58, 0xf6, 0xfd, 0xb2, 0xef, 0x2f, 0xfa, 0xed, 0xc7, 0xb1, 0x94, 0x71, 0x42, 0x11, 0x56, 0x1c,
-	0x61, 0x21, 0xa4, 0xc1, 0x86, 0x4b, 0xa1, 0xf7, 0x0a, 0xef, 0x1d, 0x78, 0xf2, 0xb9, 0x00, 0x8c,
-	0xc5, 0x94, 0x4e, 0xc7, 0x4a, 0x12, 0x36, 0x34, 0x64, 0x42, 0x79, 0xcc, 0x4c, 0x40, 0xe7, 0x39,
-	0xd5, 0x06, 0x76, 0x40, 0x8b, 0x16, 0x8d, 0x50, 0xe4, 0xe9, 0x23, 0xa7, 0xeb, 0xf4, 0xee, 0x04,
-	0x4d, 0x5b, 0xf8, 0x94, 0xa7, 0xde, 0x17, 0xd0, 0x3d, 0xac, 0xd7, 0x4a, 0x0a, 0x4d, 0xe1, 0x6b,
-	0xf0, 0x30, 0x32, 0x24, 0x4c, 0x8a, 0x62, 0x48, 0x12, 0x4e, 0x85, 0x09, 0x99, 0xfd, 0xa5, 0xc4,
-	0x3d, 0x88, 0x0c, 0xf9, 0x58, 0x7c, 0x8f, 0x6c, 0x73, 0x2f, 0xf7, 0xde, 0x83, 0x67, 0x16, 0x1d,
-	0x50, 0x25, 0x33, 0x43, 0xa7, 0x23, 0x46, 0xc9, 0x4c, 0x49, 0x2e, 0x4c, 0xdd, 0x88, 0x64, 0xa6,
-	0x4c, 0xc8, 0xb0, 0x66, 0x96, 0xd9, 0x0a, 0x9a, 0x45, 0x61, 0x82, 0x35, 0xf3, 0x30, 0xe8, 0x1d,
-	0xe7, 0xdc, 0x68, 0xd4, 0x97, 0x3f, 0x6e, 0x83, 0xbb, 0xf6, 0x0c, 0xf8, 0xdb, 0x01, 0xf7, 0x6b,
-	0x76, 0x01, 0x07, 0xfe, 0xc5, 0x68, 0xfc, 0x23, 0x9b, 0x6f, 0xbf, 0xba, 0x9a, 0x68, 0xef, 0xc1,
-	0xf3, 0xbf, 0xff, 0x3b, 0xfd, 0x75, 0xab, 0x07, 0x9f, 0xa2, 0x9a, 0xdb, 0x62, 0x83, 0xd3, 0x68,
-	0x55, 0x25, 0xba, 0x86, 0x7f, 0x1d, 0xd0, 0xb9, 0x64, 0x37, 0xf0, 0xcd, 0xc1, 0x29, 0x8e, 0x27,
-	0xd3, 0x7e, 0x7b, 0x3d, 0x71, 0x69, 0x65, 0x60, 0xad, 0xbc, 0x80, 0xcf, 0xeb, 0xac, 0x90, 0x4a,
-	0xa8, 0xd1, 0xaa, 0x8a, 0x7f, 0x3d, 0xfc, 0xf0, 0x67, 0xeb, 0x3a, 0x9b, 0xad, 0xeb, 0x9c, 0x6c,
-	0x5d, 0xe7, 0xe7, 0xce, 0x6d, 0x6c, 0x76, 0x6e, 0xe3, 0xff, 0xce, 0x6d, 0x7c, 0x45, 0x31, 0x37,
-	0x2c, 0x8f, 0x7c, 0x22, 0xd3, 0x73, 0x20, 0x61, 0x98, 0x8b, 0x8a, 0xfe, 0xad, 0xe2, 0x9b, 0xa5,
-	0xa2, 0x3a, 0xba, 0x67, 0x1f, 0xc9, 0xe0, 0x2c, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x12, 0xa1, 0x9a,
-	0x78, 0x03, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x5d, 0x6b, 0xd4, 0x4c,
+	0x14, 0xde, 0xb4, 0xdd, 0x7d, 0xbb, 0x53, 0x78, 0xc1, 0xe9, 0x42, 0x43, 0x5a, 0xd3, 0x25, 0x42,
+	0xbb, 0x50, 0xcc, 0xd0, 0x5d, 0x45, 0xc5, 0x8f, 0x8b, 0x96, 0x4a, 0x85, 0xe2, 0x47, 0xbc, 0xd2,
+	0x9b, 0x65, 0x92, 0x0c, 0x49, 0x68, 0x76, 0x26, 0xcd, 0xcc, 0x16, 0x97, 0xd2, 0x1b, 0x7f, 0x81,
+	0xe0, 0x1f, 0xf1, 0x42, 0xfc, 0x01, 0x5e, 0xf5, 0x46, 0x28, 0x78, 0x23, 0x08, 0x22, 0xad, 0x3f,
+	0x44, 0x32, 0x99, 0x4d, 0xd5, 0x26, 0xfd, 0xd0, 0xbb, 0x9d, 0x73, 0xce, 0xf3, 0x9c, 0xe7, 0x3c,
+	0x93, 0x33, 0x0b, 0x4c, 0x17, 0xbb, 0xa3, 0x98, 0x51, 0x34, 0x60, 0x34, 0x12, 0x2c, 0x45, 0xbb,
+	0xab, 0x68, 0x67, 0x48, 0xd2, 0x91, 0x9d, 0xa4, 0x4c, 0x30, 0x08, 0x55, 0xde, 0x56, 0x79, 0x7b,
+	0x77, 0xd5, 0x68, 0x05, 0x2c, 0x60, 0x32, 0x8d, 0xb2, 0x5f, 0x79, 0xa5, 0xb1, 0x10, 0x30, 0x16,
+	0xc4, 0x04, 0xe1, 0x24, 0x42, 0x98, 0x52, 0x26, 0xb0, 0x88, 0x18, 0xe5, 0x2a, 0xdb, 0x2e, 0xe9,
+	0x33, 0xa6, 0xcc, 0x2b, 0x16, 0x4b, 0x2a, 0x12, 0x9c, 0xe2, 0x81, 0xa2, 0xb0, 0x5a, 0x00, 0x3e,
+	0xcb, 0x94, 0x3d, 0x95, 0x41, 0x87, 0xec, 0x0c, 0x09, 0x17, 0xd6, 0x13, 0x30, 0xfb, 0x5b, 0x94,
+	0x27, 0x8c, 0x72, 0x02, 0x6f, 0x83, 0x46, 0x0e, 0xd6, 0xb5, 0xb6, 0xd6, 0x99, 0xe9, 0x1a, 0xf6,
+	0xe9, 0x41, 0xec, 0x1c, 0xb3, 0x36, 0x75, 0xf0, 0x6d, 0xb1, 0xe6, 0xa8, 0x7a, 0xeb, 0x01, 0x58,
+	0x94, 0x84, 0x1b, 0xd4, 0x27, 0xfe, 0x46, 0xc2, 0xbc, 0x70, 0x4d, 0x78, 0x9b, 0x24, 0x0a, 0x42,
+	0xa1, 0x7a, 0xc2, 0x79, 0xd0, 0x24, 0x59, 0xa2, 0x4f, 0x87, 0x03, 0xc9, 0x3f, 0xe5, 0x4c, 0xcb,
+	0xc0, 0xe3, 0xe1, 0xc0, 0x7a, 0x01, 0xda, 0xd5, 0x78, 0xa5, 0xee, 0x26, 0x98, 0x73, 0x85, 0xd7,
+	0x8f, 0xb3, 0x60, 0xdf, 0x8b, 0x23, 0x42, 0x45, 0x3f, 0x94, 0x25, 0x8a, 0xae, 0xe5, 0x0a, 0x6f,
+	0x2b, 0x3b, 0xaf, 0xcb, 0x64, 0x0e, 0xb7, 0x1e, 0x82, 0x65, 0x49, 0xed, 0x90, 0x84, 0xa5, 0x82,
+	0xf8, 0xeb, 0x21, 0xf1, 0xb6, 0x13, 0x16, 0x51, 0x51, 0x26, 0xd1, 0xdb, 0x4e, 0x44, 0x3f, 0xc4,
+	0x3c, 0x94, 0x9c, 0x4d, 0x67, 0x3a, 0x0b, 0x6c, 0x62, 0x1e, 0x5a, 0x18, 0x74, 0xce, 0xe7, 0xf9,
+	0x37, 0xa9, 0xf7, 0x81, 0x29, 0x5b, 0x9c, 0x50, 0x6f, 0x45, 0xbb, 0x84, 0x12, 0xce, 0x2f, 0x64,
+	0xe2, 0x57, 0x0d, 0xcc, 0x9d, 0x40, 0x9f, 0x0b, 0x1c, 0x90, 0x31, 0x1e, 0xde, 0x01, 0x75, 0x9e,
+	0x05, 0x24, 0xe8, 0xff, 0xee, 0xb5, 0xb2, 0x9b, 0xfd, 0x03, 0xeb, 0xe4, 0x08, 0xa8, 0x83, 0xff,
+	0x52, 0x82, 0xbd, 0x90, 0xf8, 0xfa, 0x44, 0x5b, 0xeb, 0x4c, 0x3b, 0xe3, 0x23, 0xbc, 0x0a, 0x40,
+	0x36, 0xa6, 0x9a, 0x6c, 0x52, 0xca, 0x69, 0xba, 0x63, 0x37, 0x60, 0x0b, 0xd4, 0x7d, 0x12, 0xe3,
+	0x91, 0x3e, 0x25, 0x33, 0xf9, 0x01, 0x2e, 0x80, 0xa6, 0x08, 0x53, 0xc2, 0x43, 0x16, 0xfb, 0x7a,
+	0x3d, 0xc7, 0x14, 0x81, 0xac, 0x19, 0x8e, 0x71, 0x3a, 0x20, 0xbe, 0xde, 0xc8, 0x9b, 0xa9, 0xa3,
+	0xf5, 0x5e, 0x53, 0xdf, 0x58, 0x99, 0x3b, 0xca, 0xf7, 0xb3, 0xec, 0x81, 0x08, 0xb4, 0xf2, 0x24,
+	0xa1, 0x7e, 0xff, 0x17, 0xdd, 0x13, 0xb2, 0xee, 0x8a, 0xcc, 0x6d, 0x50, 0xbf, 0xb8, 0x4d, 0xb8,
+	0x0e, 0x1a, 0xd2, 0x01, 0xae, 0x4f, 0xb6, 0x27, 0x3b, 0x33, 0xdd, 0x95, 0x0b, 0x98, 0x56, 0x48,
+	0x52, 0xd0, 0xee, 0xc7, 0x3a, 0xa8, 0x4b, 0xd9, 0x70, 0x1f, 0x34, 0xf2, 0xdd, 0x81, 0x4b, 0x65,
+	0x44, 0xa7, 0xd7, 0xd4, 0x58, 0x3e, 0xb7, 0x2e, 0x9f, 0xdb, 0xb2, 0x5e, 0x7f, 0xfe, 0xf1, 0x76,
+	0x62, 0x01, 0x1a, 0xa8, 0xf2, 0x3d, 0x80, 0xef, 0x34, 0x30, 0x5b, 0xb2, 0x5e, 0xb0, 0x57, 0xd9,
+	0xa4, 0x7a, 0x99, 0x8d, 0x1b, 0x97, 0x03, 0x29, 0x99, 0xb6, 0x94, 0xd9, 0x81, 0x4b, 0x65, 0x32,
+	0xa5, 0xff, 0x1c, 0xed, 0x15, 0x17, 0xb8, 0x0f, 0x3f, 0x69, 0x60, 0xfe, 0x8c, 0x75, 0x83, 0x77,
+	0x2b, 0x55, 0x9c, 0xbf, 0xec, 0xc6, 0xbd, 0xbf, 0x03, 0xab, 0x51, 0x7a, 0x72, 0x94, 0xeb, 0x70,
+	0xa5, 0x6c, 0x14, 0xaf, 0x00, 0x72, 0xb4, 0x57, 0xbc, 0x28, 0xfb, 0xf0, 0x83, 0x06, 0xe0, 0xe9,
+	0xaf, 0x17, 0x76, 0x2b, 0x95, 0x54, 0x3e, 0x04, 0x46, 0xef, 0x52, 0x18, 0x25, 0xfa, 0x96, 0x14,
+	0xbd, 0x0a, 0xd1, 0xc5, 0xfc, 0x47, 0xb1, 0x22, 0x58, 0x7b, 0x74, 0x70, 0x64, 0x6a, 0x87, 0x47,
+	0xa6, 0xf6, 0xfd, 0xc8, 0xd4, 0xde, 0x1c, 0x9b, 0xb5, 0xc3, 0x63, 0xb3, 0xf6, 0xe5, 0xd8, 0xac,
+	0xbd, 0x44, 0x41, 0x24, 0xc2, 0xa1, 0x6b, 0x7b, 0x6c, 0x30, 0x26, 0xf5, 0x42, 0x1c, 0xd1, 0xa2,
+	0xc3, 0xab, 0xa2, 0x87, 0x18, 0x25, 0x84, 0xbb, 0x0d, 0xf9, 0xbf, 0xd4, 0xfb, 0x39, 0x00, 0xef,
+	0x13, 0x72, 0x40, 0x44, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -270,12 +582,17 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EndedEpochBtcHeight returns the BTC light client height at provided epoch
 	// finish
 	EndedEpochBtcHeight(ctx context.Context, in *QueryEndedEpochBtcHeightRequest, opts ...grpc.CallOption) (*QueryEndedEpochBtcHeightResponse, error)
 	// ReportedCheckpointBtcHeight returns the BTC light client height at which
 	// the checkpoint with the given hash is reported back to Babylon
 	ReportedCheckpointBtcHeight(ctx context.Context, in *QueryReportedCheckpointBtcHeightRequest, opts ...grpc.CallOption) (*QueryReportedCheckpointBtcHeightResponse, error)
+	// CheckpointLiveness returns the BTC-block delays between the given epoch
+	// ending and its checkpoint reaching each stage
+	CheckpointLiveness(ctx context.Context, in *QueryCheckpointLivenessRequest, opts ...grpc.CallOption) (*QueryCheckpointLivenessResponse, error)
 }
 
 type queryClient struct {
@@ -286,6 +603,15 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EndedEpochBtcHeight(ctx context.Context, in *QueryEndedEpochBtcHeightRequest, opts ...grpc.CallOption) (*QueryEndedEpochBtcHeightResponse, error) {
 	out := new(QueryEndedEpochBtcHeightResponse)
 	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/EndedEpochBtcHeight", in, out, opts...)
@@ -304,31 +630,69 @@ func (c *queryClient) ReportedCheckpointBtcHeight(ctx context.Context, in *Query
 	return out, nil
 }
 
+func (c *queryClient) CheckpointLiveness(ctx context.Context, in *QueryCheckpointLivenessRequest, opts ...grpc.CallOption) (*QueryCheckpointLivenessResponse, error) {
+	out := new(QueryCheckpointLivenessResponse)
+	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/CheckpointLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EndedEpochBtcHeight returns the BTC light client height at provided epoch
 	// finish
 	EndedEpochBtcHeight(context.Context, *QueryEndedEpochBtcHeightRequest) (*QueryEndedEpochBtcHeightResponse, error)
 	// ReportedCheckpointBtcHeight returns the BTC light client height at which
 	// the checkpoint with the given hash is reported back to Babylon
 	ReportedCheckpointBtcHeight(context.Context, *QueryReportedCheckpointBtcHeightRequest) (*QueryReportedCheckpointBtcHeightResponse, error)
+	// CheckpointLiveness returns the BTC-block delays between the given epoch
+	// ending and its checkpoint reaching each stage
+	CheckpointLiveness(context.Context, *QueryCheckpointLivenessRequest) (*QueryCheckpointLivenessResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServer) EndedEpochBtcHeight(ctx context.Context, req *QueryEndedEpochBtcHeightRequest) (*QueryEndedEpochBtcHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EndedEpochBtcHeight not implemented")
 }
 func (*UnimplementedQueryServer) ReportedCheckpointBtcHeight(ctx context.Context, req *QueryReportedCheckpointBtcHeightRequest) (*QueryReportedCheckpointBtcHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportedCheckpointBtcHeight not implemented")
 }
+func (*UnimplementedQueryServer) CheckpointLiveness(ctx context.Context, req *QueryCheckpointLivenessRequest) (*QueryCheckpointLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointLiveness not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.monitor.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EndedEpochBtcHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryEndedEpochBtcHeightRequest)
 	if err := dec(in); err != nil {
@@ -365,10 +729,32 @@ func _Query_ReportedCheckpointBtcHeight_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CheckpointLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.monitor.v1.Query/CheckpointLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointLiveness(ctx, req.(*QueryCheckpointLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.monitor.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "EndedEpochBtcHeight",
 			Handler:    _Query_EndedEpochBtcHeight_Handler,
@@ -377,11 +763,71 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportedCheckpointBtcHeight",
 			Handler:    _Query_ReportedCheckpointBtcHeight_Handler,
 		},
+		{
+			MethodName: "CheckpointLiveness",
+			Handler:    _Query_CheckpointLiveness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/monitor/v1/query.proto",
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryEndedEpochBtcHeightRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -496,6 +942,144 @@ func (m *QueryReportedCheckpointBtcHeightResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCheckpointLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointStageLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointStageLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointStageLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Alarmed {
+		i--
+		if m.Alarmed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Delay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Delay))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reached {
+		i--
+		if m.Reached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Stage != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stages) > 0 {
+		for iNdEx := len(m.Stages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EpochEndBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochEndBtcHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -507,6 +1091,26 @@ func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryEndedEpochBtcHeightRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -556,13 +1160,73 @@ func (m *QueryReportedCheckpointBtcHeightResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCheckpointLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *CheckpointStageLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stage != 0 {
+		n += 1 + sovQuery(uint64(m.Stage))
+	}
+	if m.Reached {
+		n += 2
+	}
+	if m.BtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcHeight))
+	}
+	if m.Delay != 0 {
+		n += 1 + sovQuery(uint64(m.Delay))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovQuery(uint64(m.Threshold))
+	}
+	if m.Alarmed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCheckpointLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.EpochEndBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EpochEndBtcHeight))
+	}
+	if len(m.Stages) > 0 {
+		for _, e := range m.Stages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -585,31 +1249,12 @@ func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEndedEpochBtcHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEndedEpochBtcHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
-			}
-			m.EpochNum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -631,7 +1276,7 @@ func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -654,17 +1299,17 @@ func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEndedEpochBtcHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEndedEpochBtcHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.BtcLightClientHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -674,11 +1319,25 @@ func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcLightClientHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -700,7 +1359,7 @@ func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -723,17 +1382,17 @@ func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEndedEpochBtcHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEndedEpochBtcHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CkptHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			var stringLen uint64
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -743,24 +1402,11 @@ func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CkptHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -782,7 +1428,7 @@ func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReportedCheckpointBtcHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -805,10 +1451,10 @@ func (m *QueryReportedCheckpointBtcHeightResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEndedEpochBtcHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEndedEpochBtcHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -851,6 +1497,514 @@ func (m *QueryReportedCheckpointBtcHeightResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CkptHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CkptHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportedCheckpointBtcHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+			}
+			m.BtcLightClientHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcLightClientHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointLivenessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointLivenessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointStageLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointStageLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointStageLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= CheckpointStage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reached = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			}
+			m.BtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			m.Delay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alarmed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Alarmed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointLivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointLivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEndBtcHeight", wireType)
+			}
+			m.EpochEndBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochEndBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stages = append(m.Stages, &CheckpointStageLiveness{})
+			if err := m.Stages[len(m.Stages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17,7 +17,9 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	return nil
 }
 
+// EndBlocker resends BTC timestamps queued in each channel
 func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+	k.ResendPendingBTCTimestamps(ctx)
 	return []abci.ValidatorUpdate{}, nil
 }
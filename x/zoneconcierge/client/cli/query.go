@@ -26,6 +26,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdChainsInfo())
 	cmd.AddCommand(CmdFinalizedChainsInfo())
 	cmd.AddCommand(CmdEpochChainsInfoInfo())
+	cmd.AddCommand(CmdConsumerRegistryList())
+	cmd.AddCommand(CmdConsumerRegistry())
+	cmd.AddCommand(CmdBTCTimestampDelivery())
 	return cmd
 }
 
@@ -103,3 +106,74 @@ func CmdEpochChainsInfoInfo() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdConsumerRegistryList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-registry-list",
+		Short: "retrieve the list of registered consumer chains",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := types.QueryConsumerRegistryListRequest{Pagination: pageReq}
+			resp, err := queryClient.ConsumerRegistryList(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumer-registry-list")
+	return cmd
+}
+
+func CmdConsumerRegistry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-registry <chain-id>",
+		Short: "retrieve the registration of a consumer chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			req := types.QueryConsumerRegistryRequest{ChainId: args[0]}
+			resp, err := queryClient.ConsumerRegistry(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBTCTimestampDelivery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-timestamp-delivery",
+		Short: "retrieve the delivery lag and pending epochs of BTC timestamps for each consumer channel",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.BTCTimestampDelivery(cmd.Context(), &types.QueryBTCTimestampDeliveryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// GetBTCTimestampDeliveryState returns the state of delivering BTC timestamps
+// through the given channel
+func (k Keeper) GetBTCTimestampDeliveryState(ctx context.Context, channelID string) *types.BTCTimestampDeliveryState {
+	stateBytes := k.btcTimestampDeliveryStore(ctx).Get([]byte(channelID))
+	if stateBytes == nil {
+		return &types.BTCTimestampDeliveryState{}
+	}
+	var state types.BTCTimestampDeliveryState
+	k.cdc.MustUnmarshal(stateBytes, &state)
+	return &state
+}
+
+func (k Keeper) setBTCTimestampDeliveryState(ctx context.Context, channelID string, state *types.BTCTimestampDeliveryState) {
+	k.btcTimestampDeliveryStore(ctx).Set([]byte(channelID), k.cdc.MustMarshal(state))
+}
+
+// recordBTCTimestampSent records that the BTC timestamp of the given epoch is
+// sent through the given channel
+func (k Keeper) recordBTCTimestampSent(ctx context.Context, channelID string, epoch uint64) {
+	state := k.GetBTCTimestampDeliveryState(ctx, channelID)
+	state.RecordSent(epoch)
+	k.setBTCTimestampDeliveryState(ctx, channelID, state)
+}
+
+// enqueueBTCTimestamp queues the BTC timestamp of the given epoch for being
+// resent through the given channel after a failed delivery. Each failed
+// delivery of the same epoch backs off its next resend exponentially
+func (k Keeper) enqueueBTCTimestamp(ctx context.Context, channelID string, epoch uint64) {
+	state := k.GetBTCTimestampDeliveryState(ctx, channelID)
+	if dropped := state.AddPendingEpoch(epoch, sdk.UnwrapSDKContext(ctx).HeaderInfo().Height); dropped != 0 {
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Error("BTC timestamp resend queue is full, drop the earliest epoch", "channelID", channelID, "epoch", dropped)
+	}
+	k.setBTCTimestampDeliveryState(ctx, channelID, state)
+}
+
+// dropPendingBTCTimestamp removes the BTC timestamp of the given epoch from
+// the resend queue of the given channel
+func (k Keeper) dropPendingBTCTimestamp(ctx context.Context, channelID string, epoch uint64) {
+	state := k.GetBTCTimestampDeliveryState(ctx, channelID)
+	state.RemovePendingEpoch(epoch)
+	k.setBTCTimestampDeliveryState(ctx, channelID, state)
+}
+
+// HandleBTCTimestampAcknowledgement handles the acknowledgement of the BTC
+// timestamp of the given epoch sent through the given channel. A BTC timestamp
+// acknowledged with an error is queued for being resent
+func (k Keeper) HandleBTCTimestampAcknowledgement(ctx context.Context, channelID string, epoch uint64, success bool) {
+	if !success {
+		k.enqueueBTCTimestamp(ctx, channelID, epoch)
+		return
+	}
+	state := k.GetBTCTimestampDeliveryState(ctx, channelID)
+	state.RecordAcked(epoch)
+	k.setBTCTimestampDeliveryState(ctx, channelID, state)
+}
+
+// ResendPendingBTCTimestamps resends BTC timestamps queued in each channel
+// whose backoff has elapsed, up to MaxResentBTCTimestampsPerChannel BTC
+// timestamps per channel. Channels whose consumer chain is not registered are
+// skipped. Pending BTC timestamps of a channel that is no longer open are
+// moved to an open channel of the same consumer chain, if any
+func (k Keeper) ResendPendingBTCTimestamps(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.HeaderInfo().Height
+
+	// collect channels with pending BTC timestamps
+	pendingChannelIDs := []string{}
+	iter := k.btcTimestampDeliveryStore(ctx).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var state types.BTCTimestampDeliveryState
+		k.cdc.MustUnmarshal(iter.Value(), &state)
+		if len(state.PendingBtcTimestamps) > 0 {
+			pendingChannelIDs = append(pendingChannelIDs, string(iter.Key()))
+		}
+	}
+	iter.Close()
+	if len(pendingChannelIDs) == 0 {
+		return
+	}
+
+	openChannels := map[string]channeltypes.IdentifiedChannel{}
+	for _, channel := range k.GetAllOpenZCChannels(ctx) {
+		openChannels[channel.ChannelId] = channel
+	}
+
+	for _, channelID := range pendingChannelIDs {
+		channel, ok := openChannels[channelID]
+		if !ok {
+			k.migratePendingBTCTimestamps(ctx, channelID, openChannels)
+			continue
+		}
+		if _, err := k.getConsumerOfChannel(ctx, channel); err != nil {
+			// the consumer chain is not registered or not bound to this
+			// channel, so keep its BTC timestamps until it is
+			continue
+		}
+		state := k.GetBTCTimestampDeliveryState(ctx, channelID)
+		for _, epoch := range state.GetDueEpochs(height, types.MaxResentBTCTimestampsPerChannel) {
+			btcTimestamp, err := k.generateBTCTimestamp(ctx, channel, epoch)
+			if err != nil {
+				// the failure is deterministic, e.g., the consumer chain has
+				// no chain info at this epoch, so the BTC timestamp is dropped
+				// rather than being retried forever
+				k.Logger(sdkCtx).Error("failed to generate BTC timestamp, drop it from the resend queue", "channelID", channelID, "epoch", epoch, "error", err)
+				k.dropPendingBTCTimestamp(ctx, channelID, epoch)
+				continue
+			}
+			if err := k.SendIBCPacket(ctx, channel, types.NewBTCTimestampPacketData(btcTimestamp)); err != nil {
+				// count the failure as an attempt, so that the BTC timestamp
+				// is retried after a longer backoff
+				k.Logger(sdkCtx).Error("failed to resend BTC timestamp", "channelID", channelID, "epoch", epoch, "error", err)
+				k.enqueueBTCTimestamp(ctx, channelID, epoch)
+				continue
+			}
+			k.recordBTCTimestampSent(ctx, channelID, epoch)
+		}
+	}
+}
+
+// migratePendingBTCTimestamps moves the pending BTC timestamps of the given
+// channel that is no longer open to an open channel of the same consumer
+// chain. The pending BTC timestamps are kept if there is no such channel yet
+func (k Keeper) migratePendingBTCTimestamps(ctx context.Context, channelID string, openChannels map[string]channeltypes.IdentifiedChannel) {
+	chainID, _, err := k.getChainID(ctx, channeltypes.IdentifiedChannel{PortId: k.GetPort(ctx), ChannelId: channelID})
+	if err != nil {
+		return
+	}
+	for _, channel := range openChannels {
+		consumer, err := k.getConsumerOfChannel(ctx, channel)
+		if err != nil || consumer.ChainId != chainID {
+			continue
+		}
+		state := k.GetBTCTimestampDeliveryState(ctx, channelID)
+		newState := k.GetBTCTimestampDeliveryState(ctx, channel.ChannelId)
+		for _, dropped := range newState.MovePendingBTCTimestamps(state) {
+			k.Logger(sdk.UnwrapSDKContext(ctx)).Error("BTC timestamp resend queue is full, drop the earliest epoch", "channelID", channel.ChannelId, "epoch", dropped)
+		}
+		k.setBTCTimestampDeliveryState(ctx, channel.ChannelId, newState)
+		k.setBTCTimestampDeliveryState(ctx, channelID, state)
+		return
+	}
+}
+
+// generateBTCTimestamp generates the BTC timestamp of the given finalized
+// epoch for the consumer chain under the given channel. The BTC timestamp
+// carries the last w+1 BTC headers, which are guaranteed to connect with the
+// consumer chain's BTC light client
+func (k Keeper) generateBTCTimestamp(ctx context.Context, channel channeltypes.IdentifiedChannel, epoch uint64) (*types.BTCTimestamp, error) {
+	consumer, err := k.getConsumerOfChannel(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+	finalizedInfo, err := k.getFinalizedInfo(ctx, epoch, k.getDeepEnoughBTCHeaders(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate metadata of epoch %d: %w", epoch, err)
+	}
+	return k.createBTCTimestamp(ctx, consumer.ChainId, channel, finalizedInfo)
+}
+
+// btcTimestampDeliveryStore returns the KVStore of the state of delivering
+// BTC timestamps through each channel
+// prefix: BTCTimestampDeliveryKey
+// key: channel ID
+// value: BTCTimestampDeliveryState
+func (k Keeper) btcTimestampDeliveryStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCTimestampDeliveryKey)
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzBTCTimestampDelivery(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		lastFinalizedEpoch := datagen.RandomInt(r, 100) + 10
+		checkpointingKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		checkpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(lastFinalizedEpoch).AnyTimes()
+		zcKeeper, ctx := testkeeper.ZoneConciergeKeeper(t, nil, checkpointingKeeper, nil, nil)
+
+		channelID := "channel-" + datagen.GenRandomHexStr(r, 4)
+		require.Equal(t, &types.BTCTimestampDeliveryState{}, zcKeeper.GetBTCTimestampDeliveryState(ctx, channelID))
+
+		// BTC timestamps acknowledged with errors are queued for resending
+		numFailed := datagen.RandomInt(r, 5) + 1
+		for epoch := uint64(1); epoch <= numFailed; epoch++ {
+			zcKeeper.HandleBTCTimestampAcknowledgement(ctx, channelID, epoch, false)
+		}
+		state := zcKeeper.GetBTCTimestampDeliveryState(ctx, channelID)
+		require.Len(t, state.PendingBtcTimestamps, int(numFailed))
+		require.Zero(t, state.LastAckedEpoch)
+
+		// another failure of a queued BTC timestamp backs off its resend
+		height := ctx.HeaderInfo().Height
+		zcKeeper.HandleBTCTimestampAcknowledgement(ctx, channelID, 1, false)
+		state = zcKeeper.GetBTCTimestampDeliveryState(ctx, channelID)
+		require.Len(t, state.PendingBtcTimestamps, int(numFailed))
+		require.Equal(t, uint32(2), state.PendingBtcTimestamps[0].Attempts)
+		require.Equal(t, height+types.BTCTimestampResendBackoff(2), state.PendingBtcTimestamps[0].NextResendHeight)
+
+		// a successful acknowledgement removes the epoch from the queue
+		zcKeeper.HandleBTCTimestampAcknowledgement(ctx, channelID, numFailed, true)
+		state = zcKeeper.GetBTCTimestampDeliveryState(ctx, channelID)
+		require.Len(t, state.PendingBtcTimestamps, int(numFailed)-1)
+		require.NotContains(t, state.GetPendingEpochs(), numFailed)
+		require.Equal(t, numFailed, state.LastAckedEpoch)
+
+		// pending BTC timestamps of a channel that is not open are kept until
+		// the consumer chain has another open channel
+		zcKeeper.ResendPendingBTCTimestamps(ctx)
+		require.Equal(t, state, zcKeeper.GetBTCTimestampDeliveryState(ctx, channelID))
+
+		// the delivery status only covers open channels
+		resp, err := zcKeeper.BTCTimestampDelivery(ctx, &types.QueryBTCTimestampDeliveryRequest{})
+		require.NoError(t, err)
+		require.Equal(t, lastFinalizedEpoch, resp.LastFinalizedEpoch)
+		require.Empty(t, resp.Channels)
+	})
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// AddConsumer registers the given consumer chain, or overwrites the
+// registration of the consumer chain if it is registered already
+func (k Keeper) AddConsumer(ctx context.Context, consumer *types.ConsumerMetadata) error {
+	if err := consumer.Validate(); err != nil {
+		return err
+	}
+	k.setConsumerMetadata(ctx, consumer)
+	return nil
+}
+
+// RemoveConsumer deregisters the consumer chain with the given chain ID,
+// after which Babylon stops tracking its headers and sending BTC timestamps to it
+func (k Keeper) RemoveConsumer(ctx context.Context, chainID string) error {
+	store := k.consumerMetadataStore(ctx)
+	if !store.Has([]byte(chainID)) {
+		return types.ErrConsumerNotRegistered.Wrapf("chain ID %s", chainID)
+	}
+	store.Delete([]byte(chainID))
+	return nil
+}
+
+// IsConsumerRegistered checks whether the consumer chain with the given chain
+// ID is registered
+func (k Keeper) IsConsumerRegistered(ctx context.Context, chainID string) bool {
+	return k.consumerMetadataStore(ctx).Has([]byte(chainID))
+}
+
+// GetConsumerMetadata returns the registration of the consumer chain with the
+// given chain ID, or nil if the consumer chain is not registered
+func (k Keeper) GetConsumerMetadata(ctx context.Context, chainID string) *types.ConsumerMetadata {
+	metadataBytes := k.consumerMetadataStore(ctx).Get([]byte(chainID))
+	if metadataBytes == nil {
+		return nil
+	}
+	var metadata types.ConsumerMetadata
+	k.cdc.MustUnmarshal(metadataBytes, &metadata)
+	return &metadata
+}
+
+// GetAllConsumers returns the registrations of all consumer chains
+func (k Keeper) GetAllConsumers(ctx context.Context) []*types.ConsumerMetadata {
+	consumers := []*types.ConsumerMetadata{}
+	iter := k.consumerMetadataStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var metadata types.ConsumerMetadata
+		k.cdc.MustUnmarshal(iter.Value(), &metadata)
+		consumers = append(consumers, &metadata)
+	}
+	return consumers
+}
+
+// isConsumerClientAllowed checks whether the headers of the given chain from
+// the given IBC light client are tracked, i.e., whether the chain is a
+// registered consumer chain and the IBC light client is allowed for it
+func (k Keeper) isConsumerClientAllowed(ctx context.Context, chainID string, clientID string) bool {
+	consumer := k.GetConsumerMetadata(ctx, chainID)
+	return consumer != nil && consumer.IsClientAllowed(clientID)
+}
+
+// getConsumerOfChannel returns the registered consumer chain at the other end
+// of the given channel, or an error if the counterparty chain is not registered
+// or the channel is not allowed for it
+func (k Keeper) getConsumerOfChannel(ctx context.Context, channel channeltypes.IdentifiedChannel) (*types.ConsumerMetadata, error) {
+	chainID, clientID, err := k.getChainID(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+	consumer := k.GetConsumerMetadata(ctx, chainID)
+	if consumer == nil {
+		return nil, types.ErrConsumerNotRegistered.Wrapf("chain ID %s", chainID)
+	}
+	if !consumer.IsChannelAllowed(clientID, channel.ChannelId) {
+		return nil, types.ErrConsumerNotAllowed.Wrapf("chain %s, client %s, channel %s", chainID, clientID, channel.ChannelId)
+	}
+	return consumer, nil
+}
+
+func (k Keeper) setConsumerMetadata(ctx context.Context, consumer *types.ConsumerMetadata) {
+	k.consumerMetadataStore(ctx).Set([]byte(consumer.ChainId), k.cdc.MustMarshal(consumer))
+}
+
+// consumerMetadataStore returns the KVStore of the registration of each
+// consumer chain
+// prefix: ConsumerMetadataKey
+// key: chain ID
+// value: ConsumerMetadata
+func (k Keeper) consumerMetadataStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.ConsumerMetadataKey)
+}
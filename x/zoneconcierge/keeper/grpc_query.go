@@ -33,10 +33,16 @@ func (k Keeper) ChainList(c context.Context, req *types.QueryChainListRequest) (
 
 	chainIDs := []string{}
 	store := k.chainInfoStore(ctx)
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
 		chainID := string(key)
-		chainIDs = append(chainIDs, chainID)
-		return nil
+		// only list chains that are registered consumers
+		if !k.IsConsumerRegistered(ctx, chainID) {
+			return false, nil
+		}
+		if accumulate {
+			chainIDs = append(chainIDs, chainID)
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -49,6 +55,52 @@ func (k Keeper) ChainList(c context.Context, req *types.QueryChainListRequest) (
 	return resp, nil
 }
 
+// ConsumerRegistryList returns the registered consumer chains, with pagination support
+func (k Keeper) ConsumerRegistryList(c context.Context, req *types.QueryConsumerRegistryListRequest) (*types.QueryConsumerRegistryListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	consumers := []*types.ConsumerMetadata{}
+	store := k.consumerMetadataStore(ctx)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var consumer types.ConsumerMetadata
+		k.cdc.MustUnmarshal(value, &consumer)
+		consumers = append(consumers, &consumer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &types.QueryConsumerRegistryListResponse{
+		Consumers:  consumers,
+		Pagination: pageRes,
+	}
+	return resp, nil
+}
+
+// ConsumerRegistry returns the registration of a consumer chain
+func (k Keeper) ConsumerRegistry(c context.Context, req *types.QueryConsumerRegistryRequest) (*types.QueryConsumerRegistryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.ChainId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "chain ID cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	consumer := k.GetConsumerMetadata(ctx, req.ChainId)
+	if consumer == nil {
+		return nil, status.Error(codes.NotFound, types.ErrConsumerNotRegistered.Wrapf("chain ID %s", req.ChainId).Error())
+	}
+
+	return &types.QueryConsumerRegistryResponse{Consumer: consumer}, nil
+}
+
 // ChainsInfo returns the latest info for a given list of chains
 func (k Keeper) ChainsInfo(c context.Context, req *types.QueryChainsInfoRequest) (*types.QueryChainsInfoResponse, error) {
 	if req == nil {
@@ -73,6 +125,10 @@ func (k Keeper) ChainsInfo(c context.Context, req *types.QueryChainsInfoRequest)
 	ctx := sdk.UnwrapSDKContext(c)
 	var chainsInfo []*types.ChainInfo
 	for _, chainID := range req.ChainIds {
+		if !k.IsConsumerRegistered(ctx, chainID) {
+			return nil, status.Error(codes.InvalidArgument, types.ErrConsumerNotRegistered.Wrapf("chain ID %s", chainID).Error())
+		}
+
 		chainInfo, err := k.GetChainInfo(ctx, chainID)
 		if err != nil {
 			return nil, err
@@ -135,6 +191,9 @@ func (k Keeper) EpochChainsInfo(c context.Context, req *types.QueryEpochChainsIn
 	var chainsInfo []*types.ChainInfo
 	for _, chainID := range req.ChainIds {
 		// check if chain ID is valid
+		if !k.IsConsumerRegistered(ctx, chainID) {
+			return nil, status.Error(codes.InvalidArgument, types.ErrConsumerNotRegistered.Wrapf("chain ID %s", chainID).Error())
+		}
 		if !k.HasChainInfo(ctx, chainID) {
 			return nil, status.Error(codes.InvalidArgument, types.ErrChainInfoNotFound.Wrapf("chain ID %s", chainID).Error())
 		}
@@ -241,6 +300,9 @@ func (k Keeper) FinalizedChainsInfo(c context.Context, req *types.QueryFinalized
 	lastFinalizedEpoch := k.GetLastFinalizedEpoch(ctx)
 	for _, chainID := range req.ChainIds {
 		// check if chain ID is valid
+		if !k.IsConsumerRegistered(ctx, chainID) {
+			return nil, status.Error(codes.InvalidArgument, types.ErrConsumerNotRegistered.Wrapf("chain ID %s", chainID).Error())
+		}
 		if !k.HasChainInfo(ctx, chainID) {
 			return nil, status.Error(codes.InvalidArgument, types.ErrChainInfoNotFound.Wrapf("chain ID %s", chainID).Error())
 		}
@@ -314,6 +376,9 @@ func (k Keeper) FinalizedChainInfoUntilHeight(c context.Context, req *types.Quer
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	if !k.IsConsumerRegistered(ctx, req.ChainId) {
+		return nil, status.Error(codes.InvalidArgument, types.ErrConsumerNotRegistered.Wrapf("chain ID %s", req.ChainId).Error())
+	}
 	resp := &types.QueryFinalizedChainInfoUntilHeightResponse{}
 
 	// find the last finalised epoch
@@ -401,3 +466,37 @@ func (k Keeper) FinalizedChainInfoUntilHeight(c context.Context, req *types.Quer
 
 	return resp, nil
 }
+
+// BTCTimestampDelivery returns the state of delivering BTC timestamps through
+// each open ZoneConcierge channel
+func (k Keeper) BTCTimestampDelivery(c context.Context, req *types.QueryBTCTimestampDeliveryRequest) (*types.QueryBTCTimestampDeliveryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	lastFinalizedEpoch := k.GetLastFinalizedEpoch(ctx)
+	resp := &types.QueryBTCTimestampDeliveryResponse{
+		LastFinalizedEpoch: lastFinalizedEpoch,
+		Channels:           []*types.ChannelDeliveryStatus{},
+	}
+
+	for _, channel := range k.GetAllOpenZCChannels(ctx) {
+		state := k.GetBTCTimestampDeliveryState(ctx, channel.ChannelId)
+		channelStatus := &types.ChannelDeliveryStatus{
+			ChannelId:      channel.ChannelId,
+			LastSentEpoch:  state.LastSentEpoch,
+			LastAckedEpoch: state.LastAckedEpoch,
+			PendingEpochs:  state.GetPendingEpochs(),
+		}
+		if consumer, err := k.getConsumerOfChannel(ctx, channel); err == nil {
+			channelStatus.ChainId = consumer.ChainId
+		}
+		if lastFinalizedEpoch > state.LastAckedEpoch {
+			channelStatus.Lag = lastFinalizedEpoch - state.LastAckedEpoch
+		}
+		resp.Channels = append(resp.Channels, channelStatus)
+	}
+
+	return resp, nil
+}
@@ -46,12 +46,27 @@ func FuzzChainList(f *testing.F) {
 			} else {
 				chainID = datagen.GenRandomHexStr(r, 30)
 				allChainIDs = append(allChainIDs, chainID)
+				RegisterConsumer(ctx, &zcKeeper, chainID, "")
 			}
 			header := datagen.GenRandomIBCTMHeader(r, chainID, 0)
 			zcKeeper.HandleHeaderWithValidCommit(ctx, datagen.GenRandomByteArray(r, 32), datagen.HeaderToHeaderInfo(header), false)
 		}
 
-		limit := datagen.RandomInt(r, len(allChainIDs)) + 1
+		// a chain that is no longer a registered consumer is not listed
+		deregisteredChainID := allChainIDs[r.Intn(len(allChainIDs))]
+		err := zcKeeper.RemoveConsumer(ctx, deregisteredChainID)
+		require.NoError(t, err)
+		registeredChainIDs := []string{}
+		for _, chainID := range zcKeeper.GetAllChainIDs(ctx) {
+			if chainID != deregisteredChainID {
+				registeredChainIDs = append(registeredChainIDs, chainID)
+			}
+		}
+		if len(registeredChainIDs) == 0 {
+			return
+		}
+
+		limit := datagen.RandomInt(r, len(registeredChainIDs)) + 1
 
 		// make query to get actual chain IDs
 		resp, err := zcKeeper.ChainList(ctx, &zctypes.QueryChainListRequest{
@@ -63,9 +78,8 @@ func FuzzChainList(f *testing.F) {
 		actualChainIDs := resp.ChainIds
 
 		require.Equal(t, limit, uint64(len(actualChainIDs)))
-		allChainIDs = zcKeeper.GetAllChainIDs(ctx)
 		for i := uint64(0); i < limit; i++ {
-			require.Equal(t, allChainIDs[i], actualChainIDs[i])
+			require.Equal(t, registeredChainIDs[i], actualChainIDs[i])
 		}
 	})
 }
@@ -82,6 +82,10 @@ func (d *IBCHeaderDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, su
 		if headerInfo == nil {
 			continue
 		}
+		// only track headers of registered consumer chains from allowed clients
+		if !d.k.isConsumerClientAllowed(ctx, headerInfo.ChainId, headerInfo.ClientId) {
+			continue
+		}
 
 		// FrozenHeight is non-zero -> client is frozen -> this is a fork header
 		// NOTE: A valid tx can ONLY have a single fork header msg, and this fork
@@ -51,11 +51,12 @@ func (k Keeper) SendIBCPacket(ctx context.Context, channel channeltypes.Identifi
 
 	// send packet
 	if err != nil {
-		// Failed/timeout packet should not make the system crash
+		// Failed/timeout packet should not make the system crash, but the
+		// caller needs to know the packet is not sent
 		k.Logger(sdkCtx).Error(fmt.Sprintf("failed to send IBC packet (sequence number: %d) to channel %v port %s: %v", seq, destinationChannel, destinationPort, err))
-	} else {
-		k.Logger(sdkCtx).Info(fmt.Sprintf("successfully sent IBC packet (sequence number: %d) to channel %v port %s", seq, destinationChannel, destinationPort))
+		return err
 	}
+	k.Logger(sdkCtx).Info(fmt.Sprintf("successfully sent IBC packet (sequence number: %d) to channel %v port %s", seq, destinationChannel, destinationPort))
 
 	// metrics stuff
 	labels := []metrics.Label{
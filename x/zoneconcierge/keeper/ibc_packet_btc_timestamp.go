@@ -27,21 +27,22 @@ type finalizedInfo struct {
 	BTCHeaders          []*btclctypes.BTCHeaderInfo
 }
 
-// getChainID gets the ID of the counterparty chain under the given channel
-func (k Keeper) getChainID(ctx context.Context, channel channeltypes.IdentifiedChannel) (string, error) {
+// getChainID gets the ID of the counterparty chain under the given channel,
+// together with the ID of the IBC light client of the channel
+func (k Keeper) getChainID(ctx context.Context, channel channeltypes.IdentifiedChannel) (string, string, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	// get clientState under this channel
-	_, clientState, err := k.channelKeeper.GetChannelClientState(sdkCtx, channel.PortId, channel.ChannelId)
+	clientID, clientState, err := k.channelKeeper.GetChannelClientState(sdkCtx, channel.PortId, channel.ChannelId)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	// cast clientState to comet clientState
 	// TODO: support for chains other than Cosmos zones
 	cmtClientState, ok := clientState.(*ibctmtypes.ClientState)
 	if !ok {
-		return "", fmt.Errorf("client must be a Comet client, expected: %T, got: %T", &ibctmtypes.ClientState{}, cmtClientState)
+		return "", "", fmt.Errorf("client must be a Comet client, expected: %T, got: %T", &ibctmtypes.ClientState{}, cmtClientState)
 	}
-	return cmtClientState.ChainId, nil
+	return cmtClientState.ChainId, clientID, nil
 }
 
 // getFinalizedInfo returns metadata and proofs that are identical to all BTC timestamps in the same epoch
@@ -234,16 +235,19 @@ func (k Keeper) BroadcastBTCTimestamps(
 
 	// for each channel, construct and send BTC timestamp
 	for _, channel := range openZCChannels {
-		// get the ID of the chain under this channel
-		chainID, err := k.getChainID(ctx, channel)
+		// get the registered consumer chain under this channel
+		consumer, err := k.getConsumerOfChannel(ctx, channel)
 		if err != nil {
-			k.Logger(sdkCtx).Error("failed to get chain ID, skip sending BTC timestamp for this chain", "channelID", channel.ChannelId, "error", err)
+			k.Logger(sdkCtx).Info("no registered consumer chain under this channel, skip sending BTC timestamp for this channel", "channelID", channel.ChannelId, "error", err)
 			continue
 		}
+		chainID := consumer.ChainId
 
 		// generate timestamp for this channel
 		btcTimestamp, err := k.createBTCTimestamp(ctx, chainID, channel, finalizedInfo)
 		if err != nil {
+			// the failure is deterministic, e.g., the chain has no chain info
+			// at this epoch, so resending the BTC timestamp would fail as well
 			k.Logger(sdkCtx).Error("failed to generate BTC timestamp, skip sending BTC timestamp for this chain", "chainID", chainID, "error", err)
 			continue
 		}
@@ -252,8 +256,10 @@ func (k Keeper) BroadcastBTCTimestamps(
 		packet := types.NewBTCTimestampPacketData(btcTimestamp)
 		// send IBC packet
 		if err := k.SendIBCPacket(ctx, channel, packet); err != nil {
-			k.Logger(sdkCtx).Error("failed to send BTC timestamp IBC packet, skip sending BTC timestamp for this chain", "chainID", chainID, "channelID", channel.ChannelId, "error", err)
+			k.Logger(sdkCtx).Error("failed to send BTC timestamp IBC packet, queue it for resending", "chainID", chainID, "channelID", channel.ChannelId, "error", err)
+			k.enqueueBTCTimestamp(ctx, channel.ChannelId, epochNum)
 			continue
 		}
+		k.recordBTCTimestampSent(ctx, channel.ChannelId, epochNum)
 	}
 }
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// GetConsumerOfChannel returns the registered consumer chain at the other end
+// of the given port and channel of ZoneConcierge
+func (k Keeper) GetConsumerOfChannel(ctx context.Context, portID string, channelID string) (*types.ConsumerMetadata, error) {
+	return k.getConsumerOfChannel(ctx, channeltypes.IdentifiedChannel{PortId: portID, ChannelId: channelID})
+}
+
+// HandleConsumerRegister handles the metadata reported by the registered
+// consumer chain with the given chain ID through the given channel. The
+// reported metadata updates the name and description of the consumer chain.
+// The channel has to be bound to the consumer chain by governance already.
+func (k Keeper) HandleConsumerRegister(ctx context.Context, chainID string, channelID string, packet *types.ConsumerRegisterIBCPacket) error {
+	if err := packet.ValidateBasic(); err != nil {
+		return err
+	}
+
+	consumer := k.GetConsumerMetadata(ctx, chainID)
+	if consumer == nil {
+		return types.ErrConsumerNotRegistered.Wrapf("chain ID %s", chainID)
+	}
+	if consumer.ChannelId != channelID {
+		return types.ErrConsumerNotAllowed.Wrapf("chain %s is bound to channel %q rather than %s", chainID, consumer.ChannelId, channelID)
+	}
+	consumer.Name = packet.ConsumerName
+	consumer.Description = packet.ConsumerDescription
+	k.setConsumerMetadata(ctx, consumer)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerRegister,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChainID, chainID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		),
+	)
+
+	return nil
+}
+
+// HandleConsumerSlashing handles the slashing of a finality provider reported
+// by the consumer chain with the given chain ID
+func (k Keeper) HandleConsumerSlashing(ctx context.Context, chainID string, packet *types.ConsumerSlashingIBCPacket) error {
+	if err := packet.ValidateBasic(); err != nil {
+		return err
+	}
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(packet.FpBtcPkHex)
+	if err != nil {
+		panic(err) // only programming error, as the packet is validated
+	}
+
+	store := k.consumerSlashingStore(ctx, chainID)
+	key := append(fpBTCPK.MustMarshal(), sdk.Uint64ToBigEndian(packet.BlockHeight)...)
+	if store.Has(key) {
+		return types.ErrInvalidPacket.Wrapf("the slashing of finality provider %s at height %d is already reported", packet.FpBtcPkHex, packet.BlockHeight)
+	}
+
+	record := &types.ConsumerSlashingRecord{
+		ChainId:       chainID,
+		FpBtcPkHex:    packet.FpBtcPkHex,
+		BlockHeight:   packet.BlockHeight,
+		Evidence:      packet.Evidence,
+		BabylonHeight: uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height),
+	}
+	store.Set(key, k.cdc.MustMarshal(record))
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerSlashing,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChainID, chainID),
+			sdk.NewAttribute(types.AttributeKeyFpBtcPkHex, packet.FpBtcPkHex),
+			sdk.NewAttribute(types.AttributeKeyConsumerHeight, fmt.Sprintf("%d", packet.BlockHeight)),
+		),
+	)
+
+	return nil
+}
+
+// GetConsumerSlashingRecords returns all slashings reported by the consumer
+// chain with the given chain ID
+func (k Keeper) GetConsumerSlashingRecords(ctx context.Context, chainID string) []*types.ConsumerSlashingRecord {
+	records := []*types.ConsumerSlashingRecord{}
+	iter := k.consumerSlashingStore(ctx, chainID).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.ConsumerSlashingRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		records = append(records, &record)
+	}
+	return records
+}
+
+// HandleBTCTimestampAck handles the acknowledgement of the BTC timestamp of
+// an epoch by the consumer chain with the given chain ID, received through the
+// given channel. The acknowledged epoch cannot go beyond the last finalized
+// epoch. It is recorded in the delivery state of the channel, which is also
+// updated by the IBC acknowledgement of the BTC timestamp packet, so an epoch
+// that is acknowledged already has no effect.
+func (k Keeper) HandleBTCTimestampAck(ctx context.Context, chainID string, channelID string, packet *types.BTCTimestampAckIBCPacket) error {
+	if err := packet.ValidateBasic(); err != nil {
+		return err
+	}
+
+	lastFinalizedEpoch := k.GetLastFinalizedEpoch(ctx)
+	if packet.EpochNum > lastFinalizedEpoch {
+		return types.ErrInvalidBTCTimestampAck.Wrapf("epoch %d is not finalized yet, last finalized epoch: %d", packet.EpochNum, lastFinalizedEpoch)
+	}
+
+	state := k.GetBTCTimestampDeliveryState(ctx, channelID)
+	state.RecordAcked(packet.EpochNum)
+	k.setBTCTimestampDeliveryState(ctx, channelID, state)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBTCTimestampAck,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChainID, chainID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyEpochNum, fmt.Sprintf("%d", packet.EpochNum)),
+		),
+	)
+
+	return nil
+}
+
+// HandleBTCTimestampTimeout handles the timeout of a BTC timestamp packet sent
+// through the given channel. As ZoneConcierge channels are ordered, IBC closes
+// the channel upon the timeout. The BTC timestamp is queued for resending, and
+// is moved to the next open channel of the same consumer chain.
+func (k Keeper) HandleBTCTimestampTimeout(ctx context.Context, channelID string, btcTimestamp *types.BTCTimestamp) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epochNum := uint64(0)
+	if btcTimestamp.EpochInfo != nil {
+		epochNum = btcTimestamp.EpochInfo.EpochNumber
+		k.enqueueBTCTimestamp(ctx, channelID, epochNum)
+	}
+	k.Logger(sdkCtx).Error("BTC timestamp packet timed out, the ordered channel will be closed", "channelID", channelID, "epoch", epochNum)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyEpochNum, fmt.Sprintf("%d", epochNum)),
+		),
+	)
+}
+
+// consumerSlashingStore returns the KVStore of the slashings reported by a
+// consumer chain. The chain ID is length-prefixed, so that the store of a chain
+// ID never overlaps with the store of another chain ID it is a prefix of
+// prefix: ConsumerSlashingKey || len(chain ID) || chain ID
+// key: finality provider's BTC PK || consumer height
+// value: ConsumerSlashingRecord
+func (k Keeper) consumerSlashingStore(ctx context.Context, chainID string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.ConsumerSlashingKey)
+	return prefix.NewStore(store, address.MustLengthPrefix([]byte(chainID)))
+}
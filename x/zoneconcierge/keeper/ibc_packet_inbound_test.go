@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzHandleInboundPackets(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		lastFinalizedEpoch := datagen.RandomInt(r, 100) + 10
+		checkpointingKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		checkpointingKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(lastFinalizedEpoch).AnyTimes()
+		zcKeeper, ctx := testkeeper.ZoneConciergeKeeper(t, nil, checkpointingKeeper, nil, nil)
+
+		chainID := datagen.GenRandomHexStr(r, 30)
+		channelID := "channel-" + datagen.GenRandomHexStr(r, 4)
+
+		// consumer registration is rejected if the consumer is not registered
+		registerPacket := types.NewConsumerRegisterPacketData(datagen.GenRandomHexStr(r, 10), datagen.GenRandomHexStr(r, 100)).GetConsumerRegister()
+		err := zcKeeper.HandleConsumerRegister(ctx, chainID, channelID, registerPacket)
+		require.ErrorIs(t, err, types.ErrConsumerNotRegistered)
+		// consumer registration does not bind a channel to the registered
+		// consumer, which is only done by governance
+		RegisterConsumer(ctx, zcKeeper, chainID, "")
+		err = zcKeeper.HandleConsumerRegister(ctx, chainID, channelID, registerPacket)
+		require.ErrorIs(t, err, types.ErrConsumerNotAllowed)
+		require.Empty(t, zcKeeper.GetConsumerMetadata(ctx, chainID).ChannelId)
+		// consumer registration through the bound channel updates the metadata
+		err = zcKeeper.AddConsumer(ctx, types.NewConsumerMetadata(chainID, chainID, "", testConsumerClientID, channelID))
+		require.NoError(t, err)
+		err = zcKeeper.HandleConsumerRegister(ctx, chainID, channelID, registerPacket)
+		require.NoError(t, err)
+		metadata := zcKeeper.GetConsumerMetadata(ctx, chainID)
+		require.NotNil(t, metadata)
+		require.Equal(t, chainID, metadata.ChainId)
+		require.Equal(t, registerPacket.ConsumerName, metadata.Name)
+		require.Equal(t, registerPacket.ConsumerDescription, metadata.Description)
+		require.Equal(t, channelID, metadata.ChannelId)
+		// consumer registration through another channel is rejected
+		err = zcKeeper.HandleConsumerRegister(ctx, chainID, channelID+"0", registerPacket)
+		require.ErrorIs(t, err, types.ErrConsumerNotAllowed)
+		// empty name is rejected
+		err = zcKeeper.HandleConsumerRegister(ctx, chainID, channelID, &types.ConsumerRegisterIBCPacket{})
+		require.ErrorIs(t, err, types.ErrInvalidPacket)
+
+		// consumer slashing
+		fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		numSlashings := int(datagen.RandomInt(r, 5)) + 1
+		for i := 0; i < numSlashings; i++ {
+			slashingPacket := types.NewConsumerSlashingPacketData(fpBTCPK, uint64(i+1), datagen.GenRandomByteArray(r, 64)).GetConsumerSlashing()
+			err = zcKeeper.HandleConsumerSlashing(ctx, chainID, slashingPacket)
+			require.NoError(t, err)
+			// duplicated slashing is rejected
+			err = zcKeeper.HandleConsumerSlashing(ctx, chainID, slashingPacket)
+			require.ErrorIs(t, err, types.ErrInvalidPacket)
+		}
+		records := zcKeeper.GetConsumerSlashingRecords(ctx, chainID)
+		require.Len(t, records, numSlashings)
+		for i, record := range records {
+			require.Equal(t, fpBTCPK.MarshalHex(), record.FpBtcPkHex)
+			require.Equal(t, uint64(i+1), record.BlockHeight)
+		}
+		require.Empty(t, zcKeeper.GetConsumerSlashingRecords(ctx, chainID+"-other"))
+		// the records of a chain ID are not visible to chain IDs that are its
+		// prefix, and vice versa
+		require.Empty(t, zcKeeper.GetConsumerSlashingRecords(ctx, chainID[:len(chainID)-1]))
+		longerChainID := chainID + "0"
+		err = zcKeeper.HandleConsumerSlashing(ctx, longerChainID, types.NewConsumerSlashingPacketData(fpBTCPK, 1, datagen.GenRandomByteArray(r, 64)).GetConsumerSlashing())
+		require.NoError(t, err)
+		require.Len(t, zcKeeper.GetConsumerSlashingRecords(ctx, chainID), numSlashings)
+		require.Len(t, zcKeeper.GetConsumerSlashingRecords(ctx, longerChainID), 1)
+
+		// BTC timestamp acknowledgement is recorded in the delivery state of
+		// the channel
+		require.Zero(t, zcKeeper.GetBTCTimestampDeliveryState(ctx, channelID).LastAckedEpoch)
+		ackedEpoch := datagen.RandomInt(r, int(lastFinalizedEpoch)) + 1
+		err = zcKeeper.HandleBTCTimestampAck(ctx, chainID, channelID, types.NewBTCTimestampAckPacketData(ackedEpoch).GetBtcTimestampAck())
+		require.NoError(t, err)
+		require.Equal(t, ackedEpoch, zcKeeper.GetBTCTimestampDeliveryState(ctx, channelID).LastAckedEpoch)
+		// acknowledging an epoch that is acknowledged already, e.g., by the IBC
+		// acknowledgement of the BTC timestamp packet, has no effect
+		err = zcKeeper.HandleBTCTimestampAck(ctx, chainID, channelID, types.NewBTCTimestampAckPacketData(ackedEpoch).GetBtcTimestampAck())
+		require.NoError(t, err)
+		err = zcKeeper.HandleBTCTimestampAck(ctx, chainID, channelID, types.NewBTCTimestampAckPacketData(1).GetBtcTimestampAck())
+		require.NoError(t, err)
+		require.Equal(t, ackedEpoch, zcKeeper.GetBTCTimestampDeliveryState(ctx, channelID).LastAckedEpoch)
+		// acknowledging a non-finalized epoch is rejected
+		err = zcKeeper.HandleBTCTimestampAck(ctx, chainID, channelID, types.NewBTCTimestampAckPacketData(lastFinalizedEpoch+1).GetBtcTimestampAck())
+		require.ErrorIs(t, err, types.ErrInvalidBTCTimestampAck)
+		require.Equal(t, ackedEpoch, zcKeeper.GetBTCTimestampDeliveryState(ctx, channelID).LastAckedEpoch)
+	})
+}
@@ -8,10 +8,27 @@ import (
 
 	"github.com/babylonchain/babylon/testutil/datagen"
 	zckeeper "github.com/babylonchain/babylon/x/zoneconcierge/keeper"
+	zctypes "github.com/babylonchain/babylon/x/zoneconcierge/types"
 )
 
+// testConsumerClientID is the IBC light client of the consumer chains
+// registered in tests
+const testConsumerClientID = "07-tendermint-0"
+
+// RegisterConsumer registers the chain with the given ID as a consumer chain
+// with the given bound channel, if it is not registered yet
+func RegisterConsumer(ctx context.Context, k *zckeeper.Keeper, chainID string, channelID string) {
+	if k.IsConsumerRegistered(ctx, chainID) {
+		return
+	}
+	if err := k.AddConsumer(ctx, zctypes.NewConsumerMetadata(chainID, chainID, "", testConsumerClientID, channelID)); err != nil {
+		panic(err)
+	}
+}
+
 // SimulateNewHeaders generates a non-zero number of canonical headers
 func SimulateNewHeaders(ctx context.Context, r *rand.Rand, k *zckeeper.Keeper, chainID string, startHeight uint64, numHeaders uint64) []*ibctmtypes.Header {
+	RegisterConsumer(ctx, k, chainID, "")
 	headers := []*ibctmtypes.Header{}
 	// invoke the hook a number of times to simulate a number of blocks
 	for i := uint64(0); i < numHeaders; i++ {
@@ -24,6 +41,7 @@ func SimulateNewHeaders(ctx context.Context, r *rand.Rand, k *zckeeper.Keeper, c
 
 // SimulateNewHeadersAndForks generates a random non-zero number of canonical headers and fork headers
 func SimulateNewHeadersAndForks(ctx context.Context, r *rand.Rand, k *zckeeper.Keeper, chainID string, startHeight uint64, numHeaders uint64, numForkHeaders uint64) ([]*ibctmtypes.Header, []*ibctmtypes.Header) {
+	RegisterConsumer(ctx, k, chainID, "")
 	headers := []*ibctmtypes.Header{}
 	// invoke the hook a number of times to simulate a number of blocks
 	for i := uint64(0); i < numHeaders; i++ {
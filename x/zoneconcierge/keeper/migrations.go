@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates x/zoneconcierge from consensus version 1 to 2.
+// Version 2 only tracks headers of, and sends BTC timestamps to, consumer
+// chains in the consumer registry, which is empty after the upgrade. The
+// registry is seeded with the chains Babylon already integrates with, so that
+// their integration keeps working:
+//   - the counterparty chain of each open ZoneConcierge channel, bound to the
+//     channel and its IBC light client, and
+//   - each chain with chain info but no open channel, allowed to use the IBC
+//     light client tracking it, without a bound channel.
+//
+// The seeded registrations use the chain ID as the consumer name, and can be
+// edited or removed by governance afterwards.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+
+	for _, channel := range k.GetAllOpenZCChannels(ctx) {
+		chainID, clientID, err := k.getChainID(ctx, channel)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get the counterparty chain of the channel, skip registering it", "channelID", channel.ChannelId, "error", err)
+			continue
+		}
+		// a chain with multiple open channels is bound to the first one
+		if k.IsConsumerRegistered(ctx, chainID) {
+			continue
+		}
+		k.addMigratedConsumer(ctx, types.NewConsumerMetadata(chainID, chainID, "", clientID, channel.ChannelId))
+	}
+
+	clientIDs := k.getCometClientIDs(ctx)
+	for _, chainID := range k.GetAllChainIDs(ctx) {
+		if k.IsConsumerRegistered(ctx, chainID) {
+			continue
+		}
+		clientID, ok := clientIDs[chainID]
+		if !ok {
+			k.Logger(ctx).Error("no IBC light client of the chain, skip registering it", "chainID", chainID)
+			continue
+		}
+		k.addMigratedConsumer(ctx, types.NewConsumerMetadata(chainID, chainID, "", clientID, ""))
+	}
+
+	return nil
+}
+
+// addMigratedConsumer registers the given consumer chain. A chain whose
+// registration is invalid, e.g., as its chain ID is too long to be its name,
+// is skipped rather than failing the upgrade, and can be registered by
+// governance afterwards
+func (k Keeper) addMigratedConsumer(ctx sdk.Context, consumer *types.ConsumerMetadata) {
+	if err := k.AddConsumer(ctx, consumer); err != nil {
+		k.Logger(ctx).Error("failed to register the consumer chain", "chainID", consumer.ChainId, "error", err)
+	}
+}
+
+// getCometClientIDs returns the ID of the first Comet IBC light client of
+// each chain, indexed by the chain ID
+func (k Keeper) getCometClientIDs(ctx sdk.Context) map[string]string {
+	clientIDs := map[string]string{}
+	k.clientKeeper.IterateClientStates(ctx, nil, func(clientID string, cs ibcexported.ClientState) bool {
+		cmtClientState, ok := cs.(*ibctmtypes.ClientState)
+		if !ok {
+			return false
+		}
+		if _, ok := clientIDs[cmtClientState.ChainId]; !ok {
+			clientIDs[cmtClientState.ChainId] = clientID
+		}
+		return false
+	})
+	return clientIDs
+}
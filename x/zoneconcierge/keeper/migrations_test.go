@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/app"
+	zckeeper "github.com/babylonchain/babylon/x/zoneconcierge/keeper"
+	zctypes "github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	babylonApp := app.Setup(t, false)
+	zcKeeper := babylonApp.ZoneConciergeKeeper
+	ctx := babylonApp.NewContext(false)
+
+	// chain A is connected via an open ZoneConcierge channel
+	babylonApp.IBCKeeper.ClientKeeper.SetClientState(ctx, "07-tendermint-0", &ibctmtypes.ClientState{ChainId: "chain-a", LatestHeight: clienttypes.NewHeight(0, 1)})
+	babylonApp.IBCKeeper.ConnectionKeeper.SetConnection(ctx, "connection-0", connectiontypes.ConnectionEnd{ClientId: "07-tendermint-0", State: connectiontypes.OPEN})
+	babylonApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, zcKeeper.GetPort(ctx), "channel-0", channeltypes.Channel{
+		State:          channeltypes.OPEN,
+		Ordering:       channeltypes.ORDERED,
+		Counterparty:   channeltypes.NewCounterparty(zctypes.PortID, "channel-0"),
+		ConnectionHops: []string{"connection-0"},
+		Version:        zctypes.Version,
+	})
+	// chain B has headers tracked by an IBC light client, but no channel
+	babylonApp.IBCKeeper.ClientKeeper.SetClientState(ctx, "07-tendermint-1", &ibctmtypes.ClientState{ChainId: "chain-b", LatestHeight: clienttypes.NewHeight(0, 1)})
+	_, err := zcKeeper.InitChainInfo(ctx, "chain-b")
+	require.NoError(t, err)
+	// chain C has chain info, but no IBC light client any more
+	_, err = zcKeeper.InitChainInfo(ctx, "chain-c")
+	require.NoError(t, err)
+
+	require.Empty(t, zcKeeper.GetAllConsumers(ctx))
+
+	m := zckeeper.NewMigrator(zcKeeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+
+	require.Equal(t, zctypes.NewConsumerMetadata("chain-a", "chain-a", "", "07-tendermint-0", "channel-0"), zcKeeper.GetConsumerMetadata(ctx, "chain-a"))
+	require.Equal(t, zctypes.NewConsumerMetadata("chain-b", "chain-b", "", "07-tendermint-1", ""), zcKeeper.GetConsumerMetadata(ctx, "chain-b"))
+	require.False(t, zcKeeper.IsConsumerRegistered(ctx, "chain-c"))
+
+	// chain A keeps receiving BTC timestamps through its channel
+	channels := zcKeeper.GetAllOpenZCChannels(ctx)
+	require.Len(t, channels, 1)
+	require.True(t, zcKeeper.GetConsumerMetadata(ctx, "chain-a").IsChannelAllowed("07-tendermint-0", channels[0].ChannelId))
+}
@@ -37,3 +37,33 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RegisterConsumer registers a consumer chain, or updates the registration of
+// a registered consumer chain
+func (ms msgServer) RegisterConsumer(goCtx context.Context, req *types.MsgRegisterConsumer) (*types.MsgRegisterConsumerResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	consumer := types.NewConsumerMetadata(req.ChainId, req.Name, req.Description, req.ClientId, req.ChannelId)
+	if err := ms.AddConsumer(ctx, consumer); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid consumer: %v", err)
+	}
+
+	return &types.MsgRegisterConsumerResponse{}, nil
+}
+
+// DeregisterConsumer deregisters a consumer chain
+func (ms msgServer) DeregisterConsumer(goCtx context.Context, req *types.MsgDeregisterConsumer) (*types.MsgDeregisterConsumerResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.RemoveConsumer(ctx, req.ChainId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeregisterConsumerResponse{}, nil
+}
@@ -1 +1,80 @@
 package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/zoneconcierge/keeper"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzMsgRegisterConsumer(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		zcKeeper, ctx := testkeeper.ZoneConciergeKeeper(t, nil, nil, nil, nil)
+		ms := keeper.NewMsgServerImpl(*zcKeeper)
+		authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+		chainID := datagen.GenRandomHexStr(r, 30)
+		msg := &types.MsgRegisterConsumer{
+			Authority:   authority,
+			ChainId:     chainID,
+			Name:        datagen.GenRandomHexStr(r, 10),
+			Description: datagen.GenRandomHexStr(r, 100),
+			ClientId:    "07-tendermint-0",
+			ChannelId:   "channel-0",
+		}
+
+		// only the governance account can register consumers
+		_, err := ms.RegisterConsumer(ctx, &types.MsgRegisterConsumer{Authority: datagen.GenRandomAccount().Address, ChainId: chainID, Name: msg.Name})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+		// invalid registration is rejected
+		_, err = ms.RegisterConsumer(ctx, &types.MsgRegisterConsumer{Authority: authority, ChainId: chainID})
+		require.ErrorIs(t, err, govtypes.ErrInvalidProposalMsg)
+		// the IBC light client is required
+		_, err = ms.RegisterConsumer(ctx, &types.MsgRegisterConsumer{Authority: authority, ChainId: chainID, Name: msg.Name, ChannelId: msg.ChannelId})
+		require.ErrorIs(t, err, govtypes.ErrInvalidProposalMsg)
+		require.False(t, zcKeeper.IsConsumerRegistered(ctx, chainID))
+
+		// no channel is allowed until governance binds one
+		_, err = ms.RegisterConsumer(ctx, &types.MsgRegisterConsumer{Authority: authority, ChainId: chainID, Name: msg.Name, ClientId: msg.ClientId})
+		require.NoError(t, err)
+		consumer := zcKeeper.GetConsumerMetadata(ctx, chainID)
+		require.True(t, consumer.IsClientAllowed("07-tendermint-0"))
+		require.False(t, consumer.IsChannelAllowed("07-tendermint-0", "channel-0"))
+
+		_, err = ms.RegisterConsumer(ctx, msg)
+		require.NoError(t, err)
+		consumer = zcKeeper.GetConsumerMetadata(ctx, chainID)
+		require.Equal(t, types.NewConsumerMetadata(chainID, msg.Name, msg.Description, msg.ClientId, msg.ChannelId), consumer)
+		require.True(t, consumer.IsChannelAllowed("07-tendermint-0", "channel-0"))
+		require.False(t, consumer.IsChannelAllowed("07-tendermint-0", "channel-1"))
+		require.False(t, consumer.IsClientAllowed("07-tendermint-1"))
+		require.False(t, consumer.IsChannelAllowed("07-tendermint-1", "channel-0"))
+
+		// the registration is queryable
+		resp, err := zcKeeper.ConsumerRegistry(ctx, &types.QueryConsumerRegistryRequest{ChainId: chainID})
+		require.NoError(t, err)
+		require.Equal(t, consumer, resp.Consumer)
+		listResp, err := zcKeeper.ConsumerRegistryList(ctx, &types.QueryConsumerRegistryListRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []*types.ConsumerMetadata{consumer}, listResp.Consumers)
+
+		// a registered consumer is no longer tracked after deregistration
+		_, err = ms.DeregisterConsumer(ctx, &types.MsgDeregisterConsumer{Authority: authority, ChainId: chainID})
+		require.NoError(t, err)
+		require.False(t, zcKeeper.IsConsumerRegistered(ctx, chainID))
+		_, err = zcKeeper.ChainsInfo(ctx, &types.QueryChainsInfoRequest{ChainIds: []string{chainID}})
+		require.ErrorContains(t, err, types.ErrConsumerNotRegistered.Error())
+		_, err = ms.DeregisterConsumer(ctx, &types.MsgDeregisterConsumer{Authority: authority, ChainId: chainID})
+		require.ErrorIs(t, err, types.ErrConsumerNotRegistered)
+	})
+}
@@ -149,9 +149,37 @@ func (im IBCModule) OnRecvPacket(
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	// Babylon is supposed to not take any IBC packet
+	var modulePacketData types.ZoneconciergePacketData
+	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error()))
+	}
+
+	// packets are only accepted from the registered consumer chain at the
+	// other end of the channel
+	consumer, err := im.keeper.GetConsumerOfChannel(ctx, modulePacket.DestinationPort, modulePacket.DestinationChannel)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	chainID := consumer.ChainId
+
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
-	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "Babylon is supposed to not take any IBC packet"))
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.ZoneconciergePacketData_ConsumerRegister:
+		err = im.keeper.HandleConsumerRegister(ctx, chainID, modulePacket.DestinationChannel, packet.ConsumerRegister)
+	case *types.ZoneconciergePacketData_ConsumerSlashing:
+		err = im.keeper.HandleConsumerSlashing(ctx, chainID, packet.ConsumerSlashing)
+	case *types.ZoneconciergePacketData_BtcTimestampAck:
+		err = im.keeper.HandleBTCTimestampAck(ctx, chainID, modulePacket.DestinationChannel, packet.BtcTimestampAck)
+	default:
+		// including BTC timestamps, which are only sent by Babylon
+		err = errorsmod.Wrapf(types.ErrInvalidPacket, "unrecognized %s packet type: %T", types.ModuleName, packet)
+	}
+	if err != nil {
+		im.keeper.Logger(ctx).Error("failed to handle the received packet", "chainID", chainID, "error", err)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -173,12 +201,17 @@ func (im IBCModule) OnAcknowledgementPacket(
 		}
 	}
 
-	// // TODO (Babylon): Dispatch and process packet
-	// switch packet := modulePacketData.Packet.(type) {
-	// default:
-	// 	errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
-	// 	return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
-	// }
+	var modulePacketData types.ZoneconciergePacketData
+	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
+
+	// track the delivery of BTC timestamps, such that a BTC timestamp
+	// acknowledged with an error is resent
+	if packet, ok := modulePacketData.Packet.(*types.ZoneconciergePacketData_BtcTimestamp); ok && packet.BtcTimestamp.EpochInfo != nil {
+		epochNum := packet.BtcTimestamp.EpochInfo.EpochNumber
+		im.keeper.HandleBTCTimestampAcknowledgement(ctx, modulePacket.SourceChannel, epochNum, ack.Success())
+	}
 
 	switch resp := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Result:
@@ -215,14 +248,13 @@ func (im IBCModule) OnTimeoutPacket(
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
 	}
 
-	// // TODO (Babylon): Dispatch and process packet
-	// switch packet := modulePacketData.Packet.(type) {
-	// default:
-	// 	errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
-	// 	return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
-	// }
-
-	// TODO: close channel upon timeout
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.ZoneconciergePacketData_BtcTimestamp:
+		// the ordered channel is closed by IBC upon timeout
+		im.keeper.HandleBTCTimestampTimeout(ctx, modulePacket.SourceChannel, packet.BtcTimestamp)
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s packet type: %T", types.ModuleName, packet)
+	}
 
 	return nil
 }
@@ -26,6 +26,13 @@ func GetCZHeaderKey(chainID string, height uint64) []byte {
 	return key
 }
 
+// GetCZHeaderKeyPath returns the store key of the module and the key of the
+// consumer chain header with the given chain ID and height in the module
+// store. It is the verifier.CZHeaderKeyFunc for verifying BTC timestamps
+func GetCZHeaderKeyPath(chainID string, height uint64) (string, []byte) {
+	return StoreKey, GetCZHeaderKey(chainID, height)
+}
+
 func GetEpochInfoKey(epochNumber uint64) []byte {
 	epochInfoKey := epochingtypes.EpochInfoKey
 	epochInfoKey = append(epochInfoKey, sdk.Uint64ToBigEndian(epochNumber)...)
@@ -192,14 +199,15 @@ func VerifyCZHeaderInEpoch(header *IndexedHeader, epoch *epochingtypes.Epoch, pr
 // - basic sanity checks
 // - Merkle proofs in txsInfo are valid
 // - the raw ckpt decoded from txsInfo is same as the expected rawCkpt
+// The checkpoint is either split into two txs, or carried by a single tx
 func VerifyEpochSubmitted(rawCkpt *checkpointingtypes.RawCheckpoint, txsInfo []*btcctypes.TransactionInfo, btcHeaders []*wire.BlockHeader, powLimit *big.Int, babylonTag txformat.BabylonTag) error {
 	// basic sanity check
 	if rawCkpt == nil {
 		return fmt.Errorf("rawCkpt is nil")
-	} else if len(txsInfo) != txformat.NumberOfParts {
-		return fmt.Errorf("txsInfo contains %d parts rather than %d", len(txsInfo), txformat.NumberOfParts)
-	} else if len(btcHeaders) != txformat.NumberOfParts {
-		return fmt.Errorf("btcHeaders contains %d parts rather than %d", len(btcHeaders), txformat.NumberOfParts)
+	} else if len(txsInfo) != 1 && len(txsInfo) != txformat.NumberOfParts {
+		return fmt.Errorf("txsInfo contains %d parts rather than 1 or %d", len(txsInfo), txformat.NumberOfParts)
+	} else if len(btcHeaders) != len(txsInfo) {
+		return fmt.Errorf("btcHeaders contains %d parts rather than %d", len(btcHeaders), len(txsInfo))
 	}
 
 	// sanity check of each tx info
@@ -209,6 +217,42 @@ func VerifyEpochSubmitted(rawCkpt *checkpointingtypes.RawCheckpoint, txsInfo []*
 		}
 	}
 
+	var (
+		rawCkptData []byte
+		version     txformat.FormatVersion
+		err         error
+	)
+	if len(txsInfo) == 1 {
+		rawCkptData, err = getSingleTxCheckpointData(txsInfo[0], btcHeaders[0], powLimit, babylonTag)
+		version = txformat.SingleTxVersion
+	} else {
+		rawCkptData, err = getTwoTxsCheckpointData(txsInfo, btcHeaders, powLimit, babylonTag)
+		version = txformat.CurrentVersion
+	}
+	if err != nil {
+		return err
+	}
+
+	btcCkpt, err := txformat.DecodeRawCheckpoint(version, rawCkptData)
+	if err != nil {
+		return err
+	}
+	decodedRawCkpt, err := checkpointingtypes.FromBTCCkptToRawCkpt(btcCkpt)
+	if err != nil {
+		return err
+	}
+
+	// check if decodedRawCkpt is same as the expected rawCkpt
+	if !decodedRawCkpt.Equal(rawCkpt) {
+		return fmt.Errorf("the decoded rawCkpt (%v) is different from the expected rawCkpt (%v)", decodedRawCkpt, rawCkpt)
+	}
+
+	return nil
+}
+
+// getTwoTxsCheckpointData verifies Merkle proofs of the two txs carrying the
+// checkpoint and returns the raw checkpoint bytes connected from them
+func getTwoTxsCheckpointData(txsInfo []*btcctypes.TransactionInfo, btcHeaders []*wire.BlockHeader, powLimit *big.Int, babylonTag txformat.BabylonTag) ([]byte, error) {
 	// verify Merkle proofs for each tx info
 	parsedProofs := []*btcctypes.ParsedProof{}
 	for i, txInfo := range txsInfo {
@@ -221,7 +265,7 @@ func VerifyEpochSubmitted(rawCkpt *checkpointingtypes.RawCheckpoint, txsInfo []*
 			powLimit,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		parsedProofs = append(parsedProofs, parsedProof)
 	}
@@ -237,25 +281,29 @@ func VerifyEpochSubmitted(rawCkpt *checkpointingtypes.RawCheckpoint, txsInfo []*
 		)
 
 		if err != nil {
-			return err
+			return nil, err
 		}
 		checkpointData = append(checkpointData, data)
 	}
-	rawCkptData, err := txformat.ConnectParts(txformat.CurrentVersion, checkpointData[0], checkpointData[1])
-	if err != nil {
-		return err
-	}
-	decodedRawCkpt, err := checkpointingtypes.FromBTCCkptBytesToRawCkpt(rawCkptData)
-	if err != nil {
-		return err
-	}
+	return txformat.ConnectParts(txformat.CurrentVersion, checkpointData[0], checkpointData[1])
+}
 
-	// check if decodedRawCkpt is same as the expected rawCkpt
-	if !decodedRawCkpt.Equal(rawCkpt) {
-		return fmt.Errorf("the decoded rawCkpt (%v) is different from the expected rawCkpt (%v)", decodedRawCkpt, rawCkpt)
+// getSingleTxCheckpointData verifies Merkle proof of the single tx carrying
+// the checkpoint and returns the raw checkpoint bytes revealed by it
+func getSingleTxCheckpointData(txInfo *btcctypes.TransactionInfo, btcHeader *wire.BlockHeader, powLimit *big.Int, babylonTag txformat.BabylonTag) ([]byte, error) {
+	btcHeaderBytes := bbn.NewBTCHeaderBytesFromBlockHeader(btcHeader)
+	parsedProof, err := btcctypes.ParseEnvelopeProof(
+		txInfo.Transaction,
+		txInfo.Key.Index,
+		txInfo.Proof,
+		&btcHeaderBytes,
+		txInfo.SpentTransaction,
+		powLimit,
+	)
+	if err != nil {
+		return nil, err
 	}
-
-	return nil
+	return txformat.GetSingleTxCheckpointData(babylonTag, parsedProof.EnvelopeData)
 }
 
 func (ts *BTCTimestamp) Verify(
@@ -313,11 +361,11 @@ func (ts *BTCTimestamp) VerifyStateless(
 		return fmt.Errorf("epoch number in epoch metadata and raw checkpoint is not same")
 	}
 
-	if len(ts.BtcSubmissionKey.Key) != txformat.NumberOfParts {
+	if numTxs := len(ts.BtcSubmissionKey.Key); numTxs != 1 && numTxs != txformat.NumberOfParts {
 		return fmt.Errorf("incorrect number of txs for a checkpoint")
 	}
 
-	// verify the checkpoint txs are committed to the two headers
+	// verify the checkpoint txs are committed to the headers
 	err := VerifyEpochSubmitted(ts.RawCheckpoint, ts.Proof.ProofEpochSubmitted, btcHeadersWithCkpt, powLimit, ckptTag)
 	if err != nil {
 		return err
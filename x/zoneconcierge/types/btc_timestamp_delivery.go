@@ -0,0 +1,148 @@
+package types
+
+import (
+	"sort"
+)
+
+const (
+	// MaxPendingBTCTimestamps is the maximum number of epochs whose BTC
+	// timestamps are queued for resending through a channel. Upon the queue
+	// being full, the earliest epoch is dropped
+	MaxPendingBTCTimestamps = 100
+	// MaxResentBTCTimestampsPerChannel is the maximum number of BTC timestamps
+	// resent through each channel in a block, so that a channel with a long
+	// queue cannot starve the other channels
+	MaxResentBTCTimestampsPerChannel = 5
+	// MaxBTCTimestampResendBackoff is the maximum number of blocks between two
+	// resends of the BTC timestamp of an epoch
+	MaxBTCTimestampResendBackoff = 1024
+)
+
+// BTCTimestampResendBackoff returns the number of blocks to wait before
+// resending a BTC timestamp that has failed the given number of times, which
+// doubles upon each failure up to MaxBTCTimestampResendBackoff
+func BTCTimestampResendBackoff(attempts uint32) int64 {
+	if attempts == 0 {
+		return 0
+	}
+	if attempts > 11 {
+		return MaxBTCTimestampResendBackoff
+	}
+	return min(int64(1)<<(attempts-1), MaxBTCTimestampResendBackoff)
+}
+
+// AddPendingEpoch queues the given epoch for resending its BTC timestamp
+// after a failed delivery at the given height. If the epoch is queued already,
+// the failure counts as another attempt. The resend is delayed by
+// BTCTimestampResendBackoff of the number of attempts. It returns the epoch
+// dropped from the queue due to the queue being full, or 0 if no epoch is
+// dropped
+func (s *BTCTimestampDeliveryState) AddPendingEpoch(epoch uint64, height int64) uint64 {
+	idx, found := s.findPendingEpoch(epoch)
+	if !found {
+		return s.insertPending(idx, &PendingBTCTimestamp{
+			Epoch:            epoch,
+			Attempts:         1,
+			NextResendHeight: height + BTCTimestampResendBackoff(1),
+		})
+	}
+	pending := s.PendingBtcTimestamps[idx]
+	pending.Attempts++
+	pending.NextResendHeight = height + BTCTimestampResendBackoff(pending.Attempts)
+	pending.InFlight = false
+	return 0
+}
+
+// MovePendingBTCTimestamps moves the queued BTC timestamps of the given state
+// to this state, keeping their numbers of attempts. The moved BTC timestamps
+// are no longer in flight, as their acknowledgements are not expected through
+// this channel. It returns the epochs dropped due to the queue being full
+func (s *BTCTimestampDeliveryState) MovePendingBTCTimestamps(from *BTCTimestampDeliveryState) []uint64 {
+	dropped := []uint64{}
+	for _, pending := range from.PendingBtcTimestamps {
+		pending.InFlight = false
+		idx, found := s.findPendingEpoch(pending.Epoch)
+		if found {
+			s.PendingBtcTimestamps[idx] = pending
+			continue
+		}
+		if epoch := s.insertPending(idx, pending); epoch != 0 {
+			dropped = append(dropped, epoch)
+		}
+	}
+	from.PendingBtcTimestamps = nil
+	return dropped
+}
+
+// insertPending inserts the given pending BTC timestamp at the given index of
+// the queue, and drops the earliest epoch if the queue is full. It returns the
+// dropped epoch, or 0 if no epoch is dropped
+func (s *BTCTimestampDeliveryState) insertPending(idx int, pending *PendingBTCTimestamp) uint64 {
+	s.PendingBtcTimestamps = append(s.PendingBtcTimestamps, nil)
+	copy(s.PendingBtcTimestamps[idx+1:], s.PendingBtcTimestamps[idx:])
+	s.PendingBtcTimestamps[idx] = pending
+
+	if len(s.PendingBtcTimestamps) <= MaxPendingBTCTimestamps {
+		return 0
+	}
+	dropped := s.PendingBtcTimestamps[0].Epoch
+	s.PendingBtcTimestamps = s.PendingBtcTimestamps[1:]
+	return dropped
+}
+
+// RemovePendingEpoch removes the given epoch from the queue, if it exists
+func (s *BTCTimestampDeliveryState) RemovePendingEpoch(epoch uint64) {
+	if idx, found := s.findPendingEpoch(epoch); found {
+		s.PendingBtcTimestamps = append(s.PendingBtcTimestamps[:idx], s.PendingBtcTimestamps[idx+1:]...)
+	}
+}
+
+// GetPendingEpochs returns the epochs whose BTC timestamps are queued for
+// resending, in ascending order
+func (s *BTCTimestampDeliveryState) GetPendingEpochs() []uint64 {
+	epochs := make([]uint64, 0, len(s.PendingBtcTimestamps))
+	for _, pending := range s.PendingBtcTimestamps {
+		epochs = append(epochs, pending.Epoch)
+	}
+	return epochs
+}
+
+// GetDueEpochs returns up to the given number of the earliest epochs whose BTC
+// timestamps can be resent at the given height, i.e., they are not awaiting
+// the acknowledgement of a previous resend, and their backoff has elapsed
+func (s *BTCTimestampDeliveryState) GetDueEpochs(height int64, limit int) []uint64 {
+	epochs := []uint64{}
+	for _, pending := range s.PendingBtcTimestamps {
+		if len(epochs) >= limit {
+			break
+		}
+		if !pending.InFlight && pending.NextResendHeight <= height {
+			epochs = append(epochs, pending.Epoch)
+		}
+	}
+	return epochs
+}
+
+// RecordSent records that the BTC timestamp of the given epoch is sent. A
+// queued epoch stays in the queue until its BTC timestamp is acknowledged,
+// so that its number of attempts is kept if the delivery fails again
+func (s *BTCTimestampDeliveryState) RecordSent(epoch uint64) {
+	s.LastSentEpoch = max(s.LastSentEpoch, epoch)
+	if idx, found := s.findPendingEpoch(epoch); found {
+		s.PendingBtcTimestamps[idx].InFlight = true
+	}
+}
+
+// RecordAcked records that the BTC timestamp of the given epoch is
+// acknowledged successfully
+func (s *BTCTimestampDeliveryState) RecordAcked(epoch uint64) {
+	s.LastAckedEpoch = max(s.LastAckedEpoch, epoch)
+	s.RemovePendingEpoch(epoch)
+}
+
+// findPendingEpoch returns the index of the given epoch in the queue, or the
+// index where it would be inserted if it is not queued
+func (s *BTCTimestampDeliveryState) findPendingEpoch(epoch uint64) (int, bool) {
+	idx := sort.Search(len(s.PendingBtcTimestamps), func(i int) bool { return s.PendingBtcTimestamps[i].Epoch >= epoch })
+	return idx, idx < len(s.PendingBtcTimestamps) && s.PendingBtcTimestamps[idx].Epoch == epoch
+}
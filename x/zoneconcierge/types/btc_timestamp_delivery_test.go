@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzBTCTimestampDeliveryState(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		state := &types.BTCTimestampDeliveryState{}
+		expected := map[uint64]struct{}{}
+		height := int64(datagen.RandomInt(r, 1000))
+		numEpochs := int(datagen.RandomInt(r, 3*types.MaxPendingBTCTimestamps)) + 1
+		for i := 0; i < numEpochs; i++ {
+			epoch := datagen.RandomInt(r, 2*types.MaxPendingBTCTimestamps) + 1
+			dropped := state.AddPendingEpoch(epoch, height)
+			expected[epoch] = struct{}{}
+			if dropped != 0 {
+				delete(expected, dropped)
+			}
+
+			// the queue is sorted, deduplicated and bounded
+			epochs := state.GetPendingEpochs()
+			require.True(t, sort.SliceIsSorted(epochs, func(i, j int) bool { return epochs[i] < epochs[j] }))
+			require.Len(t, epochs, len(expected))
+			require.LessOrEqual(t, len(epochs), types.MaxPendingBTCTimestamps)
+			// the earliest epoch is dropped when the queue is full
+			if dropped != 0 {
+				require.Less(t, dropped, epochs[0])
+			}
+		}
+
+		// a queued epoch is due after the backoff of its attempts
+		epochs := state.GetPendingEpochs()
+		require.Empty(t, state.GetDueEpochs(height, len(epochs)))
+		due := state.GetDueEpochs(height+types.MaxBTCTimestampResendBackoff, types.MaxResentBTCTimestampsPerChannel)
+		require.Equal(t, epochs[:min(len(epochs), types.MaxResentBTCTimestampsPerChannel)], due)
+
+		// another failure of the same epoch counts as another attempt and
+		// doubles the backoff
+		epoch := epochs[r.Intn(len(epochs))]
+		var attempts uint32
+		for _, pending := range state.PendingBtcTimestamps {
+			if pending.Epoch == epoch {
+				attempts = pending.Attempts
+			}
+		}
+		require.Zero(t, state.AddPendingEpoch(epoch, height))
+		for _, pending := range state.PendingBtcTimestamps {
+			if pending.Epoch == epoch {
+				require.Equal(t, attempts+1, pending.Attempts)
+				require.Equal(t, height+types.BTCTimestampResendBackoff(attempts+1), pending.NextResendHeight)
+				require.Equal(t, 2*types.BTCTimestampResendBackoff(attempts), types.BTCTimestampResendBackoff(attempts+1))
+			}
+		}
+
+		// a resent epoch stays in the queue but is not due until it fails again
+		state.RecordSent(epoch)
+		require.Contains(t, state.GetPendingEpochs(), epoch)
+		require.NotContains(t, state.GetDueEpochs(height+types.MaxBTCTimestampResendBackoff, len(epochs)), epoch)
+		require.GreaterOrEqual(t, state.LastSentEpoch, epoch)
+		state.AddPendingEpoch(epoch, height)
+		require.Contains(t, state.GetDueEpochs(height+types.MaxBTCTimestampResendBackoff, len(epochs)), epoch)
+
+		// moving the queue to another channel keeps the attempts
+		moved := &types.BTCTimestampDeliveryState{}
+		pendings := state.PendingBtcTimestamps
+		require.Empty(t, moved.MovePendingBTCTimestamps(state))
+		require.Empty(t, state.PendingBtcTimestamps)
+		require.Equal(t, pendings, moved.PendingBtcTimestamps)
+		state = moved
+
+		// acknowledging an epoch removes it from the queue
+		state.RecordAcked(epoch)
+		require.NotContains(t, state.GetPendingEpochs(), epoch)
+		require.Equal(t, epoch, state.LastAckedEpoch)
+	})
+}
@@ -164,5 +164,26 @@ func FuzzBTCTimestamp(f *testing.F) {
 
 		err = btcTs.VerifyStateless(btcHeaders, powLimit, tagAsBytes)
 		h.NoError(err)
+
+		/*
+			forge a BTC header including the checkpoint carried by a single tx
+		*/
+		singleTxBlock := datagen.CreateSingleTxCheckpointBlock(r, 3, uint32(idxs[0]+offsets[0]), uint32(idxs[0]), testRawCkptData.SingleTxData)
+		singleTxMsg := datagen.GenerateMessageWithRandomSubmitter([]*datagen.BlockCreationResult{singleTxBlock})
+		btcTs.BtcSubmissionKey = &btcctypes.SubmissionKey{
+			Key: []*btcctypes.TransactionKey{
+				{Index: uint32(idxs[0]), Hash: singleTxBlock.HeaderBytes.Hash()},
+			},
+		}
+		btcTs.Proof.ProofEpochSubmitted = []*btcctypes.TransactionInfo{
+			btcctypes.NewTransactionInfoFromSpvProof(singleTxMsg.Proofs[0]),
+		}
+		err = btcTs.VerifyStateless([]*wire.BlockHeader{singleTxBlock.HeaderBytes.ToBlockHeader()}, powLimit, tagAsBytes)
+		h.NoError(err)
+
+		// the single tx checkpoint cannot be verified without the spent tx
+		btcTs.Proof.ProofEpochSubmitted[0].SpentTransaction = nil
+		err = btcTs.VerifyStateless([]*wire.BlockHeader{singleTxBlock.HeaderBytes.ToBlockHeader()}, powLimit, tagAsBytes)
+		h.Error(err)
 	})
 }
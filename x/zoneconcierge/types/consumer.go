@@ -0,0 +1,56 @@
+package types
+
+import (
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+func NewConsumerMetadata(chainID string, name string, description string, clientID string, channelID string) *ConsumerMetadata {
+	return &ConsumerMetadata{
+		ChainId:     chainID,
+		Name:        name,
+		Description: description,
+		ClientId:    clientID,
+		ChannelId:   channelID,
+	}
+}
+
+// Validate performs basic validation of the registration of a consumer chain
+func (m *ConsumerMetadata) Validate() error {
+	if len(m.ChainId) == 0 {
+		return ErrInvalidConsumer.Wrap("empty chain ID")
+	}
+	if len(m.Name) == 0 {
+		return ErrInvalidConsumer.Wrap("empty consumer name")
+	}
+	if len(m.Name) > MaxConsumerNameLength {
+		return ErrInvalidConsumer.Wrapf("consumer name is longer than %d", MaxConsumerNameLength)
+	}
+	if len(m.Description) > MaxConsumerDescriptionLength {
+		return ErrInvalidConsumer.Wrapf("consumer description is longer than %d", MaxConsumerDescriptionLength)
+	}
+	if len(m.ClientId) == 0 {
+		return ErrInvalidConsumer.Wrap("empty client ID")
+	}
+	if err := host.ClientIdentifierValidator(m.ClientId); err != nil {
+		return ErrInvalidConsumer.Wrapf("invalid client ID: %v", err)
+	}
+	if len(m.ChannelId) > 0 {
+		if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+			return ErrInvalidConsumer.Wrapf("invalid channel ID: %v", err)
+		}
+	}
+	return nil
+}
+
+// IsClientAllowed checks whether headers from the given IBC light client are
+// accepted for the consumer chain
+func (m *ConsumerMetadata) IsClientAllowed(clientID string) bool {
+	return m.ClientId == clientID
+}
+
+// IsChannelAllowed checks whether the given ZoneConcierge channel with the
+// given IBC light client is allowed to connect to the consumer chain. A
+// channel is only allowed once it is bound to the consumer chain.
+func (m *ConsumerMetadata) IsChannelAllowed(clientID string, channelID string) bool {
+	return m.IsClientAllowed(clientID) && len(m.ChannelId) > 0 && m.ChannelId == channelID
+}
@@ -16,4 +16,9 @@ var (
 	ErrInvalidMerkleProof      = errorsmod.Register(ModuleName, 1108, "invalid Merkle inclusion proof")
 	ErrInvalidChainInfo        = errorsmod.Register(ModuleName, 1109, "invalid chain info")
 	ErrInvalidChainIDs         = errorsmod.Register(ModuleName, 1110, "chain ids contain duplicates or empty strings")
+	ErrInvalidPacket           = errorsmod.Register(ModuleName, 1111, "invalid IBC packet")
+	ErrInvalidBTCTimestampAck  = errorsmod.Register(ModuleName, 1112, "invalid acknowledgement of BTC timestamp")
+	ErrInvalidConsumer         = errorsmod.Register(ModuleName, 1113, "invalid consumer chain registration")
+	ErrConsumerNotRegistered   = errorsmod.Register(ModuleName, 1114, "consumer chain is not registered")
+	ErrConsumerNotAllowed      = errorsmod.Register(ModuleName, 1115, "IBC client or channel is not allowed for the consumer chain")
 )
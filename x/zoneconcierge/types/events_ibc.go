@@ -2,8 +2,17 @@ package types
 
 // IBC events
 const (
-	EventTypeAck = "acknowledgement"
+	EventTypeAck              = "acknowledgement"
+	EventTypeTimeout          = "timeout"
+	EventTypeConsumerRegister = "consumer_register"
+	EventTypeConsumerSlashing = "consumer_slashing"
+	EventTypeBTCTimestampAck  = "btc_timestamp_ack"
 
-	AttributeKeyAckSuccess = "success"
-	AttributeKeyAckError   = "error"
+	AttributeKeyAckSuccess     = "success"
+	AttributeKeyAckError       = "error"
+	AttributeKeyChainID        = "chain_id"
+	AttributeKeyChannelID      = "channel_id"
+	AttributeKeyEpochNum       = "epoch_num"
+	AttributeKeyFpBtcPkHex     = "fp_btc_pk_hex"
+	AttributeKeyConsumerHeight = "consumer_height"
 )
@@ -59,6 +59,7 @@ type ChannelKeeper interface {
 type ClientKeeper interface {
 	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
 	SetClientState(ctx sdk.Context, clientID string, clientState ibcexported.ClientState)
+	IterateClientStates(ctx sdk.Context, storeprefix []byte, cb func(clientID string, cs ibcexported.ClientState) bool)
 }
 
 // ConnectionKeeper defines the expected IBC connection keeper
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
@@ -29,5 +31,15 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	chainIDs := map[string]struct{}{}
+	for _, consumer := range gs.Consumers {
+		if err := consumer.Validate(); err != nil {
+			return err
+		}
+		if _, ok := chainIDs[consumer.ChainId]; ok {
+			return fmt.Errorf("duplicated consumer chain %s", consumer.ChainId)
+		}
+		chainIDs[consumer.ChainId] = struct{}{}
+	}
 	return nil
 }
@@ -26,6 +26,52 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid genesis state with consumers",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.DefaultParams(),
+				Consumers: []*types.ConsumerMetadata{
+					types.NewConsumerMetadata("chain-a", "Chain A", "", "07-tendermint-0", ""),
+					types.NewConsumerMetadata("chain-b", "Chain B", "a consumer chain", "07-tendermint-1", "channel-0"),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated consumers",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.DefaultParams(),
+				Consumers: []*types.ConsumerMetadata{
+					types.NewConsumerMetadata("chain-a", "Chain A", "", "07-tendermint-0", ""),
+					types.NewConsumerMetadata("chain-a", "Chain A", "", "07-tendermint-0", ""),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "consumer with invalid channel ID",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.DefaultParams(),
+				Consumers: []*types.ConsumerMetadata{
+					types.NewConsumerMetadata("chain-a", "Chain A", "", "07-tendermint-0", "c"),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "consumer without client ID",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.DefaultParams(),
+				Consumers: []*types.ConsumerMetadata{
+					types.NewConsumerMetadata("chain-a", "Chain A", "", "", "channel-0"),
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
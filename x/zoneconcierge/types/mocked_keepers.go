@@ -340,6 +340,18 @@ func (mr *MockClientKeeperMockRecorder) GetClientState(ctx, clientID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientState", reflect.TypeOf((*MockClientKeeper)(nil).GetClientState), ctx, clientID)
 }
 
+// IterateClientStates mocks base method.
+func (m *MockClientKeeper) IterateClientStates(ctx types4.Context, storeprefix []byte, cb func(string, exported.ClientState) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateClientStates", ctx, storeprefix, cb)
+}
+
+// IterateClientStates indicates an expected call of IterateClientStates.
+func (mr *MockClientKeeperMockRecorder) IterateClientStates(ctx, storeprefix, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateClientStates", reflect.TypeOf((*MockClientKeeper)(nil).IterateClientStates), ctx, storeprefix, cb)
+}
+
 // SetClientState mocks base method.
 func (m *MockClientKeeper) SetClientState(ctx types4.Context, clientID string, clientState exported.ClientState) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,83 @@
+package types
+
+import (
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+const (
+	// MaxConsumerNameLength is the maximum length of the name of a consumer chain
+	MaxConsumerNameLength = 128
+	// MaxConsumerDescriptionLength is the maximum length of the description of
+	// a consumer chain
+	MaxConsumerDescriptionLength = 1024
+	// MaxSlashingEvidenceLength is the maximum length of the evidence reported
+	// by a consumer chain
+	MaxSlashingEvidenceLength = 64 * 1024
+)
+
+func NewConsumerRegisterPacketData(name string, description string) *ZoneconciergePacketData {
+	return &ZoneconciergePacketData{
+		Packet: &ZoneconciergePacketData_ConsumerRegister{
+			ConsumerRegister: &ConsumerRegisterIBCPacket{
+				ConsumerName:        name,
+				ConsumerDescription: description,
+			},
+		},
+	}
+}
+
+func NewConsumerSlashingPacketData(fpBTCPK *bbn.BIP340PubKey, blockHeight uint64, evidence []byte) *ZoneconciergePacketData {
+	return &ZoneconciergePacketData{
+		Packet: &ZoneconciergePacketData_ConsumerSlashing{
+			ConsumerSlashing: &ConsumerSlashingIBCPacket{
+				FpBtcPkHex:  fpBTCPK.MarshalHex(),
+				BlockHeight: blockHeight,
+				Evidence:    evidence,
+			},
+		},
+	}
+}
+
+func NewBTCTimestampAckPacketData(epochNum uint64) *ZoneconciergePacketData {
+	return &ZoneconciergePacketData{
+		Packet: &ZoneconciergePacketData_BtcTimestampAck{
+			BtcTimestampAck: &BTCTimestampAckIBCPacket{
+				EpochNum: epochNum,
+			},
+		},
+	}
+}
+
+func (p *ConsumerRegisterIBCPacket) ValidateBasic() error {
+	if len(p.ConsumerName) == 0 {
+		return ErrInvalidPacket.Wrap("empty consumer name")
+	}
+	if len(p.ConsumerName) > MaxConsumerNameLength {
+		return ErrInvalidPacket.Wrapf("consumer name is longer than %d", MaxConsumerNameLength)
+	}
+	if len(p.ConsumerDescription) > MaxConsumerDescriptionLength {
+		return ErrInvalidPacket.Wrapf("consumer description is longer than %d", MaxConsumerDescriptionLength)
+	}
+	return nil
+}
+
+func (p *ConsumerSlashingIBCPacket) ValidateBasic() error {
+	if _, err := bbn.NewBIP340PubKeyFromHex(p.FpBtcPkHex); err != nil {
+		return ErrInvalidPacket.Wrapf("invalid finality provider BTC PK: %v", err)
+	}
+	if len(p.Evidence) == 0 {
+		return ErrInvalidPacket.Wrap("empty slashing evidence")
+	}
+	if len(p.Evidence) > MaxSlashingEvidenceLength {
+		return ErrInvalidPacket.Wrapf("slashing evidence is longer than %d", MaxSlashingEvidenceLength)
+	}
+	return nil
+}
+
+func (p *BTCTimestampAckIBCPacket) ValidateBasic() error {
+	// Babylon does not send BTC timestamps before finalising epoch 1
+	if p.EpochNum == 0 {
+		return ErrInvalidPacket.Wrap("BTC timestamp of epoch 0 does not exist")
+	}
+	return nil
+}
@@ -33,6 +33,9 @@ type ZoneconciergePacketData struct {
 	//
 	// Types that are valid to be assigned to Packet:
 	//	*ZoneconciergePacketData_BtcTimestamp
+	//	*ZoneconciergePacketData_ConsumerRegister
+	//	*ZoneconciergePacketData_ConsumerSlashing
+	//	*ZoneconciergePacketData_BtcTimestampAck
 	Packet isZoneconciergePacketData_Packet `protobuf_oneof:"packet"`
 }
 
@@ -78,8 +81,20 @@ type isZoneconciergePacketData_Packet interface {
 type ZoneconciergePacketData_BtcTimestamp struct {
 	BtcTimestamp *BTCTimestamp `protobuf:"bytes,1,opt,name=btc_timestamp,json=btcTimestamp,proto3,oneof" json:"btc_timestamp,omitempty"`
 }
+type ZoneconciergePacketData_ConsumerRegister struct {
+	ConsumerRegister *ConsumerRegisterIBCPacket `protobuf:"bytes,2,opt,name=consumer_register,json=consumerRegister,proto3,oneof" json:"consumer_register,omitempty"`
+}
+type ZoneconciergePacketData_ConsumerSlashing struct {
+	ConsumerSlashing *ConsumerSlashingIBCPacket `protobuf:"bytes,3,opt,name=consumer_slashing,json=consumerSlashing,proto3,oneof" json:"consumer_slashing,omitempty"`
+}
+type ZoneconciergePacketData_BtcTimestampAck struct {
+	BtcTimestampAck *BTCTimestampAckIBCPacket `protobuf:"bytes,4,opt,name=btc_timestamp_ack,json=btcTimestampAck,proto3,oneof" json:"btc_timestamp_ack,omitempty"`
+}
 
-func (*ZoneconciergePacketData_BtcTimestamp) isZoneconciergePacketData_Packet() {}
+func (*ZoneconciergePacketData_BtcTimestamp) isZoneconciergePacketData_Packet()     {}
+func (*ZoneconciergePacketData_ConsumerRegister) isZoneconciergePacketData_Packet() {}
+func (*ZoneconciergePacketData_ConsumerSlashing) isZoneconciergePacketData_Packet() {}
+func (*ZoneconciergePacketData_BtcTimestampAck) isZoneconciergePacketData_Packet()  {}
 
 func (m *ZoneconciergePacketData) GetPacket() isZoneconciergePacketData_Packet {
 	if m != nil {
@@ -95,11 +110,206 @@ func (m *ZoneconciergePacketData) GetBtcTimestamp() *BTCTimestamp {
 	return nil
 }
 
+func (m *ZoneconciergePacketData) GetConsumerRegister() *ConsumerRegisterIBCPacket {
+	if x, ok := m.GetPacket().(*ZoneconciergePacketData_ConsumerRegister); ok {
+		return x.ConsumerRegister
+	}
+	return nil
+}
+
+func (m *ZoneconciergePacketData) GetConsumerSlashing() *ConsumerSlashingIBCPacket {
+	if x, ok := m.GetPacket().(*ZoneconciergePacketData_ConsumerSlashing); ok {
+		return x.ConsumerSlashing
+	}
+	return nil
+}
+
+func (m *ZoneconciergePacketData) GetBtcTimestampAck() *BTCTimestampAckIBCPacket {
+	if x, ok := m.GetPacket().(*ZoneconciergePacketData_BtcTimestampAck); ok {
+		return x.BtcTimestampAck
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ZoneconciergePacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ZoneconciergePacketData_BtcTimestamp)(nil),
+		(*ZoneconciergePacketData_ConsumerRegister)(nil),
+		(*ZoneconciergePacketData_ConsumerSlashing)(nil),
+		(*ZoneconciergePacketData_BtcTimestampAck)(nil),
+	}
+}
+
+// ConsumerRegisterIBCPacket is sent by a consumer chain to register or update
+// its metadata in Babylon
+type ConsumerRegisterIBCPacket struct {
+	// consumer_name is the name of the consumer chain
+	ConsumerName string `protobuf:"bytes,1,opt,name=consumer_name,json=consumerName,proto3" json:"consumer_name,omitempty"`
+	// consumer_description is a description of the consumer chain
+	ConsumerDescription string `protobuf:"bytes,2,opt,name=consumer_description,json=consumerDescription,proto3" json:"consumer_description,omitempty"`
+}
+
+func (m *ConsumerRegisterIBCPacket) Reset()         { *m = ConsumerRegisterIBCPacket{} }
+func (m *ConsumerRegisterIBCPacket) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRegisterIBCPacket) ProtoMessage()    {}
+func (*ConsumerRegisterIBCPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be12e124c5c4fdb9, []int{1}
+}
+func (m *ConsumerRegisterIBCPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRegisterIBCPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRegisterIBCPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerRegisterIBCPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRegisterIBCPacket.Merge(m, src)
+}
+func (m *ConsumerRegisterIBCPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRegisterIBCPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRegisterIBCPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerRegisterIBCPacket proto.InternalMessageInfo
+
+func (m *ConsumerRegisterIBCPacket) GetConsumerName() string {
+	if m != nil {
+		return m.ConsumerName
+	}
+	return ""
+}
+
+func (m *ConsumerRegisterIBCPacket) GetConsumerDescription() string {
+	if m != nil {
+		return m.ConsumerDescription
+	}
+	return ""
+}
+
+// ConsumerSlashingIBCPacket is sent by a consumer chain to report that a
+// finality provider of the consumer chain has been slashed
+type ConsumerSlashingIBCPacket struct {
+	// fp_btc_pk_hex is the hex string of the BTC PK of the slashed finality
+	// provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// block_height is the height of the consumer chain at which the finality
+	// provider is slashed
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// evidence is the evidence of the slashable offence, encoded by the
+	// consumer chain
+	Evidence []byte `protobuf:"bytes,3,opt,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (m *ConsumerSlashingIBCPacket) Reset()         { *m = ConsumerSlashingIBCPacket{} }
+func (m *ConsumerSlashingIBCPacket) String() string { return proto.CompactTextString(m) }
+func (*ConsumerSlashingIBCPacket) ProtoMessage()    {}
+func (*ConsumerSlashingIBCPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be12e124c5c4fdb9, []int{2}
+}
+func (m *ConsumerSlashingIBCPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerSlashingIBCPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerSlashingIBCPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerSlashingIBCPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerSlashingIBCPacket.Merge(m, src)
+}
+func (m *ConsumerSlashingIBCPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerSlashingIBCPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerSlashingIBCPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerSlashingIBCPacket proto.InternalMessageInfo
+
+func (m *ConsumerSlashingIBCPacket) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+func (m *ConsumerSlashingIBCPacket) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ConsumerSlashingIBCPacket) GetEvidence() []byte {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+// BTCTimestampAckIBCPacket is sent by a consumer chain to acknowledge that the
+// BTC timestamp of the given epoch has been verified and processed
+type BTCTimestampAckIBCPacket struct {
+	// epoch_num is the epoch number of the acknowledged BTC timestamp
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+}
+
+func (m *BTCTimestampAckIBCPacket) Reset()         { *m = BTCTimestampAckIBCPacket{} }
+func (m *BTCTimestampAckIBCPacket) String() string { return proto.CompactTextString(m) }
+func (*BTCTimestampAckIBCPacket) ProtoMessage()    {}
+func (*BTCTimestampAckIBCPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be12e124c5c4fdb9, []int{3}
+}
+func (m *BTCTimestampAckIBCPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCTimestampAckIBCPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCTimestampAckIBCPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCTimestampAckIBCPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCTimestampAckIBCPacket.Merge(m, src)
+}
+func (m *BTCTimestampAckIBCPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCTimestampAckIBCPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCTimestampAckIBCPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCTimestampAckIBCPacket proto.InternalMessageInfo
+
+func (m *BTCTimestampAckIBCPacket) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
 	}
+	return 0
 }
 
 // BTCTimestamp is a BTC timestamp that carries information of a BTC-finalised epoch
@@ -130,7 +340,7 @@ func (m *BTCTimestamp) Reset()         { *m = BTCTimestamp{} }
 func (m *BTCTimestamp) String() string { return proto.CompactTextString(m) }
 func (*BTCTimestamp) ProtoMessage()    {}
 func (*BTCTimestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be12e124c5c4fdb9, []int{1}
+	return fileDescriptor_be12e124c5c4fdb9, []int{4}
 }
 func (m *BTCTimestamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -203,6 +413,9 @@ func (m *BTCTimestamp) GetProof() *ProofFinalizedChainInfo {
 
 func init() {
 	proto.RegisterType((*ZoneconciergePacketData)(nil), "babylon.zoneconcierge.v1.ZoneconciergePacketData")
+	proto.RegisterType((*ConsumerRegisterIBCPacket)(nil), "babylon.zoneconcierge.v1.ConsumerRegisterIBCPacket")
+	proto.RegisterType((*ConsumerSlashingIBCPacket)(nil), "babylon.zoneconcierge.v1.ConsumerSlashingIBCPacket")
+	proto.RegisterType((*BTCTimestampAckIBCPacket)(nil), "babylon.zoneconcierge.v1.BTCTimestampAckIBCPacket")
 	proto.RegisterType((*BTCTimestamp)(nil), "babylon.zoneconcierge.v1.BTCTimestamp")
 }
 
@@ -211,37 +424,51 @@ func init() {
 }
 
 var fileDescriptor_be12e124c5c4fdb9 = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x1b, 0xca, 0x2a, 0x70, 0x37, 0x84, 0x7c, 0x21, 0xda, 0x21, 0x9a, 0x2a, 0x01, 0x45,
-	0x9a, 0x1c, 0x65, 0x88, 0x03, 0x27, 0xa4, 0x96, 0x3f, 0xab, 0x10, 0x30, 0x85, 0x71, 0xd9, 0xa5,
-	0xb2, 0xdd, 0xb7, 0x8d, 0xd5, 0xd6, 0x8e, 0x12, 0xaf, 0x5b, 0xf7, 0x29, 0xf8, 0x52, 0x48, 0x1c,
-	0x77, 0xe4, 0x88, 0xda, 0x2f, 0x82, 0xec, 0xfc, 0x69, 0x52, 0x94, 0x4b, 0xe4, 0xf7, 0xc9, 0xcf,
-	0x8f, 0xfd, 0x3e, 0x7e, 0xd1, 0x73, 0x46, 0xd9, 0x7a, 0xa1, 0xa4, 0x7f, 0xa7, 0x24, 0x70, 0x25,
-	0xb9, 0x80, 0x64, 0x06, 0xfe, 0x2a, 0xf0, 0x63, 0xca, 0xe7, 0xa0, 0x49, 0x9c, 0x28, 0xad, 0xb0,
-	0x9b, 0x63, 0xa4, 0x86, 0x91, 0x55, 0x70, 0x7c, 0x5a, 0x18, 0x30, 0xcd, 0x79, 0x04, 0x7c, 0x1e,
-	0x2b, 0x21, 0xb5, 0x31, 0xa8, 0x09, 0x99, 0xcf, 0xf1, 0xab, 0x82, 0xde, 0xfd, 0x11, 0x72, 0x66,
-	0xe8, 0xff, 0x50, 0x52, 0x31, 0x5e, 0x88, 0x59, 0x64, 0xbe, 0x50, 0x3a, 0x57, 0x94, 0x9c, 0xef,
-	0x15, 0x3c, 0xc4, 0x8a, 0x47, 0xb9, 0x6b, 0xb1, 0xce, 0x99, 0xd3, 0xc6, 0x6e, 0xeb, 0x7d, 0x59,
-	0xba, 0x97, 0xa0, 0x67, 0x57, 0x55, 0xf9, 0xc2, 0x26, 0xf2, 0x9e, 0x6a, 0x8a, 0xbf, 0xa0, 0x23,
-	0xa6, 0xf9, 0x58, 0x8b, 0x25, 0xa4, 0x9a, 0x2e, 0x63, 0xd7, 0x39, 0x71, 0xfa, 0xdd, 0xb3, 0x17,
-	0xa4, 0x29, 0x27, 0x32, 0xb8, 0x1c, 0x5e, 0x16, 0xf4, 0x79, 0x2b, 0x3c, 0x64, 0x9a, 0x97, 0xf5,
-	0xe0, 0x11, 0xea, 0x64, 0x71, 0xf7, 0x7e, 0xb5, 0xd1, 0x61, 0x15, 0xc5, 0xef, 0x50, 0x27, 0x02,
-	0x3a, 0x81, 0x24, 0x3f, 0xe2, 0x65, 0xf3, 0x11, 0x23, 0x39, 0x81, 0x5b, 0x98, 0x9c, 0x5b, 0x3c,
-	0xcc, 0xb7, 0xe1, 0x11, 0xea, 0x9a, 0xab, 0x66, 0x55, 0xea, 0x3e, 0x38, 0x69, 0xf7, 0xbb, 0x67,
-	0xfd, 0xd2, 0x65, 0x2f, 0xcb, 0xec, 0xa6, 0x99, 0xc5, 0x48, 0x4e, 0x55, 0x88, 0x98, 0xe6, 0x59,
-	0x99, 0xe2, 0xb7, 0x08, 0xd9, 0x40, 0xc7, 0x42, 0x4e, 0x95, 0xdb, 0xb6, 0xf7, 0x29, 0xdf, 0x89,
-	0x94, 0x59, 0xaf, 0x02, 0xf2, 0xc1, 0xac, 0xc3, 0xc7, 0x56, 0x32, 0x36, 0xf8, 0x2b, 0x7a, 0x92,
-	0xd0, 0x9b, 0xf1, 0xee, 0x95, 0xdd, 0x87, 0x7b, 0xed, 0xd4, 0x26, 0xc2, 0x78, 0x84, 0xf4, 0x66,
-	0x58, 0x6a, 0xe1, 0x51, 0x52, 0x2d, 0xf1, 0x0f, 0x84, 0x4d, 0x57, 0xe9, 0x35, 0x5b, 0x8a, 0x34,
-	0x15, 0x4a, 0x8e, 0xe7, 0xb0, 0x76, 0x0f, 0xf6, 0x3c, 0xeb, 0x23, 0xb8, 0x0a, 0xc8, 0xf7, 0x92,
-	0xff, 0x0c, 0xeb, 0xf0, 0x29, 0xd3, 0xbc, 0xa6, 0xe0, 0x4f, 0xe8, 0x20, 0x4e, 0x94, 0x9a, 0xba,
-	0x1d, 0xeb, 0x14, 0x34, 0x87, 0x7d, 0x61, 0xb0, 0x8f, 0x42, 0xd2, 0x85, 0xb8, 0x83, 0xc9, 0x30,
-	0xa2, 0x42, 0xda, 0xbc, 0xb2, 0xfd, 0x83, 0x6f, 0xbf, 0x37, 0x9e, 0x73, 0xbf, 0xf1, 0x9c, 0xbf,
-	0x1b, 0xcf, 0xf9, 0xb9, 0xf5, 0x5a, 0xf7, 0x5b, 0xaf, 0xf5, 0x67, 0xeb, 0xb5, 0xae, 0xde, 0xcc,
-	0x84, 0x8e, 0xae, 0x19, 0xe1, 0x6a, 0xe9, 0xe7, 0xee, 0xdc, 0xec, 0x2e, 0x0a, 0xff, 0x76, 0x6f,
-	0x3a, 0xf5, 0x3a, 0x86, 0x94, 0x75, 0xec, 0x4c, 0xbe, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x31,
-	0xea, 0x29, 0xbc, 0xb1, 0x03, 0x00, 0x00,
+	// 691 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6a, 0xdb, 0x4a,
+	0x10, 0xb6, 0x63, 0xc7, 0x24, 0x6b, 0xfb, 0x9c, 0x64, 0xcf, 0x81, 0xa3, 0x93, 0x82, 0x49, 0x5c,
+	0xda, 0xa6, 0x10, 0x64, 0x9c, 0x50, 0x4a, 0xaf, 0x4a, 0xec, 0xb4, 0xb5, 0x29, 0x4d, 0xc3, 0x26,
+	0xbd, 0xc9, 0x8d, 0xba, 0x5a, 0xaf, 0xed, 0x45, 0xd6, 0xae, 0x90, 0xd6, 0x8e, 0x1d, 0xe8, 0x3b,
+	0xf4, 0x01, 0xfa, 0x3a, 0x85, 0x5e, 0xe6, 0xb2, 0x97, 0x25, 0x79, 0x91, 0xb2, 0xab, 0x1f, 0x4b,
+	0x4e, 0x05, 0xb9, 0x11, 0x9a, 0x99, 0x6f, 0xbe, 0xd9, 0xf9, 0x76, 0x76, 0xc0, 0x13, 0x1b, 0xdb,
+	0x8b, 0x89, 0xe0, 0xad, 0x6b, 0xc1, 0x29, 0x11, 0x9c, 0x30, 0xea, 0x8f, 0x68, 0x6b, 0xd6, 0x6e,
+	0x79, 0x98, 0x38, 0x54, 0x9a, 0x9e, 0x2f, 0xa4, 0x80, 0x46, 0x04, 0x33, 0x33, 0x30, 0x73, 0xd6,
+	0xde, 0x39, 0x88, 0x09, 0x6c, 0x49, 0xc8, 0x98, 0x12, 0xc7, 0x13, 0x8c, 0x4b, 0x45, 0x90, 0x71,
+	0x84, 0x3c, 0x3b, 0xcf, 0x63, 0xf4, 0x32, 0xc2, 0xf8, 0x48, 0xa1, 0xef, 0x41, 0xcd, 0x14, 0xf1,
+	0x84, 0x8d, 0xc6, 0xea, 0x4b, 0x13, 0xe6, 0x94, 0x27, 0xc2, 0x37, 0x63, 0x3c, 0xf5, 0x04, 0x19,
+	0x47, 0xac, 0xf1, 0x7f, 0x84, 0x39, 0xc8, 0xed, 0x36, 0xdb, 0x97, 0x46, 0x37, 0xbf, 0x95, 0xc0,
+	0x7f, 0x97, 0x69, 0xff, 0x99, 0x96, 0xe4, 0x04, 0x4b, 0x0c, 0x3f, 0x80, 0xba, 0x2d, 0x89, 0x25,
+	0x99, 0x4b, 0x03, 0x89, 0x5d, 0xcf, 0x28, 0xee, 0x16, 0xf7, 0xab, 0x87, 0x4f, 0xcd, 0x3c, 0xa1,
+	0xcc, 0xce, 0x45, 0xf7, 0x22, 0x46, 0xf7, 0x0a, 0xa8, 0x66, 0x4b, 0x92, 0xd8, 0xd0, 0x06, 0xdb,
+	0x44, 0xf0, 0x60, 0xea, 0x52, 0xdf, 0xf2, 0xe9, 0x88, 0x05, 0x92, 0xfa, 0xc6, 0x9a, 0xa6, 0x3c,
+	0xca, 0xa7, 0xec, 0x46, 0x29, 0x28, 0xca, 0xe8, 0x77, 0xba, 0xe1, 0x11, 0x7b, 0x05, 0xb4, 0x45,
+	0x56, 0x82, 0x99, 0x1a, 0xc1, 0x04, 0x07, 0x4a, 0x17, 0xa3, 0xf4, 0xd0, 0x1a, 0xe7, 0x51, 0xc6,
+	0x1f, 0x6b, 0xc4, 0x41, 0xf8, 0x19, 0x6c, 0x67, 0x64, 0xb1, 0x30, 0x71, 0x8c, 0xb2, 0xae, 0x71,
+	0xf8, 0x30, 0x69, 0x8e, 0x89, 0x93, 0x2e, 0xf1, 0x77, 0x5a, 0xa6, 0x63, 0xe2, 0x74, 0x36, 0x40,
+	0x25, 0x9c, 0xcc, 0x66, 0x00, 0xfe, 0xcf, 0x15, 0x00, 0x3e, 0x06, 0xf5, 0xa4, 0x59, 0x8e, 0x5d,
+	0xaa, 0xef, 0x67, 0x13, 0xd5, 0x62, 0xe7, 0x29, 0x76, 0x29, 0x6c, 0x83, 0x7f, 0x13, 0xd0, 0x80,
+	0x06, 0xc4, 0x67, 0x9e, 0x64, 0x82, 0x6b, 0xe1, 0x37, 0xd1, 0x3f, 0x71, 0xec, 0x64, 0x19, 0x6a,
+	0x7e, 0x59, 0x16, 0xbd, 0xa7, 0x08, 0xdc, 0x03, 0xf5, 0xa1, 0x67, 0x29, 0x01, 0x3c, 0xc7, 0x1a,
+	0xd3, 0x79, 0x54, 0x14, 0x0c, 0xbd, 0x8e, 0x24, 0x67, 0x4e, 0x8f, 0xce, 0xe1, 0x1e, 0xa8, 0xd9,
+	0x13, 0x41, 0x54, 0x58, 0x4d, 0xb0, 0x2e, 0x55, 0x46, 0x55, 0xed, 0xeb, 0x69, 0x17, 0xdc, 0x01,
+	0x1b, 0x74, 0xc6, 0x06, 0x94, 0x13, 0xaa, 0xaf, 0xa7, 0x86, 0x12, 0xbb, 0xf9, 0x12, 0x18, 0x79,
+	0x62, 0xc1, 0x47, 0x60, 0x53, 0x8f, 0xbb, 0xc5, 0xa7, 0xae, 0xae, 0x5c, 0x46, 0x1b, 0xda, 0x71,
+	0x3a, 0x75, 0x9b, 0xdf, 0x4b, 0xa0, 0x96, 0xce, 0x84, 0xaf, 0x41, 0x65, 0x4c, 0xf1, 0x80, 0xfa,
+	0xd1, 0xe4, 0x3e, 0xcb, 0xbf, 0x9e, 0x3e, 0x1f, 0xd0, 0x39, 0x1d, 0xf4, 0x34, 0x1c, 0x45, 0x69,
+	0xb0, 0x0f, 0xaa, 0xaa, 0xd3, 0xd0, 0x0a, 0x8c, 0xb5, 0xdd, 0xd2, 0x7e, 0xf5, 0x70, 0x3f, 0x61,
+	0x59, 0x79, 0xa3, 0xe1, 0x2d, 0x87, 0x14, 0x7d, 0x3e, 0x14, 0x08, 0xd8, 0x92, 0x84, 0x66, 0x00,
+	0x5f, 0x01, 0x10, 0x9e, 0x9c, 0xf1, 0xa1, 0x88, 0x46, 0x32, 0x79, 0xff, 0x66, 0xf2, 0x86, 0x67,
+	0x6d, 0xf3, 0x8d, 0xfa, 0x47, 0x61, 0x9f, 0x8a, 0x06, 0x9e, 0x82, 0xbf, 0x7c, 0x7c, 0x65, 0x2d,
+	0xb7, 0x87, 0x51, 0x5e, 0x69, 0x27, 0xb3, 0x69, 0x14, 0x07, 0xc2, 0x57, 0xdd, 0xc4, 0x87, 0xea,
+	0x7e, 0xda, 0x84, 0x9f, 0x00, 0x54, 0x5d, 0x05, 0x53, 0xdb, 0x65, 0x41, 0xc0, 0x04, 0xb7, 0x1c,
+	0xba, 0x30, 0xd6, 0x57, 0x38, 0xb3, 0xab, 0x6d, 0xd6, 0x36, 0xcf, 0x13, 0xfc, 0x7b, 0xba, 0x40,
+	0x5b, 0xb6, 0x24, 0x19, 0x0f, 0x7c, 0x07, 0xd6, 0x3d, 0x5f, 0x88, 0xa1, 0x51, 0xd1, 0x4c, 0xed,
+	0x7c, 0xb1, 0xcf, 0x14, 0xec, 0x2d, 0xe3, 0x78, 0xc2, 0xae, 0xe9, 0xa0, 0x3b, 0xc6, 0x8c, 0x6b,
+	0xbd, 0xc2, 0xfc, 0xce, 0xc7, 0x1f, 0xb7, 0x8d, 0xe2, 0xcd, 0x6d, 0xa3, 0xf8, 0xeb, 0xb6, 0x51,
+	0xfc, 0x7a, 0xd7, 0x28, 0xdc, 0xdc, 0x35, 0x0a, 0x3f, 0xef, 0x1a, 0x85, 0xcb, 0x17, 0x23, 0x26,
+	0xc7, 0x53, 0xdb, 0x24, 0xc2, 0x6d, 0x45, 0xec, 0x44, 0x65, 0xc7, 0x46, 0x6b, 0xbe, 0xb2, 0xf5,
+	0xe4, 0xc2, 0xa3, 0x81, 0x5d, 0xd1, 0xbb, 0xee, 0xe8, 0xf7, 0x00, 0x01, 0x20, 0xbb, 0x8e, 0x09,
+	0x06, 0x00, 0x00,
 }
 
 func (m *ZoneconciergePacketData) Marshal() (dAtA []byte, err error) {
@@ -297,6 +524,176 @@ func (m *ZoneconciergePacketData_BtcTimestamp) MarshalToSizedBuffer(dAtA []byte)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ZoneconciergePacketData_ConsumerRegister) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneconciergePacketData_ConsumerRegister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConsumerRegister != nil {
+		{
+			size, err := m.ConsumerRegister.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ZoneconciergePacketData_ConsumerSlashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneconciergePacketData_ConsumerSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConsumerSlashing != nil {
+		{
+			size, err := m.ConsumerSlashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ZoneconciergePacketData_BtcTimestampAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneconciergePacketData_BtcTimestampAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BtcTimestampAck != nil {
+		{
+			size, err := m.BtcTimestampAck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConsumerRegisterIBCPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRegisterIBCPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRegisterIBCPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerDescription) > 0 {
+		i -= len(m.ConsumerDescription)
+		copy(dAtA[i:], m.ConsumerDescription)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ConsumerDescription)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerName) > 0 {
+		i -= len(m.ConsumerName)
+		copy(dAtA[i:], m.ConsumerName)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ConsumerName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerSlashingIBCPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerSlashingIBCPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerSlashingIBCPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCTimestampAckIBCPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCTimestampAckIBCPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCTimestampAckIBCPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BTCTimestamp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -429,31 +826,116 @@ func (m *ZoneconciergePacketData_BtcTimestamp) Size() (n int) {
 	}
 	return n
 }
-func (m *BTCTimestamp) Size() (n int) {
+func (m *ZoneconciergePacketData_ConsumerRegister) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
+	if m.ConsumerRegister != nil {
+		l = m.ConsumerRegister.Size()
 		n += 1 + l + sovPacket(uint64(l))
 	}
-	if len(m.BtcHeaders) > 0 {
-		for _, e := range m.BtcHeaders {
-			l = e.Size()
-			n += 1 + l + sovPacket(uint64(l))
-		}
+	return n
+}
+func (m *ZoneconciergePacketData_ConsumerSlashing) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.EpochInfo != nil {
-		l = m.EpochInfo.Size()
+	var l int
+	_ = l
+	if m.ConsumerSlashing != nil {
+		l = m.ConsumerSlashing.Size()
 		n += 1 + l + sovPacket(uint64(l))
 	}
-	if m.RawCheckpoint != nil {
-		l = m.RawCheckpoint.Size()
+	return n
+}
+func (m *ZoneconciergePacketData_BtcTimestampAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcTimestampAck != nil {
+		l = m.BtcTimestampAck.Size()
 		n += 1 + l + sovPacket(uint64(l))
 	}
-	if m.BtcSubmissionKey != nil {
+	return n
+}
+func (m *ConsumerRegisterIBCPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerName)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.ConsumerDescription)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerSlashingIBCPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovPacket(uint64(m.BlockHeight))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCTimestampAckIBCPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovPacket(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *BTCTimestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.BtcHeaders) > 0 {
+		for _, e := range m.BtcHeaders {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.EpochInfo != nil {
+		l = m.EpochInfo.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.RawCheckpoint != nil {
+		l = m.RawCheckpoint.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.BtcSubmissionKey != nil {
 		l = m.BtcSubmissionKey.Size()
 		n += 1 + l + sovPacket(uint64(l))
 	}
@@ -534,6 +1016,429 @@ func (m *ZoneconciergePacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Packet = &ZoneconciergePacketData_BtcTimestamp{v}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRegister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConsumerRegisterIBCPacket{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &ZoneconciergePacketData_ConsumerRegister{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerSlashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConsumerSlashingIBCPacket{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &ZoneconciergePacketData_ConsumerSlashing{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcTimestampAck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BTCTimestampAckIBCPacket{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &ZoneconciergePacketData_BtcTimestampAck{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRegisterIBCPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRegisterIBCPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRegisterIBCPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerDescription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerSlashingIBCPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerSlashingIBCPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerSlashingIBCPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence[:0], dAtA[iNdEx:postIndex]...)
+			if m.Evidence == nil {
+				m.Evidence = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCTimestampAckIBCPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCTimestampAckIBCPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCTimestampAckIBCPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -10,15 +10,17 @@ const (
 )
 
 // NewParams creates a new Params instance
-func NewParams(ibcPacketTimeoutSeconds uint32) Params {
+func NewParams(ibcPacketTimeoutSeconds uint32, wasmCustomMsgsEnabled bool) Params {
 	return Params{
 		IbcPacketTimeoutSeconds: ibcPacketTimeoutSeconds,
+		WasmCustomMsgsEnabled:   wasmCustomMsgsEnabled,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultIbcPacketTimeoutSeconds)
+	// CosmWasm custom messages are disabled by default
+	return NewParams(DefaultIbcPacketTimeoutSeconds, false)
 }
 
 // Validate validates the set of params
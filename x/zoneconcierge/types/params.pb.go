@@ -28,6 +28,9 @@ type Params struct {
 	// ibc_packet_timeout_seconds is the time period after which an unrelayed
 	// IBC packet becomes timeout, measured in seconds
 	IbcPacketTimeoutSeconds uint32 `protobuf:"varint,1,opt,name=ibc_packet_timeout_seconds,json=ibcPacketTimeoutSeconds,proto3" json:"ibc_packet_timeout_seconds,omitempty" yaml:"ibc_packet_timeout_seconds"`
+	// wasm_custom_msgs_enabled specifies whether CosmWasm contracts are allowed
+	// to submit Babylon custom messages
+	WasmCustomMsgsEnabled bool `protobuf:"varint,2,opt,name=wasm_custom_msgs_enabled,json=wasmCustomMsgsEnabled,proto3" json:"wasm_custom_msgs_enabled,omitempty" yaml:"wasm_custom_msgs_enabled"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +73,13 @@ func (m *Params) GetIbcPacketTimeoutSeconds() uint32 {
 	return 0
 }
 
+func (m *Params) GetWasmCustomMsgsEnabled() bool {
+	if m != nil {
+		return m.WasmCustomMsgsEnabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.zoneconcierge.v1.Params")
 }
@@ -79,22 +89,25 @@ func init() {
 }
 
 var fileDescriptor_c0696c936eb15fe4 = []byte{
-	// 227 bytes of a gzipped FileDescriptorProto
+	// 284 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0x4a, 0x4c, 0xaa,
 	0xcc, 0xc9, 0xcf, 0xd3, 0xaf, 0xca, 0xcf, 0x4b, 0x4d, 0xce, 0xcf, 0x4b, 0xce, 0x4c, 0x2d, 0x4a,
 	0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x92, 0x80, 0x2a, 0xd3, 0x43, 0x51, 0xa6, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0x15, 0x71, 0xb1, 0x05, 0x80, 0xf5, 0x0b,
-	0x25, 0x71, 0x49, 0x65, 0x26, 0x25, 0xc7, 0x17, 0x24, 0x26, 0x67, 0xa7, 0x96, 0xc4, 0x97, 0x64,
-	0xe6, 0xa6, 0xe6, 0x97, 0x96, 0xc4, 0x17, 0x83, 0x0c, 0x49, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd4,
-	0xe0, 0x75, 0x52, 0xfd, 0x74, 0x4f, 0x5e, 0xb1, 0x32, 0x31, 0x37, 0xc7, 0x4a, 0x09, 0xb7, 0x5a,
-	0xa5, 0x20, 0xf1, 0xcc, 0xa4, 0xe4, 0x00, 0xb0, 0x5c, 0x08, 0x44, 0x2a, 0x18, 0x22, 0x63, 0xc5,
-	0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x93, 0xff, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
-	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
-	0x44, 0x99, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x3d, 0x92,
-	0x9c, 0x91, 0x98, 0x99, 0x07, 0xe3, 0xe8, 0x57, 0xa0, 0x79, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38,
-	0x89, 0x0d, 0xec, 0x17, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xf3, 0xe4, 0xef, 0x24,
-	0x01, 0x00, 0x00,
+	0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0x5d, 0x60, 0xe4, 0x62, 0x0b, 0x00, 0x1b,
+	0x20, 0x94, 0xc4, 0x25, 0x95, 0x99, 0x94, 0x1c, 0x5f, 0x90, 0x98, 0x9c, 0x9d, 0x5a, 0x12, 0x5f,
+	0x92, 0x99, 0x9b, 0x9a, 0x5f, 0x5a, 0x12, 0x5f, 0x0c, 0x32, 0x25, 0xa5, 0x58, 0x82, 0x51, 0x81,
+	0x51, 0x83, 0xd7, 0x49, 0xf5, 0xd3, 0x3d, 0x79, 0xc5, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0xdc,
+	0x6a, 0x95, 0x82, 0xc4, 0x33, 0x93, 0x92, 0x03, 0xc0, 0x72, 0x21, 0x10, 0xa9, 0x60, 0x88, 0x8c,
+	0x50, 0x0c, 0x97, 0x44, 0x79, 0x62, 0x71, 0x6e, 0x7c, 0x72, 0x69, 0x71, 0x49, 0x7e, 0x6e, 0x7c,
+	0x6e, 0x71, 0x7a, 0x71, 0x7c, 0x6a, 0x5e, 0x62, 0x52, 0x4e, 0x6a, 0x8a, 0x04, 0x93, 0x02, 0xa3,
+	0x06, 0x87, 0x93, 0xf2, 0xa7, 0x7b, 0xf2, 0xf2, 0x10, 0x1b, 0x70, 0xa9, 0x54, 0x0a, 0x12, 0x05,
+	0x49, 0x39, 0x83, 0x65, 0x7c, 0x8b, 0xd3, 0x8b, 0x5d, 0x21, 0xe2, 0x56, 0x2c, 0x2f, 0x16, 0xc8,
+	0x33, 0x3a, 0xf9, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
+	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x69, 0x7a,
+	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x34, 0x9c, 0x92, 0x33, 0x12, 0x33,
+	0xf3, 0x60, 0x1c, 0xfd, 0x0a, 0xb4, 0xd0, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07,
+	0x95, 0x31, 0x60, 0x00, 0xfc, 0x72, 0xa4, 0x3f, 0x83, 0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -119,6 +132,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.IbcPacketTimeoutSeconds != that1.IbcPacketTimeoutSeconds {
 		return false
 	}
+	if this.WasmCustomMsgsEnabled != that1.WasmCustomMsgsEnabled {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +157,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WasmCustomMsgsEnabled {
+		i--
+		if m.WasmCustomMsgsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.IbcPacketTimeoutSeconds != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.IbcPacketTimeoutSeconds))
 		i--
@@ -169,6 +195,9 @@ func (m *Params) Size() (n int) {
 	if m.IbcPacketTimeoutSeconds != 0 {
 		n += 1 + sovParams(uint64(m.IbcPacketTimeoutSeconds))
 	}
+	if m.WasmCustomMsgsEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -226,6 +255,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmCustomMsgsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WasmCustomMsgsEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
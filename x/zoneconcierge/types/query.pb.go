@@ -323,6 +323,202 @@ func (m *QueryChainListResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryConsumerRegistryListRequest is request type for the
+// Query/ConsumerRegistryList RPC method
+type QueryConsumerRegistryListRequest struct {
+	// pagination defines whether to have the pagination in the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsumerRegistryListRequest) Reset()         { *m = QueryConsumerRegistryListRequest{} }
+func (m *QueryConsumerRegistryListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRegistryListRequest) ProtoMessage()    {}
+func (*QueryConsumerRegistryListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd665af90102da38, []int{6}
+}
+func (m *QueryConsumerRegistryListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRegistryListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRegistryListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerRegistryListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRegistryListRequest.Merge(m, src)
+}
+func (m *QueryConsumerRegistryListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRegistryListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRegistryListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerRegistryListRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerRegistryListRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConsumerRegistryListResponse is response type for the
+// Query/ConsumerRegistryList RPC method
+type QueryConsumerRegistryListResponse struct {
+	// consumers are the registered consumer chains in ascending alphabetical
+	// order of chain IDs
+	Consumers []*ConsumerMetadata `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsumerRegistryListResponse) Reset()         { *m = QueryConsumerRegistryListResponse{} }
+func (m *QueryConsumerRegistryListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRegistryListResponse) ProtoMessage()    {}
+func (*QueryConsumerRegistryListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd665af90102da38, []int{7}
+}
+func (m *QueryConsumerRegistryListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRegistryListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRegistryListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerRegistryListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRegistryListResponse.Merge(m, src)
+}
+func (m *QueryConsumerRegistryListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRegistryListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRegistryListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerRegistryListResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerRegistryListResponse) GetConsumers() []*ConsumerMetadata {
+	if m != nil {
+		return m.Consumers
+	}
+	return nil
+}
+
+func (m *QueryConsumerRegistryListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConsumerRegistryRequest is request type for the Query/ConsumerRegistry
+// RPC method
+type QueryConsumerRegistryRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryConsumerRegistryRequest) Reset()         { *m = QueryConsumerRegistryRequest{} }
+func (m *QueryConsumerRegistryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRegistryRequest) ProtoMessage()    {}
+func (*QueryConsumerRegistryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd665af90102da38, []int{8}
+}
+func (m *QueryConsumerRegistryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRegistryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRegistryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerRegistryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRegistryRequest.Merge(m, src)
+}
+func (m *QueryConsumerRegistryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRegistryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRegistryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerRegistryRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerRegistryRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// QueryConsumerRegistryResponse is response type for the
+// Query/ConsumerRegistry RPC method
+type QueryConsumerRegistryResponse struct {
+	Consumer *ConsumerMetadata `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+}
+
+func (m *QueryConsumerRegistryResponse) Reset()         { *m = QueryConsumerRegistryResponse{} }
+func (m *QueryConsumerRegistryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRegistryResponse) ProtoMessage()    {}
+func (*QueryConsumerRegistryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd665af90102da38, []int{9}
+}
+func (m *QueryConsumerRegistryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRegistryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRegistryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerRegistryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRegistryResponse.Merge(m, src)
+}
+func (m *QueryConsumerRegistryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRegistryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRegistryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerRegistryResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerRegistryResponse) GetConsumer() *ConsumerMetadata {
+	if m != nil {
+		return m.Consumer
+	}
+	return nil
+}
+
 // QueryChainsInfoRequest is request type for the Query/ChainsInfo RPC method.
 type QueryChainsInfoRequest struct {
 	ChainIds []string `protobuf:"bytes,1,rep,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
@@ -332,7 +528,7 @@ func (m *QueryChainsInfoRequest) Reset()         { *m = QueryChainsInfoRequest{}
 func (m *QueryChainsInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryChainsInfoRequest) ProtoMessage()    {}
 func (*QueryChainsInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd665af90102da38, []int{6}
+	return fileDescriptor_cd665af90102da38, []int{10}
 }
 func (m *QueryChainsInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,7 +573,7 @@ func (m *QueryChainsInfoResponse) Reset()         { *m = QueryChainsInfoResponse
 func (m *QueryChainsInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryChainsInfoResponse) ProtoMessage()    {}
 func (*QueryChainsInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd665af90102da38, []int{7}
+	return fileDescriptor_cd665af90102da38, []int{11}
 }
 func (m *QueryChainsInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,7 +620,7 @@ func (m *QueryEpochChainsInfoRequest) Reset()         { *m = QueryEpochChainsInf
 func (m *QueryEpochChainsInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochChainsInfoRequest) ProtoMessage()    {}
 func (*QueryEpochChainsInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd665af90102da38, []int{8}
+	return fileDescriptor_cd665af90102da38, []int{12}
 }
 func (m *QueryEpochChainsInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +674,7 @@ func (m *QueryEpochChainsInfoResponse) Reset()         { *m = QueryEpochChainsIn
 func (m *QueryEpochChainsInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochChainsInfoResponse) ProtoMessage()    {}
 func (*QueryEpochChainsInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd665af90102da38, []int{9}
+	return fileDescriptor_cd665af90102da38, []int{13}
 }
 func (m *QueryEpochChainsInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -525,7 +721,7 @@ func (m *QueryListHeadersRequest) Reset()         { *m = QueryListHeadersRequest
 func (m *QueryListHeadersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListHeadersRequest) ProtoMessage()    {}
 func (*QueryListHeadersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd665af90102da38, []int{10}
+	return fileDescriptor_cd665af90102da38, []int{14}
 }
 func (m *QueryListHeadersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,7 +777,7 @@ func (m *QueryListHeadersResponse) Reset()         { *m = QueryListHeadersRespon
 func (m *QueryListHeadersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListHeadersResponse) ProtoMessage()    {}
 func (*QueryListHeadersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd665af90102da38, []int{11}
+	return fileDescriptor_cd665af90102da38, []int{15}
 }
 func (m *QueryListHeadersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +831,7 @@ func (m *QueryListEpochHeadersRequest) Reset()         { *m = QueryListEpochHead
 func (m *QueryListEpochHeadersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListEpochHeadersRequest) ProtoMessage()    {}
 func (*QueryListEpochHeadersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd665af90102da38, []int{12}
+	return fileDescriptor_cd665af90102da38, []int{16}
 }
 func (m *QueryListEpochHeadersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +885,7 @@ func (m *QueryListEpochHeadersResponse) Reset()         { *m = QueryListEpochHea
 func (m *QueryListEpochHeadersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListEpochHeadersResponse) ProtoMessage()    {}
 func (*QueryListEpochHeadersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd665af90102da38, []int{13}
+	return fileDescriptor_cd665af90102da38, []int{17}
 }
 func (m *QueryListEpochHeadersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -738,7 +934,7 @@ func (m *QueryFinalizedChainsInfoRequest) Reset()         { *m = QueryFinalizedC
 func (m *QueryFinalizedChainsInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalizedChainsInfoRequest) ProtoMessage()    {}
 func (*QueryFinalizedChainsInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd665af90102da38, []int{14}
+	return fileDescriptor_cd665af90102da38, []int{18}
 }
 func (m *QueryFinalizedChainsInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -791,7 +987,7 @@ func (m *QueryFinalizedChainsInfoResponse) Reset()         { *m = QueryFinalized
 func (m *QueryFinalizedChainsInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalizedChainsInfoResponse) ProtoMessage()    {}
 func (*QueryFinalizedChainsInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd665af90102da38, []int{15}
+	return fileDescriptor_cd665af90102da38, []int{19}
 }
 func (m *QueryFinalizedChainsInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -848,7 +1044,7 @@ func (m *QueryFinalizedChainInfoUntilHeightRequest) String() string {
 }
 func (*QueryFinalizedChainInfoUntilHeightRequest) ProtoMessage() {}
 func (*QueryFinalizedChainInfoUntilHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd665af90102da38, []int{16}
+	return fileDescriptor_cd665af90102da38, []int{20}
 }
 func (m *QueryFinalizedChainInfoUntilHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1118,7 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) String() string {
 }
 func (*QueryFinalizedChainInfoUntilHeightResponse) ProtoMessage() {}
 func (*QueryFinalizedChainInfoUntilHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd665af90102da38, []int{17}
+	return fileDescriptor_cd665af90102da38, []int{21}
 }
 func (m *QueryFinalizedChainInfoUntilHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -986,6 +1182,196 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) GetProof() *ProofFinalizedC
 	return nil
 }
 
+// QueryBTCTimestampDeliveryRequest is request type for the
+// Query/BTCTimestampDelivery RPC method.
+type QueryBTCTimestampDeliveryRequest struct {
+}
+
+func (m *QueryBTCTimestampDeliveryRequest) Reset()         { *m = QueryBTCTimestampDeliveryRequest{} }
+func (m *QueryBTCTimestampDeliveryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCTimestampDeliveryRequest) ProtoMessage()    {}
+func (*QueryBTCTimestampDeliveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd665af90102da38, []int{22}
+}
+func (m *QueryBTCTimestampDeliveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCTimestampDeliveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCTimestampDeliveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCTimestampDeliveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCTimestampDeliveryRequest.Merge(m, src)
+}
+func (m *QueryBTCTimestampDeliveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCTimestampDeliveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCTimestampDeliveryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCTimestampDeliveryRequest proto.InternalMessageInfo
+
+// ChannelDeliveryStatus is the status of delivering BTC timestamps through a
+// ZoneConcierge channel
+type ChannelDeliveryStatus struct {
+	// channel_id is the ID of the channel
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// chain_id is the ID of the consumer chain at the other end of the channel.
+	// It is empty if the counterparty chain is not a registered consumer chain
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// last_sent_epoch is the last epoch whose BTC timestamp is sent through the
+	// channel
+	LastSentEpoch uint64 `protobuf:"varint,3,opt,name=last_sent_epoch,json=lastSentEpoch,proto3" json:"last_sent_epoch,omitempty"`
+	// last_acked_epoch is the last epoch whose BTC timestamp is acknowledged
+	// successfully through the channel
+	LastAckedEpoch uint64 `protobuf:"varint,4,opt,name=last_acked_epoch,json=lastAckedEpoch,proto3" json:"last_acked_epoch,omitempty"`
+	// lag is the number of finalized epochs after the last acknowledged epoch
+	Lag uint64 `protobuf:"varint,5,opt,name=lag,proto3" json:"lag,omitempty"`
+	// pending_epochs are the epochs whose BTC timestamps are to be resent
+	// through the channel
+	PendingEpochs []uint64 `protobuf:"varint,6,rep,packed,name=pending_epochs,json=pendingEpochs,proto3" json:"pending_epochs,omitempty"`
+}
+
+func (m *ChannelDeliveryStatus) Reset()         { *m = ChannelDeliveryStatus{} }
+func (m *ChannelDeliveryStatus) String() string { return proto.CompactTextString(m) }
+func (*ChannelDeliveryStatus) ProtoMessage()    {}
+func (*ChannelDeliveryStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd665af90102da38, []int{23}
+}
+func (m *ChannelDeliveryStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelDeliveryStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelDeliveryStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelDeliveryStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelDeliveryStatus.Merge(m, src)
+}
+func (m *ChannelDeliveryStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelDeliveryStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelDeliveryStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelDeliveryStatus proto.InternalMessageInfo
+
+func (m *ChannelDeliveryStatus) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelDeliveryStatus) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChannelDeliveryStatus) GetLastSentEpoch() uint64 {
+	if m != nil {
+		return m.LastSentEpoch
+	}
+	return 0
+}
+
+func (m *ChannelDeliveryStatus) GetLastAckedEpoch() uint64 {
+	if m != nil {
+		return m.LastAckedEpoch
+	}
+	return 0
+}
+
+func (m *ChannelDeliveryStatus) GetLag() uint64 {
+	if m != nil {
+		return m.Lag
+	}
+	return 0
+}
+
+func (m *ChannelDeliveryStatus) GetPendingEpochs() []uint64 {
+	if m != nil {
+		return m.PendingEpochs
+	}
+	return nil
+}
+
+// QueryBTCTimestampDeliveryResponse is response type for the
+// Query/BTCTimestampDelivery RPC method.
+type QueryBTCTimestampDeliveryResponse struct {
+	// last_finalized_epoch is the last finalized epoch
+	LastFinalizedEpoch uint64 `protobuf:"varint,1,opt,name=last_finalized_epoch,json=lastFinalizedEpoch,proto3" json:"last_finalized_epoch,omitempty"`
+	// channels are the delivery status of each open ZoneConcierge channel
+	Channels []*ChannelDeliveryStatus `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (m *QueryBTCTimestampDeliveryResponse) Reset()         { *m = QueryBTCTimestampDeliveryResponse{} }
+func (m *QueryBTCTimestampDeliveryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCTimestampDeliveryResponse) ProtoMessage()    {}
+func (*QueryBTCTimestampDeliveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd665af90102da38, []int{24}
+}
+func (m *QueryBTCTimestampDeliveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCTimestampDeliveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCTimestampDeliveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCTimestampDeliveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCTimestampDeliveryResponse.Merge(m, src)
+}
+func (m *QueryBTCTimestampDeliveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCTimestampDeliveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCTimestampDeliveryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCTimestampDeliveryResponse proto.InternalMessageInfo
+
+func (m *QueryBTCTimestampDeliveryResponse) GetLastFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.LastFinalizedEpoch
+	}
+	return 0
+}
+
+func (m *QueryBTCTimestampDeliveryResponse) GetChannels() []*ChannelDeliveryStatus {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.zoneconcierge.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.zoneconcierge.v1.QueryParamsResponse")
@@ -993,6 +1379,10 @@ func init() {
 	proto.RegisterType((*QueryHeaderResponse)(nil), "babylon.zoneconcierge.v1.QueryHeaderResponse")
 	proto.RegisterType((*QueryChainListRequest)(nil), "babylon.zoneconcierge.v1.QueryChainListRequest")
 	proto.RegisterType((*QueryChainListResponse)(nil), "babylon.zoneconcierge.v1.QueryChainListResponse")
+	proto.RegisterType((*QueryConsumerRegistryListRequest)(nil), "babylon.zoneconcierge.v1.QueryConsumerRegistryListRequest")
+	proto.RegisterType((*QueryConsumerRegistryListResponse)(nil), "babylon.zoneconcierge.v1.QueryConsumerRegistryListResponse")
+	proto.RegisterType((*QueryConsumerRegistryRequest)(nil), "babylon.zoneconcierge.v1.QueryConsumerRegistryRequest")
+	proto.RegisterType((*QueryConsumerRegistryResponse)(nil), "babylon.zoneconcierge.v1.QueryConsumerRegistryResponse")
 	proto.RegisterType((*QueryChainsInfoRequest)(nil), "babylon.zoneconcierge.v1.QueryChainsInfoRequest")
 	proto.RegisterType((*QueryChainsInfoResponse)(nil), "babylon.zoneconcierge.v1.QueryChainsInfoResponse")
 	proto.RegisterType((*QueryEpochChainsInfoRequest)(nil), "babylon.zoneconcierge.v1.QueryEpochChainsInfoRequest")
@@ -1005,6 +1395,9 @@ func init() {
 	proto.RegisterType((*QueryFinalizedChainsInfoResponse)(nil), "babylon.zoneconcierge.v1.QueryFinalizedChainsInfoResponse")
 	proto.RegisterType((*QueryFinalizedChainInfoUntilHeightRequest)(nil), "babylon.zoneconcierge.v1.QueryFinalizedChainInfoUntilHeightRequest")
 	proto.RegisterType((*QueryFinalizedChainInfoUntilHeightResponse)(nil), "babylon.zoneconcierge.v1.QueryFinalizedChainInfoUntilHeightResponse")
+	proto.RegisterType((*QueryBTCTimestampDeliveryRequest)(nil), "babylon.zoneconcierge.v1.QueryBTCTimestampDeliveryRequest")
+	proto.RegisterType((*ChannelDeliveryStatus)(nil), "babylon.zoneconcierge.v1.ChannelDeliveryStatus")
+	proto.RegisterType((*QueryBTCTimestampDeliveryResponse)(nil), "babylon.zoneconcierge.v1.QueryBTCTimestampDeliveryResponse")
 }
 
 func init() {
@@ -1012,81 +1405,103 @@ func init() {
 }
 
 var fileDescriptor_cd665af90102da38 = []byte{
-	// 1176 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0xf3, 0xab, 0xc9, 0x5b, 0x0a, 0xd5, 0x24, 0x2d, 0x8b, 0xdb, 0x6e, 0x22, 0x43, 0x69,
-	0x5a, 0x12, 0x9b, 0x4d, 0x49, 0xab, 0x72, 0xa0, 0x6a, 0x52, 0x92, 0x46, 0x45, 0xa5, 0x35, 0x04,
-	0x24, 0x2e, 0xc6, 0xf6, 0xce, 0x7a, 0xad, 0x64, 0x3d, 0x5b, 0xdb, 0xbb, 0xed, 0x36, 0x84, 0x03,
-	0xea, 0x1d, 0x24, 0x2e, 0x88, 0x13, 0x27, 0x0e, 0x1c, 0x7a, 0xe3, 0x4f, 0x40, 0xea, 0x81, 0x43,
-	0x25, 0x2e, 0x9c, 0x10, 0x4a, 0xf8, 0x37, 0x90, 0x90, 0x67, 0xc6, 0xbb, 0xfe, 0xb9, 0xeb, 0x0d,
-	0xb9, 0xed, 0x8c, 0xdf, 0xfb, 0xbe, 0xef, 0xbd, 0x79, 0x33, 0xef, 0x2d, 0xbc, 0x65, 0xe8, 0x46,
-	0x77, 0x8f, 0x38, 0xca, 0x53, 0xe2, 0x60, 0x93, 0x38, 0xa6, 0x8d, 0x5d, 0x0b, 0x2b, 0x9d, 0xaa,
-	0xf2, 0xa8, 0x8d, 0xdd, 0xae, 0xdc, 0x72, 0x89, 0x4f, 0x50, 0x99, 0x5b, 0xc9, 0x31, 0x2b, 0xb9,
-	0x53, 0x15, 0xe7, 0x2d, 0x62, 0x11, 0x6a, 0xa4, 0x04, 0xbf, 0x98, 0xbd, 0x78, 0xc1, 0x22, 0xc4,
-	0xda, 0xc3, 0x8a, 0xde, 0xb2, 0x15, 0xdd, 0x71, 0x88, 0xaf, 0xfb, 0x36, 0x71, 0x3c, 0xfe, 0xf5,
-	0xaa, 0x49, 0xbc, 0x26, 0xf1, 0x14, 0x43, 0xf7, 0x30, 0xa3, 0x51, 0x3a, 0x55, 0x03, 0xfb, 0x7a,
-	0x55, 0x69, 0xe9, 0x96, 0xed, 0x50, 0x63, 0x6e, 0xbb, 0x1c, 0xea, 0x33, 0x7c, 0xd3, 0x6c, 0x60,
-	0x73, 0xb7, 0x45, 0x6c, 0xc7, 0x0f, 0xf4, 0xc5, 0x36, 0xb8, 0xf5, 0x95, 0xd0, 0xba, 0xff, 0xc5,
-	0x76, 0xac, 0xc0, 0x3a, 0x65, 0x2a, 0x85, 0xa6, 0xb8, 0x45, 0xcc, 0x06, 0xb7, 0x0a, 0x7f, 0x27,
-	0xc9, 0x53, 0xc9, 0x89, 0xe7, 0x81, 0x59, 0x5f, 0xca, 0xb5, 0x6e, 0xe9, 0xae, 0xde, 0xe4, 0xd1,
-	0x4b, 0xf3, 0x80, 0x1e, 0x06, 0x31, 0x3f, 0xa0, 0x9b, 0x2a, 0x7e, 0xd4, 0xc6, 0x9e, 0x2f, 0xed,
-	0xc0, 0x5c, 0x6c, 0xd7, 0x6b, 0x11, 0xc7, 0xc3, 0xe8, 0x03, 0x98, 0x66, 0xce, 0x65, 0x61, 0x51,
-	0x58, 0x2a, 0xad, 0x2e, 0xca, 0x79, 0x27, 0x21, 0x33, 0xcf, 0xf5, 0xc9, 0x17, 0x7f, 0x2d, 0x8c,
-	0xa9, 0xdc, 0x4b, 0xda, 0xe2, 0x64, 0x77, 0xb1, 0x5e, 0xc3, 0x2e, 0x27, 0x43, 0x6f, 0xc0, 0x8c,
-	0xd9, 0xd0, 0x6d, 0x47, 0xb3, 0x6b, 0x14, 0x77, 0x56, 0x3d, 0x45, 0xd7, 0xdb, 0x35, 0x74, 0x0e,
-	0xa6, 0x1b, 0xd8, 0xb6, 0x1a, 0x7e, 0x79, 0x7c, 0x51, 0x58, 0x9a, 0x54, 0xf9, 0x4a, 0xfa, 0x51,
-	0xe0, 0x02, 0x43, 0x24, 0x2e, 0xf0, 0x56, 0x60, 0x1f, 0xec, 0x70, 0x81, 0x97, 0xf3, 0x05, 0x6e,
-	0x3b, 0x35, 0xfc, 0x04, 0xd7, 0x38, 0x00, 0x77, 0x43, 0xeb, 0xf0, 0x4a, 0x9d, 0xb8, 0xbb, 0x1a,
-	0x5b, 0x7a, 0x94, 0xb6, 0xb4, 0xba, 0x90, 0x0f, 0xb3, 0x49, 0xdc, 0x5d, 0x4f, 0x2d, 0x05, 0x4e,
-	0x0c, 0xca, 0x93, 0x34, 0x38, 0x4b, 0xb5, 0x6d, 0x04, 0x41, 0x7c, 0x64, 0x7b, 0x7e, 0x18, 0xe8,
-	0x26, 0x40, 0xbf, 0xa2, 0xb8, 0xc2, 0xb7, 0x65, 0x56, 0x7e, 0x72, 0x50, 0x7e, 0x32, 0xab, 0x72,
-	0x5e, 0x7e, 0xf2, 0x03, 0xdd, 0xc2, 0xdc, 0x57, 0x8d, 0x78, 0x4a, 0x5f, 0xc3, 0xb9, 0x24, 0x01,
-	0x8f, 0xff, 0x3c, 0xcc, 0x86, 0xa9, 0x0c, 0xce, 0x68, 0x62, 0x69, 0x56, 0x9d, 0xe1, 0xb9, 0xf4,
-	0xd0, 0x56, 0x8c, 0x7e, 0x9c, 0x27, 0x68, 0x18, 0x3d, 0x43, 0x8e, 0xf1, 0xaf, 0x45, 0xf9, 0xbd,
-	0x6d, 0xa7, 0x4e, 0xc2, 0x08, 0x07, 0xf1, 0x4b, 0x1a, 0xbc, 0x9e, 0x72, 0xe3, 0xba, 0xef, 0x40,
-	0x89, 0x9a, 0x79, 0x9a, 0xed, 0xd4, 0x09, 0xf5, 0x2c, 0xad, 0xbe, 0x99, 0x9f, 0x75, 0x0a, 0x41,
-	0x11, 0xc0, 0xec, 0xa1, 0x49, 0x9f, 0xc3, 0x79, 0x4a, 0xf0, 0x61, 0x70, 0x6f, 0x32, 0xc5, 0xd1,
-	0x1b, 0xa5, 0x39, 0xed, 0x26, 0xcd, 0xfe, 0xa4, 0x3a, 0x43, 0x37, 0xee, 0xb7, 0x9b, 0x71, 0xe5,
-	0xe3, 0x09, 0xe5, 0x35, 0xb8, 0x90, 0x0d, 0x7c, 0xa2, 0xf2, 0xbf, 0xe2, 0xf9, 0x09, 0x4e, 0x94,
-	0xd7, 0x52, 0x81, 0x2b, 0xb2, 0x99, 0x71, 0xaa, 0xc7, 0x29, 0xaa, 0x9f, 0x05, 0x28, 0xa7, 0xe9,
-	0x79, 0x80, 0xb7, 0xe1, 0x54, 0x78, 0x23, 0x58, 0x70, 0x85, 0x2f, 0x56, 0xe8, 0x77, 0x72, 0xd5,
-	0xf7, 0x19, 0x3f, 0x8c, 0x40, 0x27, 0x3d, 0x90, 0x44, 0xae, 0x06, 0x1e, 0x73, 0x34, 0x91, 0xe3,
-	0xb1, 0x44, 0x4a, 0x06, 0x5c, 0xcc, 0xc1, 0x3d, 0xb1, 0x24, 0x48, 0x9f, 0xc2, 0x02, 0xe5, 0xd8,
-	0xb4, 0x1d, 0x7d, 0xcf, 0x7e, 0x8a, 0x6b, 0xa3, 0x5d, 0x21, 0x34, 0x0f, 0x53, 0x2d, 0x97, 0x74,
-	0x30, 0xd5, 0x3e, 0xa3, 0xb2, 0x85, 0xf4, 0x4c, 0x80, 0xc5, 0x7c, 0x58, 0xae, 0xfe, 0x4b, 0x38,
-	0x5b, 0x0f, 0x3f, 0x6b, 0xe9, 0x6a, 0x5d, 0x1e, 0xf0, 0xc4, 0xc5, 0x50, 0x29, 0xe8, 0x5c, 0x3d,
-	0xcd, 0x24, 0xf9, 0x70, 0x25, 0x43, 0x45, 0xf0, 0x69, 0xc7, 0xf1, 0xed, 0xbd, 0xbb, 0xf4, 0xe9,
-	0x3e, 0xfe, 0xa3, 0xdf, 0x0f, 0x7e, 0x22, 0x1a, 0xfc, 0xf3, 0x09, 0xb8, 0x5a, 0x84, 0x96, 0xa7,
-	0x61, 0x07, 0xe6, 0x13, 0x69, 0x08, 0xb3, 0x20, 0x14, 0xbd, 0xb3, 0xa8, 0x9e, 0x62, 0x42, 0x37,
-	0x01, 0x58, 0xd1, 0x51, 0x30, 0x56, 0xdd, 0x62, 0x0f, 0xac, 0xd7, 0xc8, 0x3b, 0x55, 0x99, 0x96,
-	0x96, 0xca, 0x4a, 0x94, 0xba, 0xde, 0x87, 0x57, 0x5d, 0xfd, 0xb1, 0xd6, 0x1f, 0x09, 0x68, 0x7c,
-	0xd1, 0xea, 0x8a, 0x8d, 0x0f, 0x01, 0x86, 0xaa, 0x3f, 0xde, 0xe8, 0xed, 0xa9, 0xa7, 0xdd, 0xe8,
-	0x12, 0xed, 0x00, 0x32, 0x7c, 0x53, 0xf3, 0xda, 0x46, 0xd3, 0xf6, 0x3c, 0x9b, 0x38, 0xda, 0x2e,
-	0xee, 0x96, 0x27, 0x13, 0x98, 0xf1, 0x79, 0xa5, 0x53, 0x95, 0x3f, 0xe9, 0xd9, 0xdf, 0xc3, 0x5d,
-	0xf5, 0x8c, 0xe1, 0x9b, 0xb1, 0x1d, 0xb4, 0x45, 0xb3, 0x4f, 0xea, 0xe5, 0x29, 0x8a, 0x54, 0x1d,
-	0xd0, 0xfa, 0x03, 0xb3, 0x8c, 0xa2, 0x61, 0xfe, 0xab, 0xcf, 0x4e, 0xc3, 0x14, 0x3d, 0x30, 0xf4,
-	0xad, 0x00, 0xd3, 0x6c, 0x4e, 0x40, 0x03, 0xca, 0x2f, 0x3d, 0x9e, 0x88, 0x2b, 0x05, 0xad, 0xd9,
-	0x99, 0x4b, 0x4b, 0xdf, 0xfc, 0xf1, 0xcf, 0xf7, 0xe3, 0x12, 0x5a, 0x54, 0x86, 0xcc, 0x44, 0xe8,
-	0xb9, 0x00, 0xd3, 0xec, 0xce, 0x0e, 0x55, 0x14, 0x9b, 0x61, 0x86, 0x2a, 0x8a, 0xcf, 0x29, 0xd2,
-	0x16, 0x55, 0x74, 0x1b, 0xdd, 0xca, 0x57, 0xd4, 0xaf, 0x4d, 0x65, 0x3f, 0xbc, 0x29, 0x07, 0x0a,
-	0x7b, 0x48, 0x94, 0x7d, 0x76, 0x25, 0x0e, 0xd0, 0x0f, 0x02, 0xcc, 0xf6, 0xc6, 0x00, 0xa4, 0x0c,
-	0x51, 0x91, 0x9c, 0x48, 0xc4, 0x77, 0x8b, 0x3b, 0x14, 0xcf, 0x25, 0x7b, 0x5c, 0xd0, 0x4f, 0x02,
-	0x40, 0xff, 0x75, 0x40, 0x85, 0xa8, 0xa2, 0x2f, 0xa1, 0x58, 0x1d, 0xc1, 0x83, 0xab, 0x5b, 0xa1,
-	0xea, 0x2e, 0xa3, 0x4b, 0xc3, 0xd4, 0xd1, 0xc4, 0xa2, 0x5f, 0x05, 0x78, 0x2d, 0xd1, 0xd3, 0xd1,
-	0xda, 0x10, 0xd6, 0xec, 0xe1, 0x42, 0xbc, 0x3e, 0xaa, 0x1b, 0x57, 0x7c, 0x8d, 0x2a, 0x5e, 0x41,
-	0xef, 0xe4, 0x2b, 0x66, 0x0f, 0x4b, 0x54, 0xf7, 0x2f, 0x02, 0x94, 0x22, 0x6d, 0x1a, 0x0d, 0xcb,
-	0x54, 0x7a, 0xa2, 0x10, 0x57, 0x47, 0x71, 0xe1, 0x5a, 0xdf, 0xa3, 0x5a, 0x65, 0xb4, 0x9c, 0xaf,
-	0x95, 0x37, 0xba, 0x48, 0xc9, 0xa2, 0xdf, 0x05, 0x38, 0x93, 0xec, 0xa9, 0xe8, 0x7a, 0x01, 0xfa,
-	0x8c, 0xe6, 0x2e, 0xde, 0x18, 0xd9, 0xaf, 0xf8, 0x8d, 0x4b, 0x6b, 0x67, 0xa9, 0xf7, 0x94, 0xfd,
-	0xde, 0x40, 0x71, 0x80, 0x7e, 0x13, 0x60, 0x2e, 0xa3, 0xcf, 0xa2, 0x9b, 0x43, 0x94, 0xe5, 0xb7,
-	0x7c, 0xf1, 0xfd, 0xe3, 0xb8, 0xf2, 0xb8, 0x6e, 0xd0, 0xb8, 0xaa, 0x48, 0xc9, 0x8f, 0x2b, 0xb3,
-	0xed, 0xa3, 0x7f, 0x05, 0xb8, 0x38, 0xb0, 0x65, 0xa2, 0x8d, 0x91, 0x64, 0x65, 0xf7, 0x79, 0xf1,
-	0xce, 0xff, 0x03, 0xe1, 0x51, 0x3e, 0xa4, 0x51, 0xde, 0x43, 0xdb, 0x85, 0xa3, 0xcc, 0x78, 0x39,
-	0x03, 0xc4, 0xde, 0xcb, 0xb9, 0xfe, 0xf1, 0x8b, 0xc3, 0x8a, 0xf0, 0xf2, 0xb0, 0x22, 0xfc, 0x7d,
-	0x58, 0x11, 0xbe, 0x3b, 0xaa, 0x8c, 0xbd, 0x3c, 0xaa, 0x8c, 0xfd, 0x79, 0x54, 0x19, 0xfb, 0x62,
-	0xcd, 0xb2, 0xfd, 0x46, 0xdb, 0x90, 0x4d, 0xd2, 0x0c, 0xe9, 0x28, 0x4c, 0x8f, 0xfb, 0x49, 0x82,
-	0xdd, 0xef, 0xb6, 0xb0, 0x67, 0x4c, 0xd3, 0x3f, 0xd4, 0xd7, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x03, 0xf9, 0xf2, 0x6b, 0xc4, 0x10, 0x00, 0x00,
+	// 1521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x73, 0xdb, 0xc4,
+	0x17, 0x8f, 0x12, 0x37, 0x4d, 0x9e, 0xbf, 0x6d, 0xf3, 0xdd, 0xb8, 0xc5, 0xa8, 0x49, 0x6a, 0x04,
+	0x6d, 0xd3, 0xd2, 0x48, 0xb5, 0x4b, 0x7f, 0xcf, 0xb4, 0xd3, 0xa4, 0x24, 0xcd, 0x14, 0x4a, 0xab,
+	0x36, 0x30, 0xc3, 0x45, 0xc8, 0xd2, 0x5a, 0x16, 0xb1, 0x25, 0xd7, 0x2b, 0xbb, 0x4d, 0x4b, 0x39,
+	0x30, 0xdc, 0x61, 0x86, 0x0b, 0xc3, 0x89, 0xe1, 0xc0, 0x81, 0x43, 0x6f, 0xf0, 0x0f, 0x00, 0x33,
+	0x65, 0x86, 0x43, 0x67, 0xb8, 0x70, 0x00, 0x86, 0x69, 0xf9, 0x37, 0x98, 0x61, 0xb4, 0xbb, 0x92,
+	0x2d, 0x5b, 0xb2, 0xe5, 0x10, 0x6e, 0xd6, 0xee, 0x7b, 0x9f, 0xf7, 0x79, 0x9f, 0x7d, 0xbb, 0xfb,
+	0xd6, 0xf0, 0x4a, 0x59, 0x2f, 0x6f, 0xd5, 0x5c, 0x47, 0x79, 0xe0, 0x3a, 0xd8, 0x70, 0x1d, 0xc3,
+	0xc6, 0x4d, 0x0b, 0x2b, 0xed, 0xa2, 0x72, 0xb7, 0x85, 0x9b, 0x5b, 0x72, 0xa3, 0xe9, 0x7a, 0x2e,
+	0xca, 0x73, 0x2b, 0x39, 0x62, 0x25, 0xb7, 0x8b, 0x62, 0xce, 0x72, 0x2d, 0x97, 0x1a, 0x29, 0xfe,
+	0x2f, 0x66, 0x2f, 0xce, 0x59, 0xae, 0x6b, 0xd5, 0xb0, 0xa2, 0x37, 0x6c, 0x45, 0x77, 0x1c, 0xd7,
+	0xd3, 0x3d, 0xdb, 0x75, 0x08, 0x9f, 0x3d, 0x6e, 0xb8, 0xa4, 0xee, 0x12, 0xa5, 0xac, 0x13, 0xcc,
+	0xc2, 0x28, 0xed, 0x62, 0x19, 0x7b, 0x7a, 0x51, 0x69, 0xe8, 0x96, 0xed, 0x50, 0x63, 0x6e, 0x7b,
+	0x22, 0xe0, 0x57, 0xf6, 0x0c, 0xa3, 0x8a, 0x8d, 0xcd, 0x86, 0x6b, 0x3b, 0x9e, 0xcf, 0x2f, 0x32,
+	0xc0, 0xad, 0x8f, 0x05, 0xd6, 0x9d, 0x19, 0xdb, 0xb1, 0x7c, 0xeb, 0x3e, 0x53, 0x29, 0x30, 0xc5,
+	0x0d, 0xd7, 0xa8, 0x72, 0xab, 0xe0, 0x77, 0x6f, 0xf0, 0x3e, 0x71, 0xa2, 0x3a, 0x30, 0xeb, 0xc3,
+	0x89, 0xd6, 0x0d, 0xbd, 0xa9, 0xd7, 0x79, 0xf6, 0x52, 0x0e, 0xd0, 0x2d, 0x3f, 0xe7, 0x9b, 0x74,
+	0x50, 0xc5, 0x77, 0x5b, 0x98, 0x78, 0xd2, 0x06, 0xcc, 0x46, 0x46, 0x49, 0xc3, 0x75, 0x08, 0x46,
+	0x97, 0x60, 0x92, 0x39, 0xe7, 0x85, 0x82, 0xb0, 0x98, 0x2d, 0x15, 0xe4, 0xa4, 0x95, 0x90, 0x99,
+	0xe7, 0x72, 0xe6, 0xc9, 0x1f, 0x87, 0xc6, 0x54, 0xee, 0x25, 0xad, 0xf1, 0x60, 0xd7, 0xb0, 0x6e,
+	0xe2, 0x26, 0x0f, 0x86, 0x5e, 0x84, 0x29, 0xa3, 0xaa, 0xdb, 0x8e, 0x66, 0x9b, 0x14, 0x77, 0x5a,
+	0xdd, 0x4d, 0xbf, 0xd7, 0x4d, 0x74, 0x00, 0x26, 0xab, 0xd8, 0xb6, 0xaa, 0x5e, 0x7e, 0xbc, 0x20,
+	0x2c, 0x66, 0x54, 0xfe, 0x25, 0x7d, 0x21, 0xc0, 0x6c, 0x04, 0x89, 0x13, 0xbc, 0xec, 0xdb, 0xfb,
+	0x23, 0x9c, 0xe0, 0xd1, 0x64, 0x82, 0xeb, 0x8e, 0x89, 0xef, 0x63, 0x93, 0x03, 0x70, 0x37, 0xb4,
+	0x0c, 0xff, 0xab, 0xb8, 0xcd, 0x4d, 0x8d, 0x7d, 0x12, 0x1a, 0x36, 0x5b, 0x3a, 0x94, 0x0c, 0xb3,
+	0xea, 0x36, 0x37, 0x89, 0x9a, 0xf5, 0x9d, 0x18, 0x14, 0x91, 0x34, 0xd8, 0x4f, 0xb9, 0xad, 0xf8,
+	0x49, 0xbc, 0x61, 0x13, 0x2f, 0x48, 0x74, 0x15, 0xa0, 0x53, 0x51, 0x9c, 0xe1, 0x11, 0x99, 0x95,
+	0x9f, 0xec, 0x97, 0x9f, 0xcc, 0xaa, 0x9c, 0x97, 0x9f, 0x7c, 0x53, 0xb7, 0x30, 0xf7, 0x55, 0xbb,
+	0x3c, 0xa5, 0x0f, 0xe1, 0x40, 0x6f, 0x00, 0x9e, 0xff, 0x41, 0x98, 0x0e, 0xa4, 0xf4, 0xd7, 0x68,
+	0x62, 0x71, 0x5a, 0x9d, 0xe2, 0x5a, 0x12, 0xb4, 0x16, 0x09, 0x3f, 0xce, 0x05, 0x1a, 0x16, 0x9e,
+	0x21, 0x47, 0xe2, 0xbf, 0x0f, 0x05, 0x16, 0xdf, 0x75, 0x48, 0xab, 0xee, 0xcb, 0x6f, 0xd9, 0xc4,
+	0x6b, 0x6e, 0xfd, 0x17, 0xb9, 0x7e, 0x27, 0xc0, 0x4b, 0x03, 0x82, 0xf1, 0xbc, 0xaf, 0xc1, 0xb4,
+	0xc1, 0xe7, 0x59, 0xde, 0xd9, 0xd2, 0xf1, 0xe4, 0x35, 0x0b, 0xa0, 0xde, 0xc4, 0x9e, 0x6e, 0xea,
+	0x9e, 0xae, 0x76, 0x9c, 0x77, 0x4e, 0xa4, 0xf3, 0x30, 0x17, 0xcb, 0x7b, 0x78, 0xd5, 0x4b, 0x16,
+	0xcc, 0x27, 0xb8, 0xf2, 0x74, 0x57, 0x61, 0x2a, 0x60, 0xcc, 0xa5, 0x1d, 0x25, 0xdb, 0xd0, 0x57,
+	0x3a, 0xdd, 0x5d, 0x48, 0x64, 0xdd, 0xa9, 0xb8, 0x01, 0xbb, 0x41, 0x85, 0x24, 0x69, 0xf0, 0x42,
+	0x9f, 0x1b, 0x67, 0x76, 0x15, 0xb2, 0xd4, 0x8c, 0x68, 0xb6, 0x53, 0x71, 0xf9, 0x52, 0xbc, 0x3c,
+	0x80, 0x1c, 0xc5, 0xf4, 0x11, 0xc0, 0x08, 0xd1, 0xa4, 0x77, 0xe0, 0x20, 0x0d, 0xf0, 0xba, 0x7f,
+	0x00, 0xc6, 0x92, 0xa3, 0x47, 0xa3, 0xe6, 0xb4, 0xea, 0x34, 0xff, 0x8c, 0x3a, 0x45, 0x07, 0x6e,
+	0xb4, 0xea, 0x51, 0xe6, 0xe3, 0x3d, 0xcc, 0x4d, 0x98, 0x8b, 0x07, 0xde, 0x51, 0xfa, 0x1f, 0x70,
+	0x7d, 0xfc, 0x12, 0xe5, 0x87, 0x42, 0x8a, 0xb3, 0x6e, 0x35, 0xa6, 0xf2, 0xb6, 0xb3, 0x63, 0xbe,
+	0x16, 0x20, 0xdf, 0x1f, 0x9e, 0x27, 0x78, 0x05, 0x76, 0x07, 0x47, 0x1b, 0x4b, 0x2e, 0xf5, 0x09,
+	0x19, 0xf8, 0xed, 0xdc, 0x0e, 0x79, 0x1b, 0xe6, 0x42, 0x9e, 0x74, 0x41, 0x7a, 0xb4, 0x1a, 0xb8,
+	0xcc, 0xdd, 0x42, 0x8e, 0x47, 0xb7, 0x4f, 0x19, 0xe6, 0x13, 0x70, 0x77, 0x4c, 0x04, 0xe9, 0x0e,
+	0x1c, 0xa2, 0x31, 0x56, 0x6d, 0x47, 0xaf, 0xd9, 0x0f, 0xb0, 0x39, 0xda, 0x16, 0x42, 0x39, 0xd8,
+	0xd5, 0x68, 0xba, 0x6d, 0x4c, 0xb9, 0x4f, 0xa9, 0xec, 0x43, 0xfa, 0x58, 0x80, 0x42, 0x32, 0x2c,
+	0x67, 0xff, 0x1e, 0xec, 0xaf, 0x04, 0xd3, 0x5a, 0x7f, 0xb5, 0x9e, 0x18, 0x70, 0x57, 0x45, 0x50,
+	0x29, 0xe8, 0x6c, 0xa5, 0x3f, 0x92, 0xe4, 0xc1, 0xb1, 0x18, 0x16, 0xfe, 0xd4, 0x86, 0xe3, 0xd9,
+	0xb5, 0x6b, 0xf4, 0x0e, 0xde, 0xfe, 0xed, 0xdd, 0x49, 0x7e, 0xa2, 0x3b, 0xf9, 0xc7, 0x13, 0x70,
+	0x3c, 0x4d, 0x58, 0x2e, 0xc3, 0x06, 0xe4, 0x7a, 0x64, 0x08, 0x54, 0x10, 0xd2, 0xee, 0x59, 0x54,
+	0xe9, 0x8b, 0x84, 0xce, 0x03, 0xb0, 0xa2, 0xa3, 0x60, 0xac, 0xba, 0xc5, 0x10, 0x2c, 0xec, 0xc8,
+	0xda, 0x45, 0x99, 0x96, 0x96, 0xca, 0x4a, 0x94, 0xba, 0xde, 0x80, 0xbd, 0x4d, 0xfd, 0x9e, 0xd6,
+	0xe9, 0xed, 0x68, 0x7e, 0xdd, 0xd5, 0x15, 0xe9, 0x03, 0x7d, 0x0c, 0x55, 0xbf, 0xb7, 0x12, 0x8e,
+	0xa9, 0x7b, 0x9a, 0xdd, 0x9f, 0x68, 0x03, 0x50, 0xd9, 0x33, 0x34, 0xd2, 0x2a, 0xd7, 0x6d, 0x42,
+	0x6c, 0xd7, 0xd1, 0x36, 0xf1, 0x56, 0x3e, 0xd3, 0x83, 0x19, 0x6d, 0x3c, 0xdb, 0x45, 0xf9, 0x76,
+	0x68, 0x7f, 0x1d, 0x6f, 0xa9, 0x33, 0x65, 0xcf, 0x88, 0x8c, 0xa0, 0x35, 0xaa, 0xbe, 0x5b, 0xc9,
+	0xef, 0xa2, 0x48, 0xc5, 0x01, 0x3d, 0x9c, 0x6f, 0x16, 0x53, 0x34, 0xcc, 0x5f, 0x92, 0x78, 0xb1,
+	0x2e, 0xdf, 0x59, 0xb9, 0x63, 0xd7, 0x31, 0xf1, 0xf4, 0x7a, 0xe3, 0x2a, 0xae, 0xd9, 0x6d, 0x1c,
+	0xde, 0x72, 0xd2, 0x6f, 0x02, 0xec, 0x5f, 0xa9, 0xea, 0x8e, 0x83, 0x6b, 0xc1, 0xd4, 0x6d, 0x4f,
+	0xf7, 0x5a, 0x04, 0xcd, 0x03, 0x18, 0x6c, 0xa2, 0x53, 0x39, 0xd3, 0x7c, 0x64, 0xdd, 0x1c, 0xb0,
+	0xbf, 0xd1, 0x11, 0xd8, 0x57, 0xd3, 0x89, 0xa7, 0x11, 0xec, 0x78, 0x1a, 0x95, 0x9f, 0x0a, 0x9d,
+	0x51, 0xf7, 0xf8, 0xc3, 0xb7, 0xb1, 0xc3, 0x76, 0x3d, 0x5a, 0x84, 0x19, 0x6a, 0xa7, 0x1b, 0x9b,
+	0xd8, 0xe4, 0x86, 0x19, 0x6a, 0xb8, 0xd7, 0x1f, 0xbf, 0xe2, 0x0f, 0x33, 0xcb, 0x19, 0x98, 0xa8,
+	0xe9, 0x16, 0x15, 0x24, 0xa3, 0xfa, 0x3f, 0xd1, 0x61, 0xd8, 0xdb, 0xc0, 0x8e, 0x69, 0x3b, 0x16,
+	0x73, 0x24, 0xf9, 0xc9, 0xc2, 0x84, 0x1f, 0x82, 0x8f, 0x52, 0x3f, 0x22, 0x7d, 0x15, 0x74, 0x27,
+	0xf1, 0x1a, 0xf0, 0x52, 0x3d, 0x09, 0x39, 0x4a, 0xa4, 0x53, 0xaf, 0x8c, 0x0c, 0x3b, 0xd3, 0x90,
+	0x3f, 0x17, 0xaa, 0xcc, 0x08, 0x5d, 0x87, 0x29, 0x2e, 0x05, 0xbb, 0xc3, 0xb2, 0x25, 0x65, 0x60,
+	0x41, 0xf7, 0xeb, 0xab, 0x86, 0x00, 0xa5, 0xdf, 0xff, 0x0f, 0xbb, 0x28, 0x49, 0xf4, 0x89, 0x00,
+	0x93, 0xac, 0x31, 0x47, 0x03, 0x8e, 0x89, 0xfe, 0xf7, 0x80, 0xb8, 0x94, 0xd2, 0x9a, 0x25, 0x2c,
+	0x2d, 0x7e, 0xf4, 0xcb, 0x5f, 0x9f, 0x8d, 0x4b, 0xa8, 0xa0, 0x0c, 0x79, 0x84, 0xa0, 0xc7, 0x02,
+	0x4c, 0xb2, 0xb3, 0x75, 0x28, 0xa3, 0xc8, 0xa3, 0x41, 0x5c, 0x4a, 0x69, 0xcd, 0x19, 0xad, 0x51,
+	0x46, 0x57, 0xd0, 0xe5, 0x64, 0x46, 0x9d, 0x33, 0x44, 0x79, 0xc8, 0x7f, 0x9b, 0x8f, 0x14, 0x76,
+	0xe0, 0x2b, 0x0f, 0xd9, 0xd1, 0xf5, 0x08, 0x7d, 0x2e, 0xc0, 0x74, 0xd8, 0x77, 0x23, 0x65, 0x08,
+	0x8b, 0xde, 0x27, 0x80, 0x78, 0x32, 0xbd, 0x43, 0x7a, 0x2d, 0xd9, 0x25, 0x80, 0xbe, 0x14, 0x00,
+	0x3a, 0xa7, 0x38, 0x4a, 0x15, 0xaa, 0xfb, 0xc6, 0x12, 0x8b, 0x23, 0x78, 0x70, 0x76, 0x4b, 0x94,
+	0xdd, 0x51, 0x74, 0x78, 0x18, 0x3b, 0x2a, 0x2c, 0xfa, 0x56, 0x80, 0x7d, 0x3d, 0xbd, 0x17, 0x3a,
+	0x3d, 0x24, 0x6a, 0x7c, 0x13, 0x28, 0x9e, 0x19, 0xd5, 0x8d, 0x33, 0x3e, 0x45, 0x19, 0x2f, 0xa1,
+	0x57, 0x93, 0x19, 0xb3, 0x0b, 0xa0, 0x9b, 0xf7, 0x37, 0x02, 0x64, 0xbb, 0xda, 0x29, 0x34, 0x4c,
+	0xa9, 0xfe, 0xce, 0x4f, 0x2c, 0x8d, 0xe2, 0xc2, 0xb9, 0xbe, 0x46, 0xb9, 0xca, 0xe8, 0x44, 0x32,
+	0x57, 0xde, 0x90, 0x74, 0x95, 0x2c, 0xfa, 0x59, 0x80, 0x99, 0xde, 0xde, 0x07, 0x9d, 0x49, 0x11,
+	0x3e, 0xa6, 0x09, 0x13, 0xcf, 0x8e, 0xec, 0x97, 0x7e, 0xc7, 0xf5, 0x73, 0x67, 0xd2, 0x13, 0xe5,
+	0x61, 0xd8, 0xf8, 0x3d, 0x42, 0x3f, 0x08, 0x90, 0x8b, 0x7b, 0xfc, 0xa1, 0x0b, 0xc3, 0xca, 0x35,
+	0xf9, 0x79, 0x2a, 0x5e, 0xdc, 0x96, 0x6f, 0xfa, 0x12, 0x0a, 0x9e, 0x58, 0x5a, 0x93, 0x03, 0xa0,
+	0xef, 0x05, 0x98, 0xe9, 0x45, 0x1d, 0xba, 0x2a, 0x09, 0x8f, 0x47, 0xf1, 0xec, 0xc8, 0x7e, 0x9c,
+	0xfa, 0x25, 0x4a, 0xfd, 0x1c, 0x3a, 0x33, 0x02, 0xf5, 0xee, 0xda, 0xfa, 0x51, 0x80, 0xd9, 0x98,
+	0xe6, 0x14, 0x9d, 0x1f, 0x42, 0x28, 0xb9, 0x4f, 0x16, 0x2f, 0x6c, 0xc7, 0x95, 0xa7, 0x73, 0x96,
+	0xa6, 0x53, 0x44, 0x4a, 0x72, 0x3a, 0xb1, 0xbd, 0x32, 0xfa, 0x5b, 0x80, 0xf9, 0x81, 0x7d, 0x26,
+	0x5a, 0x19, 0x89, 0x56, 0x7c, 0x73, 0x2c, 0x5e, 0xfd, 0x77, 0x20, 0x3c, 0xcb, 0x5b, 0x34, 0xcb,
+	0xeb, 0x68, 0x3d, 0x75, 0x96, 0x31, 0xd7, 0x98, 0x8f, 0xd8, 0xb9, 0xc6, 0x7e, 0x12, 0x20, 0x17,
+	0xd7, 0xb3, 0x0c, 0xdd, 0x54, 0x03, 0x9a, 0x3d, 0xf1, 0xe2, 0xb6, 0x7c, 0x79, 0x92, 0xe7, 0x68,
+	0x92, 0x25, 0x74, 0x32, 0x39, 0x49, 0xbf, 0x1b, 0xf6, 0x02, 0x00, 0xcd, 0xe4, 0x08, 0xcb, 0x6f,
+	0x3d, 0x79, 0xb6, 0x20, 0x3c, 0x7d, 0xb6, 0x20, 0xfc, 0xf9, 0x6c, 0x41, 0xf8, 0xf4, 0xf9, 0xc2,
+	0xd8, 0xd3, 0xe7, 0x0b, 0x63, 0xbf, 0x3e, 0x5f, 0x18, 0x7b, 0xf7, 0xb4, 0x65, 0x7b, 0xd5, 0x56,
+	0x59, 0x36, 0xdc, 0x7a, 0x80, 0x4a, 0x25, 0x09, 0x43, 0xdc, 0xef, 0x09, 0xe2, 0x6d, 0x35, 0x30,
+	0x29, 0x4f, 0xd2, 0xbf, 0x46, 0x4f, 0xfd, 0x33, 0x00, 0xcd, 0x99, 0xde, 0x25, 0x8e, 0x16, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1118,11 +1533,19 @@ type QueryClient interface {
 	// ListEpochHeaders queries the headers of a chain timestamped in a given
 	// epoch of Babylon, with pagination support
 	ListEpochHeaders(ctx context.Context, in *QueryListEpochHeadersRequest, opts ...grpc.CallOption) (*QueryListEpochHeadersResponse, error)
+	// ConsumerRegistryList queries the list of registered consumer chains, with
+	// pagination support
+	ConsumerRegistryList(ctx context.Context, in *QueryConsumerRegistryListRequest, opts ...grpc.CallOption) (*QueryConsumerRegistryListResponse, error)
+	// ConsumerRegistry queries the registration of a consumer chain
+	ConsumerRegistry(ctx context.Context, in *QueryConsumerRegistryRequest, opts ...grpc.CallOption) (*QueryConsumerRegistryResponse, error)
 	// FinalizedChainsInfo queries the BTC-finalised info of chains with given IDs, with proofs
 	FinalizedChainsInfo(ctx context.Context, in *QueryFinalizedChainsInfoRequest, opts ...grpc.CallOption) (*QueryFinalizedChainsInfoResponse, error)
 	// FinalizedChainInfoUntilHeight queries the BTC-finalised info no later than
 	// the provided CZ height, with proofs
 	FinalizedChainInfoUntilHeight(ctx context.Context, in *QueryFinalizedChainInfoUntilHeightRequest, opts ...grpc.CallOption) (*QueryFinalizedChainInfoUntilHeightResponse, error)
+	// BTCTimestampDelivery queries the state of delivering BTC timestamps
+	// through each open ZoneConcierge channel
+	BTCTimestampDelivery(ctx context.Context, in *QueryBTCTimestampDeliveryRequest, opts ...grpc.CallOption) (*QueryBTCTimestampDeliveryResponse, error)
 }
 
 type queryClient struct {
@@ -1196,6 +1619,24 @@ func (c *queryClient) ListEpochHeaders(ctx context.Context, in *QueryListEpochHe
 	return out, nil
 }
 
+func (c *queryClient) ConsumerRegistryList(ctx context.Context, in *QueryConsumerRegistryListRequest, opts ...grpc.CallOption) (*QueryConsumerRegistryListResponse, error) {
+	out := new(QueryConsumerRegistryListResponse)
+	err := c.cc.Invoke(ctx, "/babylon.zoneconcierge.v1.Query/ConsumerRegistryList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsumerRegistry(ctx context.Context, in *QueryConsumerRegistryRequest, opts ...grpc.CallOption) (*QueryConsumerRegistryResponse, error) {
+	out := new(QueryConsumerRegistryResponse)
+	err := c.cc.Invoke(ctx, "/babylon.zoneconcierge.v1.Query/ConsumerRegistry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) FinalizedChainsInfo(ctx context.Context, in *QueryFinalizedChainsInfoRequest, opts ...grpc.CallOption) (*QueryFinalizedChainsInfoResponse, error) {
 	out := new(QueryFinalizedChainsInfoResponse)
 	err := c.cc.Invoke(ctx, "/babylon.zoneconcierge.v1.Query/FinalizedChainsInfo", in, out, opts...)
@@ -1214,10 +1655,19 @@ func (c *queryClient) FinalizedChainInfoUntilHeight(ctx context.Context, in *Que
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (c *queryClient) BTCTimestampDelivery(ctx context.Context, in *QueryBTCTimestampDeliveryRequest, opts ...grpc.CallOption) (*QueryBTCTimestampDeliveryResponse, error) {
+	out := new(QueryBTCTimestampDeliveryResponse)
+	err := c.cc.Invoke(ctx, "/babylon.zoneconcierge.v1.Query/BTCTimestampDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Header queries the CZ header and fork headers at a given height.
 	Header(context.Context, *QueryHeaderRequest) (*QueryHeaderResponse, error)
 	// ChainList queries the list of chains that checkpoint to Babylon
@@ -1233,11 +1683,19 @@ type QueryServer interface {
 	// ListEpochHeaders queries the headers of a chain timestamped in a given
 	// epoch of Babylon, with pagination support
 	ListEpochHeaders(context.Context, *QueryListEpochHeadersRequest) (*QueryListEpochHeadersResponse, error)
+	// ConsumerRegistryList queries the list of registered consumer chains, with
+	// pagination support
+	ConsumerRegistryList(context.Context, *QueryConsumerRegistryListRequest) (*QueryConsumerRegistryListResponse, error)
+	// ConsumerRegistry queries the registration of a consumer chain
+	ConsumerRegistry(context.Context, *QueryConsumerRegistryRequest) (*QueryConsumerRegistryResponse, error)
 	// FinalizedChainsInfo queries the BTC-finalised info of chains with given IDs, with proofs
 	FinalizedChainsInfo(context.Context, *QueryFinalizedChainsInfoRequest) (*QueryFinalizedChainsInfoResponse, error)
 	// FinalizedChainInfoUntilHeight queries the BTC-finalised info no later than
 	// the provided CZ height, with proofs
 	FinalizedChainInfoUntilHeight(context.Context, *QueryFinalizedChainInfoUntilHeightRequest) (*QueryFinalizedChainInfoUntilHeightResponse, error)
+	// BTCTimestampDelivery queries the state of delivering BTC timestamps
+	// through each open ZoneConcierge channel
+	BTCTimestampDelivery(context.Context, *QueryBTCTimestampDeliveryRequest) (*QueryBTCTimestampDeliveryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1265,12 +1723,21 @@ func (*UnimplementedQueryServer) ListHeaders(ctx context.Context, req *QueryList
 func (*UnimplementedQueryServer) ListEpochHeaders(ctx context.Context, req *QueryListEpochHeadersRequest) (*QueryListEpochHeadersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEpochHeaders not implemented")
 }
+func (*UnimplementedQueryServer) ConsumerRegistryList(ctx context.Context, req *QueryConsumerRegistryListRequest) (*QueryConsumerRegistryListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumerRegistryList not implemented")
+}
+func (*UnimplementedQueryServer) ConsumerRegistry(ctx context.Context, req *QueryConsumerRegistryRequest) (*QueryConsumerRegistryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumerRegistry not implemented")
+}
 func (*UnimplementedQueryServer) FinalizedChainsInfo(ctx context.Context, req *QueryFinalizedChainsInfoRequest) (*QueryFinalizedChainsInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizedChainsInfo not implemented")
 }
 func (*UnimplementedQueryServer) FinalizedChainInfoUntilHeight(ctx context.Context, req *QueryFinalizedChainInfoUntilHeightRequest) (*QueryFinalizedChainInfoUntilHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizedChainInfoUntilHeight not implemented")
 }
+func (*UnimplementedQueryServer) BTCTimestampDelivery(ctx context.Context, req *QueryBTCTimestampDeliveryRequest) (*QueryBTCTimestampDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCTimestampDelivery not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1402,6 +1869,42 @@ func _Query_ListEpochHeaders_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConsumerRegistryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerRegistryListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsumerRegistryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.zoneconcierge.v1.Query/ConsumerRegistryList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsumerRegistryList(ctx, req.(*QueryConsumerRegistryListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsumerRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerRegistryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsumerRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.zoneconcierge.v1.Query/ConsumerRegistry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsumerRegistry(ctx, req.(*QueryConsumerRegistryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_FinalizedChainsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFinalizedChainsInfoRequest)
 	if err := dec(in); err != nil {
@@ -1438,6 +1941,24 @@ func _Query_FinalizedChainInfoUntilHeight_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BTCTimestampDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCTimestampDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCTimestampDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.zoneconcierge.v1.Query/BTCTimestampDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCTimestampDelivery(ctx, req.(*QueryBTCTimestampDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.zoneconcierge.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1470,6 +1991,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListEpochHeaders",
 			Handler:    _Query_ListEpochHeaders_Handler,
 		},
+		{
+			MethodName: "ConsumerRegistryList",
+			Handler:    _Query_ConsumerRegistryList_Handler,
+		},
+		{
+			MethodName: "ConsumerRegistry",
+			Handler:    _Query_ConsumerRegistry_Handler,
+		},
 		{
 			MethodName: "FinalizedChainsInfo",
 			Handler:    _Query_FinalizedChainsInfo_Handler,
@@ -1478,6 +2007,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizedChainInfoUntilHeight",
 			Handler:    _Query_FinalizedChainInfoUntilHeight_Handler,
 		},
+		{
+			MethodName: "BTCTimestampDelivery",
+			Handler:    _Query_BTCTimestampDelivery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/zoneconcierge/v1/query.proto",
@@ -1700,6 +2233,155 @@ func (m *QueryChainListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryConsumerRegistryListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRegistryListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRegistryListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRegistryListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRegistryListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRegistryListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRegistryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRegistryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRegistryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRegistryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Consumer != nil {
+		{
+			size, err := m.Consumer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryChainsInfoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2213,60 +2895,195 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBTCTimestampDeliveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryBTCTimestampDeliveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBTCTimestampDeliveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeaderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+func (m *ChannelDeliveryStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ChannelDeliveryStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelDeliveryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if len(m.PendingEpochs) > 0 {
+		dAtA17 := make([]byte, len(m.PendingEpochs)*10)
+		var j16 int
+		for _, num := range m.PendingEpochs {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintQuery(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Lag != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Lag))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastAckedEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastAckedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastSentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastSentEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCTimestampDeliveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCTimestampDeliveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCTimestampDeliveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.LastFinalizedEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastFinalizedEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -2309,6 +3126,64 @@ func (m *QueryChainListResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConsumerRegistryListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerRegistryListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Consumers) > 0 {
+		for _, e := range m.Consumers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerRegistryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerRegistryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Consumer != nil {
+		l = m.Consumer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryChainsInfoRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2520,6 +3395,66 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBTCTimestampDeliveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ChannelDeliveryStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastSentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.LastSentEpoch))
+	}
+	if m.LastAckedEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.LastAckedEpoch))
+	}
+	if m.Lag != 0 {
+		n += 1 + sovQuery(uint64(m.Lag))
+	}
+	if len(m.PendingEpochs) > 0 {
+		l = 0
+		for _, e := range m.PendingEpochs {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryBTCTimestampDeliveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastFinalizedEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.LastFinalizedEpoch))
+	}
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3086,7 +4021,7 @@ func (m *QueryChainListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainsInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerRegistryListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3109,17 +4044,17 @@ func (m *QueryChainsInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainsInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerRegistryListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerRegistryListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3129,23 +4064,27 @@ func (m *QueryChainsInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3168,7 +4107,7 @@ func (m *QueryChainsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerRegistryListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3191,15 +4130,15 @@ func (m *QueryChainsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerRegistryListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerRegistryListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainsInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3226,22 +4165,58 @@ func (m *QueryChainsInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainsInfo = append(m.ChainsInfo, &ChainInfo{})
-			if err := m.ChainsInfo[len(m.ChainsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Consumers = append(m.Consumers, &ConsumerMetadata{})
+			if err := m.Consumers[len(m.Consumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -3252,7 +4227,7 @@ func (m *QueryChainsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochChainsInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerRegistryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3275,34 +4250,15 @@ func (m *QueryEpochChainsInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochChainsInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerRegistryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochChainsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerRegistryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
-			}
-			m.EpochNum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3330,7 +4286,7 @@ func (m *QueryEpochChainsInfoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3353,7 +4309,7 @@ func (m *QueryEpochChainsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochChainsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerRegistryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3376,15 +4332,15 @@ func (m *QueryEpochChainsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochChainsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerRegistryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochChainsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainsInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3411,8 +4367,10 @@ func (m *QueryEpochChainsInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainsInfo = append(m.ChainsInfo, &ChainInfo{})
-			if err := m.ChainsInfo[len(m.ChainsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Consumer == nil {
+				m.Consumer = &ConsumerMetadata{}
+			}
+			if err := m.Consumer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3437,7 +4395,7 @@ func (m *QueryEpochChainsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListHeadersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChainsInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3460,15 +4418,15 @@ func (m *QueryListHeadersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListHeadersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainsInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListHeadersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3496,43 +4454,7 @@ func (m *QueryListHeadersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3555,7 +4477,7 @@ func (m *QueryListHeadersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListHeadersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChainsInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3578,49 +4500,15 @@ func (m *QueryListHeadersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListHeadersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainsInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Headers = append(m.Headers, &IndexedHeader{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainsInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3647,10 +4535,8 @@ func (m *QueryListHeadersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainsInfo = append(m.ChainsInfo, &ChainInfo{})
+			if err := m.ChainsInfo[len(m.ChainsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3675,7 +4561,7 @@ func (m *QueryListHeadersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListEpochHeadersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochChainsInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3698,10 +4584,10 @@ func (m *QueryListEpochHeadersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEpochHeadersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochChainsInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEpochHeadersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochChainsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3725,7 +4611,7 @@ func (m *QueryListEpochHeadersRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3753,7 +4639,7 @@ func (m *QueryListEpochHeadersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3776,7 +4662,7 @@ func (m *QueryListEpochHeadersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListEpochHeadersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochChainsInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3799,15 +4685,15 @@ func (m *QueryListEpochHeadersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEpochHeadersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochChainsInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEpochHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochChainsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainsInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3834,8 +4720,8 @@ func (m *QueryListEpochHeadersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, &IndexedHeader{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainsInfo = append(m.ChainsInfo, &ChainInfo{})
+			if err := m.ChainsInfo[len(m.ChainsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3860,7 +4746,7 @@ func (m *QueryListEpochHeadersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalizedChainsInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListHeadersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3883,15 +4769,15 @@ func (m *QueryFinalizedChainsInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalizedChainsInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListHeadersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalizedChainsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListHeadersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3919,13 +4805,13 @@ func (m *QueryFinalizedChainsInfoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3935,12 +4821,28 @@ func (m *QueryFinalizedChainsInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prove = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3962,7 +4864,7 @@ func (m *QueryFinalizedChainsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalizedChainsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListHeadersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3985,15 +4887,15 @@ func (m *QueryFinalizedChainsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalizedChainsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListHeadersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalizedChainsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedChainsInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4020,33 +4922,69 @@ func (m *QueryFinalizedChainsInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalizedChainsInfo = append(m.FinalizedChainsInfo, &FinalizedChainInfo{})
-			if err := m.FinalizedChainsInfo[len(m.FinalizedChainsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, &IndexedHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *QueryFinalizedChainInfoUntilHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListEpochHeadersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4069,17 +5007,17 @@ func (m *QueryFinalizedChainInfoUntilHeightRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEpochHeadersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEpochHeadersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			var stringLen uint64
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4089,29 +5027,16 @@ func (m *QueryFinalizedChainInfoUntilHeightRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4121,31 +5046,24 @@ func (m *QueryFinalizedChainInfoUntilHeightRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Prove = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4167,7 +5085,7 @@ func (m *QueryFinalizedChainInfoUntilHeightRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListEpochHeadersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4190,15 +5108,15 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEpochHeadersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEpochHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedChainInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4225,18 +5143,66 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalizedChainInfo == nil {
-				m.FinalizedChainInfo = &ChainInfo{}
-			}
-			if err := m.FinalizedChainInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, &IndexedHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalizedChainsInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedChainsInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedChainsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4246,33 +5212,29 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EpochInfo == nil {
-				m.EpochInfo = &types.Epoch{}
-			}
-			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4282,31 +5244,65 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+			m.Prove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RawCheckpoint == nil {
-				m.RawCheckpoint = &types1.RawCheckpoint{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalizedChainsInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedChainsInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedChainsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcSubmissionKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedChainsInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4333,21 +5329,190 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BtcSubmissionKey == nil {
-				m.BtcSubmissionKey = &types2.SubmissionKey{}
-			}
-			if err := m.BtcSubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FinalizedChainsInfo = append(m.FinalizedChainsInfo, &FinalizedChainInfo{})
+			if err := m.FinalizedChainsInfo[len(m.FinalizedChainsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalizedChainInfoUntilHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedChainInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
@@ -4369,10 +5534,554 @@ func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proof == nil {
-				m.Proof = &ProofFinalizedChainInfo{}
+			if m.FinalizedChainInfo == nil {
+				m.FinalizedChainInfo = &ChainInfo{}
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinalizedChainInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochInfo == nil {
+				m.EpochInfo = &types.Epoch{}
+			}
+			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawCheckpoint == nil {
+				m.RawCheckpoint = &types1.RawCheckpoint{}
+			}
+			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcSubmissionKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcSubmissionKey == nil {
+				m.BtcSubmissionKey = &types2.SubmissionKey{}
+			}
+			if err := m.BtcSubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &ProofFinalizedChainInfo{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCTimestampDeliveryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCTimestampDeliveryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCTimestampDeliveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelDeliveryStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelDeliveryStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelDeliveryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSentEpoch", wireType)
+			}
+			m.LastSentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAckedEpoch", wireType)
+			}
+			m.LastAckedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAckedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
+			}
+			m.Lag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lag |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PendingEpochs = append(m.PendingEpochs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PendingEpochs) == 0 {
+					m.PendingEpochs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PendingEpochs = append(m.PendingEpochs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingEpochs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCTimestampDeliveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCTimestampDeliveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCTimestampDeliveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFinalizedEpoch", wireType)
+			}
+			m.LastFinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, &ChannelDeliveryStatus{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -623,6 +623,324 @@ func (m *BTCChainSegment) GetBtcHeaders() []*types3.BTCHeaderInfo {
 	return nil
 }
 
+// ConsumerMetadata is the metadata of a consumer chain registered in Babylon.
+// Babylon only tracks headers of and sends BTC timestamps to registered
+// consumer chains
+type ConsumerMetadata struct {
+	// chain_id is the ID of the consumer chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// name is the name of the consumer chain
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// description is a description of the consumer chain
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// channel_id is the ZoneConcierge IBC channel bound to the consumer chain.
+	// If empty, no channel is bound yet, and Babylon neither sends BTC
+	// timestamps to nor accepts packets from the consumer chain until governance
+	// binds a channel
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// client_id is the IBC light client of the consumer chain. Only headers
+	// from and channels over this IBC light client are accepted
+	ClientId string `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *ConsumerMetadata) Reset()         { *m = ConsumerMetadata{} }
+func (m *ConsumerMetadata) String() string { return proto.CompactTextString(m) }
+func (*ConsumerMetadata) ProtoMessage()    {}
+func (*ConsumerMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab886e1868e5c5cd, []int{8}
+}
+func (m *ConsumerMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerMetadata.Merge(m, src)
+}
+func (m *ConsumerMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerMetadata proto.InternalMessageInfo
+
+func (m *ConsumerMetadata) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ConsumerMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ConsumerMetadata) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ConsumerMetadata) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ConsumerMetadata) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// ConsumerSlashingRecord is a slashing of a finality provider reported by a
+// consumer chain through IBC
+type ConsumerSlashingRecord struct {
+	// chain_id is the ID of the consumer chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// fp_btc_pk_hex is the hex string of the BTC PK of the slashed finality
+	// provider
+	FpBtcPkHex string `protobuf:"bytes,2,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// block_height is the height of the consumer chain at which the finality
+	// provider is slashed
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// evidence is the evidence of the slashable offence, encoded by the
+	// consumer chain
+	Evidence []byte `protobuf:"bytes,4,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// babylon_height is the Babylon height at which the slashing is reported
+	BabylonHeight uint64 `protobuf:"varint,5,opt,name=babylon_height,json=babylonHeight,proto3" json:"babylon_height,omitempty"`
+}
+
+func (m *ConsumerSlashingRecord) Reset()         { *m = ConsumerSlashingRecord{} }
+func (m *ConsumerSlashingRecord) String() string { return proto.CompactTextString(m) }
+func (*ConsumerSlashingRecord) ProtoMessage()    {}
+func (*ConsumerSlashingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab886e1868e5c5cd, []int{9}
+}
+func (m *ConsumerSlashingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerSlashingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerSlashingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerSlashingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerSlashingRecord.Merge(m, src)
+}
+func (m *ConsumerSlashingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerSlashingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerSlashingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerSlashingRecord proto.InternalMessageInfo
+
+func (m *ConsumerSlashingRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ConsumerSlashingRecord) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+func (m *ConsumerSlashingRecord) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ConsumerSlashingRecord) GetEvidence() []byte {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *ConsumerSlashingRecord) GetBabylonHeight() uint64 {
+	if m != nil {
+		return m.BabylonHeight
+	}
+	return 0
+}
+
+// BTCTimestampDeliveryState is the state of delivering BTC timestamps through
+// a ZoneConcierge IBC channel
+type BTCTimestampDeliveryState struct {
+	// last_sent_epoch is the last epoch whose BTC timestamp is sent through the
+	// channel
+	LastSentEpoch uint64 `protobuf:"varint,1,opt,name=last_sent_epoch,json=lastSentEpoch,proto3" json:"last_sent_epoch,omitempty"`
+	// last_acked_epoch is the last epoch whose BTC timestamp is acknowledged
+	// successfully through the channel
+	LastAckedEpoch uint64 `protobuf:"varint,2,opt,name=last_acked_epoch,json=lastAckedEpoch,proto3" json:"last_acked_epoch,omitempty"`
+	// pending_btc_timestamps are the BTC timestamps to be resent through the
+	// channel, in ascending order of their epochs
+	PendingBtcTimestamps []*PendingBTCTimestamp `protobuf:"bytes,3,rep,name=pending_btc_timestamps,json=pendingBtcTimestamps,proto3" json:"pending_btc_timestamps,omitempty"`
+}
+
+func (m *BTCTimestampDeliveryState) Reset()         { *m = BTCTimestampDeliveryState{} }
+func (m *BTCTimestampDeliveryState) String() string { return proto.CompactTextString(m) }
+func (*BTCTimestampDeliveryState) ProtoMessage()    {}
+func (*BTCTimestampDeliveryState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab886e1868e5c5cd, []int{10}
+}
+func (m *BTCTimestampDeliveryState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCTimestampDeliveryState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCTimestampDeliveryState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCTimestampDeliveryState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCTimestampDeliveryState.Merge(m, src)
+}
+func (m *BTCTimestampDeliveryState) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCTimestampDeliveryState) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCTimestampDeliveryState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCTimestampDeliveryState proto.InternalMessageInfo
+
+func (m *BTCTimestampDeliveryState) GetLastSentEpoch() uint64 {
+	if m != nil {
+		return m.LastSentEpoch
+	}
+	return 0
+}
+
+func (m *BTCTimestampDeliveryState) GetLastAckedEpoch() uint64 {
+	if m != nil {
+		return m.LastAckedEpoch
+	}
+	return 0
+}
+
+func (m *BTCTimestampDeliveryState) GetPendingBtcTimestamps() []*PendingBTCTimestamp {
+	if m != nil {
+		return m.PendingBtcTimestamps
+	}
+	return nil
+}
+
+// PendingBTCTimestamp is the BTC timestamp of an epoch whose delivery through
+// a ZoneConcierge IBC channel has failed, and which is to be resent
+type PendingBTCTimestamp struct {
+	// epoch is the epoch of the BTC timestamp
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// attempts is the number of failed deliveries of the BTC timestamp
+	Attempts uint32 `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// next_resend_height is the Babylon height from which the BTC timestamp
+	// can be resent, which backs off exponentially in the number of attempts
+	NextResendHeight int64 `protobuf:"varint,3,opt,name=next_resend_height,json=nextResendHeight,proto3" json:"next_resend_height,omitempty"`
+	// in_flight is whether the BTC timestamp has been resent and awaits its
+	// acknowledgement
+	InFlight bool `protobuf:"varint,4,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+}
+
+func (m *PendingBTCTimestamp) Reset()         { *m = PendingBTCTimestamp{} }
+func (m *PendingBTCTimestamp) String() string { return proto.CompactTextString(m) }
+func (*PendingBTCTimestamp) ProtoMessage()    {}
+func (*PendingBTCTimestamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab886e1868e5c5cd, []int{11}
+}
+func (m *PendingBTCTimestamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBTCTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBTCTimestamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingBTCTimestamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBTCTimestamp.Merge(m, src)
+}
+func (m *PendingBTCTimestamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBTCTimestamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBTCTimestamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingBTCTimestamp proto.InternalMessageInfo
+
+func (m *PendingBTCTimestamp) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *PendingBTCTimestamp) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *PendingBTCTimestamp) GetNextResendHeight() int64 {
+	if m != nil {
+		return m.NextResendHeight
+	}
+	return 0
+}
+
+func (m *PendingBTCTimestamp) GetInFlight() bool {
+	if m != nil {
+		return m.InFlight
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*IndexedHeader)(nil), "babylon.zoneconcierge.v1.IndexedHeader")
 	proto.RegisterType((*Forks)(nil), "babylon.zoneconcierge.v1.Forks")
@@ -632,6 +950,10 @@ func init() {
 	proto.RegisterType((*ProofEpochSealed)(nil), "babylon.zoneconcierge.v1.ProofEpochSealed")
 	proto.RegisterType((*ProofFinalizedChainInfo)(nil), "babylon.zoneconcierge.v1.ProofFinalizedChainInfo")
 	proto.RegisterType((*BTCChainSegment)(nil), "babylon.zoneconcierge.v1.BTCChainSegment")
+	proto.RegisterType((*ConsumerMetadata)(nil), "babylon.zoneconcierge.v1.ConsumerMetadata")
+	proto.RegisterType((*ConsumerSlashingRecord)(nil), "babylon.zoneconcierge.v1.ConsumerSlashingRecord")
+	proto.RegisterType((*BTCTimestampDeliveryState)(nil), "babylon.zoneconcierge.v1.BTCTimestampDeliveryState")
+	proto.RegisterType((*PendingBTCTimestamp)(nil), "babylon.zoneconcierge.v1.PendingBTCTimestamp")
 }
 
 func init() {
@@ -639,68 +961,87 @@ func init() {
 }
 
 var fileDescriptor_ab886e1868e5c5cd = []byte{
-	// 964 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x4e, 0xd2, 0x3c, 0xc7, 0x6d, 0x98, 0xa4, 0xd4, 0x0d, 0xc2, 0xb1, 0x5c, 0xa9,
-	0xb8, 0x08, 0xd6, 0xb2, 0x81, 0x03, 0xdc, 0xb0, 0xd5, 0xd2, 0x14, 0x44, 0xd1, 0xda, 0x2d, 0x08,
-	0x81, 0x56, 0xfb, 0x67, 0xbc, 0xbb, 0xca, 0x7a, 0xc7, 0xda, 0x9d, 0xb8, 0x71, 0x3e, 0x45, 0xbf,
-	0x05, 0x5c, 0xf9, 0x00, 0xdc, 0x39, 0xf6, 0xc8, 0x0d, 0x94, 0x7c, 0x05, 0x2e, 0xdc, 0xd0, 0xbc,
-	0x99, 0x59, 0xef, 0x26, 0x32, 0x49, 0x2f, 0xd1, 0xce, 0xcc, 0xef, 0xbd, 0xf7, 0x7b, 0xbf, 0xf7,
-	0xc7, 0x81, 0x8f, 0x5c, 0xc7, 0x5d, 0xc4, 0x2c, 0xe9, 0x9e, 0xb1, 0x84, 0x7a, 0x2c, 0xf1, 0x22,
-	0x9a, 0x06, 0xb4, 0x3b, 0xef, 0x95, 0x2f, 0xcc, 0x59, 0xca, 0x38, 0x23, 0x0d, 0x85, 0x36, 0xcb,
-	0x8f, 0xf3, 0xde, 0xc1, 0x7e, 0xc0, 0x02, 0x86, 0xa0, 0xae, 0xf8, 0x92, 0xf8, 0x83, 0xc3, 0x80,
-	0xb1, 0x20, 0xa6, 0x5d, 0x3c, 0xb9, 0x27, 0x93, 0x2e, 0x8f, 0xa6, 0x34, 0xe3, 0xce, 0x74, 0xa6,
-	0x00, 0xef, 0x73, 0x9a, 0xf8, 0x34, 0x9d, 0x46, 0x09, 0xef, 0x7a, 0xe9, 0x62, 0xc6, 0x99, 0xc0,
-	0xb2, 0x89, 0x7a, 0xce, 0xd9, 0xb9, 0xdc, 0xf3, 0x42, 0xea, 0x1d, 0xcf, 0x98, 0x40, 0xce, 0x7b,
-	0xe5, 0x0b, 0x85, 0x7e, 0xa8, 0xd1, 0xcb, 0x97, 0x28, 0x09, 0x10, 0x1d, 0x67, 0xf6, 0x31, 0x5d,
-	0x28, 0xdc, 0xa3, 0x95, 0xb8, 0x2b, 0x2e, 0xdb, 0x1a, 0x4a, 0x67, 0xcc, 0x0b, 0x15, 0x4a, 0x7f,
-	0x2b, 0x8c, 0x59, 0x20, 0x19, 0x47, 0x41, 0x28, 0xfe, 0xd2, 0x9c, 0x65, 0xe1, 0x46, 0xe2, 0xdb,
-	0xbf, 0xaf, 0x43, 0xfd, 0x28, 0xf1, 0xe9, 0x29, 0xf5, 0x9f, 0x52, 0xc7, 0xa7, 0x29, 0xb9, 0x0f,
-	0xb7, 0xbc, 0xd0, 0x89, 0x12, 0x3b, 0xf2, 0x1b, 0x46, 0xcb, 0xe8, 0x6c, 0x5b, 0x5b, 0x78, 0x3e,
-	0xf2, 0x09, 0x81, 0x6a, 0xe8, 0x64, 0x61, 0x63, 0xbd, 0x65, 0x74, 0x76, 0x2c, 0xfc, 0x26, 0xef,
-	0xc2, 0x66, 0x48, 0x85, 0xdb, 0x46, 0xa5, 0x65, 0x74, 0xaa, 0x96, 0x3a, 0x91, 0x4f, 0xa1, 0x2a,
-	0xf4, 0x6d, 0x54, 0x5b, 0x46, 0xa7, 0xd6, 0x3f, 0x30, 0xa5, 0xf8, 0xa6, 0x16, 0xdf, 0x1c, 0x6b,
-	0xf1, 0x07, 0xd5, 0xd7, 0x7f, 0x1d, 0x1a, 0x16, 0xa2, 0x89, 0x09, 0x7b, 0x2a, 0x01, 0x3b, 0x44,
-	0x3a, 0x36, 0x06, 0xdc, 0xc0, 0x80, 0xef, 0xa8, 0x27, 0x49, 0xf4, 0xa9, 0x88, 0xde, 0x87, 0xbb,
-	0x97, 0xf1, 0x92, 0xcc, 0x26, 0x92, 0xd9, 0x2b, 0x5b, 0x48, 0x66, 0x0f, 0xa0, 0xae, 0x6d, 0x50,
-	0xbc, 0xc6, 0x16, 0x62, 0x77, 0xd4, 0xe5, 0x63, 0x71, 0x47, 0x1e, 0xc2, 0x1d, 0x0d, 0xe2, 0xa7,
-	0x92, 0xc4, 0x2d, 0x24, 0xa1, 0x6d, 0xc7, 0xa7, 0x82, 0x40, 0xfb, 0x19, 0x6c, 0x3c, 0x61, 0xe9,
-	0x71, 0x46, 0xbe, 0x84, 0x2d, 0xc9, 0x20, 0x6b, 0x54, 0x5a, 0x95, 0x4e, 0xad, 0xff, 0x81, 0xb9,
-	0xaa, 0x3f, 0xcd, 0x92, 0xe0, 0x96, 0xb6, 0x6b, 0xff, 0x63, 0xc0, 0xf6, 0x10, 0xa5, 0x4e, 0x26,
-	0xec, 0xff, 0xea, 0xf0, 0x0d, 0xd4, 0x63, 0x87, 0xd3, 0x8c, 0xab, 0xa4, 0xb1, 0x20, 0x6f, 0x11,
-	0x71, 0x47, 0x5a, 0xab, 0x82, 0x0f, 0x40, 0x9d, 0xed, 0x89, 0xc8, 0x04, 0xeb, 0x58, 0xeb, 0x1f,
-	0xae, 0x76, 0x86, 0x09, 0x5b, 0x35, 0x69, 0x24, 0xb3, 0xff, 0x02, 0xee, 0xe7, 0xd3, 0x44, 0x7d,
-	0x45, 0x2b, 0xb3, 0x3d, 0x76, 0x92, 0x70, 0x6c, 0x81, 0xaa, 0x75, 0xaf, 0x00, 0x90, 0x91, 0xb3,
-	0xa1, 0x78, 0x6e, 0xff, 0x6a, 0x00, 0xc9, 0xd3, 0xfe, 0x3e, 0xe2, 0xe1, 0x77, 0x62, 0xe8, 0xc8,
-	0x00, 0x40, 0xe5, 0x9f, 0x4c, 0x18, 0x2a, 0x50, 0xeb, 0x3f, 0x58, 0x4d, 0x2a, 0xf7, 0x60, 0x6d,
-	0x7b, 0xb9, 0x86, 0xdf, 0xc2, 0x5d, 0x9c, 0x60, 0xdd, 0x1c, 0x91, 0x2e, 0xb9, 0x14, 0xec, 0x3d,
-	0x73, 0x39, 0xf1, 0xa6, 0x9c, 0x78, 0x13, 0x83, 0x3f, 0x9f, 0x65, 0x16, 0x41, 0x4b, 0xc9, 0xf4,
-	0x48, 0x76, 0x45, 0xfb, 0xb7, 0x0a, 0x90, 0x27, 0x51, 0xe2, 0xc4, 0xd1, 0x19, 0xf5, 0x6f, 0x54,
-	0xaa, 0x17, 0xb0, 0x3f, 0xd1, 0x06, 0x76, 0x21, 0x9f, 0xf5, 0x9b, 0xe7, 0x43, 0x26, 0x57, 0x23,
-	0x7e, 0x0e, 0x80, 0x89, 0x48, 0x67, 0x15, 0x35, 0x63, 0xda, 0x59, 0xbe, 0x13, 0xe6, 0x3d, 0x13,
-	0x89, 0x5b, 0xdb, 0x78, 0xa5, 0x34, 0xb9, 0x9d, 0x3a, 0xaf, 0xec, 0xe5, 0x76, 0x51, 0x23, 0xba,
-	0xec, 0x9e, 0xd2, 0x26, 0x12, 0x3e, 0x2c, 0xe7, 0xd5, 0x30, 0xbf, 0xb3, 0xea, 0x69, 0xf1, 0x48,
-	0x5e, 0x00, 0x71, 0xb9, 0x67, 0x67, 0x27, 0xee, 0x34, 0xca, 0xb2, 0x88, 0x25, 0x62, 0xb9, 0xe1,
-	0xc4, 0x16, 0x7d, 0x96, 0x57, 0xe4, 0xbc, 0x67, 0x8e, 0x72, 0xfc, 0xd7, 0x74, 0x61, 0xed, 0xba,
-	0xdc, 0x2b, 0xdd, 0x90, 0xaf, 0x60, 0x03, 0x0b, 0x80, 0x93, 0x5c, 0xeb, 0xf7, 0x56, 0x2b, 0x85,
-	0x15, 0xbb, 0x5a, 0x15, 0x4b, 0xda, 0xb7, 0xff, 0x35, 0x60, 0x17, 0x21, 0xa8, 0xc4, 0x88, 0x3a,
-	0x31, 0xf5, 0x89, 0x05, 0xf5, 0xb9, 0x13, 0x47, 0xbe, 0xc3, 0x59, 0x6a, 0x67, 0x94, 0x37, 0x0c,
-	0x9c, 0xd9, 0x8f, 0x57, 0x6b, 0xf0, 0x52, 0xc3, 0x45, 0x87, 0x0e, 0xe2, 0x4c, 0xb0, 0xde, 0xc9,
-	0x7d, 0x8c, 0x28, 0x27, 0x8f, 0x61, 0x57, 0x36, 0x5b, 0xa1, 0x32, 0x37, 0xe8, 0xb3, 0xdb, 0xb3,
-	0x9c, 0x1c, 0xd6, 0xe7, 0x19, 0xec, 0x15, 0xdd, 0xcc, 0x9d, 0x18, 0x09, 0x56, 0xae, 0xf7, 0xb4,
-	0xbb, 0xf4, 0xf4, 0xd2, 0x89, 0x47, 0x94, 0xb7, 0x7f, 0x59, 0x87, 0x7b, 0x2b, 0xe4, 0x21, 0x23,
-	0x68, 0xc8, 0x38, 0xde, 0xd9, 0x95, 0xf1, 0x30, 0xae, 0x0f, 0xb6, 0x8f, 0xc6, 0xc3, 0xb3, 0xd2,
-	0x80, 0x90, 0x1f, 0x80, 0x14, 0xc9, 0x67, 0xa8, 0xb6, 0x52, 0xe1, 0xc3, 0x6b, 0x4a, 0x58, 0xa8,
-	0x4f, 0x31, 0x15, 0x55, 0xb1, 0x9f, 0xf5, 0x28, 0x2b, 0xcf, 0xa2, 0x59, 0x38, 0xa7, 0xbe, 0xda,
-	0xb6, 0x8f, 0x56, 0x77, 0xda, 0x38, 0x75, 0x92, 0xcc, 0xf1, 0x78, 0xc4, 0x64, 0x5f, 0xec, 0x15,
-	0x7c, 0x6b, 0x2f, 0xed, 0x9f, 0xe0, 0xce, 0x60, 0x3c, 0x44, 0x75, 0x46, 0x34, 0x98, 0xd2, 0x84,
-	0x93, 0x23, 0xa8, 0x89, 0xc6, 0xd6, 0x5b, 0x5d, 0x76, 0x48, 0xa7, 0x18, 0xa7, 0xf8, 0x73, 0x3a,
-	0xef, 0x99, 0x83, 0xf1, 0x50, 0xab, 0x31, 0x61, 0x16, 0xb8, 0xdc, 0x53, 0x7b, 0x6e, 0xf0, 0xfc,
-	0x8f, 0xf3, 0xa6, 0xf1, 0xe6, 0xbc, 0x69, 0xfc, 0x7d, 0xde, 0x34, 0x5e, 0x5f, 0x34, 0xd7, 0xde,
-	0x5c, 0x34, 0xd7, 0xfe, 0xbc, 0x68, 0xae, 0xfd, 0xf8, 0x59, 0x10, 0xf1, 0xf0, 0xc4, 0x35, 0x3d,
-	0x36, 0xed, 0x2a, 0xcf, 0xb8, 0x25, 0xf4, 0xa1, 0x7b, 0x7a, 0xe9, 0x9f, 0x21, 0xbe, 0x98, 0xd1,
-	0xcc, 0xdd, 0xc4, 0xdf, 0xd1, 0x4f, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xdf, 0x50, 0x58,
-	0x32, 0x09, 0x00, 0x00,
+	// 1276 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x72, 0x1b, 0xc5,
+	0x13, 0xce, 0x5a, 0x52, 0x62, 0xb5, 0x2c, 0x47, 0xbf, 0xb1, 0x93, 0x28, 0x4e, 0xc5, 0x71, 0x94,
+	0xfa, 0x05, 0x85, 0x4a, 0x56, 0x25, 0x03, 0x07, 0xb8, 0x45, 0x22, 0xc1, 0x0e, 0x7f, 0x92, 0x1a,
+	0x39, 0x81, 0xa2, 0xa0, 0xb6, 0x56, 0xbb, 0x23, 0x69, 0x4b, 0xab, 0xd9, 0xad, 0x9d, 0xb1, 0x22,
+	0xfb, 0x29, 0x52, 0xbc, 0x00, 0x47, 0xb8, 0x72, 0x87, 0x3b, 0xc7, 0x14, 0x27, 0x6e, 0x50, 0xc9,
+	0x2b, 0x70, 0xe1, 0x46, 0x4d, 0xcf, 0xec, 0x6a, 0x65, 0xa3, 0x38, 0x5c, 0x54, 0x9a, 0x9e, 0xaf,
+	0x7b, 0xbe, 0xfe, 0xba, 0xa7, 0x67, 0xe1, 0x6e, 0xdf, 0xed, 0x1f, 0x85, 0x11, 0x6f, 0x1d, 0x47,
+	0x9c, 0x79, 0x11, 0xf7, 0x02, 0x96, 0x0c, 0x59, 0x6b, 0xda, 0x5e, 0x34, 0xd8, 0x71, 0x12, 0xc9,
+	0x88, 0xd4, 0x0d, 0xda, 0x5e, 0xdc, 0x9c, 0xb6, 0xb7, 0x36, 0x87, 0xd1, 0x30, 0x42, 0x50, 0x4b,
+	0xfd, 0xd3, 0xf8, 0xad, 0x1b, 0xc3, 0x28, 0x1a, 0x86, 0xac, 0x85, 0xab, 0xfe, 0xe1, 0xa0, 0x25,
+	0x83, 0x09, 0x13, 0xd2, 0x9d, 0xc4, 0x06, 0x70, 0x5d, 0x32, 0xee, 0xb3, 0x64, 0x12, 0x70, 0xd9,
+	0xf2, 0x92, 0xa3, 0x58, 0x46, 0x0a, 0x1b, 0x0d, 0xcc, 0x76, 0xc6, 0xae, 0x2f, 0x3d, 0x6f, 0xc4,
+	0xbc, 0x71, 0x1c, 0x29, 0xe4, 0xb4, 0xbd, 0x68, 0x30, 0xe8, 0xdb, 0x29, 0x7a, 0xbe, 0x13, 0xf0,
+	0x21, 0xa2, 0x43, 0xe1, 0x8c, 0xd9, 0x91, 0xc1, 0xdd, 0x59, 0x8a, 0x3b, 0x15, 0xb2, 0x91, 0x42,
+	0x59, 0x1c, 0x79, 0x23, 0x83, 0x4a, 0xff, 0x1b, 0x8c, 0x9d, 0x23, 0x19, 0x06, 0xc3, 0x91, 0xfa,
+	0x65, 0x19, 0xcb, 0x9c, 0x45, 0xe3, 0x1b, 0xbf, 0xac, 0x40, 0x75, 0x9f, 0xfb, 0x6c, 0xc6, 0xfc,
+	0x3d, 0xe6, 0xfa, 0x2c, 0x21, 0x57, 0x61, 0xd5, 0x1b, 0xb9, 0x01, 0x77, 0x02, 0xbf, 0x6e, 0xed,
+	0x58, 0xcd, 0x32, 0xbd, 0x80, 0xeb, 0x7d, 0x9f, 0x10, 0x28, 0x8e, 0x5c, 0x31, 0xaa, 0xaf, 0xec,
+	0x58, 0xcd, 0x35, 0x8a, 0xff, 0xc9, 0x65, 0x38, 0x3f, 0x62, 0x2a, 0x6c, 0xbd, 0xb0, 0x63, 0x35,
+	0x8b, 0xd4, 0xac, 0xc8, 0xfb, 0x50, 0x54, 0xfa, 0xd6, 0x8b, 0x3b, 0x56, 0xb3, 0xb2, 0xbb, 0x65,
+	0x6b, 0xf1, 0xed, 0x54, 0x7c, 0xfb, 0x20, 0x15, 0xbf, 0x53, 0x7c, 0xf1, 0xc7, 0x0d, 0x8b, 0x22,
+	0x9a, 0xd8, 0xb0, 0x61, 0x12, 0x70, 0x46, 0x48, 0xc7, 0xc1, 0x03, 0x4b, 0x78, 0xe0, 0xff, 0xcc,
+	0x96, 0x26, 0xba, 0xa7, 0x4e, 0xdf, 0x85, 0x4b, 0x27, 0xf1, 0x9a, 0xcc, 0x79, 0x24, 0xb3, 0xb1,
+	0xe8, 0xa1, 0x99, 0xdd, 0x82, 0x6a, 0xea, 0x83, 0xe2, 0xd5, 0x2f, 0x20, 0x76, 0xcd, 0x18, 0x1f,
+	0x28, 0x1b, 0xb9, 0x0d, 0x17, 0x53, 0x90, 0x9c, 0x69, 0x12, 0xab, 0x48, 0x22, 0xf5, 0x3d, 0x98,
+	0x29, 0x02, 0x8d, 0x47, 0x50, 0x7a, 0x18, 0x25, 0x63, 0x41, 0xee, 0xc3, 0x05, 0xcd, 0x40, 0xd4,
+	0x0b, 0x3b, 0x85, 0x66, 0x65, 0xf7, 0x1d, 0x7b, 0x59, 0x7f, 0xda, 0x0b, 0x82, 0xd3, 0xd4, 0xaf,
+	0xf1, 0x97, 0x05, 0xe5, 0x2e, 0x4a, 0xcd, 0x07, 0xd1, 0x9b, 0xea, 0xf0, 0x19, 0x54, 0x43, 0x57,
+	0x32, 0x21, 0x4d, 0xd2, 0x58, 0x90, 0xff, 0x70, 0xe2, 0x9a, 0xf6, 0x36, 0x05, 0xef, 0x80, 0x59,
+	0x3b, 0x03, 0x95, 0x09, 0xd6, 0xb1, 0xb2, 0x7b, 0x63, 0x79, 0x30, 0x4c, 0x98, 0x56, 0xb4, 0x93,
+	0xce, 0xfe, 0x23, 0xb8, 0x9a, 0xdd, 0x26, 0xe6, 0x1b, 0x5a, 0xc2, 0xf1, 0xa2, 0x43, 0x2e, 0xb1,
+	0x05, 0x8a, 0xf4, 0x4a, 0x0e, 0xa0, 0x4f, 0x16, 0x5d, 0xb5, 0xdd, 0xf8, 0xd1, 0x02, 0x92, 0xa5,
+	0xfd, 0x65, 0x20, 0x47, 0x4f, 0xd4, 0xa5, 0x23, 0x1d, 0x00, 0x93, 0x3f, 0x1f, 0x44, 0xa8, 0x40,
+	0x65, 0xf7, 0xd6, 0x72, 0x52, 0x59, 0x04, 0x5a, 0xf6, 0x32, 0x0d, 0xbf, 0x80, 0x4b, 0x78, 0x83,
+	0xd3, 0xe6, 0x08, 0xd2, 0x92, 0x6b, 0xc1, 0xae, 0xd9, 0xf3, 0x1b, 0x6f, 0xeb, 0x1b, 0x6f, 0xe3,
+	0xe1, 0x8f, 0x63, 0x41, 0x09, 0x7a, 0x6a, 0xa6, 0xfb, 0xba, 0x2b, 0x1a, 0x3f, 0x15, 0x80, 0x3c,
+	0x0c, 0xb8, 0x1b, 0x06, 0xc7, 0xcc, 0x7f, 0xab, 0x52, 0x3d, 0x85, 0xcd, 0x41, 0xea, 0xe0, 0xe4,
+	0xf2, 0x59, 0x79, 0xfb, 0x7c, 0xc8, 0xe0, 0xf4, 0x89, 0x1f, 0x02, 0x60, 0x22, 0x3a, 0x58, 0xc1,
+	0xdc, 0xb1, 0x34, 0x58, 0x36, 0x13, 0xa6, 0x6d, 0x1b, 0x89, 0xd3, 0x32, 0x9a, 0x8c, 0x26, 0xeb,
+	0x89, 0xfb, 0xdc, 0x99, 0x4f, 0x97, 0x7a, 0xf1, 0x44, 0xf7, 0x2c, 0x4c, 0x22, 0x15, 0x83, 0xba,
+	0xcf, 0xbb, 0x99, 0x8d, 0x56, 0x93, 0xfc, 0x92, 0x3c, 0x05, 0xd2, 0x97, 0x9e, 0x23, 0x0e, 0xfb,
+	0x93, 0x40, 0x88, 0x20, 0xe2, 0x6a, 0xb8, 0xd5, 0x4b, 0x27, 0x62, 0x2e, 0x8e, 0xc8, 0x69, 0xdb,
+	0xee, 0x65, 0xf8, 0x4f, 0xd9, 0x11, 0xad, 0xf5, 0xa5, 0xb7, 0x60, 0x21, 0x9f, 0x40, 0x09, 0x0b,
+	0x80, 0x37, 0xb9, 0xb2, 0xdb, 0x5e, 0xae, 0x14, 0x56, 0xec, 0x74, 0x55, 0xa8, 0xf6, 0x6f, 0xfc,
+	0x6d, 0x41, 0x0d, 0x21, 0xa8, 0x44, 0x8f, 0xb9, 0x21, 0xf3, 0x09, 0x85, 0xea, 0xd4, 0x0d, 0x03,
+	0xdf, 0x95, 0x51, 0xe2, 0x08, 0x26, 0xeb, 0x16, 0xde, 0xd9, 0x7b, 0xcb, 0x35, 0x78, 0x96, 0xc2,
+	0x55, 0x87, 0x76, 0x42, 0xa1, 0x58, 0xaf, 0x65, 0x31, 0x7a, 0x4c, 0x92, 0x07, 0x50, 0xd3, 0xcd,
+	0x96, 0xab, 0xcc, 0x5b, 0xf4, 0xd9, 0x7a, 0x9c, 0x91, 0xc3, 0xfa, 0x3c, 0x82, 0x8d, 0x7c, 0x98,
+	0xa9, 0x1b, 0x22, 0xc1, 0xc2, 0xd9, 0x91, 0x6a, 0xf3, 0x48, 0xcf, 0xdc, 0xb0, 0xc7, 0x64, 0xe3,
+	0x87, 0x15, 0xb8, 0xb2, 0x44, 0x1e, 0xd2, 0x83, 0xba, 0x3e, 0xc7, 0x3b, 0x3e, 0x75, 0x3d, 0xac,
+	0xb3, 0x0f, 0xdb, 0x44, 0xe7, 0xee, 0xf1, 0xc2, 0x05, 0x21, 0x5f, 0x01, 0xc9, 0x93, 0x17, 0xa8,
+	0xb6, 0x51, 0xe1, 0xdd, 0x33, 0x4a, 0x98, 0xab, 0x4f, 0x3e, 0x15, 0x53, 0xb1, 0x6f, 0xe1, 0xd2,
+	0x42, 0x64, 0xd5, 0x2c, 0x52, 0x32, 0xdf, 0x4c, 0xdb, 0x3b, 0xcb, 0x3b, 0xed, 0x20, 0x71, 0xb9,
+	0x70, 0x3d, 0x19, 0x44, 0xba, 0x2f, 0x36, 0x72, 0xb1, 0xd3, 0x28, 0x8d, 0x6f, 0xe0, 0x62, 0xe7,
+	0xa0, 0x8b, 0xea, 0xf4, 0xd8, 0x70, 0xc2, 0xb8, 0x24, 0xfb, 0x50, 0x51, 0x8d, 0x9d, 0x4e, 0x75,
+	0xdd, 0x21, 0xcd, 0xfc, 0x39, 0xf9, 0xe7, 0x74, 0xda, 0xb6, 0x3b, 0x07, 0xdd, 0x54, 0x8d, 0x41,
+	0x44, 0xa1, 0x2f, 0xbd, 0x3d, 0x33, 0xd9, 0xbf, 0xb7, 0xa0, 0xd6, 0x8d, 0xb8, 0x38, 0x9c, 0xb0,
+	0xe4, 0x73, 0x26, 0x5d, 0xdf, 0x95, 0xee, 0x19, 0x0f, 0x2d, 0x77, 0x27, 0x0c, 0x85, 0x2b, 0x53,
+	0xfc, 0x4f, 0x76, 0xa0, 0xe2, 0x33, 0xe1, 0x25, 0x41, 0xac, 0x32, 0xc1, 0x7e, 0x28, 0xd3, 0xbc,
+	0x89, 0x5c, 0xc7, 0x89, 0xc9, 0x39, 0x0b, 0x55, 0xc8, 0x22, 0x02, 0xca, 0xc6, 0xb2, 0xef, 0x93,
+	0x6b, 0x50, 0xd6, 0x5c, 0xd5, 0x6e, 0x09, 0x77, 0x57, 0xb5, 0x61, 0xdf, 0x6f, 0xfc, 0x6c, 0xc1,
+	0xe5, 0x94, 0x61, 0x2f, 0x74, 0x85, 0x1a, 0x1f, 0x94, 0x79, 0x51, 0xe2, 0xbf, 0x89, 0xe7, 0x4d,
+	0xa8, 0x0e, 0x62, 0x47, 0xa9, 0x14, 0x8f, 0x9d, 0x11, 0x9b, 0x19, 0xc2, 0x30, 0x88, 0x3b, 0xd2,
+	0x7b, 0x32, 0xde, 0x63, 0x33, 0x72, 0x13, 0xd6, 0xfa, 0x61, 0xe4, 0x8d, 0x9d, 0x85, 0xaf, 0x84,
+	0x0a, 0xda, 0xcc, 0x83, 0xbc, 0x05, 0xab, 0x6c, 0x1a, 0xf8, 0x8c, 0x7b, 0xfa, 0x73, 0x61, 0x8d,
+	0x66, 0x6b, 0xf2, 0x7f, 0x58, 0x9f, 0x3f, 0xf0, 0x18, 0xa0, 0x84, 0x01, 0xaa, 0xd9, 0xcb, 0xae,
+	0x8c, 0x8d, 0xdf, 0x2c, 0xb8, 0xda, 0x39, 0xe8, 0x66, 0x1f, 0x15, 0x1f, 0xb3, 0x30, 0x98, 0xb2,
+	0xe4, 0xa8, 0x27, 0x5d, 0xc9, 0xd4, 0x63, 0x1e, 0xba, 0x42, 0x3a, 0x42, 0x25, 0x3f, 0xef, 0xf0,
+	0x22, 0xad, 0x2a, 0x73, 0x8f, 0x71, 0xa9, 0xbb, 0xb7, 0x09, 0x35, 0xc4, 0xb9, 0xde, 0x98, 0xf9,
+	0xb9, 0x97, 0xa2, 0x48, 0xd7, 0x95, 0xfd, 0xbe, 0x32, 0x6b, 0xa4, 0x07, 0x97, 0x63, 0xc6, 0xfd,
+	0x80, 0x0f, 0x31, 0xfb, 0xec, 0x69, 0x4b, 0x1f, 0xff, 0x7b, 0x6f, 0xe8, 0x75, 0xed, 0x97, 0x67,
+	0x4b, 0x37, 0x4d, 0xb0, 0x8e, 0xf4, 0x32, 0xa3, 0x68, 0x7c, 0x67, 0xc1, 0xc6, 0xbf, 0xa0, 0xc9,
+	0x26, 0x94, 0xf2, 0x49, 0xe8, 0x85, 0x52, 0xd1, 0x95, 0x92, 0x4d, 0x62, 0x29, 0x90, 0x74, 0x95,
+	0x66, 0x6b, 0x72, 0x17, 0x08, 0x67, 0x33, 0xe9, 0x24, 0x4c, 0x30, 0xee, 0xe7, 0x4b, 0x51, 0xa0,
+	0x35, 0xb5, 0x43, 0x71, 0xc3, 0xd4, 0xe3, 0x1a, 0x94, 0x03, 0xee, 0x0c, 0xb0, 0xbb, 0xb1, 0x20,
+	0xab, 0x74, 0x35, 0xe0, 0x0f, 0x71, 0xdd, 0x79, 0xfc, 0xeb, 0xab, 0x6d, 0xeb, 0xe5, 0xab, 0x6d,
+	0xeb, 0xcf, 0x57, 0xdb, 0xd6, 0x8b, 0xd7, 0xdb, 0xe7, 0x5e, 0xbe, 0xde, 0x3e, 0xf7, 0xfb, 0xeb,
+	0xed, 0x73, 0x5f, 0x7f, 0x30, 0x0c, 0xe4, 0xe8, 0xb0, 0x6f, 0x7b, 0xd1, 0xa4, 0x65, 0xb2, 0xc7,
+	0x3e, 0x49, 0x17, 0xad, 0xd9, 0x89, 0xef, 0x7a, 0x79, 0x14, 0x33, 0xd1, 0x3f, 0x8f, 0x9f, 0x84,
+	0xef, 0xfd, 0x33, 0x00, 0x9c, 0x82, 0x3b, 0x4b, 0xfd, 0x0b, 0x00, 0x00,
 }
 
 func (m *IndexedHeader) Marshal() (dAtA []byte, err error) {
@@ -1171,71 +1512,278 @@ func (m *BTCChainSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintZoneconcierge(dAtA []byte, offset int, v uint64) int {
-	offset -= sovZoneconcierge(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ConsumerMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *IndexedHeader) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ConsumerMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovZoneconcierge(uint64(l))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovZoneconcierge(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovZoneconcierge(uint64(m.Height))
-	}
-	if m.Time != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
-		n += 1 + l + sovZoneconcierge(uint64(l))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.BabylonHeaderHash)
-	if l > 0 {
-		n += 1 + l + sovZoneconcierge(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.BabylonHeaderHeight != 0 {
-		n += 1 + sovZoneconcierge(uint64(m.BabylonHeaderHeight))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.BabylonEpoch != 0 {
-		n += 1 + sovZoneconcierge(uint64(m.BabylonEpoch))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.BabylonTxHash)
-	if l > 0 {
-		n += 1 + l + sovZoneconcierge(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Forks) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerSlashingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerSlashingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerSlashingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Headers) > 0 {
-		for _, e := range m.Headers {
-			l = e.Size()
-			n += 1 + l + sovZoneconcierge(uint64(l))
-		}
+	if m.BabylonHeight != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.BabylonHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *ChainInfo) Size() (n int) {
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCTimestampDeliveryState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCTimestampDeliveryState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCTimestampDeliveryState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingBtcTimestamps) > 0 {
+		for iNdEx := len(m.PendingBtcTimestamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingBtcTimestamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintZoneconcierge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastAckedEpoch != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.LastAckedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastSentEpoch != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.LastSentEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingBTCTimestamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBTCTimestamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBTCTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InFlight {
+		i--
+		if m.InFlight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NextResendHeight != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.NextResendHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintZoneconcierge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovZoneconcierge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IndexedHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	l = len(m.BabylonHeaderHash)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.BabylonHeaderHeight != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.BabylonHeaderHeight))
+	}
+	if m.BabylonEpoch != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.BabylonEpoch))
+	}
+	l = len(m.BabylonTxHash)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	return n
+}
+
+func (m *Forks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovZoneconcierge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1370,6 +1918,104 @@ func (m *BTCChainSegment) Size() (n int) {
 	return n
 }
 
+func (m *ConsumerMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerSlashingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.BlockHeight))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.BabylonHeight != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.BabylonHeight))
+	}
+	return n
+}
+
+func (m *BTCTimestampDeliveryState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastSentEpoch != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.LastSentEpoch))
+	}
+	if m.LastAckedEpoch != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.LastAckedEpoch))
+	}
+	if len(m.PendingBtcTimestamps) > 0 {
+		for _, e := range m.PendingBtcTimestamps {
+			l = e.Size()
+			n += 1 + l + sovZoneconcierge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingBTCTimestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.Epoch))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.Attempts))
+	}
+	if m.NextResendHeight != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.NextResendHeight))
+	}
+	if m.InFlight {
+		n += 2
+	}
+	return n
+}
+
 func sovZoneconcierge(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2690,6 +3336,651 @@ func (m *BTCChainSegment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZoneconcierge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerSlashingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZoneconcierge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerSlashingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerSlashingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence[:0], dAtA[iNdEx:postIndex]...)
+			if m.Evidence == nil {
+				m.Evidence = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeight", wireType)
+			}
+			m.BabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCTimestampDeliveryState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZoneconcierge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCTimestampDeliveryState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCTimestampDeliveryState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSentEpoch", wireType)
+			}
+			m.LastSentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAckedEpoch", wireType)
+			}
+			m.LastAckedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAckedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBtcTimestamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBtcTimestamps = append(m.PendingBtcTimestamps, &PendingBTCTimestamp{})
+			if err := m.PendingBtcTimestamps[len(m.PendingBtcTimestamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingBTCTimestamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZoneconcierge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingBTCTimestamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingBTCTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextResendHeight", wireType)
+			}
+			m.NextResendHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextResendHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlight", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InFlight = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipZoneconcierge(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
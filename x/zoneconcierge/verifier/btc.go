@@ -0,0 +1,213 @@
+package verifier
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// BTC helpers that verify the inclusion of checkpoint txs in BTC blocks and
+// extract the checkpoint data from them. They follow the btccheckpoint
+// module, which accepts the checkpoints in the first place
+
+// maxOpReturnPkScriptSize is the maximum size of an OP_RETURN script, i.e.,
+// OP_RETURN, OP_DATAXX or OP_PUSHDATA1 with its length byte, and at most 80
+// bytes of data
+const maxOpReturnPkScriptSize = 83
+
+// validateBTCHeader checks the proof-of-work and the timestamp precision of
+// the given BTC header
+func validateBTCHeader(header *wire.BlockHeader, powLimit *big.Int) error {
+	block := btcutil.NewBlock(&wire.MsgBlock{Header: *header})
+	if err := blockchain.CheckProofOfWork(block, powLimit); err != nil {
+		return err
+	}
+	if !header.Timestamp.Equal(time.Unix(header.Timestamp.Unix(), 0)) {
+		return fmt.Errorf("block timestamp of %v has a higher precision than one second", header.Timestamp)
+	}
+	return nil
+}
+
+func parseTransaction(txBytes []byte) (*btcutil.Tx, error) {
+	tx, err := btcutil.NewTxFromBytes(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := blockchain.CheckTransactionSanity(tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// parseIncludedTransaction parses the transaction and verifies that it is
+// included at the given index in the block of the given header
+func parseIncludedTransaction(txInfo *TransactionInfo, btcHeader *wire.BlockHeader, powLimit *big.Int) (*btcutil.Tx, error) {
+	tx, err := parseTransaction(txInfo.Transaction)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateBTCHeader(btcHeader, powLimit); err != nil {
+		return nil, err
+	}
+	if !verifyMerkleProof(tx.Hash(), &btcHeader.MerkleRoot, txInfo.Proof, txInfo.Key.Index) {
+		return nil, fmt.Errorf("header failed validation due to failed proof")
+	}
+	return tx, nil
+}
+
+// verifyMerkleProof verifies that the tx with the given hash is at the given
+// index of the Merkle tree with the given root, as in the btccheckpoint module
+func verifyMerkleProof(txHash *chainhash.Hash, merkleRoot *chainhash.Hash, intermediateNodes []byte, index uint32) bool {
+	// Shortcut the empty-block case
+	if txHash.IsEqual(merkleRoot) && index == 0 && len(intermediateNodes) == 0 {
+		return true
+	}
+
+	proof := []byte{}
+	proof = append(proof, txHash[:]...)
+	proof = append(proof, intermediateNodes...)
+	proof = append(proof, merkleRoot[:]...)
+
+	proofLength := len(proof)
+	if proofLength%32 != 0 || proofLength == 64 {
+		return false
+	}
+
+	var current chainhash.Hash
+	copy(current[:], proof[:32])
+	idx := index
+	numSteps := (proofLength / 32) - 1
+	for i := 1; i < numSteps; i++ {
+		next := proof[i*32 : i*32+32]
+		if idx%2 == 1 {
+			current = chainhash.DoubleHashH(append(append([]byte{}, next...), current[:]...))
+		} else {
+			current = chainhash.DoubleHashH(append(append([]byte{}, current[:]...), next...))
+		}
+		idx >>= 1
+	}
+
+	return bytes.Equal(current[:], proof[proofLength-32:])
+}
+
+// extractOpReturnData returns the concatenated data of the OP_RETURN outputs
+// of the given tx
+func extractOpReturnData(tx *btcutil.Tx) []byte {
+	opReturnData := []byte{}
+	for _, output := range tx.MsgTx().TxOut {
+		pkScript := output.PkScript
+		pkScriptLen := len(pkScript)
+		if pkScriptLen > 1 &&
+			pkScriptLen <= maxOpReturnPkScriptSize &&
+			pkScript[0] == txscript.OP_RETURN {
+			if pkScript[1] == txscript.OP_PUSHDATA1 {
+				opReturnData = append(opReturnData, pkScript[3:]...)
+			} else {
+				opReturnData = append(opReturnData, pkScript[2:]...)
+			}
+		}
+	}
+	return opReturnData
+}
+
+// extractEnvelope returns the data within the first envelope of the given
+// tapscript, i.e., the concatenation of the data pushes between OP_FALSE OP_IF
+// and OP_ENDIF
+func extractEnvelope(script []byte) ([]byte, error) {
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+
+	var prevOp byte = txscript.OP_CHECKSIG
+	for tokenizer.Next() {
+		op := tokenizer.Opcode()
+		if prevOp == txscript.OP_FALSE && op == txscript.OP_IF {
+			break
+		}
+		prevOp = op
+	}
+	if tokenizer.Done() {
+		return nil, errors.New("script does not contain an envelope")
+	}
+
+	envelopeData := []byte{}
+	for tokenizer.Next() {
+		op := tokenizer.Opcode()
+		if op == txscript.OP_ENDIF {
+			return envelopeData, nil
+		}
+		// only data pushes are allowed within the envelope
+		if op > txscript.OP_PUSHDATA4 {
+			return nil, fmt.Errorf("envelope contains a non-push opcode %d", op)
+		}
+		envelopeData = append(envelopeData, tokenizer.Data()...)
+	}
+	if err := tokenizer.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, errors.New("envelope is not terminated")
+}
+
+// extractEnvelopeData returns the data revealed in the envelope of the
+// tapscript through which tx spends a Taproot output of spentTx. The
+// tapscript is verified against the Taproot output key of the spent output,
+// so that the envelope data cannot be forged by replacing the witness of tx
+func extractEnvelopeData(tx *btcutil.Tx, spentTx *btcutil.Tx) ([]byte, error) {
+	spentTxHash := spentTx.Hash()
+	spentOutputs := spentTx.MsgTx().TxOut
+
+	for _, txIn := range tx.MsgTx().TxIn {
+		if !txIn.PreviousOutPoint.Hash.IsEqual(spentTxHash) {
+			continue
+		}
+		outIdx := txIn.PreviousOutPoint.Index
+		if int(outIdx) >= len(spentOutputs) {
+			return nil, fmt.Errorf("spent output index %d is out of range", outIdx)
+		}
+		pkScript := spentOutputs[outIdx].PkScript
+		if !txscript.IsPayToTaproot(pkScript) {
+			continue
+		}
+
+		// script path spend witness is [...args, script, control block, (annex)]
+		witness := txIn.Witness
+		if len(witness) > 0 {
+			lastElement := witness[len(witness)-1]
+			if len(witness) > 1 && len(lastElement) > 0 && lastElement[0] == txscript.TaprootAnnexTag {
+				witness = witness[:len(witness)-1]
+			}
+		}
+		if len(witness) < 2 {
+			// key path spend does not reveal any script
+			continue
+		}
+		script := witness[len(witness)-2]
+		controlBlock, err := txscript.ParseControlBlock(witness[len(witness)-1])
+		if err != nil {
+			continue
+		}
+		if controlBlock.LeafVersion != txscript.BaseLeafVersion {
+			continue
+		}
+		// the witness program of a Taproot output is the 32 bytes after
+		// OP_1 OP_DATA_32
+		if err := txscript.VerifyTaprootLeafCommitment(controlBlock, pkScript[2:], script); err != nil {
+			continue
+		}
+
+		envelopeData, err := extractEnvelope(script)
+		if err != nil || len(envelopeData) == 0 {
+			continue
+		}
+		return envelopeData, nil
+	}
+
+	return nil, errors.New("transaction does not reveal envelope data of the spent transaction")
+}
@@ -0,0 +1,4956 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/zoneconcierge/verifier/v1/btc_timestamp.proto
+
+package verifier
+
+import (
+	fmt "fmt"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IndexedHeader is the metadata of a CZ header, as in
+// babylon.zoneconcierge.v1.IndexedHeader
+type IndexedHeader struct {
+	// chain_id is the unique ID of the chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// hash is the hash of this header
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// height is the height of this header on CZ ledger
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the timestamp of this header on CZ ledger
+	Time *time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// babylon_header_hash is the hash of the babylon block that includes this CZ
+	// header
+	BabylonHeaderHash []byte `protobuf:"bytes,5,opt,name=babylon_header_hash,json=babylonHeaderHash,proto3" json:"babylon_header_hash,omitempty"`
+	// babylon_header_height is the height of the babylon block that includes
+	// this CZ header
+	BabylonHeaderHeight uint64 `protobuf:"varint,6,opt,name=babylon_header_height,json=babylonHeaderHeight,proto3" json:"babylon_header_height,omitempty"`
+	// epoch is the epoch number of this header on Babylon ledger
+	BabylonEpoch uint64 `protobuf:"varint,7,opt,name=babylon_epoch,json=babylonEpoch,proto3" json:"babylon_epoch,omitempty"`
+	// babylon_tx_hash is the hash of the tx that includes this header
+	BabylonTxHash []byte `protobuf:"bytes,8,opt,name=babylon_tx_hash,json=babylonTxHash,proto3" json:"babylon_tx_hash,omitempty"`
+}
+
+func (m *IndexedHeader) Reset()         { *m = IndexedHeader{} }
+func (m *IndexedHeader) String() string { return proto.CompactTextString(m) }
+func (*IndexedHeader) ProtoMessage()    {}
+func (*IndexedHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{0}
+}
+func (m *IndexedHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedHeader.Merge(m, src)
+}
+func (m *IndexedHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedHeader proto.InternalMessageInfo
+
+func (m *IndexedHeader) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *IndexedHeader) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *IndexedHeader) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *IndexedHeader) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *IndexedHeader) GetBabylonHeaderHash() []byte {
+	if m != nil {
+		return m.BabylonHeaderHash
+	}
+	return nil
+}
+
+func (m *IndexedHeader) GetBabylonHeaderHeight() uint64 {
+	if m != nil {
+		return m.BabylonHeaderHeight
+	}
+	return 0
+}
+
+func (m *IndexedHeader) GetBabylonEpoch() uint64 {
+	if m != nil {
+		return m.BabylonEpoch
+	}
+	return 0
+}
+
+func (m *IndexedHeader) GetBabylonTxHash() []byte {
+	if m != nil {
+		return m.BabylonTxHash
+	}
+	return nil
+}
+
+// Epoch is the metadata of an epoch, as in babylon.epoching.v1.Epoch
+type Epoch struct {
+	// epoch_number is the number of this epoch
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// current_epoch_interval is the number of blocks in this epoch
+	CurrentEpochInterval uint64 `protobuf:"varint,2,opt,name=current_epoch_interval,json=currentEpochInterval,proto3" json:"current_epoch_interval,omitempty"`
+	// first_block_height is the height of the first block in this epoch
+	FirstBlockHeight uint64 `protobuf:"varint,3,opt,name=first_block_height,json=firstBlockHeight,proto3" json:"first_block_height,omitempty"`
+	// last_block_time is the time of the last block in this epoch
+	LastBlockTime *time.Time `protobuf:"bytes,4,opt,name=last_block_time,json=lastBlockTime,proto3,stdtime" json:"last_block_time,omitempty"`
+	// sealer_app_hash is the app hash of the sealer, i.e., the last block of
+	// this epoch
+	SealerAppHash []byte `protobuf:"bytes,5,opt,name=sealer_app_hash,json=sealerAppHash,proto3" json:"sealer_app_hash,omitempty"`
+	// sealer_block_hash is the hash of the sealer
+	SealerBlockHash []byte `protobuf:"bytes,6,opt,name=sealer_block_hash,json=sealerBlockHash,proto3" json:"sealer_block_hash,omitempty"`
+}
+
+func (m *Epoch) Reset()         { *m = Epoch{} }
+func (m *Epoch) String() string { return proto.CompactTextString(m) }
+func (*Epoch) ProtoMessage()    {}
+func (*Epoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{1}
+}
+func (m *Epoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Epoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Epoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Epoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Epoch.Merge(m, src)
+}
+func (m *Epoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *Epoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Epoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Epoch proto.InternalMessageInfo
+
+func (m *Epoch) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *Epoch) GetCurrentEpochInterval() uint64 {
+	if m != nil {
+		return m.CurrentEpochInterval
+	}
+	return 0
+}
+
+func (m *Epoch) GetFirstBlockHeight() uint64 {
+	if m != nil {
+		return m.FirstBlockHeight
+	}
+	return 0
+}
+
+func (m *Epoch) GetLastBlockTime() *time.Time {
+	if m != nil {
+		return m.LastBlockTime
+	}
+	return nil
+}
+
+func (m *Epoch) GetSealerAppHash() []byte {
+	if m != nil {
+		return m.SealerAppHash
+	}
+	return nil
+}
+
+func (m *Epoch) GetSealerBlockHash() []byte {
+	if m != nil {
+		return m.SealerBlockHash
+	}
+	return nil
+}
+
+// RawCheckpoint is the BLS multi sig of an epoch with metadata, as in
+// babylon.checkpointing.v1.RawCheckpoint
+type RawCheckpoint struct {
+	// epoch_num defines the epoch number the raw checkpoint is for
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// block_hash is the hash of the block that individual BLS sigs are signed on
+	BlockHash []byte `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// bitmap defines the bitmap that indicates the signers of the BLS multi sig
+	Bitmap []byte `protobuf:"bytes,3,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+	// bls_multi_sig defines the multi sig that is aggregated from individual BLS
+	// sigs
+	BlsMultiSig []byte `protobuf:"bytes,4,opt,name=bls_multi_sig,json=blsMultiSig,proto3" json:"bls_multi_sig,omitempty"`
+}
+
+func (m *RawCheckpoint) Reset()         { *m = RawCheckpoint{} }
+func (m *RawCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*RawCheckpoint) ProtoMessage()    {}
+func (*RawCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{2}
+}
+func (m *RawCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RawCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RawCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawCheckpoint.Merge(m, src)
+}
+func (m *RawCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawCheckpoint proto.InternalMessageInfo
+
+func (m *RawCheckpoint) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *RawCheckpoint) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *RawCheckpoint) GetBitmap() []byte {
+	if m != nil {
+		return m.Bitmap
+	}
+	return nil
+}
+
+func (m *RawCheckpoint) GetBlsMultiSig() []byte {
+	if m != nil {
+		return m.BlsMultiSig
+	}
+	return nil
+}
+
+// ValidatorWithBlsKeySet is a set of validators with their BLS public keys,
+// as in babylon.checkpointing.v1.ValidatorWithBlsKeySet
+type ValidatorWithBlsKeySet struct {
+	ValSet []*ValidatorWithBlsKey `protobuf:"bytes,1,rep,name=val_set,json=valSet,proto3" json:"val_set,omitempty"`
+}
+
+func (m *ValidatorWithBlsKeySet) Reset()         { *m = ValidatorWithBlsKeySet{} }
+func (m *ValidatorWithBlsKeySet) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWithBlsKeySet) ProtoMessage()    {}
+func (*ValidatorWithBlsKeySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{3}
+}
+func (m *ValidatorWithBlsKeySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorWithBlsKeySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorWithBlsKeySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorWithBlsKeySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWithBlsKeySet.Merge(m, src)
+}
+func (m *ValidatorWithBlsKeySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorWithBlsKeySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWithBlsKeySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorWithBlsKeySet proto.InternalMessageInfo
+
+func (m *ValidatorWithBlsKeySet) GetValSet() []*ValidatorWithBlsKey {
+	if m != nil {
+		return m.ValSet
+	}
+	return nil
+}
+
+// ValidatorWithBlsKey couples validator address, voting power, and its BLS
+// public key, as in babylon.checkpointing.v1.ValidatorWithBlsKey
+type ValidatorWithBlsKey struct {
+	// validator_address is the address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// bls_pub_key is the BLS public key of the validator
+	BlsPubKey []byte `protobuf:"bytes,2,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key,omitempty"`
+	// voting_power is the voting power of the validator at the given epoch
+	VotingPower uint64 `protobuf:"varint,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *ValidatorWithBlsKey) Reset()         { *m = ValidatorWithBlsKey{} }
+func (m *ValidatorWithBlsKey) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWithBlsKey) ProtoMessage()    {}
+func (*ValidatorWithBlsKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{4}
+}
+func (m *ValidatorWithBlsKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorWithBlsKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorWithBlsKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorWithBlsKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWithBlsKey.Merge(m, src)
+}
+func (m *ValidatorWithBlsKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorWithBlsKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWithBlsKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorWithBlsKey proto.InternalMessageInfo
+
+func (m *ValidatorWithBlsKey) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorWithBlsKey) GetBlsPubKey() []byte {
+	if m != nil {
+		return m.BlsPubKey
+	}
+	return nil
+}
+
+func (m *ValidatorWithBlsKey) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+// TransactionKey is the position of a tx on BTC, as in
+// babylon.btccheckpoint.v1.TransactionKey
+type TransactionKey struct {
+	// index is the index of the tx in its block
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// hash is the hash of the block including the tx
+	Hash *BTCHeaderHashBytes `protobuf:"bytes,2,opt,name=hash,proto3,customtype=BTCHeaderHashBytes" json:"hash,omitempty"`
+}
+
+func (m *TransactionKey) Reset()         { *m = TransactionKey{} }
+func (m *TransactionKey) String() string { return proto.CompactTextString(m) }
+func (*TransactionKey) ProtoMessage()    {}
+func (*TransactionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{5}
+}
+func (m *TransactionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransactionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionKey.Merge(m, src)
+}
+func (m *TransactionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionKey proto.InternalMessageInfo
+
+func (m *TransactionKey) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// SubmissionKey is the positions of the txs carrying a checkpoint, as in
+// babylon.btccheckpoint.v1.SubmissionKey
+type SubmissionKey struct {
+	Key []*TransactionKey `protobuf:"bytes,1,rep,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *SubmissionKey) Reset()         { *m = SubmissionKey{} }
+func (m *SubmissionKey) String() string { return proto.CompactTextString(m) }
+func (*SubmissionKey) ProtoMessage()    {}
+func (*SubmissionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{6}
+}
+func (m *SubmissionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmissionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmissionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmissionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmissionKey.Merge(m, src)
+}
+func (m *SubmissionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmissionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmissionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmissionKey proto.InternalMessageInfo
+
+func (m *SubmissionKey) GetKey() []*TransactionKey {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+// TransactionInfo is a tx on BTC with its position and Merkle proof, as in
+// babylon.btccheckpoint.v1.TransactionInfo
+type TransactionInfo struct {
+	// key is the position of this tx on BTC
+	Key *TransactionKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// transaction is the full transaction in bytes
+	Transaction []byte `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// proof is the Merkle proof that this tx is included in the position in `key`
+	Proof []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// spent_transaction is the transaction whose Taproot output is spent by
+	// `transaction` to reveal the checkpoint data, for checkpoints carried by a
+	// single transaction
+	SpentTransaction []byte `protobuf:"bytes,4,opt,name=spent_transaction,json=spentTransaction,proto3" json:"spent_transaction,omitempty"`
+}
+
+func (m *TransactionInfo) Reset()         { *m = TransactionInfo{} }
+func (m *TransactionInfo) String() string { return proto.CompactTextString(m) }
+func (*TransactionInfo) ProtoMessage()    {}
+func (*TransactionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{7}
+}
+func (m *TransactionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransactionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionInfo.Merge(m, src)
+}
+func (m *TransactionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionInfo proto.InternalMessageInfo
+
+func (m *TransactionInfo) GetKey() *TransactionKey {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetTransaction() []byte {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetSpentTransaction() []byte {
+	if m != nil {
+		return m.SpentTransaction
+	}
+	return nil
+}
+
+// BTCHeaderInfo is a BTC header, as in babylon.btclightclient.v1.BTCHeaderInfo
+type BTCHeaderInfo struct {
+	// header is the full header bytes
+	Header *BTCHeaderBytes `protobuf:"bytes,1,opt,name=header,proto3,customtype=BTCHeaderBytes" json:"header,omitempty"`
+	// hash is the hash of the header
+	Hash *BTCHeaderHashBytes `protobuf:"bytes,2,opt,name=hash,proto3,customtype=BTCHeaderHashBytes" json:"hash,omitempty"`
+	// height is the height of the header in the BTC chain
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// work is the total work of the header in decimal
+	Work string `protobuf:"bytes,4,opt,name=work,proto3" json:"work,omitempty"`
+}
+
+func (m *BTCHeaderInfo) Reset()         { *m = BTCHeaderInfo{} }
+func (m *BTCHeaderInfo) String() string { return proto.CompactTextString(m) }
+func (*BTCHeaderInfo) ProtoMessage()    {}
+func (*BTCHeaderInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{8}
+}
+func (m *BTCHeaderInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCHeaderInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCHeaderInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCHeaderInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCHeaderInfo.Merge(m, src)
+}
+func (m *BTCHeaderInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCHeaderInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCHeaderInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCHeaderInfo proto.InternalMessageInfo
+
+func (m *BTCHeaderInfo) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BTCHeaderInfo) GetWork() string {
+	if m != nil {
+		return m.Work
+	}
+	return ""
+}
+
+// ProofEpochSealed is the proof that an epoch is sealed by the sealer header,
+// as in babylon.zoneconcierge.v1.ProofEpochSealed
+type ProofEpochSealed struct {
+	// validator_set is the validator set of the sealed epoch
+	ValidatorSet []*ValidatorWithBlsKey `protobuf:"bytes,1,rep,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+	// proof_epoch_info is the Merkle proof that the epoch's metadata is committed
+	// to `app_hash` of the sealer header
+	ProofEpochInfo *crypto.ProofOps `protobuf:"bytes,2,opt,name=proof_epoch_info,json=proofEpochInfo,proto3" json:"proof_epoch_info,omitempty"`
+	// proof_epoch_val_set is the Merkle proof that the epoch's validator set is
+	// committed to `app_hash` of the sealer header
+	ProofEpochValSet *crypto.ProofOps `protobuf:"bytes,3,opt,name=proof_epoch_val_set,json=proofEpochValSet,proto3" json:"proof_epoch_val_set,omitempty"`
+}
+
+func (m *ProofEpochSealed) Reset()         { *m = ProofEpochSealed{} }
+func (m *ProofEpochSealed) String() string { return proto.CompactTextString(m) }
+func (*ProofEpochSealed) ProtoMessage()    {}
+func (*ProofEpochSealed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{9}
+}
+func (m *ProofEpochSealed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofEpochSealed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofEpochSealed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProofEpochSealed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofEpochSealed.Merge(m, src)
+}
+func (m *ProofEpochSealed) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofEpochSealed) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofEpochSealed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofEpochSealed proto.InternalMessageInfo
+
+func (m *ProofEpochSealed) GetValidatorSet() []*ValidatorWithBlsKey {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return nil
+}
+
+func (m *ProofEpochSealed) GetProofEpochInfo() *crypto.ProofOps {
+	if m != nil {
+		return m.ProofEpochInfo
+	}
+	return nil
+}
+
+func (m *ProofEpochSealed) GetProofEpochValSet() *crypto.ProofOps {
+	if m != nil {
+		return m.ProofEpochValSet
+	}
+	return nil
+}
+
+// ProofFinalizedChainInfo is a set of proofs that attest a chain info is
+// BTC-finalised, as in babylon.zoneconcierge.v1.ProofFinalizedChainInfo
+type ProofFinalizedChainInfo struct {
+	// proof_cz_header_in_epoch is the proof that the CZ header is timestamped
+	// within a certain epoch
+	ProofCzHeaderInEpoch *crypto.ProofOps `protobuf:"bytes,1,opt,name=proof_cz_header_in_epoch,json=proofCzHeaderInEpoch,proto3" json:"proof_cz_header_in_epoch,omitempty"`
+	// proof_epoch_sealed is the proof that the epoch is sealed
+	ProofEpochSealed *ProofEpochSealed `protobuf:"bytes,2,opt,name=proof_epoch_sealed,json=proofEpochSealed,proto3" json:"proof_epoch_sealed,omitempty"`
+	// proof_epoch_submitted is the proof that the epoch's checkpoint is included
+	// in BTC ledger
+	ProofEpochSubmitted []*TransactionInfo `protobuf:"bytes,3,rep,name=proof_epoch_submitted,json=proofEpochSubmitted,proto3" json:"proof_epoch_submitted,omitempty"`
+}
+
+func (m *ProofFinalizedChainInfo) Reset()         { *m = ProofFinalizedChainInfo{} }
+func (m *ProofFinalizedChainInfo) String() string { return proto.CompactTextString(m) }
+func (*ProofFinalizedChainInfo) ProtoMessage()    {}
+func (*ProofFinalizedChainInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{10}
+}
+func (m *ProofFinalizedChainInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofFinalizedChainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofFinalizedChainInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProofFinalizedChainInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofFinalizedChainInfo.Merge(m, src)
+}
+func (m *ProofFinalizedChainInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofFinalizedChainInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofFinalizedChainInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofFinalizedChainInfo proto.InternalMessageInfo
+
+func (m *ProofFinalizedChainInfo) GetProofCzHeaderInEpoch() *crypto.ProofOps {
+	if m != nil {
+		return m.ProofCzHeaderInEpoch
+	}
+	return nil
+}
+
+func (m *ProofFinalizedChainInfo) GetProofEpochSealed() *ProofEpochSealed {
+	if m != nil {
+		return m.ProofEpochSealed
+	}
+	return nil
+}
+
+func (m *ProofFinalizedChainInfo) GetProofEpochSubmitted() []*TransactionInfo {
+	if m != nil {
+		return m.ProofEpochSubmitted
+	}
+	return nil
+}
+
+// BTCTimestamp is a BTC timestamp that carries information of a BTC-finalised
+// epoch, as in babylon.zoneconcierge.v1.BTCTimestamp
+type BTCTimestamp struct {
+	// header is the last CZ header in the finalized Babylon epoch
+	Header *IndexedHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// btc_headers is BTC headers extending the BTC chain up to the BTC tip at
+	// the finalized epoch
+	BtcHeaders []*BTCHeaderInfo `protobuf:"bytes,2,rep,name=btc_headers,json=btcHeaders,proto3" json:"btc_headers,omitempty"`
+	// epoch_info is the metadata of the sealed epoch
+	EpochInfo *Epoch `protobuf:"bytes,3,opt,name=epoch_info,json=epochInfo,proto3" json:"epoch_info,omitempty"`
+	// raw_checkpoint is the raw checkpoint that seals this epoch
+	RawCheckpoint *RawCheckpoint `protobuf:"bytes,4,opt,name=raw_checkpoint,json=rawCheckpoint,proto3" json:"raw_checkpoint,omitempty"`
+	// btc_submission_key is position of BTC txs that include the raw checkpoint
+	// of this epoch
+	BtcSubmissionKey *SubmissionKey `protobuf:"bytes,5,opt,name=btc_submission_key,json=btcSubmissionKey,proto3" json:"btc_submission_key,omitempty"`
+	// proof is the proofs that the header is finalized
+	Proof *ProofFinalizedChainInfo `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *BTCTimestamp) Reset()         { *m = BTCTimestamp{} }
+func (m *BTCTimestamp) String() string { return proto.CompactTextString(m) }
+func (*BTCTimestamp) ProtoMessage()    {}
+func (*BTCTimestamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{11}
+}
+func (m *BTCTimestamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCTimestamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCTimestamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCTimestamp.Merge(m, src)
+}
+func (m *BTCTimestamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCTimestamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCTimestamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCTimestamp proto.InternalMessageInfo
+
+func (m *BTCTimestamp) GetHeader() *IndexedHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BTCTimestamp) GetBtcHeaders() []*BTCHeaderInfo {
+	if m != nil {
+		return m.BtcHeaders
+	}
+	return nil
+}
+
+func (m *BTCTimestamp) GetEpochInfo() *Epoch {
+	if m != nil {
+		return m.EpochInfo
+	}
+	return nil
+}
+
+func (m *BTCTimestamp) GetRawCheckpoint() *RawCheckpoint {
+	if m != nil {
+		return m.RawCheckpoint
+	}
+	return nil
+}
+
+func (m *BTCTimestamp) GetBtcSubmissionKey() *SubmissionKey {
+	if m != nil {
+		return m.BtcSubmissionKey
+	}
+	return nil
+}
+
+func (m *BTCTimestamp) GetProof() *ProofFinalizedChainInfo {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// ZoneconciergePacketData is the data of zoneconcierge IBC packets, as in
+// babylon.zoneconcierge.v1.ZoneconciergePacketData. Packets other than BTC
+// timestamps are ignored
+type ZoneconciergePacketData struct {
+	// packet is the actual message carried in the IBC packet
+	//
+	// Types that are valid to be assigned to Packet:
+	//
+	//	*ZoneconciergePacketData_BtcTimestamp
+	Packet isZoneconciergePacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *ZoneconciergePacketData) Reset()         { *m = ZoneconciergePacketData{} }
+func (m *ZoneconciergePacketData) String() string { return proto.CompactTextString(m) }
+func (*ZoneconciergePacketData) ProtoMessage()    {}
+func (*ZoneconciergePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{12}
+}
+func (m *ZoneconciergePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneconciergePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZoneconciergePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ZoneconciergePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneconciergePacketData.Merge(m, src)
+}
+func (m *ZoneconciergePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneconciergePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneconciergePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneconciergePacketData proto.InternalMessageInfo
+
+type isZoneconciergePacketData_Packet interface {
+	isZoneconciergePacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ZoneconciergePacketData_BtcTimestamp struct {
+	BtcTimestamp *BTCTimestamp `protobuf:"bytes,1,opt,name=btc_timestamp,json=btcTimestamp,proto3,oneof" json:"btc_timestamp,omitempty"`
+}
+
+func (*ZoneconciergePacketData_BtcTimestamp) isZoneconciergePacketData_Packet() {}
+
+func (m *ZoneconciergePacketData) GetPacket() isZoneconciergePacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *ZoneconciergePacketData) GetBtcTimestamp() *BTCTimestamp {
+	if x, ok := m.GetPacket().(*ZoneconciergePacketData_BtcTimestamp); ok {
+		return x.BtcTimestamp
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ZoneconciergePacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ZoneconciergePacketData_BtcTimestamp)(nil),
+	}
+}
+
+// ChainInfo is the information of a CZ, as in
+// babylon.zoneconcierge.v1.ChainInfo
+type ChainInfo struct {
+	// chain_id is the ID of the chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// latest_header is the latest header in CZ's canonical chain
+	LatestHeader *IndexedHeader `protobuf:"bytes,2,opt,name=latest_header,json=latestHeader,proto3" json:"latest_header,omitempty"`
+}
+
+func (m *ChainInfo) Reset()         { *m = ChainInfo{} }
+func (m *ChainInfo) String() string { return proto.CompactTextString(m) }
+func (*ChainInfo) ProtoMessage()    {}
+func (*ChainInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{13}
+}
+func (m *ChainInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainInfo.Merge(m, src)
+}
+func (m *ChainInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainInfo proto.InternalMessageInfo
+
+func (m *ChainInfo) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainInfo) GetLatestHeader() *IndexedHeader {
+	if m != nil {
+		return m.LatestHeader
+	}
+	return nil
+}
+
+// QueryFinalizedChainInfoUntilHeightResponse is the response of the
+// FinalizedChainInfoUntilHeight query, as in
+// babylon.zoneconcierge.v1.QueryFinalizedChainInfoUntilHeightResponse
+type QueryFinalizedChainInfoUntilHeightResponse struct {
+	// finalized_chain_info is the info of the CZ
+	FinalizedChainInfo *ChainInfo `protobuf:"bytes,1,opt,name=finalized_chain_info,json=finalizedChainInfo,proto3" json:"finalized_chain_info,omitempty"`
+	// epoch_info is the metadata of the last BTC-finalised epoch
+	EpochInfo *Epoch `protobuf:"bytes,2,opt,name=epoch_info,json=epochInfo,proto3" json:"epoch_info,omitempty"`
+	// raw_checkpoint is the raw checkpoint of this epoch
+	RawCheckpoint *RawCheckpoint `protobuf:"bytes,3,opt,name=raw_checkpoint,json=rawCheckpoint,proto3" json:"raw_checkpoint,omitempty"`
+	// btc_submission_key is position of BTC txs that include the raw checkpoint
+	// of this epoch
+	BtcSubmissionKey *SubmissionKey `protobuf:"bytes,4,opt,name=btc_submission_key,json=btcSubmissionKey,proto3" json:"btc_submission_key,omitempty"`
+	// proof is the proof that the chain info is finalized
+	Proof *ProofFinalizedChainInfo `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryFinalizedChainInfoUntilHeightResponse) Reset() {
+	*m = QueryFinalizedChainInfoUntilHeightResponse{}
+}
+func (m *QueryFinalizedChainInfoUntilHeightResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalizedChainInfoUntilHeightResponse) ProtoMessage() {}
+func (*QueryFinalizedChainInfoUntilHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26e7ff1a31f2cd72, []int{14}
+}
+func (m *QueryFinalizedChainInfoUntilHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizedChainInfoUntilHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizedChainInfoUntilHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalizedChainInfoUntilHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizedChainInfoUntilHeightResponse.Merge(m, src)
+}
+func (m *QueryFinalizedChainInfoUntilHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizedChainInfoUntilHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizedChainInfoUntilHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalizedChainInfoUntilHeightResponse proto.InternalMessageInfo
+
+func (m *QueryFinalizedChainInfoUntilHeightResponse) GetFinalizedChainInfo() *ChainInfo {
+	if m != nil {
+		return m.FinalizedChainInfo
+	}
+	return nil
+}
+
+func (m *QueryFinalizedChainInfoUntilHeightResponse) GetEpochInfo() *Epoch {
+	if m != nil {
+		return m.EpochInfo
+	}
+	return nil
+}
+
+func (m *QueryFinalizedChainInfoUntilHeightResponse) GetRawCheckpoint() *RawCheckpoint {
+	if m != nil {
+		return m.RawCheckpoint
+	}
+	return nil
+}
+
+func (m *QueryFinalizedChainInfoUntilHeightResponse) GetBtcSubmissionKey() *SubmissionKey {
+	if m != nil {
+		return m.BtcSubmissionKey
+	}
+	return nil
+}
+
+func (m *QueryFinalizedChainInfoUntilHeightResponse) GetProof() *ProofFinalizedChainInfo {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*IndexedHeader)(nil), "babylon.zoneconcierge.verifier.v1.IndexedHeader")
+	proto.RegisterType((*Epoch)(nil), "babylon.zoneconcierge.verifier.v1.Epoch")
+	proto.RegisterType((*RawCheckpoint)(nil), "babylon.zoneconcierge.verifier.v1.RawCheckpoint")
+	proto.RegisterType((*ValidatorWithBlsKeySet)(nil), "babylon.zoneconcierge.verifier.v1.ValidatorWithBlsKeySet")
+	proto.RegisterType((*ValidatorWithBlsKey)(nil), "babylon.zoneconcierge.verifier.v1.ValidatorWithBlsKey")
+	proto.RegisterType((*TransactionKey)(nil), "babylon.zoneconcierge.verifier.v1.TransactionKey")
+	proto.RegisterType((*SubmissionKey)(nil), "babylon.zoneconcierge.verifier.v1.SubmissionKey")
+	proto.RegisterType((*TransactionInfo)(nil), "babylon.zoneconcierge.verifier.v1.TransactionInfo")
+	proto.RegisterType((*BTCHeaderInfo)(nil), "babylon.zoneconcierge.verifier.v1.BTCHeaderInfo")
+	proto.RegisterType((*ProofEpochSealed)(nil), "babylon.zoneconcierge.verifier.v1.ProofEpochSealed")
+	proto.RegisterType((*ProofFinalizedChainInfo)(nil), "babylon.zoneconcierge.verifier.v1.ProofFinalizedChainInfo")
+	proto.RegisterType((*BTCTimestamp)(nil), "babylon.zoneconcierge.verifier.v1.BTCTimestamp")
+	proto.RegisterType((*ZoneconciergePacketData)(nil), "babylon.zoneconcierge.verifier.v1.ZoneconciergePacketData")
+	proto.RegisterType((*ChainInfo)(nil), "babylon.zoneconcierge.verifier.v1.ChainInfo")
+	proto.RegisterType((*QueryFinalizedChainInfoUntilHeightResponse)(nil), "babylon.zoneconcierge.verifier.v1.QueryFinalizedChainInfoUntilHeightResponse")
+}
+
+func init() {
+	proto.RegisterFile("babylon/zoneconcierge/verifier/v1/btc_timestamp.proto", fileDescriptor_26e7ff1a31f2cd72)
+}
+
+var fileDescriptor_26e7ff1a31f2cd72 = []byte{
+	// 1295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5d, 0x6f, 0xdb, 0x54,
+	0x18, 0xae, 0x93, 0x34, 0x6b, 0xdf, 0xc4, 0x5d, 0x76, 0xda, 0x75, 0xa1, 0xd3, 0xd2, 0xce, 0x48,
+	0xa8, 0x2a, 0x93, 0xc3, 0xba, 0x81, 0x10, 0x77, 0x4b, 0x19, 0xb4, 0x20, 0x58, 0xe7, 0x66, 0x9b,
+	0x34, 0xa4, 0x59, 0xc7, 0xf6, 0x49, 0x72, 0x54, 0xc7, 0xb6, 0xec, 0x93, 0xb4, 0x29, 0xe2, 0x06,
+	0x09, 0x71, 0x3b, 0x89, 0xbf, 0xc1, 0x1f, 0x40, 0x88, 0x7b, 0x2e, 0x77, 0x89, 0x76, 0x31, 0xa6,
+	0xed, 0x87, 0x80, 0xce, 0x87, 0x1d, 0x7b, 0x1f, 0x2c, 0x85, 0x71, 0x97, 0xf3, 0x9e, 0xf7, 0xe3,
+	0xf1, 0x73, 0x9e, 0xf7, 0x3d, 0x27, 0xf0, 0xa1, 0x83, 0x9d, 0x89, 0x1f, 0x06, 0xed, 0x93, 0x30,
+	0x20, 0x6e, 0x18, 0xb8, 0x94, 0xc4, 0x7d, 0xd2, 0x1e, 0x93, 0x98, 0xf6, 0x28, 0x89, 0xdb, 0xe3,
+	0xab, 0x6d, 0x87, 0xb9, 0x36, 0xa3, 0x43, 0x92, 0x30, 0x3c, 0x8c, 0xcc, 0x28, 0x0e, 0x59, 0x88,
+	0x2e, 0xab, 0x30, 0xb3, 0x10, 0x66, 0xa6, 0x61, 0xe6, 0xf8, 0xea, 0xda, 0x4a, 0x3f, 0xec, 0x87,
+	0xc2, 0xbb, 0xcd, 0x7f, 0xc9, 0xc0, 0xb5, 0xf5, 0x7e, 0x18, 0xf6, 0x7d, 0xd2, 0x16, 0x2b, 0x67,
+	0xd4, 0x6b, 0xbf, 0x90, 0x79, 0xed, 0x12, 0x23, 0x81, 0x47, 0xe2, 0x21, 0x0d, 0x58, 0xdb, 0x8d,
+	0x27, 0x11, 0x0b, 0xb9, 0x6f, 0xd8, 0x93, 0xdb, 0xc6, 0x6f, 0x25, 0xd0, 0xf7, 0x02, 0x8f, 0x1c,
+	0x13, 0x6f, 0x97, 0x60, 0x8f, 0xc4, 0xe8, 0x1d, 0x58, 0x70, 0x07, 0x98, 0x06, 0x36, 0xf5, 0x9a,
+	0xda, 0x86, 0xb6, 0xb9, 0x68, 0x9d, 0x11, 0xeb, 0x3d, 0x0f, 0x21, 0xa8, 0x0c, 0x70, 0x32, 0x68,
+	0x96, 0x36, 0xb4, 0xcd, 0xba, 0x25, 0x7e, 0xa3, 0x55, 0xa8, 0x0e, 0x08, 0xed, 0x0f, 0x58, 0xb3,
+	0xbc, 0xa1, 0x6d, 0x56, 0x2c, 0xb5, 0x42, 0xd7, 0xa1, 0xc2, 0xa1, 0x34, 0x2b, 0x1b, 0xda, 0x66,
+	0x6d, 0x7b, 0xcd, 0x94, 0x38, 0xcd, 0x14, 0xa7, 0xd9, 0x4d, 0x71, 0x76, 0x2a, 0x0f, 0xff, 0x5c,
+	0xd7, 0x2c, 0xe1, 0x8d, 0x4c, 0x58, 0x56, 0x4c, 0xd8, 0x03, 0x01, 0xc7, 0x16, 0x05, 0xe7, 0x45,
+	0xc1, 0x73, 0x6a, 0x4b, 0x02, 0xdd, 0xe5, 0xd5, 0xb7, 0xe1, 0xfc, 0x8b, 0xfe, 0x12, 0x4c, 0x55,
+	0x80, 0x59, 0x2e, 0x46, 0x48, 0x64, 0xef, 0x82, 0x9e, 0xc6, 0x90, 0x28, 0x74, 0x07, 0xcd, 0x33,
+	0xc2, 0xb7, 0xae, 0x8c, 0x37, 0xb9, 0x0d, 0xbd, 0x07, 0x67, 0x53, 0x27, 0x76, 0x2c, 0x41, 0x2c,
+	0x08, 0x10, 0x69, 0x6c, 0xf7, 0x98, 0x03, 0x30, 0x7e, 0x2e, 0xc1, 0xbc, 0x8c, 0xb8, 0x0c, 0x75,
+	0x91, 0xce, 0x0e, 0x46, 0x43, 0x87, 0xc4, 0x82, 0xbb, 0x8a, 0x55, 0x13, 0xb6, 0xaf, 0x85, 0x09,
+	0x5d, 0x87, 0x55, 0x77, 0x14, 0xc7, 0x24, 0x60, 0xb2, 0xb2, 0x4d, 0x03, 0x46, 0xe2, 0x31, 0xf6,
+	0x05, 0xa3, 0x15, 0x6b, 0x45, 0xed, 0x8a, 0x84, 0x7b, 0x6a, 0x0f, 0x5d, 0x01, 0xd4, 0xa3, 0x71,
+	0xc2, 0x6c, 0xc7, 0x0f, 0xdd, 0x43, 0xbb, 0xc0, 0x76, 0x43, 0xec, 0x74, 0xf8, 0x86, 0xfa, 0xba,
+	0x5d, 0x38, 0xeb, 0xe3, 0xcc, 0xf9, 0x54, 0x47, 0xa0, 0xf3, 0x40, 0x91, 0x8b, 0xef, 0x70, 0x0a,
+	0x12, 0x82, 0x7d, 0x12, 0xdb, 0x38, 0x8a, 0xf2, 0xe7, 0xa0, 0x4b, 0xf3, 0x8d, 0x28, 0x12, 0x67,
+	0xb0, 0x05, 0xe7, 0x94, 0x9f, 0x02, 0xc8, 0x3d, 0xab, 0xc2, 0x53, 0x25, 0x90, 0xf8, 0x38, 0x5d,
+	0x3f, 0x6a, 0xa0, 0x5b, 0xf8, 0x68, 0x67, 0x40, 0xdc, 0xc3, 0x28, 0xa4, 0x01, 0x43, 0x17, 0x61,
+	0x31, 0xa3, 0x4d, 0x71, 0xb6, 0x90, 0x72, 0x86, 0x2e, 0x01, 0xe4, 0x72, 0x4a, 0xd9, 0x2d, 0x3a,
+	0x69, 0x36, 0xae, 0x3d, 0x87, 0xb2, 0x21, 0x8e, 0x04, 0x1b, 0x75, 0x4b, 0xad, 0x90, 0x01, 0xba,
+	0xe3, 0x27, 0xf6, 0x70, 0xe4, 0x33, 0x6a, 0x27, 0xb4, 0x2f, 0x18, 0xa8, 0x5b, 0x35, 0xc7, 0x4f,
+	0xbe, 0xe2, 0xb6, 0x03, 0xda, 0x37, 0x28, 0xac, 0xde, 0xc5, 0x3e, 0xf5, 0x30, 0x0b, 0xe3, 0x7b,
+	0x94, 0x0d, 0x3a, 0x7e, 0xf2, 0x25, 0x99, 0x1c, 0x10, 0x86, 0x6e, 0xc1, 0x99, 0x31, 0xf6, 0xed,
+	0x84, 0xb0, 0xa6, 0xb6, 0x51, 0xde, 0xac, 0x6d, 0x7f, 0x64, 0xbe, 0xb1, 0x3b, 0xcd, 0x57, 0xe4,
+	0xb2, 0xaa, 0x63, 0xec, 0x1f, 0x10, 0x66, 0xfc, 0xa0, 0xc1, 0xf2, 0x2b, 0xf6, 0xd1, 0xfb, 0x70,
+	0x6e, 0x9c, 0x9a, 0x6d, 0xec, 0x79, 0x31, 0x49, 0x12, 0xd5, 0x72, 0x8d, 0x6c, 0xe3, 0x86, 0xb4,
+	0xa3, 0x16, 0x70, 0xf8, 0x76, 0x34, 0x72, 0xec, 0x43, 0x32, 0x99, 0x72, 0x91, 0xec, 0x8f, 0x1c,
+	0x9e, 0xec, 0x32, 0xd4, 0xc7, 0x21, 0xa3, 0x41, 0xdf, 0x8e, 0xc2, 0x23, 0x12, 0x2b, 0x7d, 0xd4,
+	0xa4, 0x6d, 0x9f, 0x9b, 0x0c, 0x0b, 0x96, 0xba, 0x31, 0x0e, 0x12, 0xec, 0x32, 0x1a, 0x06, 0x3c,
+	0x68, 0x05, 0xe6, 0x29, 0x6f, 0x7e, 0x51, 0x55, 0xb7, 0xe4, 0x02, 0x6d, 0xe5, 0xdb, 0xbc, 0xb3,
+	0xfa, 0xf8, 0xc9, 0x3a, 0xea, 0x74, 0x77, 0xa6, 0x5d, 0xd7, 0x99, 0x30, 0x92, 0xc8, 0xf6, 0x37,
+	0xba, 0xa0, 0x1f, 0x8c, 0x9c, 0x21, 0x4d, 0x12, 0x95, 0x72, 0x07, 0xca, 0x1c, 0x9f, 0x64, 0xee,
+	0xea, 0x0c, 0xcc, 0x15, 0x21, 0x59, 0x3c, 0xda, 0xf8, 0x55, 0x83, 0xb3, 0x39, 0xfb, 0x5e, 0xd0,
+	0x0b, 0xa7, 0x89, 0xb5, 0x7f, 0x9f, 0x18, 0x6d, 0x40, 0x8d, 0x4d, 0xcd, 0x8a, 0xc5, 0xbc, 0x89,
+	0x53, 0x22, 0xe6, 0xa3, 0x92, 0x94, 0x5c, 0xf0, 0xa3, 0x4a, 0x22, 0xde, 0xb7, 0xf9, 0x68, 0xa9,
+	0xaa, 0x86, 0xd8, 0xc8, 0x15, 0x33, 0x7e, 0xd2, 0x40, 0xcf, 0x08, 0x13, 0xd8, 0xb7, 0xf8, 0x90,
+	0xe4, 0x2b, 0x01, 0xbf, 0xde, 0x41, 0x8f, 0x9f, 0xac, 0x2f, 0x65, 0x2e, 0x92, 0x4f, 0xe5, 0x71,
+	0x1a, 0xf6, 0x5f, 0x3b, 0x7c, 0x11, 0x54, 0x8e, 0xc2, 0xf8, 0x50, 0x20, 0x5c, 0xb4, 0xc4, 0x6f,
+	0xe3, 0xfb, 0x12, 0x34, 0xf6, 0xf9, 0xc7, 0x88, 0xe9, 0x72, 0xc0, 0x1b, 0xd3, 0x43, 0xdf, 0x80,
+	0x3e, 0x95, 0xe0, 0x7f, 0x57, 0x7c, 0x3d, 0x4b, 0xc6, 0x1b, 0xe9, 0x26, 0x34, 0x04, 0x7b, 0xd9,
+	0xb0, 0xeb, 0x85, 0xe2, 0xab, 0x6a, 0xdb, 0x17, 0xcd, 0xe9, 0xad, 0x64, 0xca, 0x5b, 0xc9, 0x14,
+	0xd8, 0x6e, 0x45, 0x89, 0xb5, 0x14, 0x65, 0x28, 0x05, 0x79, 0x5f, 0xc0, 0x72, 0x3e, 0x4d, 0xda,
+	0x9b, 0xe5, 0x37, 0x67, 0x6a, 0x4c, 0x33, 0xdd, 0x95, 0xad, 0xf8, 0x4b, 0x09, 0x2e, 0x88, 0xed,
+	0xcf, 0x68, 0x80, 0x7d, 0x7a, 0x42, 0xbc, 0x1d, 0x71, 0xb7, 0xf1, 0x3a, 0x07, 0xd0, 0x94, 0x75,
+	0xdc, 0x93, 0xf4, 0x32, 0xa1, 0xe9, 0x15, 0xa1, 0xbd, 0xb9, 0xd8, 0x8a, 0x08, 0xde, 0x39, 0x49,
+	0x8f, 0x5d, 0xde, 0x0a, 0x18, 0x50, 0x1e, 0xbc, 0x98, 0x87, 0x9e, 0x62, 0xe1, 0xda, 0x0c, 0x2c,
+	0xbf, 0x78, 0x62, 0xf9, 0x6f, 0x52, 0x67, 0xd8, 0x83, 0xf3, 0x85, 0x12, 0xbc, 0x1d, 0x19, 0x23,
+	0x5e, 0xb3, 0x2c, 0xce, 0x72, 0xfb, 0x74, 0xad, 0xc2, 0xa9, 0xb0, 0x96, 0x73, 0x45, 0xd2, 0x74,
+	0xc6, 0x5f, 0x65, 0xa8, 0x77, 0xba, 0x3b, 0xd9, 0xad, 0x81, 0x76, 0x0b, 0xaa, 0xae, 0x6d, 0x7f,
+	0x30, 0x43, 0xa5, 0xc2, 0x5b, 0x23, 0xd3, 0xfc, 0x6d, 0xa8, 0xf1, 0x57, 0x91, 0x5c, 0x25, 0xcd,
+	0x92, 0x00, 0x3e, 0x4b, 0xba, 0x42, 0x9b, 0x59, 0xe0, 0x30, 0x57, 0x2e, 0x13, 0xf4, 0x39, 0x40,
+	0x4e, 0x76, 0x52, 0x2c, 0x9b, 0x33, 0x64, 0x14, 0x1f, 0x6d, 0xc9, 0x3b, 0x49, 0xc8, 0xe2, 0x1e,
+	0x2c, 0xc5, 0xf8, 0xc8, 0x76, 0xb3, 0x2b, 0x4b, 0xdd, 0xa7, 0xb3, 0xc0, 0x2b, 0x5c, 0x75, 0x96,
+	0x1e, 0x17, 0x6e, 0xbe, 0x07, 0x80, 0xf8, 0x47, 0x27, 0xd9, 0xf8, 0x14, 0x83, 0x7d, 0x7e, 0xe6,
+	0xe4, 0x85, 0xb9, 0x6b, 0x35, 0x1c, 0xe6, 0x16, 0x27, 0xf1, 0x7e, 0x3a, 0xc9, 0xaa, 0x22, 0xe5,
+	0x27, 0xb3, 0xaa, 0xed, 0xe5, 0xd6, 0x50, 0x53, 0xd0, 0xf8, 0x16, 0x2e, 0xdc, 0xcf, 0xc7, 0xee,
+	0x63, 0xf7, 0x90, 0xb0, 0x4f, 0x31, 0xc3, 0xe8, 0x2e, 0xe8, 0x85, 0x77, 0xad, 0x92, 0x44, 0x7b,
+	0xb6, 0x33, 0xcc, 0x34, 0xb5, 0x3b, 0x67, 0xd5, 0x1d, 0xe6, 0x4e, 0x5f, 0x26, 0x0b, 0x50, 0x8d,
+	0x44, 0x15, 0xe3, 0x3b, 0x58, 0x9c, 0xf6, 0xea, 0x3f, 0x3c, 0x52, 0xef, 0x80, 0xee, 0x63, 0x46,
+	0x12, 0xa6, 0xe4, 0xa4, 0x9a, 0xed, 0xf4, 0xe2, 0xac, 0xcb, 0x34, 0x72, 0x65, 0x3c, 0x2d, 0xc3,
+	0xd6, 0xed, 0x11, 0x89, 0x27, 0x2f, 0xd3, 0x73, 0x27, 0x60, 0xd4, 0x97, 0xcf, 0x2f, 0x8b, 0x24,
+	0x51, 0x18, 0x24, 0x04, 0x3d, 0x80, 0x95, 0x5e, 0xea, 0x68, 0x2b, 0xa8, 0x5c, 0x88, 0x92, 0x96,
+	0x2b, 0x33, 0x80, 0x99, 0xb2, 0x8f, 0x7a, 0x2f, 0x0f, 0xab, 0xa2, 0xbc, 0x4b, 0x6f, 0x53, 0xde,
+	0xe5, 0xff, 0x53, 0xde, 0x95, 0xb7, 0x2f, 0xef, 0xf9, 0xb7, 0x24, 0xef, 0x8e, 0xf5, 0xfb, 0xb3,
+	0x96, 0xf6, 0xe8, 0x59, 0x4b, 0x7b, 0xfa, 0xac, 0xa5, 0x3d, 0x7c, 0xde, 0x9a, 0x7b, 0xf4, 0xbc,
+	0x35, 0xf7, 0xc7, 0xf3, 0xd6, 0xdc, 0xfd, 0x8f, 0xfb, 0x94, 0x0d, 0x46, 0x8e, 0xe9, 0x86, 0xc3,
+	0xb6, 0x2a, 0x23, 0xce, 0x34, 0x5d, 0xb4, 0x8f, 0x5f, 0xf3, 0x7f, 0xcf, 0xa9, 0x8a, 0xd7, 0xf6,
+	0xb5, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x94, 0x10, 0x30, 0x54, 0x18, 0x0e, 0x00, 0x00,
+}
+
+func (m *IndexedHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BabylonTxHash) > 0 {
+		i -= len(m.BabylonTxHash)
+		copy(dAtA[i:], m.BabylonTxHash)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.BabylonTxHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BabylonEpoch != 0 {
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(m.BabylonEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BabylonHeaderHeight != 0 {
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(m.BabylonHeaderHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BabylonHeaderHash) > 0 {
+		i -= len(m.BabylonHeaderHash)
+		copy(dAtA[i:], m.BabylonHeaderHash)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.BabylonHeaderHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Time != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Epoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Epoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Epoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SealerBlockHash) > 0 {
+		i -= len(m.SealerBlockHash)
+		copy(dAtA[i:], m.SealerBlockHash)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.SealerBlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SealerAppHash) > 0 {
+		i -= len(m.SealerAppHash)
+		copy(dAtA[i:], m.SealerAppHash)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.SealerAppHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastBlockTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastBlockTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FirstBlockHeight != 0 {
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(m.FirstBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentEpochInterval != 0 {
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(m.CurrentEpochInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RawCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RawCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlsMultiSig) > 0 {
+		i -= len(m.BlsMultiSig)
+		copy(dAtA[i:], m.BlsMultiSig)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.BlsMultiSig)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Bitmap) > 0 {
+		i -= len(m.Bitmap)
+		copy(dAtA[i:], m.Bitmap)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.Bitmap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorWithBlsKeySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorWithBlsKeySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWithBlsKeySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValSet) > 0 {
+		for iNdEx := len(m.ValSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorWithBlsKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorWithBlsKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWithBlsKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlsPubKey) > 0 {
+		i -= len(m.BlsPubKey)
+		copy(dAtA[i:], m.BlsPubKey)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.BlsPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hash != nil {
+		{
+			size := m.Hash.Size()
+			i -= size
+			if _, err := m.Hash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmissionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		for iNdEx := len(m.Key) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Key[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpentTransaction) > 0 {
+		i -= len(m.SpentTransaction)
+		copy(dAtA[i:], m.SpentTransaction)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.SpentTransaction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Transaction) > 0 {
+		i -= len(m.Transaction)
+		copy(dAtA[i:], m.Transaction)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.Transaction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Key != nil {
+		{
+			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCHeaderInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCHeaderInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCHeaderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Work) > 0 {
+		i -= len(m.Work)
+		copy(dAtA[i:], m.Work)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.Work)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Hash != nil {
+		{
+			size := m.Hash.Size()
+			i -= size
+			if _, err := m.Hash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size := m.Header.Size()
+			i -= size
+			if _, err := m.Header.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofEpochSealed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofEpochSealed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofEpochSealed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofEpochValSet != nil {
+		{
+			size, err := m.ProofEpochValSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProofEpochInfo != nil {
+		{
+			size, err := m.ProofEpochInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorSet) > 0 {
+		for iNdEx := len(m.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofFinalizedChainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofFinalizedChainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofFinalizedChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofEpochSubmitted) > 0 {
+		for iNdEx := len(m.ProofEpochSubmitted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProofEpochSubmitted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ProofEpochSealed != nil {
+		{
+			size, err := m.ProofEpochSealed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProofCzHeaderInEpoch != nil {
+		{
+			size, err := m.ProofCzHeaderInEpoch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCTimestamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCTimestamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BtcSubmissionKey != nil {
+		{
+			size, err := m.BtcSubmissionKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RawCheckpoint != nil {
+		{
+			size, err := m.RawCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EpochInfo != nil {
+		{
+			size, err := m.EpochInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BtcHeaders) > 0 {
+		for iNdEx := len(m.BtcHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZoneconciergePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZoneconciergePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneconciergePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZoneconciergePacketData_BtcTimestamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneconciergePacketData_BtcTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BtcTimestamp != nil {
+		{
+			size, err := m.BtcTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ChainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestHeader != nil {
+		{
+			size, err := m.LatestHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintBtcTimestamp(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizedChainInfoUntilHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizedChainInfoUntilHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizedChainInfoUntilHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BtcSubmissionKey != nil {
+		{
+			size, err := m.BtcSubmissionKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RawCheckpoint != nil {
+		{
+			size, err := m.RawCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochInfo != nil {
+		{
+			size, err := m.EpochInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FinalizedChainInfo != nil {
+		{
+			size, err := m.FinalizedChainInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcTimestamp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBtcTimestamp(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBtcTimestamp(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IndexedHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcTimestamp(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	l = len(m.BabylonHeaderHash)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.BabylonHeaderHeight != 0 {
+		n += 1 + sovBtcTimestamp(uint64(m.BabylonHeaderHeight))
+	}
+	if m.BabylonEpoch != 0 {
+		n += 1 + sovBtcTimestamp(uint64(m.BabylonEpoch))
+	}
+	l = len(m.BabylonTxHash)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	return n
+}
+
+func (m *Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovBtcTimestamp(uint64(m.EpochNumber))
+	}
+	if m.CurrentEpochInterval != 0 {
+		n += 1 + sovBtcTimestamp(uint64(m.CurrentEpochInterval))
+	}
+	if m.FirstBlockHeight != 0 {
+		n += 1 + sovBtcTimestamp(uint64(m.FirstBlockHeight))
+	}
+	if m.LastBlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastBlockTime)
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	l = len(m.SealerAppHash)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	l = len(m.SealerBlockHash)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	return n
+}
+
+func (m *RawCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovBtcTimestamp(uint64(m.EpochNum))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	l = len(m.Bitmap)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	l = len(m.BlsMultiSig)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorWithBlsKeySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValSet) > 0 {
+		for _, e := range m.ValSet {
+			l = e.Size()
+			n += 1 + l + sovBtcTimestamp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorWithBlsKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovBtcTimestamp(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *TransactionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBtcTimestamp(uint64(m.Index))
+	}
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmissionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		for _, e := range m.Key {
+			l = e.Size()
+			n += 1 + l + sovBtcTimestamp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransactionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	l = len(m.Transaction)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	l = len(m.SpentTransaction)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCHeaderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtcTimestamp(uint64(m.Height))
+	}
+	l = len(m.Work)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofEpochSealed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorSet) > 0 {
+		for _, e := range m.ValidatorSet {
+			l = e.Size()
+			n += 1 + l + sovBtcTimestamp(uint64(l))
+		}
+	}
+	if m.ProofEpochInfo != nil {
+		l = m.ProofEpochInfo.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.ProofEpochValSet != nil {
+		l = m.ProofEpochValSet.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofFinalizedChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProofCzHeaderInEpoch != nil {
+		l = m.ProofCzHeaderInEpoch.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.ProofEpochSealed != nil {
+		l = m.ProofEpochSealed.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if len(m.ProofEpochSubmitted) > 0 {
+		for _, e := range m.ProofEpochSubmitted {
+			l = e.Size()
+			n += 1 + l + sovBtcTimestamp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BTCTimestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if len(m.BtcHeaders) > 0 {
+		for _, e := range m.BtcHeaders {
+			l = e.Size()
+			n += 1 + l + sovBtcTimestamp(uint64(l))
+		}
+	}
+	if m.EpochInfo != nil {
+		l = m.EpochInfo.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.RawCheckpoint != nil {
+		l = m.RawCheckpoint.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.BtcSubmissionKey != nil {
+		l = m.BtcSubmissionKey.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	return n
+}
+
+func (m *ZoneconciergePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *ZoneconciergePacketData_BtcTimestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcTimestamp != nil {
+		l = m.BtcTimestamp.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	return n
+}
+func (m *ChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.LatestHeader != nil {
+		l = m.LatestHeader.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalizedChainInfoUntilHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalizedChainInfo != nil {
+		l = m.FinalizedChainInfo.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.EpochInfo != nil {
+		l = m.EpochInfo.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.RawCheckpoint != nil {
+		l = m.RawCheckpoint.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.BtcSubmissionKey != nil {
+		l = m.BtcSubmissionKey.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovBtcTimestamp(uint64(l))
+	}
+	return n
+}
+
+func sovBtcTimestamp(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBtcTimestamp(x uint64) (n int) {
+	return sovBtcTimestamp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IndexedHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BabylonHeaderHash = append(m.BabylonHeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BabylonHeaderHash == nil {
+				m.BabylonHeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeaderHeight", wireType)
+			}
+			m.BabylonHeaderHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonHeaderHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonEpoch", wireType)
+			}
+			m.BabylonEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonTxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BabylonTxHash = append(m.BabylonTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BabylonTxHash == nil {
+				m.BabylonTxHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Epoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Epoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Epoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochInterval", wireType)
+			}
+			m.CurrentEpochInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpochInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstBlockHeight", wireType)
+			}
+			m.FirstBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastBlockTime == nil {
+				m.LastBlockTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealerAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SealerAppHash = append(m.SealerAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SealerAppHash == nil {
+				m.SealerAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealerBlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SealerBlockHash = append(m.SealerBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SealerBlockHash == nil {
+				m.SealerBlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bitmap = append(m.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bitmap == nil {
+				m.Bitmap = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsMultiSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsMultiSig = append(m.BlsMultiSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsMultiSig == nil {
+				m.BlsMultiSig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorWithBlsKeySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorWithBlsKeySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorWithBlsKeySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValSet = append(m.ValSet, &ValidatorWithBlsKey{})
+			if err := m.ValSet[len(m.ValSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorWithBlsKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorWithBlsKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorWithBlsKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKey = append(m.BlsPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsPubKey == nil {
+				m.BlsPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCHeaderHashBytes
+			m.Hash = &v
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmissionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key, &TransactionKey{})
+			if err := m.Key[len(m.Key)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Key == nil {
+				m.Key = &TransactionKey{}
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transaction = append(m.Transaction[:0], dAtA[iNdEx:postIndex]...)
+			if m.Transaction == nil {
+				m.Transaction = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentTransaction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentTransaction = append(m.SpentTransaction[:0], dAtA[iNdEx:postIndex]...)
+			if m.SpentTransaction == nil {
+				m.SpentTransaction = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCHeaderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCHeaderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCHeaderBytes
+			m.Header = &v
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCHeaderHashBytes
+			m.Hash = &v
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Work", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Work = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofEpochSealed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofEpochSealed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofEpochSealed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSet = append(m.ValidatorSet, &ValidatorWithBlsKey{})
+			if err := m.ValidatorSet[len(m.ValidatorSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofEpochInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofEpochInfo == nil {
+				m.ProofEpochInfo = &crypto.ProofOps{}
+			}
+			if err := m.ProofEpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofEpochValSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofEpochValSet == nil {
+				m.ProofEpochValSet = &crypto.ProofOps{}
+			}
+			if err := m.ProofEpochValSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofFinalizedChainInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofFinalizedChainInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofFinalizedChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCzHeaderInEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofCzHeaderInEpoch == nil {
+				m.ProofCzHeaderInEpoch = &crypto.ProofOps{}
+			}
+			if err := m.ProofCzHeaderInEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofEpochSealed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofEpochSealed == nil {
+				m.ProofEpochSealed = &ProofEpochSealed{}
+			}
+			if err := m.ProofEpochSealed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofEpochSubmitted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofEpochSubmitted = append(m.ProofEpochSubmitted, &TransactionInfo{})
+			if err := m.ProofEpochSubmitted[len(m.ProofEpochSubmitted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCTimestamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCTimestamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &IndexedHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcHeaders = append(m.BtcHeaders, &BTCHeaderInfo{})
+			if err := m.BtcHeaders[len(m.BtcHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochInfo == nil {
+				m.EpochInfo = &Epoch{}
+			}
+			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawCheckpoint == nil {
+				m.RawCheckpoint = &RawCheckpoint{}
+			}
+			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcSubmissionKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcSubmissionKey == nil {
+				m.BtcSubmissionKey = &SubmissionKey{}
+			}
+			if err := m.BtcSubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &ProofFinalizedChainInfo{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZoneconciergePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZoneconciergePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZoneconciergePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BTCTimestamp{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &ZoneconciergePacketData_BtcTimestamp{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestHeader == nil {
+				m.LatestHeader = &IndexedHeader{}
+			}
+			if err := m.LatestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalizedChainInfoUntilHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizedChainInfoUntilHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedChainInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizedChainInfo == nil {
+				m.FinalizedChainInfo = &ChainInfo{}
+			}
+			if err := m.FinalizedChainInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochInfo == nil {
+				m.EpochInfo = &Epoch{}
+			}
+			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawCheckpoint == nil {
+				m.RawCheckpoint = &RawCheckpoint{}
+			}
+			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcSubmissionKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcSubmissionKey == nil {
+				m.BtcSubmissionKey = &SubmissionKey{}
+			}
+			if err := m.BtcSubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &ProofFinalizedChainInfo{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcTimestamp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcTimestamp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBtcTimestamp(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBtcTimestamp
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBtcTimestamp
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBtcTimestamp
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBtcTimestamp
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBtcTimestamp
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBtcTimestamp        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBtcTimestamp          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBtcTimestamp = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,85 @@
+package verifier
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cosmos/gogoproto/jsonpb"
+)
+
+// ReadBTCHeaders reads hex-encoded BTC headers, one per line. Empty lines
+// are ignored
+func ReadBTCHeaders(r io.Reader) ([]*wire.BlockHeader, error) {
+	headers := []*wire.BlockHeader{}
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		headerBytes, err := NewBTCHeaderBytesFromHex(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid BTC header at line %d: %w", lineNum, err)
+		}
+		header, err := headerBytes.ToBlockHeader()
+		if err != nil {
+			return nil, fmt.Errorf("invalid BTC header at line %d: %w", lineNum, err)
+		}
+		headers = append(headers, header)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return headers, nil
+}
+
+// BTCTimestampFromQueryJSON decodes a BTC timestamp from the JSON response of
+// the FinalizedChainInfoUntilHeight query. The response does not carry BTC
+// headers, so the BTC headers including the checkpoint have to be trusted.
+// Fields of the response that are not needed for verification are ignored
+func BTCTimestampFromQueryJSON(bz []byte) (*BTCTimestamp, error) {
+	var resp QueryFinalizedChainInfoUntilHeightResponse
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := unmarshaler.Unmarshal(bytes.NewReader(bz), &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode FinalizedChainInfoUntilHeight response: %w", err)
+	}
+	if resp.FinalizedChainInfo == nil {
+		return nil, fmt.Errorf("finalized chain info is nil")
+	}
+	return &BTCTimestamp{
+		Header:           resp.FinalizedChainInfo.LatestHeader,
+		EpochInfo:        resp.EpochInfo,
+		RawCheckpoint:    resp.RawCheckpoint,
+		BtcSubmissionKey: resp.BtcSubmissionKey,
+		Proof:            resp.Proof,
+	}, nil
+}
+
+// BTCTimestampFromPacket decodes a BTC timestamp from the data of an IBC
+// packet sent by the zoneconcierge module
+func BTCTimestampFromPacket(bz []byte) (*BTCTimestamp, error) {
+	var packetData ZoneconciergePacketData
+	if err := packetData.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode zoneconcierge packet: %w", err)
+	}
+	ts := packetData.GetBtcTimestamp()
+	if ts == nil {
+		return nil, fmt.Errorf("zoneconcierge packet does not carry a BTC timestamp")
+	}
+	return ts, nil
+}
+
+// BTCTimestampFromPacketHex decodes a BTC timestamp from the hex-encoded data
+// of an IBC packet, as in the packet_data_hex attribute of IBC events
+func BTCTimestampFromPacketHex(packetHex string) (*BTCTimestamp, error) {
+	bz, err := hex.DecodeString(strings.TrimSpace(packetHex))
+	if err != nil {
+		return nil, fmt.Errorf("packet data is not hex-encoded: %w", err)
+	}
+	return BTCTimestampFromPacket(bz)
+}
@@ -0,0 +1,12 @@
+package verifier
+
+import (
+	"errors"
+)
+
+// sentinel errors of BTC timestamp verification. They are not registered
+// under a codespace, as the verifier is not part of a module
+var (
+	ErrInvalidProofEpochSealed = errors.New("invalid ProofEpochSealed")
+	ErrInvalidMerkleProof      = errors.New("invalid Merkle inclusion proof")
+)
@@ -0,0 +1,363 @@
+package verifier
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	ics23 "github.com/cosmos/ics23/go"
+
+	"github.com/btcsuite/btcd/wire"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	"github.com/babylonchain/babylon/crypto/bls12381"
+)
+
+const (
+	// types of the proof ops generated by the stores of Babylon, as in
+	// cosmossdk.io/store/types
+	proofOpIAVLCommitment         = "ics23:iavl"
+	proofOpSimpleMerkleCommitment = "ics23:simple"
+)
+
+// CZHeaderKeyFunc returns the store key of the zoneconcierge module and the
+// key of the consumer chain header with the given chain ID and height in the
+// module store, under which the header is committed to the app hash of
+// Babylon. The store layout is owned by the zoneconcierge module, which
+// provides it as types.GetCZHeaderKeyPath
+type CZHeaderKeyFunc func(chainID string, height uint64) (storeKey string, key []byte)
+
+// commitmentOp is an ICS-23 proof op of a store of Babylon. It mirrors
+// CommitmentOp of cosmossdk.io/store/types
+type commitmentOp struct {
+	spec  *ics23.ProofSpec
+	key   []byte
+	proof *ics23.CommitmentProof
+}
+
+var _ merkle.ProofOperator = commitmentOp{}
+
+func commitmentOpDecoder(pop cmtcrypto.ProofOp) (merkle.ProofOperator, error) {
+	var spec *ics23.ProofSpec
+	switch pop.Type {
+	case proofOpIAVLCommitment:
+		spec = ics23.IavlSpec
+	case proofOpSimpleMerkleCommitment:
+		spec = ics23.TendermintSpec
+	default:
+		return nil, fmt.Errorf("unexpected ProofOp.Type %s", pop.Type)
+	}
+
+	proof := &ics23.CommitmentProof{}
+	if err := proof.Unmarshal(pop.Data); err != nil {
+		return nil, err
+	}
+	return commitmentOp{spec: spec, key: pop.Key, proof: proof}, nil
+}
+
+func (op commitmentOp) GetKey() []byte {
+	return op.key
+}
+
+// Run verifies the existence of the key with the value in args[0], or the
+// absence of the key if args is empty, and returns the root of the proof
+func (op commitmentOp) Run(args [][]byte) ([][]byte, error) {
+	root, err := op.proof.Calculate()
+	if err != nil {
+		return nil, fmt.Errorf("could not calculate root for proof: %w", err)
+	}
+	switch len(args) {
+	case 0:
+		if !ics23.VerifyNonMembership(op.spec, root, op.proof, op.key) {
+			return nil, fmt.Errorf("proof did not verify absence of key: %s", string(op.key))
+		}
+	case 1:
+		if !ics23.VerifyMembership(op.spec, root, op.proof, op.key, args[0]) {
+			return nil, fmt.Errorf("proof did not verify existence of key %s with given value %x", op.key, args[0])
+		}
+	default:
+		return nil, fmt.Errorf("args must be length 0 or 1, got: %d", len(args))
+	}
+	return [][]byte{root}, nil
+}
+
+func (op commitmentOp) ProofOp() cmtcrypto.ProofOp {
+	panic("commitmentOp is only used for verification")
+}
+
+// VerifyStore verifies whether a KV pair is committed to the Merkle root, with the assistance of a Merkle proof
+// (adapted from https://github.com/cosmos/cosmos-sdk/blob/v0.46.6/store/rootmulti/proof_test.go)
+func VerifyStore(root []byte, moduleStoreKey string, key []byte, value []byte, proof *cmtcrypto.ProofOps) error {
+	prt := merkle.NewProofRuntime()
+	prt.RegisterOpDecoder(proofOpIAVLCommitment, commitmentOpDecoder)
+	prt.RegisterOpDecoder(proofOpSimpleMerkleCommitment, commitmentOpDecoder)
+
+	keypath := merkle.KeyPath{}
+	keypath = keypath.AppendKey([]byte(moduleStoreKey), merkle.KeyEncodingURL)
+	keypath = keypath.AppendKey(key, merkle.KeyEncodingURL)
+	keypathStr := keypath.String()
+
+	if err1 := prt.VerifyValue(proof, root, keypathStr, value); err1 != nil {
+		if err2 := prt.VerifyAbsence(proof, root, keypathStr); err2 != nil {
+			return fmt.Errorf("the Merkle proof does not pass any verification: err of VerifyValue: %w; err of VerifyAbsence: %w", err1, err2)
+		}
+	}
+
+	return nil
+}
+
+func VerifyEpochInfo(epoch *Epoch, proof *cmtcrypto.ProofOps) error {
+	// get the Merkle root, i.e., the BlockHash of the sealer header
+	root := epoch.SealerAppHash
+
+	// Ensure The epoch medatata is committed to the app_hash of the sealer header
+	// NOTE: the proof is generated when sealer header is generated. At that time
+	// sealer header hash is not given to epoch metadata. Thus we need to clear the
+	// sealer header hash when verifying the proof.
+	epoch.SealerAppHash = []byte{}
+	epochBytes, err := epoch.Marshal()
+	if err != nil {
+		return err
+	}
+	epoch.SealerAppHash = root
+	if err := VerifyStore(root, epochingStoreKey, GetEpochInfoKey(epoch.EpochNumber), epochBytes, proof); err != nil {
+		return fmt.Errorf("%w: invalid inclusion proof for epoch metadata: %v", ErrInvalidMerkleProof, err)
+	}
+
+	return nil
+}
+
+func VerifyValSet(epoch *Epoch, valSet *ValidatorWithBlsKeySet, proof *cmtcrypto.ProofOps) error {
+	valSetBytes, err := valSet.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := VerifyStore(epoch.SealerAppHash, checkpointingStoreKey, GetValSetKey(epoch.EpochNumber), valSetBytes, proof); err != nil {
+		return fmt.Errorf("%w: invalid inclusion proof for validator set: %v", ErrInvalidMerkleProof, err)
+	}
+
+	return nil
+}
+
+// VerifyEpochSealed verifies that the given `epoch` is sealed by the `rawCkpt` by using the given `proof`
+// The verification rules include:
+// - basic sanity checks
+// - The raw checkpoint's BlockHash is same as the sealer_block_hash of the sealed epoch
+// - More than 2/3 (in voting power) validators in the validator set of this epoch have signed sealer_block_hash of the sealed epoch
+// - The epoch medatata is committed to the sealer_app_hash of the sealed epoch
+// - The validator set is committed to the sealer_app_hash of the sealed epoch
+func VerifyEpochSealed(epoch *Epoch, rawCkpt *RawCheckpoint, proof *ProofEpochSealed) error {
+	// nil check
+	if epoch == nil {
+		return fmt.Errorf("epoch is nil")
+	} else if rawCkpt == nil {
+		return fmt.Errorf("rawCkpt is nil")
+	} else if proof == nil {
+		return fmt.Errorf("proof is nil")
+	}
+
+	// sanity check
+	if err := epoch.ValidateBasic(); err != nil {
+		return err
+	} else if err := rawCkpt.ValidateBasic(); err != nil {
+		return err
+	} else if err = proof.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// ensure epoch number is same in epoch and rawCkpt
+	if epoch.EpochNumber != rawCkpt.EpochNum {
+		return fmt.Errorf("epoch.EpochNumber (%d) is not equal to rawCkpt.EpochNum (%d)", epoch.EpochNumber, rawCkpt.EpochNum)
+	}
+
+	// ensure the raw checkpoint's block_hash is same as the sealer_block_hash of the sealed epoch
+	// NOTE: since this proof is assembled by a Babylon node who has verified the checkpoint,
+	// the two blockhash values should always be the same, otherwise this Babylon node is malicious.
+	if !bytes.Equal(rawCkpt.BlockHash, epoch.SealerBlockHash) {
+		return fmt.Errorf("BlockHash is not same in rawCkpt (%x) and epoch's SealerHeader (%x)", rawCkpt.BlockHash, epoch.SealerBlockHash)
+	}
+
+	/*
+		Ensure more than 2/3 (in voting power) validators of this epoch have signed (epoch_num || block_hash) in the raw checkpoint
+	*/
+	valSet := &ValidatorWithBlsKeySet{ValSet: proof.ValidatorSet}
+	// filter validator set that contributes to the signature
+	signerSet, signerSetPower, err := valSet.FindSubsetWithPowerSum(rawCkpt.Bitmap)
+	if err != nil {
+		return err
+	}
+	// ensure the signerSet has > 2/3 voting power
+	if signerSetPower*3 <= valSet.GetTotalPower()*2 {
+		return fmt.Errorf("insufficient voting power")
+	}
+	// verify BLS multisig
+	ok, err := bls12381.VerifyMultiSig(rawCkpt.BlsMultiSig, signerSet.GetBLSKeySet(), rawCkpt.SignedMsg())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("BLS signature does not match the public key")
+	}
+
+	// Ensure The epoch medatata is committed to the app_hash of the sealer header
+	if err := VerifyEpochInfo(epoch, proof.ProofEpochInfo); err != nil {
+		return err
+	}
+
+	// Ensure The validator set is committed to the app_hash of the sealer header
+	if err := VerifyValSet(epoch, valSet, proof.ProofEpochValSet); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// VerifyCZHeaderInEpoch verifies that the consumer chain header is committed
+// to the app hash of the sealer header of the given epoch, under the key given
+// by headerKey
+func VerifyCZHeaderInEpoch(header *IndexedHeader, epoch *Epoch, proof *cmtcrypto.ProofOps, headerKey CZHeaderKeyFunc) error {
+	// nil check
+	if header == nil {
+		return fmt.Errorf("header is nil")
+	} else if epoch == nil {
+		return fmt.Errorf("epoch is nil")
+	} else if proof == nil {
+		return fmt.Errorf("proof is nil")
+	} else if headerKey == nil {
+		return fmt.Errorf("key of the header is unknown")
+	}
+
+	// sanity check
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	} else if err := epoch.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// ensure epoch number is same in epoch and CZ header
+	if epoch.EpochNumber != header.BabylonEpoch {
+		return fmt.Errorf("epoch.EpochNumber (%d) is not equal to header.BabylonEpoch (%d)", epoch.EpochNumber, header.BabylonEpoch)
+	}
+
+	// get the Merkle root, i.e., the BlockHash of the sealer header
+	root := epoch.SealerAppHash
+
+	// Ensure The header is committed to the BlockHash of the sealer header
+	headerBytes, err := header.Marshal()
+	if err != nil {
+		return err
+	}
+
+	storeKey, key := headerKey(header.ChainId, header.Height)
+	if err := VerifyStore(root, storeKey, key, headerBytes, proof); err != nil {
+		return fmt.Errorf("%w: invalid inclusion proof for CZ header: %v", ErrInvalidMerkleProof, err)
+	}
+
+	return nil
+}
+
+// VerifyEpochSubmitted verifies whether an epoch's checkpoint has been included in BTC or not
+// verifications include:
+// - basic sanity checks
+// - Merkle proofs in txsInfo are valid
+// - the raw ckpt decoded from txsInfo is same as the expected rawCkpt
+// The checkpoint is either split into two txs, or carried by a single tx
+func VerifyEpochSubmitted(rawCkpt *RawCheckpoint, txsInfo []*TransactionInfo, btcHeaders []*wire.BlockHeader, powLimit *big.Int, babylonTag txformat.BabylonTag) error {
+	// basic sanity check
+	if rawCkpt == nil {
+		return fmt.Errorf("rawCkpt is nil")
+	} else if len(txsInfo) != 1 && len(txsInfo) != txformat.NumberOfParts {
+		return fmt.Errorf("txsInfo contains %d parts rather than 1 or %d", len(txsInfo), txformat.NumberOfParts)
+	} else if len(btcHeaders) != len(txsInfo) {
+		return fmt.Errorf("btcHeaders contains %d parts rather than %d", len(btcHeaders), len(txsInfo))
+	}
+
+	// sanity check of each tx info
+	for _, txInfo := range txsInfo {
+		if err := txInfo.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	var (
+		rawCkptData []byte
+		version     txformat.FormatVersion
+		err         error
+	)
+	if len(txsInfo) == 1 {
+		rawCkptData, err = getSingleTxCheckpointData(txsInfo[0], btcHeaders[0], powLimit, babylonTag)
+		version = txformat.SingleTxVersion
+	} else {
+		rawCkptData, err = getTwoTxsCheckpointData(txsInfo, btcHeaders, powLimit, babylonTag)
+		version = txformat.CurrentVersion
+	}
+	if err != nil {
+		return err
+	}
+
+	btcCkpt, err := txformat.DecodeRawCheckpoint(version, rawCkptData)
+	if err != nil {
+		return err
+	}
+	decodedRawCkpt := &RawCheckpoint{
+		EpochNum:    btcCkpt.Epoch,
+		BlockHash:   btcCkpt.BlockHash,
+		Bitmap:      btcCkpt.BitMap,
+		BlsMultiSig: btcCkpt.BlsSig,
+	}
+
+	// check if decodedRawCkpt is same as the expected rawCkpt
+	if !decodedRawCkpt.Equal(rawCkpt) {
+		return fmt.Errorf("the decoded rawCkpt (%v) is different from the expected rawCkpt (%v)", decodedRawCkpt, rawCkpt)
+	}
+
+	return nil
+}
+
+// getTwoTxsCheckpointData verifies Merkle proofs of the two txs carrying the
+// checkpoint and returns the raw checkpoint bytes connected from them
+func getTwoTxsCheckpointData(txsInfo []*TransactionInfo, btcHeaders []*wire.BlockHeader, powLimit *big.Int, babylonTag txformat.BabylonTag) ([]byte, error) {
+	checkpointData := [][]byte{}
+	for i, txInfo := range txsInfo {
+		tx, err := parseIncludedTransaction(txInfo, btcHeaders[i], powLimit)
+		if err != nil {
+			return nil, err
+		}
+		opReturnData := extractOpReturnData(tx)
+		if len(opReturnData) == 0 {
+			return nil, fmt.Errorf("provided transaction should provide op return data")
+		}
+
+		data, err := txformat.GetCheckpointData(
+			babylonTag,
+			txformat.CurrentVersion,
+			uint8(i),
+			opReturnData,
+		)
+		if err != nil {
+			return nil, err
+		}
+		checkpointData = append(checkpointData, data)
+	}
+	return txformat.ConnectParts(txformat.CurrentVersion, checkpointData[0], checkpointData[1])
+}
+
+// getSingleTxCheckpointData verifies Merkle proof of the single tx carrying
+// the checkpoint and returns the raw checkpoint bytes revealed by it
+func getSingleTxCheckpointData(txInfo *TransactionInfo, btcHeader *wire.BlockHeader, powLimit *big.Int, babylonTag txformat.BabylonTag) ([]byte, error) {
+	tx, err := parseIncludedTransaction(txInfo, btcHeader, powLimit)
+	if err != nil {
+		return nil, err
+	}
+	spentTx, err := parseTransaction(txInfo.SpentTransaction)
+	if err != nil {
+		return nil, fmt.Errorf("invalid spent transaction: %w", err)
+	}
+	envelopeData, err := extractEnvelopeData(tx, spentTx)
+	if err != nil {
+		return nil, err
+	}
+	return txformat.GetSingleTxCheckpointData(babylonTag, envelopeData)
+}
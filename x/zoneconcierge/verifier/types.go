@@ -0,0 +1,292 @@
+package verifier
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/boljen/go-bitmap"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+)
+
+const (
+	// store keys and key prefixes under which the epoch metadata and the
+	// validator set are committed to the app hash of Babylon. They are the
+	// same as in the epoching and checkpointing modules
+	epochingStoreKey      = "epoching"
+	checkpointingStoreKey = "checkpointing"
+
+	btcHeaderLen     = 80
+	btcHeaderHashLen = chainhash.HashSize
+	blockHashLen     = 32
+)
+
+var (
+	epochInfoKeyPrefix = []byte{0x11}
+	valSetKeyPrefix    = []byte{0x3}
+)
+
+// BTCHeaderBytes is a serialized BTC header, which is encoded in hex in JSON
+type BTCHeaderBytes []byte
+
+// NewBTCHeaderBytesFromHex decodes a hex-encoded BTC header
+func NewBTCHeaderBytesFromHex(headerHex string) (BTCHeaderBytes, error) {
+	bz, err := hex.DecodeString(headerHex)
+	if err != nil {
+		return nil, err
+	}
+	var header BTCHeaderBytes
+	if err := header.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func (m BTCHeaderBytes) Marshal() ([]byte, error) {
+	return m, nil
+}
+
+func (m BTCHeaderBytes) MarshalTo(data []byte) (int, error) {
+	return copy(data, m), nil
+}
+
+func (m *BTCHeaderBytes) Unmarshal(data []byte) error {
+	if len(data) != btcHeaderLen {
+		return fmt.Errorf("invalid BTC header length %d", len(data))
+	}
+	if _, err := toBlockHeader(data); err != nil {
+		return err
+	}
+	*m = data
+	return nil
+}
+
+func (m BTCHeaderBytes) Size() int {
+	return len(m)
+}
+
+func (m BTCHeaderBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(m))
+}
+
+func (m *BTCHeaderBytes) UnmarshalJSON(bz []byte) error {
+	var headerHex string
+	if err := json.Unmarshal(bz, &headerHex); err != nil {
+		return err
+	}
+	header, err := NewBTCHeaderBytesFromHex(headerHex)
+	if err != nil {
+		return err
+	}
+	*m = header
+	return nil
+}
+
+// ToBlockHeader decodes the BTC header. The header bytes are validated upon
+// unmarshalling, so decoding only fails for headers built in code
+func (m BTCHeaderBytes) ToBlockHeader() (*wire.BlockHeader, error) {
+	return toBlockHeader(m)
+}
+
+func toBlockHeader(data []byte) (*wire.BlockHeader, error) {
+	header := &wire.BlockHeader{}
+	if err := header.Deserialize(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+// BTCHeaderHashBytes is the hash of a BTC header, which is encoded in JSON as
+// a hex string in the byte order of BTC block explorers
+type BTCHeaderHashBytes []byte
+
+// NewBTCHeaderHashBytesFromChainhash returns the hash bytes of the given hash
+func NewBTCHeaderHashBytesFromChainhash(hash *chainhash.Hash) BTCHeaderHashBytes {
+	return BTCHeaderHashBytes(hash.CloneBytes())
+}
+
+func (m BTCHeaderHashBytes) Marshal() ([]byte, error) {
+	return m, nil
+}
+
+func (m BTCHeaderHashBytes) MarshalTo(data []byte) (int, error) {
+	return copy(data, m), nil
+}
+
+func (m *BTCHeaderHashBytes) Unmarshal(data []byte) error {
+	if len(data) != btcHeaderHashLen {
+		return fmt.Errorf("invalid BTC header hash length %d", len(data))
+	}
+	*m = data
+	return nil
+}
+
+func (m BTCHeaderHashBytes) Size() int {
+	return len(m)
+}
+
+func (m BTCHeaderHashBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+func (m *BTCHeaderHashBytes) UnmarshalJSON(bz []byte) error {
+	var hashHex string
+	if err := json.Unmarshal(bz, &hashHex); err != nil {
+		return err
+	}
+	hash, err := chainhash.NewHashFromStr(hashHex)
+	if err != nil {
+		return err
+	}
+	return m.Unmarshal(hash.CloneBytes())
+}
+
+// ToChainhash returns the hash as a chainhash.Hash. The hash bytes are
+// validated upon unmarshalling, so it only fails for hashes built in code
+func (m BTCHeaderHashBytes) ToChainhash() (*chainhash.Hash, error) {
+	return chainhash.NewHash(m)
+}
+
+func (m BTCHeaderHashBytes) String() string {
+	hash, err := m.ToChainhash()
+	if err != nil {
+		return hex.EncodeToString(m)
+	}
+	return hash.String()
+}
+
+func GetEpochInfoKey(epochNumber uint64) []byte {
+	return append(append([]byte{}, epochInfoKeyPrefix...), uint64ToBigEndian(epochNumber)...)
+}
+
+func GetValSetKey(epochNumber uint64) []byte {
+	return append(append([]byte{}, valSetKeyPrefix...), uint64ToBigEndian(epochNumber)...)
+}
+
+func uint64ToBigEndian(i uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, i)
+	return b
+}
+
+func (e *Epoch) ValidateBasic() error {
+	if e.CurrentEpochInterval < 2 {
+		return fmt.Errorf("invalid epoch: CurrentEpochInterval (%d) < 2", e.CurrentEpochInterval)
+	}
+	return nil
+}
+
+// ValidateBasic does sanity checks on a raw checkpoint
+func (ckpt *RawCheckpoint) ValidateBasic() error {
+	if ckpt.Bitmap == nil {
+		return fmt.Errorf("invalid raw checkpoint: bitmap cannot be empty")
+	}
+	if len(ckpt.BlockHash) != blockHashLen {
+		return fmt.Errorf("invalid raw checkpoint: invalid block hash")
+	}
+	if len(ckpt.BlsMultiSig) != bls12381.SignatureSize {
+		return fmt.Errorf("invalid raw checkpoint: invalid BLS multi sig")
+	}
+	return nil
+}
+
+// SignedMsg is the message signed by the BLS multi sig of the checkpoint,
+// i.e., epoch_num || block_hash
+func (ckpt *RawCheckpoint) SignedMsg() []byte {
+	return append(uint64ToBigEndian(ckpt.EpochNum), ckpt.BlockHash...)
+}
+
+func (ckpt *RawCheckpoint) Equal(ckpt2 *RawCheckpoint) bool {
+	return ckpt.EpochNum == ckpt2.EpochNum &&
+		bytes.Equal(ckpt.BlockHash, ckpt2.BlockHash) &&
+		bytes.Equal(ckpt.Bitmap, ckpt2.Bitmap) &&
+		bytes.Equal(ckpt.BlsMultiSig, ckpt2.BlsMultiSig)
+}
+
+// FindSubsetWithPowerSum returns the validators selected by the bitmap and
+// their total voting power
+func (ks *ValidatorWithBlsKeySet) FindSubsetWithPowerSum(bm bitmap.Bitmap) (*ValidatorWithBlsKeySet, uint64, error) {
+	var sum uint64
+	valSet := &ValidatorWithBlsKeySet{
+		ValSet: make([]*ValidatorWithBlsKey, 0),
+	}
+
+	// ensure bitmap is big enough to contain ks
+	if bm.Len() < len(ks.ValSet) {
+		return valSet, sum, fmt.Errorf("bitmap (with %d bits) is not large enough to contain the validator set with size %d", bm.Len(), len(ks.ValSet))
+	}
+
+	for i := 0; i < len(ks.ValSet); i++ {
+		if bm.Get(i) {
+			valSet.ValSet = append(valSet.ValSet, ks.ValSet[i])
+			sum += ks.ValSet[i].VotingPower
+		}
+	}
+	return valSet, sum, nil
+}
+
+func (ks *ValidatorWithBlsKeySet) GetBLSKeySet() []bls12381.PublicKey {
+	var blsKeySet []bls12381.PublicKey
+	for _, val := range ks.ValSet {
+		blsKeySet = append(blsKeySet, val.BlsPubKey)
+	}
+	return blsKeySet
+}
+
+func (ks *ValidatorWithBlsKeySet) GetTotalPower() uint64 {
+	var total uint64
+	for _, val := range ks.ValSet {
+		total += val.VotingPower
+	}
+	return total
+}
+
+func (ti *TransactionInfo) ValidateBasic() error {
+	if ti.Key == nil {
+		return fmt.Errorf("key in TransactionInfo is nil")
+	}
+	if ti.Key.Hash == nil {
+		return fmt.Errorf("block hash in TransactionInfo is nil")
+	}
+	if ti.Transaction == nil {
+		return fmt.Errorf("transaction in TransactionInfo is nil")
+	}
+	if ti.Proof == nil {
+		return fmt.Errorf("proof in TransactionInfo is nil")
+	}
+	return nil
+}
+
+func (p *ProofEpochSealed) ValidateBasic() error {
+	if p.ValidatorSet == nil {
+		return fmt.Errorf("%w: ValidatorSet is nil", ErrInvalidProofEpochSealed)
+	} else if len(p.ValidatorSet) == 0 {
+		return fmt.Errorf("%w: ValidatorSet is empty", ErrInvalidProofEpochSealed)
+	} else if p.ProofEpochInfo == nil {
+		return fmt.Errorf("%w: ProofEpochInfo is nil", ErrInvalidProofEpochSealed)
+	} else if p.ProofEpochValSet == nil {
+		return fmt.Errorf("%w: ProofEpochValSet is nil", ErrInvalidProofEpochSealed)
+	}
+	return nil
+}
+
+func (ih *IndexedHeader) ValidateBasic() error {
+	if len(ih.ChainId) == 0 {
+		return fmt.Errorf("empty ChainID")
+	}
+	if len(ih.Hash) == 0 {
+		return fmt.Errorf("empty Hash")
+	}
+	if len(ih.BabylonHeaderHash) == 0 {
+		return fmt.Errorf("empty BabylonHeader hash")
+	}
+	if len(ih.BabylonTxHash) == 0 {
+		return fmt.Errorf("empty BabylonTxHash")
+	}
+	return nil
+}
@@ -0,0 +1,306 @@
+// Package verifier verifies BTC timestamps of consumer chains offline, i.e.,
+// without a Babylon node or a BTC light client. A BTC timestamp is verified
+// against a set of BTC headers trusted by the caller, and the verdict of each
+// verification step is reported individually.
+package verifier
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// StepEpochCheckpoint checks that the BTC timestamp is well-formed and
+	// its raw checkpoint corresponds to its epoch
+	StepEpochCheckpoint = "epoch-checkpoint"
+	// StepBTCHeaders checks that the BTC headers in the BTC timestamp extend
+	// the trusted BTC headers
+	StepBTCHeaders = "btc-headers"
+	// StepCheckpointDepth checks that the BTC blocks including the checkpoint
+	// are on the BTC chain and at least one of them is w-deep
+	StepCheckpointDepth = "checkpoint-depth"
+	// StepEpochSubmitted checks that the checkpoint is included in the BTC
+	// blocks
+	StepEpochSubmitted = "epoch-submitted"
+	// StepEpochSealed checks that the epoch is sealed by its validator set
+	StepEpochSealed = "epoch-sealed"
+	// StepHeaderInEpoch checks that the consumer chain header is committed
+	// to the epoch
+	StepHeaderInEpoch = "header-in-epoch"
+)
+
+// Verdict is the outcome of a verification step
+type Verdict int
+
+const (
+	Pass Verdict = iota
+	Fail
+	Skip
+)
+
+func (v Verdict) String() string {
+	switch v {
+	case Pass:
+		return "PASS"
+	case Fail:
+		return "FAIL"
+	case Skip:
+		return "SKIP"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// StepResult is the verdict of a verification step. Reason explains why the
+// step failed or was skipped
+type StepResult struct {
+	Name    string
+	Verdict Verdict
+	Reason  string
+}
+
+func (r StepResult) String() string {
+	if r.Reason == "" {
+		return fmt.Sprintf("%s: %s", r.Name, r.Verdict)
+	}
+	return fmt.Sprintf("%s: %s (%s)", r.Name, r.Verdict, r.Reason)
+}
+
+// Report is the result of verifying a BTC timestamp
+type Report struct {
+	Steps []StepResult
+}
+
+// Valid returns whether none of the verification steps failed or was skipped
+// due to an earlier failure. The step verifying the consumer chain header is
+// skipped when the BTC timestamp carries no header, which does not
+// invalidate the BTC timestamp
+func (r *Report) Valid() bool {
+	for _, step := range r.Steps {
+		if step.Verdict == Fail {
+			return false
+		}
+		if step.Verdict == Skip && step.Name != StepHeaderInEpoch {
+			return false
+		}
+	}
+	return true
+}
+
+// Step returns the result of the verification step with the given name
+func (r *Report) Step(name string) (StepResult, bool) {
+	for _, step := range r.Steps {
+		if step.Name == name {
+			return step, true
+		}
+	}
+	return StepResult{}, false
+}
+
+func (r *Report) String() string {
+	lines := make([]string, 0, len(r.Steps))
+	for _, step := range r.Steps {
+		lines = append(lines, step.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r *Report) add(name string, err error) {
+	if err != nil {
+		r.Steps = append(r.Steps, StepResult{Name: name, Verdict: Fail, Reason: err.Error()})
+		return
+	}
+	r.Steps = append(r.Steps, StepResult{Name: name, Verdict: Pass})
+}
+
+func (r *Report) skip(name string, reason string) {
+	r.Steps = append(r.Steps, StepResult{Name: name, Verdict: Skip, Reason: reason})
+}
+
+// Config is the configuration of the verifier, which shall be consistent
+// with the Babylon chain that produced the BTC timestamp
+type Config struct {
+	// PowLimit is the highest proof-of-work target of the BTC network
+	PowLimit *big.Int
+	// CheckpointTag is the tag of Babylon checkpoints on BTC
+	CheckpointTag txformat.BabylonTag
+	// W is the BTC confirmation depth for a checkpoint to be finalised
+	W uint64
+	// CZHeaderKey gives the key under which the consumer chain header is
+	// committed to the app hash of Babylon
+	CZHeaderKey CZHeaderKeyFunc
+}
+
+// Verify verifies the given BTC timestamp against the given trusted BTC
+// headers, which shall be ordered by height and connected to each other.
+// The BTC headers in the BTC timestamp that are not trusted have to extend
+// the trusted ones, and are checked against their own proof-of-work target.
+// Difficulty adjustments are not checked, so the trusted BTC headers shall
+// be as close to the BTC tip as possible
+func Verify(ts *BTCTimestamp, trustedHeaders []*wire.BlockHeader, cfg Config) *Report {
+	report := &Report{}
+
+	if err := verifyEpochCheckpoint(ts); err != nil {
+		report.add(StepEpochCheckpoint, err)
+		for _, name := range []string{StepBTCHeaders, StepCheckpointDepth, StepEpochSubmitted, StepEpochSealed, StepHeaderInEpoch} {
+			report.skip(name, "malformed BTC timestamp")
+		}
+		return report
+	}
+	report.add(StepEpochCheckpoint, nil)
+
+	// verify the BTC headers and find the BTC headers including the checkpoint
+	chain, err := extendTrustedHeaders(trustedHeaders, ts.BtcHeaders, cfg.PowLimit)
+	report.add(StepBTCHeaders, err)
+	if err != nil {
+		report.skip(StepCheckpointDepth, "invalid BTC headers")
+		report.skip(StepEpochSubmitted, "invalid BTC headers")
+	} else {
+		btcHeadersWithCkpt, err := chain.checkpointHeaders(ts.BtcSubmissionKey, cfg.W)
+		report.add(StepCheckpointDepth, err)
+		if btcHeadersWithCkpt == nil {
+			report.skip(StepEpochSubmitted, "BTC headers including the checkpoint are unknown")
+		} else {
+			err = VerifyEpochSubmitted(ts.RawCheckpoint, ts.Proof.ProofEpochSubmitted, btcHeadersWithCkpt, cfg.PowLimit, cfg.CheckpointTag)
+			report.add(StepEpochSubmitted, err)
+		}
+	}
+
+	report.add(StepEpochSealed, VerifyEpochSealed(ts.EpochInfo, ts.RawCheckpoint, ts.Proof.ProofEpochSealed))
+
+	if ts.Header == nil {
+		report.skip(StepHeaderInEpoch, "no consumer chain header in the BTC timestamp")
+	} else {
+		report.add(StepHeaderInEpoch, VerifyCZHeaderInEpoch(ts.Header, ts.EpochInfo, ts.Proof.ProofCzHeaderInEpoch, cfg.CZHeaderKey))
+	}
+
+	return report
+}
+
+// verifyEpochCheckpoint ensures the BTC timestamp carries all fields needed
+// for verification, and the raw checkpoint corresponds to the epoch
+func verifyEpochCheckpoint(ts *BTCTimestamp) error {
+	switch {
+	case ts == nil:
+		return fmt.Errorf("BTC timestamp is nil")
+	case ts.EpochInfo == nil:
+		return fmt.Errorf("epoch info is nil")
+	case ts.RawCheckpoint == nil:
+		return fmt.Errorf("raw checkpoint is nil")
+	case ts.BtcSubmissionKey == nil:
+		return fmt.Errorf("BTC submission key is nil")
+	case ts.Proof == nil:
+		return fmt.Errorf("proof is nil")
+	}
+
+	if ts.EpochInfo.EpochNumber != ts.RawCheckpoint.EpochNum {
+		return fmt.Errorf("epoch number in epoch metadata (%d) and raw checkpoint (%d) is not same", ts.EpochInfo.EpochNumber, ts.RawCheckpoint.EpochNum)
+	}
+	numTxs := len(ts.BtcSubmissionKey.Key)
+	if numTxs != 1 && numTxs != txformat.NumberOfParts {
+		return fmt.Errorf("incorrect number of txs for a checkpoint: %d", numTxs)
+	}
+	if len(ts.Proof.ProofEpochSubmitted) != numTxs {
+		return fmt.Errorf("BTC submission key has %d txs while the proof has %d", numTxs, len(ts.Proof.ProofEpochSubmitted))
+	}
+	for i, key := range ts.BtcSubmissionKey.Key {
+		if key == nil || key.Hash == nil {
+			return fmt.Errorf("tx key %d in BTC submission key is nil", i)
+		}
+		txInfo := ts.Proof.ProofEpochSubmitted[i]
+		if txInfo == nil || txInfo.Key == nil || txInfo.Key.Index != key.Index || txInfo.Key.Hash == nil || !bytes.Equal(*txInfo.Key.Hash, *key.Hash) {
+			return fmt.Errorf("tx key %d in the proof does not match the BTC submission key", i)
+		}
+	}
+	return nil
+}
+
+// btcChain is a BTC chain segment ordered by height
+type btcChain struct {
+	headers []*wire.BlockHeader
+	indexes map[chainhash.Hash]int
+}
+
+func (c *btcChain) tip() *wire.BlockHeader {
+	return c.headers[len(c.headers)-1]
+}
+
+func (c *btcChain) append(header *wire.BlockHeader) {
+	c.indexes[header.BlockHash()] = len(c.headers)
+	c.headers = append(c.headers, header)
+}
+
+// extendTrustedHeaders connects the trusted BTC headers, and extends them
+// with the BTC headers that are not trusted
+func extendTrustedHeaders(trustedHeaders []*wire.BlockHeader, headers []*BTCHeaderInfo, powLimit *big.Int) (*btcChain, error) {
+	if len(trustedHeaders) == 0 {
+		return nil, fmt.Errorf("no trusted BTC headers")
+	}
+	chain := &btcChain{indexes: map[chainhash.Hash]int{}}
+	for i, header := range trustedHeaders {
+		if i > 0 && !header.PrevBlock.IsEqual(toHashPtr(chain.tip().BlockHash())) {
+			return nil, fmt.Errorf("trusted BTC header %d does not extend its previous header", i)
+		}
+		chain.append(header)
+	}
+
+	for i, headerInfo := range headers {
+		if headerInfo == nil || headerInfo.Header == nil {
+			return nil, fmt.Errorf("BTC header %d in the BTC timestamp is nil", i)
+		}
+		header, err := headerInfo.Header.ToBlockHeader()
+		if err != nil {
+			return nil, fmt.Errorf("BTC header %d in the BTC timestamp is invalid: %w", i, err)
+		}
+		if _, ok := chain.indexes[header.BlockHash()]; ok {
+			continue
+		}
+		if !header.PrevBlock.IsEqual(toHashPtr(chain.tip().BlockHash())) {
+			return nil, fmt.Errorf("BTC header %s in the BTC timestamp does not extend the trusted BTC headers", header.BlockHash())
+		}
+		if err := validateBTCHeader(header, powLimit); err != nil {
+			return nil, fmt.Errorf("BTC header %s in the BTC timestamp is invalid: %w", header.BlockHash(), err)
+		}
+		chain.append(header)
+	}
+
+	return chain, nil
+}
+
+// checkpointHeaders returns the BTC headers that include the checkpoint and
+// ensures at least one of them is w-deep. It returns nil headers if any of
+// them is not on the chain
+func (c *btcChain) checkpointHeaders(submissionKey *SubmissionKey, w uint64) ([]*wire.BlockHeader, error) {
+	btcHeadersWithCkpt := []*wire.BlockHeader{}
+	wDeep := false
+	for _, key := range submissionKey.Key {
+		hash, err := key.Hash.ToChainhash()
+		if err != nil {
+			return nil, err
+		}
+		idx, ok := c.indexes[*hash]
+		if !ok {
+			return nil, fmt.Errorf("BTC header %s including the checkpoint is not on the BTC chain", hash)
+		}
+		btcHeadersWithCkpt = append(btcHeadersWithCkpt, c.headers[idx])
+
+		depth := uint64(len(c.headers) - 1 - idx)
+		if depth >= w {
+			wDeep = true
+		}
+	}
+	if !wDeep {
+		return btcHeadersWithCkpt, fmt.Errorf("checkpoint is not %d-deep", w)
+	}
+	return btcHeadersWithCkpt, nil
+}
+
+func toHashPtr(hash chainhash.Hash) *chainhash.Hash {
+	return &hash
+}
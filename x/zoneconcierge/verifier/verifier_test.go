@@ -0,0 +1,232 @@
+package verifier_test
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/boljen/go-bitmap"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	txformat "github.com/babylonchain/babylon/btctxformatter"
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testhelper "github.com/babylonchain/babylon/testutil/helper"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+	"github.com/babylonchain/babylon/x/zoneconcierge/verifier"
+)
+
+func signBLSWithBitmap(blsSKs []bls12381.PrivateKey, bm bitmap.Bitmap, msg []byte) (bls12381.Signature, error) {
+	sigs := []bls12381.Signature{}
+	for i := 0; i < len(blsSKs); i++ {
+		if bitmap.Get(bm, i) {
+			sig := bls12381.Sign(blsSKs[i], msg)
+			sigs = append(sigs, sig)
+		}
+	}
+	return bls12381.AggrSigList(sigs)
+}
+
+func requireVerdicts(t *testing.T, report *verifier.Report, expected map[string]verifier.Verdict) {
+	for name, verdict := range expected {
+		step, ok := report.Step(name)
+		require.True(t, ok, name)
+		require.Equal(t, verdict, step.Verdict, step.String())
+	}
+}
+
+func FuzzVerify(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		genesisValSet, privSigner, err := datagen.GenesisValidatorSetWithPrivSigner(10)
+		require.NoError(t, err)
+		h := testhelper.NewHelperWithValSet(t, genesisValSet, privSigner)
+		ek := &h.App.EpochingKeeper
+		zck := h.App.ZoneConciergeKeeper
+
+		/*
+			generate a CZ header in epoch 2 and seal epoch 2
+		*/
+		epochInterval := ek.GetParams(h.Ctx).EpochInterval
+		for j := 0; j < int(epochInterval)-2; j++ {
+			h.Ctx, err = h.ApplyEmptyBlockWithVoteExtension(r)
+			h.NoError(err)
+		}
+		chainID := datagen.GenRandomHexStr(r, 10)
+		height := datagen.RandomInt(r, 100) + 1
+		ibctmHeader := datagen.GenRandomIBCTMHeader(r, chainID, height)
+		zck.HandleHeaderWithValidCommit(h.Ctx, datagen.GenRandomByteArray(r, 32), datagen.HeaderToHeaderInfo(ibctmHeader), false)
+		indexedHeader, err := zck.GetHeader(h.Ctx, chainID, height)
+		h.NoError(err)
+		for j := 0; j < int(epochInterval)+1; j++ {
+			h.Ctx, err = h.ApplyEmptyBlockWithVoteExtension(r)
+			h.NoError(err)
+		}
+		epoch, err := ek.GetHistoricalEpoch(h.Ctx, indexedHeader.BabylonEpoch)
+		h.NoError(err)
+
+		btcTs := &types.BTCTimestamp{
+			Header:    indexedHeader,
+			EpochInfo: epoch,
+			Proof:     &types.ProofFinalizedChainInfo{},
+		}
+		btcTs.Proof.ProofCzHeaderInEpoch, err = zck.ProveCZHeaderInEpoch(h.Ctx, indexedHeader, epoch)
+		h.NoError(err)
+		btcTs.Proof.ProofEpochSealed, err = zck.ProveEpochSealed(h.Ctx, epoch.EpochNumber)
+		h.NoError(err)
+
+		bm := datagen.GenFullBitmap()
+		blockHash := checkpointingtypes.BlockHash(epoch.SealerBlockHash)
+		rawCkpt := &checkpointingtypes.RawCheckpoint{
+			EpochNum:  epoch.EpochNumber,
+			BlockHash: &blockHash,
+			Bitmap:    bm,
+		}
+		multiSig, err := signBLSWithBitmap(h.GenValidators.GetBLSPrivKeys(), bm, rawCkpt.SignedMsg())
+		require.NoError(t, err)
+		rawCkpt.BlsMultiSig = &multiSig
+		btcTs.RawCheckpoint = rawCkpt
+
+		/*
+			forge a BTC chain extending the trusted BTC genesis, in which two
+			blocks include the checkpoint and are followed by w blocks
+		*/
+		submitterAddr := datagen.GenRandomByteArray(r, txformat.AddressLength)
+		rawBTCCkpt, err := checkpointingtypes.FromRawCkptToBTCCkpt(rawCkpt, submitterAddr)
+		h.NoError(err)
+		testRawCkptData := datagen.EncodeRawCkptToTestData(rawBTCCkpt)
+		idxs := []uint64{datagen.RandomInt(r, 5) + 1, datagen.RandomInt(r, 5) + 1}
+		offsets := []uint64{datagen.RandomInt(r, 5) + 1, datagen.RandomInt(r, 5) + 1}
+		btcBlocks := []*datagen.BlockCreationResult{
+			datagen.CreateBlock(r, 1, uint32(idxs[0]+offsets[0]), uint32(idxs[0]), testRawCkptData.FirstPart),
+			datagen.CreateBlock(r, 2, uint32(idxs[1]+offsets[1]), uint32(idxs[1]), testRawCkptData.SecondPart),
+		}
+		msgInsertBtcSpvProof := datagen.GenerateMessageWithRandomSubmitter(btcBlocks)
+
+		genesisHeader := chaincfg.SimNetParams.GenesisBlock.Header
+		prevHeader := &genesisHeader
+		btcHeaders := []*wire.BlockHeader{}
+		for _, block := range btcBlocks {
+			header := block.HeaderBytes.ToBlockHeader()
+			header.PrevBlock = prevHeader.BlockHash()
+			datagen.SolveBlock(header)
+			btcHeaders = append(btcHeaders, header)
+			prevHeader = header
+		}
+		w := datagen.RandomInt(r, 5) + 1
+		btcHeaders = append(btcHeaders, datagen.GenRandomValidChainStartingFrom(r, 0, prevHeader, nil, uint32(w))...)
+		for _, header := range btcHeaders {
+			headerBytes := bbn.NewBTCHeaderBytesFromBlockHeader(header)
+			btcTs.BtcHeaders = append(btcTs.BtcHeaders, &btclctypes.BTCHeaderInfo{Header: &headerBytes})
+		}
+
+		btcTs.BtcSubmissionKey = &btcctypes.SubmissionKey{}
+		for i := range btcBlocks {
+			blockHash := btcHeaders[i].BlockHash()
+			hash := bbn.NewBTCHeaderHashBytesFromChainhash(&blockHash)
+			key := &btcctypes.TransactionKey{Index: uint32(idxs[i]), Hash: &hash}
+			btcTs.BtcSubmissionKey.Key = append(btcTs.BtcSubmissionKey.Key, key)
+			btcTs.Proof.ProofEpochSubmitted = append(btcTs.Proof.ProofEpochSubmitted, &btcctypes.TransactionInfo{
+				Key:         key,
+				Transaction: msgInsertBtcSpvProof.Proofs[i].BtcTransaction,
+				Proof:       msgInsertBtcSpvProof.Proofs[i].MerkleNodes,
+			})
+		}
+
+		tag, err := hex.DecodeString(btcctypes.DefaultCheckpointTag)
+		require.NoError(t, err)
+		cfg := verifier.Config{
+			PowLimit:      chaincfg.SimNetParams.PowLimit,
+			CheckpointTag: tag,
+			W:             w,
+			CZHeaderKey:   types.GetCZHeaderKeyPath,
+		}
+		trustedHeaders := []*wire.BlockHeader{&genesisHeader}
+
+		// the BTC timestamp is decoded from the data of the IBC packet
+		// carrying it
+		packetData := types.NewBTCTimestampPacketData(btcTs)
+		packetBytes, err := packetData.Marshal()
+		require.NoError(t, err)
+		ts, err := verifier.BTCTimestampFromPacketHex(hex.EncodeToString(packetBytes))
+		require.NoError(t, err)
+
+		// the BTC timestamp passes all steps
+		report := verifier.Verify(ts, trustedHeaders, cfg)
+		require.True(t, report.Valid(), report.String())
+		require.Len(t, report.Steps, 6)
+		for _, step := range report.Steps {
+			require.Equal(t, verifier.Pass, step.Verdict, step.String())
+		}
+
+		// the checkpoint is not deep enough under a larger w
+		deeperCfg := cfg
+		deeperCfg.W = w + 2
+		report = verifier.Verify(ts, trustedHeaders, deeperCfg)
+		require.False(t, report.Valid())
+		requireVerdicts(t, report, map[string]verifier.Verdict{
+			verifier.StepBTCHeaders:      verifier.Pass,
+			verifier.StepCheckpointDepth: verifier.Fail,
+			verifier.StepEpochSubmitted:  verifier.Pass,
+		})
+
+		// the BTC headers do not extend untrusted BTC headers
+		randomHeader := datagen.GenRandomBtcdHeader(r)
+		report = verifier.Verify(ts, []*wire.BlockHeader{randomHeader}, cfg)
+		require.False(t, report.Valid())
+		requireVerdicts(t, report, map[string]verifier.Verdict{
+			verifier.StepBTCHeaders:      verifier.Fail,
+			verifier.StepCheckpointDepth: verifier.Skip,
+			verifier.StepEpochSubmitted:  verifier.Skip,
+			verifier.StepEpochSealed:     verifier.Pass,
+			verifier.StepHeaderInEpoch:   verifier.Pass,
+		})
+
+		// the BTC timestamp can be decoded from the query response, whose BTC
+		// headers are all trusted
+		resp := &types.QueryFinalizedChainInfoUntilHeightResponse{
+			FinalizedChainInfo: &types.ChainInfo{ChainId: chainID, LatestHeader: indexedHeader},
+			EpochInfo:          btcTs.EpochInfo,
+			RawCheckpoint:      btcTs.RawCheckpoint,
+			BtcSubmissionKey:   btcTs.BtcSubmissionKey,
+			Proof:              btcTs.Proof,
+		}
+		respJSON, err := types.ModuleCdc.MarshalJSON(resp)
+		require.NoError(t, err)
+		decodedTs, err := verifier.BTCTimestampFromQueryJSON(respJSON)
+		require.NoError(t, err)
+		headerLines := []string{bbn.NewBTCHeaderBytesFromBlockHeader(&genesisHeader).MarshalHex()}
+		for _, header := range btcHeaders {
+			headerLines = append(headerLines, bbn.NewBTCHeaderBytesFromBlockHeader(header).MarshalHex())
+		}
+		allHeaders, err := verifier.ReadBTCHeaders(strings.NewReader(strings.Join(headerLines, "\n")))
+		require.NoError(t, err)
+		require.Len(t, allHeaders, len(btcHeaders)+1)
+		report = verifier.Verify(decodedTs, allHeaders, cfg)
+		require.True(t, report.Valid(), report.String())
+
+		// a BTC timestamp without CZ header is still valid
+		decodedTs.Header = nil
+		report = verifier.Verify(decodedTs, allHeaders, cfg)
+		require.True(t, report.Valid(), report.String())
+		requireVerdicts(t, report, map[string]verifier.Verdict{verifier.StepHeaderInEpoch: verifier.Skip})
+
+		// a raw checkpoint of another epoch makes the BTC timestamp malformed
+		decodedTs.RawCheckpoint.EpochNum++
+		report = verifier.Verify(decodedTs, allHeaders, cfg)
+		require.False(t, report.Valid())
+		requireVerdicts(t, report, map[string]verifier.Verdict{
+			verifier.StepEpochCheckpoint: verifier.Fail,
+			verifier.StepEpochSealed:     verifier.Skip,
+		})
+	})
+}